@@ -84,5 +84,10 @@ which is given to the BLAS routine as [∗ 1 2 3 4 ...].
 
 See http://www.crest.iu.edu/research/mtl/reference/html/banded.html
 for more information
+
+Large Level 3 operations are split into blocks that are computed concurrently
+by multiple goroutines. The maximum number of goroutines used is set by
+SetWorkers and defaults to runtime.GOMAXPROCS(0). The Implementation returned
+by Serial computes all operations in the calling goroutine.
 */
 package gonum // import "gonum.org/v1/gonum/blas/gonum"
@@ -12,7 +12,21 @@ import (
 	"gonum.org/v1/gonum/internal/math32"
 )
 
-type Implementation struct{}
+// Implementation is the native Go implementation of the BLAS routines.
+type Implementation struct {
+	// serial specifies that the Level 3 routines are computed in the
+	// calling goroutine.
+	serial bool
+}
+
+// Serial returns an Implementation that computes the Level 3 routines in the
+// calling goroutine regardless of the number of workers set by SetWorkers.
+// It is intended for callers that already call the BLAS routines from
+// concurrently running goroutines, so that the number of goroutines does not
+// multiply.
+func Serial() Implementation {
+	return Implementation{serial: true}
+}
 
 // [SD]gemm behavior constants. These are kept here to keep them out of the
 // way during single precision code genration.
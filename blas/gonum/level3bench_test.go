@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/testblas"
+)
+
+func BenchmarkDsymmMedMed(b *testing.B) {
+	testblas.DsymmBenchmark(b, impl, blas.Left, blas.Upper, Med, Med)
+}
+
+func BenchmarkDsymmLgLg(b *testing.B) {
+	testblas.DsymmBenchmark(b, impl, blas.Left, blas.Upper, Lg, Lg)
+}
+
+func BenchmarkDsymmRightLgMed(b *testing.B) {
+	testblas.DsymmBenchmark(b, impl, blas.Right, blas.Lower, Lg, Med)
+}
+
+func BenchmarkDsyrkMedMed(b *testing.B) {
+	testblas.DsyrkBenchmark(b, impl, blas.Upper, NT, Med, Med)
+}
+
+func BenchmarkDsyrkLgLg(b *testing.B) {
+	testblas.DsyrkBenchmark(b, impl, blas.Upper, NT, Lg, Lg)
+}
+
+func BenchmarkDsyrkLgSmT(b *testing.B) {
+	testblas.DsyrkBenchmark(b, impl, blas.Lower, T, Lg, Sm)
+}
+
+func BenchmarkDsyr2kMedMed(b *testing.B) {
+	testblas.Dsyr2kBenchmark(b, impl, blas.Upper, NT, Med, Med)
+}
+
+func BenchmarkDsyr2kLgLg(b *testing.B) {
+	testblas.Dsyr2kBenchmark(b, impl, blas.Lower, NT, Lg, Lg)
+}
+
+func BenchmarkDtrmmMedMed(b *testing.B) {
+	testblas.DtrmmBenchmark(b, impl, blas.Left, blas.Upper, NT, blas.NonUnit, Med, Med)
+}
+
+func BenchmarkDtrmmLgLg(b *testing.B) {
+	testblas.DtrmmBenchmark(b, impl, blas.Left, blas.Upper, NT, blas.NonUnit, Lg, Lg)
+}
+
+func BenchmarkDtrmmRightLgLg(b *testing.B) {
+	testblas.DtrmmBenchmark(b, impl, blas.Right, blas.Lower, T, blas.NonUnit, Lg, Lg)
+}
+
+func BenchmarkDtrsmMedMed(b *testing.B) {
+	testblas.DtrsmBenchmark(b, impl, blas.Left, blas.Lower, NT, blas.NonUnit, Med, Med)
+}
+
+func BenchmarkDtrsmLgLg(b *testing.B) {
+	testblas.DtrsmBenchmark(b, impl, blas.Left, blas.Lower, NT, blas.NonUnit, Lg, Lg)
+}
+
+func BenchmarkDtrsmRightLgLg(b *testing.B) {
+	testblas.DtrsmBenchmark(b, impl, blas.Right, blas.Upper, T, blas.NonUnit, Lg, Lg)
+}
+
+func BenchmarkZgemmMedMedMed(b *testing.B) {
+	testblas.ZgemmBenchmark(b, impl, NT, NT, Med, Med, Med)
+}
+
+func BenchmarkZgemmLgLgLg(b *testing.B) {
+	testblas.ZgemmBenchmark(b, impl, NT, NT, Lg, Lg, Lg)
+}
+
+func BenchmarkZhemmLgLg(b *testing.B) {
+	testblas.ZhemmBenchmark(b, impl, blas.Left, blas.Upper, Lg, Lg)
+}
+
+func BenchmarkZherkLgLg(b *testing.B) {
+	testblas.ZherkBenchmark(b, impl, blas.Upper, NT, Lg, Lg)
+}
+
+func BenchmarkZtrsmLgLg(b *testing.B) {
+	testblas.ZtrsmBenchmark(b, impl, blas.Left, blas.Lower, NT, blas.NonUnit, Lg, Lg)
+}
@@ -19,7 +19,7 @@ var _ blas.Complex128Level3 = Implementation{}
 //  op(X) = X  or  op(X) = Xᵀ  or  op(X) = Xᴴ,
 // alpha and beta are scalars, and A, B and C are matrices, with op(A) an m×k matrix,
 // op(B) a k×n matrix and C an m×n matrix.
-func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	switch tA {
 	default:
 		panic(badTranspose)
@@ -94,6 +94,10 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 		return
 	}
 
+	if !impl.serial && zgemmParallel(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	switch tA {
 	case blas.NoTrans:
 		switch tB {
@@ -263,7 +267,7 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 // where alpha and beta are scalars, A is an m×m or n×n hermitian matrix and B
 // and C are m×n matrices. The imaginary parts of the diagonal elements of A are
 // assumed to be zero.
-func (Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -323,6 +327,10 @@ func (Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 		return
 	}
 
+	if !impl.serial && zsymmParallel(true, side, uplo, m, n, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	if side == blas.Left {
 		// Form  C = alpha*A*B + beta*C.
 		for i := 0; i < m; i++ {
@@ -412,7 +420,7 @@ func (Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 //
 // The imaginary parts of the diagonal elements of C are assumed to be zero, and
 // on return they will be set to zero.
-func (Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) {
+func (impl Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) {
 	var rowA, colA int
 	switch trans {
 	default:
@@ -492,6 +500,10 @@ func (Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 		return
 	}
 
+	if !impl.serial && zherkParallel(uplo, trans, n, k, alpha, a, lda, beta, c, ldc) {
+		return
+	}
+
 	calpha := complex(alpha, 0)
 	if trans == blas.NoTrans {
 		// Form  C = alpha*A*Aᴴ + beta*C.
@@ -610,7 +622,7 @@ func (Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 //
 // The imaginary parts of the diagonal elements of C are assumed to be zero, and
 // on return they will be set to zero.
-func (Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) {
+func (impl Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) {
 	var row, col int
 	switch trans {
 	default:
@@ -695,6 +707,10 @@ func (Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 		return
 	}
 
+	if !impl.serial && zher2kParallel(uplo, trans, n, k, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	conjalpha := cmplx.Conj(alpha)
 	cbeta := complex(beta, 0)
 	if trans == blas.NoTrans {
@@ -803,7 +819,7 @@ func (Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 //  C = alpha*B*A + beta*C  if side == blas.Right
 // where alpha and beta are scalars, A is an m×m or n×n symmetric matrix and B
 // and C are m×n matrices.
-func (Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -863,6 +879,10 @@ func (Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 		return
 	}
 
+	if !impl.serial && zsymmParallel(false, side, uplo, m, n, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	if side == blas.Left {
 		// Form  C = alpha*A*B + beta*C.
 		for i := 0; i < m; i++ {
@@ -947,7 +967,7 @@ func (Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 //  C = alpha*Aᵀ*A + beta*C  if trans == blas.Trans
 // where alpha and beta are scalars, C is an n×n symmetric matrix and A is
 // an n×k matrix in the first case and a k×n matrix in the second case.
-func (Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
 	var rowA, colA int
 	switch trans {
 	default:
@@ -1021,6 +1041,10 @@ func (Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 		return
 	}
 
+	if !impl.serial && zsyrkParallel(uplo, trans, n, k, alpha, a, lda, beta, c, ldc) {
+		return
+	}
+
 	if trans == blas.NoTrans {
 		// Form  C = alpha*A*Aᵀ + beta*C.
 		if uplo == blas.Upper {
@@ -1105,7 +1129,7 @@ func (Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 //  C = alpha*Aᵀ*B + alpha*Bᵀ*A + beta*C  if trans == blas.Trans
 // where alpha and beta are scalars, C is an n×n symmetric matrix and A and B
 // are n×k matrices in the first case and k×n matrices in the second case.
-func (Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	var row, col int
 	switch trans {
 	default:
@@ -1184,6 +1208,10 @@ func (Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 		return
 	}
 
+	if !impl.serial && zsyr2kParallel(uplo, trans, n, k, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	if trans == blas.NoTrans {
 		// Form  C = alpha*A*Bᵀ + alpha*B*Aᵀ + beta*C.
 		if uplo == blas.Upper {
@@ -1281,7 +1309,7 @@ func (Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 //  op(A) = A   if trans == blas.NoTrans,
 //  op(A) = Aᵀ  if trans == blas.Trans,
 //  op(A) = Aᴴ  if trans == blas.ConjTrans.
-func (Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+func (impl Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -1329,6 +1357,10 @@ func (Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose
 		return
 	}
 
+	if !impl.serial && ztrmmParallel(side, uplo, trans, diag, m, n, alpha, a, lda, b, ldb) {
+		return
+	}
+
 	noConj := trans != blas.ConjTrans
 	noUnit := diag == blas.NonUnit
 	if side == blas.Left {
@@ -1510,7 +1542,7 @@ func (Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose
 //  op(A) = Aᵀ  if transA == blas.Trans,
 //  op(A) = Aᴴ  if transA == blas.ConjTrans.
 // On return the matrix X is overwritten on B.
-func (Implementation) Ztrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+func (impl Implementation) Ztrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -1556,6 +1588,10 @@ func (Implementation) Ztrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpos
 		return
 	}
 
+	if !impl.serial && ztrsmParallel(side, uplo, transA, diag, m, n, alpha, a, lda, b, ldb) {
+		return
+	}
+
 	noConj := transA != blas.ConjTrans
 	noUnit := diag == blas.NonUnit
 	if side == blas.Left {
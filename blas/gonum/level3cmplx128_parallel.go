@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The routines in this file are the complex counterparts of the routines in
+// level3float64_parallel.go. See the comment there for a description.
+
+// zgemmParallel computes Zgemm concurrently over blocks of C.
+func zgemmParallel(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) bool {
+	mb := blocks(m, blockSize)
+	nb := blocks(n, blockSize)
+	if !useParallel(mb * nb) {
+		return false
+	}
+	parallelFor(mb*nb, func(t int) {
+		i := (t / nb) * blockSize
+		j := (t % nb) * blockSize
+		var aSub, bSub []complex128
+		if tA == blas.NoTrans {
+			aSub = a[i*lda:]
+		} else {
+			aSub = a[i:]
+		}
+		if tB == blas.NoTrans {
+			bSub = b[j:]
+		} else {
+			bSub = b[j*ldb:]
+		}
+		Implementation{}.Zgemm(tA, tB, min(blockSize, m-i), min(blockSize, n-j), k, alpha, aSub, lda, bSub, ldb, beta, c[i*ldc+j:], ldc)
+	})
+	return true
+}
+
+// zsymmParallel computes Zsymm, or Zhemm if herm is true, concurrently over
+// blocks of C.
+func zsymmParallel(herm bool, side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) bool {
+	mb := blocks(m, blockSize)
+	nb := blocks(n, blockSize)
+	if !useParallel(mb * nb) {
+		return false
+	}
+	na := n
+	if side == blas.Left {
+		na = m
+	}
+	diag := Implementation{}.Zsymm
+	if herm {
+		diag = Implementation{}.Zhemm
+	}
+	parallelFor(mb*nb, func(t int) {
+		i := (t / nb) * blockSize
+		j := (t % nb) * blockSize
+		leni := min(blockSize, m-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		beta := beta
+		for l := 0; l < na; l += blockSize {
+			lenl := min(blockSize, na-l)
+			if side == blas.Left {
+				// C_ij += alpha * A_il * B_lj.
+				bSub := b[l*ldb+j:]
+				if l == i {
+					diag(side, uplo, leni, lenj, alpha, a[i*lda+i:], lda, bSub, ldb, beta, cSub, ldc)
+				} else {
+					aSub, tA := zsymBlock(herm, uplo, i, l, a, lda)
+					Implementation{}.Zgemm(tA, blas.NoTrans, leni, lenj, lenl, alpha, aSub, lda, bSub, ldb, beta, cSub, ldc)
+				}
+			} else {
+				// C_ij += alpha * B_il * A_lj.
+				bSub := b[i*ldb+l:]
+				if l == j {
+					diag(side, uplo, leni, lenj, alpha, a[j*lda+j:], lda, bSub, ldb, beta, cSub, ldc)
+				} else {
+					aSub, tA := zsymBlock(herm, uplo, l, j, a, lda)
+					Implementation{}.Zgemm(blas.NoTrans, tA, leni, lenj, lenl, alpha, bSub, ldb, aSub, lda, beta, cSub, ldc)
+				}
+			}
+			beta = 1
+		}
+	})
+	return true
+}
+
+// zsymBlock returns a view of the off-diagonal block of the symmetric, or
+// Hermitian if herm is true, matrix A starting at row i and column j, and the
+// transpose operation that must be applied to the view to obtain the block,
+// given that only the uplo triangle of a is stored.
+func zsymBlock(herm bool, uplo blas.Uplo, i, j int, a []complex128, lda int) ([]complex128, blas.Transpose) {
+	if (uplo == blas.Upper) == (i < j) {
+		return a[i*lda+j:], blas.NoTrans
+	}
+	if herm {
+		return a[j*lda+i:], blas.ConjTrans
+	}
+	return a[j*lda+i:], blas.Trans
+}
+
+// zherkParallel computes Zherk concurrently over blocks of the uplo triangle
+// of C.
+func zherkParallel(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) bool {
+	off := triBlocks(uplo, n)
+	if !useParallel(len(off)) {
+		return false
+	}
+	calpha := complex(alpha, 0)
+	cbeta := complex(beta, 0)
+	parallelFor(len(off), func(t int) {
+		i, j := off[t][0], off[t][1]
+		leni := min(blockSize, n-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		if trans == blas.NoTrans {
+			// C_ij = alpha * A_i * A_jᴴ + beta * C_ij.
+			if i == j {
+				Implementation{}.Zherk(uplo, trans, leni, k, alpha, a[i*lda:], lda, beta, cSub, ldc)
+				return
+			}
+			Implementation{}.Zgemm(blas.NoTrans, blas.ConjTrans, leni, lenj, k, calpha, a[i*lda:], lda, a[j*lda:], lda, cbeta, cSub, ldc)
+			return
+		}
+		// C_ij = alpha * A_iᴴ * A_j + beta * C_ij.
+		if i == j {
+			Implementation{}.Zherk(uplo, trans, leni, k, alpha, a[i:], lda, beta, cSub, ldc)
+			return
+		}
+		Implementation{}.Zgemm(blas.ConjTrans, blas.NoTrans, leni, lenj, k, calpha, a[i:], lda, a[j:], lda, cbeta, cSub, ldc)
+	})
+	return true
+}
+
+// zher2kParallel computes Zher2k concurrently over blocks of the uplo triangle
+// of C.
+func zher2kParallel(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) bool {
+	off := triBlocks(uplo, n)
+	if !useParallel(len(off)) {
+		return false
+	}
+	conjAlpha := cmplx.Conj(alpha)
+	cbeta := complex(beta, 0)
+	parallelFor(len(off), func(t int) {
+		i, j := off[t][0], off[t][1]
+		leni := min(blockSize, n-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		if trans == blas.NoTrans {
+			// C_ij = alpha * A_i * B_jᴴ + conj(alpha) * B_i * A_jᴴ + beta * C_ij.
+			if i == j {
+				Implementation{}.Zher2k(uplo, trans, leni, k, alpha, a[i*lda:], lda, b[i*ldb:], ldb, beta, cSub, ldc)
+				return
+			}
+			Implementation{}.Zgemm(blas.NoTrans, blas.ConjTrans, leni, lenj, k, alpha, a[i*lda:], lda, b[j*ldb:], ldb, cbeta, cSub, ldc)
+			Implementation{}.Zgemm(blas.NoTrans, blas.ConjTrans, leni, lenj, k, conjAlpha, b[i*ldb:], ldb, a[j*lda:], lda, 1, cSub, ldc)
+			return
+		}
+		// C_ij = alpha * A_iᴴ * B_j + conj(alpha) * B_iᴴ * A_j + beta * C_ij.
+		if i == j {
+			Implementation{}.Zher2k(uplo, trans, leni, k, alpha, a[i:], lda, b[i:], ldb, beta, cSub, ldc)
+			return
+		}
+		Implementation{}.Zgemm(blas.ConjTrans, blas.NoTrans, leni, lenj, k, alpha, a[i:], lda, b[j:], ldb, cbeta, cSub, ldc)
+		Implementation{}.Zgemm(blas.ConjTrans, blas.NoTrans, leni, lenj, k, conjAlpha, b[i:], ldb, a[j:], lda, 1, cSub, ldc)
+	})
+	return true
+}
+
+// zsyrkParallel computes Zsyrk concurrently over blocks of the uplo triangle
+// of C.
+func zsyrkParallel(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) bool {
+	off := triBlocks(uplo, n)
+	if !useParallel(len(off)) {
+		return false
+	}
+	parallelFor(len(off), func(t int) {
+		i, j := off[t][0], off[t][1]
+		leni := min(blockSize, n-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		if trans == blas.NoTrans {
+			// C_ij = alpha * A_i * A_jᵀ + beta * C_ij.
+			if i == j {
+				Implementation{}.Zsyrk(uplo, trans, leni, k, alpha, a[i*lda:], lda, beta, cSub, ldc)
+				return
+			}
+			Implementation{}.Zgemm(blas.NoTrans, blas.Trans, leni, lenj, k, alpha, a[i*lda:], lda, a[j*lda:], lda, beta, cSub, ldc)
+			return
+		}
+		// C_ij = alpha * A_iᵀ * A_j + beta * C_ij.
+		if i == j {
+			Implementation{}.Zsyrk(uplo, trans, leni, k, alpha, a[i:], lda, beta, cSub, ldc)
+			return
+		}
+		Implementation{}.Zgemm(blas.Trans, blas.NoTrans, leni, lenj, k, alpha, a[i:], lda, a[j:], lda, beta, cSub, ldc)
+	})
+	return true
+}
+
+// zsyr2kParallel computes Zsyr2k concurrently over blocks of the uplo triangle
+// of C.
+func zsyr2kParallel(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) bool {
+	off := triBlocks(uplo, n)
+	if !useParallel(len(off)) {
+		return false
+	}
+	parallelFor(len(off), func(t int) {
+		i, j := off[t][0], off[t][1]
+		leni := min(blockSize, n-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		if trans == blas.NoTrans {
+			// C_ij = alpha * A_i * B_jᵀ + alpha * B_i * A_jᵀ + beta * C_ij.
+			if i == j {
+				Implementation{}.Zsyr2k(uplo, trans, leni, k, alpha, a[i*lda:], lda, b[i*ldb:], ldb, beta, cSub, ldc)
+				return
+			}
+			Implementation{}.Zgemm(blas.NoTrans, blas.Trans, leni, lenj, k, alpha, a[i*lda:], lda, b[j*ldb:], ldb, beta, cSub, ldc)
+			Implementation{}.Zgemm(blas.NoTrans, blas.Trans, leni, lenj, k, alpha, b[i*ldb:], ldb, a[j*lda:], lda, 1, cSub, ldc)
+			return
+		}
+		// C_ij = alpha * A_iᵀ * B_j + alpha * B_iᵀ * A_j + beta * C_ij.
+		if i == j {
+			Implementation{}.Zsyr2k(uplo, trans, leni, k, alpha, a[i:], lda, b[i:], ldb, beta, cSub, ldc)
+			return
+		}
+		Implementation{}.Zgemm(blas.Trans, blas.NoTrans, leni, lenj, k, alpha, a[i:], lda, b[j:], ldb, beta, cSub, ldc)
+		Implementation{}.Zgemm(blas.Trans, blas.NoTrans, leni, lenj, k, alpha, b[i:], ldb, a[j:], lda, 1, cSub, ldc)
+	})
+	return true
+}
+
+// ztrmmParallel computes Ztrmm concurrently over independent panels of B.
+// The columns of B are independent when A is on the left, and the rows of B
+// are independent when A is on the right.
+func ztrmmParallel(side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) bool {
+	if side == blas.Left {
+		nb := blocks(n, blockSize)
+		if !useParallel(nb) {
+			return false
+		}
+		parallelFor(nb, func(t int) {
+			j := t * blockSize
+			Implementation{}.Ztrmm(side, uplo, trans, diag, m, min(blockSize, n-j), alpha, a, lda, b[j:], ldb)
+		})
+		return true
+	}
+	mb := blocks(m, blockSize)
+	if !useParallel(mb) {
+		return false
+	}
+	parallelFor(mb, func(t int) {
+		i := t * blockSize
+		Implementation{}.Ztrmm(side, uplo, trans, diag, min(blockSize, m-i), n, alpha, a, lda, b[i*ldb:], ldb)
+	})
+	return true
+}
+
+// ztrsmParallel computes Ztrsm concurrently over independent panels of B.
+// The columns of B are independent when A is on the left, and the rows of B
+// are independent when A is on the right.
+func ztrsmParallel(side blas.Side, uplo blas.Uplo, transA blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) bool {
+	if side == blas.Left {
+		nb := blocks(n, blockSize)
+		if !useParallel(nb) {
+			return false
+		}
+		parallelFor(nb, func(t int) {
+			j := t * blockSize
+			Implementation{}.Ztrsm(side, uplo, transA, diag, m, min(blockSize, n-j), alpha, a, lda, b[j:], ldb)
+		})
+		return true
+	}
+	mb := blocks(m, blockSize)
+	if !useParallel(mb) {
+		return false
+	}
+	parallelFor(mb, func(t int) {
+		i := t * blockSize
+		Implementation{}.Ztrsm(side, uplo, transA, diag, min(blockSize, m-i), n, alpha, a, lda, b[i*ldb:], ldb)
+	})
+	return true
+}
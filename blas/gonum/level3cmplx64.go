@@ -23,7 +23,7 @@ var _ blas.Complex64Level3 = Implementation{}
 // op(B) a k×n matrix and C an m×n matrix.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+func (impl Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
 	switch tA {
 	default:
 		panic(badTranspose)
@@ -98,6 +98,10 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 		return
 	}
 
+	if !impl.serial && cgemmParallel(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	switch tA {
 	case blas.NoTrans:
 		switch tB {
@@ -269,7 +273,7 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 // assumed to be zero.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Chemm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+func (impl Implementation) Chemm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -329,6 +333,10 @@ func (Implementation) Chemm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 		return
 	}
 
+	if !impl.serial && csymmParallel(true, side, uplo, m, n, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	if side == blas.Left {
 		// Form  C = alpha*A*B + beta*C.
 		for i := 0; i < m; i++ {
@@ -420,7 +428,7 @@ func (Implementation) Chemm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 // on return they will be set to zero.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Cherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha float32, a []complex64, lda int, beta float32, c []complex64, ldc int) {
+func (impl Implementation) Cherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha float32, a []complex64, lda int, beta float32, c []complex64, ldc int) {
 	var rowA, colA int
 	switch trans {
 	default:
@@ -500,6 +508,10 @@ func (Implementation) Cherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 		return
 	}
 
+	if !impl.serial && cherkParallel(uplo, trans, n, k, alpha, a, lda, beta, c, ldc) {
+		return
+	}
+
 	calpha := complex(alpha, 0)
 	if trans == blas.NoTrans {
 		// Form  C = alpha*A*Aᴴ + beta*C.
@@ -620,7 +632,7 @@ func (Implementation) Cherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 // on return they will be set to zero.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Cher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta float32, c []complex64, ldc int) {
+func (impl Implementation) Cher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta float32, c []complex64, ldc int) {
 	var row, col int
 	switch trans {
 	default:
@@ -705,6 +717,10 @@ func (Implementation) Cher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 		return
 	}
 
+	if !impl.serial && cher2kParallel(uplo, trans, n, k, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	conjalpha := cmplx.Conj(alpha)
 	cbeta := complex(beta, 0)
 	if trans == blas.NoTrans {
@@ -815,7 +831,7 @@ func (Implementation) Cher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 // and C are m×n matrices.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Csymm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+func (impl Implementation) Csymm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -875,6 +891,10 @@ func (Implementation) Csymm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 		return
 	}
 
+	if !impl.serial && csymmParallel(false, side, uplo, m, n, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	if side == blas.Left {
 		// Form  C = alpha*A*B + beta*C.
 		for i := 0; i < m; i++ {
@@ -961,7 +981,7 @@ func (Implementation) Csymm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 // an n×k matrix in the first case and a k×n matrix in the second case.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Csyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, beta complex64, c []complex64, ldc int) {
+func (impl Implementation) Csyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, beta complex64, c []complex64, ldc int) {
 	var rowA, colA int
 	switch trans {
 	default:
@@ -1035,6 +1055,10 @@ func (Implementation) Csyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 		return
 	}
 
+	if !impl.serial && csyrkParallel(uplo, trans, n, k, alpha, a, lda, beta, c, ldc) {
+		return
+	}
+
 	if trans == blas.NoTrans {
 		// Form  C = alpha*A*Aᵀ + beta*C.
 		if uplo == blas.Upper {
@@ -1121,7 +1145,7 @@ func (Implementation) Csyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 // are n×k matrices in the first case and k×n matrices in the second case.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Csyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+func (impl Implementation) Csyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
 	var row, col int
 	switch trans {
 	default:
@@ -1200,6 +1224,10 @@ func (Implementation) Csyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 		return
 	}
 
+	if !impl.serial && csyr2kParallel(uplo, trans, n, k, alpha, a, lda, b, ldb, beta, c, ldc) {
+		return
+	}
+
 	if trans == blas.NoTrans {
 		// Form  C = alpha*A*Bᵀ + alpha*B*Aᵀ + beta*C.
 		if uplo == blas.Upper {
@@ -1299,7 +1327,7 @@ func (Implementation) Csyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 //  op(A) = Aᴴ  if trans == blas.ConjTrans.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Ctrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
+func (impl Implementation) Ctrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -1347,6 +1375,10 @@ func (Implementation) Ctrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose
 		return
 	}
 
+	if !impl.serial && ctrmmParallel(side, uplo, trans, diag, m, n, alpha, a, lda, b, ldb) {
+		return
+	}
+
 	noConj := trans != blas.ConjTrans
 	noUnit := diag == blas.NonUnit
 	if side == blas.Left {
@@ -1530,7 +1562,7 @@ func (Implementation) Ctrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose
 // On return the matrix X is overwritten on B.
 //
 // Complex64 implementations are autogenerated and not directly tested.
-func (Implementation) Ctrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpose, diag blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
+func (impl Implementation) Ctrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpose, diag blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -1576,6 +1608,10 @@ func (Implementation) Ctrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpos
 		return
 	}
 
+	if !impl.serial && ctrsmParallel(side, uplo, transA, diag, m, n, alpha, a, lda, b, ldb) {
+		return
+	}
+
 	noConj := transA != blas.ConjTrans
 	noUnit := diag == blas.NonUnit
 	if side == blas.Left {
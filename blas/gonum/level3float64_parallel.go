@@ -0,0 +1,186 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// The routines in this file split a Level 3 operation into blocks of at most
+// blockSize×blockSize elements of the output matrix and compute the blocks
+// concurrently. Each block is computed by calling the exported routines on
+// sub-matrix views. The views are small enough that those calls are computed
+// serially.
+//
+// Each routine reports whether it performed the computation. When the
+// operation is too small to be split usefully, it returns false without
+// modifying its output and the caller must compute the result.
+
+// dsymmParallel computes Dsymm concurrently over blocks of C.
+func dsymmParallel(s blas.Side, ul blas.Uplo, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) bool {
+	mb := blocks(m, blockSize)
+	nb := blocks(n, blockSize)
+	if !useParallel(mb * nb) {
+		return false
+	}
+	k := n
+	if s == blas.Left {
+		k = m
+	}
+	parallelFor(mb*nb, func(t int) {
+		i := (t / nb) * blockSize
+		j := (t % nb) * blockSize
+		leni := min(blockSize, m-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		beta := beta
+		for l := 0; l < k; l += blockSize {
+			lenl := min(blockSize, k-l)
+			if s == blas.Left {
+				// C_ij += alpha * A_il * B_lj.
+				bSub := b[l*ldb+j:]
+				if l == i {
+					Implementation{}.Dsymm(s, ul, leni, lenj, alpha, a[i*lda+i:], lda, bSub, ldb, beta, cSub, ldc)
+				} else {
+					aSub, tA := dsymBlock(ul, i, l, a, lda)
+					Implementation{}.Dgemm(tA, blas.NoTrans, leni, lenj, lenl, alpha, aSub, lda, bSub, ldb, beta, cSub, ldc)
+				}
+			} else {
+				// C_ij += alpha * B_il * A_lj.
+				bSub := b[i*ldb+l:]
+				if l == j {
+					Implementation{}.Dsymm(s, ul, leni, lenj, alpha, a[j*lda+j:], lda, bSub, ldb, beta, cSub, ldc)
+				} else {
+					aSub, tA := dsymBlock(ul, l, j, a, lda)
+					Implementation{}.Dgemm(blas.NoTrans, tA, leni, lenj, lenl, alpha, bSub, ldb, aSub, lda, beta, cSub, ldc)
+				}
+			}
+			beta = 1
+		}
+	})
+	return true
+}
+
+// dsymBlock returns a view of the off-diagonal block of the symmetric matrix
+// A starting at row i and column j, and whether the view must be transposed
+// to obtain the block, given that only the ul triangle of a is stored.
+func dsymBlock(ul blas.Uplo, i, j int, a []float64, lda int) ([]float64, blas.Transpose) {
+	if (ul == blas.Upper) == (i < j) {
+		return a[i*lda+j:], blas.NoTrans
+	}
+	return a[j*lda+i:], blas.Trans
+}
+
+// dsyrkParallel computes Dsyrk concurrently over blocks of the ul triangle of C.
+func dsyrkParallel(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) bool {
+	off := triBlocks(ul, n)
+	if !useParallel(len(off)) {
+		return false
+	}
+	parallelFor(len(off), func(t int) {
+		i, j := off[t][0], off[t][1]
+		leni := min(blockSize, n-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		if tA == blas.NoTrans {
+			// C_ij = alpha * A_i * A_jᵀ + beta * C_ij.
+			if i == j {
+				Implementation{}.Dsyrk(ul, tA, leni, k, alpha, a[i*lda:], lda, beta, cSub, ldc)
+				return
+			}
+			Implementation{}.Dgemm(blas.NoTrans, blas.Trans, leni, lenj, k, alpha, a[i*lda:], lda, a[j*lda:], lda, beta, cSub, ldc)
+			return
+		}
+		// C_ij = alpha * A_iᵀ * A_j + beta * C_ij.
+		if i == j {
+			Implementation{}.Dsyrk(ul, tA, leni, k, alpha, a[i:], lda, beta, cSub, ldc)
+			return
+		}
+		Implementation{}.Dgemm(blas.Trans, blas.NoTrans, leni, lenj, k, alpha, a[i:], lda, a[j:], lda, beta, cSub, ldc)
+	})
+	return true
+}
+
+// dsyr2kParallel computes Dsyr2k concurrently over blocks of the ul triangle of C.
+func dsyr2kParallel(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) bool {
+	off := triBlocks(ul, n)
+	if !useParallel(len(off)) {
+		return false
+	}
+	parallelFor(len(off), func(t int) {
+		i, j := off[t][0], off[t][1]
+		leni := min(blockSize, n-i)
+		lenj := min(blockSize, n-j)
+		cSub := c[i*ldc+j:]
+		if tA == blas.NoTrans {
+			// C_ij = alpha * A_i * B_jᵀ + alpha * B_i * A_jᵀ + beta * C_ij.
+			if i == j {
+				Implementation{}.Dsyr2k(ul, tA, leni, k, alpha, a[i*lda:], lda, b[i*ldb:], ldb, beta, cSub, ldc)
+				return
+			}
+			Implementation{}.Dgemm(blas.NoTrans, blas.Trans, leni, lenj, k, alpha, a[i*lda:], lda, b[j*ldb:], ldb, beta, cSub, ldc)
+			Implementation{}.Dgemm(blas.NoTrans, blas.Trans, leni, lenj, k, alpha, b[i*ldb:], ldb, a[j*lda:], lda, 1, cSub, ldc)
+			return
+		}
+		// C_ij = alpha * A_iᵀ * B_j + alpha * B_iᵀ * A_j + beta * C_ij.
+		if i == j {
+			Implementation{}.Dsyr2k(ul, tA, leni, k, alpha, a[i:], lda, b[i:], ldb, beta, cSub, ldc)
+			return
+		}
+		Implementation{}.Dgemm(blas.Trans, blas.NoTrans, leni, lenj, k, alpha, a[i:], lda, b[j:], ldb, beta, cSub, ldc)
+		Implementation{}.Dgemm(blas.Trans, blas.NoTrans, leni, lenj, k, alpha, b[i:], ldb, a[j:], lda, 1, cSub, ldc)
+	})
+	return true
+}
+
+// dtrmmParallel computes Dtrmm concurrently over independent panels of B.
+// The columns of B are independent when A is on the left, and the rows of B
+// are independent when A is on the right.
+func dtrmmParallel(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) bool {
+	if s == blas.Left {
+		nb := blocks(n, blockSize)
+		if !useParallel(nb) {
+			return false
+		}
+		parallelFor(nb, func(t int) {
+			j := t * blockSize
+			Implementation{}.Dtrmm(s, ul, tA, d, m, min(blockSize, n-j), alpha, a, lda, b[j:], ldb)
+		})
+		return true
+	}
+	mb := blocks(m, blockSize)
+	if !useParallel(mb) {
+		return false
+	}
+	parallelFor(mb, func(t int) {
+		i := t * blockSize
+		Implementation{}.Dtrmm(s, ul, tA, d, min(blockSize, m-i), n, alpha, a, lda, b[i*ldb:], ldb)
+	})
+	return true
+}
+
+// dtrsmParallel computes Dtrsm concurrently over independent panels of B.
+// The columns of B are independent when A is on the left, and the rows of B
+// are independent when A is on the right.
+func dtrsmParallel(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) bool {
+	if s == blas.Left {
+		nb := blocks(n, blockSize)
+		if !useParallel(nb) {
+			return false
+		}
+		parallelFor(nb, func(t int) {
+			j := t * blockSize
+			Implementation{}.Dtrsm(s, ul, tA, d, m, min(blockSize, n-j), alpha, a, lda, b[j:], ldb)
+		})
+		return true
+	}
+	mb := blocks(m, blockSize)
+	if !useParallel(mb) {
+		return false
+	}
+	parallelFor(mb, func(t int) {
+		i := t * blockSize
+		Implementation{}.Dtrsm(s, ul, tA, d, min(blockSize, m-i), n, alpha, a, lda, b[i*ldb:], ldb)
+	})
+	return true
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// workers holds the maximum number of goroutines used concurrently by the
+// Level 3 routines. A value of zero indicates that runtime.GOMAXPROCS(0)
+// should be used. It must be accessed atomically.
+var workers int64
+
+// SetWorkers sets the maximum number of goroutines that the Level 3 routines
+// of Implementation use concurrently and returns the previous value. If n is
+// zero, the number of goroutines is set by runtime.GOMAXPROCS(0) at the time
+// of each call, which is the default. If n is one, all routines are computed
+// serially. SetWorkers will panic if n is negative.
+func SetWorkers(n int) (prev int) {
+	if n < 0 {
+		panic("blas: negative worker count")
+	}
+	return int(atomic.SwapInt64(&workers, int64(n)))
+}
+
+// Workers returns the maximum number of goroutines that the Level 3 routines
+// of Implementation use concurrently. This is the value set by SetWorkers, or
+// runtime.GOMAXPROCS(0) if it is zero.
+func Workers() int {
+	return numWorkers()
+}
+
+// numWorkers returns the maximum number of goroutines to use concurrently.
+func numWorkers() int {
+	n := int(atomic.LoadInt64(&workers))
+	if n == 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return n
+}
+
+// useParallel returns whether a computation split into the given number of
+// independent tasks should be performed concurrently.
+func useParallel(tasks int) bool {
+	return tasks >= minParBlock && numWorkers() > 1
+}
+
+// parallelFor calls fn(t) for each task t in [0, n) using at most
+// numWorkers() goroutines. Tasks are handed to goroutines as they
+// become free, so tasks of unequal cost are balanced between them.
+// parallelFor returns when all calls to fn have returned.
+func parallelFor(n int, fn func(t int)) {
+	w := min(numWorkers(), n)
+	next := int64(-1)
+	var wg sync.WaitGroup
+	wg.Add(w)
+	for i := 0; i < w; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				t := int(atomic.AddInt64(&next, 1))
+				if t >= n {
+					return
+				}
+				fn(t)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// triBlocks returns the row and column offsets of the blockSize×blockSize
+// blocks covering the ul triangle of an n×n matrix. Blocks on the diagonal
+// have equal row and column offsets.
+func triBlocks(ul blas.Uplo, n int) [][2]int {
+	nb := blocks(n, blockSize)
+	off := make([][2]int, 0, nb*(nb+1)/2)
+	for i := 0; i < n; i += blockSize {
+		for j := 0; j <= i; j += blockSize {
+			if ul == blas.Upper {
+				off = append(off, [2]int{j, i})
+			} else {
+				off = append(off, [2]int{i, j})
+			}
+		}
+	}
+	return off
+}
@@ -0,0 +1,324 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/cmplxs"
+	"gonum.org/v1/gonum/floats"
+)
+
+// The sizes below are chosen so that some cases are split into enough blocks
+// to be computed concurrently, and others are not. The leading dimensions
+// are larger than the number of columns.
+var (
+	parSizes = []struct{ m, n int }{
+		{5, 5},
+		{2*blockSize + 1, 5},
+		{5, 3*blockSize + 5},
+		{3*blockSize + 5, 2*blockSize + 1},
+		{blockSize + 6, 4*blockSize + 3},
+	}
+	parKs = []int{3, blockSize + 7}
+)
+
+// parallelMatchesSerial64 calls fn on two copies of out, first with all
+// routines computed serially and then concurrently, and returns whether the
+// results are equal within tol.
+func parallelMatchesSerial64(out []float64, tol float64, fn func(out []float64)) bool {
+	want := make([]float64, len(out))
+	copy(want, out)
+	got := make([]float64, len(out))
+	copy(got, out)
+	prev := SetWorkers(1)
+	fn(want)
+	SetWorkers(4)
+	fn(got)
+	SetWorkers(prev)
+	return floats.EqualApprox(got, want, tol)
+}
+
+// parallelMatchesSerial128 is the complex counterpart of parallelMatchesSerial64.
+func parallelMatchesSerial128(out []complex128, tol float64, fn func(out []complex128)) bool {
+	want := make([]complex128, len(out))
+	copy(want, out)
+	got := make([]complex128, len(out))
+	copy(got, out)
+	prev := SetWorkers(1)
+	fn(want)
+	SetWorkers(4)
+	fn(got)
+	SetWorkers(prev)
+	return cmplxs.EqualApprox(got, want, tol)
+}
+
+func randcmat(r, c, stride int, rnd *rand.Rand) []complex128 {
+	data := make([]complex128, r*stride+c)
+	for i := range data {
+		data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return data
+}
+
+// addDiag adds v to the diagonal of the n×n matrix a so that triangular
+// solves with a are well conditioned.
+func addDiag(a []float64, lda, n int, v float64) {
+	for i := 0; i < n; i++ {
+		a[i*lda+i] += v
+	}
+}
+
+func addCDiag(a []complex128, lda, n int, v float64) {
+	for i := 0; i < n; i++ {
+		a[i*lda+i] += complex(v, 0)
+	}
+}
+
+func TestLevel3Parallel(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	sides := []blas.Side{blas.Left, blas.Right}
+	uplos := []blas.Uplo{blas.Upper, blas.Lower}
+	diags := []blas.Diag{blas.NonUnit, blas.Unit}
+	trans := []blas.Transpose{blas.NoTrans, blas.Trans}
+	for _, size := range parSizes {
+		m, n := size.m, size.n
+		for _, s := range sides {
+			na := n
+			if s == blas.Left {
+				na = m
+			}
+			lda := na + 3
+			a := randmat(na, na, lda, rnd)
+			addDiag(a, lda, na, float64(na))
+			ldb := n + 2
+			b := randmat(m, n, ldb, rnd)
+			ldc := n + 1
+			c := randmat(m, n, ldc, rnd)
+			for _, ul := range uplos {
+				name := fmt.Sprintf("m=%d,n=%d,side=%c,uplo=%c", m, n, s, ul)
+				if !parallelMatchesSerial64(c, tol, func(c []float64) {
+					impl.Dsymm(s, ul, m, n, 1.5, a, lda, b, ldb, 0.5, c, ldc)
+				}) {
+					t.Errorf("%s: Dsymm mismatch", name)
+				}
+				for _, tA := range trans {
+					for _, d := range diags {
+						name := fmt.Sprintf("%s,trans=%c,diag=%c", name, tA, d)
+						if !parallelMatchesSerial64(b, tol, func(b []float64) {
+							impl.Dtrmm(s, ul, tA, d, m, n, 1.5, a, lda, b, ldb)
+						}) {
+							t.Errorf("%s: Dtrmm mismatch", name)
+						}
+						if !parallelMatchesSerial64(b, tol, func(b []float64) {
+							impl.Dtrsm(s, ul, tA, d, m, n, 1.5, a, lda, b, ldb)
+						}) {
+							t.Errorf("%s: Dtrsm mismatch", name)
+						}
+					}
+				}
+			}
+		}
+
+		for _, k := range parKs {
+			for _, tA := range trans {
+				row, col := n, k
+				if tA == blas.Trans {
+					row, col = k, n
+				}
+				lda := col + 3
+				a := randmat(row, col, lda, rnd)
+				ldb := col + 2
+				b := randmat(row, col, ldb, rnd)
+				ldc := n + 1
+				c := randmat(n, n, ldc, rnd)
+				for _, ul := range uplos {
+					name := fmt.Sprintf("n=%d,k=%d,uplo=%c,trans=%c", n, k, ul, tA)
+					if !parallelMatchesSerial64(c, tol, func(c []float64) {
+						impl.Dsyrk(ul, tA, n, k, 1.5, a, lda, 0.5, c, ldc)
+					}) {
+						t.Errorf("%s: Dsyrk mismatch", name)
+					}
+					if !parallelMatchesSerial64(c, tol, func(c []float64) {
+						impl.Dsyr2k(ul, tA, n, k, 1.5, a, lda, b, ldb, 0.5, c, ldc)
+					}) {
+						t.Errorf("%s: Dsyr2k mismatch", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLevel3ParallelComplex(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	sides := []blas.Side{blas.Left, blas.Right}
+	uplos := []blas.Uplo{blas.Upper, blas.Lower}
+	diags := []blas.Diag{blas.NonUnit, blas.Unit}
+	trans := []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans}
+	alpha := complex(1.5, -0.5)
+	beta := complex(0.5, 0.25)
+	for _, size := range parSizes {
+		m, n := size.m, size.n
+		for _, k := range parKs {
+			for _, tA := range trans {
+				for _, tB := range trans {
+					rowA, colA := m, k
+					if tA != blas.NoTrans {
+						rowA, colA = k, m
+					}
+					rowB, colB := k, n
+					if tB != blas.NoTrans {
+						rowB, colB = n, k
+					}
+					lda := colA + 3
+					a := randcmat(rowA, colA, lda, rnd)
+					ldb := colB + 2
+					b := randcmat(rowB, colB, ldb, rnd)
+					ldc := n + 1
+					c := randcmat(m, n, ldc, rnd)
+					if !parallelMatchesSerial128(c, tol, func(c []complex128) {
+						impl.Zgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+					}) {
+						t.Errorf("m=%d,n=%d,k=%d,tA=%c,tB=%c: Zgemm mismatch", m, n, k, tA, tB)
+					}
+				}
+			}
+		}
+
+		for _, s := range sides {
+			na := n
+			if s == blas.Left {
+				na = m
+			}
+			lda := na + 3
+			a := randcmat(na, na, lda, rnd)
+			addCDiag(a, lda, na, float64(na))
+			ldb := n + 2
+			b := randcmat(m, n, ldb, rnd)
+			ldc := n + 1
+			c := randcmat(m, n, ldc, rnd)
+			for _, ul := range uplos {
+				name := fmt.Sprintf("m=%d,n=%d,side=%c,uplo=%c", m, n, s, ul)
+				if !parallelMatchesSerial128(c, tol, func(c []complex128) {
+					impl.Zsymm(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+				}) {
+					t.Errorf("%s: Zsymm mismatch", name)
+				}
+				if !parallelMatchesSerial128(c, tol, func(c []complex128) {
+					impl.Zhemm(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+				}) {
+					t.Errorf("%s: Zhemm mismatch", name)
+				}
+				for _, tA := range trans {
+					for _, d := range diags {
+						name := fmt.Sprintf("%s,trans=%c,diag=%c", name, tA, d)
+						if !parallelMatchesSerial128(b, tol, func(b []complex128) {
+							impl.Ztrmm(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+						}) {
+							t.Errorf("%s: Ztrmm mismatch", name)
+						}
+						if !parallelMatchesSerial128(b, tol, func(b []complex128) {
+							impl.Ztrsm(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+						}) {
+							t.Errorf("%s: Ztrsm mismatch", name)
+						}
+					}
+				}
+			}
+		}
+
+		for _, k := range parKs {
+			for _, tA := range trans {
+				row, col := n, k
+				if tA != blas.NoTrans {
+					row, col = k, n
+				}
+				lda := col + 3
+				a := randcmat(row, col, lda, rnd)
+				ldb := col + 2
+				b := randcmat(row, col, ldb, rnd)
+				ldc := n + 1
+				c := randcmat(n, n, ldc, rnd)
+				for _, ul := range uplos {
+					name := fmt.Sprintf("n=%d,k=%d,uplo=%c,trans=%c", n, k, ul, tA)
+					if tA != blas.ConjTrans {
+						if !parallelMatchesSerial128(c, tol, func(c []complex128) {
+							impl.Zsyrk(ul, tA, n, k, alpha, a, lda, beta, c, ldc)
+						}) {
+							t.Errorf("%s: Zsyrk mismatch", name)
+						}
+						if !parallelMatchesSerial128(c, tol, func(c []complex128) {
+							impl.Zsyr2k(ul, tA, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+						}) {
+							t.Errorf("%s: Zsyr2k mismatch", name)
+						}
+					}
+					if tA != blas.Trans {
+						if !parallelMatchesSerial128(c, tol, func(c []complex128) {
+							impl.Zherk(ul, tA, n, k, 1.5, a, lda, 0.5, c, ldc)
+						}) {
+							t.Errorf("%s: Zherk mismatch", name)
+						}
+						if !parallelMatchesSerial128(c, tol, func(c []complex128) {
+							impl.Zher2k(ul, tA, n, k, alpha, a, lda, b, ldb, 0.5, c, ldc)
+						}) {
+							t.Errorf("%s: Zher2k mismatch", name)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSerial(t *testing.T) {
+	const m, n, k = 3*blockSize + 5, 2*blockSize + 1, blockSize + 7
+	rnd := rand.New(rand.NewSource(1))
+	a := randmat(m, k, k, rnd)
+	b := randmat(k, n, n, rnd)
+	c := randmat(m, n, n, rnd)
+	tri := randmat(m, m, m, rnd)
+	addDiag(tri, m, m, float64(m))
+
+	// The Level 3 routines of Serial must give the same results as
+	// Implementation with a single worker.
+	prev := SetWorkers(4)
+	defer SetWorkers(prev)
+	got := make([]float64, len(c))
+	copy(got, c)
+	Serial().Dgemm(blas.NoTrans, blas.NoTrans, m, n, k, 1.5, a, k, b, n, 0.5, got, n)
+	Serial().Dtrmm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, m, n, 2, tri, m, got, n)
+	SetWorkers(1)
+	want := make([]float64, len(c))
+	copy(want, c)
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, m, n, k, 1.5, a, k, b, n, 0.5, want, n)
+	impl.Dtrmm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, m, n, 2, tri, m, want, n)
+	if !floats.Equal(got, want) {
+		t.Errorf("serial result differs from single worker result")
+	}
+}
+
+func TestSetWorkers(t *testing.T) {
+	prev := SetWorkers(3)
+	if got := Workers(); got != 3 {
+		t.Errorf("unexpected worker count: got %d, want 3", got)
+	}
+	if got := SetWorkers(prev); got != 3 {
+		t.Errorf("unexpected previous worker count: got %d, want 3", got)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic for negative worker count")
+		}
+	}()
+	SetWorkers(-1)
+}
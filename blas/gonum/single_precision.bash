@@ -29,9 +29,9 @@ cat level1float64.go \
 | gofmt -r 'f64.ScalInc -> f32.ScalInc' \
 | gofmt -r 'f64.ScalUnitary -> f32.ScalUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
-      -e "s_^\(func (Implementation) \)Id\(.*\)\$_$WARNINGF32\1Is\2_" \
+      -e "s_^\(func ([a-z ]*Implementation) \)Id\(.*\)\$_$WARNINGF32\1Is\2_" \
       -e 's_^// Id_// Is_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
       -e 's_"math"_math "gonum.org/v1/gonum/internal/math32"_' \
@@ -56,15 +56,15 @@ cat level1cmplx128.go \
 | gofmt -r 'c128.ScalUnitary -> c64.ScalUnitary' \
 | gofmt -r 'dcabs1 -> scabs1' \
 \
-| sed -e "s_^\(func (Implementation) \)Zdot\(.*\)\$_$WARNINGC64\1Cdot\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)Zdot\(.*\)\$_$WARNINGC64\1Cdot\2_" \
       -e 's_^// Zdot_// Cdot_' \
-      -e "s_^\(func (Implementation) \)Zdscal\(.*\)\$_$WARNINGC64\1Csscal\2_" \
+      -e "s_^\(func ([a-z ]*Implementation) \)Zdscal\(.*\)\$_$WARNINGC64\1Csscal\2_" \
       -e 's_^// Zdscal_// Csscal_' \
-      -e "s_^\(func (Implementation) \)Z\(.*\)\$_$WARNINGC64\1C\2_" \
+      -e "s_^\(func ([a-z ]*Implementation) \)Z\(.*\)\$_$WARNINGC64\1C\2_" \
       -e 's_^// Z_// C_' \
-      -e "s_^\(func (Implementation) \)Iz\(.*\)\$_$WARNINGC64\1Ic\2_" \
+      -e "s_^\(func ([a-z ]*Implementation) \)Iz\(.*\)\$_$WARNINGC64\1Ic\2_" \
       -e 's_^// Iz_// Ic_' \
-      -e "s_^\(func (Implementation) \)Dz\(.*\)\$_$WARNINGC64\1Sc\2_" \
+      -e "s_^\(func ([a-z ]*Implementation) \)Dz\(.*\)\$_$WARNINGC64\1Sc\2_" \
       -e 's_^// Dz_// Sc_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/c128"_"gonum.org/v1/gonum/internal/asm/c64"_' \
       -e 's_"math"_math "gonum.org/v1/gonum/internal/math32"_' \
@@ -78,7 +78,7 @@ cat level1float64_ddot.go \
 | gofmt -r 'f64.DotInc -> f32.DotInc' \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level1float32_sdot.go
@@ -91,7 +91,7 @@ cat level1float64_ddot.go \
 | gofmt -r 'f64.DotInc -> f32.DdotInc' \
 | gofmt -r 'f64.DotUnitary -> f32.DdotUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1Ds\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNINGF32\1Ds\2_" \
       -e 's_^// D_// Ds_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level1float32_dsdot.go
@@ -104,7 +104,7 @@ cat level1float64_ddot.go \
 | gofmt -r 'f64.DotInc(x, y, f(n), f(incX), f(incY), f(ix), f(iy)) -> alpha + float32(f32.DdotInc(x, y, f(n), f(incX), f(incY), f(ix), f(iy)))' \
 | gofmt -r 'f64.DotUnitary(a, b) -> alpha + float32(f32.DdotUnitary(a, b))' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1Sds\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNINGF32\1Sds\2_" \
       -e 's_^// D\(.*\)$_// Sds\1 plus a constant_' \
       -e 's_\\sum_alpha + \\sum_' \
       -e 's/n int/n int, alpha float32/' \
@@ -134,7 +134,7 @@ cat level2float64.go \
 | gofmt -r 'f64.GemvT -> f32.GemvT' \
 | gofmt -r 'Implementation{}.Dscal -> Implementation{}.Sscal' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level2float32.go
@@ -154,7 +154,7 @@ cat level2cmplx128.go \
 | gofmt -r 'c128.ScalInc -> c64.ScalInc' \
 | gofmt -r 'c128.ScalUnitary -> c64.ScalUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)Z\(.*\)\$_$WARNINGC64\1C\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)Z\(.*\)\$_$WARNINGC64\1C\2_" \
       -e 's_^// Z_// C_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/c128"_"gonum.org/v1/gonum/internal/asm/c64"_' \
       -e 's_"math/cmplx"_cmplx "gonum.org/v1/gonum/internal/cmplx64"_' \
@@ -174,11 +174,40 @@ cat level3float64.go \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
 | gofmt -r 'f64.ScalUnitary -> f32.ScalUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
+| gofmt -r 'dsymmParallel -> ssymmParallel' \
+| gofmt -r 'dsyrkParallel -> ssyrkParallel' \
+| gofmt -r 'dsyr2kParallel -> ssyr2kParallel' \
+| gofmt -r 'dtrmmParallel -> strmmParallel' \
+| gofmt -r 'dtrsmParallel -> strsmParallel' \
+\
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level3float32.go
 
+echo Generating level3float32_parallel.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level3float32_parallel.go
+cat level3float64_parallel.go \
+| gofmt -r 'float64 -> float32' \
+\
+| gofmt -r 'dsymmParallel -> ssymmParallel' \
+| gofmt -r 'dsyrkParallel -> ssyrkParallel' \
+| gofmt -r 'dsyr2kParallel -> ssyr2kParallel' \
+| gofmt -r 'dtrmmParallel -> strmmParallel' \
+| gofmt -r 'dtrsmParallel -> strsmParallel' \
+| gofmt -r 'dsymBlock -> ssymBlock' \
+\
+| gofmt -r 'Implementation{}.Dgemm -> Implementation{}.Sgemm' \
+| gofmt -r 'Implementation{}.Dsymm -> Implementation{}.Ssymm' \
+| gofmt -r 'Implementation{}.Dsyrk -> Implementation{}.Ssyrk' \
+| gofmt -r 'Implementation{}.Dsyr2k -> Implementation{}.Ssyr2k' \
+| gofmt -r 'Implementation{}.Dtrmm -> Implementation{}.Strmm' \
+| gofmt -r 'Implementation{}.Dtrsm -> Implementation{}.Strsm' \
+\
+| sed -e 's_^// d\([a-z0-9]*Parallel\) computes D_// s\1 computes S_' \
+      -e 's_^// dsymBlock_// ssymBlock_' \
+>> level3float32_parallel.go
+
 echo Generating sgemm.go
 echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > sgemm.go
 cat dgemm.go \
@@ -197,7 +226,7 @@ cat dgemm.go \
 | gofmt -r 'f64.AxpyUnitary -> f32.AxpyUnitary' \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_^// d_// s_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
@@ -217,8 +246,49 @@ cat level3cmplx128.go \
 | gofmt -r 'c128.AxpyUnitary -> c64.AxpyUnitary' \
 | gofmt -r 'c128.DotuUnitary -> c64.DotuUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)Z\(.*\)\$_$WARNINGC64\1C\2_" \
+| gofmt -r 'zgemmParallel -> cgemmParallel' \
+| gofmt -r 'zsymmParallel -> csymmParallel' \
+| gofmt -r 'zherkParallel -> cherkParallel' \
+| gofmt -r 'zher2kParallel -> cher2kParallel' \
+| gofmt -r 'zsyrkParallel -> csyrkParallel' \
+| gofmt -r 'zsyr2kParallel -> csyr2kParallel' \
+| gofmt -r 'ztrmmParallel -> ctrmmParallel' \
+| gofmt -r 'ztrsmParallel -> ctrsmParallel' \
+\
+| sed -e "s_^\(func ([a-z ]*Implementation) \)Z\(.*\)\$_$WARNINGC64\1C\2_" \
       -e 's_^// Z_// C_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/c128"_"gonum.org/v1/gonum/internal/asm/c64"_' \
       -e 's_"math/cmplx"_cmplx "gonum.org/v1/gonum/internal/cmplx64"_' \
 >> level3cmplx64.go
+
+echo Generating level3cmplx64_parallel.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level3cmplx64_parallel.go
+cat level3cmplx128_parallel.go \
+| gofmt -r 'float64 -> float32' \
+| gofmt -r 'complex128 -> complex64' \
+\
+| gofmt -r 'zgemmParallel -> cgemmParallel' \
+| gofmt -r 'zsymmParallel -> csymmParallel' \
+| gofmt -r 'zherkParallel -> cherkParallel' \
+| gofmt -r 'zher2kParallel -> cher2kParallel' \
+| gofmt -r 'zsyrkParallel -> csyrkParallel' \
+| gofmt -r 'zsyr2kParallel -> csyr2kParallel' \
+| gofmt -r 'ztrmmParallel -> ctrmmParallel' \
+| gofmt -r 'ztrsmParallel -> ctrsmParallel' \
+| gofmt -r 'zsymBlock -> csymBlock' \
+\
+| gofmt -r 'Implementation{}.Zgemm -> Implementation{}.Cgemm' \
+| gofmt -r 'Implementation{}.Zsymm -> Implementation{}.Csymm' \
+| gofmt -r 'Implementation{}.Zhemm -> Implementation{}.Chemm' \
+| gofmt -r 'Implementation{}.Zherk -> Implementation{}.Cherk' \
+| gofmt -r 'Implementation{}.Zher2k -> Implementation{}.Cher2k' \
+| gofmt -r 'Implementation{}.Zsyrk -> Implementation{}.Csyrk' \
+| gofmt -r 'Implementation{}.Zsyr2k -> Implementation{}.Csyr2k' \
+| gofmt -r 'Implementation{}.Ztrmm -> Implementation{}.Ctrmm' \
+| gofmt -r 'Implementation{}.Ztrsm -> Implementation{}.Ctrsm' \
+\
+| sed -e 's_^// z\([a-z0-9]*Parallel\) computes Z\([a-z0-9]*\), or Z_// c\1 computes C\2, or C_' \
+      -e 's_^// z\([a-z0-9]*Parallel\) computes Z_// c\1 computes C_' \
+      -e 's_^// zsymBlock_// csymBlock_' \
+      -e 's_"math/cmplx"_cmplx "gonum.org/v1/gonum/internal/cmplx64"_' \
+>> level3cmplx64_parallel.go
@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testblas
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func randFloat64s(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rand.Float64()
+	}
+	return s
+}
+
+func randComplex128s(n int) []complex128 {
+	s := make([]complex128, n)
+	for i := range s {
+		s[i] = complex(rand.Float64(), rand.Float64())
+	}
+	return s
+}
+
+// sideDim returns the side of the square matrix A in a Level 3 operation
+// with an m×n matrix B.
+func sideDim(s blas.Side, m, n int) int {
+	if s == blas.Left {
+		return m
+	}
+	return n
+}
+
+func DsymmBenchmark(b *testing.B, impl Dsymmer, s blas.Side, ul blas.Uplo, m, n int) {
+	na := sideDim(s, m, n)
+	a := randFloat64s(na * na)
+	bv := randFloat64s(m * n)
+	c := randFloat64s(m * n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dsymm(s, ul, m, n, 3.0, a, na, bv, n, 1.0, c, n)
+	}
+}
+
+func DsyrkBenchmark(b *testing.B, impl Dsyker, ul blas.Uplo, tA blas.Transpose, n, k int) {
+	a := randFloat64s(n * k)
+	lda := k
+	if tA == blas.Trans {
+		lda = n
+	}
+	c := randFloat64s(n * n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dsyrk(ul, tA, n, k, 3.0, a, lda, 1.0, c, n)
+	}
+}
+
+func Dsyr2kBenchmark(b *testing.B, impl Dsyr2ker, ul blas.Uplo, tA blas.Transpose, n, k int) {
+	a := randFloat64s(n * k)
+	bv := randFloat64s(n * k)
+	lda := k
+	if tA == blas.Trans {
+		lda = n
+	}
+	c := randFloat64s(n * n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dsyr2k(ul, tA, n, k, 3.0, a, lda, bv, lda, 1.0, c, n)
+	}
+}
+
+// DtrmmBenchmark benchmarks Dtrmm. B is reset before each call so that its
+// elements do not overflow.
+func DtrmmBenchmark(b *testing.B, impl Dtrmmer, s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int) {
+	na := sideDim(s, m, n)
+	a := randFloat64s(na * na)
+	b0 := randFloat64s(m * n)
+	bv := make([]float64, len(b0))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(bv, b0)
+		impl.Dtrmm(s, ul, tA, d, m, n, 3.0, a, na, bv, n)
+	}
+}
+
+// DtrsmBenchmark benchmarks Dtrsm. A is diagonally dominant and B is reset
+// before each call so that the elements of the solution remain normal.
+func DtrsmBenchmark(b *testing.B, impl Dtrsmer, s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int) {
+	na := sideDim(s, m, n)
+	a := randFloat64s(na * na)
+	for i := 0; i < na; i++ {
+		a[i*na+i] += float64(na)
+	}
+	b0 := randFloat64s(m * n)
+	bv := make([]float64, len(b0))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(bv, b0)
+		impl.Dtrsm(s, ul, tA, d, m, n, 3.0, a, na, bv, n)
+	}
+}
+
+func ZgemmBenchmark(b *testing.B, impl Zgemmer, tA, tB blas.Transpose, m, n, k int) {
+	a := randComplex128s(m * k)
+	bv := randComplex128s(k * n)
+	c := randComplex128s(m * n)
+	lda := k
+	if tA != blas.NoTrans {
+		lda = m
+	}
+	ldb := n
+	if tB != blas.NoTrans {
+		ldb = k
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Zgemm(tA, tB, m, n, k, 3, a, lda, bv, ldb, 1, c, n)
+	}
+}
+
+func ZhemmBenchmark(b *testing.B, impl Zhemmer, s blas.Side, ul blas.Uplo, m, n int) {
+	na := sideDim(s, m, n)
+	a := randComplex128s(na * na)
+	bv := randComplex128s(m * n)
+	c := randComplex128s(m * n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Zhemm(s, ul, m, n, 3, a, na, bv, n, 1, c, n)
+	}
+}
+
+func ZherkBenchmark(b *testing.B, impl Zherker, ul blas.Uplo, tA blas.Transpose, n, k int) {
+	a := randComplex128s(n * k)
+	lda := k
+	if tA != blas.NoTrans {
+		lda = n
+	}
+	c := randComplex128s(n * n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Zherk(ul, tA, n, k, 3, a, lda, 1, c, n)
+	}
+}
+
+// ZtrsmBenchmark benchmarks Ztrsm. A is diagonally dominant and B is reset
+// before each call so that the elements of the solution remain normal.
+func ZtrsmBenchmark(b *testing.B, impl Ztrsmer, s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int) {
+	na := sideDim(s, m, n)
+	a := randComplex128s(na * na)
+	for i := 0; i < na; i++ {
+		a[i*na+i] += complex(float64(na), 0)
+	}
+	b0 := randComplex128s(m * n)
+	bv := make([]complex128, len(b0))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(bv, b0)
+		impl.Ztrsm(s, ul, tA, d, m, n, 3, a, na, bv, n)
+	}
+}
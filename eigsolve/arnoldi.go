@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"errors"
+	"math/cmplx"
+	"sort"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Arnoldi computes k eigenvalues and the corresponding eigenvectors of the
+// n×n general matrix A using the implicitly restarted Arnoldi method. The
+// matrix A is represented by the operator a. Which eigenvalues are computed is
+// specified by which. Complex eigenvalues of the real matrix A occur in
+// complex conjugate pairs which are returned next to each other with the
+// member with positive imaginary part first, unless the pair is split by the
+// k-th eigenvalue.
+//
+// settings provide a way to specify the parameters of the method. If settings
+// is nil, default values are used, see the Settings documentation for more
+// information. Arnoldi panics if k is not in the range [1, n] or if settings
+// is not valid.
+//
+// Arnoldi returns the computed eigenpairs with estimates of their residual
+// norms. If the limit on the number of restarts is reached before all the
+// eigenpairs have converged, Arnoldi returns the current approximations and
+// ErrIterationLimit.
+func Arnoldi(a mat.MulVecToer, n, k int, which Which, settings *Settings) (*Result, error) {
+	start := time.Now()
+
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	checkSettings(n, k, 2, which, &s)
+
+	var stats Stats
+	m := s.SubspaceDim
+	ar := newArnoldi(a, false, n, m, s.InitVec, &stats)
+	ar.extend(0)
+
+	var (
+		eig   mat.Eigen
+		theta []complex128
+		y     mat.CDense
+		order = make([]int, m)
+		resid = make([]float64, m)
+		q     = mat.NewDense(m, m, nil)
+		err   error
+	)
+	for {
+		// Compute the Ritz values and the residual estimates.
+		ok := eig.Factorize(ar.h, mat.EigenRight)
+		if !ok {
+			return nil, errors.New("eigsolve: eigendecomposition of Hessenberg matrix failed")
+		}
+		theta = eig.Values(theta)
+		eig.VectorsTo(&y)
+		for i := range order {
+			order[i] = i
+			resid[i] = ar.beta * cmplx.Abs(y.At(m-1, i))
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return which.before(theta[order[i]], theta[order[j]])
+		})
+
+		var nconv int
+		for _, i := range order[:k] {
+			if converged(theta[i], resid[i], s.Tolerance) {
+				nconv++
+			}
+		}
+		if nconv >= k || m == n {
+			break
+		}
+		if stats.Restarts == s.MaxIterations {
+			err = ErrIterationLimit
+			break
+		}
+
+		// Choose the number of Ritz values to keep so that no complex
+		// conjugate pair is split.
+		kk := restartSize(k, nconv, m)
+		if imag(theta[order[kk-1]]) > 0 {
+			if kk+1 < m {
+				kk++
+			} else {
+				kk--
+			}
+		}
+
+		// Apply the unwanted Ritz values as shifts and restart. A complex
+		// conjugate pair of shifts is applied as a real double shift.
+		q.Zero()
+		for i := 0; i < m; i++ {
+			q.Set(i, i, 1)
+		}
+		for _, i := range order[kk:] {
+			mu := theta[i]
+			switch {
+			case imag(mu) == 0:
+				ar.shift(q, real(mu))
+			case imag(mu) > 0:
+				ar.doubleShift(q, real(mu), real(mu)*real(mu)+imag(mu)*imag(mu))
+			}
+		}
+		ar.restart(q, kk)
+		stats.Restarts++
+		ar.extend(kk)
+	}
+
+	// Compute the Ritz vectors from their real and imaginary parts.
+	res := &Result{
+		Values:    make([]complex128, k),
+		Vectors:   mat.NewCDense(n, k, nil),
+		Residuals: make([]float64, k),
+	}
+	yr := mat.NewDense(m, k, nil)
+	yi := mat.NewDense(m, k, nil)
+	for c, i := range order[:k] {
+		res.Values[c] = theta[i]
+		res.Residuals[c] = resid[i]
+		for r := 0; r < m; r++ {
+			v := y.At(r, i)
+			yr.Set(r, c, real(v))
+			yi.Set(r, c, imag(v))
+		}
+	}
+	var xr, xi mat.Dense
+	xr.Mul(ar.v, yr)
+	xi.Mul(ar.v, yi)
+	for i := 0; i < n; i++ {
+		for j := 0; j < k; j++ {
+			res.Vectors.Set(i, j, complex(xr.At(i, j), xi.At(i, j)))
+		}
+	}
+
+	stats.Runtime = time.Since(start)
+	res.Stats = stats
+	return res, err
+}
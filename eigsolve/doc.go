@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package eigsolve provides iterative methods for computing a few eigenvalues
+// and eigenvectors of large matrices.
+//
+// Like the methods in package linsolve, the methods in this package only
+// require the ability to compute the product of the matrix with a vector, so
+// they can be used for matrices that are too large to be factorized, such as
+// large sparse matrices, or that are only available implicitly as a linear
+// operator.
+//
+// Background
+//
+// The eigenvalue problem for an n×n matrix A is to find scalars λ and non-zero
+// vectors x such that
+//  A * x = λ * x.
+// Dense methods such as those used by mat.EigenSym and mat.Eigen compute all n
+// eigenpairs at a cost of O(n^3) arithmetic operations and O(n^2) storage.
+// When only k ≪ n eigenpairs are needed, for example the eigenvalues of
+// largest magnitude or the smallest eigenvalues of a graph Laplacian, Krylov
+// subspace methods are usually much cheaper.
+//
+// Given a starting vector v, the Arnoldi process builds an orthonormal basis
+// V_m of the Krylov subspace
+//  span{v, A v, A^2 v, ..., A^{m-1} v}
+// together with the m×m upper Hessenberg matrix H_m = V_mᵀ A V_m. The
+// eigenvalues of H_m, called Ritz values, approximate eigenvalues of A, with
+// the extreme eigenvalues usually approximated first. If A is symmetric, H_m
+// is tridiagonal and the process is known as the Lanczos process.
+//
+// The storage and cost of the process grow with m, so the methods in this
+// package keep m fixed and restart the process implicitly. At each restart
+// the unwanted Ritz values are used as shifts in m-k steps of the shifted QR
+// algorithm applied to H_m, which filters the corresponding components out of
+// the starting vector while retaining the information about the k wanted
+// eigenpairs. The restarting is repeated until the wanted Ritz pairs converge.
+//
+// References
+//
+// Sorensen, D. C. (1992). Implicit application of polynomial filters in a
+// k-step Arnoldi method. SIAM Journal on Matrix Analysis and Applications,
+// 13(1), 357-385.
+//
+// Lehoucq, R. B., Sorensen, D. C. and Yang, C. (1998). ARPACK Users' Guide:
+// Solution of Large-Scale Eigenvalue Problems with Implicitly Restarted
+// Arnoldi Methods. Philadelphia, PA: SIAM.
+package eigsolve // import "gonum.org/v1/gonum/eigsolve"
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultMaxIterations = 300
+
+	// eps is the machine epsilon.
+	eps = 1.0 / (1 << 53)
+)
+
+// ErrIterationLimit is returned when the maximum number of restarts is reached
+// before all the requested eigenpairs have converged.
+var ErrIterationLimit = errors.New("eigsolve: iteration limit reached")
+
+// Which specifies which eigenvalues are computed.
+type Which int
+
+const (
+	// LargestMagnitude specifies the eigenvalues of largest magnitude.
+	LargestMagnitude Which = iota
+	// SmallestMagnitude specifies the eigenvalues of smallest magnitude.
+	// If these lie in the interior of the spectrum, as for indefinite
+	// matrices, the convergence may be very slow.
+	SmallestMagnitude
+	// LargestReal specifies the eigenvalues with largest real part.
+	LargestReal
+	// SmallestReal specifies the eigenvalues with smallest real part.
+	SmallestReal
+)
+
+// before returns whether the eigenvalue a is ordered before the eigenvalue b
+// according to w. Ties are broken so that complex conjugate pairs are adjacent
+// with the member with positive imaginary part first.
+func (w Which) before(a, b complex128) bool {
+	var ka, kb float64
+	switch w {
+	case LargestMagnitude:
+		ka, kb = -cmplx.Abs(a), -cmplx.Abs(b)
+	case SmallestMagnitude:
+		ka, kb = cmplx.Abs(a), cmplx.Abs(b)
+	case LargestReal:
+		ka, kb = -real(a), -real(b)
+	case SmallestReal:
+		ka, kb = real(a), real(b)
+	default:
+		panic("eigsolve: invalid Which")
+	}
+	if ka != kb {
+		return ka < kb
+	}
+	if real(a) != real(b) {
+		return real(a) > real(b)
+	}
+	if math.Abs(imag(a)) != math.Abs(imag(b)) {
+		return math.Abs(imag(a)) > math.Abs(imag(b))
+	}
+	return imag(a) > imag(b)
+}
+
+// Settings holds various settings for computing eigenpairs.
+type Settings struct {
+	// SubspaceDim is the dimension m of the Krylov subspace built between
+	// restarts. It must not be larger than the dimension n of the matrix,
+	// and it must be larger than the number k of requested eigenvalues by
+	// at least one for Lanczos and by at least two for Arnoldi unless it is
+	// equal to n. Larger values reduce the number of restarts at the cost of
+	// more storage and work per restart. If SubspaceDim is zero,
+	// min(n, max(2*k+1, 20)) is used.
+	SubspaceDim int
+
+	// Tolerance specifies the relative accuracy of the computed eigenpairs.
+	// A Ritz pair (θ, x) is considered converged if its residual norm
+	// satisfies
+	//  ‖A*x - θ*x‖ <= Tolerance * max(eps^{2/3}, |θ|),
+	// where eps is the machine epsilon. Tolerance must not be negative and
+	// must be smaller than one. If Tolerance is zero, the machine epsilon is
+	// used.
+	Tolerance float64
+
+	// MaxIterations is the limit on the number of restarts. If it is zero,
+	// a default value of 300 is used.
+	MaxIterations int
+
+	// InitVec is the starting vector for the iteration. If it is nil or
+	// empty, a random vector is used, otherwise its length must be equal to
+	// the dimension of the matrix.
+	InitVec *mat.VecDense
+}
+
+// Stats holds statistics about an eigenvalue computation.
+type Stats struct {
+	Restarts int           // Number of restarts
+	MulVec   int           // Number of MulVec operations
+	Runtime  time.Duration // Total runtime of the computation
+}
+
+// SymResult holds the result of a symmetric eigenvalue computation.
+type SymResult struct {
+	// Values holds the computed eigenvalues ordered as specified by the
+	// Which argument, so that Values[0] is the most extreme.
+	Values []float64
+
+	// Vectors holds the orthonormal eigenvectors in its columns. The i-th
+	// column corresponds to Values[i].
+	Vectors *mat.Dense
+
+	// Residuals holds estimates of the residual norms ‖A*x - λ*x‖ of the
+	// computed eigenpairs.
+	Residuals []float64
+
+	// Stats holds statistics about the computation.
+	Stats Stats
+}
+
+// Result holds the result of a non-symmetric eigenvalue computation.
+type Result struct {
+	// Values holds the computed eigenvalues ordered as specified by the
+	// Which argument, so that Values[0] is the most extreme.
+	Values []complex128
+
+	// Vectors holds the eigenvectors, normalized to have unit Euclidean
+	// norm, in its columns. The i-th column corresponds to Values[i].
+	Vectors *mat.CDense
+
+	// Residuals holds estimates of the residual norms ‖A*x - λ*x‖ of the
+	// computed eigenpairs.
+	Residuals []float64
+
+	// Stats holds statistics about the computation.
+	Stats Stats
+}
+
+func checkSettings(n, k, extra int, which Which, s *Settings) {
+	if n <= 0 {
+		panic("eigsolve: non-positive matrix dimension")
+	}
+	if k <= 0 || n < k {
+		panic("eigsolve: invalid number of eigenvalues")
+	}
+	switch which {
+	case LargestMagnitude, SmallestMagnitude, LargestReal, SmallestReal:
+	default:
+		panic("eigsolve: invalid Which")
+	}
+	if s.InitVec != nil && !s.InitVec.IsEmpty() && s.InitVec.Len() != n {
+		panic("eigsolve: mismatched length of initial vector")
+	}
+	if s.SubspaceDim == 0 {
+		s.SubspaceDim = min(n, max(2*k+1, 20))
+	}
+	if n < s.SubspaceDim || s.SubspaceDim < min(n, k+extra) {
+		panic("eigsolve: invalid subspace dimension")
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = eps
+	}
+	if s.Tolerance < 0 || 1 <= s.Tolerance {
+		panic("eigsolve: invalid tolerance")
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = defaultMaxIterations
+	}
+	if s.MaxIterations < 0 {
+		panic("eigsolve: negative iteration limit")
+	}
+}
+
+// converged returns whether a Ritz value theta with the residual norm estimate
+// resid is converged with respect to the tolerance tol.
+func converged(theta complex128, resid, tol float64) bool {
+	return resid <= tol*math.Max(math.Cbrt(eps*eps), cmplx.Abs(theta))
+}
+
+// restartSize returns the number of Ritz values kept at a restart given the
+// number k of requested eigenvalues, the number nconv of those that have
+// converged and the subspace dimension m.
+func restartSize(k, nconv, m int) int {
+	kk := k + min(nconv, (m-k)/2)
+	if kk == 1 && m >= 6 {
+		kk = m / 2
+	} else if kk == 1 && m > 3 {
+		kk = 2
+	}
+	return kk
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
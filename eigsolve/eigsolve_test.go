@@ -0,0 +1,438 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+type testProblem struct {
+	name string
+	a    mat.Matrix
+
+	// which lists the parts of the spectrum that are tested. If it is
+	// nil, all are tested.
+	which []Which
+}
+
+var allWhich = []Which{LargestMagnitude, SmallestMagnitude, LargestReal, SmallestReal}
+
+// poisson1D returns the n×n tridiagonal matrix of the 1D Poisson problem
+// as a BandDense.
+func poisson1D(n int) *mat.BandDense {
+	a := mat.NewBandDense(n, n, 1, 1, nil)
+	for i := 0; i < n; i++ {
+		a.SetBand(i, i, 2)
+		if i > 0 {
+			a.SetBand(i, i-1, -1)
+		}
+		if i < n-1 {
+			a.SetBand(i, i+1, -1)
+		}
+	}
+	return a
+}
+
+// poisson2D returns the matrix of the 2D Poisson problem on a k×k grid as
+// a CSR matrix.
+func poisson2D(k int) *mat.CSR {
+	n := k * k
+	coo := mat.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			row := i*k + j
+			coo.Append(row, row, 4)
+			if i > 0 {
+				coo.Append(row, row-k, -1)
+			}
+			if i < k-1 {
+				coo.Append(row, row+k, -1)
+			}
+			if j > 0 {
+				coo.Append(row, row-1, -1)
+			}
+			if j < k-1 {
+				coo.Append(row, row+1, -1)
+			}
+		}
+	}
+	var a mat.CSR
+	a.CloneFrom(coo)
+	return &a
+}
+
+// convectionDiffusion returns a non-symmetric band matrix arising from
+// a finite difference discretization of a 1D convection-diffusion problem.
+// For peclet > 1 the matrix has complex eigenvalues.
+func convectionDiffusion(n int, peclet float64) *mat.BandDense {
+	a := mat.NewBandDense(n, n, 1, 1, nil)
+	for i := 0; i < n; i++ {
+		a.SetBand(i, i, 2)
+		if i > 0 {
+			a.SetBand(i, i-1, -1-peclet)
+		}
+		if i < n-1 {
+			a.SetBand(i, i+1, -1+peclet)
+		}
+	}
+	return a
+}
+
+// randomSym returns a random n×n symmetric matrix.
+func randomSym(n int, rnd *rand.Rand) *mat.SymDense {
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// randomNonsym returns a random n×n matrix.
+func randomNonsym(n int, rnd *rand.Rand) *mat.Dense {
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// quasiTriangular returns an n×n quasi-upper triangular matrix with n/2
+// complex conjugate pairs of eigenvalues a_j ± i*b_j on its 2×2 diagonal
+// blocks, and an additional real eigenvalue if n is odd.
+func quasiTriangular(n int, rnd *rand.Rand) *mat.Dense {
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 2; j < n; j++ {
+			a.Set(i, j, 0.1*rnd.NormFloat64())
+		}
+	}
+	for j := 0; j+1 < n; j += 2 {
+		re := float64(j) / 5
+		im := 1 + 0.1*float64(j)
+		a.Set(j, j, re)
+		a.Set(j, j+1, im)
+		a.Set(j+1, j, -im)
+		a.Set(j+1, j+1, re)
+	}
+	if n%2 == 1 {
+		a.Set(n-1, n-1, -0.5)
+	}
+	return a
+}
+
+// diagonal returns the n×n diagonal matrix with the given diagonal.
+func diagonal(d []float64) *mat.DiagDense {
+	return mat.NewDiagDense(len(d), d)
+}
+
+var whichNames = map[Which]string{
+	LargestMagnitude:  "LM",
+	SmallestMagnitude: "SM",
+	LargestReal:       "LR",
+	SmallestReal:      "SR",
+}
+
+func TestLanczos(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	d := make([]float64, 80)
+	for i := range d {
+		// Eigenvalues of both signs with a cluster at the top.
+		d[i] = float64(i) - 30.3
+		if i >= 75 {
+			d[i] = 50 + 0.01*float64(i)
+		}
+	}
+	for _, test := range []testProblem{
+		{name: "poisson1D", a: poisson1D(50)},
+		{name: "poisson2D", a: poisson2D(8)},
+		{name: "randomSym", a: randomSym(60, rnd)},
+		{name: "diagonal", a: diagonal(d)},
+		{name: "small", a: randomSym(5, rnd)},
+	} {
+		n, _ := test.a.Dims()
+		want := symEigenvalues(test.a)
+		anorm := math.Max(math.Abs(want[0]), math.Abs(want[n-1]))
+		for _, which := range allWhich {
+			for _, k := range []int{1, 3, 5} {
+				if k > n {
+					continue
+				}
+				name := fmt.Sprintf("%s,which=%s,k=%d", test.name, whichNames[which], k)
+				res, err := Lanczos(mat.Operator(test.a), n, k, which, &Settings{Tolerance: 1e-12})
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				checkSymResult(t, name, test.a, which, want, anorm, k, res)
+			}
+		}
+	}
+}
+
+func checkSymResult(t *testing.T, name string, a mat.Matrix, which Which, all []float64, anorm float64, k int, res *SymResult) {
+	t.Helper()
+	const tol = 1e-8
+
+	want := make([]float64, len(all))
+	copy(want, all)
+	sort.SliceStable(want, func(i, j int) bool {
+		return which.before(complex(want[i], 0), complex(want[j], 0))
+	})
+	if len(res.Values) != k || len(res.Residuals) != k {
+		t.Fatalf("%s: unexpected number of eigenvalues", name)
+	}
+	r, c := res.Vectors.Dims()
+	if r != len(all) || c != k {
+		t.Fatalf("%s: unexpected size of eigenvectors", name)
+	}
+	for i, v := range res.Values {
+		if math.Abs(v-want[i]) > tol*anorm {
+			t.Errorf("%s: unexpected eigenvalue %d: got %v, want %v", name, i, v, want[i])
+		}
+	}
+
+	// Check that the eigenvectors are orthonormal.
+	var vtv mat.Dense
+	vtv.Mul(res.Vectors.T(), res.Vectors)
+	if !mat.EqualApprox(&vtv, eye(k), 1e-12) {
+		t.Errorf("%s: eigenvectors not orthonormal", name)
+	}
+
+	// Check the residuals and their estimates.
+	var av mat.Dense
+	av.Mul(a, res.Vectors)
+	for j := 0; j < k; j++ {
+		var rv mat.VecDense
+		rv.AddScaledVec(av.ColView(j), -res.Values[j], res.Vectors.ColView(j))
+		resid := mat.Norm(&rv, 2)
+		if resid > tol*anorm {
+			t.Errorf("%s: unexpected residual %d: got %v", name, j, resid)
+		}
+		if math.Abs(resid-res.Residuals[j]) > tol*anorm {
+			t.Errorf("%s: inaccurate residual estimate %d: got %v, want %v", name, j, res.Residuals[j], resid)
+		}
+	}
+}
+
+func TestArnoldi(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []testProblem{
+		{name: "convectionDiffusionReal", a: convectionDiffusion(50, 0.1)},
+		// All the eigenvalues have the same real part.
+		{name: "convectionDiffusionComplex", a: convectionDiffusion(50, 10), which: []Which{LargestMagnitude, SmallestMagnitude}},
+		{name: "quasiTriangular", a: quasiTriangular(51, rnd)},
+		{name: "poisson2D", a: poisson2D(8)},
+		// The eigenvalues of smallest magnitude lie in the interior of
+		// the spectrum where the convergence is very slow.
+		{name: "randomNonsym", a: randomNonsym(60, rnd), which: []Which{LargestMagnitude, LargestReal, SmallestReal}},
+		{name: "small", a: randomNonsym(6, rnd)},
+	} {
+		n, _ := test.a.Dims()
+		want := nonsymEigenvalues(test.a)
+		var anorm float64
+		for _, v := range want {
+			anorm = math.Max(anorm, cmplx.Abs(v))
+		}
+		which := test.which
+		if which == nil {
+			which = allWhich
+		}
+		for _, which := range which {
+			for _, k := range []int{1, 3, 4} {
+				if k > n {
+					continue
+				}
+				name := fmt.Sprintf("%s,which=%s,k=%d", test.name, whichNames[which], k)
+				res, err := Arnoldi(mat.Operator(test.a), n, k, which, &Settings{Tolerance: 1e-12})
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				checkResult(t, name, test.a, which, want, anorm, k, res)
+			}
+		}
+	}
+}
+
+func checkResult(t *testing.T, name string, a mat.Matrix, which Which, all []complex128, anorm float64, k int, res *Result) {
+	t.Helper()
+	const tol = 1e-8
+
+	want := make([]complex128, len(all))
+	copy(want, all)
+	sort.SliceStable(want, func(i, j int) bool {
+		return which.before(want[i], want[j])
+	})
+	if len(res.Values) != k || len(res.Residuals) != k {
+		t.Fatalf("%s: unexpected number of eigenvalues", name)
+	}
+	n := len(all)
+	r, c := res.Vectors.Dims()
+	if r != n || c != k {
+		t.Fatalf("%s: unexpected size of eigenvectors", name)
+	}
+	for i, v := range res.Values {
+		if cmplx.Abs(v-want[i]) > tol*anorm {
+			t.Errorf("%s: unexpected eigenvalue %d: got %v, want %v", name, i, v, want[i])
+		}
+	}
+
+	// Check the residuals of the eigenpairs using real arithmetic.
+	for j := 0; j < k; j++ {
+		xr := mat.NewVecDense(n, nil)
+		xi := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			v := res.Vectors.At(i, j)
+			xr.SetVec(i, real(v))
+			xi.SetVec(i, imag(v))
+		}
+		norm := math.Hypot(mat.Norm(xr, 2), mat.Norm(xi, 2))
+		if math.Abs(norm-1) > 1e-12 {
+			t.Errorf("%s: eigenvector %d not normalized: norm=%v", name, j, norm)
+		}
+		lr, li := real(res.Values[j]), imag(res.Values[j])
+		var rr, ri mat.VecDense
+		rr.MulVec(a, xr)
+		rr.AddScaledVec(&rr, -lr, xr)
+		rr.AddScaledVec(&rr, li, xi)
+		ri.MulVec(a, xi)
+		ri.AddScaledVec(&ri, -lr, xi)
+		ri.AddScaledVec(&ri, -li, xr)
+		resid := math.Hypot(mat.Norm(&rr, 2), mat.Norm(&ri, 2))
+		if resid > tol*anorm {
+			t.Errorf("%s: unexpected residual %d: got %v", name, j, resid)
+		}
+		if math.Abs(resid-res.Residuals[j]) > tol*anorm {
+			t.Errorf("%s: inaccurate residual estimate %d: got %v, want %v", name, j, res.Residuals[j], resid)
+		}
+	}
+}
+
+func TestLanczosInitVec(t *testing.T) {
+	t.Parallel()
+	const n = 40
+	a := poisson1D(n)
+	want := symEigenvalues(a)
+
+	// An initial vector that is an eigenvector spans an invariant
+	// subspace, so the iteration must continue with random vectors.
+	x := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		x.SetVec(i, math.Sin(float64(i+1)*math.Pi/(n+1)))
+	}
+	for _, init := range []*mat.VecDense{x, mat.NewVecDense(n, nil), &mat.VecDense{}} {
+		res, err := Lanczos(mat.Operator(a), n, 2, LargestReal, &Settings{InitVec: init, Tolerance: 1e-12})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		checkSymResult(t, "initvec", a, LargestReal, want, 4, 2, res)
+	}
+}
+
+func TestIterationLimit(t *testing.T) {
+	t.Parallel()
+	a := poisson1D(200)
+	settings := &Settings{SubspaceDim: 4, MaxIterations: 2}
+	res, err := Lanczos(mat.Operator(a), 200, 1, SmallestReal, settings)
+	if err != ErrIterationLimit {
+		t.Errorf("unexpected error from Lanczos: got %v, want %v", err, ErrIterationLimit)
+	}
+	if res == nil || len(res.Values) != 1 || res.Stats.Restarts != 2 {
+		t.Errorf("unexpected result from Lanczos: %+v", res)
+	}
+	res2, err := Arnoldi(mat.Operator(a), 200, 1, SmallestReal, settings)
+	if err != ErrIterationLimit {
+		t.Errorf("unexpected error from Arnoldi: got %v, want %v", err, ErrIterationLimit)
+	}
+	if res2 == nil || len(res2.Values) != 1 || res2.Stats.Restarts != 2 {
+		t.Errorf("unexpected result from Arnoldi: %+v", res2)
+	}
+}
+
+func TestSettingsPanics(t *testing.T) {
+	t.Parallel()
+	a := mat.Operator(poisson1D(10))
+	for _, test := range []struct {
+		name     string
+		n, k     int
+		which    Which
+		settings *Settings
+	}{
+		{name: "n", n: 0, k: 1},
+		{name: "k zero", n: 10, k: 0},
+		{name: "k large", n: 10, k: 11},
+		{name: "which", n: 10, k: 1, which: Which(-1)},
+		{name: "subspace large", n: 10, k: 1, settings: &Settings{SubspaceDim: 11}},
+		{name: "subspace small", n: 10, k: 3, settings: &Settings{SubspaceDim: 3}},
+		{name: "tolerance", n: 10, k: 1, settings: &Settings{Tolerance: -1}},
+		{name: "iterations", n: 10, k: 1, settings: &Settings{MaxIterations: -1}},
+		{name: "initvec", n: 10, k: 1, settings: &Settings{InitVec: mat.NewVecDense(3, nil)}},
+	} {
+		if !panics(func() { Lanczos(a, test.n, test.k, test.which, test.settings) }) {
+			t.Errorf("%s: Lanczos did not panic", test.name)
+		}
+		if !panics(func() { Arnoldi(a, test.n, test.k, test.which, test.settings) }) {
+			t.Errorf("%s: Arnoldi did not panic", test.name)
+		}
+	}
+}
+
+// symEigenvalues returns the eigenvalues of the symmetric matrix a in
+// ascending order.
+func symEigenvalues(a mat.Matrix) []float64 {
+	n, _ := a.Dims()
+	s := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, a.At(i, j))
+		}
+	}
+	var eig mat.EigenSym
+	if !eig.Factorize(s, false) {
+		panic("eigendecomposition failed")
+	}
+	return eig.Values(nil)
+}
+
+// nonsymEigenvalues returns the eigenvalues of the matrix a.
+func nonsymEigenvalues(a mat.Matrix) []complex128 {
+	var eig mat.Eigen
+	if !eig.Factorize(a, mat.EigenNone) {
+		panic("eigendecomposition failed")
+	}
+	return eig.Values(nil)
+}
+
+func eye(n int) *mat.Dense {
+	d := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		d.Set(i, i, 1)
+	}
+	return d
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
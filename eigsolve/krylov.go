@@ -0,0 +1,237 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// eta is the threshold of the DGKS reorthogonalization criterion.
+const eta = 0.717
+
+// arnoldi holds an m-step Arnoldi factorization
+//  A * V = V * H + f * e_mᵀ,
+// where V is an n×m matrix with orthonormal columns, H is an m×m upper
+// Hessenberg matrix and f is orthogonal to the columns of V. If sym is true,
+// A is assumed to be symmetric and only the tridiagonal part of H is kept.
+type arnoldi struct {
+	a   mat.MulVecToer
+	sym bool
+	n   int
+	m   int
+
+	v    *mat.Dense
+	h    *mat.Dense
+	f    *mat.VecDense
+	beta float64
+
+	w   *mat.VecDense
+	tmp *mat.VecDense
+	rnd *rand.Rand
+
+	stats *Stats
+}
+
+// newArnoldi returns an empty Arnoldi factorization with the subspace
+// dimension m. The first basis vector is init normalized, or a random vector if
+// init is nil, empty or zero.
+func newArnoldi(a mat.MulVecToer, sym bool, n, m int, init *mat.VecDense, stats *Stats) *arnoldi {
+	ar := &arnoldi{
+		a:     a,
+		sym:   sym,
+		n:     n,
+		m:     m,
+		v:     mat.NewDense(n, m, nil),
+		h:     mat.NewDense(m, m, nil),
+		f:     mat.NewVecDense(n, nil),
+		w:     mat.NewVecDense(n, nil),
+		tmp:   mat.NewVecDense(m, nil),
+		rnd:   rand.New(rand.NewSource(1)),
+		stats: stats,
+	}
+	if init != nil && !init.IsEmpty() {
+		ar.f.CopyVec(init)
+		ar.beta = mat.Norm(ar.f, 2)
+	}
+	return ar
+}
+
+// extend extends a k-step Arnoldi factorization to an m-step one.
+func (ar *arnoldi) extend(k int) {
+	n := ar.n
+	for j := k; j < ar.m; j++ {
+		vj := ar.v.ColView(j).(*mat.VecDense)
+		if ar.beta == 0 {
+			// The previous basis vectors span an invariant subspace
+			// or the starting vector is zero, so continue with a random
+			// vector orthogonal to the basis.
+			ar.randomOrthogonal(vj, j)
+			if j > 0 {
+				ar.setSubdiag(j, 0)
+			}
+		} else {
+			vj.ScaleVec(1/ar.beta, ar.f)
+			if j > 0 {
+				ar.setSubdiag(j, ar.beta)
+			}
+		}
+
+		ar.a.MulVecTo(ar.w, false, vj)
+		ar.stats.MulVec++
+
+		// Orthogonalize A*v_j against the basis using classical
+		// Gram-Schmidt with DGKS reorthogonalization.
+		vs := ar.v.Slice(0, n, 0, j+1)
+		hj := ar.tmp.SliceVec(0, j+1).(*mat.VecDense)
+		hj.MulVec(vs.T(), ar.w)
+		ar.f.MulVec(vs, hj)
+		ar.f.SubVec(ar.w, ar.f)
+		prev := mat.Norm(ar.w, 2)
+		ar.beta = mat.Norm(ar.f, 2)
+		c := mat.NewVecDense(j+1, nil)
+		for iter := 0; ar.beta < eta*prev; iter++ {
+			if iter == 2 {
+				// The new vector is numerically in the span of the
+				// basis.
+				ar.f.Zero()
+				ar.beta = 0
+				break
+			}
+			c.MulVec(vs.T(), ar.f)
+			ar.w.MulVec(vs, c)
+			ar.f.SubVec(ar.f, ar.w)
+			hj.AddVec(hj, c)
+			prev = ar.beta
+			ar.beta = mat.Norm(ar.f, 2)
+		}
+
+		if ar.sym {
+			ar.h.Set(j, j, hj.AtVec(j))
+		} else {
+			for i := 0; i <= j; i++ {
+				ar.h.Set(i, j, hj.AtVec(i))
+			}
+		}
+	}
+}
+
+// setSubdiag sets the element H[j][j-1], and H[j-1][j] if A is symmetric.
+func (ar *arnoldi) setSubdiag(j int, v float64) {
+	ar.h.Set(j, j-1, v)
+	if ar.sym {
+		ar.h.Set(j-1, j, v)
+	}
+}
+
+// randomOrthogonal stores into dst a random unit vector orthogonal to the first
+// j columns of V.
+func (ar *arnoldi) randomOrthogonal(dst *mat.VecDense, j int) {
+	for {
+		for i := 0; i < ar.n; i++ {
+			dst.SetVec(i, 2*ar.rnd.Float64()-1)
+		}
+		if j > 0 {
+			vs := ar.v.Slice(0, ar.n, 0, j)
+			c := mat.NewVecDense(j, nil)
+			for pass := 0; pass < 2; pass++ {
+				c.MulVec(vs.T(), dst)
+				ar.w.MulVec(vs, c)
+				dst.SubVec(dst, ar.w)
+			}
+		}
+		norm := mat.Norm(dst, 2)
+		if norm > 0 {
+			dst.ScaleVec(1/norm, dst)
+			return
+		}
+	}
+}
+
+// shift applies one step of the QR algorithm with the real shift mu to H and
+// accumulates the orthogonal transformation into q.
+func (ar *arnoldi) shift(q *mat.Dense, mu float64) {
+	m := ar.m
+	var s mat.Dense
+	s.CloneFrom(ar.h)
+	for i := 0; i < m; i++ {
+		s.Set(i, i, s.At(i, i)-mu)
+	}
+	ar.transform(q, &s)
+}
+
+// doubleShift applies one step of the QR algorithm with the complex conjugate
+// pair of shifts re ± i*im to H, where abs2 = re^2 + im^2, and accumulates the
+// orthogonal transformation into q.
+func (ar *arnoldi) doubleShift(q *mat.Dense, re, abs2 float64) {
+	var s mat.Dense
+	s.Mul(ar.h, ar.h)
+	s.Apply(func(i, j int, v float64) float64 {
+		v -= 2 * re * ar.h.At(i, j)
+		if i == j {
+			v += abs2
+		}
+		return v
+	}, &s)
+	ar.transform(q, &s)
+}
+
+// transform computes the QR factorization s = Qs*R and replaces H with
+// Qsᵀ*H*Qs and q with q*Qs.
+func (ar *arnoldi) transform(q, s *mat.Dense) {
+	var qr mat.QR
+	qr.Factorize(s)
+	var qs, tmp mat.Dense
+	qr.QTo(&qs)
+
+	tmp.Mul(ar.h, &qs)
+	ar.h.Mul(qs.T(), &tmp)
+	tmp.Mul(q, &qs)
+	q.Copy(&tmp)
+
+	// Remove the rounding errors outside the Hessenberg or tridiagonal
+	// structure.
+	m := ar.m
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i > j+1 || ar.sym && j > i+1 {
+				ar.h.Set(i, j, 0)
+			}
+		}
+	}
+	if ar.sym {
+		for i := 1; i < m; i++ {
+			v := (ar.h.At(i, i-1) + ar.h.At(i-1, i)) / 2
+			ar.h.Set(i, i-1, v)
+			ar.h.Set(i-1, i, v)
+		}
+	}
+}
+
+// restart truncates the factorization to kk steps after the shifts have been
+// applied with the accumulated orthogonal transformation q.
+func (ar *arnoldi) restart(q *mat.Dense, kk int) {
+	n, m := ar.n, ar.m
+
+	betak := ar.h.At(kk, kk-1)
+	sigma := q.At(m-1, kk-1)
+
+	var vq mat.Dense
+	vq.Mul(ar.v, q.Slice(0, m, 0, kk+1))
+
+	// f = beta_k * V*q_{kk+1} + sigma * f.
+	ar.f.ScaleVec(sigma, ar.f)
+	ar.f.AddScaledVec(ar.f, betak, vq.ColView(kk))
+	ar.v.Slice(0, n, 0, kk).(*mat.Dense).Copy(vq.Slice(0, n, 0, kk))
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i >= kk || j >= kk {
+				ar.h.Set(i, j, 0)
+			}
+		}
+	}
+	ar.beta = mat.Norm(ar.f, 2)
+}
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Lanczos computes k eigenvalues and the corresponding eigenvectors of the
+// n×n symmetric matrix A using the implicitly restarted Lanczos method. The
+// matrix A is represented by the operator a. Which eigenvalues are computed is
+// specified by which.
+//
+// settings provide a way to specify the parameters of the method. If settings
+// is nil, default values are used, see the Settings documentation for more
+// information. Lanczos panics if k is not in the range [1, n] or if settings
+// is not valid.
+//
+// Lanczos returns the computed eigenpairs with estimates of their residual
+// norms. If the limit on the number of restarts is reached before all the
+// eigenpairs have converged, Lanczos returns the current approximations and
+// ErrIterationLimit.
+func Lanczos(a mat.MulVecToer, n, k int, which Which, settings *Settings) (*SymResult, error) {
+	start := time.Now()
+
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	checkSettings(n, k, 1, which, &s)
+
+	var stats Stats
+	m := s.SubspaceDim
+	ar := newArnoldi(a, true, n, m, s.InitVec, &stats)
+	ar.extend(0)
+
+	var (
+		eig   mat.EigenSym
+		sym   = mat.NewSymDense(m, nil)
+		theta []float64
+		y     mat.Dense
+		order = make([]int, m)
+		resid = make([]float64, m)
+		q     = mat.NewDense(m, m, nil)
+		err   error
+	)
+	for {
+		// Compute the Ritz values and the residual estimates.
+		for i := 0; i < m; i++ {
+			sym.SetSym(i, i, ar.h.At(i, i))
+			if i > 0 {
+				sym.SetSym(i-1, i, ar.h.At(i-1, i))
+			}
+		}
+		ok := eig.Factorize(sym, true)
+		if !ok {
+			return nil, errors.New("eigsolve: eigendecomposition of tridiagonal matrix failed")
+		}
+		theta = eig.Values(theta)
+		eig.VectorsTo(&y)
+		for i := range order {
+			order[i] = i
+			resid[i] = ar.beta * math.Abs(y.At(m-1, i))
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return which.before(complex(theta[order[i]], 0), complex(theta[order[j]], 0))
+		})
+
+		var nconv int
+		for _, i := range order[:k] {
+			if converged(complex(theta[i], 0), resid[i], s.Tolerance) {
+				nconv++
+			}
+		}
+		if nconv >= k || m == n {
+			break
+		}
+		if stats.Restarts == s.MaxIterations {
+			err = ErrIterationLimit
+			break
+		}
+
+		// Apply the unwanted Ritz values as shifts and restart.
+		kk := restartSize(k, nconv, m)
+		q.Zero()
+		for i := 0; i < m; i++ {
+			q.Set(i, i, 1)
+		}
+		for _, i := range order[kk:] {
+			ar.shift(q, theta[i])
+		}
+		ar.restart(q, kk)
+		stats.Restarts++
+		ar.extend(kk)
+	}
+
+	// Compute the Ritz vectors.
+	res := &SymResult{
+		Values:    make([]float64, k),
+		Vectors:   mat.NewDense(n, k, nil),
+		Residuals: make([]float64, k),
+	}
+	ys := mat.NewDense(m, k, nil)
+	for c, i := range order[:k] {
+		res.Values[c] = theta[i]
+		res.Residuals[c] = resid[i]
+		for r := 0; r < m; r++ {
+			ys.Set(r, c, y.At(r, i))
+		}
+	}
+	res.Vectors.Mul(ar.v, ys)
+
+	stats.Runtime = time.Since(start)
+	res.Stats = stats
+	return res, err
+}
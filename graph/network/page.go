@@ -140,7 +140,7 @@ func edgeWeightedPageRankSparse(g graph.WeightedDirected, damp, tol float64) map
 		indexOf[n.ID()] = i
 	}
 
-	m := make(rowCompressedMatrix, len(nodes))
+	m := mat.NewCOO(len(nodes), len(nodes), nil, nil, nil)
 	var dangling compressedRow
 	df := damp / float64(len(nodes))
 	for j, u := range nodes {
@@ -154,7 +154,7 @@ func edgeWeightedPageRankSparse(g graph.WeightedDirected, damp, tol float64) map
 		if z != 0 {
 			for _, v := range to {
 				if w, ok := g.Weight(u.ID(), v.ID()); ok {
-					m.addTo(indexOf[v.ID()], j, (w*damp)/z)
+					m.Append(indexOf[v.ID()], j, (w*damp)/z)
 				}
 			}
 		} else {
@@ -162,6 +162,9 @@ func edgeWeightedPageRankSparse(g graph.WeightedDirected, damp, tol float64) map
 		}
 	}
 
+	var h mat.CSR
+	h.CloneFrom(m)
+
 	last := make([]float64, len(nodes))
 	for i := range last {
 		last[i] = 1
@@ -185,7 +188,7 @@ func edgeWeightedPageRankSparse(g graph.WeightedDirected, damp, tol float64) map
 	for {
 		lastV, v = v, lastV
 
-		m.mulVecUnitary(v, lastV)          // First term of the G matrix equation;
+		h.MulVecTo(v, false, lastV)        // First term of the G matrix equation;
 		with := dangling.dotUnitary(lastV) // Second term;
 		away := onesDotUnitary(dt, lastV)  // Last term.
 
@@ -294,20 +297,23 @@ func pageRankSparse(g graph.Directed, damp, tol float64) map[int64]float64 {
 		indexOf[n.ID()] = i
 	}
 
-	m := make(rowCompressedMatrix, len(nodes))
+	m := mat.NewCOO(len(nodes), len(nodes), nil, nil, nil)
 	var dangling compressedRow
 	df := damp / float64(len(nodes))
 	for j, u := range nodes {
 		to := graph.NodesOf(g.From(u.ID()))
 		f := damp / float64(len(to))
 		for _, v := range to {
-			m.addTo(indexOf[v.ID()], j, f)
+			m.Append(indexOf[v.ID()], j, f)
 		}
 		if len(to) == 0 {
 			dangling.addTo(j, df)
 		}
 	}
 
+	var h mat.CSR
+	h.CloneFrom(m)
+
 	last := make([]float64, len(nodes))
 	for i := range last {
 		last[i] = 1
@@ -331,7 +337,7 @@ func pageRankSparse(g graph.Directed, damp, tol float64) map[int64]float64 {
 	for {
 		lastV, v = v, lastV
 
-		m.mulVecUnitary(v, lastV)          // First term of the G matrix equation;
+		h.MulVecTo(v, false, lastV)        // First term of the G matrix equation;
 		with := dangling.dotUnitary(lastV) // Second term;
 		away := onesDotUnitary(dt, lastV)  // Last term.
 
@@ -349,25 +355,6 @@ func pageRankSparse(g graph.Directed, damp, tol float64) map[int64]float64 {
 	return ranks
 }
 
-// rowCompressedMatrix implements row-compressed
-// matrix/vector multiplication.
-type rowCompressedMatrix []compressedRow
-
-// addTo adds the value v to the matrix element at (i,j). Repeated
-// calls to addTo with the same column index will result in
-// non-unique element representation.
-func (m rowCompressedMatrix) addTo(i, j int, v float64) { m[i].addTo(j, v) }
-
-// mulVecUnitary multiplies the receiver by the src vector, storing
-// the result in dst. It assumes src and dst are the same length as m
-// and that both have unitary vector increments.
-func (m rowCompressedMatrix) mulVecUnitary(dst, src *mat.VecDense) {
-	dMat := dst.RawVector().Data
-	for i, r := range m {
-		dMat[i] = r.dotUnitary(src)
-	}
-}
-
 // compressedRow implements a simplified scatter-based Ddot.
 type compressedRow []sparseElement
 
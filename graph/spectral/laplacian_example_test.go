@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/eigsolve"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/spectral"
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleNewLaplacian_fiedler() {
+	// Construct a graph of two triangles joined by a single edge.
+	g := simple.NewUndirectedGraph()
+	for _, e := range []struct{ from, to int64 }{
+		{0, 1}, {1, 2}, {2, 0},
+		{3, 4}, {4, 5}, {5, 3},
+		{2, 3},
+	} {
+		g.SetEdge(simple.Edge{F: simple.Node(e.from), T: simple.Node(e.to)})
+	}
+	l := spectral.NewLaplacian(g)
+
+	// Only the two smallest eigenpairs of the Laplacian are needed
+	// to find the Fiedler vector.
+	var eig mat.EigenSym
+	ok := eig.FactorizeIndex(l.Matrix.(mat.Symmetric), 0, 2, true)
+	if !ok {
+		log.Fatal("eigendecomposition failed")
+	}
+	values := eig.Values(nil)
+	fmt.Printf("algebraic connectivity: %.4f\n", values[1])
+
+	// Partition the nodes by the sign of their Fiedler vector element.
+	var vectors mat.Dense
+	eig.VectorsTo(&vectors)
+	var neg, pos []int64
+	for _, n := range l.Nodes {
+		if vectors.At(l.Index[n.ID()], 1) < 0 {
+			neg = append(neg, n.ID())
+		} else {
+			pos = append(pos, n.ID())
+		}
+	}
+	sort.Slice(neg, func(i, j int) bool { return neg[i] < neg[j] })
+	sort.Slice(pos, func(i, j int) bool { return pos[i] < pos[j] })
+	if pos[0] < neg[0] {
+		neg, pos = pos, neg
+	}
+	fmt.Println("partition:", neg, pos)
+
+	// Output:
+	// algebraic connectivity: 0.4384
+	// partition: [0 1 2] [3 4 5]
+}
+
+func ExampleNewLaplacian_lanczos() {
+	// Construct a 10×30 grid graph.
+	const rows, cols = 10, 30
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			id := int64(i*cols + j)
+			if i > 0 {
+				g.SetEdge(simple.Edge{F: simple.Node(id - cols), T: simple.Node(id)})
+			}
+			if j > 0 {
+				g.SetEdge(simple.Edge{F: simple.Node(id - 1), T: simple.Node(id)})
+			}
+		}
+	}
+	l := spectral.NewLaplacian(g)
+
+	// For large graphs, the smallest eigenvalues of the Laplacian can
+	// be computed iteratively using only products with the matrix.
+	n, _ := l.Matrix.Dims()
+	res, err := eigsolve.Lanczos(mat.Operator(l.Matrix), n, 3, eigsolve.SmallestReal, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i, v := range res.Values {
+		fmt.Printf("λ_%d = %.4f\n", i, math.Abs(v))
+	}
+
+	// Output:
+	// λ_0 = 0.0000
+	// λ_1 = 0.0110
+	// λ_2 = 0.0437
+}
@@ -11,5 +11,8 @@ import (
 )
 
 func BenchmarkDgeev(b *testing.B)  { testlapack.DgeevBenchmark(b, impl) }
+func BenchmarkDgeqrf(b *testing.B) { testlapack.DgeqrfBenchmark(b, impl) }
+func BenchmarkDgetrf(b *testing.B) { testlapack.DgetrfBenchmark(b, impl) }
 func BenchmarkDlangb(b *testing.B) { testlapack.DlangbBenchmark(b, impl) }
 func BenchmarkDlantb(b *testing.B) { testlapack.DlantbBenchmark(b, impl) }
+func BenchmarkDpotrf(b *testing.B) { testlapack.DpotrfBenchmark(b, impl) }
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dbdsdc computes the singular value decomposition of a real n×n (upper or
+// lower) bidiagonal matrix B
+//  B = U * S * VT
+// using a divide and conquer method, where S is a diagonal matrix with
+// non-negative diagonal elements (the singular values of B), and U and VT are
+// orthogonal matrices of left and right singular vectors, respectively.
+// Dbdsdc can be used to compute all singular values, and optionally, singular
+// vectors.
+//
+// Dbdsdc would be much faster than Dbdsqr if singular vectors are required
+// and n is large. If only singular values are computed, Dbdsdc uses the
+// implicit QR iteration of Dbdsqr.
+//
+// On entry, d contains the n diagonal elements of the bidiagonal matrix B. On
+// exit, d contains the singular values of B in decreasing order. d must have
+// length at least n.
+//
+// On entry, e contains the n-1 off-diagonal elements of the bidiagonal matrix
+// B. On exit, e has been destroyed. e must have length at least n-1.
+//
+// If compq == lapack.BDCompute, on return u contains the n×n matrix of left
+// singular vectors of the bidiagonal matrix and vt contains the n×n matrix of
+// right singular vectors transposed. u and vt are not referenced if
+// compq == lapack.BDCompNone.
+//
+// work must have length at least 4*n if compq == lapack.BDCompNone and
+// 3*n^2+4*n if compq == lapack.BDCompute. iwork must have length at least
+// 8*n.
+//
+// Dbdsdc returns whether all singular values have been computed.
+func (impl Implementation) Dbdsdc(uplo blas.Uplo, compq lapack.BDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool) {
+	wantVec := compq == lapack.BDCompute
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case !wantVec && compq != lapack.BDCompNone:
+		panic(badBDComp)
+	case n < 0:
+		panic(nLT0)
+	case ldu < 1, wantVec && ldu < n:
+		panic(badLdU)
+	case ldvt < 1, wantVec && ldvt < n:
+		panic(badLdVT)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	lwmin := 4 * n
+	if wantVec {
+		lwmin = 3*n*n + 4*n
+	}
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case wantVec && len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case wantVec && len(vt) < (n-1)*ldvt+n:
+		panic(shortVT)
+	case len(work) < lwmin:
+		panic(shortWork)
+	case len(iwork) < 8*n:
+		panic(shortIWork)
+	}
+
+	if n == 1 {
+		if wantVec {
+			u[0] = math.Copysign(1, d[0])
+			vt[0] = 1
+		}
+		d[0] = math.Abs(d[0])
+		return true
+	}
+	nm1 := n - 1
+
+	// If matrix lower bidiagonal, rotate to be upper bidiagonal by applying
+	// Givens rotations on the left. The rotations are stored at the
+	// beginning of work when singular vectors are desired.
+	var wstart int
+	lower := uplo == blas.Lower
+	if lower {
+		if wantVec {
+			wstart = 2*n - 2
+		}
+		for i := 0; i < nm1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if wantVec {
+				work[i] = cs
+				work[nm1+i] = -sn
+			}
+		}
+	}
+
+	smlsiz := impl.Ilaenv(9, "DBDSDC", " ", 0, 0, 0, 0)
+	switch {
+	case !wantVec:
+		// Use Dlasdq to compute the singular values.
+		ok = impl.Dlasdq(blas.Upper, 0, n, 0, 0, 0, d, e, vt, ldvt, u, ldu, u, ldu, work)
+	case n <= smlsiz:
+		// If n is smaller than the minimum divide size smlsiz, then solve
+		// the problem with another solver.
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, n, n, 0, 1, vt, ldvt)
+		ok = impl.Dlasdq(blas.Upper, 0, n, n, n, 0, d, e, vt, ldvt, u, ldu, u, ldu, work[wstart:])
+	default:
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, n, n, 0, 1, vt, ldvt)
+
+		// Scale.
+		orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+		if orgnrm == 0 {
+			return true
+		}
+		impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+		impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, nm1, 1, e, 1)
+
+		eps := 0.9 * dlamchE
+		for i, v := range d[:n] {
+			if math.Abs(v) < eps {
+				d[i] = math.Copysign(eps, v)
+			}
+		}
+
+		start := 0
+		for i := 0; i < nm1; i++ {
+			if math.Abs(e[i]) >= eps && i < nm1-1 {
+				continue
+			}
+			// Subproblem found. First determine its size and then apply
+			// divide and conquer on it.
+			var nsize int
+			switch {
+			case i < nm1-1:
+				// A subproblem with e[i] small for i < n-2.
+				nsize = i - start + 1
+			case math.Abs(e[i]) >= eps:
+				// A subproblem with e[n-2] not too small.
+				nsize = n - start
+			default:
+				// A subproblem with e[n-2] small. This implies a 1×1
+				// subproblem at d[n-1]. Solve this 1×1 problem first.
+				nsize = i - start + 1
+				u[nm1*ldu+nm1] = math.Copysign(1, d[nm1])
+				vt[nm1*ldvt+nm1] = 1
+				d[nm1] = math.Abs(d[nm1])
+			}
+			ok = impl.Dlasd0(nsize, 0, d[start:], e[start:], u[start*ldu+start:], ldu,
+				vt[start*ldvt+start:], ldvt, smlsiz, iwork, work[wstart:])
+			if !ok {
+				return false
+			}
+			start = i + 1
+		}
+
+		// Unscale.
+		impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+	}
+	if !ok {
+		return false
+	}
+
+	// Use selection sort to minimize swaps of singular vectors.
+	bi := blas64.Implementation()
+	for i := 0; i < nm1; i++ {
+		kk := i
+		p := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] > p {
+				kk = j
+				p = d[j]
+			}
+		}
+		if kk == i {
+			continue
+		}
+		d[kk] = d[i]
+		d[i] = p
+		if wantVec {
+			bi.Dswap(n, u[i:], ldu, u[kk:], ldu)
+			bi.Dswap(n, vt[i*ldvt:], 1, vt[kk*ldvt:], 1)
+		}
+	}
+
+	// If B is lower bidiagonal, update U by those Givens rotations which
+	// rotated B to be upper bidiagonal.
+	if lower && wantVec {
+		impl.Dlasr(blas.Left, lapack.Variable, lapack.Backward, n, n, work[:nm1], work[nm1:2*nm1], u, ldu)
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgbcon returns an estimate of the reciprocal of the condition number of an
+// n×n band matrix A with kl sub-diagonals and ku super-diagonals, in either
+// the 1-norm or the ∞-norm, using the LU factorization computed by Dgbtrf. The
+// estimate is obtained for norm(inv(A)), and the reciprocal of the condition
+// number is computed as
+//  rcond = 1 / (anorm * norm(inv(A))).
+//
+// ab and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Dgbtrf. See the documentation for Dgbtrf for a description of
+// the band storage format of the factorization.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// The length of work must be at least 3*n and the length of iwork must be at
+// least n.
+func (impl Implementation) Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case anorm < 0:
+		panic(badNorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// Quick return if possible.
+	if anorm == 0 {
+		return 0
+	}
+
+	const smlnum = dlamchS
+
+	// The element M[i,j] of the factorization is stored in ab[kl+i*ldm+j].
+	ldm := ldab - 1
+	// kv is the number of super-diagonals of U.
+	kv := ku + kl
+
+	var (
+		ainvnm float64
+		kase   int
+		isave  [3]int
+		normin bool
+
+		// Denote work slices.
+		x     = work[:n]
+		v     = work[n : 2*n]
+		cnorm = work[2*n : 3*n]
+	)
+	kase1 := 2
+	if norm == lapack.MaxColumnSum {
+		kase1 = 1
+	}
+	// Estimate the norm of inv(A).
+	bi := blas64.Implementation()
+	for {
+		ainvnm, kase = impl.Dlacn2(n, v, x, iwork, ainvnm, kase, &isave)
+		if kase == 0 {
+			break
+		}
+		var scale float64
+		if kase == kase1 {
+			// Multiply x by inv(L).
+			if kl > 0 {
+				for j := 0; j < n-1; j++ {
+					lm := min(kl, n-1-j)
+					jp := ipiv[j]
+					t := x[jp]
+					if jp != j {
+						x[jp] = x[j]
+						x[j] = t
+					}
+					bi.Daxpy(lm, -t, ab[kl+(j+1)*ldm+j:], ldm, x[j+1:], 1)
+				}
+			}
+			// Multiply x by inv(U).
+			scale = impl.Dlatbs(blas.Upper, blas.NoTrans, blas.NonUnit, normin, n, kv, ab[kl:], ldab, x, cnorm)
+		} else {
+			// Multiply x by inv(Uᵀ).
+			scale = impl.Dlatbs(blas.Upper, blas.Trans, blas.NonUnit, normin, n, kv, ab[kl:], ldab, x, cnorm)
+			// Multiply x by inv(Lᵀ).
+			if kl > 0 {
+				for j := n - 2; j >= 0; j-- {
+					lm := min(kl, n-1-j)
+					x[j] -= bi.Ddot(lm, ab[kl+(j+1)*ldm+j:], ldm, x[j+1:], 1)
+					jp := ipiv[j]
+					if jp != j {
+						x[jp], x[j] = x[j], x[jp]
+					}
+				}
+			}
+		}
+		normin = true
+		// Multiply x by 1/scale if doing so will not cause overflow.
+		if scale != 1 {
+			ix := bi.Idamax(n, x, 1)
+			if scale < math.Abs(x[ix])*smlnum || scale == 0 {
+				return 0
+			}
+			impl.Drscl(n, scale, x, 1)
+		}
+	}
+	if ainvnm == 0 {
+		return 0
+	}
+	// Return the estimate of the reciprocal condition number.
+	return (1 / ainvnm) / anorm
+}
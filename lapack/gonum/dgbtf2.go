@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Dgbtf2 computes an LU factorization of an m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges. See the documentation for Dgbtrf for a description of the
+// parameters and of the band storage format.
+//
+// Dgbtf2 is the unblocked version of the algorithm.
+//
+// Dgbtf2 is an internal routine. It is exported for testing purposes.
+func (Implementation) Dgbtf2(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	mn := min(m, n)
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (min(m, n+kl)-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	// The element A[i,j] is stored in ab[i*ldab+kl+j-i] = ab[kl+i*ldm+j], so
+	// blocks of A within the band can be accessed as general matrices with
+	// stride ldm.
+	ldm := ldab - 1
+	// kv is the number of super-diagonals of U.
+	kv := ku + kl
+
+	// Set fill-in elements in columns ku+1 to kv-1 to zero.
+	for j := ku + 1; j < min(kv, n); j++ {
+		for i := 0; i < min(j-ku, m); i++ {
+			ab[kl+i*ldm+j] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+	ok = true
+	// ju is the index of the last column affected by the current stage of
+	// the factorization.
+	var ju int
+	for j := 0; j < mn; j++ {
+		// Set fill-in elements in column j+kv to zero.
+		if j+kv < n {
+			for i := j; i < min(j+kl, m); i++ {
+				ab[kl+i*ldm+j+kv] = 0
+			}
+		}
+
+		// Find pivot and test for singularity. km is the number of
+		// sub-diagonal elements in the current column.
+		km := min(kl, m-1-j)
+		var jp int
+		if km > 0 {
+			jp = bi.Idamax(km+1, ab[kl+j*ldm+j:], ldm)
+		}
+		ipiv[j] = j + jp
+		if ab[kl+(j+jp)*ldm+j] == 0 {
+			ok = false
+			continue
+		}
+		ju = max(ju, min(j+ku+jp, n-1))
+
+		// Apply the interchange to columns j to ju.
+		if jp != 0 {
+			bi.Dswap(ju-j+1, ab[kl+(j+jp)*ldm+j:], 1, ab[kl+j*ldm+j:], 1)
+		}
+		if km > 0 {
+			// Compute multipliers.
+			bi.Dscal(km, 1/ab[kl+j*ldm+j], ab[kl+(j+1)*ldm+j:], ldm)
+
+			// Update trailing submatrix within the band.
+			if ju > j {
+				bi.Dger(km, ju-j, -1, ab[kl+(j+1)*ldm+j:], ldm,
+					ab[kl+j*ldm+j+1:], 1,
+					ab[kl+(j+1)*ldm+j+1:], ldm)
+			}
+		}
+	}
+	return ok
+}
@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgbtrf computes an LU factorization of an m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges. The factorization has the form
+//  A = P * L * U
+// where P is a permutation matrix, L is a unit lower triangular matrix with
+// kl sub-diagonals and U is an upper triangular matrix with kl+ku
+// super-diagonals.
+//
+// The band storage scheme is illustrated below when m = n = 6, kl = 2 and
+// ku = 1. Elements marked * are not used by the function. Elements marked +
+// need not be set on entry, but are required by the function to store
+// elements of U because of fill-in resulting from the row interchanges.
+//
+//  On entry:                       On return:
+//    *    *   a00  a01   +    +       *    *   u00  u01  u02  u03
+//    *   a10  a11  a12   +    +       *   m10  u11  u12  u13  u14
+//   a20  a21  a22  a23   +    +      m20  m21  u22  u23  u24  u25
+//   a31  a32  a33  a34   +    +      m31  m32  u33  u34  u35   *
+//   a42  a43  a44  a45   +    +      m42  m43  u44  u45   *    *
+//   a53  a54  a55   *    +    +      m53  m54  u55   *    *    *
+//
+// The element A[i,j] is stored in ab[i*ldab+kl+j-i], so ldab must be at least
+// 2*kl+ku+1. On return, the elements mij are the multipliers used during the
+// factorization.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// interchanged with row ipiv[i]. ipiv must have length min(m,n), and Dgbtrf
+// will panic otherwise. ipiv is zero-indexed.
+//
+// Dgbtrf returns whether the matrix A is nonsingular. The LU factorization is
+// computed regardless of the singularity of A, but the factor U is exactly
+// singular if Dgbtrf returns false and division by zero will occur if it is
+// used to solve a system of equations.
+func (impl Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	const nbmax = 64
+
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	mn := min(m, n)
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (min(m, n+kl)-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	// Determine the block size for this environment.
+	nb := impl.Ilaenv(1, "DGBTRF", " ", m, n, kl, ku)
+	// The block size must not exceed the limit set by the size of the local
+	// arrays work13 and work31.
+	nb = min(nb, nbmax)
+
+	if nb <= 1 || kl < nb {
+		// Use unblocked code.
+		return impl.Dgbtf2(m, n, kl, ku, ab, ldab, ipiv)
+	}
+
+	// Use blocked code.
+
+	// The element A[i,j] is stored in ab[i*ldab+kl+j-i] = ab[kl+i*ldm+j], so
+	// blocks of A within the band can be accessed as general matrices with
+	// stride ldm.
+	ldm := ldab - 1
+	// kv is the number of super-diagonals of U.
+	kv := ku + kl
+
+	// work13 and work31 are nb×nb matrices that hold the elements of the
+	// blocks A13 and A31 that lie outside the band. The superdiagonal
+	// elements of work13 and the subdiagonal elements of work31 are zero.
+	ldwork := nb
+	work13 := make([]float64, nb*ldwork)
+	work31 := make([]float64, nb*ldwork)
+
+	// Set fill-in elements in columns ku+1 to kv-1 to zero.
+	for j := ku + 1; j < min(kv, n); j++ {
+		for i := 0; i < min(j-ku, m); i++ {
+			ab[kl+i*ldm+j] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+	ok = true
+	// ju is the index of the last column affected by the current stage of
+	// the factorization.
+	var ju int
+	for j := 0; j < mn; j += nb {
+		jb := min(nb, mn-j)
+
+		// The active part of the matrix is partitioned
+		//  A11   A12   A13
+		//  A21   A22   A23
+		//  A31   A32   A33
+		// Here A11, A21 and A31 denote the current block of jb columns
+		// which is about to be factorized. The number of rows in the
+		// partitioning are jb, i2, i3 respectively, and the numbers of
+		// columns are jb, j2, j3. The superdiagonal elements of A13 and
+		// the subdiagonal elements of A31 lie outside the band.
+		i2 := min(kl-jb, m-j-jb)
+		i3 := min(jb, m-j-kl)
+
+		// j2 and j3 are computed after ju has been updated.
+
+		// Factorize the current block of jb columns.
+		for jj := j; jj < j+jb; jj++ {
+			// Set fill-in elements in column jj+kv to zero.
+			if jj+kv < n {
+				for i := jj; i < min(jj+kl, m); i++ {
+					ab[kl+i*ldm+jj+kv] = 0
+				}
+			}
+
+			// Find pivot and test for singularity. km is the number of
+			// sub-diagonal elements in the current column.
+			km := min(kl, m-1-jj)
+			jp := bi.Idamax(km+1, ab[kl+jj*ldm+jj:], ldm)
+			ipiv[jj] = jp + jj - j
+			if ab[kl+(jj+jp)*ldm+jj] != 0 {
+				ju = max(ju, min(jj+ku+jp, n-1))
+				if jp != 0 {
+					// Apply the interchange to columns j to j+jb-1.
+					if jp+jj < j+kl {
+						bi.Dswap(jb, ab[kl+jj*ldm+j:], 1, ab[kl+(jj+jp)*ldm+j:], 1)
+					} else {
+						// The interchange affects columns j to jj-1
+						// of A31 which are stored in work31.
+						bi.Dswap(jj-j, ab[kl+jj*ldm+j:], 1, work31[(jp+jj-j-kl)*ldwork:], 1)
+						bi.Dswap(j+jb-jj, ab[kl+jj*ldm+jj:], 1, ab[kl+(jj+jp)*ldm+jj:], 1)
+					}
+				}
+
+				if km > 0 {
+					// Compute multipliers.
+					bi.Dscal(km, 1/ab[kl+jj*ldm+jj], ab[kl+(jj+1)*ldm+jj:], ldm)
+
+					// Update trailing submatrix within the band and
+					// within the current block. jm is the index of the
+					// last column which needs to be updated.
+					jm := min(ju, j+jb-1)
+					if jm > jj {
+						bi.Dger(km, jm-jj, -1, ab[kl+(jj+1)*ldm+jj:], ldm,
+							ab[kl+jj*ldm+jj+1:], 1,
+							ab[kl+(jj+1)*ldm+jj+1:], ldm)
+					}
+				}
+			} else {
+				ok = false
+			}
+
+			// Copy the current column of A31 into work31.
+			nw := min(jj-j+1, i3)
+			if nw > 0 {
+				bi.Dcopy(nw, ab[kl+(j+kl)*ldm+jj:], ldm, work31[jj-j:], ldwork)
+			}
+		}
+
+		if j+jb < n {
+			// Apply the row interchanges to the other blocks.
+			j2 := min(ju-j+1, kv) - jb
+			j3 := max(0, ju-j-kv+1)
+
+			// Use Dlaswp to apply the row interchanges to A12, A22 and
+			// A32.
+			impl.Dlaswp(j2, ab[kl+j*ldm+j+jb:], ldm, 0, jb-1, ipiv[j:j+jb], 1)
+
+			// Adjust the pivot indices.
+			for i := j; i < j+jb; i++ {
+				ipiv[i] += j
+			}
+
+			// Apply the row interchanges to A13, A23 and A33
+			// columnwise.
+			k2 := j + jb + j2
+			for i := 0; i < j3; i++ {
+				jj := k2 + i
+				for ii := j + i; ii < j+jb; ii++ {
+					ip := ipiv[ii]
+					if ip != ii {
+						ab[kl+ii*ldm+jj], ab[kl+ip*ldm+jj] = ab[kl+ip*ldm+jj], ab[kl+ii*ldm+jj]
+					}
+				}
+			}
+
+			// Update the relevant part of the trailing submatrix.
+			if j2 > 0 {
+				// Update A12.
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, jb, j2,
+					1, ab[kl+j*ldm+j:], ldm,
+					ab[kl+j*ldm+j+jb:], ldm)
+				if i2 > 0 {
+					// Update A22.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i2, j2, jb,
+						-1, ab[kl+(j+jb)*ldm+j:], ldm, ab[kl+j*ldm+j+jb:], ldm,
+						1, ab[kl+(j+jb)*ldm+j+jb:], ldm)
+				}
+				if i3 > 0 {
+					// Update A32.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i3, j2, jb,
+						-1, work31, ldwork, ab[kl+j*ldm+j+jb:], ldm,
+						1, ab[kl+(j+kl)*ldm+j+jb:], ldm)
+				}
+			}
+
+			if j3 > 0 {
+				// Copy the lower triangle of A13 into work13.
+				for jj := 0; jj < j3; jj++ {
+					for ii := jj; ii < jb; ii++ {
+						work13[ii*ldwork+jj] = ab[kl+(j+ii)*ldm+j+kv+jj]
+					}
+				}
+
+				// Update A13 in the work array.
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, jb, j3,
+					1, ab[kl+j*ldm+j:], ldm,
+					work13, ldwork)
+				if i2 > 0 {
+					// Update A23.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i2, j3, jb,
+						-1, ab[kl+(j+jb)*ldm+j:], ldm, work13, ldwork,
+						1, ab[kl+(j+jb)*ldm+j+kv:], ldm)
+				}
+				if i3 > 0 {
+					// Update A33.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i3, j3, jb,
+						-1, work31, ldwork, work13, ldwork,
+						1, ab[kl+(j+kl)*ldm+j+kv:], ldm)
+				}
+
+				// Copy the lower triangle of A13 back into place.
+				for jj := 0; jj < j3; jj++ {
+					for ii := jj; ii < jb; ii++ {
+						ab[kl+(j+ii)*ldm+j+kv+jj] = work13[ii*ldwork+jj]
+					}
+				}
+			}
+		} else {
+			// Adjust the pivot indices.
+			for i := j; i < j+jb; i++ {
+				ipiv[i] += j
+			}
+		}
+
+		// Partially undo the interchanges in the current block to restore
+		// the upper triangular form of A31 and copy the upper triangle of
+		// A31 back into place.
+		for jj := j + jb - 1; jj >= j; jj-- {
+			jp := ipiv[jj] - jj
+			if jp != 0 {
+				// Apply the interchange to columns j to jj-1.
+				if jp+jj < j+kl {
+					// The interchange does not affect A31.
+					bi.Dswap(jj-j, ab[kl+jj*ldm+j:], 1, ab[kl+(jj+jp)*ldm+j:], 1)
+				} else {
+					// The interchange does affect A31.
+					bi.Dswap(jj-j, ab[kl+jj*ldm+j:], 1, work31[(jp+jj-j-kl)*ldwork:], 1)
+				}
+			}
+
+			// Copy the current column of A31 back into place.
+			nw := min(i3, jj-j+1)
+			if nw > 0 {
+				bi.Dcopy(nw, work31[jj-j:], ldwork, ab[kl+(j+kl)*ldm+jj:], ldm)
+			}
+		}
+	}
+	return ok
+}
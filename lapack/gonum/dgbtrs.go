@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgbtrs solves a system of linear equations
+//  A * X = B   if trans == blas.NoTrans
+//  Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+// with an n×n band matrix A with kl sub-diagonals and ku super-diagonals using
+// the LU factorization computed by Dgbtrf. See the documentation for Dgbtrf for
+// a description of the band storage format of the factorization.
+//
+// ab and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Dgbtrf. ipiv is zero-indexed.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func (Implementation) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	// The element M[i,j] of the factorization is stored in ab[kl+i*ldm+j].
+	ldm := ldab - 1
+	// kv is the number of super-diagonals of U.
+	kv := ku + kl
+
+	bi := blas64.Implementation()
+	if trans == blas.NoTrans {
+		// Solve A*X = B.
+		if kl > 0 {
+			// Solve L*X = B, overwriting B with X.
+			//
+			// L is represented as a product of permutations and unit
+			// lower triangular matrices L = P_0 * L_0 * ... * P_{n-2} * L_{n-2},
+			// where each transformation L_j is a rank-one modification of
+			// the identity matrix.
+			for j := 0; j < n-1; j++ {
+				lm := min(kl, n-1-j)
+				l := ipiv[j]
+				if l != j {
+					bi.Dswap(nrhs, b[l*ldb:], 1, b[j*ldb:], 1)
+				}
+				bi.Dger(lm, nrhs, -1, ab[kl+(j+1)*ldm+j:], ldm, b[j*ldb:], 1, b[(j+1)*ldb:], ldb)
+			}
+		}
+		for i := 0; i < nrhs; i++ {
+			// Solve U*X = B, overwriting B with X.
+			bi.Dtbsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, kv, ab[kl:], ldab, b[i:], ldb)
+		}
+		return
+	}
+
+	// Solve Aᵀ*X = B.
+	for i := 0; i < nrhs; i++ {
+		// Solve Uᵀ*X = B, overwriting B with X.
+		bi.Dtbsv(blas.Upper, blas.Trans, blas.NonUnit, n, kv, ab[kl:], ldab, b[i:], ldb)
+	}
+	if kl > 0 {
+		// Solve Lᵀ*X = B, overwriting B with X.
+		for j := n - 2; j >= 0; j-- {
+			lm := min(kl, n-1-j)
+			bi.Dgemv(blas.Trans, lm, nrhs, -1, b[(j+1)*ldb:], ldb, ab[kl+(j+1)*ldm+j:], ldm, 1, b[j*ldb:], 1)
+			l := ipiv[j]
+			if l != j {
+				bi.Dswap(nrhs, b[l*ldb:], 1, b[j*ldb:], 1)
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dgeequ computes row and column scalings intended to equilibrate an m×n
+// matrix A and reduce its condition number. The scale factors are returned in
+// r and c so that the elements of the scaled matrix
+//  B[i,j] = r[i] * A[i,j] * c[j]
+// have an absolute value of at most 1, and the largest element in each row
+// and column of B has an absolute value of 1.
+//
+// The scale factors are not restricted to powers of the radix, so scaling by
+// them may introduce rounding errors.
+//
+// r must have length m and c must have length n, otherwise Dgeequ will panic.
+//
+// rowcnd is the ratio of the smallest r[i] to the largest r[i]. If rowcnd is
+// at least 0.1 and amax is neither too large nor too small, it is not worth
+// scaling by r. colcnd is the ratio of the smallest c[j] to the largest c[j].
+// If colcnd is at least 0.1, it is not worth scaling by c. amax is the
+// absolute value of the largest element of A. If amax is very close to
+// overflow or very close to underflow, the matrix should be scaled.
+//
+// Dgeequ returns whether all rows and columns of A are non-zero. If ok is
+// false, A has an exactly zero row or column, and rowcnd, colcnd and the
+// contents of r and c are not valid.
+func (impl Implementation) Dgeequ(m, n int, a []float64, lda int, r, c []float64) (rowcnd, colcnd, amax float64, ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, 1, 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(r) != m:
+		panic(shortR)
+	case len(c) != n:
+		panic(shortC)
+	}
+
+	const (
+		smlnum = dlamchS
+		bignum = 1 / smlnum
+	)
+
+	// Compute the row scale factors.
+	for i := 0; i < m; i++ {
+		var rmax float64
+		for _, v := range a[i*lda : i*lda+n] {
+			rmax = math.Max(rmax, math.Abs(v))
+		}
+		r[i] = rmax
+	}
+
+	// Find the maximum and minimum scale factors.
+	rcmin := bignum
+	var rcmax float64
+	for _, v := range r {
+		rcmax = math.Max(rcmax, v)
+		rcmin = math.Min(rcmin, v)
+	}
+	amax = rcmax
+
+	if rcmin == 0 {
+		// A has a zero row.
+		return 0, 0, amax, false
+	}
+	// Invert the scale factors.
+	for i, v := range r {
+		r[i] = 1 / math.Min(math.Max(v, smlnum), bignum)
+	}
+	// Compute rowcnd = min(r[i]) / max(r[i]).
+	rowcnd = math.Max(rcmin, smlnum) / math.Min(rcmax, bignum)
+
+	// Compute the column scale factors assuming that the row scaling has
+	// been applied.
+	for j := range c {
+		c[j] = 0
+	}
+	for i := 0; i < m; i++ {
+		ri := r[i]
+		for j, v := range a[i*lda : i*lda+n] {
+			c[j] = math.Max(c[j], math.Abs(v)*ri)
+		}
+	}
+
+	// Find the maximum and minimum scale factors.
+	rcmin = bignum
+	rcmax = 0
+	for _, v := range c {
+		rcmin = math.Min(rcmin, v)
+		rcmax = math.Max(rcmax, v)
+	}
+
+	if rcmin == 0 {
+		// A has a zero column.
+		return rowcnd, 0, amax, false
+	}
+	// Invert the scale factors.
+	for j, v := range c {
+		c[j] = 1 / math.Min(math.Max(v, smlnum), bignum)
+	}
+	// Compute colcnd = min(c[j]) / max(c[j]).
+	colcnd = math.Max(rcmin, smlnum) / math.Min(rcmax, bignum)
+
+	return rowcnd, colcnd, amax, true
+}
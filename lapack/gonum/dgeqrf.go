@@ -20,6 +20,9 @@ import (
 // by the temporary space available. If lwork == -1, instead of performing Dgeqrf,
 // the optimal work length will be stored into work[0].
 //
+// The trailing matrix is updated concurrently in column panels, using up to
+// the number of processors returned by Ilaenv.
+//
 // tau must have length at least min(m,n), and this function will panic otherwise.
 func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
@@ -80,6 +83,7 @@ func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []fl
 	var i int
 	if nbmin <= nb && nb < k && nx < k {
 		ldwork := nb
+		nproc := impl.Ilaenv(7, "DGEQRF", " ", m, n, -1, -1)
 		for i = 0; i < k-nx; i += nb {
 			ib := min(k-i, nb)
 			// Compute the QR factorization of the current block.
@@ -91,12 +95,18 @@ func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []fl
 					a[i*lda+i:], lda,
 					tau[i:],
 					work, ldwork)
-				impl.Dlarfb(blas.Left, blas.Trans, lapack.Forward, lapack.ColumnWise,
-					m-i, n-i-ib, ib,
-					a[i*lda+i:], lda,
-					work, ldwork,
-					a[i*lda+i+ib:], lda,
-					work[ib*ldwork:], ldwork)
+				// The columns of the trailing matrix are updated
+				// independently, so they are split into panels that
+				// are updated concurrently. Each panel uses its own
+				// rows of the workspace after T.
+				parallelColumns(n-i-ib, nproc, nb, func(bi blas.Float64, j, nj int) {
+					impl.dlarfb(bi, blas.Left, blas.Trans, lapack.Forward, lapack.ColumnWise,
+						m-i, nj, ib,
+						a[i*lda+i:], lda,
+						work, ldwork,
+						a[i*lda+i+ib+j:], lda,
+						work[(ib+j)*ldwork:], ldwork)
+				})
 			}
 		}
 	}
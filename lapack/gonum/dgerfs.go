@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgerfs improves the computed solution to a system of linear equations
+//  A * X = B   if trans == blas.NoTrans
+//  Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+// with an n×n general matrix A and provides error bounds and backward error
+// estimates for the solution.
+//
+// a contains the original matrix A. af and ipiv contain the LU factorization
+// of A and the permutation indices as computed by Dgetrf.
+//
+// b contains the n×nrhs right hand side matrix B. On entry, x contains the
+// solution matrix X as computed by Dgetrs. On return, it is overwritten with
+// the improved solution.
+//
+// ferr and berr must have length at least nrhs. On return, ferr[j] contains
+// an estimated error bound for the j-th column of X, that is, an upper bound
+// on the magnitude of the largest element in the difference between the j-th
+// column of X and the corresponding column of the true solution, divided by
+// the magnitude of the largest element of that column of X. The estimate is
+// almost always a slight overestimate of the true error. berr[j] contains the
+// componentwise relative backward error of the j-th column of X, that is, the
+// smallest relative change in any element of A or B that makes the column an
+// exact solution.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dgerfs will panic.
+func (impl Implementation) Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr []float64, work []float64, iwork []int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		for j := 0; j < nrhs; j++ {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// itmax is the maximum number of steps of iterative refinement.
+	const itmax = 5
+
+	transt := blas.Trans
+	if trans != blas.NoTrans {
+		transt = blas.NoTrans
+	}
+
+	// nz is the maximum number of nonzero entries in each row of A, plus 1.
+	nz := float64(n + 1)
+	const (
+		eps    = dlamchE
+		safmin = dlamchS
+	)
+	safe1 := nz * safmin
+	safe2 := safe1 / eps
+
+	var (
+		w     = work[:n]
+		r     = work[n : 2*n]
+		v     = work[2*n : 3*n]
+		isave [3]int
+	)
+	bi := blas64.Implementation()
+	// Do for each right hand side.
+	for j := 0; j < nrhs; j++ {
+		count := 1
+		lstres := 3.0
+		for {
+			// Loop until stopping criterion is satisfied.
+
+			// Compute residual R = B - op(A) * X, where op(A) = A or Aᵀ
+			// depending on trans.
+			bi.Dcopy(n, b[j:], ldb, r, 1)
+			bi.Dgemv(trans, n, n, -1, a, lda, x[j:], ldx, 1, r, 1)
+
+			// Compute componentwise relative backward error from formula
+			//  max(i) ( abs(R(i)) / ( abs(op(A))*abs(X) + abs(B) )(i) )
+			// where abs(Z) is the componentwise absolute value of the
+			// matrix or vector Z. If the i-th component of the
+			// denominator is less than safe2, then safe1 is added to the
+			// i-th components of the numerator and denominator before
+			// dividing.
+			for i := 0; i < n; i++ {
+				w[i] = math.Abs(b[i*ldb+j])
+			}
+			// Compute abs(op(A))*abs(X) + abs(B).
+			if trans == blas.NoTrans {
+				for i := 0; i < n; i++ {
+					var s float64
+					for k := 0; k < n; k++ {
+						s += math.Abs(a[i*lda+k]) * math.Abs(x[k*ldx+j])
+					}
+					w[i] += s
+				}
+			} else {
+				for i := 0; i < n; i++ {
+					xi := math.Abs(x[i*ldx+j])
+					for k := 0; k < n; k++ {
+						w[k] += math.Abs(a[i*lda+k]) * xi
+					}
+				}
+			}
+			var s float64
+			for i := 0; i < n; i++ {
+				if w[i] > safe2 {
+					s = math.Max(s, math.Abs(r[i])/w[i])
+				} else {
+					s = math.Max(s, (math.Abs(r[i])+safe1)/(w[i]+safe1))
+				}
+			}
+			berr[j] = s
+
+			// Test stopping criterion. Continue iterating if
+			//  1) The residual berr[j] is larger than machine epsilon, and
+			//  2) berr[j] decreased by at least a factor of 2 during the
+			//     last iteration, and
+			//  3) At most itmax iterations tried.
+			if berr[j] <= eps || 2*berr[j] > lstres || count > itmax {
+				break
+			}
+			// Update solution and try again.
+			impl.Dgetrs(trans, n, 1, af, ldaf, ipiv, r, 1)
+			bi.Daxpy(n, 1, r, 1, x[j:], ldx)
+			lstres = berr[j]
+			count++
+		}
+
+		// Bound error from formula
+		//  norm(X - XTRUE) / norm(X) .le. ferr =
+		//  norm( abs(inv(op(A)))*
+		//     ( abs(R) + nz*eps*( abs(op(A))*abs(X)+abs(B) ))) / norm(X)
+		// where
+		//   norm(Z) is the magnitude of the largest component of Z
+		//   inv(op(A)) is the inverse of op(A)
+		//   abs(Z) is the componentwise absolute value of the matrix or vector Z
+		//   nz is the maximum number of nonzeros in any row of A, plus 1
+		//   eps is machine epsilon
+		//
+		// The i-th component of abs(R)+nz*eps*(abs(op(A))*abs(X)+abs(B))
+		// is incremented by safe1 if the i-th component of
+		// abs(op(A))*abs(X) + abs(B) is less than safe2.
+		//
+		// Use Dlacn2 to estimate the infinity-norm of the matrix
+		//  inv(op(A)) * diag(W),
+		// where W = abs(R) + nz*eps*( abs(op(A))*abs(X)+abs(B) ).
+		for i := 0; i < n; i++ {
+			if w[i] > safe2 {
+				w[i] = math.Abs(r[i]) + nz*eps*w[i]
+			} else {
+				w[i] = math.Abs(r[i]) + nz*eps*w[i] + safe1
+			}
+		}
+		var kase int
+		for {
+			ferr[j], kase = impl.Dlacn2(n, v, r, iwork, ferr[j], kase, &isave)
+			if kase == 0 {
+				break
+			}
+			if kase == 1 {
+				// Multiply by diag(W)*inv(op(A)ᵀ).
+				impl.Dgetrs(transt, n, 1, af, ldaf, ipiv, r, 1)
+				for i := 0; i < n; i++ {
+					r[i] *= w[i]
+				}
+			} else {
+				// Multiply by inv(op(A))*diag(W).
+				for i := 0; i < n; i++ {
+					r[i] *= w[i]
+				}
+				impl.Dgetrs(trans, n, 1, af, ldaf, ipiv, r, 1)
+			}
+		}
+
+		// Normalize error.
+		var xmax float64
+		for i := 0; i < n; i++ {
+			xmax = math.Max(xmax, math.Abs(x[i*ldx+j]))
+		}
+		if xmax != 0 {
+			ferr[j] /= xmax
+		}
+	}
+}
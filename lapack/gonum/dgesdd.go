@@ -0,0 +1,598 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesdd computes the singular value decomposition of the input matrix A
+// using a divide and conquer method.
+//
+// The singular value decomposition is
+//  A = U * Sigma * Vᵀ
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// The divide and conquer method is much faster than the implicit QR iteration
+// used by Dgesvd when singular vectors are computed for large matrices, at
+// the cost of more workspace.
+//
+// jobz is the option for computing the singular vectors. The behavior is as
+// follows
+//  jobz == lapack.SVDAll    All m columns of U and all n rows of Vᵀ are returned in u and vt
+//  jobz == lapack.SVDStore  The first min(m,n) columns of U and rows of Vᵀ are returned in u and vt
+//  jobz == lapack.SVDNone   The columns of U and the rows of Vᵀ are not computed.
+// Dgesdd will panic if jobz == lapack.SVDOverwrite.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to
+// Dgesdd the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobz == lapack.SVDAll, u is of size m×m. If jobz == lapack.SVDStore, u is
+// of size m×min(m,n). If jobz == lapack.SVDNone, u is not used.
+//
+// vt contains the right singular vectors on exit, stored row-wise. If
+// jobz == lapack.SVDAll, vt is of size n×n. If jobz == lapack.SVDStore, vt is
+// of size min(m,n)×n. If jobz == lapack.SVDNone, vt is not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size
+// of the slice. With mn = min(m,n) and mx = max(m,n), lwork must be at least
+//  3*mn + max(mx, 7*mn)       if jobz == lapack.SVDNone,
+//  4*mn*mn + 7*mn             if jobz == lapack.SVDStore,
+//  4*mn*mn + 6*mn + mx        if jobz == lapack.SVDAll.
+// If lwork == -1, instead of performing Dgesdd, the optimal work length will
+// be stored into work[0]. Dgesdd will panic if the working memory has
+// insufficient storage.
+//
+// iwork is a slice for storing temporary integer memory and must have length
+// at least 8*min(m,n).
+//
+// Dgesdd returns whether the decomposition successfully completed.
+func (impl Implementation) Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool) {
+	if jobz == lapack.SVDOverwrite {
+		panic(noSVDO)
+	}
+
+	wntqa := jobz == lapack.SVDAll
+	wntqs := jobz == lapack.SVDStore
+	wntqas := wntqa || wntqs
+	wntqn := jobz == lapack.SVDNone
+
+	minmn := min(m, n)
+	maxmn := max(m, n)
+	var minwrk int
+	switch {
+	case minmn == 0:
+		minwrk = 1
+	case wntqn:
+		minwrk = 3*minmn + max(maxmn, 7*minmn)
+	case wntqs:
+		minwrk = 4*minmn*minmn + 7*minmn
+	default:
+		minwrk = 4*minmn*minmn + 6*minmn + maxmn
+	}
+	switch {
+	case !wntqas && !wntqn:
+		panic(badSVDJob)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < 1, wntqa && ldu < m, wntqs && ldu < minmn:
+		panic(badLdU)
+	case ldvt < 1, wntqas && ldvt < n:
+		panic(badLdVT)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// Compute the optimal workspace size. bdspac is the workspace needed by
+	// Dbdsdc.
+	mnthr := int(float64(minmn) * 11 / 6)
+	bdspac := 4 * minmn
+	if wntqas {
+		bdspac = 3*minmn*minmn + 4*minmn
+	}
+	var maxwrk int
+	if m >= n {
+		impl.Dgebrd(m, n, a, lda, nil, nil, nil, nil, work, -1)
+		lworkDgebrdMN := int(work[0])
+		impl.Dgebrd(n, n, a, lda, nil, nil, nil, nil, work, -1)
+		lworkDgebrdNN := int(work[0])
+		impl.Dgeqrf(m, n, a, lda, nil, work, -1)
+		lworkDgeqrf := int(work[0])
+		impl.Dorgqr(m, n, n, a, lda, nil, work, -1)
+		lworkDorgqrMN := int(work[0])
+		impl.Dorgqr(m, m, n, a, lda, nil, work, -1)
+		lworkDorgqrMM := int(work[0])
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, a, lda, nil, nil, n, work, -1)
+		lworkDormbrQLNNN := int(work[0])
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, n, n, a, lda, nil, nil, n, work, -1)
+		lworkDormbrQLNMN := int(work[0])
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, nil, nil, m, work, -1)
+		lworkDormbrQLNMM := int(work[0])
+		impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, nil, nil, n, work, -1)
+		lworkDormbrPRTNN := int(work[0])
+
+		if m >= mnthr {
+			// Path with a QR decomposition first (m >> n).
+			wrkbl := max(n+lworkDgeqrf, 3*n+lworkDgebrdNN)
+			switch {
+			case wntqn:
+				maxwrk = max(wrkbl, 3*n+bdspac)
+			case wntqs:
+				wrkbl = max(wrkbl, n+lworkDorgqrMN)
+				wrkbl = max(wrkbl, 3*n+lworkDormbrQLNNN)
+				wrkbl = max(wrkbl, 3*n+lworkDormbrPRTNN)
+				wrkbl = max(wrkbl, 3*n+bdspac)
+				maxwrk = n*n + wrkbl
+			default:
+				wrkbl = max(wrkbl, n+lworkDorgqrMM)
+				wrkbl = max(wrkbl, 3*n+lworkDormbrQLNNN)
+				wrkbl = max(wrkbl, 3*n+lworkDormbrPRTNN)
+				wrkbl = max(wrkbl, 3*n+bdspac)
+				maxwrk = n*n + wrkbl
+			}
+		} else {
+			// Path with a direct bidiagonalization (m at least n, but not
+			// much larger).
+			wrkbl := 3*n + lworkDgebrdMN
+			switch {
+			case wntqn:
+				maxwrk = max(wrkbl, 3*n+bdspac)
+			case wntqs:
+				maxwrk = max(wrkbl, 3*n+lworkDormbrQLNMN)
+				maxwrk = max(maxwrk, 3*n+lworkDormbrPRTNN)
+				maxwrk = max(maxwrk, 3*n+bdspac)
+			default:
+				maxwrk = max(wrkbl, 3*n+lworkDormbrQLNMM)
+				maxwrk = max(maxwrk, 3*n+lworkDormbrPRTNN)
+				maxwrk = max(maxwrk, 3*n+bdspac)
+			}
+		}
+	} else {
+		impl.Dgebrd(m, n, a, lda, nil, nil, nil, nil, work, -1)
+		lworkDgebrdMN := int(work[0])
+		impl.Dgebrd(m, m, a, lda, nil, nil, nil, nil, work, -1)
+		lworkDgebrdMM := int(work[0])
+		impl.Dgelqf(m, n, a, lda, nil, work, -1)
+		lworkDgelqf := int(work[0])
+		impl.Dorglq(m, n, m, a, lda, nil, work, -1)
+		lworkDorglqMN := int(work[0])
+		impl.Dorglq(n, n, m, a, lda, nil, work, -1)
+		lworkDorglqNN := int(work[0])
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, a, lda, nil, nil, m, work, -1)
+		lworkDormbrQLNMM := int(work[0])
+		impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, a, lda, nil, nil, m, work, -1)
+		lworkDormbrPRTMM := int(work[0])
+		impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, n, m, a, lda, nil, nil, n, work, -1)
+		lworkDormbrPRTMN := int(work[0])
+		impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, m, a, lda, nil, nil, n, work, -1)
+		lworkDormbrPRTNN := int(work[0])
+
+		if n >= mnthr {
+			// Path with an LQ decomposition first (n >> m).
+			wrkbl := max(m+lworkDgelqf, 3*m+lworkDgebrdMM)
+			switch {
+			case wntqn:
+				maxwrk = max(wrkbl, 3*m+bdspac)
+			case wntqs:
+				wrkbl = max(wrkbl, m+lworkDorglqMN)
+				wrkbl = max(wrkbl, 3*m+lworkDormbrQLNMM)
+				wrkbl = max(wrkbl, 3*m+lworkDormbrPRTMM)
+				wrkbl = max(wrkbl, 3*m+bdspac)
+				maxwrk = m*m + wrkbl
+			default:
+				wrkbl = max(wrkbl, m+lworkDorglqNN)
+				wrkbl = max(wrkbl, 3*m+lworkDormbrQLNMM)
+				wrkbl = max(wrkbl, 3*m+lworkDormbrPRTMM)
+				wrkbl = max(wrkbl, 3*m+bdspac)
+				maxwrk = m*m + wrkbl
+			}
+		} else {
+			// Path with a direct bidiagonalization (n greater than m, but
+			// not much larger).
+			wrkbl := 3*m + lworkDgebrdMN
+			switch {
+			case wntqn:
+				maxwrk = max(wrkbl, 3*m+bdspac)
+			case wntqs:
+				maxwrk = max(wrkbl, 3*m+lworkDormbrQLNMM)
+				maxwrk = max(maxwrk, 3*m+lworkDormbrPRTMN)
+				maxwrk = max(maxwrk, 3*m+bdspac)
+			default:
+				maxwrk = max(wrkbl, 3*m+lworkDormbrQLNMM)
+				maxwrk = max(maxwrk, 3*m+lworkDormbrPRTNN)
+				maxwrk = max(maxwrk, 3*m+bdspac)
+			}
+		}
+	}
+	maxwrk = max(maxwrk, minwrk)
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case wntqa && len(u) < (m-1)*ldu+m, wntqs && len(u) < (m-1)*ldu+minmn:
+		panic(shortU)
+	case wntqa && len(vt) < (n-1)*ldvt+n, wntqs && len(vt) < (minmn-1)*ldvt+n:
+		panic(shortVT)
+	case len(iwork) < 8*minmn:
+		panic(shortIWork)
+	}
+
+	// Scale A if max element outside range [smlnum, bignum].
+	eps := dlamchE
+	smlnum := math.Sqrt(dlamchS) / eps
+	bignum := 1 / smlnum
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iscl bool
+	if anrm > 0 && anrm < smlnum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+	} else if anrm > bignum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	}
+
+	bi := blas64.Implementation()
+	if m >= n {
+		if m >= mnthr {
+			// If A has sufficiently more rows than columns, first reduce
+			// using the QR decomposition.
+			switch {
+			case wntqn:
+				// Only singular values are desired.
+				itau := 0
+				nwork := itau + n
+
+				// Compute A = Q*R.
+				impl.Dgeqrf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Zero out below R.
+				if n > 1 {
+					impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+				}
+				ie := 0
+				itauq := ie + n
+				itaup := itauq + n
+				nwork = itaup + n
+
+				// Bidiagonalize R in A.
+				impl.Dgebrd(n, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+				nwork = ie + n
+
+				// Perform bidiagonal SVD, computing singular values only.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompNone, n, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+
+			case wntqs:
+				// The first n columns of U and the first n rows of Vᵀ are
+				// desired. Work in R stored in work[ir:].
+				ir := 0
+				ldwrkr := n
+				itau := ir + ldwrkr*n
+				nwork := itau + n
+
+				// Compute A = Q*R.
+				impl.Dgeqrf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Copy R to work[ir:], zeroing out below it.
+				impl.Dlacpy(blas.Upper, n, n, a, lda, work[ir:], ldwrkr)
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, work[ir+ldwrkr:], ldwrkr)
+
+				// Generate Q in A.
+				impl.Dorgqr(m, n, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				ie := itau
+				itauq := ie + n
+				itaup := itauq + n
+				nwork = itaup + n
+
+				// Bidiagonalize R in work[ir:].
+				impl.Dgebrd(n, n, work[ir:], ldwrkr, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in u and computing right singular
+				// vectors of bidiagonal matrix in vt.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+
+				// Overwrite u by the left singular vectors of R and vt by
+				// the right singular vectors of R.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, work[ir:], ldwrkr, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, work[ir:], ldwrkr, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+				// Multiply Q in A by the left singular vectors of R in
+				// work[ir:], storing the result in u.
+				impl.Dlacpy(blas.All, n, n, u, ldu, work[ir:], ldwrkr)
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, a, lda, work[ir:], ldwrkr, 0, u, ldu)
+
+			default:
+				// All m columns of U and all n rows of Vᵀ are desired.
+				// Work in the left singular vectors of R stored in
+				// work[iu:].
+				iu := 0
+				ldwrku := n
+				itau := iu + ldwrku*n
+				nwork := itau + n
+
+				// Compute A = Q*R, copying the result to u.
+				impl.Dgeqrf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				impl.Dlacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+				// Generate Q in u.
+				impl.Dorgqr(m, m, n, u, ldu, work[itau:], work[nwork:], lwork-nwork)
+
+				// Produce R in A, zeroing out other entries.
+				if n > 1 {
+					impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+				}
+				ie := itau
+				itauq := ie + n
+				itaup := itauq + n
+				nwork = itaup + n
+
+				// Bidiagonalize R in A.
+				impl.Dgebrd(n, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in work[iu:] and computing right
+				// singular vectors of bidiagonal matrix in vt.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], work[iu:], ldwrku, vt, ldvt, work[nwork:], iwork)
+
+				// Overwrite work[iu:] by the left singular vectors of R and
+				// vt by the right singular vectors of R.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, a, lda, work[itauq:], work[iu:], ldwrku, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+				// Multiply Q in u by the left singular vectors of R in
+				// work[iu:], storing the result in A, and copy it to the
+				// first n columns of u.
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, u, ldu, work[iu:], ldwrku, 0, a, lda)
+				impl.Dlacpy(blas.All, m, n, a, lda, u, ldu)
+			}
+		} else {
+			// m at least n, but not much larger. Reduce to bidiagonal form
+			// without the QR decomposition.
+			ie := 0
+			itauq := ie + n
+			itaup := itauq + n
+			nwork := itaup + n
+
+			// Bidiagonalize A.
+			impl.Dgebrd(m, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+			switch {
+			case wntqn:
+				// Perform bidiagonal SVD, only computing singular values.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompNone, n, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+
+			case wntqs:
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in u and computing right singular
+				// vectors of bidiagonal matrix in vt.
+				impl.Dlaset(blas.All, m, n, 0, 0, u, ldu)
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+
+				// Overwrite u by the left singular vectors of A and vt by
+				// the right singular vectors of A.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, n, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+			default:
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in u and computing right singular
+				// vectors of bidiagonal matrix in vt.
+				impl.Dlaset(blas.All, m, m, 0, 0, u, ldu)
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+
+				// Set the right corner of u to identity matrix.
+				if m > n {
+					impl.Dlaset(blas.All, m-n, m-n, 0, 1, u[n*ldu+n:], ldu)
+				}
+
+				// Overwrite u by the left singular vectors of A and vt by
+				// the right singular vectors of A.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+			}
+		}
+	} else {
+		if n >= mnthr {
+			// If A has sufficiently more columns than rows, first reduce
+			// using the LQ decomposition.
+			switch {
+			case wntqn:
+				// Only singular values are desired.
+				itau := 0
+				nwork := itau + m
+
+				// Compute A = L*Q.
+				impl.Dgelqf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Zero out above L.
+				if m > 1 {
+					impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+				}
+				ie := 0
+				itauq := ie + m
+				itaup := itauq + m
+				nwork = itaup + m
+
+				// Bidiagonalize L in A.
+				impl.Dgebrd(m, m, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+				nwork = ie + m
+
+				// Perform bidiagonal SVD, computing singular values only.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompNone, m, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+
+			case wntqs:
+				// The first m columns of U and the first m rows of Vᵀ are
+				// desired. Work in L stored in work[il:].
+				il := 0
+				ldwrkl := m
+				itau := il + ldwrkl*m
+				nwork := itau + m
+
+				// Compute A = L*Q.
+				impl.Dgelqf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Copy L to work[il:], zeroing out above it.
+				impl.Dlacpy(blas.Lower, m, m, a, lda, work[il:], ldwrkl)
+				impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, work[il+1:], ldwrkl)
+
+				// Generate Q in A.
+				impl.Dorglq(m, n, m, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				ie := itau
+				itauq := ie + m
+				itaup := itauq + m
+				nwork = itaup + m
+
+				// Bidiagonalize L in work[il:].
+				impl.Dgebrd(m, m, work[il:], ldwrkl, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in u and computing right singular
+				// vectors of bidiagonal matrix in vt.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, m, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+
+				// Overwrite u by the left singular vectors of L and vt by
+				// the right singular vectors of L.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, work[il:], ldwrkl, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, work[il:], ldwrkl, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+				// Multiply the right singular vectors of L in work[il:] by
+				// Q in A, storing the result in vt.
+				impl.Dlacpy(blas.All, m, m, vt, ldvt, work[il:], ldwrkl)
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, m, 1, work[il:], ldwrkl, a, lda, 0, vt, ldvt)
+
+			default:
+				// All m columns of U and all n rows of Vᵀ are desired.
+				// Work in the right singular vectors of L stored in
+				// work[ivt:].
+				ivt := 0
+				ldwkvt := m
+				itau := ivt + ldwkvt*m
+				nwork := itau + m
+
+				// Compute A = L*Q, copying the result to vt.
+				impl.Dgelqf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				impl.Dlacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+				// Generate Q in vt.
+				impl.Dorglq(n, n, m, vt, ldvt, work[itau:], work[nwork:], lwork-nwork)
+
+				// Produce L in A, zeroing out other entries.
+				if m > 1 {
+					impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+				}
+				ie := itau
+				itauq := ie + m
+				itaup := itauq + m
+				nwork = itaup + m
+
+				// Bidiagonalize L in A.
+				impl.Dgebrd(m, m, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in u and computing right singular
+				// vectors of bidiagonal matrix in work[ivt:].
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, m, s, work[ie:], u, ldu, work[ivt:], ldwkvt, work[nwork:], iwork)
+
+				// Overwrite u by the left singular vectors of L and
+				// work[ivt:] by the right singular vectors of L.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, a, lda, work[itaup:], work[ivt:], ldwkvt, work[nwork:], lwork-nwork)
+
+				// Multiply the right singular vectors of L in work[ivt:] by
+				// Q in vt, storing the result in A, and copy it to the
+				// first m rows of vt.
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, m, 1, work[ivt:], ldwkvt, vt, ldvt, 0, a, lda)
+				impl.Dlacpy(blas.All, m, n, a, lda, vt, ldvt)
+			}
+		} else {
+			// n greater than m, but not much larger. Reduce to bidiagonal
+			// form without the LQ decomposition.
+			ie := 0
+			itauq := ie + m
+			itaup := itauq + m
+			nwork := itaup + m
+
+			// Bidiagonalize A.
+			impl.Dgebrd(m, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+			switch {
+			case wntqn:
+				// Perform bidiagonal SVD, only computing singular values.
+				ok = impl.Dbdsdc(blas.Lower, lapack.BDCompNone, m, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+
+			case wntqs:
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in u and computing right singular
+				// vectors of bidiagonal matrix in vt.
+				impl.Dlaset(blas.All, m, n, 0, 0, vt, ldvt)
+				ok = impl.Dbdsdc(blas.Lower, lapack.BDCompute, m, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+
+				// Overwrite u by the left singular vectors of A and vt by
+				// the right singular vectors of A.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, n, m, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+			default:
+				// Perform bidiagonal SVD, computing left singular vectors
+				// of bidiagonal matrix in u and computing right singular
+				// vectors of bidiagonal matrix in vt.
+				impl.Dlaset(blas.All, n, n, 0, 0, vt, ldvt)
+				ok = impl.Dbdsdc(blas.Lower, lapack.BDCompute, m, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+
+				// Set the right corner of vt to identity matrix.
+				if n > m {
+					impl.Dlaset(blas.All, n-m, n-m, 0, 1, vt[m*ldvt+m:], ldvt)
+				}
+
+				// Overwrite u by the left singular vectors of A and vt by
+				// the right singular vectors of A.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, m, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+			}
+		}
+	}
+
+	// Undo scaling if necessary.
+	if iscl {
+		if anrm > bignum {
+			impl.Dlascl(lapack.General, 0, 0, bignum, anrm, minmn, 1, s, 1)
+		}
+		if anrm < smlnum {
+			impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, minmn, 1, s, 1)
+		}
+	}
+
+	work[0] = float64(maxwrk)
+	return ok
+}
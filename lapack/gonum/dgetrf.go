@@ -20,7 +20,9 @@ import (
 // changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
 // otherwise. ipiv is zero-indexed.
 //
-// Dgetrf is the blocked version of the algorithm.
+// Dgetrf is the blocked version of the algorithm. The panels of nb columns are
+// factorized by the recursive algorithm in Dgetrf2, and the trailing matrix is
+// updated with Level 3 BLAS routines.
 //
 // Dgetrf returns whether the matrix A is singular. The LU decomposition will
 // be computed regardless of the singularity of A, but division by zero
@@ -53,13 +55,13 @@ func (impl Implementation) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (o
 
 	nb := impl.Ilaenv(1, "DGETRF", " ", m, n, -1, -1)
 	if nb <= 1 || mn <= nb {
-		// Use the unblocked algorithm.
-		return impl.Dgetf2(m, n, a, lda, ipiv)
+		// Use the recursive algorithm for the whole matrix.
+		return impl.Dgetrf2(m, n, a, lda, ipiv)
 	}
 	ok = true
 	for j := 0; j < mn; j += nb {
 		jb := min(mn-j, nb)
-		blockOk := impl.Dgetf2(m-j, jb, a[j*lda+j:], lda, ipiv[j:j+jb])
+		blockOk := impl.Dgetrf2(m-j, jb, a[j*lda+j:], lda, ipiv[j:j+jb])
 		if !blockOk {
 			ok = false
 		}
@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgetrf2 computes the LU decomposition of the m×n matrix A.
+// The LU decomposition is a factorization of A into
+//  A = P * L * U
+// where P is a permutation matrix, L is a unit lower triangular matrix, and
+// U is a (usually) non-unit upper triangular matrix. On exit, L and U are stored
+// in place into a.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// changed with ipiv[i]. ipiv must have length min(m,n), and Dgetrf2 will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Dgetrf2 is the recursive version of the algorithm. It divides the matrix
+// into two halves by columns
+//  [ A11 | A12 ]
+//  [ ----|---- ]
+//  [ A21 | A22 ]
+// where A11 is n1×n1 with n1 = min(m,n)/2, factors the left half recursively,
+// updates the right half and factors the trailing block A22 recursively. Most
+// of the computation is performed by Level 3 BLAS routines, so Dgetrf2 is
+// efficient for the tall and narrow panels factorized by Dgetrf.
+//
+// Dgetrf2 returns whether the matrix A is singular. The LU decomposition will
+// be computed regardless of the singularity of A, but division by zero
+// will occur if the false is returned and the result is used to solve a
+// system of equations.
+//
+// Dgetrf2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dgetrf2(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	bi := blas64.Implementation()
+
+	if m == 1 {
+		// Use the unblocked code for one row case.
+		ipiv[0] = 0
+		return a[0] != 0
+	}
+
+	if n == 1 {
+		// Use the unblocked code for one column case.
+
+		// Find a pivot and test for singularity.
+		i := bi.Idamax(m, a, lda)
+		ipiv[0] = i
+		if a[i*lda] == 0 {
+			return false
+		}
+		// Swap the rows if necessary.
+		if i != 0 {
+			a[0], a[i*lda] = a[i*lda], a[0]
+		}
+		// Compute the elements of the column below the diagonal.
+		if math.Abs(a[0]) >= dlamchS {
+			bi.Dscal(m-1, 1/a[0], a[lda:], lda)
+		} else {
+			for i := 1; i < m; i++ {
+				a[i*lda] /= a[0]
+			}
+		}
+		return true
+	}
+
+	n1 := mn / 2
+	n2 := n - n1
+
+	// Factor the left half
+	//  [ A11 ]
+	//  [ --- ]
+	//  [ A21 ]
+	ok = impl.Dgetrf2(m, n1, a, lda, ipiv[:n1])
+
+	// Apply the interchanges to the right half
+	//  [ A12 ]
+	//  [ --- ]
+	//  [ A22 ]
+	impl.Dlaswp(n2, a[n1:], lda, 0, n1-1, ipiv[:n1], 1)
+
+	// Compute A12.
+	bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, n1, n2,
+		1, a, lda,
+		a[n1:], lda)
+
+	// Update A22.
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, m-n1, n2, n1,
+		-1, a[n1*lda:], lda, a[n1:], lda,
+		1, a[n1*lda+n1:], lda)
+
+	// Factor A22.
+	if !impl.Dgetrf2(m-n1, n2, a[n1*lda+n1:], lda, ipiv[n1:mn]) {
+		ok = false
+	}
+
+	// Adjust the pivot indices and apply the interchanges to A21.
+	for i := n1; i < mn; i++ {
+		ipiv[i] += n1
+	}
+	impl.Dlaswp(n1, a, lda, n1, mn-1, ipiv[:mn], 1)
+	return ok
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dggbak forms the right or left eigenvectors of a real generalized
+// eigenvalue problem A*x = λ*B*x by backward transformation on the computed
+// eigenvectors of the balanced pair of matrices output by Dggbal. It updates
+// an n×m matrix V as
+//  V = Pr Dr V  if side == lapack.EVRight,
+//  V = Pl Dl V  if side == lapack.EVLeft,
+// where Pr, Pl, Dr and Dl are the n×n permutation and scaling matrices
+// implicitly represented by job, rscale, lscale, ilo and ihi as returned by
+// Dggbal.
+//
+// Dggbak is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggbak(job lapack.BalanceJob, side lapack.EVSide, n, ilo, ihi int, lscale, rscale []float64, m int, v []float64, ldv int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
+		panic(badBalanceJob)
+	case side != lapack.EVLeft && side != lapack.EVRight:
+		panic(badEVSide)
+	case n < 0:
+		panic(nLT0)
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(badIlo)
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(badIhi)
+	case m < 0:
+		panic(mLT0)
+	case ldv < max(1, m):
+		panic(badLdV)
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 || job == lapack.BalanceNone {
+		return
+	}
+
+	switch {
+	case len(lscale) < n:
+		panic(shortScale)
+	case len(rscale) < n:
+		panic(shortScale)
+	case len(v) < (n-1)*ldv+m:
+		panic(shortV)
+	}
+
+	scale := rscale
+	if side == lapack.EVLeft {
+		scale = lscale
+	}
+
+	bi := blas64.Implementation()
+	if ilo != ihi && job != lapack.Permute {
+		// Backward balance.
+		for i := ilo; i <= ihi; i++ {
+			bi.Dscal(m, scale[i], v[i*ldv:], 1)
+		}
+	}
+	if job == lapack.Scale {
+		return
+	}
+	// Backward permutation.
+	for i := ilo - 1; i >= 0; i-- {
+		k := int(scale[i])
+		if k == i {
+			continue
+		}
+		bi.Dswap(m, v[i*ldv:], 1, v[k*ldv:], 1)
+	}
+	for i := ihi + 1; i < n; i++ {
+		k := int(scale[i])
+		if k == i {
+			continue
+		}
+		bi.Dswap(m, v[i*ldv:], 1, v[k*ldv:], 1)
+	}
+}
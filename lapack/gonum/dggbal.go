@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dggbal permutes a pair of n×n matrices (A,B) to isolate eigenvalues of the
+// generalized eigenvalue problem A*x = λ*B*x if possible.
+//
+// Permuting consists of applying permutation matrices Pl and Pr such that the
+// matrices that result from Pl*A*Pr and Pl*B*Pr both take the upper block
+// triangular form
+//  [ T1  X  Y  ]
+//  [  0  C  Z  ]
+//  [  0  0  T2 ]
+// where T1 and T2 are upper triangular matrices. The indices ilo and ihi mark
+// the starting and ending columns of the submatrix C. The eigenvalues of the
+// pencil isolated in the first 0 to ilo-1 and last ihi+1 to n-1 elements on
+// the diagonal can be read off without any roundoff error.
+//
+// If job is lapack.BalanceNone, Dggbal sets lscale[i] = rscale[i] = 1 for
+// all i and returns ilo=0, ihi=n-1.
+// If job is lapack.Permute, permuting will be done.
+// Scaling is not implemented, and for other values of job Dggbal will panic.
+//
+// On return, lscale and rscale will contain information about the row and
+// column permutations applied to A and B. If πl(j) and πr(j) denote the
+// indices of the rows and columns interchanged with row and column j,
+// respectively, then
+//  lscale[j] == πl(j), rscale[j] == πr(j), for j ∈ {0, ..., ilo-1, ihi+1, ..., n-1},
+//  lscale[j] == rscale[j] == 1,            for j ∈ {ilo, ..., ihi}.
+// lscale and rscale must have length n, otherwise Dggbal will panic.
+//
+// Dggbal is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggbal(job lapack.BalanceJob, n int, a []float64, lda int, b []float64, ldb int, lscale, rscale []float64) (ilo, ihi int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute:
+		panic(badBalanceJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	}
+
+	ilo = 0
+	ihi = n - 1
+
+	// Quick return if possible.
+	if n == 0 {
+		return ilo, ihi
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(lscale) != n:
+		panic(shortScale)
+	case len(rscale) != n:
+		panic(shortScale)
+	}
+
+	if job == lapack.BalanceNone {
+		for i := range lscale {
+			lscale[i] = 1
+			rscale[i] = 1
+		}
+		return ilo, ihi
+	}
+
+	bi := blas64.Implementation()
+
+	// Search for rows isolating an eigenvalue and push them down.
+	swapped := true
+	for swapped && ihi > 0 {
+		swapped = false
+	rows:
+		for i := ihi; i >= 0; i-- {
+			// Find the single nonzero column jp of row i in the
+			// block [0:ihi+1] of A and B. If the row is zero, use
+			// jp = ihi.
+			jp := -1
+			for j := 0; j <= ihi; j++ {
+				if a[i*lda+j] != 0 || b[i*ldb+j] != 0 {
+					if jp >= 0 {
+						continue rows
+					}
+					jp = j
+				}
+			}
+			if jp < 0 {
+				jp = ihi
+			}
+			lscale[ihi] = float64(i)
+			if i != ihi {
+				bi.Dswap(n, a[i*lda:], 1, a[ihi*lda:], 1)
+				bi.Dswap(n, b[i*ldb:], 1, b[ihi*ldb:], 1)
+			}
+			rscale[ihi] = float64(jp)
+			if jp != ihi {
+				bi.Dswap(ihi+1, a[jp:], lda, a[ihi:], lda)
+				bi.Dswap(ihi+1, b[jp:], ldb, b[ihi:], ldb)
+			}
+			ihi--
+			swapped = true
+			break
+		}
+	}
+
+	// Search for columns isolating an eigenvalue and push them left.
+	swapped = true
+	for swapped && ilo < ihi {
+		swapped = false
+	columns:
+		for j := ilo; j <= ihi; j++ {
+			// Find the single nonzero row ip of column j in the block
+			// [ilo:ihi+1] of A and B. If the column is zero, use
+			// ip = ihi.
+			ip := -1
+			for i := ilo; i <= ihi; i++ {
+				if a[i*lda+j] != 0 || b[i*ldb+j] != 0 {
+					if ip >= 0 {
+						continue columns
+					}
+					ip = i
+				}
+			}
+			if ip < 0 {
+				ip = ihi
+			}
+			lscale[ilo] = float64(ip)
+			if ip != ilo {
+				bi.Dswap(n-ilo, a[ip*lda+ilo:], 1, a[ilo*lda+ilo:], 1)
+				bi.Dswap(n-ilo, b[ip*ldb+ilo:], 1, b[ilo*ldb+ilo:], 1)
+			}
+			rscale[ilo] = float64(j)
+			if j != ilo {
+				bi.Dswap(ihi+1, a[j:], lda, a[ilo:], lda)
+				bi.Dswap(ihi+1, b[j:], ldb, b[ilo:], ldb)
+			}
+			ilo++
+			swapped = true
+			break
+		}
+	}
+
+	for i := ilo; i <= ihi; i++ {
+		lscale[i] = 1
+		rscale[i] = 1
+	}
+	return ilo, ihi
+}
@@ -0,0 +1,298 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dggev computes for a pair of n×n real nonsymmetric matrices (A,B) the
+// generalized eigenvalues and, optionally, the left and/or right generalized
+// eigenvectors.
+//
+// A generalized eigenvalue for a pair of matrices (A,B) is a scalar λ or a
+// ratio α/β = λ, such that A - λ*B is singular. It is usually represented as
+// the pair (α,β), as there is a reasonable interpretation for β == 0, and
+// even for both being zero.
+//
+// The right eigenvector v_j corresponding to the eigenvalue λ_j of (A,B)
+// satisfies
+//  A * v_j = λ_j * B * v_j,
+// and the left eigenvector u_j corresponding to the eigenvalue λ_j of (A,B)
+// satisfies
+//  u_jᴴ * A = λ_j * u_jᴴ * B,
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, A and B will be overwritten and the left and right eigenvectors
+// will be stored, respectively, in the columns of the n×n matrices VL and VR
+// in the same order as their eigenvalues. If the j-th eigenvalue is real, then
+//  u_j = VL[:,j],
+//  v_j = VR[:,j],
+// and if it is not real, then j and j+1 form a complex conjugate pair and the
+// eigenvectors can be recovered as
+//  u_j     = VL[:,j] + i*VL[:,j+1],
+//  u_{j+1} = VL[:,j] - i*VL[:,j+1],
+//  v_j     = VR[:,j] + i*VR[:,j+1],
+//  v_{j+1} = VR[:,j] - i*VR[:,j+1],
+// where i is the imaginary unit. Each eigenvector is scaled so the largest
+// component has |real part| + |imag. part| == 1.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Dggev will panic.
+//
+// On return, the generalized eigenvalues are
+//  (alphar[j] + i*alphai[j]) / beta[j],  j = 0, ..., n-1.
+// If alphai[j] is zero, the j-th eigenvalue is real. If it is positive, the
+// j-th and (j+1)-st eigenvalues are a complex conjugate pair, with
+// alphai[j+1] negative. beta[j] is non-negative.
+//
+// Note that the quotients alphar[j]/beta[j] and alphai[j]/beta[j] may easily
+// over- or underflow, and beta[j] may even be zero. Thus, the user should
+// avoid naively computing the ratio α/β. However, alphar and alphai will be
+// always less than and usually comparable with norm(A) in magnitude, and beta
+// always less than and usually comparable with norm(B).
+//
+// alphar, alphai and beta must have length n, otherwise Dggev will panic.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n),
+// otherwise Dggev will panic. For good performance, lwork must generally be
+// larger. On return, the optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Dggev, the function only calculates
+// the optimal value of lwork and stores it into work[0].
+//
+// Dggev returns whether the computation was successful. If ok is false, the
+// QZ iteration failed to compute all the eigenvalues or the computation of the
+// eigenvectors failed, and the contents of alphar, alphai, beta, vl and vr
+// are unspecified.
+func (impl Implementation) Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	minwrk := max(1, 8*n)
+	switch {
+	case !wantvl && jobvl != lapack.LeftEVNone:
+		panic(badLeftEVJob)
+	case !wantvr && jobvr != lapack.RightEVNone:
+		panic(badRightEVJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldvl < 1 || (ldvl < n && wantvl):
+		panic(badLdVL)
+	case ldvr < 1 || (ldvr < n && wantvr):
+		panic(badLdVR)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	maxwrk := n * (7 + impl.Ilaenv(1, "DGEQRF", " ", n, 1, n, 0))
+	maxwrk = max(maxwrk, n*(7+impl.Ilaenv(1, "DORMQR", " ", n, 1, n, 0)))
+	if wantvl {
+		maxwrk = max(maxwrk, n*(7+impl.Ilaenv(1, "DORGQR", " ", n, 1, n, -1)))
+	}
+	maxwrk = max(maxwrk, minwrk)
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(alphar) != n:
+		panic(badLenAlphar)
+	case len(alphai) != n:
+		panic(badLenAlphai)
+	case len(beta) != n:
+		panic(badLenBeta)
+	case wantvl && len(vl) < (n-1)*ldvl+n:
+		panic(shortVL)
+	case wantvr && len(vr) < (n-1)*ldvr+n:
+		panic(shortVR)
+	}
+
+	// Get machine constants.
+	smlnum := math.Sqrt(dlamchS) / dlamchP
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, n, n, a, lda, nil)
+	var scalea bool
+	var anrmto float64
+	if 0 < anrm && anrm < smlnum {
+		scalea = true
+		anrmto = smlnum
+	} else if anrm > bignum {
+		scalea = true
+		anrmto = bignum
+	}
+	if scalea {
+		impl.Dlascl(lapack.General, 0, 0, anrm, anrmto, n, n, a, lda)
+	}
+
+	// Scale B if max element outside range [smlnum,bignum].
+	bnrm := impl.Dlange(lapack.MaxAbs, n, n, b, ldb, nil)
+	var scaleb bool
+	var bnrmto float64
+	if 0 < bnrm && bnrm < smlnum {
+		scaleb = true
+		bnrmto = smlnum
+	} else if bnrm > bignum {
+		scaleb = true
+		bnrmto = bignum
+	}
+	if scaleb {
+		impl.Dlascl(lapack.General, 0, 0, bnrm, bnrmto, n, n, b, ldb)
+	}
+
+	// Permute the matrices A and B to isolate eigenvalues if possible.
+	lscale := work[:n]
+	rscale := work[n : 2*n]
+	ilo, ihi := impl.Dggbal(lapack.Permute, n, a, lda, b, ldb, lscale, rscale)
+
+	// Reduce B to triangular form using its QR factorization.
+	wantv := wantvl || wantvr
+	irows := ihi + 1 - ilo
+	icols := irows
+	if wantv {
+		icols = n - ilo
+	}
+	tau := work[2*n : 2*n+irows]
+	iwrk := 2*n + irows
+	impl.Dgeqrf(irows, icols, b[ilo*ldb+ilo:], ldb, tau, work[iwrk:], lwork-iwrk)
+
+	// Apply the orthogonal transformation to A.
+	impl.Dormqr(blas.Left, blas.Trans, irows, icols, irows, b[ilo*ldb+ilo:], ldb, tau,
+		a[ilo*lda+ilo:], lda, work[iwrk:], lwork-iwrk)
+
+	// Initialize VL.
+	if wantvl {
+		impl.Dlaset(blas.All, n, n, 0, 1, vl, ldvl)
+		if irows > 1 {
+			impl.Dlacpy(blas.Lower, irows-1, irows-1, b[(ilo+1)*ldb+ilo:], ldb, vl[(ilo+1)*ldvl+ilo:], ldvl)
+		}
+		impl.Dorgqr(irows, irows, irows, vl[ilo*ldvl+ilo:], ldvl, tau, work[iwrk:], lwork-iwrk)
+	}
+
+	// Initialize VR.
+	if wantvr {
+		impl.Dlaset(blas.All, n, n, 0, 1, vr, ldvr)
+	}
+
+	// Reduce to generalized Hessenberg form.
+	compq := lapack.SchurNone
+	if wantvl {
+		compq = lapack.SchurOrig
+	}
+	compz := lapack.SchurNone
+	if wantvr {
+		compz = lapack.SchurOrig
+	}
+	if wantv {
+		// Eigenvectors requested, work on the whole matrix.
+		impl.Dgghrd(compq, compz, n, ilo, ihi, a, lda, b, ldb, vl, ldvl, vr, ldvr)
+	} else {
+		impl.Dgghrd(lapack.SchurNone, lapack.SchurNone, irows, 0, irows-1,
+			a[ilo*lda+ilo:], lda, b[ilo*ldb+ilo:], ldb, nil, 1, nil, 1)
+	}
+
+	// Perform the QZ algorithm, computing the Schur forms and Schur vectors
+	// if eigenvectors are requested.
+	job := lapack.EigenvaluesOnly
+	if wantv {
+		job = lapack.EigenvaluesAndSchur
+	}
+	ok = impl.Dhgeqz(job, compq, compz, n, ilo, ihi, a, lda, b, ldb,
+		alphar, alphai, beta, vl, ldvl, vr, ldvr) == 0
+
+	if ok && wantv {
+		// Compute the eigenvectors.
+		side := lapack.EVRight
+		if wantvl {
+			side = lapack.EVLeft
+			if wantvr {
+				side = lapack.EVBoth
+			}
+		}
+		_, ok = impl.Dtgevc(side, lapack.EVAllMulQ, nil, n, a, lda, b, ldb,
+			vl, ldvl, vr, ldvr, n, work[2*n:])
+
+		if ok {
+			// Undo balancing on VL and VR and normalize.
+			if wantvl {
+				impl.Dggbak(lapack.Permute, lapack.EVLeft, n, ilo, ihi, lscale, rscale, n, vl, ldvl)
+				dggevNormalize(n, alphai, vl, ldvl, smlnum)
+			}
+			if wantvr {
+				impl.Dggbak(lapack.Permute, lapack.EVRight, n, ilo, ihi, lscale, rscale, n, vr, ldvr)
+				dggevNormalize(n, alphai, vr, ldvr, smlnum)
+			}
+		}
+	}
+
+	// Undo scaling if necessary.
+	if scalea {
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphar, 1)
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphai, 1)
+	}
+	if scaleb {
+		impl.Dlascl(lapack.General, 0, 0, bnrmto, bnrm, n, 1, beta, 1)
+	}
+
+	work[0] = float64(maxwrk)
+	return ok
+}
+
+// dggevNormalize scales the eigenvectors stored in the columns of the n×n
+// matrix V so that the largest component of each has |real part| + |imag.
+// part| == 1.
+func dggevNormalize(n int, alphai, v []float64, ldv int, smlnum float64) {
+	for jc := 0; jc < n; jc++ {
+		if alphai[jc] < 0 {
+			continue
+		}
+		var temp float64
+		if alphai[jc] == 0 {
+			for jr := 0; jr < n; jr++ {
+				temp = math.Max(temp, math.Abs(v[jr*ldv+jc]))
+			}
+		} else {
+			for jr := 0; jr < n; jr++ {
+				temp = math.Max(temp, math.Abs(v[jr*ldv+jc])+math.Abs(v[jr*ldv+jc+1]))
+			}
+		}
+		if temp < smlnum {
+			continue
+		}
+		temp = 1 / temp
+		for jr := 0; jr < n; jr++ {
+			v[jr*ldv+jc] *= temp
+		}
+		if alphai[jc] > 0 {
+			for jr := 0; jr < n; jr++ {
+				v[jr*ldv+jc+1] *= temp
+			}
+		}
+	}
+}
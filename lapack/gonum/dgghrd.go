@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgghrd reduces a pair of n×n real matrices (A,B) to generalized upper
+// Hessenberg form using orthogonal transformations, where A is a general
+// matrix and B is upper triangular. The form of the generalized eigenvalue
+// problem is
+//  A*x = λ*B*x,
+// and B is typically made upper triangular by computing its QR factorization
+// and moving the orthogonal matrix Q to the left side of the equation.
+//
+// Dgghrd simultaneously reduces A to a Hessenberg matrix H
+//  Qᵀ*A*Z = H,
+// and transforms B to another upper triangular matrix T
+//  Qᵀ*B*Z = T,
+// in order to reduce the problem to its standard form
+//  H*y = λ*T*y,
+// where y = Zᵀ*x.
+//
+// The orthogonal matrices Q and Z are determined as products of Givens
+// rotations. They may either be formed explicitly, or they may be
+// postmultiplied into input matrices Q1 and Z1, so that
+//  Q1 * A * Z1ᵀ = (Q1*Q) * H * (Z1*Z)ᵀ,
+//  Q1 * B * Z1ᵀ = (Q1*Q) * T * (Z1*Z)ᵀ.
+// If Q1 is the orthogonal matrix from the QR factorization of B in the
+// original equation A*x = λ*B*x, then Dgghrd reduces the original problem to
+// generalized Hessenberg form.
+//
+// If compq == lapack.SchurNone, Q is not computed and q is not referenced.
+// If compq == lapack.SchurHess, q is initialized to the identity and the
+// orthogonal matrix Q is returned in q.
+// If compq == lapack.SchurOrig, q must contain an orthogonal matrix Q1 on entry
+// and the product Q1*Q is returned in q.
+// For other values of compq Dgghrd will panic. The same applies to compz and
+// z.
+//
+// ilo and ihi determine the block of A that will be reduced. It is assumed
+// that A is already upper triangular in rows and columns [0:ilo] and
+// [ihi+1:n]. ilo and ihi are normally set by a previous call to Dggbal,
+// otherwise they should be set to 0 and n-1, respectively. It must hold that
+//  0 <= ilo <= ihi < n     if n > 0,
+//  ilo == 0 and ihi == -1  if n == 0,
+// otherwise Dgghrd will panic.
+//
+// On return, the strictly lower triangular part of B will be set to zero.
+//
+// Dgghrd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dgghrd(compq, compz lapack.SchurComp, n, ilo, ihi int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int) {
+	switch {
+	case compq != lapack.SchurNone && compq != lapack.SchurHess && compq != lapack.SchurOrig:
+		panic(badSchurComp)
+	case compz != lapack.SchurNone && compz != lapack.SchurHess && compz != lapack.SchurOrig:
+		panic(badSchurComp)
+	case n < 0:
+		panic(nLT0)
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(badIlo)
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(badIhi)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case (compq != lapack.SchurNone && ldq < n) || ldq < 1:
+		panic(badLdQ)
+	case (compz != lapack.SchurNone && ldz < n) || ldz < 1:
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case compq != lapack.SchurNone && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case compz != lapack.SchurNone && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	// Initialize Q and Z if desired.
+	if compq == lapack.SchurHess {
+		impl.Dlaset(blas.All, n, n, 0, 1, q, ldq)
+	}
+	if compz == lapack.SchurHess {
+		impl.Dlaset(blas.All, n, n, 0, 1, z, ldz)
+	}
+	if n == 1 {
+		return
+	}
+
+	// Zero out the lower triangle of B.
+	impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, b[ldb:], ldb)
+
+	bi := blas64.Implementation()
+	wantq := compq != lapack.SchurNone
+	wantz := compz != lapack.SchurNone
+
+	// Reduce A and B.
+	for jcol := ilo; jcol <= ihi-2; jcol++ {
+		for jrow := ihi; jrow >= jcol+2; jrow-- {
+			// Rotate rows jrow-1 and jrow to annihilate A[jrow,jcol].
+			var c, s float64
+			c, s, a[(jrow-1)*lda+jcol] = impl.Dlartg(a[(jrow-1)*lda+jcol], a[jrow*lda+jcol])
+			a[jrow*lda+jcol] = 0
+			bi.Drot(n-jcol-1, a[(jrow-1)*lda+jcol+1:], 1, a[jrow*lda+jcol+1:], 1, c, s)
+			bi.Drot(n-jrow+1, b[(jrow-1)*ldb+jrow-1:], 1, b[jrow*ldb+jrow-1:], 1, c, s)
+			if wantq {
+				bi.Drot(n, q[jrow-1:], ldq, q[jrow:], ldq, c, s)
+			}
+
+			// Rotate columns jrow and jrow-1 to annihilate the fill-in
+			// B[jrow,jrow-1].
+			c, s, b[jrow*ldb+jrow] = impl.Dlartg(b[jrow*ldb+jrow], b[jrow*ldb+jrow-1])
+			b[jrow*ldb+jrow-1] = 0
+			bi.Drot(ihi+1, a[jrow:], lda, a[jrow-1:], lda, c, s)
+			bi.Drot(jrow, b[jrow:], ldb, b[jrow-1:], ldb, c, s)
+			if wantz {
+				bi.Drot(n, z[jrow:], ldz, z[jrow-1:], ldz, c, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlaed0 computes all eigenvalues and corresponding eigenvectors of an n×n
+// symmetric tridiagonal matrix using the divide and conquer method.
+//
+// On entry, d contains the main diagonal of the tridiagonal matrix. On return,
+// d contains the eigenvalues in ascending order. d must have length at least n.
+//
+// On entry, e contains the off-diagonal elements of the tridiagonal matrix. On
+// return, e has been destroyed. e must have length at least n-1.
+//
+// On return, q contains the orthonormal eigenvectors of the tridiagonal
+// matrix. q need not be set on entry.
+//
+// work must have length at least 4*n+n^2 and iwork must have length at least
+// 3+5*n.
+//
+// Dlaed0 returns ok == false if an eigenvalue did not converge.
+//
+// Dlaed0 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed0(n int, d, e, q []float64, ldq int, work []float64, iwork []int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(work) < 4*n+n*n:
+		panic(shortWork)
+	case len(iwork) < 3+5*n:
+		panic(shortIWork)
+	}
+
+	smlsiz := impl.Ilaenv(9, "DLAED0", " ", 0, 0, 0, 0)
+
+	// Determine the size and placement of the submatrices, and save in the
+	// leading elements of iwork.
+	iwork[0] = n
+	subpbs := 1
+	for iwork[subpbs-1] > smlsiz {
+		for j := subpbs - 1; j >= 0; j-- {
+			iwork[2*j+1] = (iwork[j] + 1) / 2
+			iwork[2*j] = iwork[j] / 2
+		}
+		subpbs *= 2
+	}
+	for j := 1; j < subpbs; j++ {
+		iwork[j] += iwork[j-1]
+	}
+
+	// Divide the matrix into subpbs submatrices of size at most smlsiz+1
+	// using rank-1 modifications (cuts).
+	for i := 0; i < subpbs-1; i++ {
+		submat := iwork[i]
+		smm1 := submat - 1
+		d[smm1] -= math.Abs(e[smm1])
+		d[submat] -= math.Abs(e[smm1])
+	}
+
+	indxq := 4*n + 3
+
+	// The eigenvector matrices of the submatrices are placed on the
+	// diagonal of q.
+	impl.Dlaset(blas.All, n, n, 0, 0, q, ldq)
+
+	// Solve each submatrix eigenproblem at the bottom of the divide and
+	// conquer tree.
+	for i := 0; i < subpbs; i++ {
+		var submat, matsiz int
+		if i == 0 {
+			matsiz = iwork[0]
+		} else {
+			submat = iwork[i-1]
+			matsiz = iwork[i] - iwork[i-1]
+		}
+		ok = impl.Dsteqr(lapack.EVTridiag, matsiz, d[submat:], e[submat:], q[submat*ldq+submat:], ldq, work)
+		if !ok {
+			return false
+		}
+		for j := submat; j < iwork[i]; j++ {
+			iwork[indxq+j] = j - submat
+		}
+	}
+
+	// Successively merge eigensystems of adjacent submatrices into the
+	// eigensystem for the corresponding larger matrix.
+	for subpbs > 1 {
+		for i := 0; i <= subpbs-2; i += 2 {
+			var submat, matsiz, msd2 int
+			if i == 0 {
+				matsiz = iwork[1]
+				msd2 = iwork[0]
+			} else {
+				submat = iwork[i-1]
+				matsiz = iwork[i+1] - iwork[i-1]
+				msd2 = matsiz / 2
+			}
+
+			// Merge lower order eigensystems (of size msd2 and
+			// matsiz-msd2) into an eigensystem of size matsiz.
+			ok = impl.Dlaed1(matsiz, d[submat:], q[submat*ldq+submat:], ldq, iwork[indxq+submat:],
+				e[submat+msd2-1], msd2, work, iwork[subpbs:])
+			if !ok {
+				return false
+			}
+			iwork[i/2] = iwork[i+1]
+		}
+		subpbs /= 2
+	}
+
+	// Re-merge the eigenvalues and eigenvectors which were deflated at the
+	// final merge step.
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		j := iwork[indxq+i]
+		work[i] = d[j]
+		bi.Dcopy(n, q[j:], ldq, work[n+i:], n)
+	}
+	copy(d[:n], work[:n])
+	impl.Dlacpy(blas.All, n, n, work[n:], n, q, ldq)
+	return true
+}
@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlaed3 finds the roots of the secular equation, as defined by the values in
+// d, w and rho, between 0 and k-1. It makes the appropriate calls to Dlaed4
+// and then updates the eigenvectors by multiplying the matrix of eigenvectors
+// of the pair of eigensystems being combined by the matrix of eigenvectors of
+// the k×k system which is solved here.
+//
+// k is the number of terms in the rational function to be solved by Dlaed4
+// and it must be non-negative. n is the number of rows and columns in the q
+// matrix and n >= k. n1 is the location of the last eigenvalue in the leading
+// submatrix, min(1,n/2) <= n1 <= n/2.
+//
+// On return, d contains the updated eigenvalues in its first k elements, and q
+// contains the updated eigenvectors in its first k columns.
+//
+// dlamda contains the old roots of the deflated updating problem. These are
+// the poles of the secular equation. w contains the components of the
+// deflation-adjusted updating vector and is overwritten. rho is the value of
+// the parameter in the rank-one updater equation. dlamda and w must have
+// length at least k.
+//
+// q2 contains the non-deflated eigenvectors of the two subproblems as returned
+// by Dlaed2, stored as a row-major n1×(ctot[0]+ctot[1]) matrix followed by a
+// row-major (n-n1)×(ctot[1]+ctot[2]) matrix. indx contains the permutation
+// used to arrange the columns of the deflated q matrix into three groups, and
+// ctot contains the number of columns of each type as described in Dlaed2.
+//
+// s is workspace and must have length at least
+//  k*max(1, ctot[0]+ctot[1], ctot[1]+ctot[2]).
+//
+// Dlaed3 returns ok == false if an eigenvalue did not converge.
+//
+// Dlaed3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed3(k, n, n1 int, d, q []float64, ldq int, rho float64, dlamda, q2 []float64, indx, ctot []int, w, s []float64) (ok bool) {
+	switch {
+	case k < 0:
+		panic(kLT0)
+	case n < k:
+		panic(nLTM)
+	case n1 < min(1, n/2) || n/2 < n1:
+		panic(badN1)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	}
+
+	// Quick return if possible.
+	if k == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(dlamda) < k:
+		panic(shortDlamda)
+	case len(indx) < k:
+		panic(shortIndx)
+	case len(ctot) < 3:
+		panic(badLenCtot)
+	case len(w) < k:
+		panic(shortW)
+	}
+
+	n2 := n - n1
+	n12 := ctot[0] + ctot[1]
+	n23 := ctot[1] + ctot[2]
+	switch {
+	case len(q2) < n1*n12+n2*n23:
+		panic(shortQ2)
+	case len(s) < k*max(1, max(n12, n23)):
+		panic(shortS)
+	}
+
+	bi := blas64.Implementation()
+
+	// Compute the eigenvalues and the corresponding secular eigenvectors.
+	for j := 0; j < k; j++ {
+		d[j], ok = impl.Dlaed4(k, j, dlamda, w, s, rho)
+		if !ok {
+			// The zero finder failed so the computation is terminated.
+			return false
+		}
+		bi.Dcopy(k, s, 1, q[j:], ldq)
+	}
+
+	if k == 2 {
+		for j := 0; j < k; j++ {
+			w[0] = q[j]
+			w[1] = q[ldq+j]
+			q[j] = w[indx[0]]
+			q[ldq+j] = w[indx[1]]
+		}
+	} else if k > 2 {
+		// Compute updated w.
+		bi.Dcopy(k, w, 1, s, 1)
+
+		// Initialize w[i] = q[i,i].
+		bi.Dcopy(k, q, ldq+1, w, 1)
+		for j := 0; j < k; j++ {
+			for i := 0; i < j; i++ {
+				w[i] *= q[i*ldq+j] / (dlamda[i] - dlamda[j])
+			}
+			for i := j + 1; i < k; i++ {
+				w[i] *= q[i*ldq+j] / (dlamda[i] - dlamda[j])
+			}
+		}
+		for i := 0; i < k; i++ {
+			w[i] = math.Copysign(math.Sqrt(-w[i]), s[i])
+		}
+
+		// Compute eigenvectors of the modified rank-1 modification.
+		for j := 0; j < k; j++ {
+			for i := 0; i < k; i++ {
+				s[i] = w[i] / q[i*ldq+j]
+			}
+			temp := bi.Dnrm2(k, s, 1)
+			for i := 0; i < k; i++ {
+				q[i*ldq+j] = s[indx[i]] / temp
+			}
+		}
+	}
+
+	// Compute the updated eigenvectors.
+	if n23 != 0 {
+		impl.Dlacpy(blas.All, n23, k, q[ctot[0]*ldq:], ldq, s, k)
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n2, k, n23,
+			1, q2[n1*n12:], n23, s, k,
+			0, q[n1*ldq:], ldq)
+	} else {
+		impl.Dlaset(blas.All, n2, k, 0, 0, q[n1*ldq:], ldq)
+	}
+
+	if n12 != 0 {
+		impl.Dlacpy(blas.All, n12, k, q, ldq, s, k)
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n1, k, n12,
+			1, q2, n12, s, k,
+			0, q, ldq)
+	} else {
+		impl.Dlaset(blas.All, n1, k, 0, 0, q, ldq)
+	}
+	return true
+}
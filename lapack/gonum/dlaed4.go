@@ -0,0 +1,415 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlaed4 computes the i-th updated eigenvalue of a symmetric rank-one
+// modification to a diagonal matrix whose elements are given in the array d.
+// It is assumed that
+//  d[j] < d[j+1] for j = 0, ..., n-2,
+//  rho > 0,
+//  the Euclidean norm of z is one.
+// The method consists of approximating the rational functions in the secular
+// equation by simpler interpolating rational functions.
+//
+// On return, dlam contains the computed eigenvalue and delta contains the
+// values d[j] - lambda_i which are used to compute the corresponding
+// eigenvector. If n == 1 or n == 2, delta instead contains the normalized
+// eigenvector.
+//
+// d, z and delta must have length at least n. i must be in the range [0, n).
+//
+// Dlaed4 returns ok == false if the iteration failed to converge.
+//
+// Dlaed4 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed4(n, i int, d, z, delta []float64, rho float64) (dlam float64, ok bool) {
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case i < 0 || n <= i:
+		panic(badIndex)
+	case len(d) < n:
+		panic(shortD)
+	case len(z) < n:
+		panic(shortZ)
+	case len(delta) < n:
+		panic(shortDelta)
+	}
+
+	if n == 1 {
+		delta[0] = 1
+		return d[0] + rho*z[0]*z[0], true
+	}
+	if n == 2 {
+		return impl.Dlaed5(i, d, z, delta, rho), true
+	}
+
+	const maxit = 30
+
+	eps := dlamchE
+	rhoinv := 1 / rho
+
+	if i == n-1 {
+		// The case i == n-1.
+		ii := n - 2
+
+		// Calculate the initial guess.
+		midpt := rho / 2
+
+		// If ||z||_2 is not one, then temp should be set to
+		// rho * ||z||_2^2 / 2.
+		for j := 0; j < n; j++ {
+			delta[j] = (d[j] - d[i]) - midpt
+		}
+		var psi float64
+		for j := 0; j < n-2; j++ {
+			psi += z[j] * z[j] / delta[j]
+		}
+		c := rhoinv + psi
+		w := c + z[ii]*z[ii]/delta[ii] + z[n-1]*z[n-1]/delta[n-1]
+
+		var tau, dltlb, dltub float64
+		if w <= 0 {
+			temp := z[n-2]*z[n-2]/(d[n-1]-d[n-2]+rho) + z[n-1]*z[n-1]/rho
+			if c <= temp {
+				tau = rho
+			} else {
+				del := d[n-1] - d[n-2]
+				a := -c*del + z[n-2]*z[n-2] + z[n-1]*z[n-1]
+				b := z[n-1] * z[n-1] * del
+				if a < 0 {
+					tau = 2 * b / (math.Sqrt(a*a+4*b*c) - a)
+				} else {
+					tau = (a + math.Sqrt(a*a+4*b*c)) / (2 * c)
+				}
+			}
+			// It can be proved that
+			//  d[n-1]+rho/2 <= lambda_{n-1} < d[n-1]+tau <= d[n-1]+rho.
+			dltlb = midpt
+			dltub = rho
+		} else {
+			del := d[n-1] - d[n-2]
+			a := -c*del + z[n-2]*z[n-2] + z[n-1]*z[n-1]
+			b := z[n-1] * z[n-1] * del
+			if a < 0 {
+				tau = 2 * b / (math.Sqrt(a*a+4*b*c) - a)
+			} else {
+				tau = (a + math.Sqrt(a*a+4*b*c)) / (2 * c)
+			}
+			// It can be proved that
+			//  d[n-1] < d[n-1]+tau < lambda_{n-1} < d[n-1]+rho/2.
+			dltlb = 0
+			dltub = midpt
+		}
+		for j := 0; j < n; j++ {
+			delta[j] = (d[j] - d[i]) - tau
+		}
+
+		// Evaluate psi, phi and their derivatives, and the
+		// secular function w.
+		var dpsi, dphi, phi, erretm float64
+		evaluate := func() {
+			dpsi, psi, erretm = 0, 0, 0
+			for j := 0; j <= ii; j++ {
+				temp := z[j] / delta[j]
+				psi += z[j] * temp
+				dpsi += temp * temp
+				erretm += psi
+			}
+			erretm = math.Abs(erretm)
+			temp := z[n-1] / delta[n-1]
+			phi = z[n-1] * temp
+			dphi = temp * temp
+			erretm = 8*(-phi-psi) + erretm - phi + rhoinv + math.Abs(tau)*(dpsi+dphi)
+			w = rhoinv + phi + psi
+		}
+		evaluate()
+
+		for niter := 1; niter <= maxit; niter++ {
+			// Test for convergence.
+			if math.Abs(w) <= eps*erretm {
+				return d[i] + tau, true
+			}
+			if w <= 0 {
+				dltlb = math.Max(dltlb, tau)
+			} else {
+				dltub = math.Min(dltub, tau)
+			}
+
+			// Calculate the new step.
+			c := w - delta[n-2]*dpsi - delta[n-1]*dphi
+			a := (delta[n-2]+delta[n-1])*w - delta[n-2]*delta[n-1]*(dpsi+dphi)
+			b := delta[n-2] * delta[n-1] * w
+			var eta float64
+			if niter == 1 {
+				if c < 0 {
+					c = math.Abs(c)
+				}
+			}
+			switch {
+			case niter == 1 && c == 0:
+				eta = -w / (dpsi + dphi)
+			case a >= 0:
+				eta = (a + math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+			default:
+				eta = 2 * b / (a - math.Sqrt(math.Abs(a*a-4*b*c)))
+			}
+
+			// Note, eta should be positive if w is negative, and eta
+			// should be negative otherwise. However, if for some reason
+			// caused by roundoff, eta*w > 0, we simply use one Newton
+			// step instead. This way will guarantee eta*w < 0.
+			if w*eta > 0 {
+				eta = -w / (dpsi + dphi)
+			}
+			temp := tau + eta
+			if temp > dltub || temp < dltlb {
+				if w < 0 {
+					eta = (dltub - tau) / 2
+				} else {
+					eta = (dltlb - tau) / 2
+				}
+			}
+			for j := 0; j < n; j++ {
+				delta[j] -= eta
+			}
+			tau += eta
+
+			evaluate()
+		}
+
+		// Return with ok == false since the maximum number of
+		// iterations was reached without convergence.
+		return d[i] + tau, false
+	}
+
+	// The case i < n-1.
+	ip1 := i + 1
+
+	// Calculate the initial guess.
+	del := d[ip1] - d[i]
+	midpt := del / 2
+	for j := 0; j < n; j++ {
+		delta[j] = (d[j] - d[i]) - midpt
+	}
+	var psi float64
+	for j := 0; j < i; j++ {
+		psi += z[j] * z[j] / delta[j]
+	}
+	var phi float64
+	for j := n - 1; j >= i+2; j-- {
+		phi += z[j] * z[j] / delta[j]
+	}
+	c := rhoinv + psi + phi
+	w := c + z[i]*z[i]/delta[i] + z[ip1]*z[ip1]/delta[ip1]
+
+	var (
+		orgati       bool
+		tau          float64
+		dltlb, dltub float64
+	)
+	if w > 0 {
+		// d[i] < lambda_i < (d[i]+d[i+1])/2. We choose d[i] as the origin.
+		orgati = true
+		a := c*del + z[i]*z[i] + z[ip1]*z[ip1]
+		b := z[i] * z[i] * del
+		if a > 0 {
+			tau = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+		} else {
+			tau = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		}
+		dltlb = 0
+		dltub = midpt
+	} else {
+		// (d[i]+d[i+1])/2 <= lambda_i < d[i+1]. We choose d[i+1] as
+		// the origin.
+		orgati = false
+		a := c*del - z[i]*z[i] - z[ip1]*z[ip1]
+		b := z[ip1] * z[ip1] * del
+		if a < 0 {
+			tau = 2 * b / (a - math.Sqrt(math.Abs(a*a+4*b*c)))
+		} else {
+			tau = -(a + math.Sqrt(math.Abs(a*a+4*b*c))) / (2 * c)
+		}
+		dltlb = -midpt
+		dltub = 0
+	}
+
+	var origin float64
+	var ii int
+	if orgati {
+		origin = d[i]
+		ii = i
+	} else {
+		origin = d[ip1]
+		ii = ip1
+	}
+	for j := 0; j < n; j++ {
+		delta[j] = (d[j] - origin) - tau
+	}
+	iim1 := ii - 1
+	iip1 := ii + 1
+
+	// Evaluate psi, phi and their derivatives, the secular function w and
+	// its derivative dw.
+	var dpsi, dphi, dw, erretm float64
+	evaluate := func(tau float64) {
+		dpsi, psi, erretm = 0, 0, 0
+		for j := 0; j <= iim1; j++ {
+			temp := z[j] / delta[j]
+			psi += z[j] * temp
+			dpsi += temp * temp
+			erretm += psi
+		}
+		erretm = math.Abs(erretm)
+		dphi, phi = 0, 0
+		for j := n - 1; j >= iip1; j-- {
+			temp := z[j] / delta[j]
+			phi += z[j] * temp
+			dphi += temp * temp
+			erretm += phi
+		}
+		temp := z[ii] / delta[ii]
+		dw = dpsi + dphi + temp*temp
+		temp *= z[ii]
+		w = rhoinv + phi + psi + temp
+		erretm = 8*(phi-psi) + erretm + 2*rhoinv + 3*math.Abs(temp) + math.Abs(tau)*dw
+	}
+	evaluate(tau)
+
+	// w is the value of the secular function with its ii-th element
+	// removed.
+	swtch3 := false
+	if orgati {
+		swtch3 = w-z[ii]*z[ii]/delta[ii] < 0
+	} else {
+		swtch3 = w-z[ii]*z[ii]/delta[ii] > 0
+	}
+	if ii == 0 || ii == n-1 {
+		swtch3 = false
+	}
+
+	var zz [3]float64
+	swtch := false
+	for niter := 1; niter <= maxit; niter++ {
+		// Test for convergence.
+		if math.Abs(w) <= eps*erretm {
+			return origin + tau, true
+		}
+		if w <= 0 {
+			dltlb = math.Max(dltlb, tau)
+		} else {
+			dltub = math.Min(dltub, tau)
+		}
+
+		// Calculate the new step.
+		var eta float64
+		if !swtch3 {
+			var c float64
+			switch {
+			case !swtch && orgati:
+				temp := z[i] / delta[i]
+				c = w - delta[ip1]*dw - (d[i]-d[ip1])*temp*temp
+			case !swtch:
+				temp := z[ip1] / delta[ip1]
+				c = w - delta[i]*dw - (d[ip1]-d[i])*temp*temp
+			default:
+				temp := z[ii] / delta[ii]
+				if orgati {
+					dpsi += temp * temp
+				} else {
+					dphi += temp * temp
+				}
+				c = w - delta[i]*dpsi - delta[ip1]*dphi
+			}
+			a := (delta[i]+delta[ip1])*w - delta[i]*delta[ip1]*dw
+			b := delta[i] * delta[ip1] * w
+			switch {
+			case c == 0:
+				if a == 0 {
+					switch {
+					case !swtch && orgati:
+						a = z[i]*z[i] + delta[ip1]*delta[ip1]*(dpsi+dphi)
+					case !swtch:
+						a = z[ip1]*z[ip1] + delta[i]*delta[i]*(dpsi+dphi)
+					default:
+						a = delta[i]*delta[i]*dpsi + delta[ip1]*delta[ip1]*dphi
+					}
+				}
+				eta = b / a
+			case a <= 0:
+				eta = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+			default:
+				eta = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+			}
+		} else {
+			// Interpolation using three most relevant poles.
+			temp := rhoinv + psi + phi
+			var c float64
+			switch {
+			case swtch:
+				c = temp - delta[iim1]*dpsi - delta[iip1]*dphi
+				zz[0] = delta[iim1] * delta[iim1] * dpsi
+				zz[2] = delta[iip1] * delta[iip1] * dphi
+			case orgati:
+				temp1 := z[iim1] / delta[iim1]
+				temp1 *= temp1
+				c = temp - delta[iip1]*(dpsi+dphi) - (d[iim1]-d[iip1])*temp1
+				zz[0] = z[iim1] * z[iim1]
+				zz[2] = delta[iip1] * delta[iip1] * ((dpsi - temp1) + dphi)
+			default:
+				temp1 := z[iip1] / delta[iip1]
+				temp1 *= temp1
+				c = temp - delta[iim1]*(dpsi+dphi) - (d[iip1]-d[iim1])*temp1
+				zz[0] = delta[iim1] * delta[iim1] * (dpsi + (dphi - temp1))
+				zz[2] = z[iip1] * z[iip1]
+			}
+			zz[1] = z[ii] * z[ii]
+			var ok bool
+			eta, ok = impl.Dlaed6(niter+1, orgati, c, delta[iim1:], zz[:], w)
+			if !ok {
+				return origin + tau, false
+			}
+		}
+
+		// Note, eta should be positive if w is negative, and eta should
+		// be negative otherwise. However, if for some reason caused by
+		// roundoff, eta*w > 0, we simply use one Newton step instead.
+		// This way will guarantee eta*w < 0.
+		if w*eta >= 0 {
+			eta = -w / dw
+		}
+		temp := tau + eta
+		if temp > dltub || temp < dltlb {
+			if w < 0 {
+				eta = (dltub - tau) / 2
+			} else {
+				eta = (dltlb - tau) / 2
+			}
+		}
+
+		prew := w
+		for j := 0; j < n; j++ {
+			delta[j] -= eta
+		}
+		tau += eta
+		evaluate(tau)
+
+		if niter == 1 {
+			if orgati {
+				swtch = -w > math.Abs(prew)/10
+			} else {
+				swtch = w > math.Abs(prew)/10
+			}
+		} else if w*prew > 0 && math.Abs(w) > math.Abs(prew)/10 {
+			swtch = !swtch
+		}
+	}
+
+	// Return with ok == false since the maximum number of iterations was
+	// reached without convergence.
+	return origin + tau, false
+}
@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlag2s converts the m×n double precision matrix A to the single precision
+// matrix SA.
+//
+// Dlag2s returns whether all elements of A are within the range of single
+// precision numbers. If Dlag2s returns false, an element of A is larger in
+// magnitude than the largest finite float32 and the conversion is stopped, so
+// SA is only partially set.
+//
+// Dlag2s is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlag2s(m, n int, a []float64, lda int, sa []float32, ldsa int) (ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldsa < max(1, n):
+		panic(badLdSA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(sa) < (m-1)*ldsa+n:
+		panic(shortSA)
+	}
+
+	const rmax = math.MaxFloat32
+	for i := 0; i < m; i++ {
+		for j, v := range a[i*lda : i*lda+n] {
+			if v < -rmax || rmax < v {
+				return false
+			}
+			sa[i*ldsa+j] = float32(v)
+		}
+	}
+	return true
+}
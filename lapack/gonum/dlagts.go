@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlagts solves one of the systems of equations
+//  (T - lambda*I)*x = y   or   (T - lambda*I)ᵀ*x = y,
+// where T is an n×n tridiagonal matrix and lambda is a scalar, following the
+// factorization of (T - lambda*I) as
+//  T - lambda*I = P*L*U,
+// computed by Dlagtf.
+//
+// The choice of equation to be solved is controlled by job as follows:
+//  job = 1:  solve (T - lambda*I)*x = y.
+//  job = -1: solve (T - lambda*I)*x = y and, if overflow would otherwise
+//            occur, perturb the diagonal elements of U.
+//  job = 2:  solve (T - lambda*I)ᵀ*x = y.
+//  job = -2: solve (T - lambda*I)ᵀ*x = y and, if overflow would otherwise
+//            occur, perturb the diagonal elements of U.
+//
+// a, b, c, d and in must contain the output of Dlagtf.
+//
+// On entry, y contains the right hand side vector y. On return, y is
+// overwritten by the solution vector x.
+//
+// tol is used with negative values of job to determine the perturbation of
+// the diagonal elements of U. If tol is not positive, it is reset to
+// eps*max(|u(i,j)|), where eps is the relative machine precision.
+//
+// Dlagts returns whether the solution was computed. If job is positive and an
+// element of x would overflow, ok is false and the contents of y are
+// undefined. Dlagts always returns true for negative values of job.
+//
+// Dlagts is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlagts(job, n int, a, b, c, d []float64, in []int, y []float64, tol float64) (ok bool) {
+	switch {
+	case job != -2 && job != -1 && job != 1 && job != 2:
+		panic(badJob)
+	case n < 0:
+		panic(nLT0)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < n:
+		panic(shortA)
+	case len(b) < n-1:
+		panic(shortB)
+	case len(c) < n-1:
+		panic(shortC)
+	case len(d) < n-2:
+		panic(shortD)
+	case len(in) < n:
+		panic(shortIn)
+	case len(y) < n:
+		panic(shortY)
+	}
+
+	const (
+		eps    = dlamchE
+		sfmin  = dlamchS
+		bignum = 1 / sfmin
+	)
+
+	if job < 0 && tol <= 0 {
+		tol = math.Abs(a[0])
+		if n > 1 {
+			tol = math.Max(tol, math.Max(math.Abs(a[1]), math.Abs(b[0])))
+		}
+		for k := 2; k < n; k++ {
+			tol = math.Max(tol, math.Max(math.Abs(a[k]), math.Max(math.Abs(b[k-1]), math.Abs(d[k-2]))))
+		}
+		tol *= eps
+		if tol == 0 {
+			tol = eps
+		}
+	}
+
+	// div returns temp/a[k], guarding against overflow. If job is negative,
+	// a[k] is perturbed by multiples of tol until the division is safe.
+	div := func(temp float64, k int) (float64, bool) {
+		ak := a[k]
+		pert := math.Copysign(tol, ak)
+		for {
+			absak := math.Abs(ak)
+			if absak < 1 {
+				if absak < sfmin {
+					if absak == 0 || math.Abs(temp)*sfmin > absak {
+						if job > 0 {
+							return 0, false
+						}
+						ak += pert
+						pert *= 2
+						continue
+					}
+					temp *= bignum
+					ak *= bignum
+				} else if math.Abs(temp) > absak*bignum {
+					if job > 0 {
+						return 0, false
+					}
+					ak += pert
+					pert *= 2
+					continue
+				}
+			}
+			return temp / ak, true
+		}
+	}
+
+	if job == 1 || job == -1 {
+		for k := 1; k < n; k++ {
+			if in[k-1] == 0 {
+				y[k] -= c[k-1] * y[k-1]
+			} else {
+				temp := y[k-1]
+				y[k-1] = y[k]
+				y[k] = temp - c[k-1]*y[k]
+			}
+		}
+		for k := n - 1; k >= 0; k-- {
+			temp := y[k]
+			switch {
+			case k < n-2:
+				temp -= b[k]*y[k+1] + d[k]*y[k+2]
+			case k == n-2:
+				temp -= b[k] * y[k+1]
+			}
+			y[k], ok = div(temp, k)
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	for k := 0; k < n; k++ {
+		temp := y[k]
+		switch {
+		case k >= 2:
+			temp -= b[k-1]*y[k-1] + d[k-2]*y[k-2]
+		case k == 1:
+			temp -= b[k-1] * y[k-1]
+		}
+		y[k], ok = div(temp, k)
+		if !ok {
+			return false
+		}
+	}
+	for k := n - 1; k > 0; k-- {
+		if in[k-1] == 0 {
+			y[k-1] -= c[k-1] * y[k]
+		} else {
+			temp := y[k-1]
+			y[k-1] = y[k]
+			y[k] = temp - c[k-1]*y[k]
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/lapack"
+
+// Dlaqge equilibrates an m×n general matrix A using the row and column
+// scaling factors in r and c, as computed by Dgeequ. rowcnd, colcnd and amax
+// are the corresponding values returned by Dgeequ.
+//
+// On return, a is overwritten by the equilibrated matrix, one of
+//  diag(r) * A * diag(c)  if equed == lapack.EquilibrationBoth,
+//  diag(r) * A            if equed == lapack.EquilibrationRow,
+//  A * diag(c)            if equed == lapack.EquilibrationCol,
+//  A                      if equed == lapack.EquilibrationNone.
+// Row scaling is done if rowcnd < 0.1 or amax is very close to underflow or
+// overflow. Column scaling is done if colcnd < 0.1.
+//
+// r must have length m and c must have length n, otherwise Dlaqge will panic.
+//
+// Dlaqge is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) (equed lapack.EquilibrationType) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return lapack.EquilibrationNone
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(r) != m:
+		panic(shortR)
+	case len(c) != n:
+		panic(shortC)
+	}
+
+	// thresh is the threshold value used to decide whether scaling should
+	// be based on the ratio of the scaling factors. If rowcnd < thresh, row
+	// scaling is done, and if colcnd < thresh, column scaling is done.
+	const (
+		thresh = 0.1
+		small  = dlamchS / dlamchP
+		large  = 1 / small
+	)
+
+	if rowcnd >= thresh && small <= amax && amax <= large {
+		// No row scaling.
+		if colcnd >= thresh {
+			// No column scaling.
+			return lapack.EquilibrationNone
+		}
+		// Column scaling.
+		for i := 0; i < m; i++ {
+			row := a[i*lda : i*lda+n]
+			for j, cj := range c {
+				row[j] *= cj
+			}
+		}
+		return lapack.EquilibrationCol
+	}
+	if colcnd >= thresh {
+		// Row scaling, no column scaling.
+		for i, ri := range r {
+			row := a[i*lda : i*lda+n]
+			for j := range row {
+				row[j] *= ri
+			}
+		}
+		return lapack.EquilibrationRow
+	}
+	// Row and column scaling.
+	for i, ri := range r {
+		row := a[i*lda : i*lda+n]
+		for j, cj := range c {
+			row[j] *= ri * cj
+		}
+	}
+	return lapack.EquilibrationBoth
+}
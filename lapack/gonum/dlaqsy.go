@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlaqsy equilibrates an n×n symmetric matrix A using the scaling factors in
+// s, as computed by Dpoequ. scond and amax are the corresponding values
+// returned by Dpoequ.
+//
+// On entry, a contains the upper or lower triangle of A depending on uplo. On
+// return, a is overwritten by the corresponding triangle of the equilibrated
+// matrix, one of
+//  diag(s) * A * diag(s)  if equed == lapack.EquilibrationBoth,
+//  A                      if equed == lapack.EquilibrationNone.
+// Scaling is done if scond < 0.1 or amax is very close to underflow or
+// overflow.
+//
+// s must have length n, otherwise Dlaqsy will panic.
+//
+// Dlaqsy is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) (equed lapack.EquilibrationType) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return lapack.EquilibrationNone
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(s) != n:
+		panic(shortS)
+	}
+
+	// thresh is the threshold value used to decide whether scaling should
+	// be based on the ratio of the scaling factors. If scond < thresh,
+	// scaling is done.
+	const (
+		thresh = 0.1
+		small  = dlamchS / dlamchP
+		large  = 1 / small
+	)
+
+	if scond >= thresh && small <= amax && amax <= large {
+		// No equilibration.
+		return lapack.EquilibrationNone
+	}
+
+	// Replace A by diag(s) * A * diag(s).
+	if uplo == blas.Upper {
+		for i := 0; i < n; i++ {
+			si := s[i]
+			for j := i; j < n; j++ {
+				a[i*lda+j] *= si * s[j]
+			}
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			si := s[i]
+			for j := 0; j <= i; j++ {
+				a[i*lda+j] *= si * s[j]
+			}
+		}
+	}
+	return lapack.EquilibrationBoth
+}
@@ -55,7 +55,7 @@ import (
 // this function will panic if this size is not met.
 //
 // Dlarfb is an internal routine. It is exported for testing purposes.
-func (Implementation) Dlarfb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64, ldwork int) {
+func (impl Implementation) Dlarfb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64, ldwork int) {
 	nv := m
 	if side == blas.Right {
 		nv = n
@@ -108,8 +108,12 @@ func (Implementation) Dlarfb(side blas.Side, trans blas.Transpose, direct lapack
 		panic(shortWork)
 	}
 
-	bi := blas64.Implementation()
+	impl.dlarfb(blas64.Implementation(), side, trans, direct, store, m, n, k, v, ldv, t, ldt, c, ldc, work, ldwork)
+}
 
+// dlarfb applies a block reflector to a matrix like Dlarfb but calls the
+// routines of the BLAS implementation bi. The arguments are not checked.
+func (Implementation) dlarfb(bi blas.Float64, side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64, ldwork int) {
 	transt := blas.Trans
 	if trans == blas.Trans {
 		transt = blas.NoTrans
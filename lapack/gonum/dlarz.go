@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlarz applies an elementary reflector H to an m×n matrix C:
+//  C = H * C  if side == blas.Left
+//  C = C * H  if side == blas.Right
+// H is represented in the form
+//  H = I - tau * v * vᵀ
+// where tau is a scalar and v is a vector of the form
+//  v = [ 1 ]
+//      [ 0 ]
+//      [ z ]
+// with z a vector of length l. Only z is stored in v, with increment incv.
+// H is a reflector as returned by Dtzrzf.
+//
+// If side == blas.Left, the last l rows of C are combined with the first row,
+// and if side == blas.Right, the last l columns of C are combined with the
+// first column.
+//
+// work must have length at least n if side == blas.Left and at least m if
+// side == blas.Right, otherwise Dlarz will panic.
+//
+// Dlarz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarz(side blas.Side, m, n, l int, v []float64, incv int, tau float64, c []float64, ldc int, work []float64) {
+	left := side == blas.Left
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case incv == 0:
+		panic(zeroIncV)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || tau == 0 {
+		return
+	}
+
+	switch {
+	case l > 0 && len(v) < 1+(l-1)*abs(incv):
+		panic(shortV)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case left && len(work) < n:
+		panic(shortWork)
+	case !left && len(work) < m:
+		panic(shortWork)
+	}
+
+	bi := blas64.Implementation()
+	if left {
+		// Form H * C.
+		// w = C[0,:].
+		bi.Dcopy(n, c, 1, work, 1)
+		// w += C[m-l:m,:]ᵀ * z.
+		if l > 0 {
+			bi.Dgemv(blas.Trans, l, n, 1, c[(m-l)*ldc:], ldc, v, incv, 1, work, 1)
+		}
+		// C[0,:] -= tau * w.
+		bi.Daxpy(n, -tau, work, 1, c, 1)
+		// C[m-l:m,:] -= tau * z * wᵀ.
+		if l > 0 {
+			bi.Dger(l, n, -tau, v, incv, work, 1, c[(m-l)*ldc:], ldc)
+		}
+		return
+	}
+	// Form C * H.
+	// w = C[:,0].
+	bi.Dcopy(m, c, ldc, work, 1)
+	// w += C[:,n-l:n] * z.
+	if l > 0 {
+		bi.Dgemv(blas.NoTrans, m, l, 1, c[n-l:], ldc, v, incv, 1, work, 1)
+	}
+	// C[:,0] -= tau * w.
+	bi.Daxpy(m, -tau, work, 1, c, ldc)
+	// C[:,n-l:n] -= tau * w * zᵀ.
+	if l > 0 {
+		bi.Dger(m, l, -tau, work, 1, v, incv, c[n-l:], ldc)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlarzb applies a block reflector H or its transpose to an m×n matrix C:
+//  C = H * C   if side == blas.Left and trans == blas.NoTrans,
+//  C = Hᵀ * C  if side == blas.Left and trans == blas.Trans,
+//  C = C * H   if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Hᵀ  if side == blas.Right and trans == blas.Trans.
+// H is the block reflector
+//  H = I - Vᵀ * T * V
+// formed by Dlarzt from k elementary reflectors as returned by Dtzrzf.
+//
+// Only direct == lapack.Backward and store == lapack.RowWise are supported.
+// For other values of direct and store Dlarzb will panic.
+//
+// The k×l matrix V contains the non-trivial parts of the vectors defining
+// the elementary reflectors. They act on the first k and the last l rows of C
+// if side == blas.Left, and on the first k and the last l columns of C if
+// side == blas.Right.
+//
+// t contains the k×k lower triangular factor of the block reflector.
+//
+// work is a temporary storage matrix with stride ldwork. work must be of size
+// at least n×k if side == blas.Left and m×k if side == blas.Right, otherwise
+// Dlarzb will panic.
+//
+// Dlarzb is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlarzb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k, l int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64, ldwork int) {
+	left := side == blas.Left
+	nw := m
+	if left {
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.Trans && trans != blas.NoTrans:
+		panic(badTrans)
+	case direct != lapack.Backward:
+		panic(badDirect)
+	case store != lapack.RowWise:
+		panic(badStoreV)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case ldv < max(1, l):
+		panic(badLdV)
+	case ldt < max(1, k):
+		panic(badLdT)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case ldwork < max(1, k):
+		panic(badLdWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case l > 0 && len(v) < (k-1)*ldv+l:
+		panic(shortV)
+	case len(t) < (k-1)*ldt+k:
+		panic(shortT)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < (nw-1)*ldwork+k:
+		panic(shortWork)
+	}
+
+	bi := blas64.Implementation()
+	if left {
+		// Form H * C or Hᵀ * C.
+		transt := blas.Trans
+		if trans == blas.Trans {
+			transt = blas.NoTrans
+		}
+		// W = C[0:k,:]ᵀ.
+		for j := 0; j < k; j++ {
+			bi.Dcopy(n, c[j*ldc:], 1, work[j:], ldwork)
+		}
+		// W += C[m-l:m,:]ᵀ * Vᵀ.
+		if l > 0 {
+			bi.Dgemm(blas.Trans, blas.Trans, n, k, l, 1, c[(m-l)*ldc:], ldc, v, ldv, 1, work, ldwork)
+		}
+		// W = W * Tᵀ or W * T.
+		bi.Dtrmm(blas.Right, blas.Lower, transt, blas.NonUnit, n, k, 1, t, ldt, work, ldwork)
+		// C[0:k,:] -= Wᵀ.
+		for i := 0; i < k; i++ {
+			for j := 0; j < n; j++ {
+				c[i*ldc+j] -= work[j*ldwork+i]
+			}
+		}
+		// C[m-l:m,:] -= Vᵀ * Wᵀ.
+		if l > 0 {
+			bi.Dgemm(blas.Trans, blas.Trans, l, n, k, -1, v, ldv, work, ldwork, 1, c[(m-l)*ldc:], ldc)
+		}
+		return
+	}
+
+	// Form C * H or C * Hᵀ.
+	// W = C[:,0:k].
+	for j := 0; j < k; j++ {
+		bi.Dcopy(m, c[j:], ldc, work[j:], ldwork)
+	}
+	// W += C[:,n-l:n] * Vᵀ.
+	if l > 0 {
+		bi.Dgemm(blas.NoTrans, blas.Trans, m, k, l, 1, c[n-l:], ldc, v, ldv, 1, work, ldwork)
+	}
+	// W = W * T or W * Tᵀ.
+	bi.Dtrmm(blas.Right, blas.Lower, trans, blas.NonUnit, m, k, 1, t, ldt, work, ldwork)
+	// C[:,0:k] -= W.
+	for i := 0; i < m; i++ {
+		for j := 0; j < k; j++ {
+			c[i*ldc+j] -= work[i*ldwork+j]
+		}
+	}
+	// C[:,n-l:n] -= W * V.
+	if l > 0 {
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, m, l, k, -1, work, ldwork, v, ldv, 1, c[n-l:], ldc)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlarzt forms the triangular factor T of a block reflector H of order > n,
+// which is defined as a product of k elementary reflectors as returned by
+// Dtzrzf.
+//
+// Only direct == lapack.Backward and store == lapack.RowWise are supported,
+// in which case
+//  H = H_{k-1} * ... * H_1 * H_0,
+// T is k×k lower triangular and
+//  H = I - Vᵀ * T * V.
+// For other values of direct and store Dlarzt will panic.
+//
+// The k×n matrix V contains in its i-th row the non-trivial part z of the
+// vector defining H_i, as described in Dlarz.
+//
+// tau contains the scalar factors of the elementary reflectors and must have
+// length at least k, otherwise Dlarzt will panic.
+//
+// Dlarzt is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlarzt(direct lapack.Direct, store lapack.StoreV, n, k int, v []float64, ldv int, tau, t []float64, ldt int) {
+	switch {
+	case direct != lapack.Backward:
+		panic(badDirect)
+	case store != lapack.RowWise:
+		panic(badStoreV)
+	case n < 0:
+		panic(nLT0)
+	case k < 1:
+		panic(kLT1)
+	case ldv < max(1, n):
+		panic(badLdV)
+	case ldt < max(1, k):
+		panic(badLdT)
+	}
+
+	switch {
+	case len(v) < (k-1)*ldv+n:
+		panic(shortV)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(t) < (k-1)*ldt+k:
+		panic(shortT)
+	}
+
+	bi := blas64.Implementation()
+	for i := k - 1; i >= 0; i-- {
+		if tau[i] == 0 {
+			// H_i = I.
+			for j := i; j < k; j++ {
+				t[j*ldt+i] = 0
+			}
+			continue
+		}
+		if i < k-1 {
+			// T[i+1:k,i] = -tau[i] * V[i+1:k,:] * V[i,:]ᵀ.
+			bi.Dgemv(blas.NoTrans, k-i-1, n, -tau[i], v[(i+1)*ldv:], ldv, v[i*ldv:], 1, 0, t[(i+1)*ldt+i:], ldt)
+			// T[i+1:k,i] = T[i+1:k,i+1:k] * T[i+1:k,i].
+			bi.Dtrmv(blas.Lower, blas.NoTrans, blas.NonUnit, k-i-1, t[(i+1)*ldt+i+1:], ldt, t[(i+1)*ldt+i:], ldt)
+		}
+		t[i*ldt+i] = tau[i]
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dlasd0 computes, using a divide and conquer approach, the singular value
+// decomposition of a real upper bidiagonal n×m matrix B with diagonal d and
+// off-diagonal e, where m = n + sqre. The algorithm computes orthogonal
+// matrices U and VT such that
+//  B = U * S * VT
+// where S is an n×m diagonal matrix of singular values.
+//
+// d must have length at least n. On entry, it contains the main diagonal of
+// the bidiagonal matrix and on return it contains the singular values in
+// ascending order. e must have length at least m-1 and contains the
+// off-diagonal entries of the bidiagonal matrix. It is destroyed on return.
+//
+// On entry, U and VT must contain the identity of order n and m respectively.
+// On return, u contains the n×n matrix of left singular vectors and vt
+// contains the m×m matrix of right singular vectors transposed.
+//
+// smlsiz is the maximum size of the subproblems at the bottom of the
+// computation tree and it must be at least 3.
+//
+// iwork must have length at least 8*n and work must have length at least
+// 3*m^2+2*m.
+//
+// Dlasd0 returns whether all singular values have been computed.
+//
+// Dlasd0 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd0(n, sqre int, d, e, u []float64, ldu int, vt []float64, ldvt, smlsiz int, iwork []int, work []float64) (ok bool) {
+	m := n + sqre
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case ldu < max(1, n):
+		panic(badLdU)
+	case ldvt < max(1, m):
+		panic(badLdVT)
+	case smlsiz < 3:
+		panic(badSmlsiz)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < m-1:
+		panic(shortE)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(iwork) < 8*n:
+		panic(shortIWork)
+	case len(work) < 3*m*m+2*m:
+		panic(shortWork)
+	}
+
+	// If the input matrix is too small, call Dlasdq to find the SVD.
+	if n <= smlsiz {
+		return impl.Dlasdq(blas.Upper, sqre, n, m, n, 0, d, e, vt, ldvt, u, ldu, u, ldu, work)
+	}
+
+	// Set up the computation tree.
+	inode := 0
+	ndiml := inode + n
+	ndimr := ndiml + n
+	idxq := ndimr + n
+	iwk := idxq + n
+	nlvl, nd := impl.Dlasdt(n, iwork[inode:inode+n], iwork[ndiml:ndiml+n], iwork[ndimr:ndimr+n], smlsiz)
+
+	// For the nodes on the bottom level of the tree, solve their
+	// subproblems by Dlasdq.
+	for i := (nd - 1) / 2; i < nd; i++ {
+		// ic is the center row of each node, nl is the number of rows of
+		// the top subproblem and nr is the number of rows of the bottom
+		// subproblem. The top subproblem starts at row nlf and the bottom
+		// subproblem at row nrf.
+		ic := iwork[inode+i]
+		nl := iwork[ndiml+i]
+		nr := iwork[ndimr+i]
+		nlf := ic - nl
+		nrf := ic + 1
+		ok = impl.Dlasdq(blas.Upper, 1, nl, nl+1, nl, 0, d[nlf:], e[nlf:], vt[nlf*ldvt+nlf:], ldvt,
+			u[nlf*ldu+nlf:], ldu, u[nlf*ldu+nlf:], ldu, work)
+		if !ok {
+			return false
+		}
+		for j := 0; j < nl; j++ {
+			iwork[idxq+nlf+j] = j
+		}
+		sqrei := 1
+		if i == nd-1 {
+			sqrei = sqre
+		}
+		ok = impl.Dlasdq(blas.Upper, sqrei, nr, nr+sqrei, nr, 0, d[nrf:], e[nrf:], vt[nrf*ldvt+nrf:], ldvt,
+			u[nrf*ldu+nrf:], ldu, u[nrf*ldu+nrf:], ldu, work)
+		if !ok {
+			return false
+		}
+		for j := 0; j < nr; j++ {
+			iwork[idxq+nrf+j] = j
+		}
+	}
+
+	// Now conquer each subproblem bottom-up.
+	for lvl := nlvl; lvl >= 1; lvl-- {
+		// Find the first node lf and last node ll on the current level lvl.
+		lf := 1<<uint(lvl-1) - 1
+		ll := 2 * lf
+		for i := lf; i <= ll; i++ {
+			ic := iwork[inode+i]
+			nl := iwork[ndiml+i]
+			nr := iwork[ndimr+i]
+			nlf := ic - nl
+			sqrei := 1
+			if sqre == 0 && i == ll {
+				sqrei = 0
+			}
+			alpha := d[ic]
+			beta := e[ic]
+			ok = impl.Dlasd1(nl, nr, sqrei, d[nlf:], alpha, beta, u[nlf*ldu+nlf:], ldu, vt[nlf*ldvt+nlf:], ldvt,
+				iwork[idxq+nlf:], iwork[iwk:], work)
+			if !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasd1 computes the SVD of an upper bidiagonal n×m matrix B, where
+// n = nl + nr + 1 and m = n + sqre. Dlasd1 is called from Dlasd0.
+//
+// Dlasd1 computes the SVD as follows:
+//                ( D1(in)    0    0       0 )
+//    B = U(in) * (   Z1ᵀ     a   Z2ᵀ      b ) * VT(in)
+//                (   0       0   D2(in)   0 )
+//      = U(out) * ( D(out) 0) * VT(out)
+// where Zᵀ = (Z1ᵀ a Z2ᵀ b) = uᵀ VTᵀ, and u is a vector of dimension m with
+// alpha and beta in the nl+1 and nl+2 positions and zeros elsewhere. The
+// left singular vectors of the original matrix are stored in U, and the
+// transpose of the right singular vectors are stored in VT, and the singular
+// values are in d. The algorithm consists of three stages:
+//
+// The first stage consists of deflating the size of the problem when there
+// are multiple singular values or when there are zeros in the Z vector. For
+// each such occurrence the dimension of the secular equation problem is
+// reduced by one. This stage is performed by Dlasd2.
+//
+// The second stage consists of calculating the updated singular values. This
+// is done by finding the square roots of the roots of the secular equation
+// via Dlaed4 (as called by Dlasd3). This routine also calculates the singular
+// vectors of the current problem.
+//
+// The final stage consists of computing the updated singular vectors directly
+// using the updated singular values. The singular vectors for the current
+// problem are multiplied with the singular vectors from the overall problem.
+//
+// nl and nr are the row dimensions of the upper and lower blocks and they must
+// be at least one. sqre must be 0 or 1.
+//
+// On entry d[0:nl] contains the singular values of the upper block and
+// d[nl+1:n] contains the singular values of the lower block. On return, d
+// contains the singular values of the modified matrix. d must have length at
+// least n.
+//
+// alpha and beta contain the diagonal and off-diagonal element associated
+// with the added row.
+//
+// On entry u[0:nl, 0:nl] contains the left singular vectors of the upper
+// block and u[nl+1:n, nl+1:n] contains the left singular vectors of the lower
+// block. On return, u contains the left singular vectors of the bidiagonal
+// matrix.
+//
+// On entry vt[0:nl+1, 0:nl+1] contains the transpose of the right singular
+// vectors of the upper block and vt[nl+1:m, nl+1:m] contains the transpose of
+// the right singular vectors of the lower block. On return, vt contains the
+// transpose of the right singular vectors of the bidiagonal matrix.
+//
+// On entry, idxq contains the permutations which separately sort the two
+// subproblems in d into ascending order. On return, it contains the
+// permutation which will reintegrate the subproblem just solved back into
+// sorted order, that is, d[idxq[0:n]] will be in ascending order. idxq must
+// have length at least n.
+//
+// iwork must have length at least 4*n and work must have length at least
+// 3*m^2+2*m.
+//
+// Dlasd1 returns ok == false if a singular value did not converge.
+//
+// Dlasd1 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd1(nl, nr, sqre int, d []float64, alpha, beta float64, u []float64, ldu int, vt []float64, ldvt int, idxq, iwork []int, work []float64) (ok bool) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case ldu < n:
+		panic(badLdU)
+	case ldvt < m:
+		panic(badLdVT)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(idxq) < n:
+		panic(shortIdxq)
+	case len(iwork) < 4*n:
+		panic(shortIWork)
+	case len(work) < 3*m*m+2*m:
+		panic(shortWork)
+	}
+
+	// The following values are for bookkeeping purposes only. They are
+	// offsets into the work and iwork arrays.
+	ldu2 := n
+	ldvt2 := m
+
+	iz := 0
+	isigma := iz + m
+	iu2 := isigma + n
+	ivt2 := iu2 + ldu2*n
+	iq := ivt2 + ldvt2*m
+
+	idx := 0
+	idxc := idx + n
+	coltyp := idxc + n
+	idxp := coltyp + n
+
+	// Scale.
+	orgnrm := math.Max(math.Abs(alpha), math.Abs(beta))
+	d[nl] = 0
+	for _, v := range d[:n] {
+		orgnrm = math.Max(orgnrm, math.Abs(v))
+	}
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+	alpha /= orgnrm
+	beta /= orgnrm
+
+	// Deflate singular values.
+	k := impl.Dlasd2(nl, nr, sqre, d, work[iz:iz+m], alpha, beta, u, ldu, vt, ldvt,
+		work[isigma:isigma+n], work[iu2:iu2+ldu2*n], ldu2, work[ivt2:ivt2+ldvt2*m], ldvt2,
+		iwork[idxp:idxp+n], iwork[idx:idx+n], iwork[idxc:idxc+n], idxq, iwork[coltyp:])
+
+	// Solve secular equation and update singular vectors.
+	ldq := k
+	ok = impl.Dlasd3(nl, nr, sqre, k, d, work[iq:iq+ldq*k], ldq, work[isigma:isigma+n], u, ldu,
+		work[iu2:iu2+ldu2*n], ldu2, vt, ldvt, work[ivt2:ivt2+ldvt2*m], ldvt2,
+		iwork[idxc:idxc+n], iwork[coltyp:coltyp+4], work[iz:iz+m])
+	if !ok {
+		return false
+	}
+
+	// Unscale.
+	impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+
+	// Prepare the idxq sorting permutation.
+	impl.Dlamrg(k, n-k, d, 1, -1, idxq)
+	return true
+}
@@ -0,0 +1,352 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlasd2 merges the two sets of singular values together into a single sorted
+// set. Then it tries to deflate the size of the problem. There are two ways in
+// which deflation can occur: when two or more singular values are close
+// together or if there is a tiny entry in the z vector. For each such
+// occurrence the order of the related secular equation problem is reduced by
+// one. Dlasd2 is called from Dlasd1.
+//
+// nl and nr are the row dimensions of the upper and lower blocks of the
+// bidiagonal matrix, n = nl + nr + 1 and m = n + sqre.
+//
+// On entry d contains the singular values of the two submatrices to be
+// combined. On return d contains the trailing n-k updated singular values
+// (those which were deflated) sorted into decreasing order. d must have length
+// at least n.
+//
+// On return, z contains the updating row vector in the secular equation. z
+// must have length at least m.
+//
+// alpha and beta contain the diagonal and off-diagonal element associated
+// with the added row.
+//
+// On entry u contains the left singular vectors of two submatrices in the two
+// square blocks with corners at (0,0) and (nl+1,nl+1). On return, u contains
+// the trailing n-k updated left singular vectors (those which were deflated)
+// in its last n-k columns.
+//
+// On entry vt contains the transpose of the right singular vectors of two
+// submatrices in the two square blocks with corners at (0,0) and (nl+1,nl+1).
+// On return, vt contains the trailing n-k updated right singular vectors
+// (those which were deflated) in its last n-k rows. In case sqre == 1, the
+// last row of vt spans the right null space.
+//
+// On return, dsigma contains a copy of the diagonal elements (k-1 singular
+// values and one zero) in the secular equation, u2 contains a copy of the
+// first k-1 left singular vectors which will be used by Dlasd3 in a matrix
+// multiply to solve for the new left singular vectors, and vt2 contains a copy
+// of the first k right singular vectors which will be used by Dlasd3. u2 is
+// an n×n matrix and vt2 is an m×m matrix, and dsigma must have length at
+// least n.
+//
+// idxp is the permutation used to place deflated values of d at the end of
+// the array, idx is the permutation used to sort the contents of d into
+// ascending order and idxc is the permutation used to arrange the columns of
+// the deflated u matrix into three groups: the first group contains non-zero
+// entries only at and above nl, the second contains non-zero entries only
+// below nl+1, and the third is dense. idxp, idx and idxc must have length at
+// least n.
+//
+// On entry, idxq contains the permutations which separately sort the two
+// subproblems in d into ascending order. Note that entries in the first half
+// of this permutation must first be moved one position backward and entries
+// in the second half must first have nl+1 added to their values. idxq must
+// have length at least n.
+//
+// On return, the first four elements of coltyp contain the number of columns
+// of each type. coltyp must have length at least max(4,n).
+//
+// Dlasd2 returns the dimension k of the non-deflated matrix, 1 <= k <= n.
+//
+// Dlasd2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd2(nl, nr, sqre int, d, z []float64, alpha, beta float64, u []float64, ldu int, vt []float64, ldvt int, dsigma, u2 []float64, ldu2 int, vt2 []float64, ldvt2 int, idxp, idx, idxc, idxq, coltyp []int) (k int) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case ldu < n:
+		panic(badLdU)
+	case ldvt < m:
+		panic(badLdVT)
+	case ldu2 < n:
+		panic(badLdU2)
+	case ldvt2 < m:
+		panic(badLdVT2)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(z) < m:
+		panic(shortZ)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(dsigma) < n:
+		panic(shortDsigma)
+	case len(u2) < (n-1)*ldu2+n:
+		panic(shortU2)
+	case len(vt2) < (m-1)*ldvt2+m:
+		panic(shortVT2)
+	case len(idxq) < n:
+		panic(shortIdxq)
+	case len(idxp) < n, len(idx) < n, len(idxc) < n, len(coltyp) < max(4, n):
+		panic(shortIWork)
+	}
+
+	bi := blas64.Implementation()
+
+	// Generate the first part of the vector z and move the singular values
+	// in the first part of d one position backward.
+	z1 := alpha * vt[nl*ldvt+nl]
+	z[0] = z1
+	for i := nl - 1; i >= 0; i-- {
+		z[i+1] = alpha * vt[i*ldvt+nl]
+		d[i+1] = d[i]
+		idxq[i+1] = idxq[i] + 1
+	}
+
+	// Generate the second part of the vector z.
+	for i := nl + 1; i < m; i++ {
+		z[i] = beta * vt[i*ldvt+nl+1]
+	}
+
+	// Initialize some reference arrays.
+	for i := 1; i <= nl; i++ {
+		coltyp[i] = 1
+	}
+	for i := nl + 1; i < n; i++ {
+		coltyp[i] = 2
+	}
+
+	// Sort the singular values into increasing order.
+	for i := nl + 1; i < n; i++ {
+		idxq[i] += nl + 1
+	}
+
+	// dsigma, idxc and the first column of u2 are used as storage space.
+	for i := 1; i < n; i++ {
+		dsigma[i] = d[idxq[i]]
+		u2[i*ldu2] = z[idxq[i]]
+		idxc[i] = coltyp[idxq[i]]
+	}
+	impl.Dlamrg(nl, nr, dsigma[1:], 1, 1, idx[1:])
+	for i := 1; i < n; i++ {
+		idxi := 1 + idx[i]
+		d[i] = dsigma[idxi]
+		z[i] = u2[idxi*ldu2]
+		coltyp[i] = idxc[idxi]
+	}
+
+	// Calculate the allowable deflation tolerance.
+	eps := dlamchE
+	tol := math.Max(math.Abs(alpha), math.Abs(beta))
+	tol = 8 * eps * math.Max(math.Abs(d[n-1]), tol)
+
+	// There are 2 kinds of deflation -- first a value in the z-vector is
+	// small, second two (or more) singular values are very close together
+	// (their difference is small).
+	//
+	// If the value in the z-vector is small, we simply permute the array so
+	// that the corresponding singular value is moved to the end.
+	//
+	// If two values in the d-vector are close, we perform a two-sided
+	// rotation designed to make one of the corresponding z-vector entries
+	// zero, and then permute the array so that the deflated singular value is
+	// moved to the end.
+	//
+	// If there are multiple singular values then the problem deflates. Here
+	// the number of equal singular values are found. As each equal singular
+	// value is found, an elementary reflector is computed to rotate the
+	// corresponding singular subspace so that the corresponding components
+	// of z are zero in this new basis.
+	k = 1
+	k2 := n
+	jprev := -1
+	for j := 1; j < n; j++ {
+		if math.Abs(z[j]) > tol {
+			jprev = j
+			break
+		}
+		// Deflate due to small z component.
+		k2--
+		idxp[k2] = j
+		coltyp[j] = 4
+	}
+	if jprev >= 0 {
+		for j := jprev + 1; j < n; j++ {
+			if math.Abs(z[j]) <= tol {
+				// Deflate due to small z component.
+				k2--
+				idxp[k2] = j
+				coltyp[j] = 4
+				continue
+			}
+
+			// Check if singular values are close enough to allow deflation.
+			if math.Abs(d[j]-d[jprev]) > tol {
+				dsigma[k] = d[jprev]
+				u2[k*ldu2] = z[jprev]
+				idxp[k] = jprev
+				k++
+				jprev = j
+				continue
+			}
+
+			// Deflation is possible.
+			s := z[jprev]
+			c := z[j]
+
+			// Find sqrt(a^2+b^2) without overflow or destructive underflow.
+			tau := impl.Dlapy2(c, s)
+			c /= tau
+			s = -s / tau
+			z[j] = tau
+			z[jprev] = 0
+
+			// Apply back the Givens rotation to the left and right singular
+			// vector matrices.
+			idxjp := idxq[idx[jprev]+1]
+			idxj := idxq[idx[j]+1]
+			if idxjp <= nl {
+				idxjp--
+			}
+			if idxj <= nl {
+				idxj--
+			}
+			bi.Drot(n, u[idxjp:], ldu, u[idxj:], ldu, c, s)
+			bi.Drot(m, vt[idxjp*ldvt:], 1, vt[idxj*ldvt:], 1, c, s)
+			if coltyp[j] != coltyp[jprev] {
+				coltyp[j] = 3
+			}
+			coltyp[jprev] = 4
+			k2--
+			idxp[k2] = jprev
+			jprev = j
+		}
+
+		// Record the last singular value.
+		dsigma[k] = d[jprev]
+		u2[k*ldu2] = z[jprev]
+		idxp[k] = jprev
+		k++
+	}
+
+	// Count up the total number of the various types of columns, then form
+	// a permutation which positions the four column types into four groups
+	// of uniform structure (although one or more of these groups may be
+	// empty).
+	var ctot, psm [4]int
+	for j := 1; j < n; j++ {
+		ctot[coltyp[j]-1]++
+	}
+
+	// psm is the position in the submatrix of types 1 through 4.
+	psm[0] = 1
+	psm[1] = 1 + ctot[0]
+	psm[2] = psm[1] + ctot[1]
+	psm[3] = psm[2] + ctot[2]
+
+	// Fill out the idxc array so that the permutation which it induces will
+	// place all type-1 columns first, all type-2 columns next, then all
+	// type-3's, and finally all type-4's, starting from the second column.
+	// This applies similarly to the rows of vt.
+	for j := 1; j < n; j++ {
+		ct := coltyp[idxp[j]] - 1
+		idxc[psm[ct]] = j
+		psm[ct]++
+	}
+
+	// Sort the singular values and corresponding singular vectors into
+	// dsigma, u2 and vt2 respectively. The singular values and vectors which
+	// were not deflated go into the first k slots of dsigma, u2 and vt2
+	// respectively, while those which were deflated go into the last n-k
+	// slots, except that the first column and row will be treated
+	// separately.
+	for j := 1; j < n; j++ {
+		dsigma[j] = d[idxp[j]]
+		idxj := idxq[idx[idxp[idxc[j]]]+1]
+		if idxj <= nl {
+			idxj--
+		}
+		bi.Dcopy(n, u[idxj:], ldu, u2[j:], ldu2)
+		bi.Dcopy(m, vt[idxj*ldvt:], 1, vt2[j*ldvt2:], 1)
+	}
+
+	// Determine dsigma[0], dsigma[1] and z[0].
+	dsigma[0] = 0
+	hlftol := tol / 2
+	if math.Abs(dsigma[1]) <= hlftol {
+		dsigma[1] = hlftol
+	}
+	var c, s float64
+	if m > n {
+		z[0] = impl.Dlapy2(z1, z[m-1])
+		if z[0] <= tol {
+			c = 1
+			s = 0
+			z[0] = tol
+		} else {
+			c = z1 / z[0]
+			s = z[m-1] / z[0]
+		}
+	} else {
+		if math.Abs(z1) <= tol {
+			z[0] = tol
+		} else {
+			z[0] = z1
+		}
+	}
+
+	// Move the rest of the updating row to z.
+	bi.Dcopy(k-1, u2[ldu2:], ldu2, z[1:], 1)
+
+	// Determine the first column of u2, the first row of vt2 and the last
+	// row of vt.
+	impl.Dlaset(blas.All, n, 1, 0, 0, u2, ldu2)
+	u2[nl*ldu2] = 1
+	if m > n {
+		for i := 0; i <= nl; i++ {
+			vt[(m-1)*ldvt+i] = -s * vt[nl*ldvt+i]
+			vt2[i] = c * vt[nl*ldvt+i]
+		}
+		for i := nl + 1; i < m; i++ {
+			vt2[i] = s * vt[(m-1)*ldvt+i]
+			vt[(m-1)*ldvt+i] *= c
+		}
+		bi.Dcopy(m, vt[(m-1)*ldvt:], 1, vt2[(m-1)*ldvt2:], 1)
+	} else {
+		bi.Dcopy(m, vt[nl*ldvt:], 1, vt2, 1)
+	}
+
+	// The deflated singular values and their corresponding vectors go into
+	// the back of d, u and vt respectively.
+	if n > k {
+		bi.Dcopy(n-k, dsigma[k:], 1, d[k:], 1)
+		impl.Dlacpy(blas.All, n, n-k, u2[k:], ldu2, u[k:], ldu)
+		impl.Dlacpy(blas.All, n-k, m, vt2[k*ldvt2:], ldvt2, vt[k*ldvt:], ldvt)
+	}
+
+	// Copy ctot into coltyp for referencing in Dlasd3.
+	copy(coltyp[:4], ctot[:])
+
+	return k
+}
@@ -0,0 +1,245 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasd3 finds all the square roots of the roots of the secular equation, as
+// defined by the values in dsigma and z. It makes the appropriate calls to
+// Dlaed4 and then updates the singular vectors by matrix multiplication.
+// Dlasd3 is called from Dlasd1.
+//
+// The squares of the singular values are computed as the eigenvalues of the
+// rank-one modification
+//  diag(dsigma)^2 + z * zᵀ
+// so that the differences dsigma[j]^2 - d[i]^2 needed for the singular
+// vectors are available directly from Dlaed4.
+//
+// nl and nr are the row dimensions of the upper and lower blocks of the
+// bidiagonal matrix, n = nl + nr + 1 and m = n + sqre. k is the size of the
+// secular equation, 1 <= k <= n.
+//
+// On return, d contains the square roots of the roots of the secular equation
+// in ascending order. d must have length at least k.
+//
+// q is a workspace k×k matrix.
+//
+// dsigma contains the first k entries of the deflation-adjusted diagonal
+// matrix of the secular equation. dsigma[0] must be zero and the other
+// entries must be strictly increasing.
+//
+// On return, u contains the left singular vectors of the bidiagonal matrix
+// in its first k columns. u2 contains a copy of the first k-1 left singular
+// vectors in columns 1 through k-1 and the first column of u2 is the unit
+// vector e_nl.
+//
+// On return, vt contains the transpose of the right singular vectors of the
+// bidiagonal matrix in its first k rows. The first k rows of vt2 contain a
+// copy of the transposed right singular vectors which will be used to
+// compute the updated ones.
+//
+// idxc contains the permutation used to arrange the columns of u and the rows
+// of vt into three groups. ctot contains the number of columns of each type
+// as returned in coltyp by Dlasd2.
+//
+// z contains the components of the deflation-adjusted updating row vector and
+// it is destroyed on return.
+//
+// Dlasd3 returns ok == false if a singular value did not converge.
+//
+// Dlasd3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd3(nl, nr, sqre, k int, d, q []float64, ldq int, dsigma, u []float64, ldu int, u2 []float64, ldu2 int, vt []float64, ldvt int, vt2 []float64, ldvt2 int, idxc, ctot []int, z []float64) (ok bool) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case k < 1:
+		panic(kLT1)
+	case k > n:
+		panic(kGTN)
+	case ldq < k:
+		panic(badLdQ)
+	case ldu < n:
+		panic(badLdU)
+	case ldu2 < n:
+		panic(badLdU2)
+	case ldvt < m:
+		panic(badLdVT)
+	case ldvt2 < m:
+		panic(badLdVT2)
+	}
+
+	switch {
+	case len(d) < k:
+		panic(shortD)
+	case len(q) < (k-1)*ldq+k:
+		panic(shortQ)
+	case len(dsigma) < k:
+		panic(shortDsigma)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(u2) < (n-1)*ldu2+n:
+		panic(shortU2)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(vt2) < (m-1)*ldvt2+m:
+		panic(shortVT2)
+	case len(idxc) < k:
+		panic(shortIWork)
+	case len(ctot) != 4:
+		panic(badLenCtot)
+	case len(z) < k:
+		panic(shortZ)
+	}
+
+	bi := blas64.Implementation()
+
+	// Quick return if possible.
+	if k == 1 {
+		d[0] = math.Abs(z[0])
+		bi.Dcopy(m, vt2, 1, vt, 1)
+		if z[0] > 0 {
+			bi.Dcopy(n, u2, ldu2, u, ldu)
+		} else {
+			for i := 0; i < n; i++ {
+				u[i*ldu] = -u2[i*ldu2]
+			}
+		}
+		return true
+	}
+
+	if k == 2 {
+		// The secular equation is that of the 2×2 upper triangular
+		//  [z[0]       z[1]]
+		//  [   0  dsigma[1]]
+		// whose singular value decomposition is computed directly.
+		ssmin, ssmax, snr, csr, snl, csl := impl.Dlasv2(z[0], z[1], dsigma[1])
+		d[0] = math.Abs(ssmin)
+		d[1] = math.Abs(ssmax)
+		sgnmin := math.Copysign(1, ssmin)
+		sgnmax := math.Copysign(1, ssmax)
+		q[0] = -snl * sgnmin
+		q[ldq] = csl * sgnmin
+		q[1] = csl * sgnmax
+		q[ldq+1] = snl * sgnmax
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n, k, k, 1, u2, ldu2, q, ldq, 0, u, ldu)
+		q[0] = -snr
+		q[1] = csr
+		q[ldq] = csr
+		q[ldq+1] = snr
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, k, m, k, 1, q, ldq, vt2, ldvt2, 0, vt, ldvt)
+		return true
+	}
+
+	// Normalize z.
+	rho := bi.Dnrm2(k, z, 1)
+	impl.Dlascl(lapack.General, 0, 0, rho, 1, k, 1, z, 1)
+	rho *= rho
+
+	// Find the new singular values. The first two rows of q are used to
+	// store the squares of dsigma and the differences returned by Dlaed4,
+	// and the differences dsigma[j]^2 - d[i]^2 are kept in the i-th column
+	// of the leading k×k block of vt.
+	dsq := q[:k]
+	delta := q[ldq : ldq+k]
+	for j, v := range dsigma[:k] {
+		dsq[j] = v * v
+	}
+	for i := 0; i < k; i++ {
+		lambda, ok := impl.Dlaed4(k, i, dsq, z, delta, rho)
+		if !ok {
+			return false
+		}
+		d[i] = math.Sqrt(lambda)
+		bi.Dcopy(k, delta, 1, vt[i:], ldvt)
+	}
+
+	// Compute updated z.
+	for i := 0; i < k; i++ {
+		zi := vt[i*ldvt+k-1]
+		for j := 0; j < i; j++ {
+			zi *= vt[i*ldvt+j] / (dsq[i] - dsq[j])
+		}
+		for j := i; j < k-1; j++ {
+			zi *= vt[i*ldvt+j] / (dsq[i] - dsq[j+1])
+		}
+		z[i] = math.Copysign(math.Sqrt(math.Abs(zi)), z[i])
+	}
+
+	// Compute left singular vectors of the modified diagonal matrix, and
+	// store related information for the right singular vectors.
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			vt[j*ldvt+i] = z[j] / vt[j*ldvt+i]
+		}
+		q[i] = -1
+		for j := 1; j < k; j++ {
+			jc := idxc[j]
+			q[j*ldq+i] = dsigma[jc] * vt[jc*ldvt+i]
+		}
+		temp := bi.Dnrm2(k, q[i:], ldq)
+		bi.Dscal(k, 1/temp, q[i:], ldq)
+	}
+
+	// Update the left singular vector matrix.
+	switch {
+	case ctot[0] > 0:
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, nl, k, ctot[0], 1, u2[1:], ldu2, q[ldq:], ldq, 0, u, ldu)
+		if ctot[2] > 0 {
+			ktemp := 1 + ctot[0] + ctot[1]
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, nl, k, ctot[2], 1, u2[ktemp:], ldu2, q[ktemp*ldq:], ldq, 1, u, ldu)
+		}
+	case ctot[2] > 0:
+		ktemp := 1 + ctot[0] + ctot[1]
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, nl, k, ctot[2], 1, u2[ktemp:], ldu2, q[ktemp*ldq:], ldq, 0, u, ldu)
+	default:
+		impl.Dlacpy(blas.All, nl, k, u2, ldu2, u, ldu)
+	}
+	bi.Dcopy(k, q, 1, u[nl*ldu:], 1)
+	ktemp := 1 + ctot[0]
+	ctemp := ctot[1] + ctot[2]
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, nr, k, ctemp, 1, u2[(nl+1)*ldu2+ktemp:], ldu2, q[ktemp*ldq:], ldq, 0, u[(nl+1)*ldu:], ldu)
+
+	// Generate the right singular vectors.
+	for i := 0; i < k; i++ {
+		temp := bi.Dnrm2(k, vt[i:], ldvt)
+		q[i*ldq] = vt[i] / temp
+		for j := 1; j < k; j++ {
+			jc := idxc[j]
+			q[i*ldq+j] = vt[jc*ldvt+i] / temp
+		}
+	}
+
+	// Update the right singular vector matrix.
+	ktemp = 1 + ctot[0]
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, k, nl+1, ktemp, 1, q, ldq, vt2, ldvt2, 0, vt, ldvt)
+	if ctot[2] > 0 {
+		ktemp = 1 + ctot[0] + ctot[1]
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, k, nl+1, ctot[2], 1, q[ktemp:], ldq, vt2[ktemp*ldvt2:], ldvt2, 1, vt, ldvt)
+	}
+	ktemp = ctot[0]
+	if ktemp > 0 {
+		for i := 0; i < k; i++ {
+			q[i*ldq+ktemp] = q[i*ldq]
+		}
+		for i := nl + 1; i < m; i++ {
+			vt2[ktemp*ldvt2+i] = vt2[i]
+		}
+	}
+	ctemp = 1 + ctot[1] + ctot[2]
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, k, nr+sqre, ctemp, 1, q[ktemp:], ldq, vt2[ktemp*ldvt2+nl+1:], ldvt2, 0, vt[nl+1:], ldvt)
+	return true
+}
@@ -0,0 +1,204 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasdq computes the singular value decomposition of a real (upper or lower)
+// bidiagonal matrix B with diagonal d and off-diagonal e. If sqre == 0, B is
+// square n×n, otherwise B is non-square: n×(n+1) if uplo == blas.Upper and
+// (n+1)×n if uplo == blas.Lower. B is decomposed as
+//  B = Q * S * Pᵀ
+// where S is a diagonal matrix of singular values, Q is an orthogonal matrix
+// of left singular vectors and P is an orthogonal matrix of right singular
+// vectors.
+//
+// d must have length at least n and on return it contains the singular values
+// of B in ascending order. e must have length at least n-1+sqre and it is
+// destroyed on return.
+//
+// VT is a matrix whose elements are stored in vt. It has n+sqre rows if
+// uplo == blas.Upper and n rows otherwise, and ncvt columns. On return, vt
+// contains Pᵀ * VT. VT is not referenced if ncvt == 0.
+//
+// U is a matrix whose elements are stored in u. It has nru rows, and n+sqre
+// columns if uplo == blas.Lower and n columns otherwise. On return, u contains
+// U * Q. U is not referenced if nru == 0.
+//
+// C is a matrix whose elements are stored in c. It has n+sqre rows if
+// uplo == blas.Lower and n rows otherwise, and ncc columns. On return, c
+// contains Qᵀ * C. C is not referenced if ncc == 0.
+//
+// work must have length at least 4*n.
+//
+// Dlasdq returns whether the decomposition was successful.
+//
+// Dlasdq is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasdq(uplo blas.Uplo, sqre, n, ncvt, nru, ncc int, d, e, vt []float64, ldvt int, u []float64, ldu int, c []float64, ldc int, work []float64) (ok bool) {
+	// Number of rows of VT and C and number of columns of U.
+	nrvt, nrc := n, n
+	if sqre == 1 {
+		if uplo == blas.Upper {
+			nrvt++
+		} else {
+			nrc++
+		}
+	}
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case n < 0:
+		panic(nLT0)
+	case ncvt < 0:
+		panic(ncvtLT0)
+	case nru < 0:
+		panic(nruLT0)
+	case ncc < 0:
+		panic(nccLT0)
+	case ldvt < max(1, ncvt):
+		panic(badLdVT)
+	case ldu < 1, nru > 0 && ldu < nrc:
+		panic(badLdU)
+	case ldc < max(1, ncc):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1+sqre:
+		panic(shortE)
+	case ncvt > 0 && len(vt) < (nrvt-1)*ldvt+ncvt:
+		panic(shortVT)
+	case nru > 0 && len(u) < (nru-1)*ldu+nrc:
+		panic(shortU)
+	case ncc > 0 && len(c) < (nrc-1)*ldc+ncc:
+		panic(shortC)
+	case len(work) < 4*n:
+		panic(shortWork)
+	}
+
+	rotate := ncvt > 0 || nru > 0 || ncc > 0
+	np1 := n + 1
+	sqre1 := sqre
+
+	// If matrix non-square upper bidiagonal, rotate to be lower bidiagonal.
+	// The rotations are on the right.
+	lower := uplo == blas.Lower
+	if !lower && sqre1 == 1 {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+		cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+		d[n-1] = r
+		e[n-1] = 0
+		if rotate {
+			work[n-1] = cs
+			work[2*n-1] = sn
+		}
+		lower = true
+		sqre1 = 0
+
+		// Update singular vectors if desired.
+		if ncvt > 0 {
+			impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, np1, ncvt, work[:n], work[n:], vt, ldvt)
+		}
+	}
+
+	// If matrix lower bidiagonal, rotate to be upper bidiagonal by applying
+	// Givens rotations on the left.
+	if lower {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+
+		// If matrix (n+1)×n lower bidiagonal, one additional rotation is
+		// needed.
+		if sqre1 == 1 {
+			cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+			d[n-1] = r
+			if rotate {
+				work[n-1] = cs
+				work[2*n-1] = sn
+			}
+		}
+
+		// Update singular vectors if desired.
+		if nru > 0 {
+			if sqre1 == 0 {
+				impl.Dlasr(blas.Right, lapack.Variable, lapack.Forward, nru, n, work[:n], work[n:], u, ldu)
+			} else {
+				impl.Dlasr(blas.Right, lapack.Variable, lapack.Forward, nru, np1, work[:n], work[n:], u, ldu)
+			}
+		}
+		if ncc > 0 {
+			if sqre1 == 0 {
+				impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, n, ncc, work[:n], work[n:], c, ldc)
+			} else {
+				impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, np1, ncc, work[:n], work[n:], c, ldc)
+			}
+		}
+	}
+
+	// Call Dbdsqr to compute the SVD of the reduced real n×n upper
+	// bidiagonal matrix.
+	ok = impl.Dbdsqr(blas.Upper, n, ncvt, nru, ncc, d, e, vt, ldvt, u, ldu, c, ldc, work)
+
+	// Sort the singular values into ascending order with only one
+	// transposition per singular vector.
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		// Scan for smallest d[i].
+		isub := i
+		smin := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < smin {
+				isub = j
+				smin = d[j]
+			}
+		}
+		if isub == i {
+			continue
+		}
+		// Swap singular values and vectors.
+		d[isub] = d[i]
+		d[i] = smin
+		if ncvt > 0 {
+			bi.Dswap(ncvt, vt[isub*ldvt:], 1, vt[i*ldvt:], 1)
+		}
+		if nru > 0 {
+			bi.Dswap(nru, u[isub:], ldu, u[i:], ldu)
+		}
+		if ncc > 0 {
+			bi.Dswap(ncc, c[isub*ldc:], 1, c[i*ldc:], 1)
+		}
+	}
+	return ok
+}
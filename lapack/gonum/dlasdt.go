@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlasdt creates a tree of subproblems for bidiagonal divide and conquer.
+//
+// n is the number of diagonal elements of the bidiagonal matrix and msub is
+// the maximum row dimension of each subproblem at the bottom of the tree.
+//
+// On return, inode contains the indices of the centers of the subproblems,
+// and ndiml and ndimr contain the row dimensions of the left and right child
+// of each node. inode, ndiml and ndimr must have length at least n.
+//
+// Dlasdt returns the number of levels lvl of the computation tree and the
+// number of nodes nd in the tree.
+//
+// Dlasdt is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlasdt(n int, inode, ndiml, ndimr []int, msub int) (lvl, nd int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case msub < 1:
+		panic(badSmlsiz)
+	case len(inode) < n:
+		panic(shortInode)
+	case len(ndiml) < n, len(ndimr) < n:
+		panic(shortIWork)
+	}
+
+	// Find the number of levels on the tree.
+	maxn := max(1, n)
+	temp := math.Log(float64(maxn)/float64(msub+1)) / math.Ln2
+	lvl = int(temp) + 1
+
+	if n == 0 {
+		return lvl, 0
+	}
+
+	i := n / 2
+	inode[0] = i
+	ndiml[0] = i
+	ndimr[0] = n - i - 1
+	il := -1
+	ir := 0
+	llst := 1
+	for nlvl := 1; nlvl < lvl; nlvl++ {
+		// Constructing the tree at (nlvl+1)-st level. The number of nodes
+		// created on this level is llst * 2.
+		for i := 0; i < llst; i++ {
+			il += 2
+			ir += 2
+			ncrnt := llst + i - 1
+			ndiml[il] = ndiml[ncrnt] / 2
+			ndimr[il] = ndiml[ncrnt] - ndiml[il] - 1
+			inode[il] = inode[ncrnt] - ndimr[il] - 1
+			ndiml[ir] = ndimr[ncrnt] / 2
+			ndimr[ir] = ndimr[ncrnt] - ndiml[ir] - 1
+			inode[ir] = inode[ncrnt] + ndiml[ir] + 1
+		}
+		llst *= 2
+	}
+	return lvl, 2*llst - 1
+}
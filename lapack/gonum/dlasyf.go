@@ -0,0 +1,421 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlasyf computes a partial factorization of a symmetric n×n matrix A using
+// the Bunch-Kaufman diagonal pivoting method. The partial factorization has
+// the form
+//  A = [ I U12 ] [ A11  0  ] [  I     0   ]  if uplo == blas.Upper, or
+//      [ 0 U22 ] [  0   D  ] [ U12ᵀ U22ᵀ ]
+//
+//  A = [ L11 0 ] [ D   0  ] [ L11ᵀ L21ᵀ ]    if uplo == blas.Lower,
+//      [ L21 I ] [ 0  A22 ] [  0     I   ]
+// where the order of D is at most nb. The actual order is returned in kb and
+// is either nb or nb-1, or n if n <= nb.
+//
+// Dlasyf is an auxiliary routine called by Dsytrf. It uses blocked code
+// (calling Level 3 BLAS) to update the submatrix A11 (if uplo == blas.Upper)
+// or A22 (if uplo == blas.Lower).
+//
+// On entry, a contains the upper or lower triangle of A as specified by uplo.
+// On return, a contains details of the partial factorization. See Dsytrf for
+// further details.
+//
+// ipiv must have length n and contains details of the interchanges and the
+// block structure of D on return. If uplo == blas.Upper, only the last kb
+// elements of ipiv are set, and if uplo == blas.Lower, only the first kb
+// elements are set.
+//
+// w is an n×nb workspace matrix with row stride ldw >= max(1,nb).
+//
+// Dlasyf returns whether the computed diagonal block D is nonsingular.
+//
+// Dlasyf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasyf(uplo blas.Uplo, n, nb int, a []float64, lda int, ipiv []int, w []float64, ldw int) (kb int, ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nb < 0:
+		panic(nbLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldw < max(1, nb):
+		panic(badLdW)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(w) < (n-1)*ldw+nb:
+		panic(shortW)
+	}
+
+	bi := blas64.Implementation()
+
+	// alpha is used to determine the pivot block size.
+	alpha := (1 + math.Sqrt(17)) / 8
+
+	ok = true
+	if uplo == blas.Upper {
+		// Factorize the trailing columns of A using the upper triangle of A
+		// and working backwards, and compute the matrix W = U12*D for use in
+		// updating A11.
+		//
+		// k is the main loop index, decreasing from n-1 in steps of 1 or 2,
+		// and kw is the column of W which corresponds to column k of A.
+		k := n - 1
+		for {
+			kw := nb + k - n
+			// Exit from the loop.
+			if (k <= n-nb && nb < n) || k < 0 {
+				break
+			}
+			kstep := 1
+
+			// Copy column k of A to column kw of W and update it.
+			bi.Dcopy(k+1, a[k:], lda, w[kw:], ldw)
+			if k < n-1 {
+				bi.Dgemv(blas.NoTrans, k+1, n-k-1, -1, a[k+1:], lda, w[k*ldw+kw+1:], 1, 1, w[kw:], ldw)
+			}
+
+			// Determine rows and columns to be interchanged and whether a
+			// 1×1 or 2×2 pivot block will be used.
+			absakk := math.Abs(w[k*ldw+kw])
+			var imax int
+			var colmax float64
+			if k > 0 {
+				imax = bi.Idamax(k, w[kw:], ldw)
+				colmax = math.Abs(w[imax*ldw+kw])
+			}
+
+			var kp int
+			if math.Max(absakk, colmax) == 0 || math.IsNaN(absakk) {
+				// Column k is zero or contains a NaN.
+				ok = false
+				kp = k
+				bi.Dcopy(k+1, w[kw:], ldw, a[k:], lda)
+			} else {
+				if absakk >= alpha*colmax {
+					// No interchange, use 1×1 pivot block.
+					kp = k
+				} else {
+					// Copy column imax to column kw-1 of W and update it.
+					bi.Dcopy(imax+1, a[imax:], lda, w[kw-1:], ldw)
+					bi.Dcopy(k-imax, a[imax*lda+imax+1:], 1, w[(imax+1)*ldw+kw-1:], ldw)
+					if k < n-1 {
+						bi.Dgemv(blas.NoTrans, k+1, n-k-1, -1, a[k+1:], lda, w[imax*ldw+kw+1:], 1, 1, w[kw-1:], ldw)
+					}
+
+					// jmax is the row index of the largest off-diagonal
+					// element in row imax, and rowmax is its absolute value.
+					jmax := imax + 1 + bi.Idamax(k-imax, w[(imax+1)*ldw+kw-1:], ldw)
+					rowmax := math.Abs(w[jmax*ldw+kw-1])
+					if imax > 0 {
+						jmax = bi.Idamax(imax, w[kw-1:], ldw)
+						rowmax = math.Max(rowmax, math.Abs(w[jmax*ldw+kw-1]))
+					}
+
+					switch {
+					case absakk >= alpha*colmax*(colmax/rowmax):
+						// No interchange, use 1×1 pivot block.
+						kp = k
+					case math.Abs(w[imax*ldw+kw-1]) >= alpha*rowmax:
+						// Interchange rows and columns k and imax, use 1×1
+						// pivot block.
+						kp = imax
+						// Copy column kw-1 of W to column kw.
+						bi.Dcopy(k+1, w[kw-1:], ldw, w[kw:], ldw)
+					default:
+						// Interchange rows and columns k-1 and imax, use 2×2
+						// pivot block.
+						kp = imax
+						kstep = 2
+					}
+				}
+
+				// kk is the column of A where pivoting step stopped, and kkw
+				// is the corresponding column of W.
+				kk := k - kstep + 1
+				kkw := nb + kk - n
+
+				// Interchange rows and columns kp and kk. Updated column kp
+				// is already stored in column kkw of W.
+				if kp != kk {
+					// Copy non-updated column kk to column kp.
+					a[kp*lda+kp] = a[kk*lda+kk]
+					bi.Dcopy(kk-kp-1, a[(kp+1)*lda+kk:], lda, a[kp*lda+kp+1:], 1)
+					if kp > 0 {
+						bi.Dcopy(kp, a[kk:], lda, a[kp:], lda)
+					}
+					// Interchange rows kk and kp in last k+1 columns of A
+					// and W.
+					if k < n-1 {
+						bi.Dswap(n-k-1, a[kk*lda+k+1:], 1, a[kp*lda+k+1:], 1)
+					}
+					bi.Dswap(n-kk, w[kk*ldw+kkw:], 1, w[kp*ldw+kkw:], 1)
+				}
+
+				if kstep == 1 {
+					// 1×1 pivot block D[k]: column kw of W now holds
+					//  W[k] = U[k]*D[k]
+					// where U[k] is the k-th column of U.
+					//
+					// Store U[k] in column k of A.
+					bi.Dcopy(k+1, w[kw:], ldw, a[k:], lda)
+					r1 := 1 / a[k*lda+k]
+					bi.Dscal(k, r1, a[k:], lda)
+				} else {
+					// 2×2 pivot block D[k]: columns kw and kw-1 of W now hold
+					//  [W[k-1] W[k]] = [U[k-1] U[k]]*D[k]
+					// where U[k] and U[k-1] are the k-th and (k-1)-th
+					// columns of U.
+					if k > 1 {
+						// Store U[k] and U[k-1] in columns k and k-1 of A.
+						d21 := w[(k-1)*ldw+kw]
+						d11 := w[k*ldw+kw] / d21
+						d22 := w[(k-1)*ldw+kw-1] / d21
+						t := 1 / (d11*d22 - 1)
+						d21 = t / d21
+						for j := 0; j < k-1; j++ {
+							a[j*lda+k-1] = d21 * (d11*w[j*ldw+kw-1] - w[j*ldw+kw])
+							a[j*lda+k] = d21 * (d22*w[j*ldw+kw] - w[j*ldw+kw-1])
+						}
+					}
+					// Copy D[k] to A.
+					a[(k-1)*lda+k-1] = w[(k-1)*ldw+kw-1]
+					a[(k-1)*lda+k] = w[(k-1)*ldw+kw]
+					a[k*lda+k] = w[k*ldw+kw]
+				}
+			}
+
+			// Store details of the interchanges in ipiv.
+			if kstep == 1 {
+				ipiv[k] = kp
+			} else {
+				ipiv[k] = -kp - 1
+				ipiv[k-1] = -kp - 1
+			}
+			k -= kstep
+		}
+
+		// Update the upper triangle of A11 (= A[0:k+1,0:k+1]) as
+		//  A11 := A11 - U12*D*U12ᵀ = A11 - U12*Wᵀ
+		// computing blocks of nb columns at a time.
+		kw := nb + k - n
+		for j := (k / nb) * nb; j >= 0; j -= nb {
+			jb := min(nb, k-j+1)
+			// Update the upper triangle of the diagonal block.
+			for jj := j; jj < j+jb; jj++ {
+				bi.Dgemv(blas.NoTrans, jj-j+1, n-k-1, -1, a[j*lda+k+1:], lda, w[jj*ldw+kw+1:], 1, 1, a[j*lda+jj:], lda)
+			}
+			// Update the rectangular superdiagonal block.
+			if j > 0 {
+				bi.Dgemm(blas.NoTrans, blas.Trans, j, jb, n-k-1, -1, a[k+1:], lda, w[j*ldw+kw+1:], ldw, 1, a[j:], lda)
+			}
+		}
+
+		// Put U12 in standard form by partially undoing the interchanges in
+		// columns k+1:n.
+		for j := k + 1; j < n-1; {
+			jj := j
+			jp := ipiv[j]
+			if jp < 0 {
+				jp = -jp - 1
+				j++
+			}
+			j++
+			if jp != jj && j < n {
+				bi.Dswap(n-j, a[jp*lda+j:], 1, a[jj*lda+j:], 1)
+			}
+		}
+
+		// Set kb to the number of columns factorized.
+		return n - k - 1, ok
+	}
+
+	// Factorize the leading columns of A using the lower triangle of A and
+	// working forwards, and compute the matrix W = L21*D for use in updating
+	// A22.
+	//
+	// k is the main loop index, increasing from 0 in steps of 1 or 2.
+	k := 0
+	for {
+		// Exit from the loop.
+		if (k >= nb-1 && nb < n) || k >= n {
+			break
+		}
+		kstep := 1
+
+		// Copy column k of A to column k of W and update it.
+		bi.Dcopy(n-k, a[k*lda+k:], lda, w[k*ldw+k:], ldw)
+		bi.Dgemv(blas.NoTrans, n-k, k, -1, a[k*lda:], lda, w[k*ldw:], 1, 1, w[k*ldw+k:], ldw)
+
+		// Determine rows and columns to be interchanged and whether a 1×1 or
+		// 2×2 pivot block will be used.
+		absakk := math.Abs(w[k*ldw+k])
+		var imax int
+		var colmax float64
+		if k < n-1 {
+			imax = k + 1 + bi.Idamax(n-k-1, w[(k+1)*ldw+k:], ldw)
+			colmax = math.Abs(w[imax*ldw+k])
+		}
+
+		var kp int
+		if math.Max(absakk, colmax) == 0 || math.IsNaN(absakk) {
+			// Column k is zero or contains a NaN.
+			ok = false
+			kp = k
+			bi.Dcopy(n-k, w[k*ldw+k:], ldw, a[k*lda+k:], lda)
+		} else {
+			if absakk >= alpha*colmax {
+				// No interchange, use 1×1 pivot block.
+				kp = k
+			} else {
+				// Copy column imax to column k+1 of W and update it.
+				bi.Dcopy(imax-k, a[imax*lda+k:], 1, w[k*ldw+k+1:], ldw)
+				bi.Dcopy(n-imax, a[imax*lda+imax:], lda, w[imax*ldw+k+1:], ldw)
+				bi.Dgemv(blas.NoTrans, n-k, k, -1, a[k*lda:], lda, w[imax*ldw:], 1, 1, w[k*ldw+k+1:], ldw)
+
+				// jmax is the row index of the largest off-diagonal element
+				// in row imax, and rowmax is its absolute value.
+				jmax := k + bi.Idamax(imax-k, w[k*ldw+k+1:], ldw)
+				rowmax := math.Abs(w[jmax*ldw+k+1])
+				if imax < n-1 {
+					jmax = imax + 1 + bi.Idamax(n-imax-1, w[(imax+1)*ldw+k+1:], ldw)
+					rowmax = math.Max(rowmax, math.Abs(w[jmax*ldw+k+1]))
+				}
+
+				switch {
+				case absakk >= alpha*colmax*(colmax/rowmax):
+					// No interchange, use 1×1 pivot block.
+					kp = k
+				case math.Abs(w[imax*ldw+k+1]) >= alpha*rowmax:
+					// Interchange rows and columns k and imax, use 1×1 pivot
+					// block.
+					kp = imax
+					// Copy column k+1 of W to column k.
+					bi.Dcopy(n-k, w[k*ldw+k+1:], ldw, w[k*ldw+k:], ldw)
+				default:
+					// Interchange rows and columns k+1 and imax, use 2×2
+					// pivot block.
+					kp = imax
+					kstep = 2
+				}
+			}
+
+			// kk is the column of A where pivoting step stopped.
+			kk := k + kstep - 1
+
+			// Interchange rows and columns kp and kk. Updated column kp is
+			// already stored in column kk of W.
+			if kp != kk {
+				// Copy non-updated column kk to column kp.
+				a[kp*lda+kp] = a[kk*lda+kk]
+				bi.Dcopy(kp-kk-1, a[(kk+1)*lda+kk:], lda, a[kp*lda+kk+1:], 1)
+				if kp < n-1 {
+					bi.Dcopy(n-kp-1, a[(kp+1)*lda+kk:], lda, a[(kp+1)*lda+kp:], lda)
+				}
+				// Interchange rows kk and kp in first k columns of A and in
+				// first kk+1 columns of W.
+				if k > 0 {
+					bi.Dswap(k, a[kk*lda:], 1, a[kp*lda:], 1)
+				}
+				bi.Dswap(kk+1, w[kk*ldw:], 1, w[kp*ldw:], 1)
+			}
+
+			if kstep == 1 {
+				// 1×1 pivot block D[k]: column k of W now holds
+				//  W[k] = L[k]*D[k]
+				// where L[k] is the k-th column of L.
+				//
+				// Store L[k] in column k of A.
+				bi.Dcopy(n-k, w[k*ldw+k:], ldw, a[k*lda+k:], lda)
+				if k < n-1 {
+					r1 := 1 / a[k*lda+k]
+					bi.Dscal(n-k-1, r1, a[(k+1)*lda+k:], lda)
+				}
+			} else {
+				// 2×2 pivot block D[k]: columns k and k+1 of W now hold
+				//  [W[k] W[k+1]] = [L[k] L[k+1]]*D[k]
+				// where L[k] and L[k+1] are the k-th and (k+1)-th columns
+				// of L.
+				if k < n-2 {
+					// Store L[k] and L[k+1] in columns k and k+1 of A.
+					d21 := w[(k+1)*ldw+k]
+					d11 := w[(k+1)*ldw+k+1] / d21
+					d22 := w[k*ldw+k] / d21
+					t := 1 / (d11*d22 - 1)
+					d21 = t / d21
+					for j := k + 2; j < n; j++ {
+						a[j*lda+k] = d21 * (d11*w[j*ldw+k] - w[j*ldw+k+1])
+						a[j*lda+k+1] = d21 * (d22*w[j*ldw+k+1] - w[j*ldw+k])
+					}
+				}
+				// Copy D[k] to A.
+				a[k*lda+k] = w[k*ldw+k]
+				a[(k+1)*lda+k] = w[(k+1)*ldw+k]
+				a[(k+1)*lda+k+1] = w[(k+1)*ldw+k+1]
+			}
+		}
+
+		// Store details of the interchanges in ipiv.
+		if kstep == 1 {
+			ipiv[k] = kp
+		} else {
+			ipiv[k] = -kp - 1
+			ipiv[k+1] = -kp - 1
+		}
+		k += kstep
+	}
+
+	// Update the lower triangle of A22 (= A[k:n,k:n]) as
+	//  A22 := A22 - L21*D*L21ᵀ = A22 - L21*Wᵀ
+	// computing blocks of nb columns at a time.
+	for j := k; j < n; j += nb {
+		jb := min(nb, n-j)
+		// Update the lower triangle of the diagonal block.
+		for jj := j; jj < j+jb; jj++ {
+			bi.Dgemv(blas.NoTrans, j+jb-jj, k, -1, a[jj*lda:], lda, w[jj*ldw:], 1, 1, a[jj*lda+jj:], lda)
+		}
+		// Update the rectangular subdiagonal block.
+		if j+jb < n {
+			bi.Dgemm(blas.NoTrans, blas.Trans, n-j-jb, jb, k, -1, a[(j+jb)*lda:], lda, w[j*ldw:], ldw, 1, a[(j+jb)*lda+j:], lda)
+		}
+	}
+
+	// Put L21 in standard form by partially undoing the interchanges in
+	// columns 0:k.
+	for j := k - 1; j > 0; {
+		jj := j
+		jp := ipiv[j]
+		if jp < 0 {
+			jp = -jp - 1
+			j--
+		}
+		j--
+		if jp != jj && j >= 0 {
+			bi.Dswap(j+1, a[jp*lda:], 1, a[jj*lda:], 1)
+		}
+	}
+
+	// Set kb to the number of columns factorized.
+	return k, ok
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Dlat2s converts the upper or lower triangle of the n×n double precision
+// matrix A, as specified by uplo, to the corresponding triangle of the single
+// precision matrix SA.
+//
+// Dlat2s returns whether all elements of the triangle of A are within the
+// range of single precision numbers. If Dlat2s returns false, an element of A
+// is larger in magnitude than the largest finite float32 and the conversion is
+// stopped, so SA is only partially set.
+//
+// Dlat2s is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlat2s(uplo blas.Uplo, n int, a []float64, lda int, sa []float32, ldsa int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldsa < max(1, n):
+		panic(badLdSA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(sa) < (n-1)*ldsa+n:
+		panic(shortSA)
+	}
+
+	const rmax = math.MaxFloat32
+	for i := 0; i < n; i++ {
+		var jb, je int
+		if uplo == blas.Upper {
+			jb, je = i, n
+		} else {
+			jb, je = 0, i+1
+		}
+		for j := jb; j < je; j++ {
+			v := a[i*lda+j]
+			if v < -rmax || rmax < v {
+				return false
+			}
+			sa[i*ldsa+j] = float32(v)
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dormql multiplies an m×n matrix C by an orthogonal matrix Q as
+//  C = Q * C   if side == blas.Left  and trans == blas.NoTrans,
+//  C = Qᵀ * C  if side == blas.Left  and trans == blas.Trans,
+//  C = C * Q   if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Qᵀ  if side == blas.Right and trans == blas.Trans,
+// where Q is defined as the product of k elementary reflectors
+//  Q = H_{k-1} * ... * H_1 * H_0
+// as returned by Dgeql2.
+//
+// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
+// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
+// The ith column of A contains the vector which defines the elementary
+// reflector H_i and tau[i] contains its scalar factor. tau must have length k
+// and Dormql will panic otherwise.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= n if side == blas.Left and lwork >= m if side ==
+// blas.Right, and this function will panic otherwise. Larger values of lwork
+// will generally give better performance. On return, work[0] will contain the
+// optimal value of lwork.
+//
+// If lwork is -1, instead of performing Dormql, the optimal workspace size will
+// be stored into work[0].
+//
+// Dormql is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormql(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case lda < max(1, k):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	const (
+		nbmax = 64
+		ldt   = nbmax
+		tsize = nbmax * ldt
+	)
+	opts := string(side) + string(trans)
+	nb := min(nbmax, impl.Ilaenv(1, "DORMQL", opts, m, n, k, -1))
+	lworkopt := max(1, nw)*nb + tsize
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+k:
+		panic(shortA)
+	case len(tau) != k:
+		panic(badLenTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	nbmin := 2
+	if 1 < nb && nb < k {
+		if lwork < nw*nb+tsize {
+			nb = (lwork - tsize) / nw
+			nbmin = max(2, impl.Ilaenv(2, "DORMQL", opts, m, n, k, -1))
+		}
+	}
+
+	if nb < nbmin || k <= nb {
+		// Call unblocked code.
+		impl.Dorm2l(side, trans, m, n, k, a, lda, tau, c, ldc, work)
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	var (
+		ldwork  = nb
+		notrans = trans == blas.NoTrans
+	)
+	// The blocks of reflectors are applied in order of increasing index if
+	// Q is applied from the left without transposition or from the right
+	// with transposition, and in order of decreasing index otherwise.
+	first, inc := 0, nb
+	if left != notrans {
+		first, inc = ((k-1)/nb)*nb, -nb
+	}
+	for i := first; 0 <= i && i < k; i += inc {
+		ib := min(nb, k-i)
+		// Form the triangular factor of the block reflector
+		//  H = H_{i+ib-1} * ... * H_{i+1} * H_i.
+		impl.Dlarft(lapack.Backward, lapack.ColumnWise, nq-k+i+ib, ib,
+			a[i:], lda,
+			tau[i:],
+			work[:tsize], ldt)
+		mi, ni := m, n
+		if left {
+			// H or Hᵀ is applied to C[0:m-k+i+ib, 0:n].
+			mi = m - k + i + ib
+		} else {
+			// H or Hᵀ is applied to C[0:m, 0:n-k+i+ib].
+			ni = n - k + i + ib
+		}
+		impl.Dlarfb(side, trans, lapack.Backward, lapack.ColumnWise, mi, ni, ib,
+			a[i:], lda,
+			work[:tsize], ldt,
+			c, ldc,
+			work[tsize:], ldwork)
+	}
+	work[0] = float64(lworkopt)
+}
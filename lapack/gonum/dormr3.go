@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dormr3 multiplies a general m×n matrix C by the orthogonal matrix Z from a
+// RZ factorization determined by Dtzrzf:
+//  C = Z * C   if side == blas.Left and trans == blas.NoTrans,
+//  C = Zᵀ * C  if side == blas.Left and trans == blas.Trans,
+//  C = C * Z   if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Zᵀ  if side == blas.Right and trans == blas.Trans.
+// Z is defined as the product of k elementary reflectors
+//  Z = H_0 * H_1 * ... * H_{k-1}
+// as returned by Dtzrzf.
+//
+// If side == blas.Left, A is a k×m matrix and 0 <= k <= m, and if
+// side == blas.Right, A is a k×n matrix and 0 <= k <= n. The i-th row of A
+// contains in its last l columns the vector which defines the elementary
+// reflector H_i, and tau[i] contains its scalar factor. tau must have length
+// at least k, otherwise Dormr3 will panic.
+//
+// work must have length at least n if side == blas.Left and at least m if
+// side == blas.Right, otherwise Dormr3 will panic.
+//
+// Dormr3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormr3(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (k-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < nw:
+		panic(shortWork)
+	}
+
+	ja := nq - l
+	if left == (trans == blas.Trans) {
+		for i := 0; i < k; i++ {
+			impl.dormr3Apply(left, m, n, l, i, a[i*lda+ja:], tau[i], c, ldc, work)
+		}
+		return
+	}
+	for i := k - 1; i >= 0; i-- {
+		impl.dormr3Apply(left, m, n, l, i, a[i*lda+ja:], tau[i], c, ldc, work)
+	}
+}
+
+// dormr3Apply applies the elementary reflector H_i defined by v and tau to
+// C[i:m,:] from the left if left is true, and to C[:,i:n] from the right
+// otherwise.
+func (impl Implementation) dormr3Apply(left bool, m, n, l, i int, v []float64, tau float64, c []float64, ldc int, work []float64) {
+	if left {
+		impl.Dlarz(blas.Left, m-i, n, l, v, 1, tau, c[i*ldc:], ldc, work)
+		return
+	}
+	impl.Dlarz(blas.Right, m, n-i, l, v, 1, tau, c[i:], ldc, work)
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dormrz multiplies a general m×n matrix C by the orthogonal matrix Z from a
+// RZ factorization determined by Dtzrzf:
+//  C = Z * C   if side == blas.Left and trans == blas.NoTrans,
+//  C = Zᵀ * C  if side == blas.Left and trans == blas.Trans,
+//  C = C * Z   if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Zᵀ  if side == blas.Right and trans == blas.Trans.
+// Z is defined as the product of k elementary reflectors
+//  Z = H_0 * H_1 * ... * H_{k-1}
+// as returned by Dtzrzf.
+//
+// If side == blas.Left, A is a k×m matrix and 0 <= k <= m, and if
+// side == blas.Right, A is a k×n matrix and 0 <= k <= n. The i-th row of A
+// contains in its last l columns the vector which defines the elementary
+// reflector H_i, and tau[i] contains its scalar factor. tau must have length
+// k, otherwise Dormrz will panic. Dtzrzf returns A and tau in the required
+// form.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n
+// if side == blas.Left and at least m if side == blas.Right, otherwise Dormrz
+// will panic. Larger values of lwork will generally give better performance.
+// On return, work[0] will contain the optimal value of lwork.
+//
+// If lwork is -1, instead of performing Dormrz, the optimal workspace size
+// will be stored into work[0].
+func (impl Implementation) Dormrz(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	const (
+		nbmax = 64
+		ldt   = nbmax
+		tsize = nbmax * ldt
+	)
+	opts := string(side) + string(trans)
+	nb := min(nbmax, impl.Ilaenv(1, "DORMRQ", opts, m, n, k, -1))
+	lworkopt := max(1, nw)*nb + tsize
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	switch {
+	case len(a) < (k-1)*lda+nq:
+		panic(shortA)
+	case len(tau) != k:
+		panic(badLenTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	nbmin := 2
+	if 1 < nb && nb < k {
+		if lwork < nw*nb+tsize {
+			nb = (lwork - tsize) / nw
+			nbmin = max(2, impl.Ilaenv(2, "DORMRQ", opts, m, n, k, -1))
+		}
+	}
+
+	if nb < nbmin || k <= nb {
+		// Call unblocked code.
+		impl.Dormr3(side, trans, m, n, k, l, a, lda, tau, c, ldc, work)
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	ldwork := nb
+	ja := nq - l
+	// The block reflector formed by Dlarzt is the transpose of the
+	// corresponding block of Z.
+	transt := blas.Trans
+	if trans == blas.Trans {
+		transt = blas.NoTrans
+	}
+	apply := func(i int) {
+		ib := min(nb, k-i)
+		// Form the triangular factor of the block reflector
+		// H = H_{i+ib-1} * ... * H_{i+1} * H_i.
+		impl.Dlarzt(lapack.Backward, lapack.RowWise, l, ib, a[i*lda+ja:], lda, tau[i:], work[:tsize], ldt)
+		if left {
+			// H or Hᵀ is applied to C[i:m,:].
+			impl.Dlarzb(side, transt, lapack.Backward, lapack.RowWise, m-i, n, ib, l,
+				a[i*lda+ja:], lda, work[:tsize], ldt, c[i*ldc:], ldc, work[tsize:], ldwork)
+		} else {
+			// H or Hᵀ is applied to C[:,i:n].
+			impl.Dlarzb(side, transt, lapack.Backward, lapack.RowWise, m, n-i, ib, l,
+				a[i*lda+ja:], lda, work[:tsize], ldt, c[i:], ldc, work[tsize:], ldwork)
+		}
+	}
+	if left == (trans == blas.Trans) {
+		for i := 0; i < k; i += nb {
+			apply(i)
+		}
+	} else {
+		for i := ((k - 1) / nb) * nb; i >= 0; i -= nb {
+			apply(i)
+		}
+	}
+	work[0] = float64(lworkopt)
+}
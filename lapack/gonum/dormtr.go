@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Dormtr multiplies an m×n matrix C by the real orthogonal matrix Q of order nq
+// as returned by Dsytrd:
+//  C = Q * C   if side == blas.Left  and trans == blas.NoTrans,
+//  C = Qᵀ * C  if side == blas.Left  and trans == blas.Trans,
+//  C = C * Q   if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Qᵀ  if side == blas.Right and trans == blas.Trans,
+// where nq = m if side == blas.Left and nq = n if side == blas.Right.
+//
+// Q is defined as the product of nq-1 elementary reflectors
+//  Q = H_{nq-2} * ... * H_1 * H_0  if uplo == blas.Upper,
+//  Q = H_0 * H_1 * ... * H_{nq-2}  if uplo == blas.Lower.
+// a and tau contain the details of the reflectors as returned by Dsytrd, and
+// uplo must be the same as was used in the call to Dsytrd. a is an nq×nq
+// matrix and tau must have length at least nq-1, and Dormtr will panic
+// otherwise.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1,n) if side == blas.Left and lwork >= max(1,m) if
+// side == blas.Right, and this function will panic otherwise. Larger values of
+// lwork will generally give better performance. On return, work[0] will contain
+// the optimal value of lwork.
+//
+// If lwork is -1, instead of performing Dormtr, the optimal workspace size will
+// be stored into work[0].
+func (impl Implementation) Dormtr(side blas.Side, uplo blas.Uplo, trans blas.Transpose, m, n int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	mi, ni := m, n
+	if left {
+		mi = m - 1
+	} else {
+		ni = n - 1
+	}
+
+	if lwork == -1 {
+		if uplo == blas.Upper {
+			impl.Dormql(side, trans, max(0, mi), max(0, ni), max(0, nq-1), a, lda, nil, c, ldc, work, -1)
+		} else {
+			impl.Dormqr(side, trans, max(0, mi), max(0, ni), max(0, nq-1), a, lda, nil, c, ldc, work, -1)
+		}
+		work[0] = math.Max(work[0], float64(max(1, nw)))
+		return
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || nq == 1 {
+		work[0] = 1
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < nq-1:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	if uplo == blas.Upper {
+		// Q was determined by a call to Dsytrd with uplo == blas.Upper.
+		impl.Dormql(side, trans, mi, ni, nq-1, a[1:], lda, tau[:nq-1], c, ldc, work, lwork)
+		return
+	}
+	// Q was determined by a call to Dsytrd with uplo == blas.Lower.
+	if left {
+		impl.Dormqr(side, trans, mi, ni, nq-1, a[lda:], lda, tau[:nq-1], c[ldc:], ldc, work, lwork)
+	} else {
+		impl.Dormqr(side, trans, mi, ni, nq-1, a[lda:], lda, tau[:nq-1], c[1:], ldc, work, lwork)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dpoequ computes row and column scalings intended to equilibrate an n×n
+// symmetric positive definite matrix A and reduce its condition number with
+// respect to the 2-norm. The scale factors are returned in s so that the
+// scaled matrix
+//  B[i,j] = s[i] * A[i,j] * s[j]
+// has ones on the diagonal. This choice of s puts the condition number of B
+// within a factor n of the smallest possible condition number over all
+// possible diagonal scalings.
+//
+// Only the diagonal elements of A are referenced.
+//
+// s must have length n, otherwise Dpoequ will panic.
+//
+// scond is the ratio of the smallest s[i] to the largest s[i]. If scond is at
+// least 0.1 and amax is neither too large nor too small, it is not worth
+// scaling by s. amax is the absolute value of the largest element of A. If
+// amax is very close to overflow or very close to underflow, the matrix should
+// be scaled.
+//
+// Dpoequ returns whether all diagonal elements of A are positive. If ok is
+// false, A is not positive definite, and scond and the contents of s are not
+// valid.
+func (impl Implementation) Dpoequ(n int, a []float64, lda int, s []float64) (scond, amax float64, ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1, 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(s) != n:
+		panic(shortS)
+	}
+
+	// Find the minimum and maximum diagonal elements.
+	s[0] = a[0]
+	smin := s[0]
+	amax = s[0]
+	for i := 1; i < n; i++ {
+		s[i] = a[i*lda+i]
+		smin = math.Min(smin, s[i])
+		amax = math.Max(amax, s[i])
+	}
+
+	if smin <= 0 {
+		// A has a non-positive diagonal element.
+		return 0, amax, false
+	}
+
+	// Set the scale factors to the reciprocals of the square roots of the
+	// diagonal elements.
+	for i, v := range s {
+		s[i] = 1 / math.Sqrt(v)
+	}
+	// Compute scond = min(s[i]) / max(s[i]).
+	scond = math.Sqrt(smin) / math.Sqrt(amax)
+	return scond, amax, true
+}
@@ -13,7 +13,8 @@ import (
 // matrix a. If ul == blas.Upper, then a is stored as an upper-triangular matrix,
 // and a = Uᵀ U is stored in place into a. If ul == blas.Lower, then a = L Lᵀ
 // is computed and stored in-place into a. If a is not positive definite, false
-// is returned. This is the blocked version of the algorithm.
+// is returned. This is the blocked version of the algorithm. The diagonal
+// blocks are factorized by the recursive algorithm in Dpotrf2.
 func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool) {
 	switch {
 	case ul != blas.Upper && ul != blas.Lower:
@@ -35,7 +36,7 @@ func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok
 
 	nb := impl.Ilaenv(1, "DPOTRF", string(ul), n, -1, -1, -1)
 	if nb <= 1 || n <= nb {
-		return impl.Dpotf2(ul, n, a, lda)
+		return impl.Dpotrf2(ul, n, a, lda)
 	}
 	bi := blas64.Implementation()
 	if ul == blas.Upper {
@@ -44,7 +45,7 @@ func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok
 			bi.Dsyrk(blas.Upper, blas.Trans, jb, j,
 				-1, a[j:], lda,
 				1, a[j*lda+j:], lda)
-			ok = impl.Dpotf2(blas.Upper, jb, a[j*lda+j:], lda)
+			ok = impl.Dpotrf2(blas.Upper, jb, a[j*lda+j:], lda)
 			if !ok {
 				return ok
 			}
@@ -64,7 +65,7 @@ func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok
 		bi.Dsyrk(blas.Lower, blas.NoTrans, jb, j,
 			-1, a[j*lda:], lda,
 			1, a[j*lda+j:], lda)
-		ok := impl.Dpotf2(blas.Lower, jb, a[j*lda+j:], lda)
+		ok := impl.Dpotrf2(blas.Lower, jb, a[j*lda+j:], lda)
 		if !ok {
 			return ok
 		}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dpotrf2 computes the Cholesky decomposition of the symmetric positive definite
+// matrix a. If ul == blas.Upper, then a is stored as an upper-triangular matrix,
+// and a = Uᵀ U is stored in place into a. If ul == blas.Lower, then a = L Lᵀ
+// is computed and stored in-place into a. If a is not positive definite, false
+// is returned.
+//
+// Dpotrf2 is the recursive version of the algorithm. It divides the matrix
+// into four blocks
+//  [ A11 | A12 ]
+//  [ ----|---- ]
+//  [ A21 | A22 ]
+// where A11 is n1×n1 with n1 = n/2, factors A11 recursively, updates A22 and
+// factors it recursively. Most of the computation is performed by Level 3
+// BLAS routines.
+//
+// Dpotrf2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dpotrf2(ul blas.Uplo, n int, a []float64, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(shortA)
+	}
+
+	if n == 1 {
+		// Test for non-positive-definiteness.
+		if a[0] <= 0 || math.IsNaN(a[0]) {
+			return false
+		}
+		a[0] = math.Sqrt(a[0])
+		return true
+	}
+
+	n1 := n / 2
+	n2 := n - n1
+
+	// Factor A11.
+	if !impl.Dpotrf2(ul, n1, a, lda) {
+		return false
+	}
+
+	bi := blas64.Implementation()
+	if ul == blas.Upper {
+		// Compute A12 = U11⁻ᵀ * A12.
+		bi.Dtrsm(blas.Left, blas.Upper, blas.Trans, blas.NonUnit, n1, n2,
+			1, a, lda,
+			a[n1:], lda)
+		// Update A22 = A22 - A12ᵀ * A12.
+		bi.Dsyrk(blas.Upper, blas.Trans, n2, n1,
+			-1, a[n1:], lda,
+			1, a[n1*lda+n1:], lda)
+		// Factor A22.
+		return impl.Dpotrf2(ul, n2, a[n1*lda+n1:], lda)
+	}
+	// Compute A21 = A21 * L11⁻ᵀ.
+	bi.Dtrsm(blas.Right, blas.Lower, blas.Trans, blas.NonUnit, n2, n1,
+		1, a, lda,
+		a[n1*lda:], lda)
+	// Update A22 = A22 - A21 * A21ᵀ.
+	bi.Dsyrk(blas.Lower, blas.NoTrans, n2, n1,
+		-1, a[n1*lda:], lda,
+		1, a[n1*lda+n1:], lda)
+	// Factor A22.
+	return impl.Dpotrf2(ul, n2, a[n1*lda+n1:], lda)
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsgesv computes the solution to a system of linear equations
+//  A * X = B
+// where A is an n×n matrix and X and B are n×nrhs matrices.
+//
+// Dsgesv first computes the LU factorization of A in single precision and
+// uses this factorization within an iterative refinement procedure to produce
+// a solution with double precision normwise backward error quality. The
+// iterative refinement is not going to be a winning strategy if the ratio of
+// single precision performance over double precision performance is too small
+// or if the matrix A is too ill-conditioned. In these cases Dsgesv falls back
+// to computing the solution in double precision using Dgetrf and Dgetrs.
+//
+// The iterative refinement process is stopped if iter > itermax = 30 or for
+// all the right-hand sides
+//  rnrm < sqrt(n)*xnrm*anrm*eps*bwdmax
+// where
+//  - iter is the number of the current iteration in the iterative refinement process,
+//  - rnrm is the ∞-norm of the residual,
+//  - xnrm is the ∞-norm of the solution,
+//  - anrm is the ∞-norm of the matrix A,
+//  - eps is the double precision machine epsilon,
+//  - bwdmax is 1.
+//
+// On entry, a contains the n×n matrix A. On return, if the iterative
+// refinement has been successful, a is unchanged, otherwise it contains the
+// factors L and U from the double precision factorization A = P*L*U.
+//
+// On return, ipiv contains the pivot indices of the factorization that was
+// used to compute the solution. ipiv must have length n and is zero-indexed.
+//
+// b contains the n×nrhs right hand side matrix B and is not modified. On
+// return, x contains the n×nrhs solution matrix X if ok is true.
+//
+// work must have length at least n*nrhs and swork must have length at least
+// n*(n+nrhs), otherwise Dsgesv will panic.
+//
+// iter reports how the solution was computed:
+//  iter > 0:  the iterative refinement succeeded after iter iterations.
+//  iter = -2: an overflow occurred when converting to single precision.
+//  iter = -3: the single precision factorization failed.
+//  iter = -31: the iterative refinement did not converge after 30 iterations.
+// A value of iter = 0 means that the single precision solution already
+// satisfied the stopping criterion. If iter < 0, the solution has been
+// computed in double precision.
+//
+// Dsgesv returns whether the double precision matrix A is nonsingular. If ok
+// is false, the factor U computed by Dgetrf is exactly singular and the
+// solution has not been computed.
+func (impl Implementation) Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, ok bool) {
+	const (
+		itermax = 30
+		bwdmax  = 1.0
+	)
+
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < n*nrhs:
+		panic(shortWork)
+	case len(swork) < n*(n+nrhs):
+		panic(shortSWork)
+	}
+
+	iter, ok = impl.dsgesvRefine(n, nrhs, a, lda, ipiv, b, ldb, x, ldx, work, swork, itermax, bwdmax)
+	if ok {
+		return iter, true
+	}
+
+	// Single-precision iterative refinement failed to converge to a
+	// satisfactory solution, so we resort to double precision.
+	if !impl.Dgetrf(n, n, a, lda, ipiv) {
+		return iter, false
+	}
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, x, ldx)
+	impl.Dgetrs(blas.NoTrans, n, nrhs, a, lda, ipiv, x, ldx)
+	return iter, true
+}
+
+// dsgesvRefine performs the single precision factorization and iterative
+// refinement steps of Dsgesv. It returns the value of iter and whether the
+// refinement succeeded.
+func (impl Implementation) dsgesvRefine(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, itermax int, bwdmax float64) (iter int, ok bool) {
+	// The residual R is stored in work as an n×nrhs matrix.
+	r := work[:n*nrhs]
+	ldr := nrhs
+
+	anrm := impl.Dlange(lapack.MaxRowSum, n, n, a, lda, nil)
+	cte := anrm * dlamchE * math.Sqrt(float64(n)) * bwdmax
+
+	// Denote swork slices. sa holds the single precision copy of A and its
+	// factors, sx holds the single precision right-hand sides and
+	// corrections.
+	sa := swork[:n*n]
+	sx := swork[n*n : n*(n+nrhs)]
+	ldsx := nrhs
+
+	// Convert B from double precision to single precision and store the
+	// result in sx.
+	if !impl.Dlag2s(n, nrhs, b, ldb, sx, ldsx) {
+		return -2, false
+	}
+	// Convert A from double precision to single precision and store the
+	// result in sa.
+	if !impl.Dlag2s(n, n, a, lda, sa, n) {
+		return -2, false
+	}
+	// Compute the LU factorization of sa.
+	if !impl.Sgetrf(n, n, sa, n, ipiv) {
+		return -3, false
+	}
+	// Solve the system sa*sx = sb.
+	impl.Sgetrs(blas.NoTrans, n, nrhs, sa, n, ipiv, sx, ldsx)
+	// Convert sx back to double precision.
+	impl.Slag2d(n, nrhs, sx, ldsx, x, ldx)
+
+	bi := blas64.Implementation()
+	converged := func() bool {
+		// Compute R = B - A*X.
+		impl.Dlacpy(blas.All, n, nrhs, b, ldb, r, ldr)
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n, nrhs, n, -1, a, lda, x, ldx, 1, r, ldr)
+		// Check whether the nrhs normwise backward errors satisfy the
+		// stopping criterion.
+		for i := 0; i < nrhs; i++ {
+			xnrm := math.Abs(x[bi.Idamax(n, x[i:], ldx)*ldx+i])
+			rnrm := math.Abs(r[bi.Idamax(n, r[i:], ldr)*ldr+i])
+			if rnrm > xnrm*cte {
+				return false
+			}
+		}
+		return true
+	}
+	if converged() {
+		return 0, true
+	}
+
+	for iter = 1; iter <= itermax; iter++ {
+		// Convert R from double precision to single precision and store
+		// the result in sx.
+		if !impl.Dlag2s(n, nrhs, r, ldr, sx, ldsx) {
+			return -2, false
+		}
+		// Solve the system sa*sx = sr.
+		impl.Sgetrs(blas.NoTrans, n, nrhs, sa, n, ipiv, sx, ldsx)
+		// Convert sx back to double precision and update the current
+		// iterate.
+		impl.Slag2d(n, nrhs, sx, ldsx, r, ldr)
+		for i := 0; i < n; i++ {
+			bi.Daxpy(nrhs, 1, r[i*ldr:], 1, x[i*ldx:], 1)
+		}
+		if converged() {
+			return iter, true
+		}
+	}
+	// The iterative refinement did not converge in itermax iterations.
+	return -itermax - 1, false
+}
@@ -0,0 +1,291 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstebz computes selected eigenvalues of a symmetric tridiagonal matrix T by
+// bisection. The eigenvalues to be found are specified by rng:
+//  rng == lapack.EVRangeAll:   all eigenvalues are computed,
+//  rng == lapack.EVRangeValue: the eigenvalues in the half-open interval
+//                              (vl,vu] are computed,
+//  rng == lapack.EVRangeIndex: the il-th through iu-th eigenvalues (counted
+//                              from zero in ascending order) are computed.
+// vl and vu are only referenced if rng == lapack.EVRangeValue and il and iu are
+// only referenced if rng == lapack.EVRangeIndex, in which case they must satisfy
+//  0 <= il <= iu < n  if n > 0,
+//  il = 0, iu = -1    if n == 0.
+//
+// Dstebz first splits T into independent diagonal blocks wherever an
+// off-diagonal element is negligible and then finds each requested eigenvalue
+// of each block by bisection using Sturm sequence counts.
+//
+// abstol is the absolute error tolerance for the eigenvalues. An eigenvalue is
+// considered to be located if it lies in an interval whose width is smaller
+// than abstol + 2*eps*max(|a|,|b|), where [a,b] is the interval and eps is the
+// machine precision. If abstol is not positive, eps*|T| is used instead, where
+// |T| is the 1-norm of the block containing the eigenvalue.
+//
+// d contains the n diagonal elements of T and e contains the n-1 off-diagonal
+// elements of T. The matrix should be scaled so that the squares of its
+// elements do not underflow, otherwise off-diagonal elements may be treated as
+// negligible and accuracy lost.
+//
+// On return, the first m elements of w contain the computed eigenvalues. They
+// are grouped by block in order of the blocks, and are in ascending order
+// within each block. iblock[i] holds the index of the block containing the
+// eigenvalue w[i], and the first nsplit elements of isplit hold the index of
+// the last row of each block, so that block j consists of the rows and
+// columns isplit[j-1]+1 through isplit[j] (with isplit[-1] taken to be -1).
+// w, iblock and isplit must have length at least n.
+//
+// work must have length at least n.
+//
+// Dstebz is an internal routine. It is exported for testing purposes.
+func (Implementation) Dstebz(rng lapack.EVRange, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64, iblock, isplit []int, work []float64) (m, nsplit int) {
+	switch {
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && vu <= vl:
+		panic(badVu)
+	case rng == lapack.EVRangeIndex && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || iu > n-1):
+		panic(badIu)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < n:
+		panic(shortW)
+	case len(iblock) < n:
+		panic(shortIblock)
+	case len(isplit) < n:
+		panic(shortIsplit)
+	case len(work) < n:
+		panic(shortWork)
+	}
+
+	const (
+		fudge  = 2.1
+		safmin = dlamchS
+		ulp    = dlamchP
+	)
+
+	// Split the matrix into blocks where the off-diagonal elements are
+	// negligible, storing the squares of the off-diagonal elements in work
+	// with zeros at the splitting points.
+	pivmin := 1.0
+	for j := 1; j < n; j++ {
+		tmp := e[j-1] * e[j-1]
+		if math.Abs(d[j]*d[j-1])*ulp*ulp+safmin > tmp {
+			isplit[nsplit] = j - 1
+			nsplit++
+			work[j-1] = 0
+		} else {
+			work[j-1] = tmp
+			pivmin = math.Max(pivmin, tmp)
+		}
+	}
+	isplit[nsplit] = n - 1
+	nsplit++
+	pivmin *= safmin
+
+	// count returns the number of eigenvalues of the block of T consisting of
+	// rows and columns lo through hi that are less than or equal to x. Since
+	// work is zero at the splitting points, count(0, n-1, x) is the sum of
+	// the counts over all blocks.
+	count := func(lo, hi int, x float64) int {
+		var cnt int
+		tmp := d[lo] - x
+		if math.Abs(tmp) < pivmin {
+			tmp = -pivmin
+		}
+		if tmp <= 0 {
+			cnt++
+		}
+		for j := lo + 1; j <= hi; j++ {
+			tmp = d[j] - work[j-1]/tmp - x
+			if math.Abs(tmp) < pivmin {
+				tmp = -pivmin
+			}
+			if tmp <= 0 {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	// gershgorin returns an interval containing all eigenvalues of the block
+	// of T consisting of rows and columns lo through hi, and the 1-norm of
+	// the block.
+	gershgorin := func(lo, hi int) (gl, gu, bnorm float64) {
+		gl = d[lo]
+		gu = d[lo]
+		var tmp1 float64
+		for j := lo; j < hi; j++ {
+			tmp2 := math.Abs(e[j])
+			gl = math.Min(gl, d[j]-tmp1-tmp2)
+			gu = math.Max(gu, d[j]+tmp1+tmp2)
+			tmp1 = tmp2
+		}
+		gl = math.Min(gl, d[hi]-tmp1)
+		gu = math.Max(gu, d[hi]+tmp1)
+		bnorm = math.Max(math.Abs(gl), math.Abs(gu))
+		gl -= fudge*bnorm*ulp*float64(hi-lo+1) + fudge*pivmin
+		gu += fudge*bnorm*ulp*float64(hi-lo+1) + fudge*pivmin
+		return gl, gu, bnorm
+	}
+
+	// bisect returns an interval [lo,hi] of width at most tol such that
+	// count(b0, b1, lo) <= k < count(b0, b1, hi), given an initial interval
+	// with the same property.
+	bisect := func(b0, b1, k int, lo, hi, tol float64) (float64, float64) {
+		for {
+			width := math.Abs(hi - lo)
+			if width <= math.Max(tol, math.Max(pivmin, 2*ulp*math.Max(math.Abs(lo), math.Abs(hi)))) {
+				return lo, hi
+			}
+			mid := 0.5 * (lo + hi)
+			if mid <= lo || mid >= hi {
+				return lo, hi
+			}
+			if count(b0, b1, mid) > k {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+	}
+
+	// Determine the interval (wl,wu] containing the wanted eigenvalues.
+	var wl, wu float64
+	var idiscl, idiscu int
+	switch rng {
+	case lapack.EVRangeValue:
+		wl, wu = vl, vu
+	case lapack.EVRangeIndex:
+		gl := math.Inf(1)
+		gu := math.Inf(-1)
+		for jb := 0; jb < nsplit; jb++ {
+			ibegin := 0
+			if jb > 0 {
+				ibegin = isplit[jb-1] + 1
+			}
+			bgl, bgu, _ := gershgorin(ibegin, isplit[jb])
+			gl = math.Min(gl, bgl)
+			gu = math.Max(gu, bgu)
+		}
+		tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+		atoli := fudge*2*ulp*tnorm + fudge*2*pivmin
+		wl, wu = gl, gu
+		if il > 0 {
+			wl, _ = bisect(0, n-1, il, gl, gu, atoli)
+		}
+		if iu < n-1 {
+			_, wu = bisect(0, n-1, iu, gl, gu, atoli)
+		}
+		// The interval (wl,wu] may contain more eigenvalues than were asked
+		// for if some are clustered closer than the tolerance. The extra
+		// ones are discarded below.
+		idiscl = il - count(0, n-1, wl)
+		idiscu = count(0, n-1, wu) - (iu + 1)
+	}
+
+	// Find the eigenvalues of each block.
+	for jb := 0; jb < nsplit; jb++ {
+		ibegin := 0
+		if jb > 0 {
+			ibegin = isplit[jb-1] + 1
+		}
+		iend := isplit[jb]
+
+		gl, gu, bnorm := gershgorin(ibegin, iend)
+		atoli := abstol
+		if abstol <= 0 {
+			atoli = ulp * bnorm
+		}
+		nlo, nhi := 0, iend-ibegin+1
+		if rng != lapack.EVRangeAll {
+			gl = math.Max(gl, wl)
+			gu = math.Min(gu, wu)
+			if gl >= gu {
+				continue
+			}
+			nlo = count(ibegin, iend, gl)
+			nhi = count(ibegin, iend, gu)
+		}
+		if ibegin == iend {
+			// A 1×1 block, its eigenvalue is known.
+			if nhi > nlo {
+				w[m] = d[ibegin]
+				iblock[m] = jb
+				m++
+			}
+			continue
+		}
+		for k := nlo; k < nhi; k++ {
+			lo, hi := bisect(ibegin, iend, k, gl, gu, atoli)
+			w[m] = 0.5 * (lo + hi)
+			if k > nlo {
+				// Eigenvalues closer than the tolerance may otherwise be
+				// returned out of order.
+				w[m] = math.Max(w[m], w[m-1])
+			}
+			iblock[m] = jb
+			m++
+			// The k-th eigenvalue is not smaller than lo, so the next one
+			// is not either.
+			gl = lo
+		}
+	}
+
+	// Discard the extra eigenvalues found at the ends of the interval when
+	// the eigenvalues were specified by index.
+	if idiscl > 0 || idiscu > 0 {
+		for ; idiscl > 0; idiscl-- {
+			jmin := -1
+			for j := 0; j < m; j++ {
+				if iblock[j] >= 0 && (jmin < 0 || w[j] < w[jmin]) {
+					jmin = j
+				}
+			}
+			iblock[jmin] = -1
+		}
+		for ; idiscu > 0; idiscu-- {
+			jmax := -1
+			for j := 0; j < m; j++ {
+				if iblock[j] >= 0 && (jmax < 0 || w[j] >= w[jmax]) {
+					jmax = j
+				}
+			}
+			iblock[jmax] = -1
+		}
+		var im int
+		for j := 0; j < m; j++ {
+			if iblock[j] >= 0 {
+				w[im] = w[j]
+				iblock[im] = iblock[j]
+				im++
+			}
+		}
+		m = im
+	}
+	return m, nsplit
+}
@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstedc computes all eigenvalues and, optionally, eigenvectors of a symmetric
+// tridiagonal matrix using the divide and conquer method. The eigenvectors of
+// a full or band symmetric matrix can also be found if Dsytrd has been used to
+// reduce this matrix to tridiagonal form.
+//
+// d, on entry, contains the diagonal elements of the tridiagonal matrix. On
+// exit, d contains the eigenvalues in ascending order. d must have length n
+// and Dstedc will panic otherwise.
+//
+// e, on entry, contains the off-diagonal elements of the tridiagonal matrix,
+// and is destroyed during the call to Dstedc. e must have length n-1 and Dstedc
+// will panic otherwise.
+//
+// z, on entry, contains the n×n orthogonal matrix used in the reduction to
+// tridiagonal form if compz == lapack.EVOrig. On exit, if
+// compz == lapack.EVOrig, z contains the orthonormal eigenvectors of the
+// original symmetric matrix, and if compz == lapack.EVTridiag, z contains the
+// orthonormal eigenvectors of the symmetric tridiagonal matrix. z is not used
+// if compz == lapack.EVCompNone.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum,
+//  lwork >= 1               if compz == lapack.EVCompNone or n <= 1,
+//  lwork >= 2*(n-1)         if n is at most the size of the smallest subproblem,
+//  lwork >= 1 + 4*n + n^2   if compz == lapack.EVTridiag,
+//  lwork >= 1 + 4*n + 2*n^2 if compz == lapack.EVOrig,
+// and Dstedc will panic otherwise. iwork is temporary integer storage, and
+// liwork specifies its usable length. At minimum, liwork >= 1 if
+// compz == lapack.EVCompNone or n is at most the size of the smallest
+// subproblem, and liwork >= 3 + 5*n otherwise.
+//
+// If lwork == -1 or liwork == -1, instead of computing the eigenvalues, the
+// optimal work length is stored into work[0] and the optimal integer work
+// length is stored into iwork[0].
+//
+// Dstedc returns whether the algorithm succeeded in computing all eigenvalues.
+//
+// Dstedc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	switch {
+	case compz != lapack.EVCompNone && compz != lapack.EVTridiag && compz != lapack.EVOrig:
+		panic(badEVComp)
+	case n < 0:
+		panic(nLT0)
+	case ldz < 1, compz != lapack.EVCompNone && ldz < n:
+		panic(badLdZ)
+	}
+
+	// Compute the workspace requirements.
+	smlsiz := impl.Ilaenv(9, "DSTEDC", " ", 0, 0, 0, 0)
+	lwmin, liwmin := 1, 1
+	switch {
+	case n <= 1 || compz == lapack.EVCompNone:
+	case n <= smlsiz:
+		lwmin = 2 * (n - 1)
+	case compz == lapack.EVTridiag:
+		lwmin = 1 + 4*n + n*n
+		liwmin = 3 + 5*n
+	default:
+		lwmin = 1 + 4*n + 2*n*n
+		liwmin = 3 + 5*n
+	}
+
+	switch {
+	case lwork < lwmin && lwork != -1 && liwork != -1:
+		panic(badLWork)
+	case liwork < liwmin && lwork != -1 && liwork != -1:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case compz != lapack.EVCompNone && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if compz != lapack.EVCompNone {
+			z[0] = 1
+		}
+		return true
+	}
+
+	// If the eigenvectors are not required, use Dsterf to compute the
+	// eigenvalues.
+	if compz == lapack.EVCompNone {
+		return impl.Dsterf(n, d, e)
+	}
+
+	// If n is smaller than the minimum divide size (smlsiz+1), then solve
+	// the problem with another solver.
+	if n <= smlsiz {
+		return impl.Dsteqr(compz, n, d, e, z, ldz, work)
+	}
+
+	bi := blas64.Implementation()
+
+	if compz == lapack.EVOrig {
+		// Compute the eigenvectors of the tridiagonal matrix into work and
+		// multiply them back into z.
+		if !impl.Dstedc(lapack.EVTridiag, n, d, e, work, n, work[n*n:], lwork-n*n, iwork, liwork) {
+			return false
+		}
+		impl.Dlacpy(blas.All, n, n, z, ldz, work[n*n:], n)
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n,
+			1, work[n*n:], n, work, n,
+			0, z, ldz)
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return true
+	}
+
+	impl.Dlaset(blas.All, n, n, 0, 1, z, ldz)
+
+	// Scale.
+	orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+	if orgnrm == 0 {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return true
+	}
+
+	eps := dlamchE
+	start := 0
+	for start < n {
+		// Let finish be the position of the next subdiagonal entry such
+		// that e[finish] <= tiny or finish = n-1 if no such subdiagonal
+		// exists. The matrix identified by the elements between start and
+		// finish constitutes an independent sub-problem.
+		finish := start
+		for finish < n-1 {
+			tiny := eps * math.Sqrt(math.Abs(d[finish])) * math.Sqrt(math.Abs(d[finish+1]))
+			if math.Abs(e[finish]) <= tiny {
+				break
+			}
+			finish++
+		}
+
+		// The sub-problem is determined. Compute its size and solve it.
+		m := finish - start + 1
+		switch {
+		case m == 1:
+		case m > smlsiz:
+			// Scale.
+			orgnrm = impl.Dlanst(lapack.MaxAbs, m, d[start:], e[start:])
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m, 1, d[start:], 1)
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m-1, 1, e[start:], 1)
+
+			ok = impl.Dlaed0(m, d[start:], e[start:], z[start*ldz+start:], ldz, work, iwork)
+			if !ok {
+				return false
+			}
+
+			// Scale back.
+			impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, m, 1, d[start:], 1)
+		default:
+			ok = impl.Dsteqr(lapack.EVTridiag, m, d[start:], e[start:], z[start*ldz+start:], ldz, work)
+			if !ok {
+				return false
+			}
+		}
+		start = finish + 1
+	}
+
+	// Use selection sort to minimize swaps of eigenvectors.
+	for i := 0; i < n-1; i++ {
+		k := i
+		p := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < p {
+				k = j
+				p = d[j]
+			}
+		}
+		if k != i {
+			d[k] = d[i]
+			d[i] = p
+			bi.Dswap(n, z[i:], ldz, z[k:], ldz)
+		}
+	}
+
+	work[0] = float64(lwmin)
+	iwork[0] = liwmin
+	return true
+}
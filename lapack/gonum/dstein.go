@@ -0,0 +1,233 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dstein computes the eigenvectors of a real symmetric tridiagonal matrix T
+// corresponding to specified eigenvalues, using inverse iteration.
+//
+// d contains the n diagonal elements of T and e contains the n-1 off-diagonal
+// elements of T.
+//
+// w contains the m eigenvalues for which eigenvectors are to be computed. The
+// eigenvalues must be grouped by split-off block and ordered from smallest to
+// largest within each block, as returned by Dstebz. iblock and isplit must
+// contain the block structure of T as returned by Dstebz. w and iblock must
+// have length at least m, and isplit must have length at least
+// iblock[m-1]+1.
+//
+// On return, z contains the computed eigenvectors stored as the columns of the
+// n×m matrix Z. The eigenvector corresponding to w[i] is stored in the i-th
+// column of Z. Any vector which fails to converge is set to its current
+// iterate after 5 iterations.
+//
+// work must have length at least 5*n and iwork must have length at least n.
+//
+// On return, if ok is true, all elements of ifail are set to -1. Otherwise,
+// the first k elements of ifail contain the indices of the eigenvectors that
+// failed to converge and the remaining elements are set to -1. ifail must have
+// length at least m.
+//
+// Dstein is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int, z []float64, ldz int, work []float64, iwork, ifail []int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0:
+		panic(mLT0)
+	case m > n:
+		panic(mGTN)
+	case ldz < max(1, m):
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < m:
+		panic(shortW)
+	case len(iblock) < m:
+		panic(shortIblock)
+	case len(isplit) < iblock[m-1]+1:
+		panic(shortIsplit)
+	case len(z) < (n-1)*ldz+m:
+		panic(shortZ)
+	case len(work) < 5*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	case len(ifail) < m:
+		panic(shortIfail)
+	}
+
+	for i := range ifail[:m] {
+		ifail[i] = -1
+	}
+
+	if n == 1 {
+		z[0] = 1
+		return true
+	}
+
+	const (
+		maxits = 5
+		extra  = 2
+	)
+
+	eps := dlamchP
+
+	// Partition the workspace.
+	indrv1 := 0
+	indrv2 := indrv1 + n
+	indrv3 := indrv2 + n
+	indrv4 := indrv3 + n
+	indrv5 := indrv4 + n
+
+	bi := blas64.Implementation()
+	rnd := rand.New(rand.NewSource(1))
+
+	var nfail int
+	var j1 int
+	for nblk := 0; nblk <= iblock[m-1]; nblk++ {
+		// Find the start and end of the current block.
+		b1 := 0
+		if nblk > 0 {
+			b1 = isplit[nblk-1] + 1
+		}
+		bn := isplit[nblk]
+		blksiz := bn - b1 + 1
+
+		var onenrm, ortol, dtpcrt float64
+		var gpind int
+		if blksiz > 1 {
+			gpind = j1
+
+			// Compute the reorthogonalization criterion and the stopping
+			// criterion.
+			onenrm = math.Abs(d[b1]) + math.Abs(e[b1])
+			onenrm = math.Max(onenrm, math.Abs(d[bn])+math.Abs(e[bn-1]))
+			for i := b1 + 1; i < bn; i++ {
+				onenrm = math.Max(onenrm, math.Abs(d[i])+math.Abs(e[i-1])+math.Abs(e[i]))
+			}
+			ortol = 1e-3 * onenrm
+			dtpcrt = math.Sqrt(0.1 / float64(blksiz))
+		}
+
+		// Loop through the eigenvalues of block nblk.
+		var jblk int
+		var xjm float64
+		j := j1
+		for ; j < m && iblock[j] == nblk; j++ {
+			jblk++
+			xj := w[j]
+			v := work[indrv1 : indrv1+blksiz]
+
+			if blksiz == 1 {
+				// Skip all the work if the block size is one.
+				v[0] = 1
+			} else {
+				// If eigenvalues j and j-1 are too close, add a relatively
+				// small perturbation.
+				if jblk > 1 {
+					pertol := 10 * math.Abs(eps*xj)
+					if xj-xjm < pertol {
+						xj = xjm + pertol
+					}
+				}
+
+				// Get a random starting vector.
+				for i := range v {
+					v[i] = 2*rnd.Float64() - 1
+				}
+
+				// Copy the matrix T so it won't be destroyed in the
+				// factorization.
+				copy(work[indrv4:indrv4+blksiz], d[b1:bn+1])
+				copy(work[indrv2+1:indrv2+blksiz], e[b1:bn])
+				copy(work[indrv3:indrv3+blksiz-1], e[b1:bn])
+
+				// Compute the LU factors with partial pivoting (PT = LU).
+				impl.Dlagtf(blksiz, work[indrv4:], xj, work[indrv2+1:], work[indrv3:], 0, work[indrv5:], iwork)
+
+				var converged bool
+				var nrmchk int
+				for its := 0; its < maxits; its++ {
+					// Normalize and scale the right hand side vector Pb.
+					jmax := bi.Idamax(blksiz, v, 1)
+					scl := float64(blksiz) * onenrm * math.Max(eps, math.Abs(work[indrv4+blksiz-1])) / math.Abs(v[jmax])
+					bi.Dscal(blksiz, scl, v, 1)
+
+					// Solve the system LU = Pb.
+					impl.Dlagts(-1, blksiz, work[indrv4:], work[indrv2+1:], work[indrv3:], work[indrv5:], iwork, v, 0)
+
+					// Reorthogonalize by modified Gram-Schmidt if
+					// eigenvalues are close enough.
+					if jblk > 1 {
+						if math.Abs(xj-xjm) > ortol {
+							gpind = j
+						}
+						for i := gpind; i < j; i++ {
+							ztr := -bi.Ddot(blksiz, v, 1, z[b1*ldz+i:], ldz)
+							bi.Daxpy(blksiz, ztr, z[b1*ldz+i:], ldz, v, 1)
+						}
+					}
+
+					// Check the infinity norm of the iterate and continue
+					// for additional iterations after the norm reaches the
+					// stopping criterion.
+					jmax = bi.Idamax(blksiz, v, 1)
+					if math.Abs(v[jmax]) < dtpcrt {
+						continue
+					}
+					nrmchk++
+					if nrmchk == extra+1 {
+						converged = true
+						break
+					}
+				}
+				if !converged {
+					// The stopping criterion was not satisfied.
+					ifail[nfail] = j
+					nfail++
+				}
+
+				// Accept the iterate as the j-th eigenvector.
+				scl := 1 / bi.Dnrm2(blksiz, v, 1)
+				jmax := bi.Idamax(blksiz, v, 1)
+				if v[jmax] < 0 {
+					scl = -scl
+				}
+				bi.Dscal(blksiz, scl, v, 1)
+			}
+
+			for i := 0; i < n; i++ {
+				z[i*ldz+j] = 0
+			}
+			for i, vi := range v {
+				z[(b1+i)*ldz+j] = vi
+			}
+
+			// Save the shift to check eigenvalue spacing at the next
+			// iteration.
+			xjm = xj
+		}
+		j1 = j
+	}
+	return nfail == 0
+}
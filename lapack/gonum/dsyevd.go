@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A. If eigenvectors are desired, it uses a divide and
+// conquer algorithm which is usually substantially faster than the implicit
+// QL/QR algorithm used by Dsyev for large matrices.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Dsyevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum,
+//  lwork >= 1               if n <= 1,
+//  lwork >= 2*n + 1         if jobz == lapack.EVNone,
+//  lwork >= 1 + 6*n + 2*n^2 if jobz == lapack.EVCompute,
+// and Dsyevd will panic otherwise. iwork is temporary integer storage, and
+// liwork specifies its usable length. At minimum, liwork >= 1 if n <= 1 or
+// jobz == lapack.EVNone, and liwork >= 3 + 5*n otherwise.
+//
+// If lwork == -1 or liwork == -1, instead of computing Dsyevd the optimal work
+// length is stored into work[0] and the optimal integer work length is stored
+// into iwork[0].
+//
+// Dsyevd returns whether the algorithm succeeded in computing all eigenvalues.
+func (impl Implementation) Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	wantz := jobz == lapack.EVCompute
+
+	switch {
+	case !wantz && jobz != lapack.EVNone:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	var lwmin, liwmin, lopt int
+	switch {
+	case n <= 1:
+		lwmin, liwmin, lopt = 1, 1, 1
+	case wantz:
+		liwmin = 3 + 5*n
+		lwmin = 1 + 6*n + 2*n*n
+		lopt = max(lwmin, 2*n+n*impl.Ilaenv(1, "DSYTRD", string(uplo), n, -1, -1, -1))
+	default:
+		liwmin = 1
+		lwmin = 2*n + 1
+		lopt = max(lwmin, 2*n+n*impl.Ilaenv(1, "DSYTRD", string(uplo), n, -1, -1, -1))
+	}
+
+	switch {
+	case lwork < lwmin && lwork != -1 && liwork != -1:
+		panic(badLWork)
+	case liwork < liwmin && lwork != -1 && liwork != -1:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(lopt)
+		iwork[0] = liwmin
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	if n == 1 {
+		w[0] = a[0]
+		if wantz {
+			a[0] = 1
+		}
+		return true
+	}
+
+	// Get machine constants.
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Sqrt(bignum)
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+	}
+
+	// Call Dsytrd to reduce the symmetric matrix to tridiagonal form.
+	const inde = 0
+	indtau := inde + n
+	indwrk := indtau + n
+	llwork := lwork - indwrk
+	indwk2 := indwrk + n*n
+	llwrk2 := lwork - indwk2
+	impl.Dsytrd(uplo, n, a, lda, w, work[inde:], work[indtau:], work[indwrk:], llwork)
+
+	// For eigenvalues only, call Dsterf. For eigenvectors, first call Dstedc
+	// to generate the eigenvector matrix of the tridiagonal matrix, then call
+	// Dormtr to multiply it by the Householder transformations stored in a.
+	if !wantz {
+		ok = impl.Dsterf(n, w, work[inde:])
+	} else {
+		ok = impl.Dstedc(lapack.EVTridiag, n, w, work[inde:], work[indwrk:], n, work[indwk2:], llwrk2, iwork, liwork)
+		if ok {
+			impl.Dormtr(blas.Left, uplo, blas.NoTrans, n, n, a, lda, work[indtau:], work[indwrk:], n, work[indwk2:], llwrk2)
+			impl.Dlacpy(blas.All, n, n, work[indwrk:], n, a, lda)
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(n, 1/sigma, w, 1)
+	}
+	work[0] = float64(lopt)
+	iwork[0] = liwmin
+	return true
+}
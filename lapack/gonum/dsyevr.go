@@ -0,0 +1,284 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevr computes selected eigenvalues and, optionally, eigenvectors of a real
+// symmetric matrix A. The eigenvalues to be found are specified by rng:
+//  rng == lapack.EVRangeAll:   all eigenvalues are computed,
+//  rng == lapack.EVRangeValue: the eigenvalues in the half-open interval
+//                              (vl,vu] are computed,
+//  rng == lapack.EVRangeIndex: the il-th through iu-th eigenvalues (counted
+//                              from zero in ascending order) are computed.
+// vl and vu are only referenced if rng == lapack.EVRangeValue and il and iu are
+// only referenced if rng == lapack.EVRangeIndex, in which case they must satisfy
+//  0 <= il <= iu < n  if n > 0,
+//  il = 0, iu = -1    if n == 0.
+//
+// A is first reduced to tridiagonal form T by Dsytrd. If all eigenvalues are
+// requested, they are computed from T by Dsterf, or by the divide and conquer
+// method in Dstedc if eigenvectors are also requested. Otherwise, the selected
+// eigenvalues are computed by bisection in Dstebz and the corresponding
+// eigenvectors by inverse iteration in Dstein. Unlike the reference
+// implementation, Dsyevr does not use the method of Multiple Relatively Robust
+// Representations.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On return, the specified triangular region,
+// including the diagonal, is overwritten.
+//
+// abstol is the absolute error tolerance for the eigenvalues computed by
+// bisection. If abstol is not positive, eps*|T| is used instead, where eps is
+// the machine precision and |T| is the 1-norm of the tridiagonal matrix.
+//
+// On return, m is the number of eigenvalues found, and the first m elements of
+// w contain the selected eigenvalues in ascending order. w must have length at
+// least n.
+//
+// If jobz == lapack.EVCompute, the first m columns of z contain on return the
+// orthonormal eigenvectors of A corresponding to the selected eigenvalues, with
+// the i-th column of z holding the eigenvector associated with w[i]. z must
+// have room for n rows and at least m columns, that is, ldz must be at least
+// iu-il+1 if rng == lapack.EVRangeIndex and at least n otherwise. z is not
+// referenced if jobz == lapack.EVNone.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum,
+//  lwork >= 1             if n <= 1,
+//  lwork >= 5*n           if jobz == lapack.EVNone,
+//  lwork >= 1 + 8*n + n^2 if jobz == lapack.EVCompute and all eigenvalues
+//                         are requested,
+//  lwork >= 9*n           otherwise,
+// and Dsyevr will panic otherwise. iwork is temporary integer storage, and
+// liwork specifies its usable length. At minimum,
+//  liwork >= 1       if n <= 1,
+//  liwork >= 2*n     if jobz == lapack.EVNone,
+//  liwork >= 3 + 5*n if jobz == lapack.EVCompute,
+// and Dsyevr will panic otherwise.
+//
+// If lwork == -1 or liwork == -1, instead of computing Dsyevr the optimal work
+// length is stored into work[0] and the optimal integer work length is stored
+// into iwork[0].
+//
+// Dsyevr returns whether all the requested eigenvectors converged. The
+// computed eigenvalues are valid even if ok is false.
+func (impl Implementation) Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	alleig := rng == lapack.EVRangeAll
+	valeig := rng == lapack.EVRangeValue
+	indeig := rng == lapack.EVRangeIndex
+
+	switch {
+	case !wantz && jobz != lapack.EVNone:
+		panic(badEVJob)
+	case !alleig && !valeig && !indeig:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case valeig && vu <= vl:
+		panic(badVu)
+	case indeig && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case indeig && (iu < min(n-1, il) || iu > n-1):
+		panic(badIu)
+	}
+	mmax := n
+	if indeig {
+		mmax = iu - il + 1
+	}
+	if ldz < 1 || wantz && ldz < mmax {
+		panic(badLdZ)
+	}
+
+	// All eigenvalues are computed with Dsterf or Dstedc rather than by
+	// bisection if they are all requested.
+	full := alleig || indeig && il == 0 && iu == n-1
+
+	var lwmin, liwmin, lopt int
+	switch {
+	case n <= 1:
+		lwmin, liwmin, lopt = 1, 1, 1
+	default:
+		switch {
+		case !wantz:
+			lwmin = 5 * n
+			liwmin = 2 * n
+		case full:
+			lwmin = 1 + 8*n + n*n
+			liwmin = 3 + 5*n
+		default:
+			lwmin = 9 * n
+			liwmin = 3 + 5*n
+		}
+		nb := impl.Ilaenv(1, "DSYTRD", string(uplo), n, -1, -1, -1)
+		nb = max(nb, impl.Ilaenv(1, "DORMTR", string(uplo), n, -1, -1, -1))
+		lopt = max(lwmin, 4*n+n*nb)
+	}
+
+	switch {
+	case lwork < lwmin && lwork != -1 && liwork != -1:
+		panic(badLWork)
+	case liwork < liwmin && lwork != -1 && liwork != -1:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(lopt)
+		iwork[0] = liwmin
+		return 0, true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+mmax:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if !valeig || vl < a[0] && a[0] <= vu {
+			w[0] = a[0]
+			if wantz {
+				z[0] = 1
+			}
+			return 1, true
+		}
+		return 0, true
+	}
+
+	// Get machine constants.
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	abstll := abstol
+	vll, vuu := vl, vu
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+		if abstol > 0 {
+			abstll *= sigma
+		}
+		if valeig {
+			vll *= sigma
+			vuu *= sigma
+		}
+	}
+
+	// Call Dsytrd to reduce the symmetric matrix to tridiagonal form.
+	const indtau = 0
+	indd := indtau + n
+	inde := indd + n
+	inde2 := inde + n
+	indwk := inde2 + n
+	llwork := lwork - indwk
+	impl.Dsytrd(uplo, n, a, lda, work[indd:], work[inde:], work[indtau:], work[indwk:], llwork)
+
+	if full {
+		// Compute all eigenvalues, working on copies of the tridiagonal
+		// matrix so that bisection can be used if this fails.
+		copy(w[:n], work[indd:indd+n])
+		copy(work[inde2:inde2+n-1], work[inde:inde+n-1])
+		if !wantz {
+			ok = impl.Dsterf(n, w, work[inde2:])
+		} else {
+			ok = impl.Dstedc(lapack.EVTridiag, n, w, work[inde2:], z, ldz, work[indwk:], llwork, iwork, liwork)
+			if ok {
+				// Apply the orthogonal matrix used in the reduction to
+				// tridiagonal form.
+				impl.Dormtr(blas.Left, uplo, blas.NoTrans, n, n, a, lda, work[indtau:], z, ldz, work[indwk:], llwork)
+			}
+		}
+		if ok {
+			m = n
+		}
+	}
+
+	if !ok {
+		// Compute the selected eigenvalues by bisection, and their
+		// eigenvectors by inverse iteration.
+		iblock := iwork[:n]
+		isplit := iwork[n : 2*n]
+		m, _ = impl.Dstebz(rng, n, vll, vuu, il, iu, abstll, work[indd:], work[inde:], w, iblock, isplit, work[indwk:])
+		ok = true
+		if wantz {
+			ok = impl.Dstein(n, work[indd:], work[inde:], m, w, iblock, isplit, z, ldz, work[indwk:], iwork[2*n:3*n], iwork[3*n:4*n])
+
+			// Apply the orthogonal matrix used in the reduction to
+			// tridiagonal form.
+			impl.Dormtr(blas.Left, uplo, blas.NoTrans, n, m, a, lda, work[indtau:], z, ldz, work[indwk:], llwork)
+		}
+	}
+
+	bi := blas64.Implementation()
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi.Dscal(m, 1/sigma, w, 1)
+	}
+
+	// Sort the eigenvalues into increasing order, using selection sort to
+	// minimize swaps of eigenvectors.
+	for i := 0; i < m-1; i++ {
+		k := i
+		p := w[i]
+		for j := i + 1; j < m; j++ {
+			if w[j] < p {
+				k = j
+				p = w[j]
+			}
+		}
+		if k != i {
+			w[k] = w[i]
+			w[i] = p
+			if wantz {
+				bi.Dswap(n, z[i:], ldz, z[k:], ldz)
+			}
+		}
+	}
+
+	work[0] = float64(lopt)
+	iwork[0] = liwmin
+	return m, ok
+}
@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsygv computes all the eigenvalues, and optionally, the eigenvectors of a
+// real generalized symmetric-definite eigenproblem of the form
+//  A*x = λ*B*x  if itype == lapack.GenEVAxBx,
+//  A*B*x = λ*x  if itype == lapack.GenEVABx,
+//  B*A*x = λ*x  if itype == lapack.GenEVBAx,
+// where A and B are n×n symmetric matrices and B is also positive definite.
+// For other values of itype Dsygv will panic.
+//
+// On entry, a and b contain the symmetric matrices A and B in the triangular
+// portion specified by uplo.
+//
+// If jobz == lapack.EVCompute, on return a contains the matrix Z of
+// eigenvectors. The eigenvectors are normalized as follows:
+//  Zᵀ*B*Z = I      if itype is lapack.GenEVAxBx or lapack.GenEVABx,
+//  Zᵀ*inv(B)*Z = I if itype == lapack.GenEVBAx.
+// If jobz == lapack.EVNone, on return the triangle of a specified by uplo,
+// including the diagonal, is destroyed. For other values of jobz Dsygv will
+// panic.
+//
+// On return, if ok is true, b contains the triangular factor U or L from the
+// Cholesky factorization B = Uᵀ*U or B = L*Lᵀ.
+//
+// w contains the eigenvalues in ascending order upon return. w must have
+// length at least n, and Dsygv will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n-1),
+// otherwise Dsygv will panic. For good performance, lwork must generally be
+// larger. On return, the optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Dsygv, the function only calculates
+// the optimal value of lwork and stores it into work[0].
+//
+// Dsygv returns whether the computation was successful. If ok is false,
+// either B is not positive definite, or the computation of the eigenvalues of
+// the reduced standard problem did not converge.
+func (impl Implementation) Dsygv(itype lapack.GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool) {
+	switch {
+	case itype != lapack.GenEVAxBx && itype != lapack.GenEVABx && itype != lapack.GenEVBAx:
+		panic(badGenEVType)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < max(1, 3*n-1) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	nb := impl.Ilaenv(1, "DSYTRD", string(uplo), n, -1, -1, -1)
+	lworkopt := max(1, (nb+2)*n)
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	// Form a Cholesky factorization of B.
+	ok = impl.Dpotrf(uplo, n, b, ldb)
+	if !ok {
+		work[0] = float64(lworkopt)
+		return false
+	}
+
+	// Transform the problem to the standard eigenvalue problem and solve.
+	impl.Dsygst(itype, uplo, n, a, lda, b, ldb)
+	ok = impl.Dsyev(jobz, uplo, n, a, lda, w, work, lwork)
+
+	if ok && jobz == lapack.EVCompute {
+		// Backtransform the eigenvectors to the solutions of the original
+		// problem.
+		bi := blas64.Implementation()
+		switch itype {
+		case lapack.GenEVAxBx, lapack.GenEVABx:
+			// For A*x = λ*B*x and A*B*x = λ*x, backtransform the
+			// eigenvectors as x = inv(L)ᵀ*y or inv(U)*y.
+			trans := blas.Trans
+			if uplo == blas.Upper {
+				trans = blas.NoTrans
+			}
+			bi.Dtrsm(blas.Left, uplo, trans, blas.NonUnit, n, n, 1, b, ldb, a, lda)
+		case lapack.GenEVBAx:
+			// For B*A*x = λ*x, backtransform the eigenvectors as
+			// x = L*y or Uᵀ*y.
+			trans := blas.NoTrans
+			if uplo == blas.Upper {
+				trans = blas.Trans
+			}
+			bi.Dtrmm(blas.Left, uplo, trans, blas.NonUnit, n, n, 1, b, ldb, a, lda)
+		}
+	}
+
+	work[0] = float64(max(lworkopt, int(work[0])))
+	return ok
+}
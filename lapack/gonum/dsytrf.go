@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dsytrf computes the factorization of a symmetric n×n matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The form of the factorization is
+//  A = U * D * Uᵀ  if uplo == blas.Upper, or
+//  A = L * D * Lᵀ  if uplo == blas.Lower,
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks.
+//
+// On entry, a contains the upper or lower triangle of A as specified by uplo.
+// On return, a contains the block diagonal matrix D and the multipliers used
+// to obtain the factor U or L.
+//
+// If uplo == blas.Upper, U = P_{n-1} * U_{n-1} * ... * P_k * U_k * ..., where
+// k decreases from n-1 to 0 in steps of 1 or 2 (depending on the size of the
+// diagonal blocks D_k), P_k is a permutation matrix as defined by ipiv[k], and
+// U_k is a unit upper triangular matrix, such that if the diagonal block D_k
+// is of order s (s = 1 or 2), then
+//  U_k = [ I  v  0 ]    k-s+1
+//        [ 0  I  0 ]    s
+//        [ 0  0  I ]    n-k-1
+//         k-s+1 s n-k-1
+// If s == 1, D_k overwrites A[k,k], and v overwrites A[0:k,k]. If s == 2, the
+// upper triangle of D_k overwrites A[k-1,k-1], A[k-1,k] and A[k,k], and v
+// overwrites A[0:k-1,k-1:k+1].
+//
+// If uplo == blas.Lower, L = P_0 * L_0 * ... * P_k * L_k * ..., where k
+// increases from 0 to n-1 in steps of 1 or 2, P_k is a permutation matrix as
+// defined by ipiv[k], and L_k is a unit lower triangular matrix, such that if
+// the diagonal block D_k is of order s (s = 1 or 2), then
+//  L_k = [ I  0  0 ]    k
+//        [ 0  I  0 ]    s
+//        [ 0  v  I ]    n-k-s
+//          k  s n-k-s
+// If s == 1, D_k overwrites A[k,k], and v overwrites A[k+1:n,k]. If s == 2,
+// the lower triangle of D_k overwrites A[k,k], A[k+1,k] and A[k+1,k+1], and v
+// overwrites A[k+2:n,k:k+2].
+//
+// ipiv contains details of the interchanges and the block structure of D and
+// must have length n. ipiv is zero-indexed.
+//  If ipiv[k] >= 0, rows and columns k and ipiv[k] were interchanged and D[k,k]
+//  is a 1×1 diagonal block.
+//  If uplo == blas.Upper and ipiv[k] = ipiv[k-1] < 0, rows and columns k-1 and
+//  -ipiv[k]-1 were interchanged and D[k-1:k+1,k-1:k+1] is a 2×2 diagonal block.
+//  If uplo == blas.Lower and ipiv[k] = ipiv[k+1] < 0, rows and columns k+1 and
+//  -ipiv[k]-1 were interchanged and D[k:k+2,k:k+2] is a 2×2 diagonal block.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= 1, and Dsytrf will panic otherwise. The amount of blocking
+// is limited by the usable length. If lwork == -1, instead of computing
+// Dsytrf the optimal work length is stored into work[0].
+//
+// Dsytrf returns whether D is nonsingular. If it returns false the
+// factorization has been completed, but the block diagonal matrix D is exactly
+// singular, and division by zero will occur if it is used to solve a system of
+// equations.
+func (impl Implementation) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	nb := impl.Ilaenv(1, "DSYTRF", string(uplo), n, -1, -1, -1)
+	lworkopt := max(1, n*nb)
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	nbmin := 2
+	if 1 < nb && nb < n {
+		if lwork < n*nb {
+			// Not enough workspace to use optimal nb: reduce nb and
+			// determine the minimum value of nb.
+			nb = max(lwork/n, 1)
+			nbmin = max(2, impl.Ilaenv(2, "DSYTRF", string(uplo), n, -1, -1, -1))
+		}
+	}
+	if nb < nbmin {
+		// Use the unblocked code.
+		nb = n
+	}
+	ldwork := nb
+
+	ok = true
+	if uplo == blas.Upper {
+		// Factorize A as U*D*Uᵀ using the upper triangle of A. k is the main
+		// loop index, decreasing from n-1 to 0 in steps of kb, where kb is
+		// the number of columns factorized by Dlasyf. kb is either nb or
+		// nb-1, or k+1 for the last block.
+		for k := n - 1; k >= 0; {
+			var kb int
+			var blockOk bool
+			if k+1 > nb {
+				// Factorize columns k-kb+1:k+1 of A and use blocked code to
+				// update columns 0:k-kb+1.
+				kb, blockOk = impl.Dlasyf(uplo, k+1, nb, a, lda, ipiv[:k+1], work, ldwork)
+			} else {
+				// Use unblocked code to factorize columns 0:k+1 of A.
+				blockOk = impl.Dsytf2(uplo, k+1, a, lda, ipiv[:k+1])
+				kb = k + 1
+			}
+			if !blockOk {
+				ok = false
+			}
+			k -= kb
+		}
+		work[0] = float64(lworkopt)
+		return ok
+	}
+
+	// Factorize A as L*D*Lᵀ using the lower triangle of A. k is the main loop
+	// index, increasing from 0 to n-1 in steps of kb, where kb is the number
+	// of columns factorized by Dlasyf. kb is either nb or nb-1, or n-k for the
+	// last block.
+	for k := 0; k < n; {
+		var kb int
+		var blockOk bool
+		if k < n-nb {
+			// Factorize columns k:k+kb of A and use blocked code to update
+			// columns k+kb:n.
+			kb, blockOk = impl.Dlasyf(uplo, n-k, nb, a[k*lda+k:], lda, ipiv[k:], work, ldwork)
+		} else {
+			// Use unblocked code to factorize columns k:n of A.
+			blockOk = impl.Dsytf2(uplo, n-k, a[k*lda+k:], lda, ipiv[k:])
+			kb = n - k
+		}
+		if !blockOk {
+			ok = false
+		}
+		// Adjust ipiv.
+		for j := k; j < k+kb; j++ {
+			if ipiv[j] >= 0 {
+				ipiv[j] += k
+			} else {
+				ipiv[j] -= k
+			}
+		}
+		k += kb
+	}
+	work[0] = float64(lworkopt)
+	return ok
+}
@@ -0,0 +1,532 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtgevc computes some or all of the right and/or left eigenvectors of a pair
+// of n×n real matrices (S,P), where S is upper quasi-triangular and P is upper
+// triangular. Matrix pairs of this type are produced by the generalized Schur
+// factorization of a real matrix pair (A,B):
+//  A = Q*S*Zᵀ,  B = Q*P*Zᵀ,
+// as computed by Dhgeqz.
+//
+// The right eigenvector x and the left eigenvector y of (S,P) corresponding
+// to an eigenvalue w are defined by
+//  S*x = w*P*x,  yᴴ*S = w*yᴴ*P.
+//
+// The eigenvalues are not input to this routine, but are computed directly
+// from the diagonal blocks of S and P. It is assumed that the 2×2 diagonal
+// blocks of P corresponding to 2×2 blocks of S are diagonal, as returned by
+// Dhgeqz.
+//
+// This routine returns the matrices X and/or Y of right and left eigenvectors
+// of (S,P), or the products Z*X and/or Q*Y, where Z and Q are input matrices.
+// If Q and Z are the orthogonal factors from the generalized Schur
+// factorization of a matrix pair (A,B), then Z*X and Q*Y are the matrices of
+// right and left eigenvectors of (A,B).
+//
+// If side == lapack.EVRight, only right eigenvectors will be computed.
+// If side == lapack.EVLeft, only left eigenvectors will be computed.
+// If side == lapack.EVBoth, both right and left eigenvectors will be computed.
+// For other values of side, Dtgevc will panic.
+//
+// If howmny == lapack.EVAll, all right and/or left eigenvectors will be
+// computed.
+// If howmny == lapack.EVAllMulQ, all right and/or left eigenvectors will be
+// computed and multiplied from left by the matrices in VR and/or VL.
+// If howmny == lapack.EVSelected, right and/or left eigenvectors will be
+// computed as indicated by selected.
+// For other values of howmny, Dtgevc will panic.
+//
+// selected specifies which eigenvectors will be computed. It must have length n
+// if howmny == lapack.EVSelected, and it is not referenced otherwise.
+// If w_j is a real eigenvalue, the corresponding real eigenvector will be
+// computed if selected[j] is true.
+// If w_j and w_{j+1} are a complex conjugate pair of eigenvalues, the
+// corresponding complex eigenvector is computed if either selected[j] or
+// selected[j+1] is true, and on return selected[j] will be set to true and
+// selected[j+1] will be set to false.
+//
+// VL and VR are n×mm matrices. If howmny is lapack.EVAll or lapack.EVAllMulQ,
+// mm must be at least n. If howmny is lapack.EVSelected, mm must be large
+// enough to store the selected eigenvectors. Each selected real eigenvector
+// occupies one column and each selected complex eigenvector occupies two
+// columns. If mm is not sufficiently large, Dtgevc will panic.
+//
+// On entry, if howmny is lapack.EVAllMulQ, it is assumed that VL (if side
+// is lapack.EVLeft or lapack.EVBoth) contains an n×n matrix Q, and that VR
+// (if side is lapack.EVRight or lapack.EVBoth) contains an n×n matrix Z,
+// typically the orthogonal matrices returned by Dhgeqz.
+//
+// Complex eigenvectors corresponding to a complex eigenvalue with positive
+// imaginary part are stored in VL and VR in two consecutive columns, the
+// first holding the real part, and the second the imaginary part.
+//
+// Each eigenvector will be normalized so that the element of largest magnitude
+// has magnitude 1. Here the magnitude of a complex number (x,y) is taken to be
+// |x| + |y|.
+//
+// work must have length at least 4*n, otherwise Dtgevc will panic.
+//
+// Dtgevc returns the number of columns in VL and/or VR actually used to store
+// the eigenvectors. If ok is false, a 2×2 diagonal block of (S,P) has real
+// eigenvalues and the computation of eigenvectors was stopped.
+//
+// Dtgevc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int, ok bool) {
+	bothv := side == lapack.EVBoth
+	rightv := side == lapack.EVRight || bothv
+	leftv := side == lapack.EVLeft || bothv
+	switch {
+	case !rightv && !leftv:
+		panic(badEVSide)
+	case howmny != lapack.EVAll && howmny != lapack.EVAllMulQ && howmny != lapack.EVSelected:
+		panic(badEVHowMany)
+	case n < 0:
+		panic(nLT0)
+	case lds < max(1, n):
+		panic(badLdS)
+	case ldp < max(1, n):
+		panic(badLdP)
+	case mm < 0:
+		panic(mmLT0)
+	case ldvl < 1, leftv && ldvl < mm:
+		panic(badLdVL)
+	case ldvr < 1, rightv && ldvr < mm:
+		panic(badLdVR)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(s) < (n-1)*lds+n:
+		panic(shortS)
+	case len(p) < (n-1)*ldp+n:
+		panic(shortP)
+	case len(work) < 4*n:
+		panic(shortWork)
+	}
+
+	if howmny == lapack.EVSelected {
+		if len(selected) != n {
+			panic(badLenSelected)
+		}
+		// Set m to the number of columns required to store the selected
+		// eigenvectors, and standardize the slice selected.
+		for j := 0; j < n; {
+			if j == n-1 || s[(j+1)*lds+j] == 0 {
+				if selected[j] {
+					m++
+				}
+				j++
+			} else {
+				if selected[j] || selected[j+1] {
+					selected[j] = true
+					selected[j+1] = false
+					m += 2
+				}
+				j += 2
+			}
+		}
+	} else {
+		m = n
+	}
+	if mm < m {
+		panic(badMm)
+	}
+
+	switch {
+	case leftv && len(vl) < (n-1)*ldvl+mm:
+		panic(shortVL)
+	case rightv && len(vr) < (n-1)*ldvr+mm:
+		panic(shortVR)
+	}
+
+	// Quick return if no eigenvectors were selected.
+	if m == 0 {
+		return 0, true
+	}
+
+	const safety = 100
+	safmin := dlamchS
+	ulp := dlamchP
+	small := safmin * float64(n) / ulp
+	big := 1 / small
+	bignum := 1 / (safmin * float64(n))
+
+	anorm := math.Max(impl.Dlange(lapack.MaxColumnSum, n, n, s, lds, work[:n]), safmin)
+	bnorm := math.Max(impl.Dlange(lapack.MaxColumnSum, n, n, p, ldp, work[:n]), safmin)
+	ascale := 1 / anorm
+	bscale := 1 / bnorm
+
+	// coef computes the coefficients acoef and bcoef = bcoefr + i*bcoefi of
+	// the eigenvalue w = bcoef/acoef of the diagonal block of (S,P) starting
+	// at j with size nw, scaled so that acoef*S - bcoef*P does not overflow.
+	// If the block is 1×1 and both S[j,j] and P[j,j] are negligible,
+	// singular is true. If the block is 2×2 and has real eigenvalues, ok is
+	// false.
+	coef := func(j, nw int) (acoef, bcoefr, bcoefi float64, singular, ok bool) {
+		if nw == 1 {
+			sjj := s[j*lds+j]
+			pjj := p[j*ldp+j]
+			if math.Abs(sjj) <= safmin && math.Abs(pjj) <= safmin {
+				return 0, 0, 0, true, true
+			}
+			temp := 1 / math.Max(math.Max(math.Abs(sjj)*ascale, math.Abs(pjj)*bscale), safmin)
+			salfar := (temp * sjj) * ascale
+			sbeta := (temp * pjj) * bscale
+			acoef = sbeta * ascale
+			bcoefr = salfar * bscale
+
+			// Scale to avoid underflow.
+			scale := 1.0
+			lsa := math.Abs(sbeta) >= safmin && math.Abs(acoef) < small
+			lsb := math.Abs(salfar) >= safmin && math.Abs(bcoefr) < small
+			if lsa {
+				scale = (small / math.Abs(sbeta)) * math.Min(anorm, big)
+			}
+			if lsb {
+				scale = math.Max(scale, (small/math.Abs(salfar))*math.Min(bnorm, big))
+			}
+			if lsa || lsb {
+				scale = math.Min(scale, 1/(safmin*math.Max(1, math.Max(math.Abs(acoef), math.Abs(bcoefr)))))
+				if lsa {
+					acoef = ascale * (scale * sbeta)
+				} else {
+					acoef *= scale
+				}
+				if lsb {
+					bcoefr = bscale * (scale * salfar)
+				} else {
+					bcoefr *= scale
+				}
+			}
+			return acoef, bcoefr, 0, false, true
+		}
+
+		acoef, _, bcoefr, _, bcoefi = impl.Dlag2(s[j*lds+j:], lds, p[j*ldp+j:], ldp, safmin*safety)
+		if bcoefi == 0 {
+			return 0, 0, 0, false, false
+		}
+
+		// Scale to avoid over/underflow.
+		acoefa := math.Abs(acoef)
+		bcoefa := math.Abs(bcoefr) + math.Abs(bcoefi)
+		scale := 1.0
+		if acoefa*ulp < safmin && acoefa >= safmin {
+			scale = (safmin / ulp) / acoefa
+		}
+		if bcoefa*ulp < safmin && bcoefa >= safmin {
+			scale = math.Max(scale, (safmin/ulp)/bcoefa)
+		}
+		if safmin*acoefa > ascale {
+			scale = ascale / (safmin * acoefa)
+		}
+		if safmin*bcoefa > bscale {
+			scale = math.Min(scale, bscale/(safmin*bcoefa))
+		}
+		if scale != 1 {
+			acoef *= scale
+			bcoefr *= scale
+			bcoefi *= scale
+		}
+		return acoef, bcoefr, bcoefi, false, true
+	}
+
+	bi := blas64.Implementation()
+	xr := work[:n]
+	xi := work[n : 2*n]
+	var rhs, x [4]float64
+
+	// store stores the eigenvector in xr and xi with nonzero elements in
+	// [lo:hi] into the columns iv and, if nw == 2, iv+1 of V, multiplying it
+	// by the columns [lo:hi] of V first if howmny == lapack.EVAllMulQ. The
+	// stored vector is normalized.
+	store := func(v []float64, ldv int, iv, nw, lo, hi int) {
+		if howmny == lapack.EVAllMulQ {
+			tr := work[2*n : 3*n]
+			ti := work[3*n : 4*n]
+			bi.Dgemv(blas.NoTrans, n, hi-lo, 1, v[lo:], ldv, xr[lo:], 1, 0, tr, 1)
+			if nw == 2 {
+				bi.Dgemv(blas.NoTrans, n, hi-lo, 1, v[lo:], ldv, xi[lo:], 1, 0, ti, 1)
+			}
+			lo, hi = 0, n
+			copy(xr, tr)
+			if nw == 2 {
+				copy(xi, ti)
+			}
+		}
+		for i := 0; i < n; i++ {
+			v[i*ldv+iv] = 0
+			if nw == 2 {
+				v[i*ldv+iv+1] = 0
+			}
+		}
+		var xmax float64
+		for i := lo; i < hi; i++ {
+			v[i*ldv+iv] = xr[i]
+			if nw == 2 {
+				v[i*ldv+iv+1] = xi[i]
+				xmax = math.Max(xmax, math.Abs(xr[i])+math.Abs(xi[i]))
+			} else {
+				xmax = math.Max(xmax, math.Abs(xr[i]))
+			}
+		}
+		if xmax > safmin {
+			bi.Dscal(n, 1/xmax, v[iv:], ldv)
+			if nw == 2 {
+				bi.Dscal(n, 1/xmax, v[iv+1:], ldv)
+			}
+		}
+	}
+
+	// rescale guards against overflow in the subsequent updates by
+	// rescaling the partial eigenvector in [lo:hi] if it has grown too
+	// large.
+	rescale := func(nw, lo, hi int) {
+		var xmax float64
+		for i := lo; i < hi; i++ {
+			xmax = math.Max(xmax, math.Abs(xr[i])+math.Abs(xi[i]))
+		}
+		if xmax > bignum {
+			bi.Dscal(hi-lo, 1/xmax, xr[lo:], 1)
+			if nw == 2 {
+				bi.Dscal(hi-lo, 1/xmax, xi[lo:], 1)
+			}
+		}
+	}
+
+	if leftv {
+		// Compute the left eigenvectors by forward substitution, from
+		// the first eigenvalue to the last.
+		iv := 0
+		for je := 0; je < n; {
+			nw := 1
+			if je < n-1 && s[(je+1)*lds+je] != 0 {
+				nw = 2
+			}
+			if howmny == lapack.EVSelected && !selected[je] {
+				je += nw
+				continue
+			}
+			col := je
+			if howmny == lapack.EVSelected {
+				col = iv
+			}
+
+			acoef, bcoefr, bcoefi, singular, okc := coef(je, nw)
+			if !okc {
+				return m, false
+			}
+			for i := je; i < n; i++ {
+				xr[i] = 0
+				xi[i] = 0
+			}
+			if singular {
+				// Singular matrix pencil, return a unit
+				// eigenvector.
+				xr[je] = 1
+				store(vl, ldvl, col, nw, je, je+1)
+				je += nw
+				iv += nw
+				continue
+			}
+
+			// The left eigenvector y satisfies
+			//  (acoef*Sᵀ - conj(bcoef)*Pᵀ)*y = 0.
+			wr := bcoefr
+			wi := -bcoefi
+			dmin := math.Max(math.Max(ulp*math.Abs(acoef)*anorm, ulp*(math.Abs(bcoefr)+math.Abs(bcoefi))*bnorm), safmin)
+
+			// Compute the leading part of the eigenvector from the
+			// diagonal block.
+			if nw == 1 {
+				xr[je] = 1
+			} else {
+				s00 := acoef * s[je*lds+je]
+				s01 := acoef * s[je*lds+je+1]
+				s10 := acoef * s[(je+1)*lds+je]
+				s11 := acoef * s[(je+1)*lds+je+1]
+				p0 := p[je*ldp+je]
+				p1 := p[(je+1)*ldp+je+1]
+				if math.Abs(s00-wr*p0)+math.Abs(wi*p0)+math.Abs(s10) >= math.Abs(s01)+math.Abs(s11-wr*p1)+math.Abs(wi*p1) {
+					xr[je] = s10
+					xr[je+1] = wr*p0 - s00
+					xi[je+1] = wi * p0
+				} else {
+					xr[je] = wr*p1 - s11
+					xi[je] = wi * p1
+					xr[je+1] = s01
+				}
+				xmax := math.Max(math.Abs(xr[je])+math.Abs(xi[je]), math.Abs(xr[je+1])+math.Abs(xi[je+1]))
+				for i := je; i < je+2; i++ {
+					xr[i] /= xmax
+					xi[i] /= xmax
+				}
+			}
+
+			for j := je + nw; j < n; {
+				na := 1
+				if j < n-1 && s[(j+1)*lds+j] != 0 {
+					na = 2
+				}
+				for r := j; r < j+na; r++ {
+					var ssr, ssi, spr, spi float64
+					for k := je; k < j; k++ {
+						ssr += s[k*lds+r] * xr[k]
+						ssi += s[k*lds+r] * xi[k]
+						spr += p[k*ldp+r] * xr[k]
+						spi += p[k*ldp+r] * xi[k]
+					}
+					rhs[(r-j)*2] = -(acoef*ssr - wr*spr + wi*spi)
+					rhs[(r-j)*2+1] = -(acoef*ssi - wr*spi - wi*spr)
+				}
+				d2 := 0.0
+				if na == 2 {
+					d2 = p[(j+1)*ldp+j+1]
+				}
+				scale, _, _ := impl.Dlaln2(true, na, nw, dmin, acoef, s[j*lds+j:], lds, p[j*ldp+j], d2, rhs[:], 2, wr, wi, x[:], 2)
+				if scale < 1 {
+					bi.Dscal(j-je, scale, xr[je:], 1)
+					bi.Dscal(j-je, scale, xi[je:], 1)
+				}
+				for r := 0; r < na; r++ {
+					xr[j+r] = x[r*2]
+					if nw == 2 {
+						xi[j+r] = x[r*2+1]
+					}
+				}
+				j += na
+				rescale(nw, je, j)
+			}
+			store(vl, ldvl, col, nw, je, n)
+			je += nw
+			iv += nw
+		}
+	}
+
+	if rightv {
+		// Compute the right eigenvectors by back substitution, from the
+		// last eigenvalue to the first.
+		iv := m
+		for je := n - 1; je >= 0; {
+			nw := 1
+			jb := je
+			if je > 0 && s[je*lds+je-1] != 0 {
+				nw = 2
+				jb = je - 1
+			}
+			if howmny == lapack.EVSelected && !selected[jb] {
+				je = jb - 1
+				continue
+			}
+			iv -= nw
+			col := jb
+			if howmny == lapack.EVSelected {
+				col = iv
+			}
+
+			acoef, bcoefr, bcoefi, singular, okc := coef(jb, nw)
+			if !okc {
+				return m, false
+			}
+			for i := 0; i <= je; i++ {
+				xr[i] = 0
+				xi[i] = 0
+			}
+			if singular {
+				// Singular matrix pencil, return a unit
+				// eigenvector.
+				xr[jb] = 1
+				store(vr, ldvr, col, nw, 0, jb+1)
+				je = jb - 1
+				continue
+			}
+
+			// The right eigenvector x satisfies
+			//  (acoef*S - bcoef*P)*x = 0.
+			wr := bcoefr
+			wi := bcoefi
+			dmin := math.Max(math.Max(ulp*math.Abs(acoef)*anorm, ulp*(math.Abs(bcoefr)+math.Abs(bcoefi))*bnorm), safmin)
+
+			// Compute the trailing part of the eigenvector from the
+			// diagonal block.
+			if nw == 1 {
+				xr[jb] = 1
+			} else {
+				s00 := acoef * s[jb*lds+jb]
+				s01 := acoef * s[jb*lds+jb+1]
+				s10 := acoef * s[(jb+1)*lds+jb]
+				s11 := acoef * s[(jb+1)*lds+jb+1]
+				p0 := p[jb*ldp+jb]
+				p1 := p[(jb+1)*ldp+jb+1]
+				if math.Abs(s10)+math.Abs(s11-wr*p1)+math.Abs(wi*p1) >= math.Abs(s00-wr*p0)+math.Abs(wi*p0)+math.Abs(s01) {
+					xr[jb] = wr*p1 - s11
+					xi[jb] = wi * p1
+					xr[jb+1] = s10
+				} else {
+					xr[jb] = s01
+					xr[jb+1] = wr*p0 - s00
+					xi[jb+1] = wi * p0
+				}
+				xmax := math.Max(math.Abs(xr[jb])+math.Abs(xi[jb]), math.Abs(xr[jb+1])+math.Abs(xi[jb+1]))
+				for i := jb; i < jb+2; i++ {
+					xr[i] /= xmax
+					xi[i] /= xmax
+				}
+			}
+
+			for j := jb - 1; j >= 0; {
+				na := 1
+				bj := j
+				if j > 0 && s[j*lds+j-1] != 0 {
+					na = 2
+					bj = j - 1
+				}
+				for r := bj; r <= j; r++ {
+					var ssr, ssi, spr, spi float64
+					for k := j + 1; k <= je; k++ {
+						ssr += s[r*lds+k] * xr[k]
+						ssi += s[r*lds+k] * xi[k]
+						spr += p[r*ldp+k] * xr[k]
+						spi += p[r*ldp+k] * xi[k]
+					}
+					rhs[(r-bj)*2] = -(acoef*ssr - wr*spr + wi*spi)
+					rhs[(r-bj)*2+1] = -(acoef*ssi - wr*spi - wi*spr)
+				}
+				d2 := 0.0
+				if na == 2 {
+					d2 = p[j*ldp+j]
+				}
+				scale, _, _ := impl.Dlaln2(false, na, nw, dmin, acoef, s[bj*lds+bj:], lds, p[bj*ldp+bj], d2, rhs[:], 2, wr, wi, x[:], 2)
+				if scale < 1 {
+					bi.Dscal(je-j, scale, xr[j+1:], 1)
+					bi.Dscal(je-j, scale, xi[j+1:], 1)
+				}
+				for r := 0; r < na; r++ {
+					xr[bj+r] = x[r*2]
+					if nw == 2 {
+						xi[bj+r] = x[r*2+1]
+					}
+				}
+				j = bj - 1
+				rescale(nw, bj, je+1)
+			}
+			store(vr, ldvr, col, nw, 0, je+1)
+			je = jb - 1
+		}
+	}
+	return m, true
+}
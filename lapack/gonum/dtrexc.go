@@ -43,8 +43,6 @@ import "gonum.org/v1/gonum/lapack"
 // has been moved.
 //
 // work must have length at least n, otherwise Dtrexc will panic.
-//
-// Dtrexc is an internal routine. It is exported for testing purposes.
 func (impl Implementation) Dtrexc(compq lapack.UpdateSchurComp, n int, t []float64, ldt int, q []float64, ldq int, ifst, ilst int, work []float64) (ifstOut, ilstOut int, ok bool) {
 	switch {
 	case compq != lapack.UpdateSchur && compq != lapack.UpdateSchurNone:
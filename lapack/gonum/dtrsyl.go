@@ -0,0 +1,168 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dtrsyl solves the real Sylvester matrix equation
+//  op(A)*X + isgn*X*op(B) = scale*C
+// where op(A) = A or Aᵀ as specified by trana, op(B) = B or Bᵀ as specified by
+// tranb, A is an m×m and B is an n×n upper quasi-triangular matrix in Schur
+// canonical form, and C and X are m×n matrices. isgn must be 1 or -1. The
+// solution X overwrites C.
+//
+// A and B must be block upper triangular with 1×1 and 2×2 diagonal blocks,
+// such as the matrix T returned by Dhseqr or Dgees. The equation is solved by
+// a block back-substitution over the diagonal blocks of A and B, where each
+// small Sylvester equation is solved by Dlasy2.
+//
+// scale is a scale factor less than or equal to 1 that is chosen to avoid
+// overflow in X.
+//
+// If ok is false, A and -isgn*B have common or very close eigenvalues and
+// perturbed values were used to solve the equation, but the matrices A and B
+// are unchanged.
+func (impl Implementation) Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool) {
+	notrna := trana == blas.NoTrans
+	notrnb := tranb == blas.NoTrans
+	switch {
+	case !notrna && trana != blas.Trans && trana != blas.ConjTrans:
+		panic(badTrans)
+	case !notrnb && tranb != blas.Trans && tranb != blas.ConjTrans:
+		panic(badTrans)
+	case isgn != 1 && isgn != -1:
+		panic(badIsgn)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, m):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	scale = 1
+	if m == 0 || n == 0 {
+		return scale, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+m:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	bi := blas64.Implementation()
+	sgn := float64(isgn)
+	ok = true
+
+	var rhs, x [4]float64
+	// solve computes the block of X with rows ks:ke+1 and columns ls:le+1
+	// from the right-hand side in rhs.
+	solve := func(ks, ke, ls, le int) {
+		kn := ke - ks + 1
+		ln := le - ls + 1
+		scaloc, _, okloc := impl.Dlasy2(!notrna, !notrnb, isgn, kn, ln, a[ks*lda+ks:], lda, b[ls*ldb+ls:], ldb, rhs[:], 2, x[:], 2)
+		if !okloc {
+			ok = false
+		}
+		if scaloc != 1 {
+			for i := 0; i < m; i++ {
+				bi.Dscal(n, scaloc, c[i*ldc:], 1)
+			}
+			scale *= scaloc
+		}
+		for i := 0; i < kn; i++ {
+			for j := 0; j < ln; j++ {
+				c[(ks+i)*ldc+ls+j] = x[i*2+j]
+			}
+		}
+	}
+
+	// The blocks of X are computed in an order such that all the blocks
+	// referenced in the update of the right-hand side have already been
+	// computed. For each block, the contributions of A and B are:
+	//  op(A) == A:  rows of A to the right of the block times X below it,
+	//  op(A) == Aᵀ: columns of A above the block times X above it,
+	//  op(B) == B:  X to the left of the block times columns of B above it,
+	//  op(B) == Bᵀ: X to the right of the block times rows of B to its right.
+	for lcnt := 0; lcnt < n; {
+		// Determine the current block of B. The columns of X are processed
+		// from left to right if op(B) == B and from right to left otherwise.
+		var ls, le int
+		if notrnb {
+			ls = lcnt
+			le = ls
+			if ls < n-1 && b[(ls+1)*ldb+ls] != 0 {
+				le++
+			}
+		} else {
+			le = n - 1 - lcnt
+			ls = le
+			if le > 0 && b[le*ldb+le-1] != 0 {
+				ls--
+			}
+		}
+		lcnt += le - ls + 1
+
+		for kcnt := 0; kcnt < m; {
+			// Determine the current block of A. The rows of X are
+			// processed from bottom to top if op(A) == A and from top to
+			// bottom otherwise.
+			var ks, ke int
+			if notrna {
+				ke = m - 1 - kcnt
+				ks = ke
+				if ke > 0 && a[ke*lda+ke-1] != 0 {
+					ks--
+				}
+			} else {
+				ks = kcnt
+				ke = ks
+				if ks < m-1 && a[(ks+1)*lda+ks] != 0 {
+					ke++
+				}
+			}
+			kcnt += ke - ks + 1
+
+			for i := ks; i <= ke; i++ {
+				for j := ls; j <= le; j++ {
+					var suml, sumr float64
+					if notrna {
+						if ke < m-1 {
+							suml = bi.Ddot(m-ke-1, a[i*lda+ke+1:], 1, c[(ke+1)*ldc+j:], ldc)
+						}
+					} else {
+						if ks > 0 {
+							suml = bi.Ddot(ks, a[i:], lda, c[j:], ldc)
+						}
+					}
+					if notrnb {
+						if ls > 0 {
+							sumr = bi.Ddot(ls, c[i*ldc:], 1, b[j:], ldb)
+						}
+					} else {
+						if le < n-1 {
+							sumr = bi.Ddot(n-le-1, c[i*ldc+le+1:], 1, b[j*ldb+le+1:], 1)
+						}
+					}
+					rhs[(i-ks)*2+j-ls] = c[i*ldc+j] - (suml + sgn*sumr)
+				}
+			}
+			solve(ks, ke, ls, le)
+		}
+	}
+	return scale, ok
+}
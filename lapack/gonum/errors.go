@@ -8,15 +8,19 @@ package gonum
 const (
 	// Panic strings for bad enumeration values.
 	badApplyOrtho      = "lapack: bad ApplyOrtho"
+	badBDComp          = "lapack: bad BDComp"
 	badBalanceJob      = "lapack: bad BalanceJob"
 	badDiag            = "lapack: bad Diag"
 	badDirect          = "lapack: bad Direct"
 	badEVComp          = "lapack: bad EVComp"
 	badEVHowMany       = "lapack: bad EVHowMany"
 	badEVJob           = "lapack: bad EVJob"
+	badEVRange         = "lapack: bad EVRange"
 	badEVSide          = "lapack: bad EVSide"
 	badGSVDJob         = "lapack: bad GSVDJob"
+	badGenEVType       = "lapack: bad GenEVType"
 	badGenOrtho        = "lapack: bad GenOrtho"
+	badJob             = "lapack: bad Job"
 	badLeftEVJob       = "lapack: bad LeftEVJob"
 	badMatrixType      = "lapack: bad MatrixType"
 	badNorm            = "lapack: bad Norm"
@@ -34,14 +38,21 @@ const (
 	bothSVDOver        = "lapack: both jobU and jobVT are lapack.SVDOverwrite"
 
 	// Panic strings for bad numerical and string values.
+	badCutpnt   = "lapack: cutpnt out of range"
+	badDtrd1    = "lapack: dtrd1 not 1 or -1"
+	badDtrd2    = "lapack: dtrd2 not 1 or -1"
 	badIfst     = "lapack: ifst out of range"
 	badIhi      = "lapack: ihi out of range"
 	badIhiz     = "lapack: ihiz out of range"
+	badIl       = "lapack: il out of range"
 	badIlo      = "lapack: ilo out of range"
 	badIloz     = "lapack: iloz out of range"
 	badIlst     = "lapack: ilst out of range"
+	badIndex    = "lapack: index out of range"
 	badIsave    = "lapack: bad isave value"
 	badIspec    = "lapack: bad ispec value"
+	badIsgn     = "lapack: isgn not 1 or -1"
+	badIu       = "lapack: iu out of range"
 	badJ1       = "lapack: j1 out of range"
 	badJpvt     = "lapack: bad element of jpvt"
 	badK1       = "lapack: k1 out of range"
@@ -49,6 +60,7 @@ const (
 	badKacc22   = "lapack: invalid value of kacc22"
 	badKbot     = "lapack: kbot out of range"
 	badKtop     = "lapack: ktop out of range"
+	badLIWork   = "lapack: insufficient declared integer workspace length"
 	badLWork    = "lapack: insufficient declared workspace length"
 	badMm       = "lapack: mm out of range"
 	badN1       = "lapack: bad value of n1"
@@ -59,6 +71,9 @@ const (
 	badNw       = "lapack: bad value of nw"
 	badPp       = "lapack: bad value of pp"
 	badShifts   = "lapack: bad shifts"
+	badSmlsiz   = "lapack: bad value of smlsiz"
+	badSqre     = "lapack: sqre not 0 or 1"
+	badVu       = "lapack: vu <= vl"
 	i0LT0       = "lapack: i0 < 0"
 	kGTM        = "lapack: k > m"
 	kGTN        = "lapack: k > n"
@@ -67,6 +82,10 @@ const (
 	kdLT0       = "lapack: kd < 0"
 	klLT0       = "lapack: kl < 0"
 	kuLT0       = "lapack: ku < 0"
+	lGTM        = "lapack: l > m"
+	lGTN        = "lapack: l > n"
+	lGTNMinusM  = "lapack: l > n-m"
+	lLT0        = "lapack: l < 0"
 	mGTN        = "lapack: m > n"
 	mLT0        = "lapack: m < 0"
 	mmLT0       = "lapack: mm < 0"
@@ -85,8 +104,10 @@ const (
 	negANorm    = "lapack: anorm < 0"
 	negZ        = "lapack: negative z value"
 	nhLT0       = "lapack: nh < 0"
+	nlLT1       = "lapack: nl < 1"
 	notIsolated = "lapack: block is not isolated"
 	nrhsLT0     = "lapack: nrhs < 0"
+	nrLT1       = "lapack: nr < 1"
 	nruLT0      = "lapack: nru < 0"
 	nshftsLT0   = "lapack: nshfts < 0"
 	nshftsOdd   = "lapack: nshfts must be even"
@@ -99,7 +120,10 @@ const (
 
 	// Panic strings for bad slice lengths.
 	badLenAlpha    = "lapack: bad length of alpha"
+	badLenAlphai   = "lapack: bad length of alphai"
+	badLenAlphar   = "lapack: bad length of alphar"
 	badLenBeta     = "lapack: bad length of beta"
+	badLenCtot     = "lapack: bad length of ctot"
 	badLenIpiv     = "lapack: bad length of ipiv"
 	badLenJpiv     = "lapack: bad length of jpiv"
 	badLenJpvt     = "lapack: bad length of jpvt"
@@ -112,58 +136,89 @@ const (
 	badLenWr       = "lapack: bad length of wr"
 
 	// Panic strings for insufficient slice lengths.
-	shortA     = "lapack: insufficient length of a"
-	shortAB    = "lapack: insufficient length of ab"
-	shortAuxv  = "lapack: insufficient length of auxv"
-	shortB     = "lapack: insufficient length of b"
-	shortC     = "lapack: insufficient length of c"
-	shortCNorm = "lapack: insufficient length of cnorm"
-	shortD     = "lapack: insufficient length of d"
-	shortDL    = "lapack: insufficient length of dl"
-	shortDU    = "lapack: insufficient length of du"
-	shortE     = "lapack: insufficient length of e"
-	shortF     = "lapack: insufficient length of f"
-	shortH     = "lapack: insufficient length of h"
-	shortIWork = "lapack: insufficient length of iwork"
-	shortIsgn  = "lapack: insufficient length of isgn"
-	shortQ     = "lapack: insufficient length of q"
-	shortRHS   = "lapack: insufficient length of rhs"
-	shortS     = "lapack: insufficient length of s"
-	shortScale = "lapack: insufficient length of scale"
-	shortT     = "lapack: insufficient length of t"
-	shortTau   = "lapack: insufficient length of tau"
-	shortTauP  = "lapack: insufficient length of tauP"
-	shortTauQ  = "lapack: insufficient length of tauQ"
-	shortU     = "lapack: insufficient length of u"
-	shortV     = "lapack: insufficient length of v"
-	shortVL    = "lapack: insufficient length of vl"
-	shortVR    = "lapack: insufficient length of vr"
-	shortVT    = "lapack: insufficient length of vt"
-	shortVn1   = "lapack: insufficient length of vn1"
-	shortVn2   = "lapack: insufficient length of vn2"
-	shortW     = "lapack: insufficient length of w"
-	shortWH    = "lapack: insufficient length of wh"
-	shortWV    = "lapack: insufficient length of wv"
-	shortWi    = "lapack: insufficient length of wi"
-	shortWork  = "lapack: insufficient length of work"
-	shortWr    = "lapack: insufficient length of wr"
-	shortX     = "lapack: insufficient length of x"
-	shortY     = "lapack: insufficient length of y"
-	shortZ     = "lapack: insufficient length of z"
+	shortA      = "lapack: insufficient length of a"
+	shortAB     = "lapack: insufficient length of ab"
+	shortAF     = "lapack: insufficient length of af"
+	shortAuxv   = "lapack: insufficient length of auxv"
+	shortB      = "lapack: insufficient length of b"
+	shortBerr   = "lapack: insufficient length of berr"
+	shortC      = "lapack: insufficient length of c"
+	shortCNorm  = "lapack: insufficient length of cnorm"
+	shortD      = "lapack: insufficient length of d"
+	shortDL     = "lapack: insufficient length of dl"
+	shortDU     = "lapack: insufficient length of du"
+	shortDelta  = "lapack: insufficient length of delta"
+	shortDlamda = "lapack: insufficient length of dlamda"
+	shortDsigma = "lapack: insufficient length of dsigma"
+	shortE      = "lapack: insufficient length of e"
+	shortF      = "lapack: insufficient length of f"
+	shortFerr   = "lapack: insufficient length of ferr"
+	shortH      = "lapack: insufficient length of h"
+	shortIWork  = "lapack: insufficient length of iwork"
+	shortIblock = "lapack: insufficient length of iblock"
+	shortIdxq   = "lapack: insufficient length of idxq"
+	shortIfail  = "lapack: insufficient length of ifail"
+	shortIn     = "lapack: insufficient length of in"
+	shortIndex  = "lapack: insufficient length of index"
+	shortIndx   = "lapack: insufficient length of indx"
+	shortIndxq  = "lapack: insufficient length of indxq"
+	shortInode  = "lapack: insufficient length of inode"
+	shortIsgn   = "lapack: insufficient length of isgn"
+	shortIsplit = "lapack: insufficient length of isplit"
+	shortP      = "lapack: insufficient length of p"
+	shortQ      = "lapack: insufficient length of q"
+	shortQ2     = "lapack: insufficient length of q2"
+	shortR      = "lapack: insufficient length of r"
+	shortRHS    = "lapack: insufficient length of rhs"
+	shortRWork  = "lapack: insufficient length of rwork"
+	shortS      = "lapack: insufficient length of s"
+	shortSA     = "lapack: insufficient length of sa"
+	shortSWork  = "lapack: insufficient length of swork"
+	shortScale  = "lapack: insufficient length of scale"
+	shortT      = "lapack: insufficient length of t"
+	shortTau    = "lapack: insufficient length of tau"
+	shortTauP   = "lapack: insufficient length of tauP"
+	shortTauQ   = "lapack: insufficient length of tauQ"
+	shortU      = "lapack: insufficient length of u"
+	shortU2     = "lapack: insufficient length of u2"
+	shortV      = "lapack: insufficient length of v"
+	shortVL     = "lapack: insufficient length of vl"
+	shortVR     = "lapack: insufficient length of vr"
+	shortVS     = "lapack: insufficient length of vs"
+	shortVT     = "lapack: insufficient length of vt"
+	shortVT2    = "lapack: insufficient length of vt2"
+	shortVn1    = "lapack: insufficient length of vn1"
+	shortVn2    = "lapack: insufficient length of vn2"
+	shortW      = "lapack: insufficient length of w"
+	shortWH     = "lapack: insufficient length of wh"
+	shortWV     = "lapack: insufficient length of wv"
+	shortWi     = "lapack: insufficient length of wi"
+	shortWork   = "lapack: insufficient length of work"
+	shortWr     = "lapack: insufficient length of wr"
+	shortX      = "lapack: insufficient length of x"
+	shortY      = "lapack: insufficient length of y"
+	shortZ      = "lapack: insufficient length of z"
 
 	// Panic strings for bad leading dimensions of matrices.
 	badLdA    = "lapack: bad leading dimension of A"
+	badLdAF   = "lapack: bad leading dimension of AF"
 	badLdB    = "lapack: bad leading dimension of B"
 	badLdC    = "lapack: bad leading dimension of C"
 	badLdF    = "lapack: bad leading dimension of F"
 	badLdH    = "lapack: bad leading dimension of H"
+	badLdP    = "lapack: bad leading dimension of P"
 	badLdQ    = "lapack: bad leading dimension of Q"
+	badLdS    = "lapack: bad leading dimension of S"
+	badLdSA   = "lapack: bad leading dimension of SA"
 	badLdT    = "lapack: bad leading dimension of T"
 	badLdU    = "lapack: bad leading dimension of U"
+	badLdU2   = "lapack: bad leading dimension of U2"
 	badLdV    = "lapack: bad leading dimension of V"
 	badLdVL   = "lapack: bad leading dimension of VL"
 	badLdVR   = "lapack: bad leading dimension of VR"
+	badLdVS   = "lapack: bad leading dimension of VS"
 	badLdVT   = "lapack: bad leading dimension of VT"
+	badLdVT2  = "lapack: bad leading dimension of VT2"
 	badLdW    = "lapack: bad leading dimension of W"
 	badLdWH   = "lapack: bad leading dimension of WH"
 	badLdWV   = "lapack: bad leading dimension of WV"
@@ -177,4 +232,5 @@ const (
 	badIncX      = "lapack: incX <= 0"
 	badIncY      = "lapack: incY <= 0"
 	zeroIncV     = "lapack: incv == 0"
+	zeroIncX     = "lapack: incX == 0"
 )
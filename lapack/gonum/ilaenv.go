@@ -4,6 +4,8 @@
 
 package gonum
 
+import gonumblas "gonum.org/v1/gonum/blas/gonum"
+
 // Ilaenv returns algorithm tuning parameters for the algorithm given by the
 // input string. ispec specifies the parameter to return:
 //  1: The optimal block size for a blocked algorithm.
@@ -13,7 +15,8 @@ package gonum
 //  4: The number of shifts.
 //  5: The minimum column dimension for blocking to be used.
 //  6: The crossover point for SVD (to use QR factorization or not).
-//  7: The number of processors.
+//  7: The number of processors. This is the number of workers returned by
+//     Workers in gonum.org/v1/gonum/blas/gonum.
 //  8: The crossover point for multi-shift in QR and QZ methods for non-symmetric eigenvalue problems.
 //  9: Maximum size of the subproblems in divide-and-conquer algorithms.
 //  10: ieee infinity and NaN arithmetic can be trusted not to trap.
@@ -50,6 +53,12 @@ func (impl Implementation) Ilaenv(ispec int, name string, opts string, n1, n2, n
 				}
 				return 64
 			case "QRF", "RQF", "LQF", "QLF":
+				if c3 == "QRF" && min(n1, n2) >= 1000 {
+					// The trailing matrix is updated concurrently by
+					// xGEQRF, which benefits from larger blocks when
+					// the matrix is large.
+					return 64
+				}
 				if sname {
 					return 32
 				}
@@ -366,8 +375,8 @@ func (impl Implementation) Ilaenv(ispec int, name string, opts string, n1, n2, n
 		// Used by xGELSS and xGESVD
 		return int(float64(min(n1, n2)) * 1.6)
 	case 7:
-		// Not used
-		return 1
+		// Used by xGEQRF
+		return gonumblas.Workers()
 	case 8:
 		// Used by xHSEQR
 		return 50
@@ -12,6 +12,8 @@ import "gonum.org/v1/gonum/lapack"
 type Implementation struct{}
 
 var _ lapack.Float64 = Implementation{}
+var _ lapack.Complex128 = Implementation{}
+var _ lapack.Float32 = Implementation{}
 
 func min(a, b int) int {
 	if a < b {
@@ -82,3 +84,8 @@ const (
 	dssml = 0x1p537
 	dsbig = 0x1p-538
 )
+
+// slamchS is the single precision "safe minimum", that is, the lowest number
+// such that 1/slamchS does not overflow, or also the smallest normal number.
+// For IEEE this is 2^{-126}.
+const slamchS = 0x1p-126
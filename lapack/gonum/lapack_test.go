@@ -12,6 +12,11 @@ import (
 
 var impl = Implementation{}
 
+func TestDbdsdc(t *testing.T) {
+	t.Parallel()
+	testlapack.DbdsdcTest(t, impl)
+}
+
 func TestDbdsqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DbdsqrTest(t, impl)
@@ -27,6 +32,26 @@ func TestDhseqr(t *testing.T) {
 	testlapack.DhseqrTest(t, impl)
 }
 
+func TestDgbcon(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbconTest(t, impl)
+}
+
+func TestDgbtf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dgbtf2Test(t, impl)
+}
+
+func TestDgbtrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrfTest(t, impl)
+}
+
+func TestDgbtrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrsTest(t, impl)
+}
+
 func TestDgebak(t *testing.T) {
 	t.Parallel()
 	testlapack.DgebakTest(t, impl)
@@ -52,6 +77,16 @@ func TestDgecon(t *testing.T) {
 	testlapack.DgeconTest(t, impl)
 }
 
+func TestDgees(t *testing.T) {
+	t.Parallel()
+	testlapack.DgeesTest(t, impl)
+}
+
+func TestDgeequ(t *testing.T) {
+	t.Parallel()
+	testlapack.DgeequTest(t, impl)
+}
+
 func TestDgeev(t *testing.T) {
 	t.Parallel()
 	testlapack.DgeevTest(t, impl)
@@ -122,12 +157,23 @@ func TestDgesv(t *testing.T) {
 	testlapack.DgesvTest(t, impl)
 }
 
+func TestDgesdd(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	testlapack.DgesddTest(t, impl, tol)
+}
+
 func TestDgesvd(t *testing.T) {
 	t.Parallel()
 	const tol = 1e-13
 	testlapack.DgesvdTest(t, impl, tol)
 }
 
+func TestDgerfs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgerfsTest(t, impl)
+}
+
 func TestDgetc2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgetc2Test(t, impl)
@@ -148,11 +194,21 @@ func TestDgetrf(t *testing.T) {
 	testlapack.DgetrfTest(t, impl)
 }
 
+func TestDgetrf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dgetrf2Test(t, impl)
+}
+
 func TestDgetrs(t *testing.T) {
 	t.Parallel()
 	testlapack.DgetrsTest(t, impl)
 }
 
+func TestDggev(t *testing.T) {
+	t.Parallel()
+	testlapack.DggevTest(t, impl)
+}
+
 func TestDggsvd3(t *testing.T) {
 	t.Parallel()
 	testlapack.Dggsvd3Test(t, impl)
@@ -323,6 +379,11 @@ func TestDlaqr04(t *testing.T) {
 	testlapack.Dlaqr04Test(t, impl)
 }
 
+func TestDlaqge(t *testing.T) {
+	t.Parallel()
+	testlapack.DlaqgeTest(t, impl)
+}
+
 func TestDlaqp2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dlaqp2Test(t, impl)
@@ -333,6 +394,11 @@ func TestDlaqps(t *testing.T) {
 	testlapack.DlaqpsTest(t, impl)
 }
 
+func TestDlaqsy(t *testing.T) {
+	t.Parallel()
+	testlapack.DlaqsyTest(t, impl)
+}
+
 func TestDlaqr1(t *testing.T) {
 	t.Parallel()
 	testlapack.Dlaqr1Test(t, impl)
@@ -493,11 +559,21 @@ func TestDormqr(t *testing.T) {
 	testlapack.DormqrTest(t, impl)
 }
 
+func TestDormtr(t *testing.T) {
+	t.Parallel()
+	testlapack.DormtrTest(t, impl)
+}
+
 func TestDormr2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dormr2Test(t, impl)
 }
 
+func TestDormrz(t *testing.T) {
+	t.Parallel()
+	testlapack.DormrzTest(t, impl)
+}
+
 func TestDorm2r(t *testing.T) {
 	t.Parallel()
 	testlapack.Dorm2rTest(t, impl)
@@ -523,11 +599,21 @@ func TestDpbtrs(t *testing.T) {
 	testlapack.DpbtrsTest(t, impl)
 }
 
+func TestDpoequ(t *testing.T) {
+	t.Parallel()
+	testlapack.DpoequTest(t, impl)
+}
+
 func TestDpocon(t *testing.T) {
 	t.Parallel()
 	testlapack.DpoconTest(t, impl)
 }
 
+func TestDporfs(t *testing.T) {
+	t.Parallel()
+	testlapack.DporfsTest(t, impl)
+}
+
 func TestDpotf2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dpotf2Test(t, impl)
@@ -538,6 +624,11 @@ func TestDpotrf(t *testing.T) {
 	testlapack.DpotrfTest(t, impl)
 }
 
+func TestDpotrf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dpotrf2Test(t, impl)
+}
+
 func TestDpotri(t *testing.T) {
 	t.Parallel()
 	testlapack.DpotriTest(t, impl)
@@ -553,6 +644,31 @@ func TestDrscl(t *testing.T) {
 	testlapack.DrsclTest(t, impl)
 }
 
+func TestDsgesv(t *testing.T) {
+	t.Parallel()
+	testlapack.DsgesvTest(t, impl)
+}
+
+func TestDsposv(t *testing.T) {
+	t.Parallel()
+	testlapack.DsposvTest(t, impl)
+}
+
+func TestDstebz(t *testing.T) {
+	t.Parallel()
+	testlapack.DstebzTest(t, impl)
+}
+
+func TestDstedc(t *testing.T) {
+	t.Parallel()
+	testlapack.DstedcTest(t, impl)
+}
+
+func TestDstein(t *testing.T) {
+	t.Parallel()
+	testlapack.DsteinTest(t, impl)
+}
+
 func TestDsteqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DsteqrTest(t, impl)
@@ -563,11 +679,31 @@ func TestDsterf(t *testing.T) {
 	testlapack.DsterfTest(t, impl)
 }
 
+func TestDsycon(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyconTest(t, impl)
+}
+
 func TestDsyev(t *testing.T) {
 	t.Parallel()
 	testlapack.DsyevTest(t, impl)
 }
 
+func TestDsyevd(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevdTest(t, impl)
+}
+
+func TestDsyevr(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevrTest(t, impl)
+}
+
+func TestDsygv(t *testing.T) {
+	t.Parallel()
+	testlapack.DsygvTest(t, impl)
+}
+
 func TestDsytd2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dsytd2Test(t, impl)
@@ -578,6 +714,16 @@ func TestDsytrd(t *testing.T) {
 	testlapack.DsytrdTest(t, impl)
 }
 
+func TestDsytrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrfTest(t, impl)
+}
+
+func TestDsytrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrsTest(t, impl)
+}
+
 func TestDtgsja(t *testing.T) {
 	t.Parallel()
 	testlapack.DtgsjaTest(t, impl)
@@ -603,6 +749,11 @@ func TestDtrexc(t *testing.T) {
 	testlapack.DtrexcTest(t, impl)
 }
 
+func TestDtrsyl(t *testing.T) {
+	t.Parallel()
+	testlapack.DtrsylTest(t, impl)
+}
+
 func TestDtrti2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dtrti2Test(t, impl)
@@ -618,6 +769,11 @@ func TestDtrtrs(t *testing.T) {
 	testlapack.DtrtrsTest(t, impl)
 }
 
+func TestDtzrzf(t *testing.T) {
+	t.Parallel()
+	testlapack.DtzrzfTest(t, impl)
+}
+
 func TestIladlc(t *testing.T) {
 	t.Parallel()
 	testlapack.IladlcTest(t, impl)
@@ -627,3 +783,68 @@ func TestIladlr(t *testing.T) {
 	t.Parallel()
 	testlapack.IladlrTest(t, impl)
 }
+
+func TestSgetrf(t *testing.T) {
+	t.Parallel()
+	testlapack.SgetrfTest(t, impl)
+}
+
+func TestSgetrs(t *testing.T) {
+	t.Parallel()
+	testlapack.SgetrsTest(t, impl)
+}
+
+func TestSpotrf(t *testing.T) {
+	t.Parallel()
+	testlapack.SpotrfTest(t, impl)
+}
+
+func TestSpotrs(t *testing.T) {
+	t.Parallel()
+	testlapack.SpotrsTest(t, impl)
+}
+
+func TestZgeqrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgeqrfTest(t, impl)
+}
+
+func TestZgesvd(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgesvdTest(t, impl)
+}
+
+func TestZgetrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgetrfTest(t, impl)
+}
+
+func TestZgetrs(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgetrsTest(t, impl)
+}
+
+func TestZheev(t *testing.T) {
+	t.Parallel()
+	testlapack.ZheevTest(t, impl)
+}
+
+func TestZlarfg(t *testing.T) {
+	t.Parallel()
+	testlapack.ZlarfgTest(t, impl)
+}
+
+func TestZpotrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZpotrfTest(t, impl)
+}
+
+func TestZpotrs(t *testing.T) {
+	t.Parallel()
+	testlapack.ZpotrsTest(t, impl)
+}
+
+func TestZunmqr(t *testing.T) {
+	t.Parallel()
+	testlapack.ZunmqrTest(t, impl)
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"sync"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	gonumblas "gonum.org/v1/gonum/blas/gonum"
+)
+
+// parallelColumns splits n columns into at most nproc contiguous panels of at
+// least minWidth columns each and calls fn(bi, j, nj) for every panel, where j
+// is the index of the first column of the panel, nj is the number of columns
+// in it and bi is the BLAS implementation that fn must use. The panels are
+// processed concurrently and parallelColumns returns when all calls to fn
+// have returned. If the columns are not split, fn is called in the calling
+// goroutine with the BLAS implementation set in blas64.
+func parallelColumns(n, nproc, minWidth int, fn func(bi blas.Float64, j, nj int)) {
+	if n == 0 {
+		return
+	}
+	bi := blas64.Implementation()
+	np := min(nproc, n/max(1, minWidth))
+	if np <= 1 {
+		fn(bi, 0, n)
+		return
+	}
+	// The panels are already processed concurrently, so the Level 3
+	// routines of the native BLAS implementation are computed serially
+	// to avoid starting goroutines from each panel.
+	if _, ok := bi.(gonumblas.Implementation); ok {
+		bi = gonumblas.Serial()
+	}
+	var wg sync.WaitGroup
+	wg.Add(np)
+	for p := 0; p < np; p++ {
+		j0 := p * n / np
+		j1 := (p + 1) * n / np
+		go func() {
+			defer wg.Done()
+			fn(bi, j0, j1-j0)
+		}()
+	}
+	wg.Wait()
+}
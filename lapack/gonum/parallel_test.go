@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"sync"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	gonumblas "gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/lapack/testlapack"
+)
+
+func TestParallelColumns(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 5, 31, 32, 64, 100, 257} {
+		for _, nproc := range []int{1, 2, 3, 8} {
+			for _, minWidth := range []int{0, 1, 16, 32} {
+				var mu sync.Mutex
+				count := make([]int, n)
+				panels := 0
+				var impls []blas.Float64
+				parallelColumns(n, nproc, minWidth, func(bi blas.Float64, j, nj int) {
+					mu.Lock()
+					defer mu.Unlock()
+					panels++
+					impls = append(impls, bi)
+					if nj < minWidth && nj != n {
+						t.Errorf("n=%d,nproc=%d,minWidth=%d: unexpected panel width %d", n, nproc, minWidth, nj)
+					}
+					for i := j; i < j+nj; i++ {
+						count[i]++
+					}
+				})
+				if panels > nproc {
+					t.Errorf("n=%d,nproc=%d,minWidth=%d: too many panels, got %d", n, nproc, minWidth, panels)
+				}
+				// BLAS must be called serially from concurrent panels.
+				want := blas64.Implementation()
+				if panels > 1 {
+					want = gonumblas.Serial()
+				}
+				for _, bi := range impls {
+					if bi != want {
+						t.Errorf("n=%d,nproc=%d,minWidth=%d: unexpected BLAS implementation for %d panels", n, nproc, minWidth, panels)
+						break
+					}
+				}
+				for i, c := range count {
+					if c != 1 {
+						t.Errorf("n=%d,nproc=%d,minWidth=%d: column %d updated %d times", n, nproc, minWidth, i, c)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestDgeqrfParallel checks Dgeqrf when the trailing matrix is updated
+// concurrently, irrespective of the number of processors of the machine.
+func TestDgeqrfParallel(t *testing.T) {
+	defer gonumblas.SetWorkers(gonumblas.SetWorkers(4))
+	if nproc := impl.Ilaenv(7, "DGEQRF", " ", 100, 100, -1, -1); nproc != 4 {
+		t.Errorf("unexpected number of processors: got %d, want 4", nproc)
+	}
+	testlapack.DgeqrfTest(t, impl)
+}
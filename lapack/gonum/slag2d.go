@@ -0,0 +1,40 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// Slag2d converts the m×n single precision matrix SA to the double precision
+// matrix A.
+//
+// Slag2d is an internal routine. It is exported for testing purposes.
+func (Implementation) Slag2d(m, n int, sa []float32, ldsa int, a []float64, lda int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case ldsa < max(1, n):
+		panic(badLdSA)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	switch {
+	case len(sa) < (m-1)*ldsa+n:
+		panic(shortSA)
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	}
+
+	for i := 0; i < m; i++ {
+		for j, v := range sa[i*ldsa : i*ldsa+n] {
+			a[i*lda+j] = float64(v)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/internal/math32"
+)
+
+// Spotrf2 computes the Cholesky decomposition of the symmetric positive definite
+// matrix a. If ul == blas.Upper, then a is stored as an upper-triangular matrix,
+// and a = Uᵀ U is stored in place into a. If ul == blas.Lower, then a = L Lᵀ
+// is computed and stored in-place into a. If a is not positive definite, false
+// is returned.
+//
+// Spotrf2 is the recursive version of the algorithm. It divides the matrix
+// into four blocks
+//  [ A11 | A12 ]
+//  [ ----|---- ]
+//  [ A21 | A22 ]
+// where A11 is n1×n1 with n1 = n/2, factors A11 recursively, updates A22 and
+// factors it recursively. Most of the computation is performed by Level 3
+// BLAS routines.
+//
+// Spotrf2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Spotrf2(ul blas.Uplo, n int, a []float32, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(shortA)
+	}
+
+	if n == 1 {
+		// Test for non-positive-definiteness.
+		if a[0] <= 0 || math32.IsNaN(a[0]) {
+			return false
+		}
+		a[0] = math32.Sqrt(a[0])
+		return true
+	}
+
+	n1 := n / 2
+	n2 := n - n1
+
+	// Factor A11.
+	if !impl.Spotrf2(ul, n1, a, lda) {
+		return false
+	}
+
+	bi := blas32.Implementation()
+	if ul == blas.Upper {
+		// Compute A12 = U11⁻ᵀ * A12.
+		bi.Strsm(blas.Left, blas.Upper, blas.Trans, blas.NonUnit, n1, n2,
+			1, a, lda,
+			a[n1:], lda)
+		// Update A22 = A22 - A12ᵀ * A12.
+		bi.Ssyrk(blas.Upper, blas.Trans, n2, n1,
+			-1, a[n1:], lda,
+			1, a[n1*lda+n1:], lda)
+		// Factor A22.
+		return impl.Spotrf2(ul, n2, a[n1*lda+n1:], lda)
+	}
+	// Compute A21 = A21 * L11⁻ᵀ.
+	bi.Strsm(blas.Right, blas.Lower, blas.Trans, blas.NonUnit, n2, n1,
+		1, a, lda,
+		a[n1*lda:], lda)
+	// Update A22 = A22 - A21 * A21ᵀ.
+	bi.Ssyrk(blas.Lower, blas.NoTrans, n2, n1,
+		-1, a[n1*lda:], lda,
+		1, a[n1*lda+n1:], lda)
+	// Factor A22.
+	return impl.Spotrf2(ul, n2, a[n1*lda+n1:], lda)
+}
@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Zgebd2 reduces a complex m×n matrix A to real upper or lower bidiagonal
+// form B by a unitary transformation
+//  Qᴴ * A * P = B.
+// If m >= n, B is upper bidiagonal, otherwise B is lower bidiagonal.
+//
+// The matrices Q and P are represented as products of elementary reflectors
+//  Q = H_0 * H_1 * ... * H_{k-1},
+//  P = G_0 * G_1 * ... * G_{k-1},
+// where k = min(m,n) and each reflector has the form
+//  H_i = I - tauQ[i] * v * vᴴ,
+//  G_i = I - tauP[i] * u * uᴴ.
+//
+// If m >= n, v[0:i] = 0, v[i] = 1 and v[i+1:m] is stored in A[i+1:m,i], and
+// u[0:i+1] = 0, u[i+1] = 1 and the conjugate of u[i+2:n] is stored in
+// A[i,i+2:n].
+//
+// If m < n, v[0:i+1] = 0, v[i+1] = 1 and v[i+2:m] is stored in A[i+2:m,i],
+// and u[0:i] = 0, u[i] = 1 and the conjugate of u[i+1:n] is stored in
+// A[i,i+1:n].
+//
+// On return, the diagonal elements of B are stored in d and the off-diagonal
+// elements in e. d must have length at least min(m,n), e, tauQ and tauP must
+// have length at least min(m,n)-1, min(m,n) and min(m,n) respectively.
+//
+// work is temporary storage of length at least max(m,n).
+//
+// Zgebd2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zgebd2(m, n int, a []complex128, lda int, d, e []float64, tauQ, tauP, work []complex128) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	minmn := min(m, n)
+	if minmn == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(d) < minmn:
+		panic(shortD)
+	case len(e) < minmn-1:
+		panic(shortE)
+	case len(tauQ) < minmn:
+		panic(shortTauQ)
+	case len(tauP) < minmn:
+		panic(shortTauP)
+	case len(work) < max(m, n):
+		panic(shortWork)
+	}
+
+	if m >= n {
+		// Reduce to upper bidiagonal form.
+		for i := 0; i < n; i++ {
+			// Generate H_i to annihilate A[i+1:m,i].
+			var beta complex128
+			beta, tauQ[i] = impl.Zlarfg(m-i, a[i*lda+i], a[min(i+1, m-1)*lda+i:], lda)
+			d[i] = real(beta)
+			// Apply H_iᴴ to A[i:m,i+1:n] from the left.
+			if i < n-1 {
+				a[i*lda+i] = 1
+				impl.Zlarf(blas.Left, m-i, n-i-1, a[i*lda+i:], lda, cmplx.Conj(tauQ[i]), a[i*lda+i+1:], lda, work)
+			}
+			a[i*lda+i] = complex(d[i], 0)
+
+			if i == n-1 {
+				tauP[i] = 0
+				continue
+			}
+			// Generate G_i to annihilate A[i,i+2:n].
+			impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+			beta, tauP[i] = impl.Zlarfg(n-i-1, a[i*lda+i+1], a[i*lda+min(i+2, n-1):], 1)
+			e[i] = real(beta)
+			// Apply G_i to A[i+1:m,i+1:n] from the right.
+			a[i*lda+i+1] = 1
+			impl.Zlarf(blas.Right, m-i-1, n-i-1, a[i*lda+i+1:], 1, tauP[i], a[(i+1)*lda+i+1:], lda, work)
+			impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+			a[i*lda+i+1] = complex(e[i], 0)
+		}
+		return
+	}
+
+	// Reduce to lower bidiagonal form.
+	for i := 0; i < m; i++ {
+		// Generate G_i to annihilate A[i,i+1:n].
+		impl.Zlacgv(n-i, a[i*lda+i:], 1)
+		var beta complex128
+		beta, tauP[i] = impl.Zlarfg(n-i, a[i*lda+i], a[i*lda+min(i+1, n-1):], 1)
+		d[i] = real(beta)
+		// Apply G_i to A[i+1:m,i:n] from the right.
+		a[i*lda+i] = 1
+		impl.Zlarf(blas.Right, m-i-1, n-i, a[i*lda+i:], 1, tauP[i], a[min(i+1, m-1)*lda+i:], lda, work)
+		impl.Zlacgv(n-i, a[i*lda+i:], 1)
+		a[i*lda+i] = complex(d[i], 0)
+
+		if i == m-1 {
+			tauQ[i] = 0
+			continue
+		}
+		// Generate H_i to annihilate A[i+2:m,i].
+		beta, tauQ[i] = impl.Zlarfg(m-i-1, a[(i+1)*lda+i], a[min(i+2, m-1)*lda+i:], lda)
+		e[i] = real(beta)
+		// Apply H_iᴴ to A[i+1:m,i+1:n] from the left.
+		a[(i+1)*lda+i] = 1
+		impl.Zlarf(blas.Left, m-i-1, n-i-1, a[(i+1)*lda+i:], lda, cmplx.Conj(tauQ[i]), a[(i+1)*lda+i+1:], lda, work)
+		a[(i+1)*lda+i] = complex(e[i], 0)
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zgesvd computes the singular value decomposition of the complex m×n matrix A.
+//
+// The singular value decomposition is
+//  A = U * Sigma * Vᴴ
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m unitary matrix and V is an n×n unitary matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//  jobU == lapack.SVDAll       All m columns of U are returned in u
+//  jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//  jobU == lapack.SVDNone      The columns of U are not computed.
+// The behavior is the same for jobVT and the rows of Vᴴ. lapack.SVDOverwrite
+// is not supported and Zgesvd will panic if either job is lapack.SVDOverwrite.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Zgesvd
+// the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDNone, u is not used.
+//
+// vt contains the right singular vectors on exit, stored row-wise. If
+// jobVT == lapack.SVDAll, vt is of size n×n. If jobVT == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobVT == lapack.SVDNone, vt is not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least 2*min(m,n)+2*max(m,n). If lwork == -1,
+// instead of performing Zgesvd, the optimal work length will be stored into
+// work[0]. Zgesvd will panic if the working memory has insufficient storage.
+//
+// rwork is real temporary storage of length at least 5*min(m,n) plus
+// min(m,n)*min(m,n) for each of U and Vᴴ that is computed.
+//
+// Zgesvd returns whether the decomposition successfully completed.
+func (impl Implementation) Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool) {
+	if jobU == lapack.SVDOverwrite || jobVT == lapack.SVDOverwrite {
+		panic(noSVDO)
+	}
+
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuas := wantua || wantus
+	if !wantuas && jobU != lapack.SVDNone {
+		panic(badSVDJob)
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	if !wantvas && jobVT != lapack.SVDNone {
+		panic(badSVDJob)
+	}
+
+	minmn := min(m, n)
+	maxmn := max(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = 2*minmn + 2*maxmn
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(badLdU)
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		work[0] = complex(float64(minwork), 0)
+		return true
+	}
+
+	// Number of columns of U and rows of Vᴴ to compute.
+	var ncu, nrvt int
+	switch {
+	case wantua:
+		ncu = m
+	case wantus:
+		ncu = minmn
+	}
+	switch {
+	case wantva:
+		nrvt = n
+	case wantvs:
+		nrvt = minmn
+	}
+
+	lrwork := 5 * minmn
+	if wantuas {
+		lrwork += minmn * minmn
+	}
+	if wantvas {
+		lrwork += minmn * minmn
+	}
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case wantuas && len(u) < (m-1)*ldu+ncu:
+		panic(shortU)
+	case wantvas && len(vt) < (nrvt-1)*ldvt+n:
+		panic(shortVT)
+	case len(rwork) < lrwork:
+		panic(shortRWork)
+	}
+
+	eps := dlamchP
+	smlnum := math.Sqrt(dlamchS) / eps
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum, bignum].
+	var anrm float64
+	for i := 0; i < m; i++ {
+		for _, v := range a[i*lda : i*lda+n] {
+			anrm = math.Max(anrm, cmplx.Abs(v))
+		}
+	}
+	var scl float64
+	if anrm > 0 && anrm < smlnum {
+		scl = smlnum
+	} else if anrm > bignum {
+		scl = bignum
+	}
+	if scl != 0 {
+		f := complex(scl/anrm, 0)
+		for i := 0; i < m; i++ {
+			row := a[i*lda : i*lda+n]
+			for j := range row {
+				row[j] *= f
+			}
+		}
+	}
+
+	// Reduce A to real bidiagonal form.
+	itauq := 0
+	itaup := itauq + minmn
+	iv := itaup + minmn
+	iwork := iv + maxmn
+	impl.Zgebd2(m, n, a, lda, s, rwork, work[itauq:], work[itaup:], work[iwork:])
+
+	// Compute the singular value decomposition of the bidiagonal matrix
+	// using real arithmetic, starting with identity matrices for its
+	// singular vectors.
+	ie := 0
+	irwork := ie + minmn
+	var ub, vtb []float64
+	ldub, ldvtb := 1, 1
+	var nru, ncvt int
+	if wantuas {
+		nru = minmn
+		ldub = minmn
+		ub = rwork[irwork : irwork+minmn*minmn]
+		irwork += minmn * minmn
+		impl.Dlaset(blas.All, minmn, minmn, 0, 1, ub, ldub)
+	}
+	if wantvas {
+		ncvt = minmn
+		ldvtb = minmn
+		vtb = rwork[irwork : irwork+minmn*minmn]
+		irwork += minmn * minmn
+		impl.Dlaset(blas.All, minmn, minmn, 0, 1, vtb, ldvtb)
+	}
+	uplo := blas.Upper
+	if m < n {
+		uplo = blas.Lower
+	}
+	ok = impl.Dbdsqr(uplo, minmn, ncvt, nru, 0, s, rwork[ie:], vtb, ldvtb, ub, ldub, nil, 1, rwork[irwork:])
+
+	if wantuas {
+		// Form U = Q * [Ub 0; 0 I].
+		for i := 0; i < m; i++ {
+			row := u[i*ldu : i*ldu+ncu]
+			for j := range row {
+				row[j] = 0
+			}
+			if i < minmn {
+				for j, v := range ub[i*ldub : i*ldub+minmn] {
+					row[j] = complex(v, 0)
+				}
+			} else if i < ncu {
+				row[i] = 1
+			}
+		}
+		if m >= n {
+			for i := minmn - 1; i >= 0; i-- {
+				aii := a[i*lda+i]
+				a[i*lda+i] = 1
+				impl.Zlarf(blas.Left, m-i, ncu, a[i*lda+i:], lda, work[itauq+i], u[i*ldu:], ldu, work[iwork:])
+				a[i*lda+i] = aii
+			}
+		} else {
+			for i := m - 2; i >= 0; i-- {
+				aii := a[(i+1)*lda+i]
+				a[(i+1)*lda+i] = 1
+				impl.Zlarf(blas.Left, m-i-1, ncu, a[(i+1)*lda+i:], lda, work[itauq+i], u[(i+1)*ldu:], ldu, work[iwork:])
+				a[(i+1)*lda+i] = aii
+			}
+		}
+	}
+
+	if wantvas {
+		// Form Vᴴ = [VTb 0; 0 I] * Pᴴ.
+		for i := 0; i < nrvt; i++ {
+			row := vt[i*ldvt : i*ldvt+n]
+			for j := range row {
+				row[j] = 0
+			}
+			if i < minmn {
+				for j, v := range vtb[i*ldvtb : i*ldvtb+minmn] {
+					row[j] = complex(v, 0)
+				}
+			} else {
+				row[i] = 1
+			}
+		}
+		// The reflectors G_i are stored conjugated in the rows of A
+		// so they are copied into a separate vector before being applied.
+		v := work[iv : iv+maxmn]
+		if m >= n {
+			for i := n - 2; i >= 0; i-- {
+				nv := n - i - 1
+				v[0] = 1
+				for j := 1; j < nv; j++ {
+					v[j] = cmplx.Conj(a[i*lda+i+1+j])
+				}
+				impl.Zlarf(blas.Right, nrvt, nv, v, 1, cmplx.Conj(work[itaup+i]), vt[i+1:], ldvt, work[iwork:])
+			}
+		} else {
+			for i := m - 1; i >= 0; i-- {
+				nv := n - i
+				v[0] = 1
+				for j := 1; j < nv; j++ {
+					v[j] = cmplx.Conj(a[i*lda+i+j])
+				}
+				impl.Zlarf(blas.Right, nrvt, nv, v, 1, cmplx.Conj(work[itaup+i]), vt[i:], ldvt, work[iwork:])
+			}
+		}
+	}
+
+	// Undo scaling if necessary.
+	if scl != 0 {
+		blas64.Implementation().Dscal(minmn, anrm/scl, s, 1)
+	}
+	work[0] = complex(float64(minwork), 0)
+	return ok
+}
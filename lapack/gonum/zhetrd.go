@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Zhetrd reduces a Hermitian n×n matrix A to real symmetric tridiagonal form T
+// by a unitary similarity transformation
+//  Qᴴ * A * Q = T
+// See the documentation for Zhetd2 for a description of the parameters at
+// entry and exit.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// The length of work must be at least max(1, lwork) and lwork must be -1 or
+// at least 1, otherwise Zhetrd will panic. If lwork == -1, instead of
+// computing Zhetrd the optimal work length is stored into work[0].
+func (impl Implementation) Zhetrd(uplo blas.Uplo, n int, a []complex128, lda int, d, e []float64, tau, work []complex128, lwork int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		work[0] = 1
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(tau) < n-1:
+		panic(shortTau)
+	}
+
+	impl.Zhetd2(uplo, n, a, lda, d, e, tau)
+	work[0] = 1
+}
@@ -0,0 +1,37 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math/cmplx"
+
+// Zlacgv conjugates the n-vector x.
+//
+// Zlacgv is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlacgv(n int, x []complex128, incX int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX == 0:
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	if len(x) < 1+(n-1)*abs(incX) {
+		panic(shortX)
+	}
+
+	var ix int
+	if incX < 0 {
+		ix = (1 - n) * incX
+	}
+	for i := 0; i < n; i++ {
+		x[ix] = cmplx.Conj(x[ix])
+		ix += incX
+	}
+}
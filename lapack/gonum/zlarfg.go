@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlarfg generates a complex elementary reflector for a Householder matrix. It
+// creates a reflector of order n such that
+//  Hᴴ * (alpha) = (beta)
+//       (    x)   (   0)
+//  Hᴴ * H = I
+// where alpha and x are complex and beta is real. H is represented in the form
+//  H = I - tau * (1; v) * (1 vᴴ)
+// where tau is a complex scalar with 1 ≤ real(tau) ≤ 2 and |tau-1| ≤ 1.
+//
+// If the elements of x are all zero and alpha is real, then tau = 0 and H is
+// taken to be the identity matrix.
+//
+// On entry, x contains the vector x, on exit it contains v.
+//
+// Zlarfg is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlarfg(n int, alpha complex128, x []complex128, incX int) (beta, tau complex128) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX <= 0:
+		panic(badIncX)
+	}
+
+	if n == 0 {
+		return alpha, 0
+	}
+
+	if len(x) < 1+(n-2)*abs(incX) {
+		panic(shortX)
+	}
+
+	bi := cblas128.Implementation()
+
+	var xnorm float64
+	if n > 1 {
+		xnorm = bi.Dznrm2(n-1, x, incX)
+	}
+	alphr := real(alpha)
+	alphi := imag(alpha)
+	if xnorm == 0 && alphi == 0 {
+		return alpha, 0
+	}
+	b := -math.Copysign(math.Hypot(math.Hypot(alphr, alphi), xnorm), alphr)
+	safmin := dlamchS / dlamchE
+	rsafmn := 1 / safmin
+	knt := 0
+	if math.Abs(b) < safmin {
+		// xnorm and beta may be inaccurate, scale x and recompute.
+		for {
+			knt++
+			if n > 1 {
+				bi.Zdscal(n-1, rsafmn, x, incX)
+			}
+			b *= rsafmn
+			alphi *= rsafmn
+			alphr *= rsafmn
+			if math.Abs(b) >= safmin || knt >= 20 {
+				break
+			}
+		}
+		if n > 1 {
+			xnorm = bi.Dznrm2(n-1, x, incX)
+		}
+		alpha = complex(alphr, alphi)
+		b = -math.Copysign(math.Hypot(math.Hypot(alphr, alphi), xnorm), alphr)
+	}
+	tau = complex((b-alphr)/b, -alphi/b)
+	if n > 1 {
+		bi.Zscal(n-1, 1/(alpha-complex(b, 0)), x, incX)
+	}
+	for j := 0; j < knt; j++ {
+		b *= safmin
+	}
+	return complex(b, 0), tau
+}
@@ -128,6 +128,66 @@ func Pbtrs(t blas64.TriangularBand, b blas64.General) {
 	lapack64.Dpbtrs(t.Uplo, t.N, t.K, b.Cols, t.Data, max(1, t.Stride), b.Data, max(1, b.Stride))
 }
 
+// Gbcon returns an estimate of the reciprocal of the condition number of the
+// n×n band matrix A, in either the 1-norm or the ∞-norm, using the LU
+// factorization computed by Gbtrf.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// returned by Gbtrf. anorm is the corresponding 1-norm or ∞-norm of the
+// original matrix A.
+//
+// The length of work must be at least 3*n and the length of iwork must be at
+// least n.
+//
+// Dgbcon is not part of the lapack.Float64 interface and so calls to Gbcon are
+// always executed by the Gonum implementation.
+func Gbcon(norm lapack.MatrixNorm, a blas64.Band, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	return gonum.Implementation{}.Dgbcon(norm, a.Cols, a.KL, a.KU-a.KL, a.Data, max(1, a.Stride), ipiv, anorm, work, iwork)
+}
+
+// Gbtrf computes the LU factorization of the m×n band matrix A
+//  A = P * L * U
+// using partial pivoting with row interchanges. L is a unit lower triangular
+// matrix with a.KL sub-diagonals and U is an upper triangular matrix with
+// a.KU super-diagonals.
+//
+// On entry, a contains the matrix A in its a.KL sub-diagonals, its diagonal
+// and its first a.KU-a.KL super-diagonals, so a.KU must be at least a.KL. The
+// remaining a.KL super-diagonals need not be set; they are used to store the
+// elements of U that are created by fill-in. On return, a contains the
+// factors L and U.
+//
+// ipiv must have length min(m,n) and Gbtrf will panic otherwise. On return it
+// contains the zero-indexed permutation indices. Gbtrf returns whether A is
+// nonsingular.
+//
+// Dgbtrf is not part of the lapack.Float64 interface and so calls to Gbtrf are
+// always executed by the Gonum implementation.
+func Gbtrf(a blas64.Band, ipiv []int) (ok bool) {
+	return gonum.Implementation{}.Dgbtrf(a.Rows, a.Cols, a.KL, a.KU-a.KL, a.Data, max(1, a.Stride), ipiv)
+}
+
+// Gbtrs solves a system of linear equations
+//  A * X = B   if trans == blas.NoTrans
+//  Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+// with an n×n band matrix A using the LU factorization computed by Gbtrf.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// returned by Gbtrf. On entry, b contains the right hand side matrix B. On
+// return, it is overwritten with the solution matrix X.
+//
+// Dgbtrs is not part of the lapack.Float64 interface and so calls to Gbtrs are
+// always executed by the Gonum implementation.
+func Gbtrs(trans blas.Transpose, a blas64.Band, b blas64.General, ipiv []int) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	gonum.Implementation{}.Dgbtrs(trans, a.Cols, a.KL, a.KU-a.KL, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Gecon estimates the reciprocal of the condition number of the n×n matrix A
 // given the LU decomposition of the matrix. The condition number computed may
 // be based on the 1-norm or the ∞-norm.
@@ -176,6 +236,28 @@ func Gels(trans blas.Transpose, a blas64.General, b blas64.General, work []float
 	return lapack64.Dgels(trans, a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), work, lwork)
 }
 
+// Geqp3 computes a QR factorization with column pivoting of the m×n matrix A:
+//  A*P = Q*R
+// using Level 3 BLAS. On return, the upper triangle of a contains the
+// min(m,n)×n upper trapezoidal matrix R, whose diagonal elements are
+// non-increasing in magnitude, and the elements below the diagonal, with tau,
+// represent the orthogonal matrix Q as a product of min(m,n) elementary
+// reflectors, as described for Geqrf.
+//
+// jpvt specifies a column pivot to be applied to A. If jpvt[j] is at least
+// zero, the jth column of A is permuted to the front of A*P (a leading
+// column), if jpvt[j] is -1 the jth column of A is a free column. On return,
+// jpvt holds the permutation that was applied; the jth column of A*P was the
+// jpvt[j] column of A. jpvt must have length n and tau must have length
+// min(m,n), otherwise Geqp3 will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// 3*n+1, otherwise Geqp3 will panic. If lwork == -1, instead of performing
+// Geqp3, the optimal work length will be stored into work[0].
+func Geqp3(a blas64.General, jpvt []int, tau, work []float64, lwork int) {
+	lapack64.Dgeqp3(a.Rows, a.Cols, a.Data, max(1, a.Stride), jpvt, tau, work, lwork)
+}
+
 // Geqrf computes the QR factorization of the m×n matrix A using a blocked
 // algorithm. A is modified to contain the information to construct Q and R.
 // The upper triangle of a contains the matrix R. The lower triangular elements
@@ -222,6 +304,53 @@ func Gelqf(a blas64.General, tau, work []float64, lwork int) {
 	lapack64.Dgelqf(a.Rows, a.Cols, a.Data, max(1, a.Stride), tau, work, lwork)
 }
 
+// Gesdd computes the singular value decomposition of the input matrix A using
+// a divide and conquer method.
+//
+// The singular value decomposition is
+//  A = U * Sigma * Vᵀ
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobz is the option for computing the singular vectors. The behavior is as
+// follows
+//  jobz == lapack.SVDAll    All m columns of U and all n rows of Vᵀ are returned in u and vt
+//  jobz == lapack.SVDStore  The first min(m,n) columns of U and rows of Vᵀ are returned in u and vt
+//  jobz == lapack.SVDNone   The columns of U and the rows of Vᵀ are not computed.
+// Gesdd will panic if jobz == lapack.SVDOverwrite.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Gesdd
+// the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored columnwise. If
+// jobz == lapack.SVDAll, u is of size m×m. If jobz == lapack.SVDStore u is
+// of size m×min(m,n). If jobz == lapack.SVDNone, u is not used.
+//
+// vt contains the right singular vectors on exit, stored rowwise. If
+// jobz == lapack.SVDAll, vt is of size n×n. If jobz == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobz == lapack.SVDNone, vt is not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. With mn = min(m,n) and mx = max(m,n), lwork must be at least
+//  3*mn + max(mx, 7*mn)  if jobz == lapack.SVDNone,
+//  4*mn*mn + 7*mn        if jobz == lapack.SVDStore,
+//  4*mn*mn + 6*mn + mx   if jobz == lapack.SVDAll.
+// If lwork == -1, instead of performing Gesdd, the optimal work length will be
+// stored into work[0]. Gesdd will panic if the working memory has insufficient
+// storage.
+//
+// iwork must have length at least 8*min(m,n).
+//
+// Gesdd returns whether the decomposition successfully completed.
+func Gesdd(jobz lapack.SVDJob, a, u, vt blas64.General, s, work []float64, lwork int, iwork []int) (ok bool) {
+	return lapack64.Dgesdd(jobz, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork, iwork)
+}
+
 // Gesvd computes the singular value decomposition of the input matrix A.
 //
 // The singular value decomposition is
@@ -268,6 +397,54 @@ func Gesvd(jobU, jobVT lapack.SVDJob, a, u, vt blas64.General, s, work []float64
 	return lapack64.Dgesvd(jobU, jobVT, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork)
 }
 
+// Geequ computes row and column scalings intended to equilibrate an m×n
+// matrix A and reduce its condition number. The scale factors are returned in
+// r and c so that the elements of the scaled matrix
+//  B[i,j] = r[i] * A[i,j] * c[j]
+// have an absolute value of at most 1, and the largest element in each row
+// and column of B has an absolute value of 1.
+//
+// r must have length m and c must have length n, otherwise Geequ will panic.
+//
+// rowcnd and colcnd are the ratios of the smallest to the largest scale factor
+// in r and c, respectively, and amax is the absolute value of the largest
+// element of A. Geequ returns whether all rows and columns of A are non-zero.
+//
+// Dgeequ is not part of the lapack.Float64 interface and so calls to Geequ are
+// always executed by the Gonum implementation.
+func Geequ(a blas64.General, r, c []float64) (rowcnd, colcnd, amax float64, ok bool) {
+	return gonum.Implementation{}.Dgeequ(a.Rows, a.Cols, a.Data, max(1, a.Stride), r, c)
+}
+
+// Gerfs improves the computed solution to a system of linear equations
+//  A * X = B   if trans == blas.NoTrans
+//  Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+// with an n×n general matrix A and provides error bounds and backward error
+// estimates for the solution.
+//
+// a contains the original matrix A. af and ipiv contain the LU factorization
+// of A and the permutation indices as computed by Getrf.
+//
+// b contains the right hand side matrix B. On entry, x contains the solution
+// matrix X as computed by Getrs. On return, it is overwritten with the
+// improved solution.
+//
+// ferr and berr must have length at least b.Cols. On return, ferr contains the
+// estimated forward error bound and berr the componentwise relative backward
+// error for each column of X.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Gerfs will panic.
+//
+// Dgerfs is not part of the lapack.Float64 interface and so calls to Gerfs are
+// always executed by the Gonum implementation.
+func Gerfs(trans blas.Transpose, a, af blas64.General, ipiv []int, b, x blas64.General, ferr, berr, work []float64, iwork []int) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	gonum.Implementation{}.Dgerfs(trans, a.Cols, b.Cols, a.Data, max(1, a.Stride), af.Data, max(1, af.Stride), ipiv, b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), ferr, berr, work, iwork)
+}
+
 // Getrf computes the LU decomposition of the m×n matrix A.
 // The LU decomposition is a factorization of A into
 //  A = P * L * U
@@ -420,6 +597,54 @@ func Ggsvd3(jobU, jobV, jobQ lapack.GSVDJob, a, b blas64.General, alpha, beta []
 	return lapack64.Dggsvd3(jobU, jobV, jobQ, a.Rows, a.Cols, b.Rows, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alpha, beta, u.Data, max(1, u.Stride), v.Data, max(1, v.Stride), q.Data, max(1, q.Stride), work, lwork, iwork)
 }
 
+// Ggev computes the generalized eigenvalues and, optionally, the left and/or
+// right generalized eigenvectors of a pair of n×n real nonsymmetric matrices
+// (A,B).
+//
+// The right eigenvector v_j and the left eigenvector u_j corresponding to the
+// eigenvalue λ_j = α_j/β_j of (A,B) satisfy
+//  A * v_j = λ_j * B * v_j,
+//  u_jᴴ * A = λ_j * u_jᴴ * B.
+//
+// On return, A and B will be overwritten and the left and right eigenvectors
+// will be stored, respectively, in the columns of the n×n matrices VL and VR
+// in the same order as their eigenvalues, in the format described for Geev.
+// Each eigenvector is scaled so the largest component has
+// |real part| + |imag. part| == 1.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Ggev will panic.
+//
+// On return, the generalized eigenvalues are
+//  (alphar[j] + i*alphai[j]) / beta[j],  j = 0, ..., n-1.
+// Complex conjugate pairs of eigenvalues appear consecutively with the
+// eigenvalue having the positive imaginary part first. beta[j] may be zero,
+// indicating an infinite eigenvalue. alphar, alphai and beta must have length
+// n, and Ggev will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// If lwork == -1, instead of performing Ggev, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// Ggev returns whether all the eigenvalues and requested eigenvectors have
+// been computed.
+func Ggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a, b blas64.General, alphar, alphai, beta []float64, vl, vr blas64.General, work []float64, lwork int) (ok bool) {
+	n := a.Rows
+	if a.Cols != n || b.Rows != n || b.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack64: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack64: bad size of VR")
+	}
+	return lapack64.Dggev(jobvl, jobvr, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alphar, alphai, beta, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
+}
+
 // Gtsv solves one of the equations
 //  A * X = B   if trans == blas.NoTrans
 //  Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
@@ -454,6 +679,29 @@ func Lagtm(trans blas.Transpose, alpha float64, a Tridiagonal, b blas64.General,
 	gonum.Implementation{}.Dlagtm(trans, c.Rows, c.Cols, alpha, a.DL, a.D, a.DU, b.Data, max(1, b.Stride), beta, c.Data, max(1, c.Stride))
 }
 
+// Laqge equilibrates an m×n general matrix A using the row and column scaling
+// factors in r and c, as computed by Geequ. rowcnd, colcnd and amax are the
+// corresponding values returned by Geequ. On return, a is overwritten by the
+// equilibrated matrix and equed reports the form of equilibration that was
+// applied.
+//
+// Dlaqge is not part of the lapack.Float64 interface and so calls to Laqge are
+// always executed by the Gonum implementation.
+func Laqge(a blas64.General, r, c []float64, rowcnd, colcnd, amax float64) (equed lapack.EquilibrationType) {
+	return gonum.Implementation{}.Dlaqge(a.Rows, a.Cols, a.Data, max(1, a.Stride), r, c, rowcnd, colcnd, amax)
+}
+
+// Laqsy equilibrates an n×n symmetric matrix A using the scaling factors in
+// s, as computed by Poequ. scond and amax are the corresponding values
+// returned by Poequ. On return, a is overwritten by the equilibrated matrix
+// and equed reports whether equilibration was applied.
+//
+// Dlaqsy is not part of the lapack.Float64 interface and so calls to Laqsy are
+// always executed by the Gonum implementation.
+func Laqsy(a blas64.Symmetric, s []float64, scond, amax float64) (equed lapack.EquilibrationType) {
+	return gonum.Implementation{}.Dlaqsy(a.Uplo, a.N, a.Data, max(1, a.Stride), s, scond, amax)
+}
+
 // Lange computes the matrix norm of the general m×n matrix A. The input norm
 // specifies the norm computed.
 //  lapack.MaxAbs: the maximum absolute value of an element.
@@ -586,6 +834,29 @@ func Ormqr(side blas.Side, trans blas.Transpose, a blas64.General, tau []float64
 	lapack64.Dormqr(side, trans, c.Rows, c.Cols, a.Cols, a.Data, max(1, a.Stride), tau, c.Data, max(1, c.Stride), work, lwork)
 }
 
+// Ormrz multiplies an m×n matrix C by the orthogonal matrix Z from a RZ
+// factorization as
+//  C = Z * C   if side == blas.Left  and trans == blas.NoTrans,
+//  C = Zᵀ * C  if side == blas.Left  and trans == blas.Trans,
+//  C = C * Z   if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Zᵀ  if side == blas.Right and trans == blas.Trans,
+// where Z is defined as the product of k elementary reflectors
+//  Z = H_0 * H_1 * ... * H_{k-1}.
+//
+// A is a k×m matrix if side == blas.Left and a k×n matrix if
+// side == blas.Right. The ith row of A contains in its last l columns the
+// vector which defines the elementary reflector H_i and tau[i] contains its
+// scalar factor. tau must have length k and Ormrz will panic otherwise. Tzrzf
+// returns A and tau in the required form.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Ormrz will
+// panic. If lwork is -1, instead of performing Ormrz, the optimal workspace
+// size will be stored into work[0].
+func Ormrz(side blas.Side, trans blas.Transpose, a blas64.General, l int, tau []float64, c blas64.General, work []float64, lwork int) {
+	lapack64.Dormrz(side, trans, c.Rows, c.Cols, a.Rows, l, a.Data, max(1, a.Stride), tau, c.Data, max(1, c.Stride), work, lwork)
+}
+
 // Pocon estimates the reciprocal of the condition number of a positive-definite
 // matrix A given the Cholesky decmposition of A. The condition number computed
 // is based on the 1-norm and the ∞-norm.
@@ -599,6 +870,122 @@ func Pocon(a blas64.Symmetric, anorm float64, work []float64, iwork []int) float
 	return lapack64.Dpocon(a.Uplo, a.N, a.Data, max(1, a.Stride), anorm, work, iwork)
 }
 
+// Poequ computes row and column scalings intended to equilibrate an n×n
+// symmetric positive definite matrix A and reduce its condition number with
+// respect to the 2-norm. The scale factors are returned in s so that the
+// scaled matrix
+//  B[i,j] = s[i] * A[i,j] * s[j]
+// has ones on the diagonal.
+//
+// s must have length n, otherwise Poequ will panic.
+//
+// scond is the ratio of the smallest to the largest scale factor and amax is
+// the absolute value of the largest element of A. Poequ returns whether all
+// diagonal elements of A are positive.
+//
+// Dpoequ is not part of the lapack.Float64 interface and so calls to Poequ are
+// always executed by the Gonum implementation.
+func Poequ(a blas64.Symmetric, s []float64) (scond, amax float64, ok bool) {
+	return gonum.Implementation{}.Dpoequ(a.N, a.Data, max(1, a.Stride), s)
+}
+
+// Porfs improves the computed solution to a system of linear equations
+//  A * X = B
+// with an n×n symmetric positive definite matrix A and provides error bounds
+// and backward error estimates for the solution.
+//
+// a contains the original matrix A. t contains the triangular factor of the
+// Cholesky factorization of A as computed by Potrf and must have the same
+// triangle as a.
+//
+// b contains the right hand side matrix B. On entry, x contains the solution
+// matrix X as computed by Potrs. On return, it is overwritten with the
+// improved solution.
+//
+// ferr and berr must have length at least b.Cols. On return, ferr contains the
+// estimated forward error bound and berr the componentwise relative backward
+// error for each column of X.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Porfs will panic.
+//
+// Dporfs is not part of the lapack.Float64 interface and so calls to Porfs are
+// always executed by the Gonum implementation.
+func Porfs(a blas64.Symmetric, t blas64.Triangular, b, x blas64.General, ferr, berr, work []float64, iwork []int) {
+	if a.Uplo != t.Uplo {
+		panic("lapack64: mismatched triangles")
+	}
+	gonum.Implementation{}.Dporfs(a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), t.Data, max(1, t.Stride), b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), ferr, berr, work, iwork)
+}
+
+// Sgesv computes the solution to a system of linear equations
+//  A * X = B
+// where A is an n×n matrix, using a single precision LU factorization of A
+// followed by iterative refinement in double precision. If the refinement
+// fails to converge, the solution is computed in double precision.
+//
+// On entry, a contains the matrix A. On return, if iter < 0, a contains the
+// factors L and U from the double precision factorization A = P*L*U, otherwise
+// it is unchanged. ipiv must have length n and on return contains the pivot
+// indices of the factorization that was used to compute the solution.
+//
+// b contains the right hand side matrix B and x contains the solution matrix X
+// on return.
+//
+// work must have length at least n*nrhs and swork must have length at least
+// n*(n+nrhs), otherwise Sgesv will panic.
+//
+// See the documentation for Dsgesv for the meaning of iter. Sgesv returns
+// whether A is nonsingular.
+//
+// Dsgesv is not part of the lapack.Float64 interface and so calls to Sgesv are
+// always executed by the Gonum implementation.
+func Sgesv(a blas64.General, ipiv []int, b, x blas64.General, work []float64, swork []float32) (iter int, ok bool) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	return gonum.Implementation{}.Dsgesv(a.Cols, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), work, swork)
+}
+
+// Sposv computes the solution to a system of linear equations
+//  A * X = B
+// where A is an n×n symmetric positive definite matrix, using a single
+// precision Cholesky factorization of A followed by iterative refinement in
+// double precision. If the refinement fails to converge, the solution is
+// computed in double precision.
+//
+// On entry, a contains the matrix A. On return, if iter < 0, a contains the
+// factor U or L from the double precision Cholesky factorization, otherwise it
+// is unchanged.
+//
+// b contains the right hand side matrix B and x contains the solution matrix X
+// on return.
+//
+// work must have length at least n*nrhs and swork must have length at least
+// n*(n+nrhs), otherwise Sposv will panic.
+//
+// See the documentation for Dsposv for the meaning of iter. Sposv returns
+// whether A is positive definite.
+//
+// Dsposv is not part of the lapack.Float64 interface and so calls to Sposv are
+// always executed by the Gonum implementation.
+func Sposv(a blas64.Symmetric, b, x blas64.General, work []float64, swork []float32) (iter int, ok bool) {
+	return gonum.Implementation{}.Dsposv(a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), work, swork)
+}
+
+// Sycon estimates the reciprocal of the condition number of a symmetric matrix
+// A given the Bunch-Kaufman factorization of A computed by Sytrf. The condition
+// number computed is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Sycon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Sycon will panic otherwise.
+func Sycon(a blas64.Symmetric, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return lapack64.Dsycon(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, anorm, work, iwork)
+}
+
 // Syev computes all eigenvalues and, optionally, the eigenvectors of a real
 // symmetric matrix A.
 //
@@ -618,6 +1005,110 @@ func Syev(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int) (
 	return lapack64.Dsyev(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork)
 }
 
+// Sygv computes all the eigenvalues, and optionally, the eigenvectors of a
+// real generalized symmetric-definite eigenproblem of the form
+//  A*x = λ*B*x  if itype == lapack.GenEVAxBx,
+//  A*B*x = λ*x  if itype == lapack.GenEVABx,
+//  B*A*x = λ*x  if itype == lapack.GenEVBAx,
+// where A and B are n×n symmetric matrices and B is also positive definite.
+//
+// If jobz == lapack.EVCompute, a contains the eigenvectors Z on exit,
+// normalized so that Zᵀ*B*Z = I for the first two problem types and
+// Zᵀ*inv(B)*Z = I for the third, otherwise jobz must be lapack.EVNone and on
+// exit the specified triangular region of a is overwritten. If ok is true, b
+// contains the Cholesky factor of B on exit. a and b must have the same uplo.
+//
+// w contains the eigenvalues in ascending order upon return. w must have
+// length at least n, and Sygv will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least
+// max(1,3*n-1). If lwork == -1, instead of performing Sygv, the function only
+// calculates the optimal value of lwork and stores it into work[0].
+//
+// Sygv returns whether B is positive definite and the eigenvalues have been
+// computed.
+func Sygv(itype lapack.GenEVType, jobz lapack.EVJob, a, b blas64.Symmetric, w, work []float64, lwork int) (ok bool) {
+	if a.N != b.N {
+		panic("lapack64: mismatched matrix sizes")
+	}
+	if a.Uplo != b.Uplo {
+		panic("lapack64: mismatched triangle")
+	}
+	return lapack64.Dsygv(itype, jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), w, work, lwork)
+}
+
+// Syevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A using a divide and conquer algorithm if eigenvectors are
+// desired.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Syevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// iwork is temporary integer storage, and liwork specifies its usable length.
+// If lwork == -1 or liwork == -1, instead of computing Syevd the optimal work
+// length is stored into work[0] and the optimal integer work length is stored
+// into iwork[0].
+func Syevd(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	return lapack64.Dsyevd(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork, iwork, liwork)
+}
+
+// Syevr computes selected eigenvalues and, optionally, eigenvectors of a real
+// symmetric matrix A. If rng == lapack.EVRangeAll, all eigenvalues are
+// computed. If rng == lapack.EVRangeValue, the eigenvalues in the half-open
+// interval (vl,vu] are computed. If rng == lapack.EVRangeIndex, the il-th
+// through iu-th eigenvalues, counted from zero in ascending order, are
+// computed.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On return, the specified triangular region is
+// overwritten.
+//
+// On return, m is the number of eigenvalues found, and the first m elements of
+// w contain the selected eigenvalues in ascending order. If
+// jobz == lapack.EVCompute, the first m columns of z contain the corresponding
+// orthonormal eigenvectors.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// iwork is temporary integer storage, and liwork specifies its usable length.
+// If lwork == -1 or liwork == -1, instead of computing Syevr the optimal work
+// length is stored into work[0] and the optimal integer work length is stored
+// into iwork[0].
+func Syevr(jobz lapack.EVJob, rng lapack.EVRange, a blas64.Symmetric, vl, vu float64, il, iu int, abstol float64, w []float64, z blas64.General, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	return lapack64.Dsyevr(jobz, rng, a.Uplo, a.N, a.Data, max(1, a.Stride), vl, vu, il, iu, abstol, w, z.Data, max(1, z.Stride), work, lwork, iwork, liwork)
+}
+
+// Sytrf computes the Bunch-Kaufman factorization of a symmetric matrix A
+//  A = U * D * Uᵀ  if a.Uplo == blas.Upper, or
+//  A = L * D * Lᵀ  if a.Uplo == blas.Lower,
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks. On return, a contains D and the multipliers used to obtain U or L,
+// and ipiv contains the details of the interchanges and the block structure
+// of D. ipiv must have length n.
+//
+// Work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= 1, and Sytrf will panic otherwise. If lwork == -1, instead
+// of computing Sytrf the optimal work length is stored into work[0].
+//
+// Sytrf returns whether D is nonsingular.
+func Sytrf(a blas64.Symmetric, ipiv []int, work []float64, lwork int) (ok bool) {
+	return lapack64.Dsytrf(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, work, lwork)
+}
+
+// Sytrs solves a system of n linear equations A*X = B where A is an n×n
+// symmetric matrix and B is an n×nrhs matrix, using the Bunch-Kaufman
+// factorization of A computed by Sytrf. On entry, B contains the right-hand
+// side matrix B, on return it contains the solution matrix X.
+func Sytrs(a blas64.Symmetric, ipiv []int, b blas64.General) {
+	lapack64.Dsytrs(a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Tbtrs solves a triangular system of the form
 //  A * X = B   if trans == blas.NoTrans
 //  Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
@@ -639,6 +1130,48 @@ func Trcon(norm lapack.MatrixNorm, a blas64.Triangular, work []float64, iwork []
 	return lapack64.Dtrcon(norm, a.Uplo, a.Diag, a.N, a.Data, max(1, a.Stride), work, iwork)
 }
 
+// Trexc reorders the real Schur factorization of an n×n real matrix
+//  A = Q*T*Qᵀ
+// so that the diagonal block of T with row index ifst is moved to row ilst.
+//
+// On entry, T must be in Schur canonical form, that is, block upper triangular
+// with 1×1 and 2×2 diagonal blocks; each 2×2 diagonal block has its diagonal
+// elements equal and its off-diagonal elements of opposite sign. On return, T
+// will be reordered by an orthogonal similarity transformation and will be
+// again in Schur canonical form.
+//
+// If compq is lapack.UpdateSchur, on return the matrix Q of Schur vectors will
+// be updated by post-multiplying it with the transformation. If compq is
+// lapack.UpdateSchurNone, the matrix Q is not referenced.
+//
+// If ifst points to the second row of a 2×2 block, ifstOut will point to the
+// first row, otherwise it will be equal to ifst. ilstOut will point to the
+// first row of the block in its final position.
+//
+// If ok is false, two adjacent blocks were too close to swap and T may have
+// been partially reordered.
+//
+// work must have length at least n, otherwise Trexc will panic.
+func Trexc(compq lapack.UpdateSchurComp, t, q blas64.General, ifst, ilst int, work []float64) (ifstOut, ilstOut int, ok bool) {
+	return lapack64.Dtrexc(compq, t.Rows, t.Data, max(1, t.Stride), q.Data, max(1, q.Stride), ifst, ilst, work)
+}
+
+// Trsyl solves the real Sylvester matrix equation
+//  op(A)*X + isgn*X*op(B) = scale*C
+// where op(A) = A or Aᵀ as specified by trana, op(B) = B or Bᵀ as specified by
+// tranb, A is an m×m and B is an n×n upper quasi-triangular matrix in Schur
+// canonical form, and C and X are m×n matrices. isgn must be 1 or -1. On
+// return, c contains the solution X.
+//
+// scale is a scale factor less than or equal to 1 that is chosen to avoid
+// overflow in X.
+//
+// If ok is false, A and -isgn*B have common or very close eigenvalues and
+// perturbed values were used to solve the equation.
+func Trsyl(trana, tranb blas.Transpose, isgn int, a, b, c blas64.General) (scale float64, ok bool) {
+	return lapack64.Dtrsyl(trana, tranb, isgn, a.Rows, b.Rows, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), c.Data, max(1, c.Stride))
+}
+
 // Trtri computes the inverse of a triangular matrix, storing the result in place
 // into a.
 //
@@ -654,6 +1187,22 @@ func Trtrs(trans blas.Transpose, a blas64.Triangular, b blas64.General) (ok bool
 	return lapack64.Dtrtrs(a.Uplo, trans, a.Diag, a.N, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride))
 }
 
+// Tzrzf reduces the m×n (m <= n) upper trapezoidal matrix A to upper
+// triangular form by means of orthogonal transformations,
+//  A = [ R 0 ] * Z,
+// where Z is an n×n orthogonal matrix and R is an m×m upper triangular matrix.
+//
+// On return, the upper triangle of A[0:m,0:m] contains R, and the elements in
+// A[0:m,m:n], with tau, represent Z as a product of m elementary reflectors.
+// tau must have length m and Tzrzf will panic otherwise.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,m), otherwise Tzrzf will panic. If lwork is -1, instead of performing
+// Tzrzf, the optimal workspace size will be stored into work[0].
+func Tzrzf(a blas64.General, tau, work []float64, lwork int) {
+	lapack64.Dtzrzf(a.Rows, a.Cols, a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
 // Geev computes the eigenvalues and, optionally, the left and/or right
 // eigenvectors for an n×n real nonsymmetric matrix A.
 //
@@ -715,3 +1264,34 @@ func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a blas64.General, wr,
 	}
 	return lapack64.Dgeev(jobvl, jobvr, n, a.Data, max(1, a.Stride), wr, wi, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
 }
+
+// Gees computes the eigenvalues, the real Schur form T and, optionally, the
+// matrix of Schur vectors Z for an n×n real nonsymmetric matrix A. This gives
+// the Schur factorization
+//  A = Z * T * Zᵀ
+// where Z is orthogonal and T is upper quasi-triangular in Schur canonical form
+// with 1×1 and 2×2 diagonal blocks. The 2×2 blocks correspond to complex
+// conjugate pairs of eigenvalues.
+//
+// On return, a is overwritten by T. If jobvs == lapack.SchurOrig, the Schur
+// vectors are stored in vs. If jobvs == lapack.SchurNone, vs is not referenced.
+//
+// On return, wr and wi will contain the real and imaginary parts, respectively,
+// of the computed eigenvalues in the order in which they appear on the diagonal
+// of T. wr and wi must have length n, and Gees will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n).
+// If lwork == -1, instead of performing Gees, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// Gees returns whether all the eigenvalues have been computed.
+func Gees(jobvs lapack.SchurComp, a blas64.General, wr, wi []float64, vs blas64.General, work []float64, lwork int) (ok bool) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if jobvs == lapack.SchurOrig && (vs.Rows != n || vs.Cols != n) {
+		panic("lapack64: bad size of VS")
+	}
+	return lapack64.Dgees(jobvs, n, a.Data, max(1, a.Stride), wr, wi, vs.Data, max(1, vs.Stride), work, lwork)
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dbdsdcer interface {
+	Dbdsdc(uplo blas.Uplo, compq lapack.BDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool)
+
+	Dbdsqrer
+}
+
+func DbdsdcTest(t *testing.T, impl Dbdsdcer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25, 26, 27, 50, 51, 100, 137} {
+			for _, ld := range []int{0, 5} {
+				for typ := 0; typ < 4; typ++ {
+					dbdsdcTest(t, impl, rnd, uplo, n, n+ld, typ)
+				}
+			}
+		}
+	}
+}
+
+// dbdsdcTest tests Dbdsdc on an n×n bidiagonal matrix B generated according
+// to typ as:
+//  - a random bidiagonal matrix if typ == 0,
+//  - a random bidiagonal matrix with graded diagonal if typ == 1,
+//  - a random bidiagonal matrix with some zero off-diagonal elements if typ == 2,
+//  - a bidiagonal matrix with equal diagonal and off-diagonal elements if typ == 3.
+// It checks that the singular values are non-negative, sorted in decreasing
+// order and equal to those computed by Dbdsqr, that U and VT are orthogonal,
+// and that U*S*VT multiply back to B.
+func dbdsdcTest(t *testing.T, impl Dbdsdcer, rnd *rand.Rand, uplo blas.Uplo, n, ld int, typ int) {
+	const tol = 1e-13
+
+	d := make([]float64, n)
+	e := make([]float64, max(0, n-1))
+	for i := range d {
+		d[i] = rnd.NormFloat64()
+	}
+	for i := range e {
+		e[i] = rnd.NormFloat64()
+	}
+	switch typ {
+	case 1:
+		for i := range d {
+			d[i] *= math.Pow(2, -float64(i%20))
+		}
+	case 2:
+		for i := range e {
+			if rnd.Intn(8) == 0 {
+				e[i] = 0
+			}
+		}
+	case 3:
+		for i := range d {
+			d[i] = 1
+		}
+		for i := range e {
+			e[i] = 1
+		}
+	}
+	dCopy := make([]float64, len(d))
+	copy(dCopy, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+
+	name := fmt.Sprintf("uplo=%c,n=%d,ld=%d,typ=%d", uplo, n, ld, typ)
+
+	// Compute reference singular values with Dbdsqr.
+	sWant := make([]float64, n)
+	copy(sWant, d)
+	eWant := make([]float64, len(e))
+	copy(eWant, e)
+	ok := impl.Dbdsqr(uplo, n, 0, 0, 0, sWant, eWant, nil, 1, nil, 1, nil, 1, make([]float64, 4*n))
+	if !ok {
+		t.Fatalf("%v: Dbdsqr failed", name)
+	}
+
+	// Compute singular values only.
+	s := make([]float64, n)
+	copy(s, d)
+	ework := make([]float64, len(e))
+	copy(ework, e)
+	ok = impl.Dbdsdc(uplo, lapack.BDCompNone, n, s, ework, nil, 1, nil, 1, nanSlice(4*n), make([]int, 8*n))
+	if !ok {
+		t.Errorf("%v: Dbdsdc failed computing singular values", name)
+	}
+	if !floats.EqualApprox(s, sWant, tol) {
+		t.Errorf("%v: unexpected singular values with BDCompNone", name)
+	}
+
+	// Compute singular values and vectors.
+	ldu := max(1, ld)
+	ldvt := max(1, ld)
+	u := nanSlice(n * ldu)
+	vt := nanSlice(n * ldvt)
+	work := nanSlice(3*n*n + 4*n)
+	iwork := make([]int, 8*n)
+	ok = impl.Dbdsdc(uplo, lapack.BDCompute, n, d, e, u, ldu, vt, ldvt, work, iwork)
+	if !ok {
+		t.Errorf("%v: Dbdsdc failed computing singular vectors", name)
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(d))) {
+		t.Errorf("%v: singular values not sorted in decreasing order", name)
+	}
+	if floats.Min(d) < 0 {
+		t.Errorf("%v: some singular values are negative", name)
+	}
+	if !floats.EqualApprox(d, sWant, tol*math.Max(1, sWant[0])) {
+		t.Errorf("%v: unexpected singular values with BDCompute\ngot  %v\nwant %v", name, d, sWant)
+	}
+
+	uMat := blas64.General{Rows: n, Cols: n, Stride: ldu, Data: u}
+	if resid := residualOrthogonal(uMat, false); resid > tol*float64(n) {
+		t.Errorf("%v: U not orthogonal; resid=%v", name, resid)
+	}
+	vtMat := blas64.General{Rows: n, Cols: n, Stride: ldvt, Data: vt}
+	if resid := residualOrthogonal(vtMat, true); resid > tol*float64(n) {
+		t.Errorf("%v: VT not orthogonal; resid=%v", name, resid)
+	}
+
+	b := constructBidiagonal(uplo, n, dCopy, eCopy)
+	bNorm := dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride)
+	if resid := svdFullResidual(n, n, bNorm, b.Data, b.Stride, u, ldu, d, vt, ldvt); resid > tol {
+		t.Errorf("%v: B not recovered from U*S*VT; resid=%v", name, resid)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbconer interface {
+	Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+
+	Dgbtrser
+}
+
+// DgbconTest tests Dgbcon by generating a random general band matrix A and
+// checking that the estimated condition number is not too different from the
+// condition number computed via the explicit inverse of A.
+func DgbconTest(t *testing.T, impl Dgbconer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+		for _, kl := range []int{0, 1, 2, (n + 1) / 4, (3*n - 1) / 4} {
+			for _, ku := range []int{0, 1, 3, (n + 1) / 4, (5*n + 1) / 4} {
+				for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+					for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 1 + 3} {
+						dgbconTest(t, impl, rnd, norm, n, kl, ku, ldab)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbconTest(t *testing.T, impl Dgbconer, rnd *rand.Rand, norm lapack.MatrixNorm, n, kl, ku, ldab int) {
+	const ratioThresh = 10
+
+	name := fmt.Sprintf("norm=%v,n=%v,kl=%v,ku=%v,ldab=%v", normToString(norm), n, kl, ku, ldab)
+
+	// Generate a random general band matrix.
+	ab := randGeneralBand(n, n, kl, ku, ldab, rnd)
+
+	// Compute the norm of A.
+	aNorm := impl.Dlangb(norm, n, n, kl, ku, ab, ldab)
+
+	// Compute the LU factorization of A.
+	abFac := make([]float64, len(ab))
+	copy(abFac, ab)
+	ipiv := make([]int, n)
+	ok := impl.Dgbtrf(n, n, kl, ku, abFac, ldab, ipiv)
+	if !ok {
+		t.Fatalf("%v: bad test matrix, Dgbtrf failed", name)
+	}
+
+	// Compute an estimate of rCond.
+	work := make([]float64, 3*n)
+	iwork := make([]int, n)
+	abFacCopy := make([]float64, len(abFac))
+	copy(abFacCopy, abFac)
+	rCondGot := impl.Dgbcon(norm, n, kl, ku, abFac, ldab, ipiv, aNorm, work, iwork)
+
+	if !floats.Same(abFac, abFacCopy) {
+		t.Errorf("%v: unexpected modification of ab", name)
+	}
+
+	// Form the inverse of A to compute a good estimate of the condition number
+	//  rCondWant := 1/(norm(A) * norm(inv(A)))
+	lda := max(1, n)
+	aInv := make([]float64, n*lda)
+	for i := 0; i < n; i++ {
+		aInv[i*lda+i] = 1
+	}
+	impl.Dgbtrs(blas.NoTrans, n, kl, ku, n, abFac, ldab, ipiv, aInv, lda)
+	aInvNorm := dlange(norm, n, n, aInv, lda)
+	rCondWant := 1.0
+	if aNorm > 0 && aInvNorm > 0 {
+		rCondWant = 1 / aNorm / aInvNorm
+	}
+
+	ratio := rCondTestRatio(rCondGot, rCondWant)
+	if ratio >= ratioThresh {
+		t.Errorf("%v: unexpected value of rcond. got=%v, want=%v (ratio=%v)", name, rCondGot, rCondWant, ratio)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dgbtf2er interface {
+	Dgbtf2(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+// Dgbtf2Test tests Dgbtf2 by checking that the LU factors of random general
+// band matrices multiply back to the original matrix.
+func Dgbtf2Test(t *testing.T, impl Dgbtf2er) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 33} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 33} {
+			for _, kl := range []int{0, 1, 2, 5, 31} {
+				for _, ku := range []int{0, 1, 3, 32} {
+					for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 1 + 3} {
+						dgbtrfTest(t, "Dgbtf2", impl.Dgbtf2, rnd, m, n, kl, ku, ldab)
+					}
+				}
+			}
+		}
+	}
+}
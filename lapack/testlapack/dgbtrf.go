@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dgbtrfer interface {
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+// DgbtrfTest tests Dgbtrf by checking that the LU factors of random general
+// band matrices multiply back to the original matrix.
+func DgbtrfTest(t *testing.T, impl Dgbtrfer) {
+	// With the current implementation of Ilaenv the blocked code path is
+	// taken if ku > 64 and kl >= 32.
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 33, 70, 129, 201} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 33, 70, 129, 201} {
+			for _, kl := range []int{0, 1, 2, 5, 31, 32, 45, 100} {
+				for _, ku := range []int{0, 1, 3, 64, 65, 100} {
+					for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 1 + 3} {
+						dgbtrfTest(t, "Dgbtrf", impl.Dgbtrf, rnd, m, n, kl, ku, ldab)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrfTest(t *testing.T, fname string, dgbtrf func(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) bool,
+	rnd *rand.Rand, m, n, kl, ku, ldab int) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("m=%v,n=%v,kl=%v,ku=%v,ldab=%v", m, n, kl, ku, ldab)
+
+	// Generate a random general band matrix.
+	ab := randGeneralBand(m, n, kl, ku, ldab, rnd)
+	a := generalBandToDense(m, n, kl, ku, ab, ldab)
+
+	// Compute the LU factorization of A.
+	mn := min(m, n)
+	ipiv := make([]int, mn)
+	// Pass the shortest allowed slice to check that Dgbtrf does not access
+	// elements beyond it.
+	var abMin []float64
+	if m > 0 && n > 0 {
+		abMin = ab[:(min(m, n+kl)-1)*ldab+2*kl+ku+1]
+	}
+	ok := dgbtrf(m, n, kl, ku, abMin, ldab, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+		return
+	}
+	if mn == 0 {
+		return
+	}
+
+	// Reconstruct A from the factors as
+	//  A = P_0 * L_0 * P_1 * L_1 * ... * P_{mn-1} * L_{mn-1} * U.
+	ldm := ldab - 1
+	kv := kl + ku
+	lda := max(1, n)
+	lu := make([]float64, m*lda)
+	for i := 0; i < mn; i++ {
+		for j := i; j < min(n, i+kv+1); j++ {
+			lu[i*lda+j] = ab[kl+i*ldm+j]
+		}
+	}
+	for j := mn - 1; j >= 0; j-- {
+		km := min(kl, m-1-j)
+		for i := j + 1; i <= j+km; i++ {
+			l := ab[kl+i*ldm+j]
+			for k := 0; k < n; k++ {
+				lu[i*lda+k] += l * lu[j*lda+k]
+			}
+		}
+		if p := ipiv[j]; p != j {
+			if p < j || j+kl < p {
+				t.Errorf("%v: %v: ipiv[%v]=%v out of range", fname, name, j, p)
+				return
+			}
+			for k := 0; k < n; k++ {
+				lu[j*lda+k], lu[p*lda+k] = lu[p*lda+k], lu[j*lda+k]
+			}
+		}
+	}
+
+	// Compute and check the max-norm distance between the reconstructed and
+	// original matrix A.
+	var diff float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			diff = math.Max(diff, math.Abs(a[i*lda+j]-lu[i*lda+j]))
+		}
+	}
+	if !(diff <= tol) {
+		t.Errorf("%v: %v: unexpected result, diff=%v", fname, name, diff)
+	}
+}
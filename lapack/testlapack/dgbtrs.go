@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtrser interface {
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
+
+	Dgbtrfer
+	Dlangber
+}
+
+// DgbtrsTest tests Dgbtrs by checking the residual of the computed solution
+// of a linear system with a random general band matrix.
+func DgbtrsTest(t *testing.T, impl Dgbtrser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 70, 129} {
+		for _, kl := range []int{0, 1, 2, 5, 40} {
+			for _, ku := range []int{0, 1, 3, 65} {
+				for _, nrhs := range []int{0, 1, 2, 5} {
+					for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+						for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 1 + 3} {
+							for _, ldb := range []int{max(1, nrhs), nrhs + 4} {
+								dgbtrsTest(t, impl, rnd, trans, n, kl, ku, nrhs, ldab, ldb)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrsTest(t *testing.T, impl Dgbtrser, rnd *rand.Rand, trans blas.Transpose, n, kl, ku, nrhs, ldab, ldb int) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("trans=%v,n=%v,kl=%v,ku=%v,nrhs=%v,ldab=%v,ldb=%v", string(trans), n, kl, ku, nrhs, ldab, ldb)
+
+	// Generate a random general band matrix.
+	ab := randGeneralBand(n, n, kl, ku, ldab, rnd)
+
+	// Compute the LU factorization of A.
+	abFac := make([]float64, len(ab))
+	copy(abFac, ab)
+	ipiv := make([]int, n)
+	ok := impl.Dgbtrf(n, n, kl, ku, abFac, ldab, ipiv)
+	if !ok {
+		t.Fatalf("%v: bad test matrix, Dgbtrf failed", name)
+	}
+	abFacCopy := make([]float64, len(abFac))
+	copy(abFacCopy, abFac)
+
+	// Generate a random right-hand side.
+	b := make([]float64, n*ldb)
+	for i := range b {
+		b[i] = rnd.NormFloat64()
+	}
+	x := make([]float64, len(b))
+	copy(x, b)
+
+	// Solve A*X = B or Aᵀ*X = B.
+	impl.Dgbtrs(trans, n, kl, ku, nrhs, abFac, ldab, ipiv, x, ldb)
+
+	// Check that the LU factorization has not been modified.
+	if !floats.Same(abFac, abFacCopy) {
+		t.Errorf("%v: unexpected modification of ab", name)
+	}
+
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// Compute the residual B - op(A)*X and check
+	//  |B - op(A)*X|_1 / (|op(A)|_1 * |X|_1 * n).
+	norm := lapack.MaxColumnSum
+	if trans != blas.NoTrans {
+		norm = lapack.MaxRowSum
+	}
+	aNorm := impl.Dlangb(norm, n, n, kl, ku, ab, ldab)
+	bi := blas64.Implementation()
+	for j := 0; j < nrhs; j++ {
+		bi.Dgbmv(trans, n, n, kl, ku, -1, ab, ldab, x[j:], ldb, 1, b[j:], ldb)
+		resid := bi.Dasum(n, b[j:], ldb)
+		xNorm := bi.Dasum(n, x[j:], ldb)
+		ratio := resid / (aNorm * xNorm * float64(n))
+		if !(ratio <= tol) {
+			t.Errorf("%v: unexpected residual for column %v, ratio=%v", name, j, ratio)
+		}
+	}
+}
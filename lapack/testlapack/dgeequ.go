@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dgeequer interface {
+	Dgeequ(m, n int, a []float64, lda int, r, c []float64) (rowcnd, colcnd, amax float64, ok bool)
+}
+
+// DgeequTest tests Dgeequ by checking that the scaled matrix has elements of
+// absolute value at most 1 and that the largest element in each column has
+// an absolute value of 1.
+func DgeequTest(t *testing.T, impl Dgeequer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 25} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 25} {
+			for _, lda := range []int{max(1, n), n + 4} {
+				for _, zero := range []string{"none", "row", "col"} {
+					dgeequTest(t, impl, rnd, m, n, lda, zero)
+				}
+			}
+		}
+	}
+}
+
+func dgeequTest(t *testing.T, impl Dgeequer, rnd *rand.Rand, m, n, lda int, zero string) {
+	const tol = 1e-14
+
+	if (zero == "row" || zero == "col") && (m == 0 || n == 0) {
+		return
+	}
+
+	name := fmt.Sprintf("m=%v,n=%v,lda=%v,zero=%v", m, n, lda, zero)
+
+	// Generate a random badly scaled matrix.
+	a := randomGeneral(m, n, lda, rnd)
+	for i := 0; i < m; i++ {
+		s := math.Pow(10, float64(rnd.Intn(21)-10))
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] *= s
+		}
+	}
+	for j := 0; j < n; j++ {
+		s := math.Pow(10, float64(rnd.Intn(21)-10))
+		for i := 0; i < m; i++ {
+			a.Data[i*a.Stride+j] *= s
+		}
+	}
+	switch zero {
+	case "row":
+		k := rnd.Intn(m)
+		for j := 0; j < n; j++ {
+			a.Data[k*a.Stride+j] = 0
+		}
+	case "col":
+		k := rnd.Intn(n)
+		for i := 0; i < m; i++ {
+			a.Data[i*a.Stride+k] = 0
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	r := nanSlice(m)
+	c := nanSlice(n)
+	rowcnd, colcnd, amax, ok := impl.Dgeequ(m, n, a.Data, a.Stride, r, c)
+
+	if !floats.Same(a.Data, aCopy.Data) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+
+	var wantAmax float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			wantAmax = math.Max(wantAmax, math.Abs(a.Data[i*a.Stride+j]))
+		}
+	}
+	if amax != wantAmax {
+		t.Errorf("%v: unexpected amax, got %v, want %v", name, amax, wantAmax)
+	}
+
+	if zero != "none" {
+		if ok {
+			t.Errorf("%v: zero %v not detected", name, zero)
+		}
+		return
+	}
+	if !ok {
+		t.Errorf("%v: unexpected zero row or column", name)
+		return
+	}
+	if m == 0 || n == 0 {
+		if rowcnd != 1 || colcnd != 1 {
+			t.Errorf("%v: unexpected rowcnd or colcnd for empty matrix", name)
+		}
+		return
+	}
+
+	if want := floats.Min(r) / floats.Max(r); math.Abs(rowcnd-want) > tol*want {
+		t.Errorf("%v: unexpected rowcnd, got %v, want %v", name, rowcnd, want)
+	}
+	if want := floats.Min(c) / floats.Max(c); math.Abs(colcnd-want) > tol*want {
+		t.Errorf("%v: unexpected colcnd, got %v, want %v", name, colcnd, want)
+	}
+
+	// Check that all elements of the scaled matrix diag(r)*A*diag(c) are at
+	// most 1 in absolute value and that the largest element in each row of
+	// diag(r)*A and in each column of diag(r)*A*diag(c) is 1.
+	cmax := make([]float64, n)
+	for i := 0; i < m; i++ {
+		var rmax float64
+		for j := 0; j < n; j++ {
+			v := math.Abs(r[i] * a.Data[i*a.Stride+j])
+			rmax = math.Max(rmax, v)
+			v *= c[j]
+			if v > 1+tol {
+				t.Errorf("%v: scaled element [%v,%v] too large: %v", name, i, j, v)
+			}
+			cmax[j] = math.Max(cmax[j], v)
+		}
+		if math.Abs(rmax-1) > tol {
+			t.Errorf("%v: unexpected largest element in row %v of diag(r)*A: %v", name, i, rmax)
+		}
+	}
+	for j, v := range cmax {
+		if math.Abs(v-1) > tol {
+			t.Errorf("%v: unexpected largest element in column %v of diag(r)*A*diag(c): %v", name, j, v)
+		}
+	}
+}
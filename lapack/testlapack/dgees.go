@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgeeser interface {
+	Dgees(jobvs lapack.SchurComp, n int, a []float64, lda int, wr, wi, vs []float64, ldvs int, work []float64, lwork int) (ok bool)
+}
+
+func DgeesTest(t *testing.T, impl Dgeeser) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, test := range []struct {
+		a      blas64.General
+		evWant []complex128
+		evTol  float64
+	}{
+		{A123{}.Matrix(), A123{}.Eigenvalues(), 1e-10},
+		{Circulant(5).Matrix(), Circulant(5).Eigenvalues(), 1e-10},
+		{Circulant(10).Matrix(), Circulant(10).Eigenvalues(), 1e-10},
+		{Clement(10).Matrix(), Clement(10).Eigenvalues(), 1e-10},
+		{Creation(10).Matrix(), Creation(10).Eigenvalues(), 1e-10},
+		{Diagonal(10).Matrix(), Diagonal(10).Eigenvalues(), 1e-10},
+		{Downshift(10).Matrix(), Downshift(10).Eigenvalues(), 1e-10},
+		{Fibonacci(10).Matrix(), Fibonacci(10).Eigenvalues(), 1e-10},
+		{Gear(10).Matrix(), Gear(10).Eigenvalues(), 1e-7},
+		{Rutis{}.Matrix(), Rutis{}.Eigenvalues(), 1e-10},
+		{Wilk4{}.Matrix(), Wilk4{}.Eigenvalues(), 1e-10},
+		{Zero(10).Matrix(), Zero(10).Eigenvalues(), 1e-10},
+	} {
+		for _, extra := range []int{0, 5} {
+			for _, jobvs := range []lapack.SchurComp{lapack.SchurOrig, lapack.SchurNone} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					dgeesTest(t, impl, test.a, test.evWant, test.evTol, extra, jobvs, wl)
+				}
+			}
+		}
+	}
+
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50, 101} {
+		for _, scale := range []float64{1, 1e-300, 1e300} {
+			a := randomGeneral(n, n, n, rnd)
+			for i := range a.Data {
+				a.Data[i] *= scale
+			}
+			for _, extra := range []int{0, 5} {
+				for _, jobvs := range []lapack.SchurComp{lapack.SchurOrig, lapack.SchurNone} {
+					for _, wl := range []worklen{minimumWork, optimumWork} {
+						dgeesTest(t, impl, a, nil, 0, extra, jobvs, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgeesTest(t *testing.T, impl Dgeeser, a blas64.General, evWant []complex128, evTol float64, extra int, jobvs lapack.SchurComp, wl worklen) {
+	const tol = 1e-13
+
+	n := a.Rows
+	name := fmt.Sprintf("n=%v,extra=%v,jobvs=%c,work=%v", n, extra, jobvs, wl)
+
+	aCopy := zeros(n, n, n+extra)
+	copyGeneral(aCopy, a)
+	tmat := cloneGeneral(aCopy)
+
+	wantvs := jobvs == lapack.SchurOrig
+	vs := nanGeneral(n, n, n+extra)
+	if !wantvs {
+		vs = blas64.General{Stride: 1}
+	}
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 3*n)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dgees(jobvs, n, nil, max(1, tmat.Stride), nil, nil, nil, max(1, vs.Stride), work, -1)
+		lwork = int(work[0])
+	}
+	work := nanSlice(lwork)
+	wr := nanSlice(n)
+	wi := nanSlice(n)
+
+	ok := impl.Dgees(jobvs, n, tmat.Data, max(1, tmat.Stride), wr, wi, vs.Data, max(1, vs.Stride), work, lwork)
+	if !ok {
+		t.Errorf("%v: Dgees failed", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !generalOutsideAllNaN(tmat) {
+		t.Errorf("%v: out-of-range write to T", name)
+	}
+	if !isSchurCanonicalGeneral(tmat) {
+		t.Errorf("%v: T is not in Schur canonical form", name)
+	}
+
+	// Check that wr and wi match the diagonal blocks of T.
+	for i := 0; i < n; {
+		if i == n-1 || tmat.Data[(i+1)*tmat.Stride+i] == 0 {
+			if wr[i] != tmat.Data[i*tmat.Stride+i] || wi[i] != 0 {
+				t.Errorf("%v: eigenvalue %v does not match T", name, i)
+			}
+			i++
+			continue
+		}
+		a, b, c, d := extract2x2Block(tmat.Data[i*tmat.Stride+i:], tmat.Stride)
+		ev1, ev2 := schurBlockEigenvalues(a, b, c, d)
+		if cmplx.Abs(ev1-complex(wr[i], wi[i])) > tol*cmplx.Abs(ev1) ||
+			cmplx.Abs(ev2-complex(wr[i+1], wi[i+1])) > tol*cmplx.Abs(ev2) {
+			t.Errorf("%v: eigenvalues %v and %v do not match T", name, i, i+1)
+		}
+		if wi[i] <= 0 || wi[i+1] != -wi[i] {
+			t.Errorf("%v: unexpected order of complex conjugate eigenvalues %v and %v", name, i, i+1)
+		}
+		i += 2
+	}
+
+	// Check the eigenvalues against the known values.
+	for _, ev := range evWant {
+		found, _ := containsComplex(complexSlice(wr, wi), ev, evTol)
+		if !found {
+			t.Errorf("%v: unexpected eigenvalue %v", name, ev)
+		}
+	}
+
+	if !wantvs {
+		return
+	}
+
+	if !generalOutsideAllNaN(vs) {
+		t.Errorf("%v: out-of-range write to VS", name)
+	}
+	if resid := residualOrthogonal(vs, false); resid > tol*float64(n) {
+		t.Errorf("%v: VS is not orthogonal; resid=%v", name, resid)
+	}
+
+	// Check that A = VS * T * VSᵀ.
+	anorm := dlange(lapack.MaxColumnSum, n, n, aCopy.Data, aCopy.Stride)
+	vst := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, vs, tmat, 0, vst)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, vst, vs, -1, aCopy)
+	resid := dlange(lapack.MaxColumnSum, n, n, aCopy.Data, aCopy.Stride)
+	if anorm != 0 {
+		resid /= anorm
+	}
+	if resid > tol*float64(n) || math.IsNaN(resid) {
+		t.Errorf("%v: A not recovered from VS*T*VSᵀ; resid=%v", name, resid)
+	}
+}
+
+// complexSlice returns the complex numbers with real parts in re and
+// imaginary parts in im.
+func complexSlice(re, im []float64) []complex128 {
+	z := make([]complex128, len(re))
+	for i := range z {
+		z[i] = complex(re[i], im[i])
+	}
+	return z
+}
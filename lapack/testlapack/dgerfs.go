@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dgerfser interface {
+	Dgetrser
+	Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr []float64, work []float64, iwork []int)
+}
+
+// DgerfsTest tests Dgerfs by checking that it reduces the componentwise
+// backward error of a perturbed solution to the order of machine precision
+// and that the returned forward error bounds are not smaller than the actual
+// errors.
+func DgerfsTest(t *testing.T, impl Dgerfser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25, 50} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, lda := range []int{max(1, n), n + 3} {
+					for _, ldb := range []int{max(1, nrhs), nrhs + 4} {
+						dgerfsTest(t, impl, rnd, trans, n, nrhs, lda, ldb)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgerfsTest(t *testing.T, impl Dgerfser, rnd *rand.Rand, trans blas.Transpose, n, nrhs, lda, ldb int) {
+	const perturb = 1e-8
+
+	name := fmt.Sprintf("trans=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", transToString(trans), n, nrhs, lda, ldb)
+
+	// Generate a random matrix A and a random solution X.
+	a := randomGeneral(n, n, lda, rnd)
+	xWant := randomGeneral(n, nrhs, ldb, rnd)
+	// Compute the right-hand side B = op(A) * X.
+	b := zeros(n, nrhs, ldb)
+	blas64.Gemm(trans, blas.NoTrans, 1, a, xWant, 0, b)
+
+	// Compute the LU factorization of A.
+	af := cloneGeneral(a)
+	ipiv := make([]int, n)
+	ok := impl.Dgetrf(n, n, af.Data, af.Stride, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+		return
+	}
+
+	// Compute an initial solution and perturb it so that the refinement
+	// has something to do.
+	x := cloneGeneral(b)
+	impl.Dgetrs(trans, n, nrhs, af.Data, af.Stride, ipiv, x.Data, x.Stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			x.Data[i*x.Stride+j] *= 1 + perturb*rnd.NormFloat64()
+		}
+	}
+
+	aCopy := cloneGeneral(a)
+	afCopy := cloneGeneral(af)
+	bCopy := cloneGeneral(b)
+	ipivCopy := make([]int, len(ipiv))
+	copy(ipivCopy, ipiv)
+
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(3 * n)
+	iwork := make([]int, n)
+	impl.Dgerfs(trans, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, ipiv, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+
+	if !floats.Same(a.Data, aCopy.Data) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !floats.Same(af.Data, afCopy.Data) {
+		t.Errorf("%v: unexpected modification of AF", name)
+	}
+	if !floats.Same(b.Data, bCopy.Data) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	if !intsEqual(ipiv, ipivCopy) {
+		t.Errorf("%v: unexpected modification of ipiv", name)
+	}
+
+	checkRefinedSolution(t, name, n, nrhs, x, xWant, ferr, berr)
+}
+
+// checkRefinedSolution checks the backward error estimates berr and the
+// forward error bounds ferr returned by an iterative refinement routine for
+// the computed solution x against the exact solution xWant.
+func checkRefinedSolution(t *testing.T, name string, n, nrhs int, x, xWant blas64.General, ferr, berr []float64) {
+	// berrTol is the maximum allowed componentwise backward error in units
+	// of (n+1)*eps. The value follows the threshold used by the reference
+	// LAPACK test suite.
+	const berrTol = 30
+
+	for j := 0; j < nrhs; j++ {
+		if n == 0 {
+			if ferr[j] != 0 || berr[j] != 0 {
+				t.Errorf("%v: unexpected non-zero error for column %v: ferr=%v, berr=%v", name, j, ferr[j], berr[j])
+			}
+			continue
+		}
+
+		if !(0 <= berr[j] && berr[j] <= berrTol*float64(n+1)*dlamchE) {
+			t.Errorf("%v: backward error for column %v too large: berr=%v", name, j, berr[j])
+		}
+
+		var diff, xmax float64
+		for i := 0; i < n; i++ {
+			diff = math.Max(diff, math.Abs(x.Data[i*x.Stride+j]-xWant.Data[i*xWant.Stride+j]))
+			xmax = math.Max(xmax, math.Abs(x.Data[i*x.Stride+j]))
+		}
+		if xmax != 0 {
+			diff /= xmax
+		}
+		if !(diff <= ferr[j]) {
+			t.Errorf("%v: forward error bound for column %v too small: ferr=%v, actual=%v", name, j, ferr[j], diff)
+		}
+	}
+}
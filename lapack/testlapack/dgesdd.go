@@ -0,0 +1,181 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesdder interface {
+	Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool)
+
+	Dgesvder
+}
+
+func DgesddTest(t *testing.T, impl Dgesdder, tol float64) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 30, 60, 150} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 30, 60, 150} {
+			for _, mtype := range []int{1, 2, 3, 4, 5} {
+				dgesddTest(t, impl, rnd, m, n, mtype, tol)
+			}
+		}
+	}
+}
+
+// dgesddTest tests a Dgesdd implementation on an m×n matrix A generated
+// according to mtype as in dgesvdTest. For each jobz and amount of workspace
+// it checks that
+//  - the singular values are non-negative, sorted in decreasing order and
+//    equal to those computed by Dgesvd,
+//  - U has orthonormal columns and Vᵀ has orthonormal rows,
+//  - U*Sigma*Vᵀ multiply back to A.
+func dgesddTest(t *testing.T, impl Dgesdder, rnd *rand.Rand, m, n, mtype int, tol float64) {
+	const tolOrtho = 1e-15
+
+	lda := n + 3
+	ldu := m + 5
+	ldvt := n + 7
+
+	minmn := min(m, n)
+
+	a := make([]float64, m*lda)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	var aNorm float64
+	switch mtype {
+	default:
+		panic("unknown test matrix type")
+	case 1:
+		// Zero matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+		aNorm = 0
+	case 2:
+		// Identity matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if i == j {
+					a[i*lda+i] = 1
+				} else {
+					a[i*lda+j] = 0
+				}
+			}
+		}
+		aNorm = 1
+	case 3, 4, 5:
+		// Scaled random matrix.
+		s := make([]float64, minmn)
+		Dlatm1(s, 4, float64(max(1, minmn)), false, 1, rnd)
+		aNorm = 1
+		if mtype == 4 {
+			aNorm = smlnum
+		}
+		if mtype == 5 {
+			aNorm = bignum
+		}
+		floats.Scale(aNorm, s)
+		Dlagge(m, n, max(0, m-1), max(0, n-1), s, a, lda, rnd, make([]float64, m+n))
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	// Compute the reference singular values with Dgesvd.
+	sWant := make([]float64, minmn)
+	work := make([]float64, 1)
+	impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a, lda, sWant, nil, 1, nil, 1, work, -1)
+	work = make([]float64, int(work[0]))
+	ok := impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a, lda, sWant, nil, 1, nil, 1, work, len(work))
+	if !ok {
+		t.Fatalf("m=%v,n=%v,mtype=%v: unexpected failure in Dgesvd", m, n, mtype)
+	}
+
+	for _, jobz := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDNone} {
+		for _, wl := range []worklen{minimumWork, optimumWork} {
+			prefix := fmt.Sprintf("m=%v,n=%v,mtype=%v,job=%v,work=%v", m, n, mtype, svdJobString(jobz), wl)
+
+			var ucol, vtrow int
+			switch jobz {
+			case lapack.SVDAll:
+				ucol, vtrow = m, n
+			case lapack.SVDStore:
+				ucol, vtrow = minmn, minmn
+			}
+
+			mx := max(m, n)
+			var lwork int
+			switch wl {
+			case minimumWork:
+				switch {
+				case minmn == 0:
+					lwork = 1
+				case jobz == lapack.SVDNone:
+					lwork = 3*minmn + max(mx, 7*minmn)
+				case jobz == lapack.SVDStore:
+					lwork = 4*minmn*minmn + 7*minmn
+				default:
+					lwork = 4*minmn*minmn + 6*minmn + mx
+				}
+			case optimumWork:
+				work := make([]float64, 1)
+				impl.Dgesdd(jobz, m, n, a, lda, nil, nil, ldu, nil, ldvt, work, -1, nil)
+				lwork = int(work[0])
+			}
+			work := nanSlice(max(1, lwork))
+			iwork := make([]int, 8*minmn)
+
+			copy(a, aCopy)
+			s := nanSlice(minmn)
+			u := nanSlice(m * ldu)
+			vt := nanSlice(n * ldvt)
+
+			ok := impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, len(work), iwork)
+			if !ok {
+				t.Errorf("Case %v: unexpected failure in Dgesdd", prefix)
+				continue
+			}
+			if minmn == 0 {
+				continue
+			}
+
+			if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+				t.Errorf("Case %v: singular values are not decreasing", prefix)
+			}
+			if floats.Min(s) < 0 {
+				t.Errorf("Case %v: some singular values are negative", prefix)
+			}
+			if !floats.EqualApprox(s, sWant, tol) {
+				t.Errorf("Case %v: singular values differ from Dgesvd\ngot  %v\nwant %v", prefix, s, sWant)
+			}
+
+			if jobz == lapack.SVDNone {
+				continue
+			}
+			q := blas64.General{Rows: m, Cols: ucol, Data: u, Stride: ldu}
+			if resid := residualOrthogonal(q, false); resid > tolOrtho*float64(m) {
+				t.Errorf("Case %v: columns of U are not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(m))
+			}
+			q = blas64.General{Rows: vtrow, Cols: n, Data: vt, Stride: ldvt}
+			if resid := residualOrthogonal(q, true); resid > tolOrtho*float64(n) {
+				t.Errorf("Case %v: rows of VT are not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(n))
+			}
+			if resid := svdFullResidual(m, n, aNorm, aCopy, lda, u, ldu, s, vt, ldvt); resid > tol {
+				t.Errorf("Case %v: original matrix not recovered, |A - U*D*VT|=%v", prefix, resid)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dgetrf2er interface {
+	Dgetrf2(m, n int, a []float64, lda int, ipiv []int) bool
+}
+
+func Dgetrf2Test(t *testing.T, impl Dgetrf2er) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{1, 2, 3, 4, 5, 10, 17, 64, 100} {
+		for _, n := range []int{1, 2, 3, 4, 5, 10, 17, 64, 100} {
+			for _, extra := range []int{0, 7} {
+				lda := n + extra
+				name := fmt.Sprintf("m=%d,n=%d,lda=%d", m, n, lda)
+				a := make([]float64, m*lda)
+				for i := range a {
+					a[i] = rnd.NormFloat64()
+				}
+				mn := min(m, n)
+				ipiv := make([]int, mn)
+				for i := range ipiv {
+					ipiv[i] = rnd.Int()
+				}
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+				ok := impl.Dgetrf2(m, n, a, lda, ipiv)
+				if !ok {
+					t.Errorf("%v: unexpected singular matrix", name)
+				}
+				checkPLU(t, ok, m, n, lda, ipiv, a, aCopy, 1e-12, false)
+
+				// Zero out a column of A so that it is singular, and
+				// check that Dgetrf2 detects it.
+				copy(a, aCopy)
+				j := rnd.Intn(mn)
+				for i := 0; i < m; i++ {
+					a[i*lda+j] = 0
+				}
+				if impl.Dgetrf2(m, n, a, lda, ipiv) {
+					t.Errorf("%v: unexpected success for singular matrix", name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggever interface {
+	Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) bool
+}
+
+func DggevTest(t *testing.T, impl Dggever) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 51} {
+		for _, kind := range []string{"random", "identity", "singular", "scaled"} {
+			a := randomGeneral(n, n, n, rnd)
+			var b blas64.General
+			switch kind {
+			case "random":
+				b = randomGeneral(n, n, n, rnd)
+			case "identity":
+				b = eye(n, n)
+			case "singular":
+				// Make B singular by zeroing a row and a column so that
+				// some of the eigenvalues are infinite.
+				b = randomGeneral(n, n, n, rnd)
+				if n > 0 {
+					k := rnd.Intn(n)
+					for i := 0; i < n; i++ {
+						b.Data[k*b.Stride+i] = 0
+						b.Data[i*b.Stride+k] = 0
+					}
+				}
+			case "scaled":
+				b = randomGeneral(n, n, n, rnd)
+				for i := range a.Data {
+					a.Data[i] *= 1e-300
+				}
+				for i := range b.Data {
+					b.Data[i] *= 1e300
+				}
+			}
+			for _, jobvl := range []lapack.LeftEVJob{lapack.LeftEVCompute, lapack.LeftEVNone} {
+				for _, jobvr := range []lapack.RightEVJob{lapack.RightEVCompute, lapack.RightEVNone} {
+					for _, extra := range []int{0, 5} {
+						for _, wl := range []worklen{minimumWork, optimumWork} {
+							dggevTest(t, impl, kind, a, b, jobvl, jobvr, extra, wl)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dggevTest(t *testing.T, impl Dggever, kind string, a, b blas64.General, jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, extra int, wl worklen) {
+	const tol = 1e-12
+
+	n := a.Rows
+	name := fmt.Sprintf("kind=%v,n=%v,jobvl=%c,jobvr=%c,extra=%v,work=%v", kind, n, jobvl, jobvr, extra, wl)
+
+	aCopy := zeros(n, n, n+extra)
+	copyGeneral(aCopy, a)
+	bCopy := zeros(n, n, n+extra)
+	copyGeneral(bCopy, b)
+
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	vl := blas64.General{Stride: 1}
+	if wantvl {
+		vl = nanGeneral(n, n, n+extra)
+	}
+	vr := blas64.General{Stride: 1}
+	if wantvr {
+		vr = nanGeneral(n, n, n+extra)
+	}
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 8*n)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dggev(jobvl, jobvr, n, nil, max(1, n), nil, max(1, n), nil, nil, nil, nil, max(1, vl.Stride), nil, max(1, vr.Stride), work, -1)
+		lwork = int(work[0])
+	}
+	work := nanSlice(lwork)
+	alphar := nanSlice(n)
+	alphai := nanSlice(n)
+	beta := nanSlice(n)
+
+	ok := impl.Dggev(jobvl, jobvr, n, aCopy.Data, max(1, aCopy.Stride), bCopy.Data, max(1, bCopy.Stride),
+		alphar, alphai, beta, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
+	if !ok {
+		t.Errorf("%v: Dggev failed", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check the structure of the eigenvalues.
+	for j := 0; j < n; j++ {
+		if beta[j] < 0 {
+			t.Errorf("%v: beta[%v] is negative", name, j)
+		}
+		if alphai[j] == 0 {
+			continue
+		}
+		if j == n-1 || alphai[j] < 0 || alphai[j+1] >= 0 {
+			t.Errorf("%v: unexpected complex conjugate pair at %v", name, j)
+		}
+		j++
+	}
+
+	// Compare the eigenvalues with those computed without eigenvectors.
+	if wantvl || wantvr {
+		ar := nanSlice(n)
+		ai := nanSlice(n)
+		be := nanSlice(n)
+		aa := cloneGeneral(a)
+		bb := cloneGeneral(b)
+		work := nanSlice(max(1, 8*n))
+		ok := impl.Dggev(lapack.LeftEVNone, lapack.RightEVNone, n, aa.Data, aa.Stride, bb.Data, bb.Stride,
+			ar, ai, be, nil, 1, nil, 1, work, len(work))
+		if !ok {
+			t.Errorf("%v: Dggev failed without eigenvectors", name)
+		} else {
+			for j := 0; j < n; j++ {
+				alpha1 := complex(alphar[j], alphai[j])
+				beta1 := complex(beta[j], 0)
+				scale1 := cmplx.Abs(alpha1) + beta[j]
+				var found bool
+				for k := 0; k < n; k++ {
+					alpha2 := complex(ar[k], ai[k])
+					beta2 := complex(be[k], 0)
+					scale2 := cmplx.Abs(alpha2) + be[k]
+					if cmplx.Abs(alpha1*beta2-alpha2*beta1) <= 1e-8*scale1*scale2 {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("%v: eigenvalue %v not found without eigenvectors", name, j)
+					break
+				}
+			}
+		}
+	}
+
+	if wantvr {
+		if !generalOutsideAllNaN(vr) {
+			t.Errorf("%v: out-of-range write to VR", name)
+		}
+		if resid := residualGenRightEV(a, b, alphar, alphai, beta, vr); resid > tol*float64(n) {
+			t.Errorf("%v: unexpected right eigenvectors; resid=%v", name, resid)
+		}
+		checkGenEVNormalization(t, name, "VR", alphai, vr)
+	}
+	if wantvl {
+		if !generalOutsideAllNaN(vl) {
+			t.Errorf("%v: out-of-range write to VL", name)
+		}
+		if resid := residualGenRightEV(transposeGeneral(a), transposeGeneral(b), alphar, alphai, beta, conjugateEV(alphai, vl)); resid > tol*float64(n) {
+			t.Errorf("%v: unexpected left eigenvectors; resid=%v", name, resid)
+		}
+		checkGenEVNormalization(t, name, "VL", alphai, vl)
+	}
+}
+
+// residualGenRightEV returns the largest residual
+//  |β_j*A*v_j - α_j*B*v_j| / ((|β_j|*|A| + |α_j|*|B|) * |v_j|)
+// over the right generalized eigenvectors v_j stored in V in the format
+// returned by Dggev. The max-abs norm is used for matrices and vectors.
+func residualGenRightEV(a, b blas64.General, alphar, alphai, beta []float64, v blas64.General) float64 {
+	n := a.Rows
+	anorm := math.Max(dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride), dlamchS)
+	bnorm := math.Max(dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride), dlamchS)
+	var resid float64
+	for j := 0; j < n; j++ {
+		vj := genEVColumn(v, alphai, j)
+		alpha := complex(alphar[j], alphai[j])
+		var r, vnorm float64
+		for i := 0; i < n; i++ {
+			var av, bv complex128
+			for k := 0; k < n; k++ {
+				av += complex(a.Data[i*a.Stride+k], 0) * vj[k]
+				bv += complex(b.Data[i*b.Stride+k], 0) * vj[k]
+			}
+			r = math.Max(r, cmplx.Abs(complex(beta[j], 0)*av-alpha*bv))
+			vnorm = math.Max(vnorm, cmplx.Abs(vj[i]))
+		}
+		denom := (beta[j]*anorm + cmplx.Abs(alpha)*bnorm) * vnorm
+		if denom == 0 {
+			return math.Inf(1)
+		}
+		resid = math.Max(resid, r/denom)
+	}
+	return resid
+}
+
+// genEVColumn returns the j-th complex eigenvector stored in V in the format
+// returned by Dggev.
+func genEVColumn(v blas64.General, alphai []float64, j int) []complex128 {
+	n := v.Rows
+	vj := make([]complex128, n)
+	switch {
+	case alphai[j] == 0:
+		for i := range vj {
+			vj[i] = complex(v.Data[i*v.Stride+j], 0)
+		}
+	case alphai[j] > 0:
+		for i := range vj {
+			vj[i] = complex(v.Data[i*v.Stride+j], v.Data[i*v.Stride+j+1])
+		}
+	default:
+		for i := range vj {
+			vj[i] = complex(v.Data[i*v.Stride+j-1], -v.Data[i*v.Stride+j])
+		}
+	}
+	return vj
+}
+
+// conjugateEV returns a copy of V in which the imaginary parts of the complex
+// eigenvectors stored in the format returned by Dggev are negated.
+func conjugateEV(alphai []float64, v blas64.General) blas64.General {
+	c := cloneGeneral(v)
+	for j := 0; j < len(alphai); j++ {
+		if alphai[j] > 0 {
+			for i := 0; i < c.Rows; i++ {
+				c.Data[i*c.Stride+j+1] *= -1
+			}
+		}
+	}
+	return c
+}
+
+// checkGenEVNormalization checks that the eigenvectors stored in V are scaled
+// so that their largest component has |real part| + |imag. part| == 1.
+func checkGenEVNormalization(t *testing.T, name, vname string, alphai []float64, v blas64.General) {
+	const tol = 1e-14
+	for j := 0; j < v.Cols; j++ {
+		vj := genEVColumn(v, alphai, j)
+		var vmax float64
+		for _, z := range vj {
+			vmax = math.Max(vmax, math.Abs(real(z))+math.Abs(imag(z)))
+		}
+		if math.Abs(vmax-1) > tol {
+			t.Errorf("%v: column %v of %v not normalized; max=%v", name, j, vname, vmax)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dlaqgeer interface {
+	Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) (equed lapack.EquilibrationType)
+}
+
+// DlaqgeTest tests Dlaqge by checking that the returned equilibration type
+// corresponds to the given ratios of the scale factors and that the matrix is
+// scaled accordingly.
+func DlaqgeTest(t *testing.T, impl Dlaqgeer) {
+	const tol = 1e-15
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10} {
+			for _, lda := range []int{max(1, n), n + 4} {
+				for _, rowcnd := range []float64{0.05, 0.5} {
+					for _, colcnd := range []float64{0.05, 0.5} {
+						for _, amax := range []float64{1e-300, 1, 1e300} {
+							name := fmt.Sprintf("m=%v,n=%v,lda=%v,rowcnd=%v,colcnd=%v,amax=%v", m, n, lda, rowcnd, colcnd, amax)
+
+							a := randomGeneral(m, n, lda, rnd)
+							aCopy := cloneGeneral(a)
+							r := make([]float64, m)
+							for i := range r {
+								r[i] = 1 + rnd.Float64()
+							}
+							c := make([]float64, n)
+							for i := range c {
+								c[i] = 1 + rnd.Float64()
+							}
+
+							equed := impl.Dlaqge(m, n, a.Data, a.Stride, r, c, rowcnd, colcnd, amax)
+
+							rowScale := rowcnd < 0.1 || amax < 1e-290 || amax > 1e290
+							colScale := colcnd < 0.1
+							want := lapack.EquilibrationNone
+							if m > 0 && n > 0 {
+								switch {
+								case rowScale && colScale:
+									want = lapack.EquilibrationBoth
+								case rowScale:
+									want = lapack.EquilibrationRow
+								case colScale:
+									want = lapack.EquilibrationCol
+								}
+							}
+							if equed != want {
+								t.Errorf("%v: unexpected equed, got %c, want %c", name, equed, want)
+								continue
+							}
+
+							for i := 0; i < m; i++ {
+								for j := 0; j < n; j++ {
+									v := aCopy.Data[i*aCopy.Stride+j]
+									if want == lapack.EquilibrationRow || want == lapack.EquilibrationBoth {
+										v *= r[i]
+									}
+									if want == lapack.EquilibrationCol || want == lapack.EquilibrationBoth {
+										v *= c[j]
+									}
+									got := a.Data[i*a.Stride+j]
+									if math.Abs(got-v) > tol*math.Abs(v) {
+										t.Errorf("%v: unexpected element [%v,%v], got %v, want %v", name, i, j, got, v)
+									}
+								}
+							}
+							if !generalOutsideAllNaN(a) {
+								t.Errorf("%v: out-of-range modification of A", name)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
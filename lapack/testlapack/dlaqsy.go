@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dlaqsyer interface {
+	Dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) (equed lapack.EquilibrationType)
+}
+
+// DlaqsyTest tests Dlaqsy by checking that the returned equilibration type
+// corresponds to the given ratio of the scale factors and that the uplo
+// triangle of the matrix is scaled accordingly.
+func DlaqsyTest(t *testing.T, impl Dlaqsyer) {
+	const tol = 1e-15
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10} {
+			for _, lda := range []int{max(1, n), n + 4} {
+				for _, scond := range []float64{0.05, 0.5} {
+					for _, amax := range []float64{1e-300, 1, 1e300} {
+						name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,scond=%v,amax=%v", uploToString(uplo), n, lda, scond, amax)
+
+						a := randomGeneral(n, n, lda, rnd)
+						aCopy := cloneGeneral(a)
+						s := make([]float64, n)
+						for i := range s {
+							s[i] = 1 + rnd.Float64()
+						}
+
+						equed := impl.Dlaqsy(uplo, n, a.Data, a.Stride, s, scond, amax)
+
+						want := lapack.EquilibrationNone
+						if n > 0 && (scond < 0.1 || amax < 1e-290 || amax > 1e290) {
+							want = lapack.EquilibrationBoth
+						}
+						if equed != want {
+							t.Errorf("%v: unexpected equed, got %c, want %c", name, equed, want)
+							continue
+						}
+
+						for i := 0; i < n; i++ {
+							for j := 0; j < n; j++ {
+								v := aCopy.Data[i*aCopy.Stride+j]
+								inTri := (uplo == blas.Upper && i <= j) || (uplo == blas.Lower && i >= j)
+								if want == lapack.EquilibrationBoth && inTri {
+									v *= s[i] * s[j]
+								}
+								got := a.Data[i*a.Stride+j]
+								if math.Abs(got-v) > tol*math.Abs(v) {
+									t.Errorf("%v: unexpected element [%v,%v], got %v, want %v", name, i, j, got, v)
+								}
+							}
+						}
+						if !generalOutsideAllNaN(a) {
+							t.Errorf("%v: out-of-range modification of A", name)
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dormrzer interface {
+	Dtzrzfer
+	Dormrz(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
+}
+
+func DormrzTest(t *testing.T, impl Dormrzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, k := range []int{0, 1, 2, 5, 10, 70} {
+				for _, l := range []int{0, 1, 3, 20} {
+					for _, nc := range []int{1, 4, 30} {
+						for _, extra := range []int{0, 3} {
+							dormrzTest(t, impl, rnd, side, trans, k, l, nc, extra)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dormrzTest(t *testing.T, impl Dormrzer, rnd *rand.Rand, side blas.Side, trans blas.Transpose, k, l, nc, extra int) {
+	const tol = 1e-13
+
+	nq := k + l
+	m, n := nq, nc
+	if side == blas.Right {
+		m, n = nc, nq
+	}
+	name := fmt.Sprintf("side=%v,trans=%v,m=%d,n=%d,k=%d,l=%d,extra=%d", sideToString(side), transToString(trans), m, n, k, l, extra)
+
+	// Compute the RZ factorization of a random upper trapezoidal matrix.
+	a := randomGeneral(k, nq, nq+extra, rnd)
+	for i := 0; i < k; i++ {
+		for j := 0; j < i; j++ {
+			a.Data[i*a.Stride+j] = 0
+		}
+	}
+	tau := make([]float64, k)
+	work := make([]float64, max(1, k))
+	impl.Dtzrzf(k, nq, a.Data, max(1, a.Stride), tau, work, len(work))
+
+	// Compute the expected result using the explicit Z.
+	c := randomGeneral(m, n, n+extra, rnd)
+	want := zeros(m, n, n)
+	z := constructZ(k, nq, a.Data, max(1, a.Stride), tau)
+	if side == blas.Left {
+		blas64.Gemm(trans, blas.NoTrans, 1, z, c, 0, want)
+	} else {
+		blas64.Gemm(blas.NoTrans, trans, 1, c, z, 0, want)
+	}
+
+	nw := m
+	if side == blas.Left {
+		nw = n
+	}
+	work = []float64{0}
+	impl.Dormrz(side, trans, m, n, k, l, a.Data, max(1, a.Stride), tau, c.Data, max(1, c.Stride), work, -1)
+	lwkopt := int(work[0])
+	for _, wl := range []worklen{minimumWork, optimumWork} {
+		var lwork int
+		switch wl {
+		case minimumWork:
+			lwork = max(1, nw)
+		case optimumWork:
+			lwork = max(max(1, nw), lwkopt)
+		}
+		got := cloneGeneral(c)
+		work = nanSlice(lwork)
+		impl.Dormrz(side, trans, m, n, k, l, a.Data, max(1, a.Stride), tau, got.Data, max(1, got.Stride), work, lwork)
+		if !equalApproxGeneral(got, want, tol*float64(nq)) {
+			t.Errorf("%v,work=%v: unexpected result", name, wl)
+		}
+	}
+}
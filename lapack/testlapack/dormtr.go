@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dormtrer interface {
+	Dormtr(side blas.Side, uplo blas.Uplo, trans blas.Transpose, m, n int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
+	Dorgtrer
+}
+
+func DormtrTest(t *testing.T, impl Dormtrer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, mn := range [][2]int{{0, 0}, {1, 1}, {1, 4}, {4, 1}, {3, 5}, {10, 10}, {40, 7}, {7, 40}, {100, 80}, {150, 150}} {
+					m, n := mn[0], mn[1]
+					for _, ldc := range []int{max(1, n), n + 3} {
+						for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+							dormtrTest(t, impl, rnd, side, uplo, trans, m, n, ldc, wl)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dormtrTest(t *testing.T, impl Dormtrer, rnd *rand.Rand, side blas.Side, uplo blas.Uplo, trans blas.Transpose, m, n, ldc int, wl worklen) {
+	const tol = 1e-13
+
+	nq := n
+	nw := m
+	if side == blas.Left {
+		nq = m
+		nw = n
+	}
+	name := fmt.Sprintf("side=%v,uplo=%v,trans=%v,m=%v,n=%v,ldc=%v,work=%v",
+		string(side), string(uplo), string(trans), m, n, ldc, wl)
+
+	// Reduce a random symmetric matrix to tridiagonal form.
+	lda := max(1, nq)
+	a := randomGeneral(nq, nq, lda, rnd).Data
+	d := make([]float64, nq)
+	e := make([]float64, max(0, nq-1))
+	tau := make([]float64, max(0, nq-1))
+	work := make([]float64, 1)
+	impl.Dsytrd(uplo, nq, a, lda, d, e, tau, work, -1)
+	work = make([]float64, int(work[0]))
+	impl.Dsytrd(uplo, nq, a, lda, d, e, tau, work, len(work))
+
+	// Generate the orthogonal matrix Q explicitly.
+	q := make([]float64, len(a))
+	copy(q, a)
+	work = make([]float64, max(1, nq-1))
+	impl.Dorgtr(uplo, nq, q, lda, tau, work, len(work))
+
+	// Compute the expected result using the explicit Q.
+	c := randomGeneral(m, n, ldc, rnd)
+	want := zeros(m, n, max(1, n))
+	qmat := blas64.General{Rows: nq, Cols: nq, Stride: lda, Data: q}
+	if nq > 0 && m > 0 && n > 0 {
+		if side == blas.Left {
+			blas64.Gemm(trans, blas.NoTrans, 1, qmat, c, 0, want)
+		} else {
+			blas64.Gemm(blas.NoTrans, trans, 1, c, qmat, 0, want)
+		}
+	}
+
+	// Compute the result using Dormtr.
+	impl.Dormtr(side, uplo, trans, m, n, a, lda, tau, c.Data, c.Stride, work, -1)
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, nw)
+	case mediumWork:
+		lwork = max(max(1, nw), (int(work[0])+max(1, nw))/2)
+	case optimumWork:
+		lwork = int(work[0])
+	}
+	work = make([]float64, lwork)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+	impl.Dormtr(side, uplo, trans, m, n, a, lda, tau, c.Data, c.Stride, work, lwork)
+
+	if !floats.Equal(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !equalApproxGeneral(c, want, tol) {
+		t.Errorf("%v: unexpected result", name)
+	}
+}
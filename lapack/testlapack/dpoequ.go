@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dpoequer interface {
+	Dpoequ(n int, a []float64, lda int, s []float64) (scond, amax float64, ok bool)
+}
+
+// DpoequTest tests Dpoequ by checking that the scaled matrix has ones on the
+// diagonal.
+func DpoequTest(t *testing.T, impl Dpoequer) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 25} {
+		for _, lda := range []int{max(1, n), n + 4} {
+			for _, bad := range []bool{false, true} {
+				if bad && n == 0 {
+					continue
+				}
+				name := fmt.Sprintf("n=%v,lda=%v,bad=%v", n, lda, bad)
+
+				// Generate a random badly scaled symmetric positive
+				// definite matrix.
+				a := randSymPosDef(n, lda, rnd)
+				for i := 0; i < n; i++ {
+					s := math.Pow(10, float64(rnd.Intn(21)-10))
+					for j := 0; j < n; j++ {
+						a.Data[i*a.Stride+j] *= s
+						a.Data[j*a.Stride+i] *= s
+					}
+				}
+				if bad {
+					k := rnd.Intn(n)
+					a.Data[k*a.Stride+k] = -a.Data[k*a.Stride+k]
+				}
+				aCopy := cloneGeneral(a)
+
+				s := nanSlice(n)
+				scond, amax, ok := impl.Dpoequ(n, a.Data, a.Stride, s)
+
+				if !floats.Same(a.Data, aCopy.Data) {
+					t.Errorf("%v: unexpected modification of A", name)
+				}
+
+				wantAmax := math.Inf(-1)
+				if n == 0 {
+					wantAmax = 0
+				}
+				for i := 0; i < n; i++ {
+					wantAmax = math.Max(wantAmax, a.Data[i*a.Stride+i])
+				}
+				if amax != wantAmax {
+					t.Errorf("%v: unexpected amax, got %v, want %v", name, amax, wantAmax)
+				}
+
+				if bad {
+					if ok {
+						t.Errorf("%v: non-positive diagonal element not detected", name)
+					}
+					continue
+				}
+				if !ok {
+					t.Errorf("%v: unexpected non-positive diagonal element", name)
+					continue
+				}
+				if n == 0 {
+					if scond != 1 {
+						t.Errorf("%v: unexpected scond for empty matrix", name)
+					}
+					continue
+				}
+
+				if want := floats.Min(s) / floats.Max(s); math.Abs(scond-want) > tol*want {
+					t.Errorf("%v: unexpected scond, got %v, want %v", name, scond, want)
+				}
+				for i := 0; i < n; i++ {
+					d := s[i] * a.Data[i*a.Stride+i] * s[i]
+					if math.Abs(d-1) > tol {
+						t.Errorf("%v: unexpected diagonal element %v of scaled matrix: %v", name, i, d)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dporfser interface {
+	Dpotrser
+	Dporfs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr []float64, work []float64, iwork []int)
+}
+
+// DporfsTest tests Dporfs by checking that it reduces the componentwise
+// backward error of a perturbed solution to the order of machine precision
+// and that the returned forward error bounds are not smaller than the actual
+// errors.
+func DporfsTest(t *testing.T, impl Dporfser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25, 50} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, lda := range []int{max(1, n), n + 3} {
+					for _, ldb := range []int{max(1, nrhs), nrhs + 4} {
+						dporfsTest(t, impl, rnd, uplo, n, nrhs, lda, ldb)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dporfsTest(t *testing.T, impl Dporfser, rnd *rand.Rand, uplo blas.Uplo, n, nrhs, lda, ldb int) {
+	const perturb = 1e-8
+
+	name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", uploToString(uplo), n, nrhs, lda, ldb)
+
+	// Generate a random symmetric positive definite matrix A and a random
+	// solution X.
+	a := randSymPosDef(n, lda, rnd)
+	xWant := randomGeneral(n, nrhs, ldb, rnd)
+	// Compute the right-hand side B = A * X.
+	b := zeros(n, nrhs, ldb)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, xWant, 0, b)
+
+	// Compute the Cholesky factorization of A.
+	af := cloneGeneral(a)
+	ok := impl.Dpotrf(uplo, n, af.Data, af.Stride)
+	if !ok {
+		t.Errorf("%v: unexpected Cholesky failure", name)
+		return
+	}
+
+	// Compute an initial solution and perturb it so that the refinement
+	// has something to do.
+	x := cloneGeneral(b)
+	impl.Dpotrs(uplo, n, nrhs, af.Data, af.Stride, x.Data, x.Stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			x.Data[i*x.Stride+j] *= 1 + perturb*rnd.NormFloat64()
+		}
+	}
+
+	// Only the uplo triangle of A is referenced, so destroy the other one.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && i > j) || (uplo == blas.Lower && i < j) {
+				a.Data[i*a.Stride+j] = 0
+			}
+		}
+	}
+
+	aCopy := cloneGeneral(a)
+	afCopy := cloneGeneral(af)
+	bCopy := cloneGeneral(b)
+
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(3 * n)
+	iwork := make([]int, n)
+	impl.Dporfs(uplo, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+
+	if !floats.Same(a.Data, aCopy.Data) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !floats.Same(af.Data, afCopy.Data) {
+		t.Errorf("%v: unexpected modification of AF", name)
+	}
+	if !floats.Same(b.Data, bCopy.Data) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+
+	checkRefinedSolution(t, name, n, nrhs, x, xWant, ferr, berr)
+}
+
+// randSymPosDef returns a random n×n symmetric positive definite matrix with
+// the given stride. The diagonal is shifted so that the matrix is diagonally
+// dominant.
+func randSymPosDef(n, stride int, rnd *rand.Rand) blas64.General {
+	a := nanGeneral(n, n, stride)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := rnd.Float64()
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += float64(n)
+	}
+	return a
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+type Dpotrf2er interface {
+	Dpotrf2(ul blas.Uplo, n int, a []float64, lda int) (ok bool)
+}
+
+func Dpotrf2Test(t *testing.T, impl Dpotrf2er) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	bi := blas64.Implementation()
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 4, 5, 10, 17, 31, 64, 100} {
+			for _, extra := range []int{0, 7} {
+				lda := n + extra
+				name := fmt.Sprintf("uplo=%c,n=%d,lda=%d", uplo, n, lda)
+
+				// Construct a positive definite matrix A as
+				//  A = U * D * Uᵀ
+				// where U is a random orthogonal matrix and D is
+				// a random diagonal matrix with positive entries.
+				d := make([]float64, n)
+				Dlatm1(d, 4, 10000, false, 1, rnd)
+				a := make([]float64, n*lda)
+				Dlagsy(n, 0, d, a, lda, rnd, make([]float64, 2*n))
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+
+				ok := impl.Dpotrf2(uplo, n, a, lda)
+				if !ok {
+					t.Errorf("%v: unexpected failure for positive definite matrix", name)
+					continue
+				}
+
+				// Zero out the other triangle and compute Uᵀ * U or
+				// L * Lᵀ.
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+							a[i*lda+j] = 0
+						}
+					}
+				}
+				ans := make([]float64, len(a))
+				if uplo == blas.Upper {
+					bi.Dsyrk(uplo, blas.Trans, n, n, 1, a, lda, 0, ans, lda)
+				} else {
+					bi.Dsyrk(uplo, blas.NoTrans, n, n, 1, a, lda, 0, ans, lda)
+				}
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+							continue
+						}
+						if !scalar.EqualWithinAbsOrRel(ans[i*lda+j], aCopy[i*lda+j], tol, tol) {
+							t.Errorf("%v: unexpected result at (%d,%d)", name, i, j)
+						}
+					}
+				}
+
+				// Make one element of D negative so that A is not
+				// positive definite, and check that Dpotrf2 fails.
+				d[0] *= -1
+				Dlagsy(n, 0, d, a, lda, rnd, make([]float64, 2*n))
+				if impl.Dpotrf2(uplo, n, a, lda) {
+					t.Errorf("%v: unexpected success for not positive definite matrix", name)
+				}
+			}
+		}
+	}
+}
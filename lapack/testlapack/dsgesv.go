@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsgesver interface {
+	Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, ok bool)
+}
+
+// DsgesvTest tests Dsgesv by checking the residual of the computed solution
+// for well-conditioned matrices, where the mixed precision refinement is
+// expected to succeed, and for matrices that force the fallback to double
+// precision.
+func DsgesvTest(t *testing.T, impl Dsgesver) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, kind := range []string{"random", "hilbert", "overflow"} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25, 50, 100} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, lda := range []int{max(1, n), n + 3} {
+					for _, ldb := range []int{max(1, nrhs), nrhs + 4} {
+						dsgesvTest(t, impl, rnd, kind, n, nrhs, lda, ldb)
+					}
+				}
+			}
+		}
+	}
+
+	// Check that a singular matrix is reported.
+	const n = 5
+	a := randomGeneral(n, n, n, rnd)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+2] = 0
+	}
+	b := randomGeneral(n, 1, 1, rnd)
+	x := nanGeneral(n, 1, 1)
+	_, ok := impl.Dsgesv(n, 1, a.Data, a.Stride, make([]int, n), b.Data, b.Stride, x.Data, x.Stride, make([]float64, n), make([]float32, n*(n+1)))
+	if ok {
+		t.Errorf("singular matrix not detected")
+	}
+}
+
+func dsgesvTest(t *testing.T, impl Dsgesver, rnd *rand.Rand, kind string, n, nrhs, lda, ldb int) {
+	name := fmt.Sprintf("kind=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", kind, n, nrhs, lda, ldb)
+
+	var a blas64.General
+	switch kind {
+	case "random":
+		a = randomGeneral(n, n, lda, rnd)
+	case "hilbert":
+		a = hilbertGeneral(n, lda)
+	case "overflow":
+		a = randomGeneral(n, n, lda, rnd)
+		if n > 0 {
+			a.Data[(n-1)*a.Stride] = 1e50
+		}
+	}
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	x := nanGeneral(n, nrhs, ldb)
+
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	ipiv := make([]int, n)
+	work := nanSlice(n * nrhs)
+	swork := make([]float32, n*(n+nrhs))
+	for i := range swork {
+		swork[i] = float32(math.NaN())
+	}
+	iter, ok := impl.Dsgesv(n, nrhs, a.Data, a.Stride, ipiv, b.Data, b.Stride, x.Data, x.Stride, work, swork)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+		return
+	}
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	if !floats.Same(b.Data, bCopy.Data) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	checkMixedIter(t, name, kind, n, iter)
+	if iter >= 0 && !floats.Same(a.Data, aCopy.Data) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+
+	resid := residualLinearSolve(n, nrhs, aCopy, x, b)
+	if resid > 10 {
+		t.Errorf("%v: residual too large, iter=%v, resid=%v", name, iter, resid)
+	}
+}
+
+// checkMixedIter checks the value of iter returned by the mixed precision
+// solvers for the given kind of test matrix.
+func checkMixedIter(t *testing.T, name, kind string, n, iter int) {
+	switch kind {
+	case "random":
+		// The random matrices are well-conditioned enough for the
+		// refinement to succeed. For very small n the stopping criterion
+		// may be too strict to be met, since it scales with sqrt(n).
+		if n >= 5 && iter < 0 {
+			t.Errorf("%v: unexpected fallback to double precision, iter=%v", name, iter)
+		}
+	case "hilbert":
+		// Hilbert matrices of order 10 and larger are too ill-conditioned
+		// for single precision.
+		if n >= 10 && iter >= 0 {
+			t.Errorf("%v: unexpected success of mixed precision refinement, iter=%v", name, iter)
+		}
+	case "overflow":
+		if iter != -2 {
+			t.Errorf("%v: unexpected iter for overflowing matrix, got %v, want -2", name, iter)
+		}
+	}
+}
+
+// residualLinearSolve returns the normwise residual of the solution X of
+// A * X = B relative to the norms of A and X, that is
+//  max_j |B_j - A*X_j|_∞ / (n * eps * |A|_∞ * |X_j|_∞),
+// where A is an n×n general matrix and X_j and B_j denote the j-th columns of
+// X and B.
+func residualLinearSolve(n, nrhs int, a, x, b blas64.General) float64 {
+	r := cloneGeneral(b)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, a, x, 1, r)
+	anorm := dlange(lapack.MaxRowSum, n, n, a.Data, a.Stride)
+	var resid float64
+	for j := 0; j < nrhs; j++ {
+		var rnorm, xnorm float64
+		for i := 0; i < n; i++ {
+			rnorm = math.Max(rnorm, math.Abs(r.Data[i*r.Stride+j]))
+			xnorm = math.Max(xnorm, math.Abs(x.Data[i*x.Stride+j]))
+		}
+		if xnorm == 0 {
+			resid = math.Max(resid, rnorm)
+			continue
+		}
+		resid = math.Max(resid, rnorm/anorm/xnorm/float64(n)/dlamchE)
+	}
+	return resid
+}
+
+// hilbertGeneral returns the n×n Hilbert matrix with the given stride.
+func hilbertGeneral(n, stride int) blas64.General {
+	a := nanGeneral(n, n, stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] = 1 / float64(i+j+1)
+		}
+	}
+	return a
+}
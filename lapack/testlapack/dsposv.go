@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dsposver interface {
+	Dsposv(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, ok bool)
+}
+
+// DsposvTest tests Dsposv by checking the residual of the computed solution
+// for well-conditioned matrices, where the mixed precision refinement is
+// expected to succeed, and for matrices that force the fallback to double
+// precision.
+func DsposvTest(t *testing.T, impl Dsposver) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, kind := range []string{"random", "hilbert", "overflow"} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25, 50, 100} {
+				for _, nrhs := range []int{0, 1, 2, 5} {
+					for _, lda := range []int{max(1, n), n + 3} {
+						for _, ldb := range []int{max(1, nrhs), nrhs + 4} {
+							dsposvTest(t, impl, rnd, uplo, kind, n, nrhs, lda, ldb)
+						}
+					}
+				}
+			}
+		}
+
+		// Check that a matrix that is not positive definite is reported.
+		const n = 5
+		a := randSymPosDef(n, n, rnd)
+		a.Data[2*a.Stride+2] = -1
+		b := randomGeneral(n, 1, 1, rnd)
+		x := nanGeneral(n, 1, 1)
+		iter, ok := impl.Dsposv(uplo, n, 1, a.Data, a.Stride, b.Data, b.Stride, x.Data, x.Stride, make([]float64, n), make([]float32, n*(n+1)))
+		if ok {
+			t.Errorf("uplo=%v: matrix not positive definite not detected", uploToString(uplo))
+		}
+		if iter != -3 {
+			t.Errorf("uplo=%v: unexpected iter for matrix not positive definite, got %v, want -3", uploToString(uplo), iter)
+		}
+	}
+}
+
+func dsposvTest(t *testing.T, impl Dsposver, rnd *rand.Rand, uplo blas.Uplo, kind string, n, nrhs, lda, ldb int) {
+	name := fmt.Sprintf("uplo=%v,kind=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", uploToString(uplo), kind, n, nrhs, lda, ldb)
+
+	if kind == "hilbert" && n > 12 {
+		// Hilbert matrices of larger order are not numerically positive
+		// definite in double precision.
+		return
+	}
+
+	var a blas64.General
+	switch kind {
+	case "random":
+		a = randSymPosDef(n, lda, rnd)
+	case "hilbert":
+		a = hilbertGeneral(n, lda)
+	case "overflow":
+		a = randSymPosDef(n, lda, rnd)
+		if n > 0 {
+			a.Data[(n-1)*a.Stride+n-1] = 1e50
+		}
+	}
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	x := nanGeneral(n, nrhs, ldb)
+
+	aFull := cloneGeneral(a)
+	// Only the uplo triangle of A is referenced, so destroy the other one.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && i > j) || (uplo == blas.Lower && i < j) {
+				a.Data[i*a.Stride+j] = math.NaN()
+			}
+		}
+	}
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	work := nanSlice(n * nrhs)
+	swork := make([]float32, n*(n+nrhs))
+	for i := range swork {
+		swork[i] = float32(math.NaN())
+	}
+	iter, ok := impl.Dsposv(uplo, n, nrhs, a.Data, a.Stride, b.Data, b.Stride, x.Data, x.Stride, work, swork)
+	if !ok {
+		t.Errorf("%v: unexpected Cholesky failure", name)
+		return
+	}
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	if !floats.Same(b.Data, bCopy.Data) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	checkMixedIter(t, name, kind, n, iter)
+	if iter >= 0 && !floats.Same(a.Data, aCopy.Data) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+
+	resid := residualLinearSolve(n, nrhs, aFull, x, b)
+	if resid > 10 {
+		t.Errorf("%v: residual too large, iter=%v, resid=%v", name, iter, resid)
+	}
+}
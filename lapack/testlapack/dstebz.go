@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstebzer interface {
+	Dstebz(rng lapack.EVRange, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64, iblock, isplit []int, work []float64) (m, nsplit int)
+	Dsteqrer
+}
+
+func DstebzTest(t *testing.T, impl Dstebzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, rng := range []lapack.EVRange{lapack.EVRangeAll, lapack.EVRangeValue, lapack.EVRangeIndex} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50} {
+			// Dstebz expects the matrix to be scaled so that the squares of
+			// its elements do not underflow, as is done by Dsyevr, so the
+			// matrix type with a small norm is not used.
+			for typ := 0; typ <= 6; typ++ {
+				for cas := 0; cas < 3; cas++ {
+					dstebzTest(t, impl, rnd, rng, n, typ)
+				}
+			}
+		}
+	}
+}
+
+func dstebzTest(t *testing.T, impl Dstebzer, rnd *rand.Rand, rng lapack.EVRange, n, typ int) {
+	const tol = 1e-13
+
+	d, e := symTridiagTestMatrix(typ, n, rnd)
+
+	// Compute all the eigenvalues using Dsteqr for comparison.
+	dWant := make([]float64, n)
+	copy(dWant, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+	impl.Dsteqr(lapack.EVCompNone, n, dWant, eCopy, nil, 1, nil)
+
+	anorm := symTridiagMaxAbs(d, e)
+	vl, vu, il, iu, first, last := evRangeTestBounds(rng, dWant, anorm, rnd)
+
+	name := fmt.Sprintf("range=%v,n=%v,type=%v,vl=%v,vu=%v,il=%v,iu=%v", string(rng), n, typ, vl, vu, il, iu)
+
+	w := nanSlice(n)
+	iblock := make([]int, n)
+	isplit := make([]int, n)
+	work := nanSlice(n)
+	m, nsplit := impl.Dstebz(rng, n, vl, vu, il, iu, 0, d, e, w, iblock, isplit, work)
+	if n == 0 {
+		if m != 0 || nsplit != 0 {
+			t.Errorf("%v: unexpected m=%v, nsplit=%v for empty matrix", name, m, nsplit)
+		}
+		return
+	}
+
+	// Check the block structure.
+	if nsplit < 1 || nsplit > n {
+		t.Fatalf("%v: unexpected number of blocks %v", name, nsplit)
+	}
+	if isplit[nsplit-1] != n-1 {
+		t.Errorf("%v: last block does not end at row n-1", name)
+	}
+	for i := 1; i < nsplit; i++ {
+		if isplit[i] <= isplit[i-1] {
+			t.Errorf("%v: block ends not increasing", name)
+			break
+		}
+	}
+	for i := 0; i < m; i++ {
+		if iblock[i] < 0 || iblock[i] >= nsplit {
+			t.Errorf("%v: invalid block index %v", name, iblock[i])
+			return
+		}
+		if i > 0 && (iblock[i] < iblock[i-1] || iblock[i] == iblock[i-1] && w[i] < w[i-1]) {
+			t.Errorf("%v: eigenvalues not ordered by block", name)
+			break
+		}
+	}
+
+	// Check the computed eigenvalues.
+	want := dWant[first : last+1]
+	if m != len(want) {
+		t.Errorf("%v: unexpected number of eigenvalues, got %v, want %v", name, m, len(want))
+		return
+	}
+	got := make([]float64, m)
+	copy(got, w[:m])
+	sort.Float64s(got)
+	for i, v := range got {
+		if math.Abs(v-want[i]) > tol*float64(n)*anorm {
+			t.Errorf("%v: unexpected eigenvalue %d, got %v, want %v", name, i, v, want[i])
+			break
+		}
+	}
+}
+
+// symTridiagMaxAbs returns the largest absolute value of the elements of the
+// symmetric tridiagonal matrix with diagonal d and off-diagonal e, or 1 if the
+// matrix is zero.
+func symTridiagMaxAbs(d, e []float64) float64 {
+	var anorm float64
+	for _, v := range d {
+		anorm = math.Max(anorm, math.Abs(v))
+	}
+	for _, v := range e {
+		anorm = math.Max(anorm, math.Abs(v))
+	}
+	if anorm == 0 {
+		return 1
+	}
+	return anorm
+}
+
+// evRangeTestBounds returns the arguments that select a random subset of the
+// eigenvalues ev, sorted in ascending order, according to rng, and the indices
+// of the first and last selected eigenvalue. Interval end points are chosen to
+// be well separated from the eigenvalues relative to anorm.
+func evRangeTestBounds(rng lapack.EVRange, ev []float64, anorm float64, rnd *rand.Rand) (vl, vu float64, il, iu, first, last int) {
+	n := len(ev)
+	if n == 0 {
+		if rng == lapack.EVRangeValue {
+			return 0, 1, 0, -1, 0, -1
+		}
+		return 0, 0, 0, -1, 0, -1
+	}
+	first = rnd.Intn(n)
+	last = first + rnd.Intn(n-first)
+	switch rng {
+	case lapack.EVRangeAll:
+		return 0, 0, 0, 0, 0, n - 1
+	case lapack.EVRangeIndex:
+		return 0, 0, first, last, first, last
+	}
+	// Extend the selection until it is bounded by sufficiently large gaps.
+	gap := 1e-6 * anorm
+	for first > 0 && ev[first]-ev[first-1] <= gap {
+		first--
+	}
+	for last < n-1 && ev[last+1]-ev[last] <= gap {
+		last++
+	}
+	if first == 0 {
+		vl = ev[0] - anorm
+	} else {
+		vl = (ev[first-1] + ev[first]) / 2
+	}
+	if last == n-1 {
+		vu = ev[n-1] + anorm
+	} else {
+		vu = (ev[last] + ev[last+1]) / 2
+	}
+	return vl, vu, 0, 0, first, last
+}
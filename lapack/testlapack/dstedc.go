@@ -0,0 +1,195 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstedcer interface {
+	Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dsteqrer
+}
+
+func DstedcTest(t *testing.T, impl Dstedcer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, compz := range []lapack.EVComp{lapack.EVCompNone, lapack.EVTridiag, lapack.EVOrig} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25, 26, 27, 50, 51, 100, 129} {
+			for _, ldz := range []int{max(1, n), n + 4} {
+				for typ := 0; typ <= 7; typ++ {
+					for _, extra := range []int{0, 11} {
+						dstedcTest(t, impl, rnd, compz, n, ldz, typ, extra)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dstedcTest(t *testing.T, impl Dstedcer, rnd *rand.Rand, compz lapack.EVComp, n, ldz, typ, extra int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("compz=%v,n=%v,ldz=%v,type=%v,extra=%v", string(compz), n, ldz, typ, extra)
+
+	d, e := symTridiagTestMatrix(typ, n, rnd)
+
+	// Construct the full matrix whose eigendecomposition is computed.
+	lda := max(1, n)
+	a := make([]float64, n*lda)
+	z := nanSlice(max(0, (n-1)*ldz+n))
+	if compz == lapack.EVOrig {
+		// Reduce a random symmetric matrix to tridiagonal form and generate
+		// the orthogonal matrix used in the reduction.
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				v := rnd.NormFloat64()
+				a[i*lda+j] = v
+				a[j*lda+i] = v
+			}
+		}
+		for i := 0; i < n; i++ {
+			copy(z[i*ldz:i*ldz+n], a[i*lda:i*lda+n])
+		}
+		tau := make([]float64, max(0, n-1))
+		work := make([]float64, 1)
+		impl.Dsytrd(blas.Upper, n, z, ldz, d, e, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		impl.Dsytrd(blas.Upper, n, z, ldz, d, e, tau, work, len(work))
+		impl.Dorgtr(blas.Upper, n, z, ldz, tau, work, len(work))
+	} else {
+		for i := 0; i < n; i++ {
+			a[i*lda+i] = d[i]
+			if i < n-1 {
+				a[i*lda+i+1] = e[i]
+				a[(i+1)*lda+i] = e[i]
+			}
+		}
+	}
+
+	// Compute the eigenvalues using Dsteqr for comparison.
+	dWant := make([]float64, n)
+	copy(dWant, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+	impl.Dsteqr(lapack.EVCompNone, n, dWant, eCopy, nil, 1, nil)
+
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	impl.Dstedc(compz, n, d, e, z, ldz, work, -1, iwork, -1)
+	lwork := int(work[0]) + extra
+	liwork := iwork[0] + extra
+	work = nanSlice(lwork)
+	iwork = make([]int, liwork)
+
+	ok := impl.Dstedc(compz, n, d, e, z, ldz, work, lwork, iwork, liwork)
+	if !ok {
+		t.Errorf("%v: Dstedc failed", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.Float64sAreSorted(d) {
+		t.Errorf("%v: eigenvalues not sorted", name)
+	}
+	anorm := math.Max(dlange(lapack.MaxAbs, n, n, a, lda), 1)
+	for i, v := range d {
+		if math.Abs(v-dWant[i]) > tol*float64(n)*anorm {
+			t.Errorf("%v: unexpected eigenvalue %d, got %v, want %v", name, i, v, dWant[i])
+			break
+		}
+	}
+
+	if compz == lapack.EVCompNone {
+		return
+	}
+	zMat := blas64.General{Rows: n, Cols: n, Stride: ldz, Data: z}
+	if resid := residualOrthogonal(zMat, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+	if resid := residualSymEigen(n, n, a, lda, d, z, ldz); resid > tol {
+		t.Errorf("%v: unexpected eigendecomposition; resid=%v, want<=%v", name, resid, tol)
+	}
+}
+
+// symTridiagTestMatrix returns the diagonal and off-diagonal elements of an
+// n×n symmetric tridiagonal test matrix of the given type.
+func symTridiagTestMatrix(typ, n int, rnd *rand.Rand) (d, e []float64) {
+	d = make([]float64, n)
+	e = make([]float64, max(0, n-1))
+	switch typ {
+	case 0:
+		// Zero matrix.
+	case 1:
+		// Random matrix.
+		for i := range d {
+			d[i] = 2*rnd.Float64() - 1
+		}
+		for i := range e {
+			e[i] = 2*rnd.Float64() - 1
+		}
+	case 2:
+		// Diagonal matrix with repeated eigenvalues.
+		for i := range d {
+			d[i] = float64(rnd.Intn(3))
+		}
+	case 3:
+		// Wilkinson matrix with pairs of close eigenvalues.
+		m := float64(n-1) / 2
+		for i := range d {
+			d[i] = math.Abs(m - float64(i))
+		}
+		for i := range e {
+			e[i] = 1
+		}
+	case 4:
+		// Path graph Laplacian-like matrix with known eigenvalues.
+		for i := range d {
+			d[i] = 2
+		}
+		for i := range e {
+			e[i] = -1
+		}
+	case 5:
+		// Random matrix that splits into independent blocks.
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		for i := range e {
+			if rnd.Intn(7) != 0 {
+				e[i] = rnd.NormFloat64()
+			}
+		}
+	case 6:
+		// Random matrix with a large norm.
+		for i := range d {
+			d[i] = 1e150 * rnd.NormFloat64()
+		}
+		for i := range e {
+			e[i] = 1e150 * rnd.NormFloat64()
+		}
+	case 7:
+		// Random matrix with a small norm.
+		for i := range d {
+			d[i] = 1e-150 * rnd.NormFloat64()
+		}
+		for i := range e {
+			e[i] = 1e-150 * rnd.NormFloat64()
+		}
+	default:
+		panic("testlapack: bad matrix type")
+	}
+	return d, e
+}
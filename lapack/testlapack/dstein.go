@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsteiner interface {
+	Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int, z []float64, ldz int, work []float64, iwork, ifail []int) (ok bool)
+	Dstebzer
+}
+
+func DsteinTest(t *testing.T, impl Dsteiner) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, rng := range []lapack.EVRange{lapack.EVRangeAll, lapack.EVRangeIndex} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50, 100} {
+			// The matrix type with a small norm is not used, see
+			// DstebzTest.
+			for typ := 0; typ <= 6; typ++ {
+				for _, extra := range []int{0, 5} {
+					dsteinTest(t, impl, rnd, rng, n, typ, extra)
+				}
+			}
+		}
+	}
+}
+
+func dsteinTest(t *testing.T, impl Dsteiner, rnd *rand.Rand, rng lapack.EVRange, n, typ, extra int) {
+	const tol = 1e-13
+
+	d, e := symTridiagTestMatrix(typ, n, rnd)
+
+	// Construct the full matrix.
+	lda := max(1, n)
+	a := make([]float64, n*lda)
+	for i := 0; i < n; i++ {
+		a[i*lda+i] = d[i]
+		if i < n-1 {
+			a[i*lda+i+1] = e[i]
+			a[(i+1)*lda+i] = e[i]
+		}
+	}
+
+	// Compute the eigenvalues by bisection.
+	var il, iu int
+	if n > 0 {
+		il = rnd.Intn(n)
+		iu = il + rnd.Intn(n-il)
+	} else {
+		iu = -1
+	}
+	w := make([]float64, n)
+	iblock := make([]int, n)
+	isplit := make([]int, n)
+	m, _ := impl.Dstebz(rng, n, 0, 0, il, iu, 0, d, e, w, iblock, isplit, make([]float64, n))
+
+	name := fmt.Sprintf("range=%v,n=%v,type=%v,m=%v,extra=%v", string(rng), n, typ, m, extra)
+
+	ldz := max(1, m) + extra
+	z := nanSlice(max(0, (n-1)*ldz+m))
+	work := nanSlice(5 * n)
+	iwork := make([]int, n)
+	ifail := make([]int, m)
+	for i := range ifail {
+		ifail[i] = 123
+	}
+	ok := impl.Dstein(n, d, e, m, w, iblock, isplit, z, ldz, work, iwork, ifail)
+	if !ok {
+		t.Errorf("%v: Dstein failed, ifail=%v", name, ifail)
+		return
+	}
+	for _, v := range ifail {
+		if v != -1 {
+			t.Errorf("%v: unexpected ifail=%v", name, ifail)
+			break
+		}
+	}
+
+	zMat := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+	if resid := residualOrthogonal(zMat, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+	if resid := residualSymEigen(n, m, a, lda, w, z, ldz); resid > tol {
+		t.Errorf("%v: unexpected eigendecomposition; resid=%v, want<=%v", name, resid, tol)
+	}
+}
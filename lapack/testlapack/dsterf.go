@@ -65,7 +65,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 	rnd := rand.New(rand.NewSource(1))
 	// Probabilistic tests.
 	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 50} {
-		for typ := 0; typ <= 8; typ++ {
+		for typ := 0; typ <= 10; typ++ {
 			d := make([]float64, n)
 			var e []float64
 			if n > 1 {
@@ -134,7 +134,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 				for i := range d {
 					d[i] = rnd.NormFloat64()
 				}
-			case 8:
+			case 8, 9, 10:
 				// Random symmetric tridiagonal matrix.
 				for i := range d {
 					d[i] = rnd.NormFloat64()
@@ -142,6 +142,18 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 				for i := range e {
 					e[i] = rnd.NormFloat64()
 				}
+				// Scale so that the unreduced blocks of A are
+				// scaled by Dsterf.
+				switch typ {
+				case 9:
+					// Multiply by SQRT(overflow threshold).
+					floats.Scale(math.Sqrt(1/dlamchS), d)
+					floats.Scale(math.Sqrt(1/dlamchS), e)
+				case 10:
+					// Multiply by SQRT(underflow threshold).
+					floats.Scale(math.Sqrt(dlamchS), d)
+					floats.Scale(math.Sqrt(dlamchS), e)
+				}
 			}
 			eCopy := make([]float64, len(e))
 			copy(eCopy, e)
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyconer interface {
+	Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+
+	Dsytrfer
+	Dgetrier
+	Dlanger
+}
+
+func DsyconTest(t *testing.T, impl Dsyconer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				dsyconTest(t, impl, rnd, uplo, n, lda)
+			}
+		}
+	}
+}
+
+func dsyconTest(t *testing.T, impl Dsyconer, rnd *rand.Rand, uplo blas.Uplo, n, lda int) {
+	const ratioThresh = 10
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v", string(uplo), n, lda)
+
+	// Generate a random symmetric indefinite matrix and store it also in
+	// full form.
+	a := randomSymIndefinite(n, lda, rnd)
+	aFull := make([]float64, len(a))
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aFull[i*lda+j] = a[i*lda+j]
+		}
+	}
+
+	// Allocate work slices.
+	iwork := make([]int, n)
+	work := make([]float64, max(1, 4*n))
+
+	// Compute the inverse A^{-1} from the LU factorization of A.
+	aInv := make([]float64, len(aFull))
+	copy(aInv, aFull)
+	ipivLU := make([]int, n)
+	ok := impl.Dgetrf(n, n, aInv, lda, ipivLU)
+	if !ok {
+		t.Fatalf("%v: bad matrix, Dgetrf failed", name)
+	}
+	ok = impl.Dgetri(n, aInv, lda, ipivLU, work, len(work))
+	if !ok {
+		t.Fatalf("%v: bad matrix, Dgetri failed", name)
+	}
+
+	// Compute the norm of A and A^{-1}.
+	aNorm := impl.Dlange(lapack.MaxColumnSum, n, n, aFull, lda, work)
+	aInvNorm := impl.Dlange(lapack.MaxColumnSum, n, n, aInv, lda, work)
+
+	// Compute a good estimate of the condition number
+	//  rcondWant := 1/(norm(A) * norm(inv(A)))
+	rcondWant := 1.0
+	if aNorm > 0 && aInvNorm > 0 {
+		rcondWant = 1 / aNorm / aInvNorm
+	}
+
+	// Compute the Bunch-Kaufman factorization of A.
+	ipiv := make([]int, n)
+	ok = impl.Dsytrf(uplo, n, a, lda, ipiv, work, len(work))
+	if !ok {
+		t.Fatalf("%v: bad matrix, Dsytrf failed", name)
+	}
+	aFac := make([]float64, len(a))
+	copy(aFac, a)
+
+	// Compute an estimate of rcond using the factorization and Dsycon.
+	rcondGot := impl.Dsycon(uplo, n, a, lda, ipiv, aNorm, work, iwork)
+	if !floats.Same(a, aFac) {
+		t.Errorf("%v: unexpected modification of a", name)
+	}
+
+	ratio := rCondTestRatio(rcondGot, rcondWant)
+	if ratio >= ratioThresh {
+		t.Errorf("%v: unexpected value of rcond; got=%v, want=%v (ratio=%v)",
+			name, rcondGot, rcondWant, ratio)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevder interface {
+	Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+}
+
+func DsyevdTest(t *testing.T, impl Dsyevder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 25, 26, 50, 101} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for typ := 0; typ <= 3; typ++ {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						dsyevdTest(t, impl, rnd, uplo, n, lda, typ, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsyevdTest(t *testing.T, impl Dsyevder, rnd *rand.Rand, uplo blas.Uplo, n, lda, typ int, wl worklen) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,type=%v,work=%v", string(uplo), n, lda, typ, wl)
+
+	a := randomSymEigenTestMatrix(typ, n, lda, rnd)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	// Compute the eigenvalues and eigenvectors.
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	impl.Dsyevd(lapack.EVCompute, uplo, n, a, lda, nil, work, -1, iwork, -1)
+	lwork, liwork := dsyevdWork(lapack.EVCompute, n, int(work[0]), iwork[0], wl)
+	work = nanSlice(lwork)
+	iwork = make([]int, liwork)
+	w := nanSlice(n)
+	ok := impl.Dsyevd(lapack.EVCompute, uplo, n, a, lda, w, work, lwork, iwork, liwork)
+	if !ok {
+		t.Errorf("%v: Dsyevd failed", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.Float64sAreSorted(w) {
+		t.Errorf("%v: eigenvalues not sorted", name)
+	}
+	z := blas64.General{Rows: n, Cols: n, Stride: lda, Data: a}
+	if resid := residualOrthogonal(z, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+	if resid := residualSymEigen(n, n, aCopy, lda, w, a, lda); resid > tol {
+		t.Errorf("%v: unexpected eigendecomposition; resid=%v, want<=%v", name, resid, tol)
+	}
+
+	// Compute only the eigenvalues and compare with the eigenvalues computed
+	// with eigenvectors.
+	copy(a, aCopy)
+	work = make([]float64, 1)
+	impl.Dsyevd(lapack.EVNone, uplo, n, a, lda, nil, work, -1, iwork, -1)
+	lwork, liwork = dsyevdWork(lapack.EVNone, n, int(work[0]), iwork[0], wl)
+	work = nanSlice(lwork)
+	iwork = make([]int, liwork)
+	wNone := nanSlice(n)
+	ok = impl.Dsyevd(lapack.EVNone, uplo, n, a, lda, wNone, work, lwork, iwork, liwork)
+	if !ok {
+		t.Errorf("%v: Dsyevd failed when eigenvectors not computed", name)
+		return
+	}
+	anorm := math.Max(dlange(lapack.MaxAbs, n, n, aCopy, lda), 1)
+	if !floats.EqualApprox(w, wNone, tol*float64(n)*anorm) {
+		t.Errorf("%v: eigenvalue mismatch when eigenvectors not computed", name)
+	}
+}
+
+// dsyevdWork returns the workspace lengths to use with Dsyevd for the given
+// optimal lengths.
+func dsyevdWork(jobz lapack.EVJob, n, lopt, liopt int, wl worklen) (lwork, liwork int) {
+	lwmin := 2*n + 1
+	if jobz == lapack.EVCompute {
+		lwmin = 1 + 6*n + 2*n*n
+	}
+	if n <= 1 {
+		lwmin = 1
+	}
+	switch wl {
+	case minimumWork:
+		lwork = lwmin
+	case mediumWork:
+		lwork = (lwmin + lopt) / 2
+	case optimumWork:
+		lwork = lopt
+	}
+	return lwork, liopt
+}
+
+// randomSymEigenTestMatrix returns an n×n symmetric matrix of the given type
+// stored in full.
+func randomSymEigenTestMatrix(typ, n, lda int, rnd *rand.Rand) []float64 {
+	a := make([]float64, max(0, (n-1)*lda+n))
+	switch typ {
+	case 0:
+		// Zero matrix.
+	case 1:
+		// Random matrix.
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				v := rnd.NormFloat64()
+				a[i*lda+j] = v
+				a[j*lda+i] = v
+			}
+		}
+	case 2, 3:
+		d := make([]float64, n)
+		if typ == 2 {
+			// Random matrix with clustered eigenvalues.
+			for i := range d {
+				d[i] = float64(rnd.Intn(4)) + 1e-10*rnd.NormFloat64()
+			}
+		} else {
+			// Random matrix with geometrically distributed eigenvalues.
+			for i := range d {
+				d[i] = math.Pow(10, -8*float64(i)/float64(max(1, n-1)))
+				if rnd.Intn(2) == 0 {
+					d[i] *= -1
+				}
+			}
+		}
+		Dlagsy(n, max(0, n-1), d, a, lda, rnd, make([]float64, 2*n))
+	default:
+		panic("testlapack: bad matrix type")
+	}
+	return a
+}
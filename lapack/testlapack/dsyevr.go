@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevrer interface {
+	Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Dsyevder
+}
+
+func DsyevrTest(t *testing.T, impl Dsyevrer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 25, 26, 50, 101} {
+			for typ := 0; typ <= 3; typ++ {
+				for _, rng := range []lapack.EVRange{lapack.EVRangeAll, lapack.EVRangeValue, lapack.EVRangeIndex} {
+					for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+						for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+							dsyevrTest(t, impl, rnd, jobz, rng, uplo, n, typ, wl)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsyevrTest(t *testing.T, impl Dsyevrer, rnd *rand.Rand, jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n, typ int, wl worklen) {
+	const tol = 1e-13
+
+	lda := n + 3
+	a := randomSymEigenTestMatrix(typ, n, lda, rnd)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	// Compute all the eigenvalues using Dsyevd for comparison.
+	wWant := make([]float64, n)
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	impl.Dsyevd(lapack.EVNone, uplo, n, a, lda, wWant, work, -1, iwork, -1)
+	work = make([]float64, int(work[0]))
+	iwork = make([]int, iwork[0])
+	impl.Dsyevd(lapack.EVNone, uplo, n, a, lda, wWant, work, len(work), iwork, len(iwork))
+	copy(a, aCopy)
+
+	anorm := math.Max(dlange(lapack.MaxAbs, n, n, aCopy, lda), 1)
+	vl, vu, il, iu, first, last := evRangeTestBounds(rng, wWant, anorm, rnd)
+
+	name := fmt.Sprintf("jobz=%v,range=%v,uplo=%v,n=%v,type=%v,work=%v,vl=%v,vu=%v,il=%v,iu=%v",
+		string(jobz), string(rng), string(uplo), n, typ, wl, vl, vu, il, iu)
+
+	mmax := n
+	if rng == lapack.EVRangeIndex {
+		mmax = iu - il + 1
+	}
+	ldz := max(1, mmax) + 2
+	z := nanSlice(max(0, (n-1)*ldz+mmax))
+
+	work = make([]float64, 1)
+	iwork = make([]int, 1)
+	impl.Dsyevr(jobz, rng, uplo, n, a, lda, vl, vu, il, iu, 0, nil, z, ldz, work, -1, iwork, -1)
+	lwork := dsyevrWork(jobz, rng, n, il, iu, int(work[0]), wl)
+	liwork := iwork[0]
+	work = nanSlice(lwork)
+	iwork = make([]int, liwork)
+	w := nanSlice(n)
+
+	m, ok := impl.Dsyevr(jobz, rng, uplo, n, a, lda, vl, vu, il, iu, 0, w, z, ldz, work, lwork, iwork, liwork)
+	if !ok {
+		t.Errorf("%v: Dsyevr failed", name)
+		return
+	}
+
+	want := wWant[first : last+1]
+	if m != len(want) {
+		t.Errorf("%v: unexpected number of eigenvalues, got %v, want %v", name, m, len(want))
+		return
+	}
+	if !sort.Float64sAreSorted(w[:m]) {
+		t.Errorf("%v: eigenvalues not sorted", name)
+	}
+	for i, v := range w[:m] {
+		if math.Abs(v-want[i]) > tol*float64(n)*anorm {
+			t.Errorf("%v: unexpected eigenvalue %d, got %v, want %v", name, i, v, want[i])
+			break
+		}
+	}
+
+	if jobz == lapack.EVNone {
+		return
+	}
+	zMat := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+	if resid := residualOrthogonal(zMat, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+	if resid := residualSymEigen(n, m, aCopy, lda, w, z, ldz); resid > tol {
+		t.Errorf("%v: unexpected eigendecomposition; resid=%v, want<=%v", name, resid, tol)
+	}
+}
+
+// dsyevrWork returns the workspace length to use with Dsyevr for the given
+// optimal length.
+func dsyevrWork(jobz lapack.EVJob, rng lapack.EVRange, n, il, iu, lopt int, wl worklen) int {
+	var lwmin int
+	switch {
+	case n <= 1:
+		lwmin = 1
+	case jobz == lapack.EVNone:
+		lwmin = 5 * n
+	case rng == lapack.EVRangeAll || rng == lapack.EVRangeIndex && il == 0 && iu == n-1:
+		lwmin = 1 + 8*n + n*n
+	default:
+		lwmin = 9 * n
+	}
+	switch wl {
+	case minimumWork:
+		return lwmin
+	case mediumWork:
+		return (lwmin + lopt) / 2
+	}
+	return lopt
+}
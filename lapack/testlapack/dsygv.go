@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsygver interface {
+	Dsygv(itype lapack.GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) bool
+}
+
+func DsygvTest(t *testing.T, impl Dsygver) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, itype := range []lapack.GenEVType{lapack.GenEVAxBx, lapack.GenEVABx, lapack.GenEVBAx} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, n := range []int{0, 1, 2, 3, 5, 10, 33, 70, 101} {
+				for _, extra := range []int{0, 5} {
+					for _, wl := range []worklen{minimumWork, optimumWork} {
+						dsygvTest(t, impl, rnd, itype, uplo, n, extra, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsygvTest(t *testing.T, impl Dsygver, rnd *rand.Rand, itype lapack.GenEVType, uplo blas.Uplo, n, extra int, wl worklen) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("itype=%c,uplo=%v,n=%v,extra=%v,work=%v", itype, string(uplo), n, extra, wl)
+
+	// Generate a random symmetric matrix A and a random symmetric positive
+	// definite matrix B.
+	a := randomGeneral(n, n, n+extra, rnd)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			a.Data[j*a.Stride+i] = a.Data[i*a.Stride+j]
+		}
+	}
+	g := randomGeneral(n, n, n, rnd)
+	b := zeros(n, n, n+extra)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, g, g, 0, b)
+	for i := 0; i < n; i++ {
+		b.Data[i*b.Stride+i] += float64(n)
+	}
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 3*n-1)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dsygv(itype, lapack.EVCompute, uplo, n, nil, max(1, a.Stride), nil, max(1, b.Stride), nil, work, -1)
+		lwork = int(work[0])
+	}
+
+	// Compute the eigenvalues only.
+	aWork := cloneGeneral(a)
+	bWork := cloneGeneral(b)
+	wNone := nanSlice(n)
+	work := nanSlice(lwork)
+	ok := impl.Dsygv(itype, lapack.EVNone, uplo, n, aWork.Data, max(1, aWork.Stride), bWork.Data, max(1, bWork.Stride), wNone, work, lwork)
+	if !ok {
+		t.Errorf("%v: Dsygv failed with jobz=EVNone", name)
+		return
+	}
+
+	// Compute the eigenvalues and eigenvectors.
+	w := nanSlice(n)
+	work = nanSlice(lwork)
+	ok = impl.Dsygv(itype, lapack.EVCompute, uplo, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), w, work, lwork)
+	if !ok {
+		t.Errorf("%v: Dsygv failed with jobz=EVCompute", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.Float64sAreSorted(w) {
+		t.Errorf("%v: eigenvalues are not sorted", name)
+	}
+	if !floats.EqualApprox(w, wNone, tol*float64(n)*math.Max(1, math.Abs(w[n-1])+math.Abs(w[0]))) {
+		t.Errorf("%v: eigenvalues differ with and without eigenvectors", name)
+	}
+
+	// Check that the Cholesky factor of B is returned in the triangle
+	// specified by uplo.
+	u := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			if uplo == blas.Upper {
+				u.Data[i*n+j] = b.Data[i*b.Stride+j]
+			} else {
+				u.Data[i*n+j] = b.Data[j*b.Stride+i]
+			}
+		}
+	}
+	utu := zeros(n, n, n)
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, u, u, 0, utu)
+	if !equalApproxGeneral(utu, trimStride(bCopy), tol*float64(n)*float64(n)) {
+		t.Errorf("%v: B does not contain the Cholesky factor", name)
+	}
+
+	z := trimStride(a)
+	wz := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			wz.Data[i*n+j] = z.Data[i*n+j] * w[j]
+		}
+	}
+	lhs := zeros(n, n, n)
+	rhs := zeros(n, n, n)
+	tmp := zeros(n, n, n)
+	norm := zeros(n, n, n)
+	switch itype {
+	case lapack.GenEVAxBx:
+		// Check that A*Z = B*Z*Λ and Zᵀ*B*Z = I.
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aCopy, z, 0, lhs)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, bCopy, wz, 0, rhs)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, bCopy, z, 0, tmp)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, z, tmp, 0, norm)
+	case lapack.GenEVABx:
+		// Check that A*B*Z = Z*Λ and Zᵀ*B*Z = I.
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, bCopy, z, 0, tmp)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aCopy, tmp, 0, lhs)
+		copyGeneral(rhs, wz)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, z, tmp, 0, norm)
+	case lapack.GenEVBAx:
+		// Check that B*A*Z = Z*Λ and Zᵀ*inv(B)*Z = I, the latter using
+		// inv(B) = inv(U)*inv(Uᵀ).
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aCopy, z, 0, tmp)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, bCopy, tmp, 0, lhs)
+		copyGeneral(rhs, wz)
+		copyGeneral(tmp, z)
+		blas64.Trsm(blas.Left, blas.Trans, 1, blas64.Triangular{
+			Uplo: blas.Upper, Diag: blas.NonUnit, N: n, Stride: n, Data: u.Data,
+		}, tmp)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, tmp, tmp, 0, norm)
+	}
+	scale := math.Max(1, dlange(lapack.MaxColumnSum, n, n, lhs.Data, n))
+	for i := range lhs.Data {
+		lhs.Data[i] -= rhs.Data[i]
+	}
+	if resid := dlange(lapack.MaxColumnSum, n, n, lhs.Data, n) / scale; resid > tol*float64(n) {
+		t.Errorf("%v: eigenvector residual too large; resid=%v", name, resid)
+	}
+	if resid := distFromIdentity(n, norm.Data, n); resid > tol*float64(n) {
+		t.Errorf("%v: eigenvectors not normalized; resid=%v", name, resid)
+	}
+}
+
+// trimStride returns a copy of the n×n matrix a with stride n.
+func trimStride(a blas64.General) blas64.General {
+	n := a.Rows
+	c := zeros(n, a.Cols, a.Cols)
+	copyGeneral(c, a)
+	return c
+}
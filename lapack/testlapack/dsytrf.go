@@ -0,0 +1,259 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dsytrfer interface {
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool)
+}
+
+func DsytrfTest(t *testing.T, impl Dsytrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 63, 64, 65, 100, 129, 200} {
+			for _, lda := range []int{max(1, n), n + 7} {
+				for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+					dsytrfTest(t, impl, rnd, uplo, n, lda, wl)
+				}
+			}
+		}
+	}
+}
+
+func dsytrfTest(t *testing.T, impl Dsytrfer, rnd *rand.Rand, uplo blas.Uplo, n, lda int, wl worklen) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,work=%v", string(uplo), n, lda, wl)
+
+	// Generate a random symmetric indefinite matrix.
+	a := randomSymIndefinite(n, lda, rnd)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	ipiv := make([]int, n)
+	for i := range ipiv {
+		ipiv[i] = -1
+	}
+
+	work := make([]float64, 1)
+	impl.Dsytrf(uplo, n, a, lda, ipiv, work, -1)
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = 1
+	case mediumWork:
+		lwork = max(1, int(work[0])/2)
+	case optimumWork:
+		lwork = int(work[0])
+	}
+	work = make([]float64, lwork)
+
+	ok := impl.Dsytrf(uplo, n, a, lda, ipiv, work, lwork)
+	if !ok {
+		t.Errorf("%v: unexpected singular D for a random matrix", name)
+		return
+	}
+
+	if !checkSytrfPivots(uplo, ipiv) {
+		t.Errorf("%v: invalid ipiv %v", name, ipiv)
+		return
+	}
+
+	resid := dsytrfResidual(uplo, n, a, lda, ipiv, aCopy, lda)
+	if resid > tol {
+		t.Errorf("%v: unexpected factorization residual |A - U*D*Uᵀ|/(n*|A|)=%v", name, resid)
+	}
+}
+
+// randomSymIndefinite returns a random symmetric n×n matrix with both
+// positive and negative eigenvalues.
+func randomSymIndefinite(n, lda int, rnd *rand.Rand) []float64 {
+	a := make([]float64, max(0, (n-1)*lda+n))
+	for i := range a {
+		a[i] = math.NaN()
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := rnd.NormFloat64()
+			a[i*lda+j] = v
+			a[j*lda+i] = v
+		}
+	}
+	return a
+}
+
+// checkSytrfPivots returns whether ipiv describes a valid block structure of
+// D as returned by Dsytrf.
+func checkSytrfPivots(uplo blas.Uplo, ipiv []int) bool {
+	n := len(ipiv)
+	if uplo == blas.Upper {
+		for k := n - 1; k >= 0; k-- {
+			if ipiv[k] >= 0 {
+				if ipiv[k] > k {
+					return false
+				}
+				continue
+			}
+			if k == 0 || ipiv[k-1] != ipiv[k] || -ipiv[k]-1 > k-1 {
+				return false
+			}
+			k--
+		}
+		return true
+	}
+	for k := 0; k < n; k++ {
+		if ipiv[k] >= 0 {
+			if ipiv[k] < k || ipiv[k] >= n {
+				return false
+			}
+			continue
+		}
+		if k == n-1 || ipiv[k+1] != ipiv[k] || -ipiv[k]-1 < k+1 || -ipiv[k]-1 >= n {
+			return false
+		}
+		k++
+	}
+	return true
+}
+
+// dsytrfResidual returns the residual
+//  |A - U*D*Uᵀ| / (n * |A|)  or  |A - L*D*Lᵀ| / (n * |A|)
+// where U*D*Uᵀ and L*D*Lᵀ are given by the Bunch-Kaufman factorization in a
+// and ipiv as computed by Dsytrf, and the upper or lower triangle of aOrig
+// contains the original symmetric matrix A. The 1-norm is used.
+func dsytrfResidual(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, aOrig []float64, ldaOrig int) float64 {
+	if n == 0 {
+		return 0
+	}
+	u, d := constructSytrfFactors(uplo, n, a, lda, ipiv)
+
+	// Compute U*D*Uᵀ - A.
+	bi := blas64.Implementation()
+	ud := zeros(n, n, n)
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, u.Data, u.Stride, d.Data, d.Stride, 0, ud.Data, ud.Stride)
+	resid := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper) == (i <= j) {
+				resid.Data[i*n+j] = aOrig[i*ldaOrig+j]
+			} else {
+				resid.Data[i*n+j] = aOrig[j*ldaOrig+i]
+			}
+		}
+	}
+	bi.Dgemm(blas.NoTrans, blas.Trans, n, n, n, 1, ud.Data, ud.Stride, u.Data, u.Stride, -1, resid.Data, resid.Stride)
+
+	anorm := norm1(uplo, n, aOrig, ldaOrig)
+	rnorm := norm1(blas.All, n, resid.Data, resid.Stride)
+	if anorm == 0 {
+		return rnorm
+	}
+	return rnorm / anorm / float64(n)
+}
+
+// norm1 returns the 1-norm of the n×n matrix in a. If uplo is not blas.All,
+// a is taken to be symmetric with its elements in the given triangle.
+func norm1(uplo blas.Uplo, n int, a []float64, lda int) float64 {
+	var value float64
+	for j := 0; j < n; j++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			switch {
+			case uplo == blas.All, uplo == blas.Upper && i <= j, uplo == blas.Lower && i >= j:
+				sum += math.Abs(a[i*lda+j])
+			default:
+				sum += math.Abs(a[j*lda+i])
+			}
+		}
+		value = math.Max(value, sum)
+	}
+	return value
+}
+
+// constructSytrfFactors returns the explicit factors U (or L) and D from the
+// Bunch-Kaufman factorization computed by Dsytrf.
+func constructSytrfFactors(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) (u, d blas64.General) {
+	u = eye(n, n)
+	d = zeros(n, n, n)
+	bi := blas64.Implementation()
+	if uplo == blas.Upper {
+		// U = P_{n-1} * U_{n-1} * ... * P_k * U_k * ...
+		for k := n - 1; k >= 0; {
+			s := 1
+			kp := ipiv[k]
+			if kp < 0 {
+				s = 2
+				kp = -kp - 1
+			}
+			kk := k - s + 1
+			// Multiply by P_k from the right.
+			if kp != kk {
+				bi.Dswap(n, u.Data[kk:], u.Stride, u.Data[kp:], u.Stride)
+			}
+			// Multiply by U_k from the right.
+			for c := kk; c <= k; c++ {
+				for i := 0; i < n; i++ {
+					var sum float64
+					for l := 0; l < kk; l++ {
+						sum += u.Data[i*u.Stride+l] * a[l*lda+c]
+					}
+					u.Data[i*u.Stride+c] += sum
+				}
+			}
+			// Extract D_k.
+			d.Data[k*d.Stride+k] = a[k*lda+k]
+			if s == 2 {
+				d.Data[(k-1)*d.Stride+k-1] = a[(k-1)*lda+k-1]
+				d.Data[(k-1)*d.Stride+k] = a[(k-1)*lda+k]
+				d.Data[k*d.Stride+k-1] = a[(k-1)*lda+k]
+			}
+			k -= s
+		}
+		return u, d
+	}
+	// L = P_0 * L_0 * ... * P_k * L_k * ...
+	for k := 0; k < n; {
+		s := 1
+		kp := ipiv[k]
+		if kp < 0 {
+			s = 2
+			kp = -kp - 1
+		}
+		kk := k + s - 1
+		// Multiply by P_k from the right.
+		if kp != kk {
+			bi.Dswap(n, u.Data[kk:], u.Stride, u.Data[kp:], u.Stride)
+		}
+		// Multiply by L_k from the right.
+		for c := k; c <= kk; c++ {
+			for i := 0; i < n; i++ {
+				var sum float64
+				for l := k + s; l < n; l++ {
+					sum += u.Data[i*u.Stride+l] * a[l*lda+c]
+				}
+				u.Data[i*u.Stride+c] += sum
+			}
+		}
+		// Extract D_k.
+		d.Data[k*d.Stride+k] = a[k*lda+k]
+		if s == 2 {
+			d.Data[(k+1)*d.Stride+k+1] = a[(k+1)*lda+k+1]
+			d.Data[(k+1)*d.Stride+k] = a[(k+1)*lda+k]
+			d.Data[k*d.Stride+k+1] = a[(k+1)*lda+k]
+		}
+		k += s
+	}
+	return u, d
+}
@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dsytrser interface {
+	Dsytrfer
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+}
+
+func DsytrsTest(t *testing.T, impl Dsytrser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50, 100} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, lda := range []int{max(1, n), n + 3} {
+					for _, ldb := range []int{max(1, nrhs), nrhs + 4} {
+						dsytrsTest(t, impl, rnd, uplo, n, nrhs, lda, ldb)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsytrsTest(t *testing.T, impl Dsytrser, rnd *rand.Rand, uplo blas.Uplo, n, nrhs, lda, ldb int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", string(uplo), n, nrhs, lda, ldb)
+
+	a := randomSymIndefinite(n, lda, rnd)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	// Generate a random right-hand side.
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	bCopy := cloneGeneral(b)
+
+	ipiv := make([]int, n)
+	work := make([]float64, 1)
+	impl.Dsytrf(uplo, n, a, lda, ipiv, work, -1)
+	work = make([]float64, int(work[0]))
+	ok := impl.Dsytrf(uplo, n, a, lda, ipiv, work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected singular D for a random matrix", name)
+		return
+	}
+
+	impl.Dsytrs(uplo, n, nrhs, a, lda, ipiv, b.Data, b.Stride)
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// Compute the residual |A*X - B| / (n * |A| * |X|).
+	full := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper) == (i <= j) {
+				full.Data[i*n+j] = aCopy[i*lda+j]
+			} else {
+				full.Data[i*n+j] = aCopy[j*lda+i]
+			}
+		}
+	}
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, full, b, -1, bCopy)
+	var rnorm float64
+	for j := 0; j < nrhs; j++ {
+		var rsum, xsum float64
+		for i := 0; i < n; i++ {
+			rsum += math.Abs(bCopy.Data[i*bCopy.Stride+j])
+			xsum += math.Abs(b.Data[i*b.Stride+j])
+		}
+		if xsum == 0 {
+			continue
+		}
+		rnorm = math.Max(rnorm, rsum/xsum)
+	}
+	anorm := norm1(uplo, n, aCopy, lda)
+	resid := rnorm / anorm / float64(n)
+	if resid > tol {
+		t.Errorf("%v: unexpected residual |A*X - B|/(n*|A|*|X|)=%v", name, resid)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtrsyler interface {
+	Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool)
+}
+
+func DtrsylTest(t *testing.T, impl Dtrsyler) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, trana := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tranb := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, isgn := range []int{1, -1} {
+				for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+					for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+						for _, extra := range []int{0, 3} {
+							for cas := 0; cas < 5; cas++ {
+								dtrsylTest(t, impl, rnd, trana, tranb, isgn, m, n, extra)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dtrsylTest(t *testing.T, impl Dtrsyler, rnd *rand.Rand, trana, tranb blas.Transpose, isgn, m, n, extra int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("trana=%v,tranb=%v,isgn=%v,m=%v,n=%v,extra=%v",
+		transToString(trana), transToString(tranb), isgn, m, n, extra)
+
+	a, _, _ := randomSchurCanonical(m, m+extra, false, rnd)
+	b, _, _ := randomSchurCanonical(n, n+extra, false, rnd)
+	// Shift the spectrum of A so that the equation is well-conditioned.
+	for i := 0; i < m; i++ {
+		a.Data[i*a.Stride+i] += 10
+	}
+	for i := 0; i < n; i++ {
+		b.Data[i*b.Stride+i] += float64(5 * isgn)
+	}
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	c := randomGeneral(m, n, n+extra, rnd)
+	cCopy := cloneGeneral(c)
+
+	scale, ok := impl.Dtrsyl(trana, tranb, isgn, m, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), c.Data, max(1, c.Stride))
+	if !ok {
+		t.Errorf("%v: unexpected perturbation", name)
+	}
+	if !equalGeneral(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !equalGeneral(b, bCopy) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	if !generalOutsideAllNaN(c) {
+		t.Errorf("%v: out-of-range write to C", name)
+	}
+	if scale <= 0 || scale > 1 {
+		t.Errorf("%v: invalid scale %v", name, scale)
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// Compute the residual
+	//  |op(A)*X + isgn*X*op(B) - scale*C| / ((|A| + |B|) * |X|).
+	r := zeros(m, n, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			r.Data[i*r.Stride+j] = -scale * cCopy.Data[i*cCopy.Stride+j]
+		}
+	}
+	blas64.Gemm(trana, blas.NoTrans, 1, a, c, 1, r)
+	blas64.Gemm(blas.NoTrans, tranb, float64(isgn), c, b, 1, r)
+	resid := dlange(lapack.MaxColumnSum, m, n, r.Data, r.Stride)
+	anorm := dlange(lapack.MaxColumnSum, m, m, a.Data, a.Stride)
+	bnorm := dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride)
+	xnorm := dlange(lapack.MaxColumnSum, m, n, c.Data, c.Stride)
+	resid /= (anorm + bnorm) * xnorm
+	if resid > tol || math.IsNaN(resid) {
+		t.Errorf("%v: unexpected residual %v", name, resid)
+	}
+}
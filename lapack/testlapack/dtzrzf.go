@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtzrzfer interface {
+	Dtzrzf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
+}
+
+func DtzrzfTest(t *testing.T, impl Dtzrzfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 40, 150} {
+		for _, extra := range []int{0, 1, 2, 5, 50} {
+			n := m + extra
+			for _, lda := range []int{max(1, n), n + 4} {
+				dtzrzfTest(t, impl, rnd, m, n, lda)
+			}
+		}
+	}
+}
+
+func dtzrzfTest(t *testing.T, impl Dtzrzfer, rnd *rand.Rand, m, n, lda int) {
+	const tol = 1e-14
+
+	// Generate a random upper trapezoidal matrix.
+	a := randomGeneral(m, n, lda, rnd)
+	for i := 0; i < m; i++ {
+		for j := 0; j < i; j++ {
+			a.Data[i*a.Stride+j] = 0
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	work := []float64{0}
+	impl.Dtzrzf(m, n, a.Data, a.Stride, nil, work, -1)
+	lwkopt := int(work[0])
+	for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+		name := fmt.Sprintf("m=%d,n=%d,lda=%d,work=%v", m, n, lda, wl)
+
+		var lwork int
+		switch wl {
+		case minimumWork:
+			lwork = max(1, m)
+		case mediumWork:
+			lwork = max(max(1, m), (max(1, m)+lwkopt)/2)
+		case optimumWork:
+			lwork = max(max(1, m), lwkopt)
+		}
+		work = nanSlice(lwork)
+		tau := nanSlice(m)
+
+		copyGeneral(a, aCopy)
+		impl.Dtzrzf(m, n, a.Data, a.Stride, tau, work, lwork)
+		if m == 0 {
+			continue
+		}
+
+		// Check that Z is orthogonal.
+		z := constructZ(m, n, a.Data, a.Stride, tau)
+		if resid := residualOrthogonal(z, false); resid > tol*float64(n) {
+			t.Errorf("%v: Z not orthogonal; resid=%v", name, resid)
+		}
+
+		// Check that A = [ R 0 ] * Z.
+		r := zeros(m, n, n)
+		for i := 0; i < m; i++ {
+			for j := i; j < m; j++ {
+				r.Data[i*r.Stride+j] = a.Data[i*a.Stride+j]
+			}
+		}
+		rz := cloneGeneral(aCopy)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, r, z, -1, rz)
+		anorm := dlange(lapack.MaxColumnSum, m, n, aCopy.Data, aCopy.Stride)
+		resid := dlange(lapack.MaxColumnSum, m, n, rz.Data, rz.Stride)
+		if resid > tol*float64(n)*anorm {
+			t.Errorf("%v: |[R 0]*Z - A|=%v, want<=%v", name, resid, tol*float64(n)*anorm)
+		}
+	}
+}
+
+// constructZ returns the n×n orthogonal matrix Z = H_0 * H_1 * ... * H_{k-1}
+// defined by the k elementary reflectors stored in the rows of a and tau as
+// returned by Dtzrzf.
+func constructZ(k, n int, a []float64, lda int, tau []float64) blas64.General {
+	z := eye(n, n)
+	u := make([]float64, n)
+	zu := make([]float64, n)
+	for i := 0; i < k; i++ {
+		for j := range u {
+			u[j] = 0
+		}
+		u[i] = 1
+		copy(u[k:], a[i*lda+k:i*lda+n])
+		// Z = Z * H_i = Z - tau[i] * (Z*u) * uᵀ.
+		blas64.Gemv(blas.NoTrans, 1, z, blas64.Vector{N: n, Data: u, Inc: 1}, 0, blas64.Vector{N: n, Data: zu, Inc: 1})
+		blas64.Ger(-tau[i], blas64.Vector{N: n, Data: zu, Inc: 1}, blas64.Vector{N: n, Data: u, Inc: 1}, z)
+	}
+	return z
+}
@@ -0,0 +1,245 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// factorBenchSizes are the orders of the matrices used in the benchmarks of
+// the LU, Cholesky and QR factorizations.
+var factorBenchSizes = []int{100, 250, 500, 1000, 2000, 4000}
+
+// factorBenchBlock is the block size used by the baseline blocked LU and
+// Cholesky factorizations.
+const factorBenchBlock = 64
+
+// DgetrfBenchmarker is the interface used by DgetrfBenchmark.
+type DgetrfBenchmarker interface {
+	Dgetrfer
+	Dgetf2er
+	Dlaswper
+}
+
+// DgetrfBenchmark benchmarks Dgetrf against a baseline blocked LU
+// factorization that uses the unblocked Dgetf2 for the panels.
+func DgetrfBenchmark(b *testing.B, impl DgetrfBenchmarker) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range factorBenchSizes {
+		aCopy := randomGeneral(n, n, n, rnd)
+		a := cloneGeneral(aCopy)
+		ipiv := make([]int, n)
+		for _, alg := range []struct {
+			name string
+			fn   func()
+		}{
+			{"Dgetrf", func() { impl.Dgetrf(n, n, a.Data, a.Stride, ipiv) }},
+			{"Dgetf2", func() { dgetrfDgetf2(impl, n, n, a.Data, a.Stride, ipiv, factorBenchBlock) }},
+		} {
+			b.Run(fmt.Sprintf("Alg=%s,N=%d", alg.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					copyGeneral(a, aCopy)
+					b.StartTimer()
+					alg.fn()
+				}
+				resultGeneral = a
+			})
+		}
+	}
+}
+
+// DpotrfBenchmarker is the interface used by DpotrfBenchmark.
+type DpotrfBenchmarker interface {
+	Dpotrfer
+	Dpotf2er
+}
+
+// DpotrfBenchmark benchmarks Dpotrf against a baseline blocked Cholesky
+// factorization that uses the unblocked Dpotf2 for the diagonal blocks.
+func DpotrfBenchmark(b *testing.B, impl DpotrfBenchmarker) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range factorBenchSizes {
+		// Construct a symmetric diagonally dominant matrix A with
+		// positive diagonal so that it is positive definite.
+		aCopy := randomGeneral(n, n, n, rnd)
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				aCopy.Data[j*n+i] = aCopy.Data[i*n+j]
+			}
+			aCopy.Data[i*n+i] = float64(2 * n)
+		}
+		a := cloneGeneral(aCopy)
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, alg := range []struct {
+				name string
+				fn   func()
+			}{
+				{"Dpotrf", func() { impl.Dpotrf(uplo, n, a.Data, a.Stride) }},
+				{"Dpotf2", func() { dpotrfDpotf2(impl, uplo, n, a.Data, a.Stride, factorBenchBlock) }},
+			} {
+				b.Run(fmt.Sprintf("Alg=%s,Uplo=%c,N=%d", alg.name, uplo, n), func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						b.StopTimer()
+						copyGeneral(a, aCopy)
+						b.StartTimer()
+						alg.fn()
+					}
+					resultGeneral = a
+				})
+			}
+		}
+	}
+}
+
+// DgeqrfBenchmarker is the interface used by DgeqrfBenchmark.
+type DgeqrfBenchmarker interface {
+	Dgeqrfer
+	Dlarfber
+}
+
+// DgeqrfBenchmark benchmarks Dgeqrf against a baseline blocked QR
+// factorization that updates the trailing matrix serially.
+func DgeqrfBenchmark(b *testing.B, impl DgeqrfBenchmarker) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range factorBenchSizes {
+		aCopy := randomGeneral(n, n, n, rnd)
+		a := cloneGeneral(aCopy)
+		tau := make([]float64, n)
+		work := make([]float64, 1)
+		impl.Dgeqrf(n, n, a.Data, a.Stride, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		const (
+			nb = 32  // Block size of the baseline.
+			nx = 128 // Crossover point of the baseline.
+		)
+		serialWork := make([]float64, n*nb)
+		for _, alg := range []struct {
+			name string
+			fn   func()
+		}{
+			{"Dgeqrf", func() { impl.Dgeqrf(n, n, a.Data, a.Stride, tau, work, len(work)) }},
+			{"Serial", func() { dgeqrfSerial(impl, n, n, a.Data, a.Stride, tau, serialWork, nb, nx) }},
+		} {
+			b.Run(fmt.Sprintf("Alg=%s,N=%d", alg.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					copyGeneral(a, aCopy)
+					b.StartTimer()
+					alg.fn()
+				}
+				resultGeneral = a
+			})
+		}
+	}
+}
+
+// dgetrfDgetf2 computes the LU factorization of the m×n matrix A with the
+// blocked algorithm of Dgetrf, using Dgetf2 to factorize the panels of nb
+// columns.
+func dgetrfDgetf2(impl DgetrfBenchmarker, m, n int, a []float64, lda int, ipiv []int, nb int) {
+	bi := blas64.Implementation()
+	mn := min(m, n)
+	for j := 0; j < mn; j += nb {
+		jb := min(mn-j, nb)
+		impl.Dgetf2(m-j, jb, a[j*lda+j:], lda, ipiv[j:j+jb])
+		for i := j; i <= min(m-1, j+jb-1); i++ {
+			ipiv[i] = j + ipiv[i]
+		}
+		impl.Dlaswp(j, a, lda, j, j+jb-1, ipiv[:j+jb], 1)
+		if j+jb < n {
+			impl.Dlaswp(n-j-jb, a[j+jb:], lda, j, j+jb-1, ipiv[:j+jb], 1)
+			bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit,
+				jb, n-j-jb, 1,
+				a[j*lda+j:], lda,
+				a[j*lda+j+jb:], lda)
+			if j+jb < m {
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m-j-jb, n-j-jb, jb, -1,
+					a[(j+jb)*lda+j:], lda,
+					a[j*lda+j+jb:], lda,
+					1, a[(j+jb)*lda+j+jb:], lda)
+			}
+		}
+	}
+}
+
+// dpotrfDpotf2 computes the Cholesky factorization of the n×n symmetric
+// positive definite matrix A with the blocked algorithm of Dpotrf, using
+// Dpotf2 to factorize the diagonal blocks of order nb.
+func dpotrfDpotf2(impl DpotrfBenchmarker, ul blas.Uplo, n int, a []float64, lda int, nb int) (ok bool) {
+	bi := blas64.Implementation()
+	for j := 0; j < n; j += nb {
+		jb := min(nb, n-j)
+		if ul == blas.Upper {
+			bi.Dsyrk(blas.Upper, blas.Trans, jb, j,
+				-1, a[j:], lda,
+				1, a[j*lda+j:], lda)
+			if !impl.Dpotf2(blas.Upper, jb, a[j*lda+j:], lda) {
+				return false
+			}
+			if j+jb < n {
+				bi.Dgemm(blas.Trans, blas.NoTrans, jb, n-j-jb, j,
+					-1, a[j:], lda, a[j+jb:], lda,
+					1, a[j*lda+j+jb:], lda)
+				bi.Dtrsm(blas.Left, blas.Upper, blas.Trans, blas.NonUnit, jb, n-j-jb,
+					1, a[j*lda+j:], lda,
+					a[j*lda+j+jb:], lda)
+			}
+			continue
+		}
+		bi.Dsyrk(blas.Lower, blas.NoTrans, jb, j,
+			-1, a[j*lda:], lda,
+			1, a[j*lda+j:], lda)
+		if !impl.Dpotf2(blas.Lower, jb, a[j*lda+j:], lda) {
+			return false
+		}
+		if j+jb < n {
+			bi.Dgemm(blas.NoTrans, blas.Trans, n-j-jb, jb, j,
+				-1, a[(j+jb)*lda:], lda, a[j*lda:], lda,
+				1, a[(j+jb)*lda+j:], lda)
+			bi.Dtrsm(blas.Right, blas.Lower, blas.Trans, blas.NonUnit, n-j-jb, jb,
+				1, a[j*lda+j:], lda,
+				a[(j+jb)*lda+j:], lda)
+		}
+	}
+	return true
+}
+
+// dgeqrfSerial computes the QR factorization of the m×n matrix A with the
+// blocked algorithm of Dgeqrf using the block size nb and the crossover
+// point nx, updating the trailing matrix with a single serial call to Dlarfb.
+// work must have length at least n*nb.
+func dgeqrfSerial(impl DgeqrfBenchmarker, m, n int, a []float64, lda int, tau, work []float64, nb, nx int) {
+	k := min(m, n)
+	var i int
+	if nb < k && nx < k {
+		for i = 0; i < k-nx; i += nb {
+			ib := min(k-i, nb)
+			impl.Dgeqr2(m-i, ib, a[i*lda+i:], lda, tau[i:], work)
+			if i+ib < n {
+				impl.Dlarft(lapack.Forward, lapack.ColumnWise, m-i, ib,
+					a[i*lda+i:], lda,
+					tau[i:],
+					work, nb)
+				impl.Dlarfb(blas.Left, blas.Trans, lapack.Forward, lapack.ColumnWise,
+					m-i, n-i-ib, ib,
+					a[i*lda+i:], lda,
+					work, nb,
+					a[i*lda+i+ib:], lda,
+					work[ib*nb:], nb)
+			}
+		}
+	}
+	if i < k {
+		impl.Dgeqr2(m-i, n-i, a[i*lda+i:], lda, tau[i:], work)
+	}
+}
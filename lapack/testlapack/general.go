@@ -1409,3 +1409,60 @@ func residualOrthogonal(q blas64.General, rowwise bool) float64 {
 	blas64.Syrk(transq, -1, q, 1, work)
 	return dlansy(lapack.MaxColumnSum, blas.Upper, work.N, work.Data, work.Stride)
 }
+
+// residualSymEigen returns the scaled residual
+//  |A*Z - Z*diag(w)|_1 / (n * |A|_1)
+// where A is an n×n symmetric matrix stored in full in a, and the m columns of
+// the n×m matrix Z contain the eigenvectors corresponding to the eigenvalues in
+// w. If |A|_1 is zero, the unscaled residual is returned.
+// It can be used to check the result of a symmetric eigensolver.
+func residualSymEigen(n, m int, a []float64, lda int, w, z []float64, ldz int) float64 {
+	if n == 0 || m == 0 {
+		return 0
+	}
+	// Compute r = Z*diag(w) - A*Z.
+	r := make([]float64, n*m)
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			r[i*m+j] = z[i*ldz+j] * w[j]
+		}
+	}
+	bi := blas64.Implementation()
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, n, m, n, -1, a, lda, z, ldz, 1, r, m)
+	resid := dlange(lapack.MaxColumnSum, n, m, r, m)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a, lda)
+	if anorm == 0 {
+		return resid
+	}
+	return resid / (float64(n) * anorm)
+}
+
+// randGeneralBand returns an m×n random general band matrix with kl
+// sub-diagonals and ku super-diagonals in the band storage format used by
+// Dgbtrf. Elements of ab outside of the band are set to NaN.
+func randGeneralBand(m, n, kl, ku, ldab int, rnd *rand.Rand) []float64 {
+	var ab []float64
+	if m > 0 && n > 0 {
+		ab = nanSlice(min(m, n+kl) * ldab)
+	}
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			ab[i*ldab+kl+j-i] = rnd.NormFloat64()
+		}
+	}
+	return ab
+}
+
+// generalBandToDense returns the dense representation of the m×n general band
+// matrix A with kl sub-diagonals and ku super-diagonals stored in ab. The
+// returned matrix has stride max(1,n).
+func generalBandToDense(m, n, kl, ku int, ab []float64, ldab int) []float64 {
+	lda := max(1, n)
+	a := make([]float64, m*lda)
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			a[i*lda+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	return a
+}
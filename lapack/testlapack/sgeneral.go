@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// randomGeneral32 allocates a new r×c float32 general matrix filled with
+// values from the standard normal distribution. Elements outside the matrix
+// are filled with NaN.
+func randomGeneral32(r, c, stride int, rnd *rand.Rand) blas32.General {
+	stride = max(1, max(c, stride))
+	data := make([]float32, max(0, (r-1)*stride+c))
+	for i := range data {
+		data[i] = float32(math.NaN())
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			data[i*stride+j] = float32(rnd.NormFloat64())
+		}
+	}
+	return blas32.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: stride,
+		Data:   data,
+	}
+}
+
+// randomSPD32 returns the elements of a random n×n float32 symmetric
+// positive definite matrix with stride max(n, stride).
+func randomSPD32(n, stride int, rnd *rand.Rand) []float32 {
+	stride = max(1, max(n, stride))
+	a := make([]float32, max(0, (n-1)*stride+n))
+	b := randomGeneral32(n, n, n, rnd)
+	bi := blas32.Implementation()
+	bi.Ssyrk(blas.Upper, blas.NoTrans, n, n, 1, b.Data, b.Stride, 0, a, stride)
+	for i := 0; i < n; i++ {
+		a[i*stride+i] += float32(n)
+		for j := i + 1; j < n; j++ {
+			a[j*stride+i] = a[i*stride+j]
+		}
+	}
+	return a
+}
+
+// smul returns op(a) * op(b) where op is determined by the transpose
+// parameters.
+func smul(transA blas.Transpose, a blas32.General, transB blas.Transpose, b blas32.General) blas32.General {
+	m, k := a.Rows, a.Cols
+	if transA != blas.NoTrans {
+		m, k = k, m
+	}
+	n := b.Cols
+	if transB != blas.NoTrans {
+		n = b.Rows
+	}
+	c := blas32.General{
+		Rows:   m,
+		Cols:   n,
+		Stride: max(1, n),
+		Data:   make([]float32, m*n),
+	}
+	if m == 0 || n == 0 {
+		return c
+	}
+	bi := blas32.Implementation()
+	bi.Sgemm(transA, transB, m, n, k, 1, a.Data, a.Stride, b.Data, b.Stride, 0, c.Data, c.Stride)
+	return c
+}
+
+// sdistGeneral returns the maximum absolute difference between the elements
+// of the m×n float32 matrices a and b.
+func sdistGeneral(m, n int, a []float32, lda int, b []float32, ldb int) float64 {
+	var dist float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			dist = math.Max(dist, math.Abs(float64(a[i*lda+j]-b[i*ldb+j])))
+		}
+	}
+	return dist
+}
+
+// scloneGeneral returns a deep copy of a.
+func scloneGeneral(a blas32.General) blas32.General {
+	c := a
+	c.Data = make([]float32, len(a.Data))
+	copy(c.Data, a.Data)
+	return c
+}
@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+type Sgetrfer interface {
+	Sgetrf(m, n int, a []float32, lda int, ipiv []int) bool
+}
+
+func SgetrfTest(t *testing.T, impl Sgetrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 30, 70, 130} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 30, 70, 130} {
+			for _, extra := range []int{0, 11} {
+				sgetrfTest(t, impl, rnd, m, n, n+extra)
+			}
+		}
+	}
+}
+
+func sgetrfTest(t *testing.T, impl Sgetrfer, rnd *rand.Rand, m, n, lda int) {
+	const tol = 1e-5
+
+	name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+
+	a := randomGeneral32(m, n, lda, rnd)
+	aCopy := scloneGeneral(a)
+	k := min(m, n)
+	ipiv := make([]int, k)
+
+	ok := impl.Sgetrf(m, n, a.Data, a.Stride, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected failure for a random matrix", name)
+		return
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// Extract the unit lower triangular L and the upper triangular U.
+	l := blas32.General{Rows: m, Cols: k, Stride: k, Data: make([]float32, m*k)}
+	u := blas32.General{Rows: k, Cols: n, Stride: n, Data: make([]float32, k*n)}
+	for i := 0; i < m; i++ {
+		for j := 0; j < k; j++ {
+			switch {
+			case i == j:
+				l.Data[i*k+j] = 1
+			case i > j:
+				l.Data[i*k+j] = a.Data[i*a.Stride+j]
+			}
+		}
+	}
+	for i := 0; i < k; i++ {
+		for j := i; j < n; j++ {
+			u.Data[i*n+j] = a.Data[i*a.Stride+j]
+		}
+	}
+
+	// Compute P * L * U and compare with the original matrix.
+	lu := smul(blas.NoTrans, l, blas.NoTrans, u)
+	bi := blas32.Implementation()
+	for i := k - 1; i >= 0; i-- {
+		if ipiv[i] != i {
+			bi.Sswap(n, lu.Data[i*lu.Stride:], 1, lu.Data[ipiv[i]*lu.Stride:], 1)
+		}
+	}
+	dist := sdistGeneral(m, n, lu.Data, lu.Stride, aCopy.Data, aCopy.Stride)
+	if dist > tol*float64(max(m, n)) {
+		t.Errorf("%v: |P*L*U - A| = %v is too large", name, dist)
+	}
+}
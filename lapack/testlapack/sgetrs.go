@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Sgetrser interface {
+	Sgetrfer
+	Sgetrs(trans blas.Transpose, n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int)
+}
+
+func SgetrsTest(t *testing.T, impl Sgetrser) {
+	const tol = 1e-2
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 50, 130} {
+			for _, nrhs := range []int{0, 1, 2, 5, 13} {
+				for _, extra := range []int{0, 11} {
+					name := fmt.Sprintf("trans=%v,n=%v,nrhs=%v,extra=%v", transToString(trans), n, nrhs, extra)
+
+					a := randomGeneral32(n, n, n+extra, rnd)
+					aCopy := scloneGeneral(a)
+					b := randomGeneral32(n, nrhs, nrhs+extra, rnd)
+					bCopy := scloneGeneral(b)
+
+					ipiv := make([]int, n)
+					impl.Sgetrf(n, n, a.Data, a.Stride, ipiv)
+					impl.Sgetrs(trans, n, nrhs, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+					if n == 0 || nrhs == 0 {
+						continue
+					}
+
+					// Compute op(A) * X and compare with B.
+					ax := smul(trans, aCopy, blas.NoTrans, b)
+					dist := sdistGeneral(n, nrhs, ax.Data, ax.Stride, bCopy.Data, bCopy.Stride)
+					if dist > tol {
+						t.Errorf("%v: |op(A)*X - B| = %v is too large", name, dist)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+type Spotrser interface {
+	Spotrfer
+	Spotrs(uplo blas.Uplo, n, nrhs int, a []float32, lda int, b []float32, ldb int)
+}
+
+func SpotrsTest(t *testing.T, impl Spotrser) {
+	const tol = 1e-5
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 65, 130} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, extra := range []int{0, 11} {
+					name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,extra=%v", uploToString(uplo), n, nrhs, extra)
+
+					lda := max(1, n+extra)
+					a := randomSPD32(n, lda, rnd)
+					aGen := blas32.General{Rows: n, Cols: n, Stride: lda, Data: make([]float32, len(a))}
+					copy(aGen.Data, a)
+					b := randomGeneral32(n, nrhs, nrhs+extra, rnd)
+					bCopy := scloneGeneral(b)
+
+					ok := impl.Spotrf(uplo, n, a, lda)
+					if !ok {
+						t.Errorf("%v: unexpected failure for positive definite matrix", name)
+						continue
+					}
+					impl.Spotrs(uplo, n, nrhs, a, lda, b.Data, b.Stride)
+					if n == 0 || nrhs == 0 {
+						continue
+					}
+
+					ax := smul(blas.NoTrans, aGen, blas.NoTrans, b)
+					dist := sdistGeneral(n, nrhs, ax.Data, ax.Stride, bCopy.Data, bCopy.Stride)
+					if dist > tol*float64(n) {
+						t.Errorf("%v: |A*X - B| = %v is too large", name, dist)
+					}
+				}
+			}
+		}
+	}
+}
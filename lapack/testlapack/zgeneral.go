@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math"
+	"math/cmplx"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// randomComplexGeneral returns an r×c complex general matrix with stride
+// max(c, stride) whose elements have real and imaginary parts drawn from the
+// standard normal distribution. Elements outside the matrix are set to NaN.
+func randomComplexGeneral(r, c, stride int, rnd *rand.Rand) cblas128.General {
+	stride = max(1, max(c, stride))
+	data := make([]complex128, max(0, (r-1)*stride+c))
+	for i := range data {
+		data[i] = cmplx.NaN()
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			data[i*stride+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: stride,
+		Data:   data,
+	}
+}
+
+// randomHermitian returns the elements of a random n×n Hermitian matrix with
+// stride max(n, stride). If posdef is true, the matrix is also positive
+// definite.
+func randomHermitian(n, stride int, posdef bool, rnd *rand.Rand) []complex128 {
+	stride = max(1, max(n, stride))
+	a := make([]complex128, max(0, (n-1)*stride+n))
+	if posdef {
+		b := randomComplexGeneral(n, n, n, rnd)
+		bi := cblas128.Implementation()
+		bi.Zherk(blas.Upper, blas.NoTrans, n, n, 1, b.Data, b.Stride, 0, a, stride)
+		for i := 0; i < n; i++ {
+			a[i*stride+i] += complex(float64(n), 0)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			a[i*stride+i] = complex(rnd.NormFloat64(), 0)
+			for j := i + 1; j < n; j++ {
+				a[i*stride+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+			}
+		}
+	}
+	// Fill the lower triangle from the upper triangle.
+	for i := 0; i < n; i++ {
+		a[i*stride+i] = complex(real(a[i*stride+i]), 0)
+		for j := i + 1; j < n; j++ {
+			a[j*stride+i] = cmplx.Conj(a[i*stride+j])
+		}
+	}
+	return a
+}
+
+// zeye returns an n×n complex identity matrix with stride max(n, stride).
+func zeye(n, stride int) cblas128.General {
+	stride = max(1, max(n, stride))
+	ans := cblas128.General{
+		Rows:   n,
+		Cols:   n,
+		Stride: stride,
+		Data:   make([]complex128, max(0, (n-1)*stride+n)),
+	}
+	for i := 0; i < n; i++ {
+		ans.Data[i*stride+i] = 1
+	}
+	return ans
+}
+
+// zmul returns op(a) * op(b) where op is determined by the transpose
+// parameters.
+func zmul(transA blas.Transpose, a cblas128.General, transB blas.Transpose, b cblas128.General) cblas128.General {
+	m, k := a.Rows, a.Cols
+	if transA != blas.NoTrans {
+		m, k = k, m
+	}
+	n := b.Cols
+	if transB != blas.NoTrans {
+		n = b.Rows
+	}
+	c := cblas128.General{
+		Rows:   m,
+		Cols:   n,
+		Stride: max(1, n),
+		Data:   make([]complex128, m*n),
+	}
+	if m == 0 || n == 0 {
+		return c
+	}
+	bi := cblas128.Implementation()
+	bi.Zgemm(transA, transB, m, n, k, 1, a.Data, a.Stride, b.Data, b.Stride, 0, c.Data, c.Stride)
+	return c
+}
+
+// zdistGeneral returns the maximum absolute difference between the elements
+// of the m×n matrices a and b.
+func zdistGeneral(m, n int, a []complex128, lda int, b []complex128, ldb int) float64 {
+	var dist float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			dist = math.Max(dist, cmplx.Abs(a[i*lda+j]-b[i*ldb+j]))
+		}
+	}
+	return dist
+}
+
+// residualUnitary returns the maximum absolute element of I - Q*Qᴴ if rowwise
+// is true or I - Qᴴ*Q otherwise.
+func residualUnitary(q cblas128.General, rowwise bool) float64 {
+	var qq cblas128.General
+	if rowwise {
+		qq = zmul(blas.NoTrans, q, blas.ConjTrans, q)
+	} else {
+		qq = zmul(blas.ConjTrans, q, blas.NoTrans, q)
+	}
+	eye := zeye(qq.Rows, 0)
+	return zdistGeneral(qq.Rows, qq.Cols, qq.Data, qq.Stride, eye.Data, eye.Stride)
+}
+
+// zcloneGeneral returns a deep copy of a.
+func zcloneGeneral(a cblas128.General) cblas128.General {
+	c := a
+	c.Data = make([]complex128, len(a.Data))
+	copy(c.Data, a.Data)
+	return c
+}
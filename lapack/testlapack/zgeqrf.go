@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zgeqrfer interface {
+	Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int)
+}
+
+func ZgeqrfTest(t *testing.T, impl Zgeqrfer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 31, 70} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 70} {
+			for _, extra := range []int{0, 11} {
+				name := fmt.Sprintf("m=%v,n=%v,extra=%v", m, n, extra)
+
+				a := randomComplexGeneral(m, n, n+extra, rnd)
+				aCopy := zcloneGeneral(a)
+				k := min(m, n)
+				tau := make([]complex128, k)
+
+				work := make([]complex128, 1)
+				impl.Zgeqrf(m, n, a.Data, a.Stride, tau, work, -1)
+				lwork := int(real(work[0]))
+				work = make([]complex128, lwork)
+				impl.Zgeqrf(m, n, a.Data, a.Stride, tau, work, lwork)
+				if m == 0 || n == 0 {
+					continue
+				}
+
+				// Extract R.
+				r := cblas128.General{Rows: m, Cols: n, Stride: n, Data: make([]complex128, m*n)}
+				for i := 0; i < k; i++ {
+					for j := i; j < n; j++ {
+						r.Data[i*n+j] = a.Data[i*a.Stride+j]
+					}
+				}
+
+				// Construct the full m×m matrix Q.
+				q := cblas128.General{Rows: m, Cols: m, Stride: m, Data: make([]complex128, m*m)}
+				for i := 0; i < m; i++ {
+					for j := 0; j < k; j++ {
+						q.Data[i*m+j] = a.Data[i*a.Stride+j]
+					}
+				}
+				work = make([]complex128, m)
+				impl.Zungqr(m, m, k, q.Data, q.Stride, tau, work, len(work))
+
+				if resid := residualUnitary(q, false); resid > tol*float64(m) {
+					t.Errorf("%v: Q is not unitary; resid=%v", name, resid)
+				}
+				qr := zmul(blas.NoTrans, q, blas.NoTrans, r)
+				dist := zdistGeneral(m, n, qr.Data, qr.Stride, aCopy.Data, aCopy.Stride)
+				if dist > tol*float64(max(m, n)) {
+					t.Errorf("%v: |Q*R - A| = %v is too large", name, dist)
+				}
+			}
+		}
+	}
+}
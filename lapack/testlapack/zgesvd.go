@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zgesvder interface {
+	Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool)
+}
+
+func ZgesvdTest(t *testing.T, impl Zgesvder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+			for _, extra := range []int{0, 11} {
+				for _, jobU := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDNone} {
+					for _, jobVT := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDNone} {
+						zgesvdTest(t, impl, rnd, jobU, jobVT, m, n, extra)
+					}
+				}
+			}
+		}
+	}
+}
+
+func zgesvdTest(t *testing.T, impl Zgesvder, rnd *rand.Rand, jobU, jobVT lapack.SVDJob, m, n, extra int) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("jobU=%v,jobVT=%v,m=%v,n=%v,extra=%v", svdJobString(jobU), svdJobString(jobVT), m, n, extra)
+
+	a := randomComplexGeneral(m, n, n+extra, rnd)
+	aCopy := zcloneGeneral(a)
+	minmn := min(m, n)
+
+	var ucol, vtrow int
+	switch jobU {
+	case lapack.SVDAll:
+		ucol = m
+	case lapack.SVDStore:
+		ucol = minmn
+	}
+	switch jobVT {
+	case lapack.SVDAll:
+		vtrow = n
+	case lapack.SVDStore:
+		vtrow = minmn
+	}
+	u := randomComplexGeneral(m, ucol, ucol+extra, rnd)
+	vt := randomComplexGeneral(vtrow, n, n+extra, rnd)
+	ldu, ldvt := u.Stride, vt.Stride
+
+	s := make([]float64, minmn)
+	work := make([]complex128, 1)
+	impl.Zgesvd(jobU, jobVT, m, n, a.Data, a.Stride, s, u.Data, ldu, vt.Data, ldvt, work, -1, nil)
+	lwork := int(real(work[0]))
+	work = make([]complex128, lwork)
+	rwork := make([]float64, 5*minmn+2*minmn*minmn)
+	ok := impl.Zgesvd(jobU, jobVT, m, n, a.Data, a.Stride, s, u.Data, ldu, vt.Data, ldvt, work, lwork, rwork)
+	if !ok {
+		t.Errorf("%v: Zgesvd failed", name)
+		return
+	}
+	if minmn == 0 {
+		return
+	}
+
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+		t.Errorf("%v: singular values are not in decreasing order", name)
+	}
+	for _, v := range s {
+		if v < 0 {
+			t.Errorf("%v: negative singular value %v", name, v)
+			break
+		}
+	}
+
+	if jobU != lapack.SVDNone {
+		if resid := residualUnitary(u, false); resid > tol*float64(m) {
+			t.Errorf("%v: U is not unitary; resid=%v", name, resid)
+		}
+	}
+	if jobVT != lapack.SVDNone {
+		if resid := residualUnitary(vt, true); resid > tol*float64(n) {
+			t.Errorf("%v: Vᴴ is not unitary; resid=%v", name, resid)
+		}
+	}
+
+	if jobU != lapack.SVDNone && jobVT != lapack.SVDNone {
+		// Check that A = U * Sigma * Vᴴ using the first min(m,n)
+		// singular vectors.
+		us := cblas128.General{Rows: m, Cols: minmn, Stride: minmn, Data: make([]complex128, m*minmn)}
+		for i := 0; i < m; i++ {
+			for j := 0; j < minmn; j++ {
+				us.Data[i*minmn+j] = u.Data[i*u.Stride+j] * complex(s[j], 0)
+			}
+		}
+		vtk := cblas128.General{Rows: minmn, Cols: n, Stride: vt.Stride, Data: vt.Data}
+		usv := zmul(blas.NoTrans, us, blas.NoTrans, vtk)
+		dist := zdistGeneral(m, n, usv.Data, usv.Stride, aCopy.Data, aCopy.Stride)
+		if dist > tol*float64(max(m, n)) {
+			t.Errorf("%v: |U*Sigma*Vᴴ - A| = %v is too large", name, dist)
+		}
+	}
+
+	// Check that the singular values agree with those computed without
+	// singular vectors.
+	a = zcloneGeneral(aCopy)
+	sNone := make([]float64, minmn)
+	ok = impl.Zgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a.Data, a.Stride, sNone, nil, 1, nil, 1, work, lwork, rwork)
+	if !ok {
+		t.Errorf("%v: Zgesvd failed without singular vectors", name)
+		return
+	}
+	for i := range s {
+		if math.Abs(s[i]-sNone[i]) > tol*math.Max(1, s[0]) {
+			t.Errorf("%v: singular value mismatch at %d: got %v, want %v", name, i, sNone[i], s[i])
+			break
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zgetrfer interface {
+	Zgetrf(m, n int, a []complex128, lda int, ipiv []int) bool
+}
+
+func ZgetrfTest(t *testing.T, impl Zgetrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 30, 70, 130} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 30, 70, 130} {
+			for _, extra := range []int{0, 11} {
+				zgetrfTest(t, impl, rnd, m, n, n+extra)
+			}
+		}
+	}
+}
+
+func zgetrfTest(t *testing.T, impl Zgetrfer, rnd *rand.Rand, m, n, lda int) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+
+	a := randomComplexGeneral(m, n, lda, rnd)
+	aCopy := zcloneGeneral(a)
+	k := min(m, n)
+	ipiv := make([]int, k)
+
+	ok := impl.Zgetrf(m, n, a.Data, a.Stride, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected failure for a random matrix", name)
+		return
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// Extract the unit lower triangular L and the upper triangular U.
+	l := cblas128.General{Rows: m, Cols: k, Stride: k, Data: make([]complex128, m*k)}
+	u := cblas128.General{Rows: k, Cols: n, Stride: n, Data: make([]complex128, k*n)}
+	for i := 0; i < m; i++ {
+		for j := 0; j < k; j++ {
+			switch {
+			case i == j:
+				l.Data[i*k+j] = 1
+			case i > j:
+				l.Data[i*k+j] = a.Data[i*a.Stride+j]
+			}
+		}
+	}
+	for i := 0; i < k; i++ {
+		for j := i; j < n; j++ {
+			u.Data[i*n+j] = a.Data[i*a.Stride+j]
+		}
+	}
+
+	// Compute P * L * U and compare with the original matrix.
+	lu := zmul(blas.NoTrans, l, blas.NoTrans, u)
+	bi := cblas128.Implementation()
+	for i := k - 1; i >= 0; i-- {
+		if ipiv[i] != i {
+			bi.Zswap(n, lu.Data[i*lu.Stride:], 1, lu.Data[ipiv[i]*lu.Stride:], 1)
+		}
+	}
+	dist := zdistGeneral(m, n, lu.Data, lu.Stride, aCopy.Data, aCopy.Stride)
+	if dist > tol*float64(max(m, n)) {
+		t.Errorf("%v: |P*L*U - A| = %v is too large", name, dist)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zheever interface {
+	Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool)
+}
+
+func ZheevTest(t *testing.T, impl Zheever) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 70} {
+			for _, extra := range []int{0, 11} {
+				name := fmt.Sprintf("uplo=%v,n=%v,extra=%v", uploToString(uplo), n, extra)
+
+				lda := max(1, n+extra)
+				a := randomHermitian(n, lda, false, rnd)
+				aGen := cblas128.General{Rows: n, Cols: n, Stride: lda, Data: make([]complex128, len(a))}
+				copy(aGen.Data, a)
+
+				w := make([]float64, n)
+				work := make([]complex128, 1)
+				impl.Zheev(lapack.EVCompute, uplo, n, a, lda, w, work, -1, nil)
+				lwork := int(real(work[0]))
+				work = make([]complex128, lwork)
+				rwork := make([]float64, max(1, 3*n-2))
+				ok := impl.Zheev(lapack.EVCompute, uplo, n, a, lda, w, work, lwork, rwork)
+				if !ok {
+					t.Errorf("%v: Zheev failed", name)
+					continue
+				}
+				if n == 0 {
+					continue
+				}
+				if !sort.Float64sAreSorted(w) {
+					t.Errorf("%v: eigenvalues are not in ascending order", name)
+				}
+
+				// Check that the eigenvectors are orthonormal.
+				z := cblas128.General{Rows: n, Cols: n, Stride: lda, Data: a}
+				if resid := residualUnitary(z, false); resid > tol*float64(n) {
+					t.Errorf("%v: eigenvectors are not orthonormal; resid=%v", name, resid)
+				}
+
+				// Check that A * Z = Z * diag(w).
+				az := zmul(blas.NoTrans, aGen, blas.NoTrans, z)
+				var resid float64
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						d := az.Data[i*az.Stride+j] - z.Data[i*z.Stride+j]*complex(w[j], 0)
+						resid = math.Max(resid, math.Hypot(real(d), imag(d)))
+					}
+				}
+				if resid > tol*float64(n) {
+					t.Errorf("%v: |A*Z - Z*W| = %v is too large", name, resid)
+				}
+
+				// Check that the eigenvalues computed without
+				// eigenvectors match.
+				a = make([]complex128, len(aGen.Data))
+				copy(a, aGen.Data)
+				wNone := make([]float64, n)
+				ok = impl.Zheev(lapack.EVNone, uplo, n, a, lda, wNone, work, lwork, rwork)
+				if !ok {
+					t.Errorf("%v: Zheev failed for EVNone", name)
+					continue
+				}
+				for i := range w {
+					if math.Abs(w[i]-wNone[i]) > tol*float64(n) {
+						t.Errorf("%v: eigenvalue mismatch at %d: got %v, want %v", name, i, wNone[i], w[i])
+						break
+					}
+				}
+			}
+		}
+	}
+}
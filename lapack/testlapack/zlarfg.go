@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Zlarfger interface {
+	Zlarfg(n int, alpha complex128, x []complex128, incX int) (beta, tau complex128)
+}
+
+func ZlarfgTest(t *testing.T, impl Zlarfger) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 50} {
+		for _, incX := range []int{1, 4} {
+			for _, zero := range []bool{false, true} {
+				name := fmt.Sprintf("n=%v,incX=%v,zero=%v", n, incX, zero)
+
+				alpha := complex(rnd.NormFloat64(), rnd.NormFloat64())
+				var x []complex128
+				if n > 1 {
+					x = make([]complex128, 1+(n-2)*incX)
+				}
+				for i := 0; i < n-1; i++ {
+					if !zero {
+						x[i*incX] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+					}
+				}
+				// Full vector [alpha; x].
+				v := make([]complex128, n)
+				v[0] = alpha
+				for i := 1; i < n; i++ {
+					v[i] = x[(i-1)*incX]
+				}
+
+				beta, tau := impl.Zlarfg(n, alpha, x, incX)
+				if imag(beta) != 0 {
+					t.Errorf("%v: beta is not real: %v", name, beta)
+				}
+				if real(tau) < 1-tol || real(tau) > 2+tol || cmplx.Abs(tau-1) > 1+tol {
+					t.Errorf("%v: tau out of range: %v", name, tau)
+				}
+
+				// Construct the reflector vector u = [1; x] and compute
+				//  Hᴴ * v = v - conj(tau) * u * (uᴴ * v).
+				u := make([]complex128, n)
+				u[0] = 1
+				for i := 1; i < n; i++ {
+					u[i] = x[(i-1)*incX]
+				}
+				var dot complex128
+				for i := range u {
+					dot += cmplx.Conj(u[i]) * v[i]
+				}
+				hv := make([]complex128, n)
+				for i := range hv {
+					hv[i] = v[i] - cmplx.Conj(tau)*u[i]*dot
+				}
+				if cmplx.Abs(hv[0]-beta) > tol*cmplx.Abs(beta)*float64(n) {
+					t.Errorf("%v: unexpected first element: got %v, want %v", name, hv[0], beta)
+				}
+				for i := 1; i < n; i++ {
+					if cmplx.Abs(hv[i]) > tol*math.Max(1, cmplx.Abs(beta))*float64(n) {
+						t.Errorf("%v: element %d not annihilated: %v", name, i, hv[i])
+						break
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zpotrfer interface {
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+}
+
+func ZpotrfTest(t *testing.T, impl Zpotrfer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 30, 63, 65, 130} {
+			for _, extra := range []int{0, 11} {
+				name := fmt.Sprintf("uplo=%v,n=%v,extra=%v", uploToString(uplo), n, extra)
+
+				lda := max(1, n+extra)
+				a := randomHermitian(n, lda, true, rnd)
+				aCopy := make([]complex128, len(a))
+				copy(aCopy, a)
+
+				ok := impl.Zpotrf(uplo, n, a, lda)
+				if !ok {
+					t.Errorf("%v: unexpected failure for positive definite matrix", name)
+					continue
+				}
+				if n == 0 {
+					continue
+				}
+
+				// Extract the triangular factor and compute
+				// Uᴴ * U or L * Lᴴ.
+				f := cblas128.General{Rows: n, Cols: n, Stride: n, Data: make([]complex128, n*n)}
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+							f.Data[i*n+j] = a[i*lda+j]
+						}
+					}
+				}
+				var ff cblas128.General
+				if uplo == blas.Upper {
+					ff = zmul(blas.ConjTrans, f, blas.NoTrans, f)
+				} else {
+					ff = zmul(blas.NoTrans, f, blas.ConjTrans, f)
+				}
+				dist := zdistGeneral(n, n, ff.Data, ff.Stride, aCopy, lda)
+				if dist > tol*float64(n) {
+					t.Errorf("%v: unexpected result, |factorization - A| = %v", name, dist)
+				}
+
+				// Make A indefinite and check that Zpotrf fails.
+				copy(a, aCopy)
+				a[(n-1)*lda+n-1] = -1
+				ok = impl.Zpotrf(uplo, n, a, lda)
+				if ok {
+					t.Errorf("%v: unexpected success for not positive definite matrix", name)
+				}
+			}
+		}
+	}
+}
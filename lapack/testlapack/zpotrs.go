@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zpotrser interface {
+	Zpotrfer
+	Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int)
+}
+
+func ZpotrsTest(t *testing.T, impl Zpotrser) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 65, 130} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, extra := range []int{0, 11} {
+					name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,extra=%v", uploToString(uplo), n, nrhs, extra)
+
+					lda := max(1, n+extra)
+					a := randomHermitian(n, lda, true, rnd)
+					aGen := cblas128.General{Rows: n, Cols: n, Stride: lda, Data: make([]complex128, len(a))}
+					copy(aGen.Data, a)
+					b := randomComplexGeneral(n, nrhs, nrhs+extra, rnd)
+					bCopy := zcloneGeneral(b)
+
+					ok := impl.Zpotrf(uplo, n, a, lda)
+					if !ok {
+						t.Errorf("%v: unexpected failure for positive definite matrix", name)
+						continue
+					}
+					impl.Zpotrs(uplo, n, nrhs, a, lda, b.Data, b.Stride)
+					if n == 0 || nrhs == 0 {
+						continue
+					}
+
+					ax := zmul(blas.NoTrans, aGen, blas.NoTrans, b)
+					dist := zdistGeneral(n, nrhs, ax.Data, ax.Stride, bCopy.Data, bCopy.Stride)
+					if dist > tol*float64(n) {
+						t.Errorf("%v: |A*X - B| = %v is too large", name, dist)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zunmqrer interface {
+	Zgeqrfer
+	Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+}
+
+func ZunmqrTest(t *testing.T, impl Zunmqrer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+			for _, test := range []struct {
+				common, adim, cdim, extra int
+			}{
+				{1, 1, 1, 0},
+				{3, 2, 4, 0},
+				{6, 7, 8, 0},
+				{6, 8, 7, 0},
+				{7, 6, 8, 0},
+				{8, 6, 7, 0},
+				{8, 7, 6, 0},
+				{40, 30, 20, 0},
+				{40, 30, 20, 7},
+				{30, 40, 20, 7},
+			} {
+				name := fmt.Sprintf("side=%v,trans=%v,common=%v,adim=%v,cdim=%v,extra=%v",
+					sideToString(side), transToString(trans), test.common, test.adim, test.cdim, test.extra)
+
+				ma, na := test.common, test.adim
+				mc, nc := test.common, test.cdim
+				if side == blas.Right {
+					mc, nc = test.cdim, test.common
+				}
+				a := randomComplexGeneral(ma, na, na+test.extra, rnd)
+				c := randomComplexGeneral(mc, nc, nc+test.extra, rnd)
+				cCopy := zcloneGeneral(c)
+
+				// Compute the QR factorization of A.
+				k := min(ma, na)
+				tau := make([]complex128, k)
+				work := make([]complex128, na)
+				impl.Zgeqrf(ma, na, a.Data, a.Stride, tau, work, len(work))
+
+				// Construct the full ma×ma matrix Q.
+				q := cblas128.General{Rows: ma, Cols: ma, Stride: ma, Data: make([]complex128, ma*ma)}
+				for i := 0; i < ma; i++ {
+					for j := 0; j < k; j++ {
+						q.Data[i*ma+j] = a.Data[i*a.Stride+j]
+					}
+				}
+				work = make([]complex128, ma)
+				impl.Zungqr(ma, ma, k, q.Data, q.Stride, tau, work, len(work))
+
+				var want cblas128.General
+				if side == blas.Left {
+					want = zmul(trans, q, blas.NoTrans, cCopy)
+				} else {
+					want = zmul(blas.NoTrans, cCopy, trans, q)
+				}
+
+				work = make([]complex128, 1)
+				impl.Zunmqr(side, trans, mc, nc, k, a.Data, a.Stride, tau, c.Data, c.Stride, work, -1)
+				work = make([]complex128, int(real(work[0])))
+				impl.Zunmqr(side, trans, mc, nc, k, a.Data, a.Stride, tau, c.Data, c.Stride, work, len(work))
+
+				dist := zdistGeneral(mc, nc, c.Data, c.Stride, want.Data, want.Stride)
+				if dist > tol*float64(ma) {
+					t.Errorf("%v: unexpected result, |got - want| = %v", name, dist)
+				}
+			}
+		}
+	}
+}
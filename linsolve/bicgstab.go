@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// BiCGStab implements the BiConjugate Gradient Stabilized method with
+// preconditioning for solving systems of linear equations
+//  A * x = b,
+// where A is a non-symmetric matrix. It requires two matrix-vector products
+// and two preconditioner solves per iteration and does not require products
+// with the transpose of A.
+//
+// BiCGStab may break down if the inner products that it computes vanish.
+// In that case a BreakdownError is returned.
+//
+// References:
+//  - Barrett, R. et al. (1994). Section 2.3.8 BiConjugate Gradient Stabilized (Bi-CGSTAB).
+//    In Templates for the Solution of Linear Systems: Building Blocks
+//    for Iterative Methods (2nd ed.) (pp. 24-25). Philadelphia, PA: SIAM.
+//    Retrieved from http://www.netlib.org/templates/templates.pdf
+type BiCGStab struct {
+	x          *mat.VecDense
+	r, rt      mat.VecDense
+	p, v       mat.VecDense
+	pHat, sHat mat.VecDense
+	s, t       mat.VecDense
+
+	rho, rhoPrev float64
+	alpha        float64
+	omega        float64
+	first        bool
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (b *BiCGStab) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("bicgstab: vector length mismatch")
+	}
+	b.x = x
+	reuseAs(&b.r, n)
+	b.r.CopyVec(residual)
+	reuseAs(&b.rt, n)
+	b.rt.CopyVec(residual)
+	reuseAs(&b.p, n)
+	reuseAs(&b.v, n)
+	reuseAs(&b.pHat, n)
+	reuseAs(&b.sHat, n)
+	reuseAs(&b.s, n)
+	reuseAs(&b.t, n)
+	b.first = true
+	b.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// BiCGStab will command the following operations:
+//  MulVec
+//  PreconSolve
+//  CheckResidualNorm
+//  MajorIteration
+func (b *BiCGStab) Iterate(ctx *Context) (Operation, error) {
+	const breakdownTol = 1e-300
+
+	switch b.resume {
+	case 1:
+		b.rho = mat.Dot(&b.rt, &b.r) // ρ_i = r̃ · r_{i-1}
+		if math.Abs(b.rho) < breakdownTol {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: math.Abs(b.rho), Tolerance: breakdownTol}
+		}
+		if b.first {
+			b.p.CopyVec(&b.r)
+			b.first = false
+		} else {
+			// p_i = r_{i-1} + β (p_{i-1} - ω_{i-1} v_{i-1})
+			beta := (b.rho / b.rhoPrev) * (b.alpha / b.omega)
+			b.p.AddScaledVec(&b.p, -b.omega, &b.v)
+			b.p.AddScaledVec(&b.r, beta, &b.p)
+		}
+		// Solve M p̂ = p_i.
+		ctx.Src = &b.p
+		ctx.Dst = &b.pHat
+		b.resume = 2
+		return PreconSolve, nil
+	case 2:
+		// Compute v_i = A p̂.
+		ctx.Src = &b.pHat
+		ctx.Dst = &b.v
+		b.resume = 3
+		return MulVec, nil
+	case 3:
+		rtv := mat.Dot(&b.rt, &b.v)
+		if math.Abs(rtv) < breakdownTol {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: math.Abs(rtv), Tolerance: breakdownTol}
+		}
+		b.alpha = b.rho / rtv                  // α_i = ρ_i / (r̃ · v_i)
+		b.s.AddScaledVec(&b.r, -b.alpha, &b.v) // s = r_{i-1} - α_i v_i
+		ctx.ResidualNorm = mat.Norm(&b.s, 2)
+		b.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		if ctx.Converged {
+			// The half step has converged.
+			b.x.AddScaledVec(b.x, b.alpha, &b.pHat)
+			b.r.CopyVec(&b.s)
+			b.rhoPrev = b.rho
+			b.omega = 1
+			b.resume = 1
+			return MajorIteration, nil
+		}
+		// Solve M ŝ = s.
+		ctx.Src = &b.s
+		ctx.Dst = &b.sHat
+		b.resume = 5
+		return PreconSolve, nil
+	case 5:
+		// Compute t = A ŝ.
+		ctx.Src = &b.sHat
+		ctx.Dst = &b.t
+		b.resume = 6
+		return MulVec, nil
+	case 6:
+		tt := mat.Dot(&b.t, &b.t)
+		if tt == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: tt, Tolerance: 0}
+		}
+		b.omega = mat.Dot(&b.t, &b.s) / tt // ω_i = (t · s) / (t · t)
+		if math.Abs(b.omega) < breakdownTol {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: math.Abs(b.omega), Tolerance: breakdownTol}
+		}
+		// x_i = x_{i-1} + α_i p̂ + ω_i ŝ
+		b.x.AddScaledVec(b.x, b.alpha, &b.pHat)
+		b.x.AddScaledVec(b.x, b.omega, &b.sHat)
+		b.r.AddScaledVec(&b.s, -b.omega, &b.t) // r_i = s - ω_i t
+		b.rhoPrev = b.rho
+		ctx.ResidualNorm = mat.Norm(&b.r, 2)
+		b.resume = 1
+		return MajorIteration, nil
+	default:
+		panic("bicgstab: Init not called")
+	}
+}
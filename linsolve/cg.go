@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// CG implements the Conjugate Gradient iterative method with preconditioning
+// for solving systems of linear equations
+//  A * x = b,
+// where A is a symmetric positive definite matrix. It requires minimal memory
+// storage and is a good choice for symmetric positive definite problems.
+//
+// The preconditioner used with CG must also be symmetric positive definite.
+// Jacobi and IC0 are suitable choices.
+//
+// References:
+//  - Barrett, R. et al. (1994). Section 2.3.1 Conjugate Gradient Method (CG).
+//    In Templates for the Solution of Linear Systems: Building Blocks
+//    for Iterative Methods (2nd ed.) (pp. 12-15). Philadelphia, PA: SIAM.
+//    Retrieved from http://www.netlib.org/templates/templates.pdf
+type CG struct {
+	x    *mat.VecDense
+	r, z mat.VecDense
+	p    mat.VecDense
+	ap   mat.VecDense
+
+	rho, rhoPrev float64
+	first        bool
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (cg *CG) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("cg: vector length mismatch")
+	}
+	cg.x = x
+	reuseAs(&cg.r, n)
+	cg.r.CopyVec(residual)
+	reuseAs(&cg.z, n)
+	reuseAs(&cg.p, n)
+	reuseAs(&cg.ap, n)
+	cg.first = true
+	cg.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// CG will command the following operations:
+//  MulVec
+//  PreconSolve
+//  MajorIteration
+func (cg *CG) Iterate(ctx *Context) (Operation, error) {
+	switch cg.resume {
+	case 1:
+		// Solve M z = r_{i-1}.
+		ctx.Src = &cg.r
+		ctx.Dst = &cg.z
+		cg.resume = 2
+		return PreconSolve, nil
+	case 2:
+		cg.rho = mat.Dot(&cg.r, &cg.z) // ρ_i = r_{i-1} · z
+		if cg.first {
+			cg.p.CopyVec(&cg.z) // p_1 = z
+			cg.first = false
+		} else {
+			beta := cg.rho / cg.rhoPrev           // β_{i-1} = ρ_{i-1} / ρ_{i-2}
+			cg.p.AddScaledVec(&cg.z, beta, &cg.p) // p_i = z + β p_{i-1}
+		}
+		// Compute A p_i.
+		ctx.Src = &cg.p
+		ctx.Dst = &cg.ap
+		cg.resume = 3
+		return MulVec, nil
+	case 3:
+		pap := mat.Dot(&cg.p, &cg.ap)
+		if pap <= 0 {
+			cg.resume = 0
+			return NoOperation, &BreakdownError{Value: pap, Tolerance: 0}
+		}
+		alpha := cg.rho / pap                    // α_i = ρ_i / (p_i · A p_i)
+		cg.x.AddScaledVec(cg.x, alpha, &cg.p)    // x_i = x_{i-1} + α p_i
+		cg.r.AddScaledVec(&cg.r, -alpha, &cg.ap) // r_i = r_{i-1} - α A p_i
+		cg.rhoPrev = cg.rho
+		ctx.ResidualNorm = mat.Norm(&cg.r, 2)
+		cg.resume = 1
+		return MajorIteration, nil
+	default:
+		panic("cg: Init not called")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linsolve provides iterative methods for solving linear systems.
+//
+// Iterative methods only require the ability to compute the product of the
+// system matrix with a vector, so they can be used for systems that are too
+// large to be factorized or where the matrix is only available implicitly
+// as a linear operator.
+//
+// Background
+//
+// A system of linear equations can be written as
+//  A * x = b,
+// where A is a given n×n non-singular matrix, b is a given n-vector (the
+// right-hand side), and x is an unknown n-vector.
+//
+// Direct methods such as the LU or QR decomposition compute (in the absence
+// of roundoff errors) the exact solution after a finite number of steps. For a
+// general matrix A they require O(n^2) storage and O(n^3) arithmetic
+// operations, which can be prohibitive for large n. For sparse A the storage
+// may be reduced, but the factors usually fill in and lose sparsity.
+//
+// Iterative methods compute a sequence of approximations x_k that converge to
+// the solution x. The methods provided by this package are Krylov subspace
+// methods, which find x_k in the affine subspace
+//  x_0 + span{r_0, A r_0, A^2 r_0, ..., A^{k-1} r_0},
+// where r_0 = b - A*x_0 is the initial residual. The rate of convergence
+// depends on the spectral properties of A and can be improved by
+// preconditioning, that is by solving a system with the matrix M^{-1} A where
+// M approximates A and systems with M are inexpensive to solve.
+//
+// Choosing a method
+//
+// If A is symmetric positive definite, CG is usually the method of choice.
+// If A is symmetric but indefinite, MINRES should be used. For general
+// matrices, GMRES and BiCGStab are available. GMRES minimizes the residual
+// norm over the restart cycle and has robust convergence but its cost grows
+// with the restart length; BiCGStab has a fixed cost per iteration but its
+// convergence may be erratic.
+//
+// References
+//
+// Barrett, Richard et al. (1994). Section 2.3.1 Conjugate Gradient Method
+// (CG). In Templates for the Solution of Linear Systems: Building Blocks for
+// Iterative Methods (2nd ed.) (pp. 12-15). Philadelphia, PA: SIAM.
+// Retrieved from http://www.netlib.org/templates/templates.pdf
+//
+// Saad, Yousef (2003). Iterative Methods for Sparse Linear Systems (2nd ed.).
+// Philadelphia, PA: SIAM. Retrieved from
+// https://www-users.cs.umn.edu/~saad/IterMethBook_2ndEd.pdf
+package linsolve // import "gonum.org/v1/gonum/linsolve"
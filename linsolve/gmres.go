@@ -0,0 +1,238 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// GMRES implements the Generalized Minimum Residual method with restarts and
+// right preconditioning for solving systems of linear equations
+//  A * x = b,
+// where A is a non-symmetric matrix.
+//
+// GMRES builds an orthonormal basis of the Krylov subspace and minimizes the
+// residual norm over it. The storage and work required by each iteration
+// grow with the dimension of the subspace, so the method is restarted after
+// Restart iterations with the current approximation as the new initial
+// guess. Each restart cycle is one major iteration.
+//
+// References:
+//  - Barrett, R. et al. (1994). Section 2.3.4 Generalized Minimal Residual (GMRES).
+//    In Templates for the Solution of Linear Systems: Building Blocks
+//    for Iterative Methods (2nd ed.) (pp. 17-19). Philadelphia, PA: SIAM.
+//    Retrieved from http://www.netlib.org/templates/templates.pdf
+//  - Saad, Y., and Schultz, M. (1986). GMRES: A generalized minimal residual
+//    algorithm for solving nonsymmetric linear systems. SIAM J. Sci. Stat.
+//    Comput., 7(3), 856-869.
+type GMRES struct {
+	// Restart is the restart parameter which is the maximum dimension of
+	// the Krylov subspace built in each restart cycle. If it is zero, it
+	// will be set to min(n, 30) where n is the dimension of the system.
+	// Restart must be non-negative.
+	Restart int
+
+	m int
+
+	x *mat.VecDense
+	r mat.VecDense
+
+	// v holds the orthonormal basis of the
+	// Krylov subspace in its first j+1 elements.
+	v []mat.VecDense
+	w mat.VecDense
+	z mat.VecDense
+
+	// h is the (m+1)×m upper Hessenberg matrix
+	// transformed into upper triangular form.
+	h      *mat.Dense
+	cs, sn []float64
+	g      []float64
+
+	j      int
+	happy  bool
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (g *GMRES) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("gmres: vector length mismatch")
+	}
+	if g.Restart < 0 {
+		panic("gmres: negative restart")
+	}
+	g.m = g.Restart
+	if g.m == 0 {
+		g.m = min(n, 30)
+	}
+	g.m = min(g.m, n)
+
+	g.x = x
+	reuseAs(&g.r, n)
+	g.r.CopyVec(residual)
+	if cap(g.v) < g.m+1 {
+		g.v = make([]mat.VecDense, g.m+1)
+	}
+	g.v = g.v[:g.m+1]
+	for i := range g.v {
+		reuseAs(&g.v[i], n)
+	}
+	reuseAs(&g.w, n)
+	reuseAs(&g.z, n)
+	g.h = mat.NewDense(g.m+1, g.m, nil)
+	g.cs = make([]float64, g.m)
+	g.sn = make([]float64, g.m)
+	g.g = make([]float64, g.m+1)
+	g.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// GMRES will command the following operations:
+//  MulVec
+//  PreconSolve
+//  CheckResidualNorm
+//  ComputeResidual
+//  MajorIteration
+func (g *GMRES) Iterate(ctx *Context) (Operation, error) {
+	switch g.resume {
+	case 1:
+		// Start a new restart cycle from the current residual.
+		beta := mat.Norm(&g.r, 2)
+		if beta == 0 {
+			ctx.ResidualNorm = 0
+			return MajorIteration, nil
+		}
+		g.v[0].ScaleVec(1/beta, &g.r)
+		for i := range g.g {
+			g.g[i] = 0
+		}
+		g.g[0] = beta
+		g.h.Zero()
+		g.j = 0
+		g.happy = false
+		fallthrough
+	case 2:
+		// Solve M z = v_j.
+		ctx.Src = &g.v[g.j]
+		ctx.Dst = &g.z
+		g.resume = 3
+		return PreconSolve, nil
+	case 3:
+		// Compute w = A z.
+		ctx.Src = &g.z
+		ctx.Dst = &g.w
+		g.resume = 4
+		return MulVec, nil
+	case 4:
+		j := g.j
+		// Orthogonalize w against the basis using
+		// the modified Gram-Schmidt process.
+		for i := 0; i <= j; i++ {
+			hij := mat.Dot(&g.w, &g.v[i])
+			g.h.Set(i, j, hij)
+			g.w.AddScaledVec(&g.w, -hij, &g.v[i])
+		}
+		hj1 := mat.Norm(&g.w, 2)
+		g.h.Set(j+1, j, hj1)
+		if hj1 != 0 {
+			g.v[j+1].ScaleVec(1/hj1, &g.w)
+		} else {
+			// The Krylov subspace is invariant under A
+			// so the solution lies in the current subspace.
+			g.happy = true
+		}
+
+		// Apply the previous Givens rotations to the new column of H.
+		for i := 0; i < j; i++ {
+			hi := g.h.At(i, j)
+			hi1 := g.h.At(i+1, j)
+			g.h.Set(i, j, g.cs[i]*hi+g.sn[i]*hi1)
+			g.h.Set(i+1, j, -g.sn[i]*hi+g.cs[i]*hi1)
+		}
+		// Compute and apply the new rotation to eliminate H[j+1,j].
+		hjj := g.h.At(j, j)
+		rho := math.Hypot(hjj, hj1)
+		if rho == 0 {
+			g.resume = 0
+			return NoOperation, &BreakdownError{Value: 0, Tolerance: 0}
+		}
+		g.cs[j] = hjj / rho
+		g.sn[j] = hj1 / rho
+		g.h.Set(j, j, rho)
+		g.h.Set(j+1, j, 0)
+		g.g[j+1] = -g.sn[j] * g.g[j]
+		g.g[j] = g.cs[j] * g.g[j]
+		g.j++
+
+		ctx.ResidualNorm = math.Abs(g.g[g.j])
+		g.resume = 5
+		return CheckResidualNorm, nil
+	case 5:
+		if !ctx.Converged && !g.happy && g.j < g.m {
+			// Continue building the Krylov subspace.
+			ctx.Src = &g.v[g.j]
+			ctx.Dst = &g.z
+			g.resume = 3
+			return PreconSolve, nil
+		}
+		// Update the approximate solution with the minimizer
+		// over the current subspace.
+		g.updateSolution()
+		ctx.Src = &g.w
+		ctx.Dst = &g.z
+		g.resume = 6
+		return PreconSolve, nil
+	case 6:
+		g.x.AddVec(g.x, &g.z)
+		ctx.Dst = &g.r
+		g.resume = 7
+		return ComputeResidual, nil
+	case 7:
+		ctx.ResidualNorm = mat.Norm(&g.r, 2)
+		g.resume = 1
+		return MajorIteration, nil
+	default:
+		panic("gmres: Init not called")
+	}
+}
+
+// updateSolution solves the upper triangular system H y = g and stores the
+// linear combination V y of the basis vectors into g.w.
+func (g *GMRES) updateSolution() {
+	k := g.j
+	hRaw := g.h.RawMatrix()
+	y := make([]float64, k)
+	copy(y, g.g[:k])
+	blas64.Trsv(blas.NoTrans,
+		blas64.Triangular{
+			Uplo:   blas.Upper,
+			Diag:   blas.NonUnit,
+			N:      k,
+			Stride: hRaw.Stride,
+			Data:   hRaw.Data,
+		},
+		blas64.Vector{N: k, Inc: 1, Data: y},
+	)
+	g.w.Zero()
+	for i, yi := range y {
+		g.w.AddScaledVec(&g.w, yi, &g.v[i])
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,322 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultTolerance = 1e-8
+
+// ErrIterationLimit is returned when the maximum number of iterations is
+// reached without convergence.
+var ErrIterationLimit = errors.New("linsolve: iteration limit reached")
+
+// BreakdownError signifies that a breakdown occurred and the method cannot
+// continue.
+type BreakdownError struct {
+	Value     float64
+	Tolerance float64
+}
+
+func (e *BreakdownError) Error() string {
+	return fmt.Sprintf("linsolve: breakdown, value=%v tolerance=%v", e.Value, e.Tolerance)
+}
+
+// Method is an iterative method that produces a sequence of vectors
+// converging to the vector x satisfying a system of linear equations
+//  A * x = b,
+// where A is a non-singular n×n matrix and b is a given n-vector.
+//
+// Method uses a reverse-communication interface between the iterative
+// algorithm and the caller. Method acts as a client that asks the caller to
+// perform needed operations via Operation returned from the Iterate method.
+type Method interface {
+	// Init initializes the method for solving an n×n linear system with
+	// an initial estimate x and the corresponding residual vector.
+	//
+	// The method may retain x and must update it in place so that x holds
+	// the current approximate solution whenever Iterate returns
+	// MajorIteration or ComputeResidual. The method must not retain the
+	// residual.
+	Init(x, residual *mat.VecDense)
+
+	// Iterate performs a step of the method and returns the Operation
+	// that the caller must perform for the method to continue. The
+	// operands of the operation are passed in ctx.
+	//
+	// If an error is returned, the iteration is terminated.
+	Iterate(ctx *Context) (Operation, error)
+}
+
+// Context mediates the communication between the Method and the caller. The
+// caller must not use values returned by Method.Iterate unless the
+// documentation for the returned Operation specifies otherwise.
+type Context struct {
+	// ResidualNorm is (an estimate of) the norm of the current residual.
+	// It must be set by the Method before returning CheckResidualNorm or
+	// MajorIteration.
+	ResidualNorm float64
+
+	// Converged indicates to the Method that the current residual norm
+	// is small enough for the iteration to be considered converged. It is
+	// set by the caller in response to CheckResidualNorm.
+	Converged bool
+
+	// Src and Dst are the operands of the operations requested by the
+	// Method. They are set by the Method.
+	Src, Dst *mat.VecDense
+}
+
+// Operation specifies the type of operation.
+type Operation uint
+
+// Operations commanded by Method.Iterate.
+const (
+	NoOperation Operation = 0
+
+	// Compute A*x where x is stored in Context.Src. The result will be
+	// stored in Context.Dst.
+	MulVec Operation = 1 << (iota - 1)
+
+	// Perform a preconditioner solve
+	//  M z = r
+	// where r is stored in Context.Src. The solution z will be stored in
+	// Context.Dst.
+	PreconSolve
+
+	// Trans indicates that MulVec or PreconSolve should be performed
+	// with the transpose, that is, compute Aᵀ*x or solve Mᵀ z = r.
+	Trans
+
+	// Check convergence using the residual norm stored in
+	// Context.ResidualNorm. Context.Converged will be set to indicate
+	// whether convergence has been reached.
+	CheckResidualNorm
+
+	// Compute the residual b - A*x where x is the current approximate
+	// solution. The result will be stored in Context.Dst.
+	ComputeResidual
+
+	// The current iteration is complete. The approximate solution passed
+	// to Init has been updated and Context.ResidualNorm holds the norm of
+	// the corresponding residual. The caller checks convergence and
+	// stops the iteration if it has been reached.
+	MajorIteration
+)
+
+// Settings holds various settings for solving a linear system.
+type Settings struct {
+	// InitX holds the initial guess. If it is nil or empty, the zero vector
+	// will be used, otherwise its length must be equal to the dimension of
+	// the system.
+	InitX *mat.VecDense
+
+	// Dst, if not nil, will be used for storing the approximate solution,
+	// otherwise a new vector will be allocated. In both cases the vector
+	// will also be returned in Result. If Dst is not empty, its length
+	// must be equal to the dimension of the system.
+	Dst *mat.VecDense
+
+	// Tolerance specifies error tolerance for the final approximate
+	// solution produced by the iterative method. Tolerance must be
+	// positive and smaller than one.
+	//
+	// If NormB is the norm of the right-hand side, then the
+	// iteration will be considered converged when the norm of the
+	// residual satisfies
+	//  ResidualNorm < Tolerance * NormB.
+	//
+	// If Tolerance is zero, a default value of 1e-8 will be used.
+	Tolerance float64
+
+	// MaxIterations is the limit on the number of major iterations. If it
+	// is zero, it will be set to twice the dimension of the system.
+	MaxIterations int
+
+	// Preconditioner is used to solve systems with the preconditioning
+	// matrix M. If it is nil, the identity preconditioner will be used.
+	Preconditioner Preconditioner
+
+	// Recorder, if not nil, is called at each major iteration with the
+	// current statistics and norm of the residual.
+	Recorder func(stats Stats, residualNorm float64)
+}
+
+// Result holds the result of an iterative solve.
+type Result struct {
+	// X is the approximate solution.
+	X *mat.VecDense
+
+	// ResidualNorm is the norm of the final residual b - A*X.
+	ResidualNorm float64
+
+	// Stats holds statistics about the iterative solve.
+	Stats Stats
+}
+
+// Stats holds statistics about an iterative solve.
+type Stats struct {
+	MajorIterations int           // Total number of major iterations
+	MulVec          int           // Number of MulVec operations
+	PreconSolve     int           // Number of PreconSolve operations
+	Runtime         time.Duration // Total runtime of the solve
+}
+
+// Iterative finds an approximate solution of the system of n linear
+// equations
+//  A*x = b,
+// where A is a non-singular square matrix of order n and b is the
+// right-hand side vector, using an iterative method m. If m is nil, default
+// GMRES will be used.
+//
+// settings provide means for adjusting the iterative process. Zero values of
+// the fields mean default values.
+//
+// Iterative returns ErrIterationLimit if the iteration did not converge
+// within the allowed number of iterations. If the method fails, the error
+// returned by the method is returned. In both cases the result holds the
+// last approximation produced by the method.
+func Iterative(a mat.MulVecToer, b mat.Vector, m Method, settings *Settings) (*Result, error) {
+	n := b.Len()
+
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	checkSettings(n, &s)
+	if m == nil {
+		m = &GMRES{}
+	}
+	start := time.Now()
+
+	var stats Stats
+	x := mat.NewVecDense(n, nil)
+	if s.InitX != nil && !s.InitX.IsEmpty() {
+		x.CopyVec(s.InitX)
+	}
+	r := mat.NewVecDense(n, nil)
+	computeResidual(r, a, b, x, &stats)
+
+	bnorm := mat.Norm(b, 2)
+	if bnorm == 0 {
+		// The solution to the system with a zero
+		// right-hand side is the zero vector.
+		x.Zero()
+		r.Zero()
+		bnorm = 1
+	}
+	rnorm := mat.Norm(r, 2)
+	tol := s.Tolerance * bnorm
+
+	var err error
+	if rnorm >= tol {
+		err = iterate(a, b, x, r, m, &s, tol, &stats)
+		computeResidual(r, a, b, x, &stats)
+		rnorm = mat.Norm(r, 2)
+	}
+
+	if s.Dst != nil {
+		if s.Dst.IsEmpty() {
+			s.Dst.ReuseAsVec(n)
+		}
+		s.Dst.CopyVec(x)
+		x = s.Dst
+	}
+	stats.Runtime = time.Since(start)
+	return &Result{
+		X:            x,
+		ResidualNorm: rnorm,
+		Stats:        stats,
+	}, err
+}
+
+func iterate(a mat.MulVecToer, b mat.Vector, x, r *mat.VecDense, m Method, s *Settings, tol float64, stats *Stats) error {
+	m.Init(x, r)
+	var ctx Context
+	for {
+		op, err := m.Iterate(&ctx)
+		if err != nil {
+			return err
+		}
+		switch op {
+		case NoOperation:
+		case MulVec, MulVec | Trans:
+			a.MulVecTo(ctx.Dst, op&Trans == Trans, ctx.Src)
+			stats.MulVec++
+		case PreconSolve, PreconSolve | Trans:
+			err = s.Preconditioner.PreconSolve(ctx.Dst, op&Trans == Trans, ctx.Src)
+			stats.PreconSolve++
+			if err != nil {
+				return err
+			}
+		case CheckResidualNorm:
+			ctx.Converged = ctx.ResidualNorm < tol
+		case ComputeResidual:
+			computeResidual(ctx.Dst, a, b, x, stats)
+		case MajorIteration:
+			stats.MajorIterations++
+			if s.Recorder != nil {
+				s.Recorder(*stats, ctx.ResidualNorm)
+			}
+			if ctx.ResidualNorm < tol {
+				return nil
+			}
+			if stats.MajorIterations >= s.MaxIterations {
+				return ErrIterationLimit
+			}
+		default:
+			panic("linsolve: invalid operation")
+		}
+	}
+}
+
+// computeResidual stores b - A*x into dst.
+func computeResidual(dst *mat.VecDense, a mat.MulVecToer, b mat.Vector, x *mat.VecDense, stats *Stats) {
+	a.MulVecTo(dst, false, x)
+	stats.MulVec++
+	dst.SubVec(b, dst)
+}
+
+func checkSettings(n int, s *Settings) {
+	if s.InitX != nil && !s.InitX.IsEmpty() && s.InitX.Len() != n {
+		panic("linsolve: mismatched length of initial guess")
+	}
+	if s.Dst != nil && !s.Dst.IsEmpty() && s.Dst.Len() != n {
+		panic("linsolve: mismatched destination length")
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = defaultTolerance
+	}
+	if s.Tolerance < 0 || 1 <= s.Tolerance {
+		panic("linsolve: invalid tolerance")
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 2 * n
+	}
+	if s.MaxIterations < 0 {
+		panic("linsolve: negative iteration limit")
+	}
+	if s.Preconditioner == nil {
+		s.Preconditioner = identity{}
+	}
+}
+
+// reuseAs resizes an empty vector to length n or resets and resizes a
+// non-empty vector of a different length. The vector is zeroed.
+func reuseAs(v *mat.VecDense, n int) {
+	if !v.IsEmpty() && v.Len() != n {
+		v.Reset()
+	}
+	if v.IsEmpty() {
+		v.ReuseAsVec(n)
+		return
+	}
+	v.Zero()
+}
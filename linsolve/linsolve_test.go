@@ -0,0 +1,343 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+type testProblem struct {
+	name      string
+	a         mat.Matrix
+	symmetric bool
+	spd       bool
+}
+
+// poisson1D returns the n×n tridiagonal matrix of the 1D Poisson problem
+// as a BandDense.
+func poisson1D(n int) *mat.BandDense {
+	a := mat.NewBandDense(n, n, 1, 1, nil)
+	for i := 0; i < n; i++ {
+		a.SetBand(i, i, 2)
+		if i > 0 {
+			a.SetBand(i, i-1, -1)
+		}
+		if i < n-1 {
+			a.SetBand(i, i+1, -1)
+		}
+	}
+	return a
+}
+
+// poisson2D returns the matrix of the 2D Poisson problem on a k×k grid as
+// a CSR matrix.
+func poisson2D(k int) *mat.CSR {
+	n := k * k
+	coo := mat.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			row := i*k + j
+			coo.Append(row, row, 4)
+			if i > 0 {
+				coo.Append(row, row-k, -1)
+			}
+			if i < k-1 {
+				coo.Append(row, row+k, -1)
+			}
+			if j > 0 {
+				coo.Append(row, row-1, -1)
+			}
+			if j < k-1 {
+				coo.Append(row, row+1, -1)
+			}
+		}
+	}
+	var a mat.CSR
+	a.CloneFrom(coo)
+	return &a
+}
+
+// convectionDiffusion returns a non-symmetric band matrix arising from
+// a finite difference discretization of a 1D convection-diffusion problem.
+func convectionDiffusion(n int, peclet float64) *mat.BandDense {
+	a := mat.NewBandDense(n, n, 1, 1, nil)
+	for i := 0; i < n; i++ {
+		a.SetBand(i, i, 2)
+		if i > 0 {
+			a.SetBand(i, i-1, -1-peclet)
+		}
+		if i < n-1 {
+			a.SetBand(i, i+1, -1+peclet)
+		}
+	}
+	return a
+}
+
+// randomSPD returns a random symmetric positive definite matrix.
+func randomSPD(n int, rnd *rand.Rand) *mat.SymDense {
+	b := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			b.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var a mat.SymDense
+	a.SymOuterK(1, b)
+	for i := 0; i < n; i++ {
+		a.SetSym(i, i, a.At(i, i)+float64(n))
+	}
+	return &a
+}
+
+// randomSymIndefinite returns a random symmetric indefinite matrix with
+// eigenvalues bounded away from zero.
+func randomSymIndefinite(n int, rnd *rand.Rand) *mat.SymDense {
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		d := float64(i + 1)
+		if i%2 == 1 {
+			d = -d
+		}
+		a.SetSym(i, i, d)
+		if i < n-1 {
+			a.SetSym(i, i+1, 0.1*rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// randomNonsym returns a random diagonally dominant non-symmetric matrix.
+func randomNonsym(n int, rnd *rand.Rand) *mat.Dense {
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j {
+				a.Set(i, j, float64(n))
+				continue
+			}
+			a.Set(i, j, rnd.Float64()-0.5)
+		}
+	}
+	return a
+}
+
+func testProblems() []testProblem {
+	rnd := rand.New(rand.NewSource(1))
+	return []testProblem{
+		{name: "Poisson1D", a: poisson1D(50), symmetric: true, spd: true},
+		{name: "Poisson2D", a: poisson2D(8), symmetric: true, spd: true},
+		{name: "RandomSPD", a: randomSPD(30, rnd), symmetric: true, spd: true},
+		{name: "RandomSymIndefinite", a: randomSymIndefinite(30, rnd), symmetric: true},
+		{name: "ConvectionDiffusion", a: convectionDiffusion(40, 0.3)},
+		{name: "RandomNonsym", a: randomNonsym(30, rnd)},
+	}
+}
+
+type testMethod struct {
+	name      string
+	newMethod func() Method
+	symmetric bool // Method requires a symmetric matrix.
+	spd       bool // Method requires a symmetric positive definite matrix.
+}
+
+func testMethods() []testMethod {
+	return []testMethod{
+		{name: "CG", newMethod: func() Method { return &CG{} }, symmetric: true, spd: true},
+		{name: "MINRES", newMethod: func() Method { return &MINRES{} }, symmetric: true},
+		{name: "GMRES", newMethod: func() Method { return &GMRES{} }},
+		{name: "GMRES(5)", newMethod: func() Method { return &GMRES{Restart: 5} }},
+		{name: "BiCGStab", newMethod: func() Method { return &BiCGStab{} }},
+	}
+}
+
+func TestIterative(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, prob := range testProblems() {
+		n, _ := prob.a.Dims()
+		want := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			want.SetVec(i, rnd.NormFloat64())
+		}
+		var b mat.VecDense
+		b.MulVec(prob.a, want)
+
+		for _, meth := range testMethods() {
+			if meth.symmetric && !prob.symmetric || meth.spd && !prob.spd {
+				continue
+			}
+			if meth.name == "GMRES(5)" && prob.symmetric && !prob.spd {
+				// Restarted GMRES may stagnate for indefinite matrices.
+				continue
+			}
+			for _, precon := range []string{"None", "Jacobi", "IC0", "ILU0"} {
+				if precon == "IC0" && !prob.spd {
+					continue
+				}
+				if precon == "Jacobi" && meth.symmetric && !prob.spd {
+					// MINRES requires a positive definite preconditioner.
+					continue
+				}
+				if precon == "ILU0" && meth.symmetric {
+					// ILU0 of a symmetric matrix is not symmetric in general.
+					continue
+				}
+				name := fmt.Sprintf("%s/%s/%s", prob.name, meth.name, precon)
+
+				settings := &Settings{
+					Tolerance:     1e-10,
+					MaxIterations: 10 * n,
+				}
+				var err error
+				switch precon {
+				case "Jacobi":
+					settings.Preconditioner, err = NewJacobi(prob.a)
+				case "IC0":
+					settings.Preconditioner, err = NewIC0(prob.a)
+				case "ILU0":
+					settings.Preconditioner, err = NewILU0(prob.a)
+				}
+				if err != nil {
+					t.Errorf("%s: unexpected error creating preconditioner: %v", name, err)
+					continue
+				}
+				var majors int
+				settings.Recorder = func(stats Stats, _ float64) {
+					majors = stats.MajorIterations
+				}
+
+				result, err := Iterative(mat.Operator(prob.a), &b, meth.newMethod(), settings)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if result.Stats.MajorIterations != majors {
+					t.Errorf("%s: mismatched major iteration count: got %d, recorded %d", name, result.Stats.MajorIterations, majors)
+				}
+				bnorm := mat.Norm(&b, 2)
+				if result.ResidualNorm > 1e-8*bnorm {
+					t.Errorf("%s: residual norm too large: got %v, want <= %v", name, result.ResidualNorm, 1e-8*bnorm)
+				}
+				var r mat.VecDense
+				r.MulVec(prob.a, result.X)
+				r.SubVec(&b, &r)
+				if rnorm := mat.Norm(&r, 2); !scalar.EqualWithinAbsOrRel(rnorm, result.ResidualNorm, 1e-14, 1e-8) {
+					t.Errorf("%s: mismatched residual norm: got %v, want %v", name, result.ResidualNorm, rnorm)
+				}
+				if !mat.EqualApprox(result.X, want, 1e-6) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+			}
+		}
+	}
+}
+
+func TestIterativeDefaultMethod(t *testing.T) {
+	t.Parallel()
+	a := convectionDiffusion(20, 0.5)
+	b := mat.NewVecDense(20, nil)
+	for i := 0; i < 20; i++ {
+		b.SetVec(i, 1)
+	}
+	dst := &mat.VecDense{}
+	result, err := Iterative(mat.Operator(a), b, nil, &Settings{Dst: dst})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.X != dst {
+		t.Errorf("result not stored in Settings.Dst")
+	}
+	if result.ResidualNorm > defaultTolerance*mat.Norm(b, 2) {
+		t.Errorf("residual norm too large: %v", result.ResidualNorm)
+	}
+}
+
+func TestIterativeZeroRHS(t *testing.T) {
+	t.Parallel()
+	a := poisson1D(10)
+	b := mat.NewVecDense(10, nil)
+	initX := mat.NewVecDense(10, nil)
+	for i := 0; i < 10; i++ {
+		initX.SetVec(i, float64(i+1))
+	}
+	for _, meth := range testMethods() {
+		result, err := Iterative(mat.Operator(a), b, meth.newMethod(), &Settings{InitX: initX})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", meth.name, err)
+			continue
+		}
+		if mat.Norm(result.X, 2) != 0 {
+			t.Errorf("%s: solution not zero for zero right-hand side", meth.name)
+		}
+		if result.Stats.MajorIterations != 0 {
+			t.Errorf("%s: unexpected iterations for zero right-hand side", meth.name)
+		}
+	}
+}
+
+func TestIterativeInitX(t *testing.T) {
+	t.Parallel()
+	a := poisson1D(10)
+	want := mat.NewVecDense(10, nil)
+	for i := 0; i < 10; i++ {
+		want.SetVec(i, float64(i+1))
+	}
+	var b mat.VecDense
+	b.MulVec(a, want)
+	for _, meth := range testMethods() {
+		result, err := Iterative(mat.Operator(a), &b, meth.newMethod(), &Settings{InitX: want})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", meth.name, err)
+			continue
+		}
+		if result.Stats.MajorIterations != 0 {
+			t.Errorf("%s: unexpected iterations for exact initial guess", meth.name)
+		}
+		if !mat.Equal(result.X, want) {
+			t.Errorf("%s: unexpected solution for exact initial guess", meth.name)
+		}
+	}
+}
+
+func TestIterativeIterationLimit(t *testing.T) {
+	t.Parallel()
+	a := poisson1D(100)
+	b := mat.NewVecDense(100, nil)
+	for i := 0; i < 100; i++ {
+		b.SetVec(i, 1)
+	}
+	for _, meth := range testMethods() {
+		result, err := Iterative(mat.Operator(a), b, meth.newMethod(), &Settings{MaxIterations: 3})
+		if err != ErrIterationLimit {
+			t.Errorf("%s: unexpected error: got %v, want %v", meth.name, err, ErrIterationLimit)
+			continue
+		}
+		if result.Stats.MajorIterations != 3 {
+			t.Errorf("%s: unexpected number of iterations: got %d, want 3", meth.name, result.Stats.MajorIterations)
+		}
+		if result.X == nil || result.X.Len() != 100 {
+			t.Errorf("%s: missing approximate solution", meth.name)
+		}
+	}
+}
+
+func TestCGBreakdown(t *testing.T) {
+	t.Parallel()
+	a := mat.NewSymDense(2, []float64{
+		1, 0,
+		0, -1,
+	})
+	b := mat.NewVecDense(2, []float64{1, 1})
+	_, err := Iterative(mat.Operator(a), b, &CG{}, nil)
+	if _, ok := err.(*BreakdownError); !ok {
+		t.Errorf("unexpected error for indefinite matrix: got %v, want *BreakdownError", err)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// MINRES implements the Minimum Residual method with preconditioning for
+// solving systems of linear equations
+//  A * x = b,
+// where A is a symmetric, possibly indefinite, matrix. It requires one
+// matrix-vector product and one preconditioner solve per iteration.
+//
+// The preconditioner used with MINRES must be symmetric positive definite.
+// If a preconditioner is used, the residual norm reported at each major
+// iteration is an estimate of the norm of the residual in the norm induced
+// by the inverse of the preconditioner. The residual norm returned by
+// Iterative is always the Euclidean norm.
+//
+// References:
+//  - Paige, C. C., and Saunders, M. A. (1975). Solution of sparse indefinite
+//    systems of linear equations. SIAM J. Numer. Anal., 12(4), 617-629.
+type MINRES struct {
+	x            *mat.VecDense
+	r1, r2, y, v mat.VecDense
+	w, w1, w2    mat.VecDense
+
+	alpha       float64
+	beta, oldb  float64
+	dbar, epsln float64
+	phibar      float64
+	cs, sn      float64
+	iter        int
+
+	resume int
+}
+
+// errIndefinitePreconditioner is returned when the preconditioner is found
+// not to be positive definite.
+var errIndefinitePreconditioner = errors.New("linsolve: preconditioner not positive definite")
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (m *MINRES) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("minres: vector length mismatch")
+	}
+	m.x = x
+	reuseAs(&m.r1, n)
+	m.r1.CopyVec(residual)
+	reuseAs(&m.r2, n)
+	m.r2.CopyVec(residual)
+	reuseAs(&m.y, n)
+	reuseAs(&m.v, n)
+	reuseAs(&m.w, n)
+	reuseAs(&m.w1, n)
+	reuseAs(&m.w2, n)
+	m.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// MINRES will command the following operations:
+//  MulVec
+//  PreconSolve
+//  MajorIteration
+func (m *MINRES) Iterate(ctx *Context) (Operation, error) {
+	switch m.resume {
+	case 1:
+		// Solve M y = r_0.
+		ctx.Src = &m.r1
+		ctx.Dst = &m.y
+		m.resume = 2
+		return PreconSolve, nil
+	case 2:
+		beta1 := mat.Dot(&m.r1, &m.y)
+		if beta1 < 0 {
+			m.resume = 0
+			return NoOperation, errIndefinitePreconditioner
+		}
+		beta1 = math.Sqrt(beta1)
+		m.oldb = 0
+		m.beta = beta1
+		m.dbar = 0
+		m.epsln = 0
+		m.phibar = beta1
+		m.cs = -1
+		m.sn = 0
+		m.iter = 0
+		m.w.Zero()
+		m.w2.Zero()
+		fallthrough
+	case 3:
+		if m.beta == 0 {
+			// The Lanczos process has terminated so the
+			// current approximation cannot be improved.
+			ctx.ResidualNorm = m.phibar
+			m.resume = 0
+			return NoOperation, &BreakdownError{Value: 0, Tolerance: 0}
+		}
+		m.iter++
+		// Compute A v where v = y / β.
+		m.v.ScaleVec(1/m.beta, &m.y)
+		ctx.Src = &m.v
+		ctx.Dst = &m.y
+		m.resume = 4
+		return MulVec, nil
+	case 4:
+		if m.iter >= 2 {
+			m.y.AddScaledVec(&m.y, -m.beta/m.oldb, &m.r1)
+		}
+		alpha := mat.Dot(&m.v, &m.y)
+		m.y.AddScaledVec(&m.y, -alpha/m.beta, &m.r2)
+		m.r1.CopyVec(&m.r2)
+		m.r2.CopyVec(&m.y)
+
+		// Store α for use after the preconditioner solve.
+		m.alpha = alpha
+
+		// Solve M y = r_2.
+		ctx.Src = &m.r2
+		ctx.Dst = &m.y
+		m.resume = 5
+		return PreconSolve, nil
+	case 5:
+		m.oldb = m.beta
+		beta := mat.Dot(&m.r2, &m.y)
+		if beta < 0 {
+			m.resume = 0
+			return NoOperation, errIndefinitePreconditioner
+		}
+		m.beta = math.Sqrt(beta)
+
+		// Apply the previous rotation.
+		oldeps := m.epsln
+		delta := m.cs*m.dbar + m.sn*m.alpha
+		gbar := m.sn*m.dbar - m.cs*m.alpha
+		m.epsln = m.sn * m.beta
+		m.dbar = -m.cs * m.beta
+
+		// Compute the next rotation.
+		gamma := math.Max(math.Hypot(gbar, m.beta), dlamchE)
+		m.cs = gbar / gamma
+		m.sn = m.beta / gamma
+		phi := m.cs * m.phibar
+		m.phibar *= m.sn
+
+		// Update the search direction and the solution.
+		m.w1, m.w2, m.w = m.w2, m.w, m.w1
+		m.w.AddScaledVec(&m.v, -oldeps, &m.w1)
+		m.w.AddScaledVec(&m.w, -delta, &m.w2)
+		m.w.ScaleVec(1/gamma, &m.w)
+		m.x.AddScaledVec(m.x, phi, &m.w)
+
+		ctx.ResidualNorm = m.phibar
+		m.resume = 3
+		return MajorIteration, nil
+	default:
+		panic("minres: Init not called")
+	}
+}
+
+// dlamchE is the machine epsilon.
+const dlamchE = 1.0 / (1 << 53)
@@ -0,0 +1,320 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Preconditioner solves systems with a preconditioning matrix M that
+// approximates the system matrix A.
+type Preconditioner interface {
+	// PreconSolve solves
+	//  M z = r   if trans is false,
+	//  Mᵀz = r   if trans is true,
+	// and stores the solution z into dst. dst will have the same length
+	// as rhs.
+	PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error
+}
+
+// identity is the identity preconditioner.
+type identity struct{}
+
+func (identity) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	dst.CopyVec(rhs)
+	return nil
+}
+
+// Jacobi is the Jacobi (diagonal) preconditioner
+//  M = diag(A).
+type Jacobi struct {
+	inv []float64
+}
+
+// NewJacobi returns a Jacobi preconditioner for the square matrix a. If a
+// diagonal element of a is zero, NewJacobi returns an error.
+func NewJacobi(a mat.Matrix) (*Jacobi, error) {
+	n := checkSquare(a)
+	inv := make([]float64, n)
+	for i := range inv {
+		d := a.At(i, i)
+		if d == 0 {
+			return nil, fmt.Errorf("linsolve: zero diagonal element in row %d", i)
+		}
+		inv[i] = 1 / d
+	}
+	return &Jacobi{inv: inv}, nil
+}
+
+// PreconSolve implements the Preconditioner interface.
+func (p *Jacobi) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	if rhs.Len() != len(p.inv) {
+		panic(mat.ErrShape)
+	}
+	for i, d := range p.inv {
+		dst.SetVec(i, d*rhs.AtVec(i))
+	}
+	return nil
+}
+
+// IC0 is the incomplete Cholesky preconditioner with no fill-in
+//  M = L Lᵀ,
+// where the lower triangular factor L has the same sparsity pattern as the
+// lower triangle of a symmetric positive definite matrix A.
+type IC0 struct {
+	l    rowCompressed
+	work []float64
+}
+
+// NewIC0 returns an incomplete Cholesky preconditioner for the symmetric
+// positive definite matrix a. Only the lower triangle of a is referenced.
+// If a non-positive pivot is encountered during the factorization, NewIC0
+// returns an error.
+func NewIC0(a mat.Matrix) (*IC0, error) {
+	n := checkSquare(a)
+	l := newRowCompressed(a, func(i, j int) bool { return j <= i })
+	for i := 0; i < n; i++ {
+		start, end := l.indptr[i], l.indptr[i+1]
+		if start == end || l.ind[end-1] != i {
+			return nil, fmt.Errorf("linsolve: zero diagonal element in row %d", i)
+		}
+		for p := start; p < end; p++ {
+			k := l.ind[p]
+			// Compute the dot product of the already computed
+			// parts of rows i and k of L with columns less than k.
+			var sum float64
+			q, qEnd := l.indptr[k], l.indptr[k+1]
+			for pp := start; pp < p; pp++ {
+				j := l.ind[pp]
+				for q < qEnd && l.ind[q] < j {
+					q++
+				}
+				if q < qEnd && l.ind[q] == j {
+					sum += l.data[pp] * l.data[q]
+				}
+			}
+			v := l.data[p] - sum
+			if k < i {
+				l.data[p] = v / l.data[l.indptr[k+1]-1]
+				continue
+			}
+			if v <= 0 {
+				return nil, fmt.Errorf("linsolve: non-positive pivot in row %d", i)
+			}
+			l.data[p] = math.Sqrt(v)
+		}
+	}
+	return &IC0{l: l, work: make([]float64, n)}, nil
+}
+
+// PreconSolve implements the Preconditioner interface. Since M is symmetric,
+// trans is ignored.
+func (p *IC0) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	l := &p.l
+	n := len(p.work)
+	if rhs.Len() != n {
+		panic(mat.ErrShape)
+	}
+	x := p.work
+	for i := range x {
+		x[i] = rhs.AtVec(i)
+	}
+	// Solve L y = r.
+	for i := 0; i < n; i++ {
+		end := l.indptr[i+1] - 1
+		sum := x[i]
+		for p := l.indptr[i]; p < end; p++ {
+			sum -= l.data[p] * x[l.ind[p]]
+		}
+		x[i] = sum / l.data[end]
+	}
+	// Solve Lᵀ z = y.
+	for i := n - 1; i >= 0; i-- {
+		end := l.indptr[i+1] - 1
+		x[i] /= l.data[end]
+		for p := l.indptr[i]; p < end; p++ {
+			x[l.ind[p]] -= l.data[p] * x[i]
+		}
+	}
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// ILU0 is the incomplete LU preconditioner with no fill-in
+//  M = L U,
+// where the unit lower triangular factor L and the upper triangular factor U
+// have the same sparsity pattern as the matrix A.
+type ILU0 struct {
+	lu   rowCompressed
+	diag []int
+	work []float64
+}
+
+// NewILU0 returns an incomplete LU preconditioner for the square matrix a.
+// If a zero pivot is encountered during the factorization, NewILU0 returns
+// an error.
+func NewILU0(a mat.Matrix) (*ILU0, error) {
+	n := checkSquare(a)
+	lu := newRowCompressed(a, nil)
+	diag := make([]int, n)
+	for i := 0; i < n; i++ {
+		start, end := lu.indptr[i], lu.indptr[i+1]
+		k := start + sort.SearchInts(lu.ind[start:end], i)
+		if k == end || lu.ind[k] != i {
+			return nil, fmt.Errorf("linsolve: zero diagonal element in row %d", i)
+		}
+		diag[i] = k
+	}
+
+	// pos maps the column index of an element
+	// in the current row to its position.
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i := 0; i < n; i++ {
+		start, end := lu.indptr[i], lu.indptr[i+1]
+		for p := start; p < end; p++ {
+			pos[lu.ind[p]] = p
+		}
+		for p := start; p < diag[i]; p++ {
+			k := lu.ind[p]
+			ukk := lu.data[diag[k]]
+			if ukk == 0 {
+				return nil, fmt.Errorf("linsolve: zero pivot in row %d", k)
+			}
+			lik := lu.data[p] / ukk
+			lu.data[p] = lik
+			for q := diag[k] + 1; q < lu.indptr[k+1]; q++ {
+				if pp := pos[lu.ind[q]]; pp != -1 {
+					lu.data[pp] -= lik * lu.data[q]
+				}
+			}
+		}
+		if lu.data[diag[i]] == 0 {
+			return nil, fmt.Errorf("linsolve: zero pivot in row %d", i)
+		}
+		for p := start; p < end; p++ {
+			pos[lu.ind[p]] = -1
+		}
+	}
+	return &ILU0{lu: lu, diag: diag, work: make([]float64, n)}, nil
+}
+
+// PreconSolve implements the Preconditioner interface.
+func (p *ILU0) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	lu := &p.lu
+	n := len(p.work)
+	if rhs.Len() != n {
+		panic(mat.ErrShape)
+	}
+	x := p.work
+	for i := range x {
+		x[i] = rhs.AtVec(i)
+	}
+	if !trans {
+		// Solve L y = r.
+		for i := 0; i < n; i++ {
+			sum := x[i]
+			for q := lu.indptr[i]; q < p.diag[i]; q++ {
+				sum -= lu.data[q] * x[lu.ind[q]]
+			}
+			x[i] = sum
+		}
+		// Solve U z = y.
+		for i := n - 1; i >= 0; i-- {
+			sum := x[i]
+			for q := p.diag[i] + 1; q < lu.indptr[i+1]; q++ {
+				sum -= lu.data[q] * x[lu.ind[q]]
+			}
+			x[i] = sum / lu.data[p.diag[i]]
+		}
+	} else {
+		// Solve Uᵀ y = r.
+		for i := 0; i < n; i++ {
+			x[i] /= lu.data[p.diag[i]]
+			for q := p.diag[i] + 1; q < lu.indptr[i+1]; q++ {
+				x[lu.ind[q]] -= lu.data[q] * x[i]
+			}
+		}
+		// Solve Lᵀ z = y.
+		for i := n - 1; i >= 0; i-- {
+			for q := lu.indptr[i]; q < p.diag[i]; q++ {
+				x[lu.ind[q]] -= lu.data[q] * x[i]
+			}
+		}
+	}
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// rowCompressed is a sparse matrix in compressed sparse row format with the
+// column indices sorted within each row.
+type rowCompressed struct {
+	indptr []int
+	ind    []int
+	data   []float64
+}
+
+// newRowCompressed returns the non-zero elements of the n×n matrix a in
+// row compressed format. If keep is not nil, only the elements for which it
+// returns true are stored.
+func newRowCompressed(a mat.Matrix, keep func(i, j int) bool) rowCompressed {
+	n, _ := a.Dims()
+	rc := rowCompressed{indptr: make([]int, n+1)}
+	add := func(i, j int, v float64) {
+		if v == 0 || (keep != nil && !keep(i, j)) {
+			return
+		}
+		rc.ind = append(rc.ind, j)
+		rc.data = append(rc.data, v)
+	}
+	rnz, isRowNonZero := a.(mat.RowNonZeroDoer)
+	for i := 0; i < n; i++ {
+		if isRowNonZero {
+			rnz.DoRowNonZero(i, add)
+		} else {
+			for j := 0; j < n; j++ {
+				add(i, j, a.At(i, j))
+			}
+		}
+		rc.indptr[i+1] = len(rc.ind)
+		sort.Sort(rowSegment{
+			ind:  rc.ind[rc.indptr[i]:],
+			data: rc.data[rc.indptr[i]:],
+		})
+	}
+	return rc
+}
+
+// rowSegment sorts the elements of a row by column index.
+type rowSegment struct {
+	ind  []int
+	data []float64
+}
+
+func (s rowSegment) Len() int           { return len(s.ind) }
+func (s rowSegment) Less(i, j int) bool { return s.ind[i] < s.ind[j] }
+func (s rowSegment) Swap(i, j int) {
+	s.ind[i], s.ind[j] = s.ind[j], s.ind[i]
+	s.data[i], s.data[j] = s.data[j], s.data[i]
+}
+
+// checkSquare panics if a is not square and returns its order.
+func checkSquare(a mat.Matrix) int {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	return r
+}
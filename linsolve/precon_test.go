@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// preconMatrix returns the dense matrix M represented by the preconditioner p
+// by solving with the columns of the identity matrix to form M⁻¹ and
+// inverting it.
+func preconMatrix(t *testing.T, p Preconditioner, n int, trans bool) *mat.Dense {
+	minv := mat.NewDense(n, n, nil)
+	e := mat.NewVecDense(n, nil)
+	z := mat.NewVecDense(n, nil)
+	for j := 0; j < n; j++ {
+		e.Zero()
+		e.SetVec(j, 1)
+		err := p.PreconSolve(z, trans, e)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		minv.SetCol(j, z.RawVector().Data)
+	}
+	var m mat.Dense
+	err := m.Inverse(minv)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &m
+}
+
+func TestJacobi(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	a := randomNonsym(10, rnd)
+	p, err := NewJacobi(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := preconMatrix(t, p, 10, false)
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			want := 0.0
+			if i == j {
+				want = a.At(i, i)
+			}
+			if got := m.At(i, j); !scalar.EqualWithinAbs(got, want, 1e-10) {
+				t.Errorf("unexpected M[%d,%d]: got %v, want %v", i, j, got, want)
+			}
+		}
+	}
+
+	a.Set(3, 3, 0)
+	_, err = NewJacobi(a)
+	if err == nil {
+		t.Error("expected error for zero diagonal element")
+	}
+}
+
+func TestIC0(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, a := range []mat.Matrix{
+		poisson1D(10),
+		randomSPD(10, rnd),
+	} {
+		// For matrices with no fill-in in the Cholesky factorization,
+		// the incomplete factorization is exact.
+		n, _ := a.Dims()
+		p, err := NewIC0(a)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, trans := range []bool{false, true} {
+			m := preconMatrix(t, p, n, trans)
+			if !mat.EqualApprox(m, a, 1e-10) {
+				t.Errorf("unexpected preconditioner matrix for trans=%t", trans)
+			}
+		}
+	}
+
+	// The pattern of the 2D Poisson matrix produces fill-in so the
+	// preconditioner must agree with A only on the pattern of A.
+	a := poisson2D(4)
+	p, err := NewIC0(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := preconMatrix(t, p, 16, false)
+	a.DoNonZero(func(i, j int, v float64) {
+		if !scalar.EqualWithinAbs(m.At(i, j), v, 1e-10) {
+			t.Errorf("unexpected M[%d,%d] on pattern: got %v, want %v", i, j, m.At(i, j), v)
+		}
+	})
+
+	_, err = NewIC0(mat.NewSymDense(2, []float64{1, 2, 2, 1}))
+	if err == nil {
+		t.Error("expected error for indefinite matrix")
+	}
+}
+
+func TestILU0(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, a := range []mat.Matrix{
+		convectionDiffusion(10, 0.3),
+		randomNonsym(10, rnd),
+	} {
+		// For matrices with no fill-in in the LU factorization,
+		// the incomplete factorization is exact.
+		n, _ := a.Dims()
+		p, err := NewILU0(a)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		m := preconMatrix(t, p, n, false)
+		if !mat.EqualApprox(m, a, 1e-10) {
+			t.Errorf("unexpected preconditioner matrix")
+		}
+		m = preconMatrix(t, p, n, true)
+		if !mat.EqualApprox(m, a.T(), 1e-10) {
+			t.Errorf("unexpected transposed preconditioner matrix")
+		}
+	}
+
+	a := poisson2D(4)
+	p, err := NewILU0(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := preconMatrix(t, p, 16, false)
+	a.DoNonZero(func(i, j int, v float64) {
+		if !scalar.EqualWithinAbs(m.At(i, j), v, 1e-10) {
+			t.Errorf("unexpected M[%d,%d] on pattern: got %v, want %v", i, j, m.At(i, j), v)
+		}
+	})
+
+	_, err = NewILU0(mat.NewDense(2, 2, []float64{0, 1, 1, 0}))
+	if err == nil {
+		t.Error("expected error for zero diagonal")
+	}
+}
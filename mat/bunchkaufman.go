@@ -0,0 +1,272 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badBunchKaufman = "mat: invalid Bunch-Kaufman factorization"
+
+// BunchKaufman is a type for creating and using the Bunch-Kaufman
+// factorization of a symmetric, possibly indefinite, matrix
+//  A = U * D * Uᵀ,
+// where U is a product of permutation and unit upper triangular matrices and
+// D is symmetric and block diagonal with 1×1 and 2×2 diagonal blocks.
+//
+// BunchKaufman methods may only be called on a value that has been initialized
+// by a call to Factorize.
+type BunchKaufman struct {
+	// The fact pointer must never be retained as a pointer outside the
+	// BunchKaufman struct, either by returning fact outside the struct or by
+	// setting it to a pointer coming from outside. The same prohibition
+	// applies to the data slice within fact.
+	fact *SymDense
+	ipiv []int
+	cond float64
+}
+
+// Factorize computes the Bunch-Kaufman factorization of the symmetric matrix a
+// and returns whether the block diagonal factor D is nonsingular. The
+// factorization completes regardless of the singularity of a, so Det, LogDet
+// and Inertia may be called even if Factorize returns false. Attempts to solve
+// a system of equations with a singular factorization will return a Condition
+// error.
+func (bk *BunchKaufman) Factorize(a Symmetric) (ok bool) {
+	n := a.Symmetric()
+	if bk.fact == nil {
+		bk.fact = NewSymDense(n, nil)
+	} else {
+		bk.fact.Reset()
+		bk.fact.reuseAsNonZeroed(n)
+	}
+	bk.fact.CopySym(a)
+	if cap(bk.ipiv) < n {
+		bk.ipiv = make([]int, n)
+	}
+	bk.ipiv = bk.ipiv[:n]
+
+	sym := bk.fact.mat
+	work := []float64{0}
+	lapack64.Sytrf(sym, bk.ipiv, work, -1)
+	lwork := int(work[0])
+	work = getFloat64s(max(lwork, 2*n), false)
+	defer putFloat64s(work)
+	anorm := lapack64.Lansy(CondNorm, sym, work)
+	ok = lapack64.Sytrf(sym, bk.ipiv, work, lwork)
+	if !ok {
+		bk.cond = math.Inf(1)
+		return false
+	}
+
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Sycon(sym, bk.ipiv, anorm, work[:2*n], iwork)
+	bk.cond = 1 / v
+	return true
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (bk *BunchKaufman) isValid() bool {
+	return bk.fact != nil && !bk.fact.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (bk *BunchKaufman) Reset() {
+	if bk.fact != nil {
+		bk.fact.Reset()
+	}
+	bk.ipiv = bk.ipiv[:0]
+	bk.cond = math.Inf(1)
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (bk *BunchKaufman) IsEmpty() bool {
+	return bk.fact == nil || bk.fact.IsEmpty()
+}
+
+// Symmetric returns the number of rows (and columns) in the factorized matrix.
+func (bk *BunchKaufman) Symmetric() int {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	return bk.fact.mat.N
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Cond() float64 {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	return bk.cond
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Det() float64 {
+	det, sign := bk.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) LogDet() (det float64, sign float64) {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+
+	// The permutations in U have unit determinant when applied as
+	// U * D * Uᵀ, so det(A) = det(D).
+	sign = 1
+	n := bk.fact.mat.N
+	for k := 0; k < n; {
+		var v float64
+		if bk.ipiv[k] >= 0 {
+			v = bk.fact.at(k, k)
+			k++
+		} else {
+			// The 2×2 block is stored in the upper triangle at
+			// rows and columns k and k+1.
+			a := bk.fact.at(k, k)
+			b := bk.fact.at(k, k+1)
+			c := bk.fact.at(k+1, k+1)
+			// Compute a*c - b*b avoiding overflow.
+			v = ((a/b)*c - b) * b
+			k += 2
+		}
+		if v < 0 {
+			sign *= -1
+			v = -v
+		}
+		det += math.Log(v)
+	}
+	return det, sign
+}
+
+// Inertia returns the inertia of the factorized matrix, that is the number of
+// positive, negative and zero eigenvalues. By Sylvester's law of inertia these
+// are the same as those of the block diagonal factor D.
+// Inertia will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Inertia() (pos, neg, zero int) {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	count := func(v float64) {
+		switch {
+		case v > 0:
+			pos++
+		case v < 0:
+			neg++
+		default:
+			zero++
+		}
+	}
+	n := bk.fact.mat.N
+	for k := 0; k < n; {
+		if bk.ipiv[k] >= 0 {
+			count(bk.fact.at(k, k))
+			k++
+			continue
+		}
+		a := bk.fact.at(k, k)
+		b := bk.fact.at(k, k+1)
+		c := bk.fact.at(k+1, k+1)
+		det := ((a/b)*c - b) * b
+		switch {
+		case det < 0:
+			// The eigenvalues have opposite signs.
+			pos++
+			neg++
+		case det > 0:
+			// The eigenvalues have the same sign as the trace.
+			count(a + c)
+			count(a + c)
+		default:
+			zero++
+			count(a + c)
+		}
+		k += 2
+	}
+	return pos, neg, zero
+}
+
+// SolveTo solves a system of linear equations
+//  A * X = B
+// where A is the symmetric matrix represented by the Bunch-Kaufman
+// factorization and B is a matrix. The result is stored in-place into dst.
+// If the factorization is singular or near-singular a Condition error is
+// returned. See the documentation for Condition for more information.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) SolveTo(dst *Dense, b Matrix) error {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.fact.mat.N
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+	if math.IsInf(bk.cond, 1) {
+		return Condition(bk.cond)
+	}
+
+	dst.reuseAsNonZeroed(bm, bn)
+	if b != dst {
+		dst.Copy(b)
+	}
+	lapack64.Sytrs(bk.fact.mat, bk.ipiv, dst.mat)
+	if bk.cond > ConditionTolerance {
+		return Condition(bk.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//  A * x = b
+// where A is the symmetric matrix represented by the Bunch-Kaufman
+// factorization and b is a vector. The result is stored in-place into dst.
+// If the factorization is singular or near-singular a Condition error is
+// returned. See the documentation for Condition for more information.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) SolveVecTo(dst *VecDense, b Vector) error {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.fact.mat.N
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	switch rv := b.(type) {
+	default:
+		dst.reuseAsNonZeroed(n)
+		return bk.SolveTo(dst.asDense(), b)
+	case RawVectorer:
+		if math.IsInf(bk.cond, 1) {
+			return Condition(bk.cond)
+		}
+		bmat := rv.RawVector()
+		if dst != b {
+			dst.checkOverlap(bmat)
+		}
+		dst.reuseAsNonZeroed(n)
+		if dst != b {
+			dst.CopyVec(b)
+		}
+		lapack64.Sytrs(bk.fact.mat, bk.ipiv, dst.asGeneral())
+		if bk.cond > ConditionTolerance {
+			return Condition(bk.cond)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestBunchKaufman(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 50, 100} {
+		a := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a.SetSym(i, j, rnd.NormFloat64())
+			}
+		}
+
+		var bk BunchKaufman
+		ok := bk.Factorize(a)
+		if !ok {
+			t.Errorf("n=%d: unexpected singular factorization", n)
+			continue
+		}
+		if bk.Symmetric() != n {
+			t.Errorf("n=%d: unexpected size %d", n, bk.Symmetric())
+		}
+
+		// Check the determinant against the LU factorization.
+		var lu LU
+		lu.Factorize(a)
+		luDet, luSign := lu.LogDet()
+		bkDet, bkSign := bk.LogDet()
+		if bkSign != luSign || math.Abs(bkDet-luDet) > 1e-10*math.Max(1, math.Abs(luDet)) {
+			t.Errorf("n=%d: unexpected LogDet: got (%v,%v), want (%v,%v)", n, bkDet, bkSign, luDet, luSign)
+		}
+
+		// Check the inertia against the eigenvalues.
+		var eig EigenSym
+		if !eig.Factorize(a, false) {
+			t.Fatalf("n=%d: eigendecomposition failed", n)
+		}
+		var wantPos, wantNeg int
+		for _, v := range eig.Values(nil) {
+			if v > 0 {
+				wantPos++
+			} else if v < 0 {
+				wantNeg++
+			}
+		}
+		pos, neg, zero := bk.Inertia()
+		if pos != wantPos || neg != wantNeg || zero != 0 {
+			t.Errorf("n=%d: unexpected inertia: got (%d,%d,%d), want (%d,%d,0)", n, pos, neg, zero, wantPos, wantNeg)
+		}
+
+		// Check the solution of A * X = B.
+		for _, nrhs := range []int{1, 4} {
+			b := NewDense(n, nrhs, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < nrhs; j++ {
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var x Dense
+			err := bk.SolveTo(&x, b)
+			if err != nil {
+				t.Errorf("n=%d,nrhs=%d: unexpected error from solve: %v", n, nrhs, err)
+				continue
+			}
+			var ax Dense
+			ax.Mul(a, &x)
+			if !EqualApprox(&ax, b, 1e-10*bk.Cond()) {
+				t.Errorf("n=%d,nrhs=%d: A*X != B", n, nrhs)
+			}
+
+			// Check in-place solve.
+			bCopy := DenseCopyOf(b)
+			err = bk.SolveTo(bCopy, bCopy)
+			if err != nil {
+				t.Errorf("n=%d,nrhs=%d: unexpected error from in-place solve: %v", n, nrhs, err)
+			}
+			if !Equal(bCopy, &x) {
+				t.Errorf("n=%d,nrhs=%d: in-place solve mismatch", n, nrhs)
+			}
+		}
+	}
+}
+
+func TestBunchKaufmanSolveVecTo(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a   *SymDense
+		b   *VecDense
+		ans *VecDense
+	}{
+		{
+			a: NewSymDense(2, []float64{
+				0, 1,
+				1, 0,
+			}),
+			b:   NewVecDense(2, []float64{5, 6}),
+			ans: NewVecDense(2, []float64{6, 5}),
+		},
+		{
+			a: NewSymDense(3, []float64{
+				1, 2, 3,
+				2, -4, 5,
+				3, 5, -6,
+			}),
+			b:   NewVecDense(3, []float64{6, 3, 2}),
+			ans: NewVecDense(3, []float64{1, 1, 1}),
+		},
+	} {
+		var bk BunchKaufman
+		ok := bk.Factorize(test.a)
+		if !ok {
+			t.Fatal("unexpected singular Bunch-Kaufman factorization")
+		}
+
+		var x VecDense
+		err := bk.SolveVecTo(&x, test.b)
+		if err != nil {
+			t.Errorf("unexpected error from Bunch-Kaufman solve: %v", err)
+		}
+		if !EqualApprox(&x, test.ans, 1e-12) {
+			t.Errorf("incorrect Bunch-Kaufman solve solution: got %v, want %v", x.RawVector().Data, test.ans.RawVector().Data)
+		}
+
+		// Check solving with a non-RawVectorer.
+		var x2 VecDense
+		err = bk.SolveVecTo(&x2, (*basicVector)(test.b))
+		if err != nil {
+			t.Errorf("unexpected error from Bunch-Kaufman solve: %v", err)
+		}
+		if !EqualApprox(&x2, test.ans, 1e-12) {
+			t.Error("incorrect Bunch-Kaufman solve solution for basic vector")
+		}
+	}
+}
+
+func TestBunchKaufmanSingular(t *testing.T) {
+	t.Parallel()
+	// A has eigenvalues 0, 0 and 3.
+	a := NewSymDense(3, []float64{
+		1, 1, 1,
+		1, 1, 1,
+		1, 1, 1,
+	})
+	var bk BunchKaufman
+	ok := bk.Factorize(a)
+	if ok {
+		t.Fatal("expected singular factorization")
+	}
+	pos, neg, zero := bk.Inertia()
+	if pos != 1 || neg != 0 || zero != 2 {
+		t.Errorf("unexpected inertia: got (%d,%d,%d), want (1,0,2)", pos, neg, zero)
+	}
+	if det := bk.Det(); det != 0 {
+		t.Errorf("unexpected determinant: got %v, want 0", det)
+	}
+	var x VecDense
+	err := bk.SolveVecTo(&x, NewVecDense(3, []float64{1, 2, 3}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular solve, got %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCCholesky = "mat: invalid CCholesky factorization"
+
+// CCholesky is a type for creating and using the Cholesky factorization of a
+// Hermitian positive definite complex matrix.
+//
+// The decomposition can be constructed using the Factorize method. The
+// factorization itself can be extracted using the UTo or LTo methods.
+//
+// CCholesky methods may only be called on a value that has been successfully
+// initialized by a call to Factorize that has returned true. Calls to methods
+// of an unsuccessful CCholesky factorization will panic.
+type CCholesky struct {
+	// chol holds the upper triangular Cholesky factor in its upper
+	// triangle. The strictly lower triangle is zero.
+	chol *CDense
+}
+
+// Factorize calculates the Cholesky decomposition of the Hermitian matrix A
+// and returns whether the matrix is positive definite. Only the upper triangle
+// of a is referenced and the matrix is assumed to be Hermitian. If Factorize
+// returns false, the factorization must not be used.
+//
+// The Cholesky decomposition of A is
+//  A = Uᴴ * U
+// where U is an upper triangular matrix with a real positive diagonal.
+// Factorize will panic if a is not square.
+func (c *CCholesky) Factorize(a CMatrix) (ok bool) {
+	r, n := a.Dims()
+	if r != n {
+		panic(ErrSquare)
+	}
+	if c.chol == nil {
+		c.chol = NewCDense(n, n, nil)
+	} else {
+		c.chol.Reset()
+		c.chol.reuseAsZeroed(n, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			c.chol.set(i, j, a.At(i, j))
+		}
+	}
+	_, ok = lapack128.Potrf(c.chol.asHermBlas())
+	if !ok {
+		c.Reset()
+	}
+	return ok
+}
+
+// asHermBlas returns the upper triangle of the square receiver as a
+// cblas128.Hermitian.
+func (m *CDense) asHermBlas() cblas128.Hermitian {
+	return cblas128.Hermitian{
+		N:      m.mat.Rows,
+		Stride: m.mat.Stride,
+		Data:   m.mat.Data,
+		Uplo:   blas.Upper,
+	}
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (c *CCholesky) Reset() {
+	if c.chol != nil {
+		c.chol.Reset()
+	}
+}
+
+// IsEmpty returns whether the receiver is empty. Empty factorizations can be
+// the receiver for size-restricted operations. The receiver can be emptied
+// using Reset.
+func (c *CCholesky) IsEmpty() bool {
+	return c.chol == nil || c.chol.IsEmpty()
+}
+
+// valid returns whether the receiver contains a successful factorization.
+func (c *CCholesky) valid() bool {
+	return !c.IsEmpty()
+}
+
+// Det returns the determinant of the matrix that has been factorized. The
+// determinant of a Hermitian positive definite matrix is real and positive.
+func (c *CCholesky) Det() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been factorized.
+func (c *CCholesky) LogDet() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	var det float64
+	n, _ := c.chol.Dims()
+	for i := 0; i < n; i++ {
+		det += 2 * math.Log(real(c.chol.mat.Data[i*c.chol.mat.Stride+i]))
+	}
+	return det
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+// If the Cholesky decomposition is singular a Condition error is returned.
+// See the documentation for Condition for more information.
+func (c *CCholesky) SolveTo(dst *CDense, b CMatrix) error {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.chol.Dims()
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+	for i := 0; i < n; i++ {
+		if c.chol.mat.Data[i*c.chol.mat.Stride+i] == 0 {
+			return Condition(math.Inf(1))
+		}
+	}
+
+	dst.reuseAsNonZeroed(bm, bn)
+	bU, _, _ := untransposeCmplx(b)
+	var restore func()
+	if dst == bU {
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawCMatrixer); ok {
+		dst.checkOverlap(rm.RawCMatrix())
+	}
+
+	dst.Copy(b)
+	t := cblas128.Triangular{
+		N:      n,
+		Stride: c.chol.mat.Stride,
+		Data:   c.chol.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+	lapack128.Potrs(t, dst.mat)
+	return nil
+}
+
+// UTo stores into dst the n×n upper triangular matrix U from a Cholesky
+// decomposition
+//  A = Uᴴ * U.
+// If dst is empty, it is resized to be n×n. When dst is non-empty, UTo
+// panics if dst is not n×n. UTo will also panic if the receiver does not
+// contain a successful factorization.
+func (c *CCholesky) UTo(dst *CDense) {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.chol.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r2, c2 := dst.Dims()
+		if r2 != n || c2 != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(c.chol)
+}
+
+// LTo stores into dst the n×n lower triangular matrix L from a Cholesky
+// decomposition
+//  A = L * Lᴴ.
+// If dst is empty, it is resized to be n×n. When dst is non-empty, LTo
+// panics if dst is not n×n. LTo will also panic if the receiver does not
+// contain a successful factorization.
+func (c *CCholesky) LTo(dst *CDense) {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.chol.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r2, c2 := dst.Dims()
+		if r2 != n || c2 != n {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			dst.set(i, j, cmplx.Conj(c.chol.at(j, i)))
+		}
+	}
+}
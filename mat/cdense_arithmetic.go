@@ -0,0 +1,323 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// Add adds a and b element-wise, placing the result in the receiver. Add
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Add(a, b CMatrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	bU, bTrans, bConj := untransposeExtractCmplx(b)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*CDense); ok {
+		if brm, ok := b.(*CDense); ok {
+			amat, bmat := arm.mat, brm.mat
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = v + bmat.Data[i+jb]
+				}
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if aTrans != aConj && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans != bConj && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, a.At(r, c)+b.At(r, c))
+		}
+	}
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Sub(a, b CMatrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	bU, bTrans, bConj := untransposeExtractCmplx(b)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*CDense); ok {
+		if brm, ok := b.(*CDense); ok {
+			amat, bmat := arm.mat, brm.mat
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = v - bmat.Data[i+jb]
+				}
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if aTrans != aConj && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans != bConj && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, a.At(r, c)-b.At(r, c))
+		}
+	}
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+//
+// See the Scaler interface for more information.
+func (m *CDense) Scale(f complex128, a CMatrix) {
+	ar, ac := a.Dims()
+
+	m.reuseAsNonZeroed(ar, ac)
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	if rm, ok := aU.(*CDense); ok && aTrans == aConj {
+		// The elements of a are stored in the same layout as the
+		// receiver and may only need to be conjugated.
+		amat := rm.mat
+		if m != aU {
+			m.checkOverlap(amat)
+		}
+		for ja, jm := 0, 0; ja < ar*amat.Stride; ja, jm = ja+amat.Stride, jm+m.mat.Stride {
+			for i, v := range amat.Data[ja : ja+ac] {
+				if aConj {
+					v = cmplx.Conj(v)
+				}
+				m.mat.Data[i+jm] = f * v
+			}
+		}
+		return
+	}
+
+	m.checkOverlapMatrix(aU)
+	if aTrans != aConj && m == aU {
+		var restore func()
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	}
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, f*a.At(r, c))
+		}
+	}
+}
+
+// blasTransCmplx returns the blas.Transpose corresponding to the transpose
+// and conjugation flags returned by untransposeCmplx. If the combination
+// cannot be represented by a blas.Transpose, ok is false.
+func blasTransCmplx(trans, conj bool) (t blas.Transpose, ok bool) {
+	switch {
+	case !trans && !conj:
+		return blas.NoTrans, true
+	case trans && !conj:
+		return blas.Trans, true
+	case !trans && conj:
+		return blas.ConjTrans, true
+	default:
+		// The element-wise conjugate without transpose.
+		return 0, false
+	}
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver.
+// If the number of columns in a does not equal the number of rows in b, Mul will panic.
+func (m *CDense) Mul(a, b CMatrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+
+	if ac != br {
+		panic(ErrShape)
+	}
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	bU, bTrans, bConj := untransposeExtractCmplx(b)
+	m.reuseAsNonZeroed(ar, bc)
+	var restore func()
+	if m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	aT, aOk := blasTransCmplx(aTrans, aConj)
+	bT, bOk := blasTransCmplx(bTrans, bConj)
+	if aU, ok := aU.(*CDense); ok && aOk {
+		if bU, ok := bU.(*CDense); ok && bOk {
+			if restore == nil {
+				m.checkOverlap(aU.mat)
+				m.checkOverlap(bU.mat)
+			}
+			cblas128.Gemm(aT, bT, 1, aU.mat, bU.mat, 0, m.mat)
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	row := make([]complex128, ac)
+	for r := 0; r < ar; r++ {
+		for i := range row {
+			row[i] = a.At(r, i)
+		}
+		for c := 0; c < bc; c++ {
+			var v complex128
+			for i, e := range row {
+				v += e * b.At(i, c)
+			}
+			m.mat.Data[r*m.mat.Stride+c] = v
+		}
+	}
+}
+
+// Inverse computes the inverse of the matrix a, storing the result into the
+// receiver. If a is ill-conditioned, a Condition error will be returned.
+// Note that matrix inversion is numerically unstable, and should generally
+// be avoided where possible, for example by using the Solve routines.
+func (m *CDense) Inverse(a CMatrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	m.reuseAsNonZeroed(r, c)
+
+	// Compute the LU factorization of a copy of A.
+	lu := getCDenseWorkspace(r, c, false)
+	defer putCDenseWorkspace(lu)
+	lu.Copy(a)
+	norm := cdenseNormInf(lu)
+	ipiv := getInts(r, false)
+	defer putInts(ipiv)
+	ok := lapack128.Getrf(lu.mat, ipiv)
+	if !ok {
+		// A is exactly singular.
+		return Condition(math.Inf(1))
+	}
+
+	// Solve A * X = I to form A^{-1}. The LU factorization holds a copy
+	// of A so the receiver can be overwritten even if it aliases a.
+	m.Zero()
+	for i := 0; i < r; i++ {
+		m.mat.Data[i*m.mat.Stride+i] = 1
+	}
+	lapack128.Getrs(blas.NoTrans, lu.mat, m.mat, ipiv)
+
+	// Compute the condition number of A from the norms of A and
+	// its inverse.
+	cond := norm * cdenseNormInf(m)
+	if math.IsNaN(cond) || math.IsInf(cond, 0) {
+		return Condition(math.Inf(1))
+	}
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// cdenseNormInf returns the maximum absolute row sum of a.
+func cdenseNormInf(a *CDense) float64 {
+	var norm float64
+	r, c := a.Dims()
+	for i := 0; i < r; i++ {
+		var sum float64
+		for _, v := range a.mat.Data[i*a.mat.Stride : i*a.mat.Stride+c] {
+			sum += cmplx.Abs(v)
+		}
+		if sum > norm || math.IsNaN(sum) {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// Solve solves the linear least squares problem
+//  minimize over x |b - A*x|_2
+// where A is an m×n matrix, b is a given m element vector and x is n element
+// solution vector. Solve assumes that A has full rank, that is
+//  rank(A) = min(m,n)
+//
+// If m >= n, Solve finds the unique least squares solution of an overdetermined
+// system.
+//
+// If m < n, there is an infinite number of solutions that satisfy b-A*x=0. In
+// this case Solve finds the unique solution of an underdetermined system that
+// minimizes |x|_2.
+//
+// Several right-hand side vectors b and solution vectors x can be handled in a
+// single call. Vectors b are stored in the columns of the m×k matrix B. Vectors
+// x will be stored in-place into the n×k receiver.
+//
+// If A is exactly singular a Condition error is returned.
+func (m *CDense) Solve(a, b CMatrix) error {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ac, bc)
+
+	if ar == ac {
+		var lu CLU
+		lu.Factorize(a)
+		return lu.SolveTo(m, false, b)
+	}
+	var qr CQR
+	if ar > ac {
+		qr.Factorize(a)
+		return qr.SolveTo(m, false, b)
+	}
+	// For an underdetermined system, factorize Aᴴ and find the
+	// minimum norm solution of (Aᴴ)ᴴ * X = B.
+	qr.Factorize(a.H())
+	return qr.SolveTo(m, true, b)
+}
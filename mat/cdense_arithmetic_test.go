@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func randCDense(r, c int, rnd *rand.Rand) *CDense {
+	m := NewCDense(r, c, nil)
+	for i := range m.mat.Data {
+		m.mat.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return m
+}
+
+func TestCDenseAddSubScale(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ r, c int }{{1, 1}, {3, 3}, {4, 7}, {7, 4}} {
+		a := randCDense(test.r, test.c, rnd)
+		b := randCDense(test.r, test.c, rnd)
+		aT := randCDense(test.c, test.r, rnd)
+
+		for _, ops := range []struct {
+			name string
+			a, b CMatrix
+		}{
+			{name: "dense", a: a, b: b},
+			{name: "T", a: aT.T(), b: b},
+			{name: "H", a: aT.H(), b: b},
+		} {
+			var add, sub CDense
+			add.Add(ops.a, ops.b)
+			sub.Sub(ops.a, ops.b)
+			for i := 0; i < test.r; i++ {
+				for j := 0; j < test.c; j++ {
+					want := ops.a.At(i, j) + ops.b.At(i, j)
+					if got := add.At(i, j); got != want {
+						t.Errorf("unexpected Add result for %s %d×%d at (%d,%d): got:%v want:%v", ops.name, test.r, test.c, i, j, got, want)
+					}
+					want = ops.a.At(i, j) - ops.b.At(i, j)
+					if got := sub.At(i, j); got != want {
+						t.Errorf("unexpected Sub result for %s %d×%d at (%d,%d): got:%v want:%v", ops.name, test.r, test.c, i, j, got, want)
+					}
+				}
+			}
+
+			f := complex(2, -3)
+			var scale CDense
+			scale.Scale(f, ops.a)
+			for i := 0; i < test.r; i++ {
+				for j := 0; j < test.c; j++ {
+					want := f * ops.a.At(i, j)
+					if got := scale.At(i, j); got != want {
+						t.Errorf("unexpected Scale result for %s %d×%d at (%d,%d): got:%v want:%v", ops.name, test.r, test.c, i, j, got, want)
+					}
+				}
+			}
+		}
+
+		// Check in-place operation.
+		want := NewCDense(test.r, test.c, nil)
+		want.Add(a, b)
+		a.Add(a, b)
+		if !CEqual(a, want) {
+			t.Errorf("unexpected in-place Add result for %d×%d", test.r, test.c)
+		}
+	}
+}
+
+func TestCDenseMul(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, k, n int }{{1, 1, 1}, {3, 4, 5}, {5, 2, 3}, {4, 4, 4}} {
+		a := randCDense(test.m, test.k, rnd)
+		b := randCDense(test.k, test.n, rnd)
+		aT := randCDense(test.k, test.m, rnd)
+		bT := randCDense(test.n, test.k, rnd)
+		var aConj CDense
+		aConj.Conj(a)
+
+		for _, ops := range []struct {
+			name string
+			a, b CMatrix
+		}{
+			{name: "NN", a: a, b: b},
+			{name: "TN", a: aT.T(), b: b},
+			{name: "HN", a: aT.H(), b: b},
+			{name: "NT", a: a, b: bT.T()},
+			{name: "NH", a: a, b: bT.H()},
+			{name: "HH", a: aT.H(), b: bT.H()},
+			{name: "conj", a: aConj.H().T(), b: b},
+		} {
+			var got CDense
+			got.Mul(ops.a, ops.b)
+			want := NewCDense(test.m, test.n, nil)
+			for i := 0; i < test.m; i++ {
+				for j := 0; j < test.n; j++ {
+					var v complex128
+					for l := 0; l < test.k; l++ {
+						v += ops.a.At(i, l) * ops.b.At(l, j)
+					}
+					want.Set(i, j, v)
+				}
+			}
+			if !CEqualApprox(&got, want, 1e-12) {
+				t.Errorf("unexpected Mul result for %s %d×%d×%d", ops.name, test.m, test.k, test.n)
+			}
+		}
+	}
+}
+
+func TestCDenseInverse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		a := randCDense(n, n, rnd)
+		var inv CDense
+		err := inv.Inverse(a)
+		if err != nil {
+			t.Errorf("unexpected error for n=%d: %v", n, err)
+			continue
+		}
+		var got CDense
+		got.Mul(a, &inv)
+		if !CEqualApprox(&got, ceye(n), 1e-10) {
+			t.Errorf("A * A^{-1} is not identity for n=%d", n)
+		}
+
+		// Check in-place inversion.
+		aCopy := NewCDense(n, n, nil)
+		aCopy.Copy(a)
+		err = aCopy.Inverse(aCopy)
+		if err != nil {
+			t.Errorf("unexpected error for in-place n=%d: %v", n, err)
+			continue
+		}
+		if !CEqualApprox(aCopy, &inv, 1e-12) {
+			t.Errorf("unexpected in-place inverse for n=%d", n)
+		}
+	}
+
+	singular := NewCDense(2, 2, []complex128{1, 1i, 1, 1i})
+	var inv CDense
+	if err := inv.Inverse(singular); err == nil {
+		t.Error("expected error for singular matrix")
+	}
+}
+
+func TestCDenseSolve(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, k int }{{3, 3, 1}, {5, 5, 3}, {8, 4, 2}, {4, 8, 2}, {1, 1, 1}} {
+		a := randCDense(test.m, test.n, rnd)
+		var x CDense
+		switch {
+		case test.m >= test.n:
+			// Choose B in the range of A so the least squares
+			// residual is zero.
+			xWant := randCDense(test.n, test.k, rnd)
+			var b CDense
+			b.Mul(a, xWant)
+			err := x.Solve(a, &b)
+			if err != nil {
+				t.Errorf("unexpected error for %d×%d: %v", test.m, test.n, err)
+				continue
+			}
+			if !CEqualApprox(&x, xWant, 1e-10) {
+				t.Errorf("unexpected solution for %d×%d", test.m, test.n)
+			}
+		default:
+			b := randCDense(test.m, test.k, rnd)
+			err := x.Solve(a, b)
+			if err != nil {
+				t.Errorf("unexpected error for %d×%d: %v", test.m, test.n, err)
+				continue
+			}
+			var ax CDense
+			ax.Mul(a, &x)
+			if !CEqualApprox(&ax, b, 1e-10) {
+				t.Errorf("A * X != B for %d×%d", test.m, test.n)
+			}
+			// The minimum norm solution lies in the range of Aᴴ,
+			// so projecting it onto that range must not change it.
+			var y, proj CDense
+			if err := y.Solve(a.H(), &x); err != nil {
+				t.Errorf("unexpected error projecting solution for %d×%d: %v", test.m, test.n, err)
+				continue
+			}
+			proj.Mul(a.H(), &y)
+			if !CEqualApprox(&proj, &x, 1e-10) {
+				t.Errorf("solution is not minimum norm for %d×%d", test.m, test.n)
+			}
+		}
+	}
+}
+
+func ceye(n int) *CDense {
+	m := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
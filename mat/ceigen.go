@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// EigenHerm is a type for creating and manipulating the Eigen decomposition of
+// Hermitian matrices.
+type EigenHerm struct {
+	vectorsComputed bool
+
+	values  []float64
+	vectors *CDense
+}
+
+// Factorize computes the eigenvalue decomposition of the Hermitian matrix a.
+// Only the upper triangle of a is referenced and the matrix is assumed to be
+// Hermitian. The Eigen decomposition is defined as
+//  A = P * D * Pᴴ
+// where D is a real diagonal matrix containing the eigenvalues of the matrix,
+// and P is a unitary matrix of the eigenvectors of A. Factorize computes the
+// eigenvalues in ascending order. If the vectors input argument is false, the
+// eigenvectors are not computed.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+// Factorize will panic if a is not square.
+func (e *EigenHerm) Factorize(a CMatrix, vectors bool) (ok bool) {
+	// kill previous decomposition
+	e.vectorsComputed = false
+	e.values = e.values[:0]
+
+	r, n := a.Dims()
+	if r != n {
+		panic(ErrSquare)
+	}
+	hd := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			hd.set(i, j, a.At(i, j))
+		}
+	}
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	w := make([]float64, n)
+	work := []complex128{0}
+	rwork := getFloat64s(max(1, 3*n-2), false)
+	defer putFloat64s(rwork)
+	lapack128.Heev(jobz, hd.asHermBlas(), w, work, -1, rwork)
+
+	work = make([]complex128, int(real(work[0])))
+	ok = lapack128.Heev(jobz, hd.asHermBlas(), w, work, len(work), rwork)
+	if !ok {
+		e.vectorsComputed = false
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.values = w
+	e.vectors = hd
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *EigenHerm) succFact() bool {
+	return len(e.values) != 0
+}
+
+// Values extracts the eigenvalues of the factorized matrix in ascending order.
+// If dst is non-nil, the values are stored in-place into dst. In this case dst
+// must have length n, otherwise Values will panic. If dst is nil, then a new
+// slice will be allocated of the proper length and filled with the eigenvalues.
+//
+// Values panics if the Eigen decomposition was not successful.
+func (e *EigenHerm) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the eigenvectors of the decomposition into the columns of
+// dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *EigenHerm) VectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	r, c := e.vectors.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
@@ -0,0 +1,272 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func randHermitian(n int, posdef bool, rnd *rand.Rand) *CDense {
+	a := randCDense(n, n, rnd)
+	h := NewCDense(n, n, nil)
+	if posdef {
+		h.Mul(a.H(), a)
+		for i := 0; i < n; i++ {
+			h.Set(i, i, h.At(i, i)+complex(float64(n), 0))
+		}
+		return h
+	}
+	h.Add(a, a.H())
+	return h
+}
+
+func TestCLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		a := randCDense(n, n, rnd)
+		var lu CLU
+		lu.Factorize(a)
+
+		var l, u, got CDense
+		lu.LTo(&l)
+		lu.UTo(&u)
+		got.Mul(&l, &u)
+		// Row i of A is row swaps[i] of L * U, that is A = P * L * U.
+		swaps := lu.Pivot(nil)
+		pa := NewCDense(n, n, nil)
+		for i, v := range swaps {
+			for j := 0; j < n; j++ {
+				pa.Set(v, j, a.At(i, j))
+			}
+		}
+		if !CEqualApprox(&got, pa, 1e-12) {
+			t.Errorf("P * L * U does not match A for n=%d", n)
+		}
+
+		det := lu.Det()
+		var inv CDense
+		inv.Inverse(a)
+		var invLU CLU
+		invLU.Factorize(&inv)
+		if p := det * invLU.Det(); cmplx.Abs(p-1) > 1e-10 {
+			t.Errorf("det(A) * det(A^{-1}) != 1 for n=%d: got %v", n, p)
+		}
+
+		for _, trans := range []bool{false, true} {
+			b := randCDense(n, 2, rnd)
+			var x, ax CDense
+			err := lu.SolveTo(&x, trans, b)
+			if err != nil {
+				t.Errorf("unexpected error for n=%d trans=%t: %v", n, trans, err)
+				continue
+			}
+			if trans {
+				ax.Mul(a.H(), &x)
+			} else {
+				ax.Mul(a, &x)
+			}
+			if !CEqualApprox(&ax, b, 1e-10) {
+				t.Errorf("unexpected solution for n=%d trans=%t", n, trans)
+			}
+		}
+	}
+}
+
+func TestCQR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{{1, 1}, {3, 3}, {5, 3}, {10, 4}} {
+		m, n := test.m, test.n
+		a := randCDense(m, n, rnd)
+		var qr CQR
+		qr.Factorize(a)
+
+		var q, r, got CDense
+		qr.QTo(&q)
+		qr.RTo(&r)
+		got.Mul(&q, &r)
+		if !CEqualApprox(&got, a, 1e-12) {
+			t.Errorf("Q * R does not match A for %d×%d", m, n)
+		}
+		var qhq CDense
+		qhq.Mul(q.H(), &q)
+		if !CEqualApprox(&qhq, ceye(m), 1e-12) {
+			t.Errorf("Q is not unitary for %d×%d", m, n)
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("R is not upper triangular for %d×%d", m, n)
+				}
+			}
+		}
+
+		// Aᴴ * X = B has a minimum norm solution.
+		b := randCDense(n, 2, rnd)
+		var x, ahx CDense
+		err := qr.SolveTo(&x, true, b)
+		if err != nil {
+			t.Errorf("unexpected error for %d×%d: %v", m, n, err)
+			continue
+		}
+		ahx.Mul(a.H(), &x)
+		if !CEqualApprox(&ahx, b, 1e-10) {
+			t.Errorf("unexpected transposed solution for %d×%d", m, n)
+		}
+	}
+}
+
+func TestCCholesky(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		a := randHermitian(n, true, rnd)
+		var chol CCholesky
+		ok := chol.Factorize(a)
+		if !ok {
+			t.Errorf("unexpected Cholesky factorization failure for n=%d", n)
+			continue
+		}
+
+		var u, l, got CDense
+		chol.UTo(&u)
+		got.Mul(u.H(), &u)
+		if !CEqualApprox(&got, a, 1e-10) {
+			t.Errorf("Uᴴ * U does not match A for n=%d", n)
+		}
+		chol.LTo(&l)
+		got.Mul(&l, l.H())
+		if !CEqualApprox(&got, a, 1e-10) {
+			t.Errorf("L * Lᴴ does not match A for n=%d", n)
+		}
+
+		var lu CLU
+		lu.Factorize(a)
+		if det := lu.Det(); math.Abs(chol.Det()-real(det)) > 1e-8*math.Abs(real(det)) {
+			t.Errorf("unexpected determinant for n=%d: got %v want %v", n, chol.Det(), det)
+		}
+
+		b := randCDense(n, 3, rnd)
+		var x, ax CDense
+		err := chol.SolveTo(&x, b)
+		if err != nil {
+			t.Errorf("unexpected error for n=%d: %v", n, err)
+			continue
+		}
+		ax.Mul(a, &x)
+		if !CEqualApprox(&ax, b, 1e-10) {
+			t.Errorf("unexpected solution for n=%d", n)
+		}
+	}
+
+	notPosDef := NewCDense(2, 2, []complex128{1, 2i, -2i, 1})
+	var chol CCholesky
+	if chol.Factorize(notPosDef) {
+		t.Error("expected factorization failure for non-positive definite matrix")
+	}
+}
+
+func TestEigenHerm(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		a := randHermitian(n, false, rnd)
+		var es EigenHerm
+		ok := es.Factorize(a, true)
+		if !ok {
+			t.Errorf("unexpected factorization failure for n=%d", n)
+			continue
+		}
+		values := es.Values(nil)
+		if !sort.Float64sAreSorted(values) {
+			t.Errorf("eigenvalues not sorted for n=%d", n)
+		}
+		var p CDense
+		es.VectorsTo(&p)
+		for j, v := range values {
+			var ap CDense
+			col := p.Slice(0, n, j, j+1)
+			ap.Mul(a, col)
+			var lp CDense
+			lp.Scale(complex(v, 0), col)
+			if !CEqualApprox(&ap, &lp, 1e-10) {
+				t.Errorf("A * p != λ * p for n=%d, eigenvalue %d", n, j)
+			}
+		}
+		var php CDense
+		php.Mul(p.H(), &p)
+		if !CEqualApprox(&php, ceye(n), 1e-12) {
+			t.Errorf("eigenvectors are not orthonormal for n=%d", n)
+		}
+
+		var esNoVec EigenHerm
+		esNoVec.Factorize(a, false)
+		got := esNoVec.Values(nil)
+		for i := range got {
+			if math.Abs(got[i]-values[i]) > 1e-10 {
+				t.Errorf("eigenvalues differ without vectors for n=%d", n)
+				break
+			}
+		}
+	}
+}
+
+func TestCSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{{1, 1}, {3, 3}, {5, 3}, {3, 5}, {10, 4}} {
+		m, n := test.m, test.n
+		a := randCDense(m, n, rnd)
+		for _, kind := range []SVDKind{SVDThin, SVDFull} {
+			var svd CSVD
+			ok := svd.Factorize(a, kind)
+			if !ok {
+				t.Errorf("unexpected factorization failure for %d×%d", m, n)
+				continue
+			}
+			s := svd.Values(nil)
+			for i := 1; i < len(s); i++ {
+				if s[i] > s[i-1] {
+					t.Errorf("singular values not in decreasing order for %d×%d", m, n)
+				}
+			}
+
+			var u, v CDense
+			svd.UTo(&u)
+			svd.VTo(&v)
+			_, uc := u.Dims()
+			_, vc := v.Dims()
+			sigma := NewCDense(uc, vc, nil)
+			for i, sv := range s {
+				sigma.Set(i, i, complex(sv, 0))
+			}
+			var us, got CDense
+			us.Mul(&u, sigma)
+			got.Mul(&us, v.H())
+			if !CEqualApprox(&got, a, 1e-10) {
+				t.Errorf("U * Σ * Vᴴ does not match A for %d×%d kind=%v", m, n, kind)
+			}
+		}
+
+		var svd CSVD
+		svd.Factorize(a, SVDNone)
+		want := svd.Values(nil)
+		var full CSVD
+		full.Factorize(a, SVDFull)
+		got := full.Values(nil)
+		for i := range got {
+			if math.Abs(got[i]-want[i]) > 1e-10 {
+				t.Errorf("singular values differ without vectors for %d×%d", m, n)
+				break
+			}
+		}
+	}
+}
@@ -307,6 +307,96 @@ func (c *Cholesky) SolveVecTo(dst *VecDense, b Vector) error {
 	}
 }
 
+// SolveRefinedTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition and improves the solution by iterative
+// refinement. The result is stored in-place into dst.
+//
+// a must be the matrix that was factorized by the receiver. It is used to
+// compute the residuals of the solution.
+//
+// SolveRefinedTo returns for each column of X an estimated forward error bound
+// ferr and the componentwise relative backward error berr. ferr[j] bounds the
+// largest element of the difference between the j-th column of X and the true
+// solution relative to the largest element of that column of X. berr[j] is the
+// smallest relative change in any element of A or B that makes the j-th column
+// of X an exact solution.
+//
+// If the Cholesky decomposition is singular or near-singular a Condition error
+// is returned. See the documentation for Condition for more information.
+func (c *Cholesky) SolveRefinedTo(dst *Dense, a Symmetric, b Matrix) (ferr, berr []float64, err error) {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	n := c.chol.mat.N
+	if a.Symmetric() != n {
+		panic(ErrShape)
+	}
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+
+	// Keep a copy of B since it is needed for computing the residuals and
+	// dst may share its storage.
+	bw := getDenseWorkspace(n, bn, false)
+	defer putDenseWorkspace(bw)
+	bw.Copy(b)
+
+	// The factorization is stored in the upper triangle, so the same
+	// triangle of A must be used.
+	var as blas64.Symmetric
+	if rs, ok := a.(RawSymmetricer); ok && rs.RawSymmetric().Uplo == blas.Upper {
+		as = rs.RawSymmetric()
+		dst.reuseAsNonZeroed(n, bn)
+		dst.checkOverlap(blas64.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: as.Stride,
+			Data:   as.Data,
+		})
+	} else {
+		aw := getSymDenseWorkspace(n, false)
+		defer putSymDenseWorkspace(aw)
+		aw.CopySym(a)
+		as = aw.mat
+	}
+
+	err = c.SolveTo(dst, bw)
+
+	ferr = make([]float64, bn)
+	berr = make([]float64, bn)
+	work := getFloat64s(3*n, false)
+	iwork := getInts(n, false)
+	lapack64.Porfs(as, c.chol.mat, bw.mat, dst.mat, ferr, berr, work, iwork)
+	putFloat64s(work)
+	putInts(iwork)
+	return ferr, berr, err
+}
+
+// SolveVecRefinedTo finds the vector x that solves A * x = b where A is
+// represented by the Cholesky decomposition and improves the solution by
+// iterative refinement. The result is stored in-place into dst.
+//
+// a must be the matrix that was factorized by the receiver. SolveVecRefinedTo
+// returns the estimated forward error bound and the componentwise relative
+// backward error of x. See the documentation for SolveRefinedTo for more
+// information.
+//
+// If the Cholesky decomposition is singular or near-singular a Condition error
+// is returned. See the documentation for Condition for more information.
+func (c *Cholesky) SolveVecRefinedTo(dst *VecDense, a Symmetric, b Vector) (ferr, berr float64, err error) {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	n := c.chol.mat.N
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(n)
+	fe, be, err := c.SolveRefinedTo(dst.asDense(), a, b)
+	return fe[0], be[0], err
+}
+
 // RawU returns the Triangular matrix used to store the Cholesky decomposition of
 // the original matrix A. The returned matrix should not be modified. If it is
 // modified, the decomposition is invalid and should not be used.
@@ -530,6 +620,70 @@ func (c *Cholesky) ExtendVecSym(a *Cholesky, v Vector) (ok bool) {
 	return true
 }
 
+// DeleteSym computes the Cholesky factorization of the original matrix A with
+// its k-th row and column removed, storing the result into the receiver. That
+// is, if in the original factorization Uᵀ * U = A, in the updated
+// factorization U'ᵀ * U' = A', where A' is the (n-1)×(n-1) matrix obtained by
+// deleting the k-th row and column of A. DeleteSym is the inverse of
+// ExtendVecSym when k is the last index, and the receiver may be a.
+//
+// DeleteSym will panic if a does not contain a factorization, if k is not in
+// the range [0, n) or if n is 1.
+//
+// DeleteSym updates a Cholesky factorization in O(n²) time. The Cholesky
+// factorization computation from scratch is O(n³).
+func (c *Cholesky) DeleteSym(a *Cholesky, k int) {
+	if !a.valid() {
+		panic(badCholesky)
+	}
+	n := a.Symmetric()
+	if k < 0 || n <= k {
+		panic(ErrIndexOutOfRange)
+	}
+	if n == 1 {
+		panic(ErrShape)
+	}
+
+	// Deleting the k-th column of U gives an n×(n-1) matrix H such that
+	// Hᵀ * H = A'. The rows of H from k onwards are in upper Hessenberg
+	// form and the subdiagonal is eliminated by Givens rotations from the
+	// left which do not change Hᵀ * H. The last row of H then is zero and
+	// the first n-1 rows form U'.
+	h := getDenseWorkspace(n, n-1, true)
+	defer putDenseWorkspace(h)
+	umat := a.chol.mat
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			if j == k {
+				continue
+			}
+			jj := j
+			if j > k {
+				jj--
+			}
+			h.mat.Data[i*h.mat.Stride+jj] = umat.Data[i*umat.Stride+j]
+		}
+	}
+	for i := k; i < n-1; i++ {
+		hmat := h.mat
+		c, s, r, _ := blas64.Rotg(hmat.Data[i*hmat.Stride+i], hmat.Data[(i+1)*hmat.Stride+i])
+		if r < 0 {
+			// Multiply by -1 to have positive diagonal elements.
+			r *= -1
+			c *= -1
+			s *= -1
+		}
+		hmat.Data[i*hmat.Stride+i] = r
+		hmat.Data[(i+1)*hmat.Stride+i] = 0
+		rotateRows(h, i, i+1, i+1, c, s)
+	}
+
+	newU := NewTriDense(n-1, Upper, nil)
+	newU.Copy(h.slice(0, n-1, 0, n-1))
+	c.chol = newU
+	c.updateCond(-1)
+}
+
 // SymRankOne performs a rank-1 update of the original matrix A and refactorizes
 // its Cholesky factorization, storing the result into the receiver. That is, if
 // in the original Cholesky factorization
@@ -553,13 +707,18 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 	if r, c := x.Dims(); r != n || c != 1 {
 		panic(ErrShape)
 	}
-	if orig != c {
+	if orig != c && c.chol != nil && c.chol.mat.N != n {
+		panic(ErrShape)
+	}
+	if alpha >= 0 && orig != c {
+		// An update cannot fail, so the receiver can be overwritten
+		// with the original factorization. A downdate may fail and
+		// works with a copy of orig instead.
 		if c.chol == nil {
 			c.chol = NewTriDense(n, Upper, nil)
-		} else if c.chol.mat.N != n {
-			panic(ErrShape)
 		}
 		c.chol.Copy(orig.chol)
+		c.cond = orig.cond
 	}
 
 	if alpha == 0 {
@@ -598,7 +757,7 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 	if rv, ok := x.(RawVectorer); ok {
 		xmat = rv.RawVector()
 	} else {
-		var tmp *VecDense
+		tmp := NewVecDense(n, nil)
 		tmp.CopyVec(x)
 		xmat = tmp.RawVector()
 	}
@@ -643,7 +802,7 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 		blas64.Scal(alpha, blas64.Vector{N: n, Data: work, Inc: 1})
 	}
 	// Solve Uᵀ * p = x storing the result into work.
-	ok = lapack64.Trtrs(blas.Trans, c.chol.RawTriangular(), blas64.General{
+	ok = lapack64.Trtrs(blas.Trans, orig.chol.RawTriangular(), blas64.General{
 		Rows:   n,
 		Cols:   1,
 		Stride: 1,
@@ -674,9 +833,9 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 			sin[i] *= -1
 		}
 	}
-	workMat := getTriDenseWorkspace(c.chol.mat.N, c.chol.triKind(), false)
+	workMat := getTriDenseWorkspace(n, Upper, false)
 	defer putTriWorkspace(workMat)
-	workMat.Copy(c.chol)
+	workMat.Copy(orig.chol)
 	umat := workMat.mat
 	stride := workMat.mat.Stride
 	for i := n - 1; i >= 0; i-- {
@@ -699,6 +858,9 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 		}
 	}
 	if ok {
+		if c.chol == nil {
+			c.chol = NewTriDense(n, Upper, nil)
+		}
 		c.chol.Copy(workMat)
 		c.updateCond(-1)
 	}
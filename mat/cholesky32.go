@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/lapack/lapack32"
+)
+
+const badCholesky32 = "mat: invalid Cholesky32 factorization"
+
+// Cholesky32 is a type for creating and using the Cholesky factorization of a
+// single precision symmetric positive definite matrix.
+//
+// The decomposition can be constructed using the Factorize method. The
+// factorization itself can be extracted using the UTo or LTo methods.
+//
+// Cholesky32 methods may only be called on a value that has been successfully
+// initialized by a call to Factorize that has returned true. Calls to methods
+// of an unsuccessful Cholesky32 factorization will panic.
+type Cholesky32 struct {
+	// chol holds the upper triangular Cholesky factor in its upper
+	// triangle. The strictly lower triangle is zero.
+	chol *Dense32
+}
+
+// Factorize calculates the Cholesky decomposition of the matrix A and returns
+// whether the matrix is positive definite. If Factorize returns false, the
+// factorization must not be used.
+//
+// The Cholesky decomposition of A is
+//  A = Uᵀ * U
+// where U is an upper triangular matrix with a positive diagonal.
+func (c *Cholesky32) Factorize(a Symmetric32) (ok bool) {
+	n := a.Symmetric()
+	if c.chol == nil {
+		c.chol = NewDense32(n, n, nil)
+	} else {
+		c.chol.Reset()
+		c.chol.reuseAsZeroed(n, n)
+	}
+	switch a := a.(type) {
+	case RawSymmetricer32:
+		amat := a.RawSymmetric32()
+		for i := 0; i < n; i++ {
+			copy(c.chol.mat.Data[i*c.chol.mat.Stride+i:i*c.chol.mat.Stride+n], amat.Data[i*amat.Stride+i:i*amat.Stride+n])
+		}
+	default:
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				c.chol.set(i, j, a.At(i, j))
+			}
+		}
+	}
+	_, ok = lapack32.Potrf(c.chol.asSymBlas())
+	if !ok {
+		c.Reset()
+	}
+	return ok
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (c *Cholesky32) Reset() {
+	if c.chol != nil {
+		c.chol.Reset()
+	}
+}
+
+// IsEmpty returns whether the receiver is empty. Empty factorizations can be
+// the receiver for size-restricted operations. The receiver can be emptied
+// using Reset.
+func (c *Cholesky32) IsEmpty() bool {
+	return c.chol == nil || c.chol.IsEmpty()
+}
+
+// valid returns whether the receiver contains a successful factorization.
+func (c *Cholesky32) valid() bool {
+	return !c.IsEmpty()
+}
+
+// Det returns the determinant of the matrix that has been factorized. The
+// determinant is computed in double precision.
+func (c *Cholesky32) Det() float64 {
+	if !c.valid() {
+		panic(badCholesky32)
+	}
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been
+// factorized. The determinant is computed in double precision.
+func (c *Cholesky32) LogDet() float64 {
+	if !c.valid() {
+		panic(badCholesky32)
+	}
+	var det float64
+	n, _ := c.chol.Dims()
+	for i := 0; i < n; i++ {
+		det += 2 * math.Log(float64(c.chol.mat.Data[i*c.chol.mat.Stride+i]))
+	}
+	return det
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+// If the Cholesky decomposition is singular a Condition error is returned.
+// See the documentation for Condition for more information.
+func (c *Cholesky32) SolveTo(dst *Dense32, b Matrix32) error {
+	if !c.valid() {
+		panic(badCholesky32)
+	}
+	n, _ := c.chol.Dims()
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+	for i := 0; i < n; i++ {
+		if c.chol.mat.Data[i*c.chol.mat.Stride+i] == 0 {
+			return Condition(math.Inf(1))
+		}
+	}
+
+	dst.reuseAsNonZeroed(bm, bn)
+	bU, _ := untranspose32(b)
+	var restore func()
+	if dst == bU {
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else {
+		dst.checkOverlapMatrix(bU)
+	}
+
+	dst.Copy(b)
+	t := blas32.Triangular{
+		N:      n,
+		Stride: c.chol.mat.Stride,
+		Data:   c.chol.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+	lapack32.Potrs(t, dst.mat)
+	return nil
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is represented
+// by the Cholesky decomposition. The result is stored in-place into
+// dst. If the Cholesky decomposition is singular a Condition error is
+// returned. See the documentation for Condition for more information.
+func (c *Cholesky32) SolveVecTo(dst *VecDense32, b Vector32) error {
+	if !c.valid() {
+		panic(badCholesky32)
+	}
+	n, _ := c.chol.Dims()
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(n)
+	d := dst.asDense32()
+	if dst == b {
+		return c.SolveTo(d, d)
+	}
+	return c.SolveTo(d, b)
+}
+
+// UTo stores into dst the n×n upper triangular matrix U from a Cholesky
+// decomposition
+//  A = Uᵀ * U.
+// If dst is empty, it is resized to be n×n. When dst is non-empty, UTo
+// panics if dst is not n×n. UTo will also panic if the receiver does not
+// contain a successful factorization.
+func (c *Cholesky32) UTo(dst *Dense32) {
+	if !c.valid() {
+		panic(badCholesky32)
+	}
+	n, _ := c.chol.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r2, c2 := dst.Dims()
+		if r2 != n || c2 != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(c.chol)
+}
+
+// LTo stores into dst the n×n lower triangular matrix L from a Cholesky
+// decomposition
+//  A = L * Lᵀ.
+// If dst is empty, it is resized to be n×n. When dst is non-empty, LTo
+// panics if dst is not n×n. LTo will also panic if the receiver does not
+// contain a successful factorization.
+func (c *Cholesky32) LTo(dst *Dense32) {
+	if !c.valid() {
+		panic(badCholesky32)
+	}
+	n, _ := c.chol.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r2, c2 := dst.Dims()
+		if r2 != n || c2 != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(c.chol.T())
+}
@@ -154,6 +154,83 @@ func TestCholeskySolveTo(t *testing.T) {
 	}
 }
 
+func TestCholeskySolveRefinedTo(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	rnd := rand.New(src)
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		for _, bc := range []int{1, 3} {
+			for _, kind := range []string{"random", "hilbert"} {
+				if kind == "hilbert" && n > 10 {
+					continue
+				}
+				var a *SymDense
+				if kind == "random" {
+					a = randSymDense(n, src)
+					for i := 0; i < n; i++ {
+						a.SetSym(i, i, a.At(i, i)+float64(n))
+					}
+				} else {
+					a = NewSymDense(n, hilbertDense(n).RawMatrix().Data)
+				}
+				want := NewDense(n, bc, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < bc; j++ {
+						want.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				var b Dense
+				b.Mul(a, want)
+
+				name := fmt.Sprintf("n=%v,bc=%v,kind=%v", n, bc, kind)
+
+				var chol Cholesky
+				if ok := chol.Factorize(a); !ok {
+					t.Errorf("%v: unexpected Cholesky factorization failure", name)
+					continue
+				}
+				// Pass A as a non-RawSymmetricer to exercise the copying
+				// path for half of the cases.
+				var as Symmetric = a
+				if bc == 3 {
+					as = asBasicSymmetric(a)
+				}
+				var x Dense
+				ferr, berr, err := chol.SolveRefinedTo(&x, as, &b)
+				if err != nil {
+					t.Errorf("%v: unexpected error: %v", name, err)
+					continue
+				}
+				checkRefinedErrors(t, name, &x, want, ferr, berr)
+
+				// Check that solving in place gives the same result.
+				bCopy := DenseCopyOf(&b)
+				_, _, err = chol.SolveRefinedTo(bCopy, as, bCopy)
+				if err != nil {
+					t.Errorf("%v: unexpected error in place: %v", name, err)
+					continue
+				}
+				if !Equal(bCopy, &x) {
+					t.Errorf("%v: in-place solution mismatch", name)
+				}
+
+				var xvec VecDense
+				fe, be, err := chol.SolveVecRefinedTo(&xvec, as, b.ColView(0))
+				if err != nil {
+					t.Errorf("%v: unexpected error from SolveVecRefinedTo: %v", name, err)
+					continue
+				}
+				if !Equal(&xvec, x.ColView(0)) {
+					t.Errorf("%v: vector solution mismatch", name)
+				}
+				if fe != ferr[0] || be != berr[0] {
+					t.Errorf("%v: vector error bounds mismatch: got (%v,%v), want (%v,%v)", name, fe, be, ferr[0], berr[0])
+				}
+			}
+		}
+	}
+}
+
 func TestCholeskySolveCholTo(t *testing.T) {
 	t.Parallel()
 	for _, test := range []struct {
@@ -503,6 +580,139 @@ func TestCholeskySymRankOne(t *testing.T) {
 	}
 }
 
+func TestCholeskySymRankOneDowndateFailure(t *testing.T) {
+	t.Parallel()
+	a := NewSymDense(4, []float64{
+		1, 1, 1, 1,
+		0, 2, 3, 4,
+		0, 0, 6, 10,
+		0, 0, 0, 20,
+	})
+	var orig Cholesky
+	ok := orig.Factorize(a)
+	if !ok {
+		t.Fatal("bad test, Cholesky factorization failed")
+	}
+	// Use a vector type that does not implement RawVectorer.
+	x := asBasicVector(NewVecDense(4, []float64{0, 0, 0, 1}))
+
+	b := NewSymDense(4, []float64{
+		4, 1, 1, 1,
+		0, 2, 1, 1,
+		0, 0, 3, 1,
+		0, 0, 0, 5,
+	})
+	var chol Cholesky
+	ok = chol.Factorize(b)
+	if !ok {
+		t.Fatal("bad test, Cholesky factorization failed")
+	}
+	var want Cholesky
+	want.Clone(&chol)
+
+	ok = chol.SymRankOne(&orig, -1, x)
+	if ok {
+		t.Fatal("expected a failure from SymRankOne")
+	}
+	if !equalChol(&chol, &want) {
+		t.Errorf("receiver modified by failed downdate")
+	}
+
+	ok = chol.SymRankOne(&orig, -0.5, x)
+	if !ok {
+		t.Fatal("unexpected failure from SymRankOne")
+	}
+	var got SymDense
+	chol.ToSym(&got)
+	a.SymRankOne(a, -0.5, x)
+	if !EqualApprox(&got, a, 1e-13) {
+		t.Errorf("mismatch between updated matrix and from Cholesky:\nupdated:\n%v\nfrom Cholesky:\n%v",
+			Formatted(a), Formatted(&got))
+	}
+}
+
+func TestCholeskyDeleteSym(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 3, 4, 5, 10, 20} {
+		for k := 0; k < n; k++ {
+			// Construct a random positive definite matrix.
+			data := make([]float64, n*n)
+			for i := range data {
+				data[i] = rnd.NormFloat64()
+			}
+			a := NewSymDense(n, nil)
+			a.SymOuterK(1, NewDense(n, n, data))
+			var orig Cholesky
+			ok := orig.Factorize(a)
+			if !ok {
+				t.Errorf("n=%d: bad test, Cholesky factorization failed", n)
+				continue
+			}
+
+			want := NewSymDense(n-1, nil)
+			for i := 0; i < n-1; i++ {
+				ii := i
+				if i >= k {
+					ii++
+				}
+				for j := i; j < n-1; j++ {
+					jj := j
+					if j >= k {
+						jj++
+					}
+					want.SetSym(i, j, a.At(ii, jj))
+				}
+			}
+			var cholWant Cholesky
+			ok = cholWant.Factorize(want)
+			if !ok {
+				t.Errorf("n=%d,k=%d: bad test, Cholesky factorization failed", n, k)
+				continue
+			}
+
+			var chol Cholesky
+			chol.DeleteSym(&orig, k)
+			var got SymDense
+			chol.ToSym(&got)
+			if !EqualApprox(&got, want, 1e-12) {
+				t.Errorf("n=%d,k=%d: mismatch between reduced matrix and from Cholesky:\nwant:\n%v\nfrom Cholesky:\n%v",
+					n, k, Formatted(want), Formatted(&got))
+			}
+			// The condition number with the updated rule is an
+			// overestimate, so only compare the factors.
+			if !EqualApprox(chol.chol, cholWant.chol, 1e-10) {
+				t.Errorf("n=%d,k=%d: updated Cholesky does not match full", n, k)
+			}
+
+			// Test in-place.
+			orig.DeleteSym(&orig, k)
+			if !equalChol(&orig, &chol) {
+				t.Errorf("n=%d,k=%d: Cholesky different in-place vs. new", n, k)
+			}
+		}
+	}
+
+	// Deleting the last row and column is the inverse of ExtendVecSym.
+	a := NewSymDense(3, []float64{
+		4, 1, 1,
+		0, 2, 3,
+		0, 0, 6,
+	})
+	var chol, cholSub Cholesky
+	if !chol.Factorize(a) || !cholSub.Factorize(a.sliceSym(0, 2)) {
+		t.Fatal("bad test, Cholesky factorization failed")
+	}
+	var ext Cholesky
+	if !ext.ExtendVecSym(&cholSub, NewVecDense(3, []float64{1, 3, 6})) {
+		t.Fatal("bad test, ExtendVecSym failed")
+	}
+	ext.DeleteSym(&ext, 2)
+	if !EqualApprox(ext.chol, cholSub.chol, 1e-14) {
+		t.Errorf("DeleteSym does not invert ExtendVecSym")
+	}
+}
+
 func TestCholeskyExtendVecSym(t *testing.T) {
 	t.Parallel()
 	for cas, test := range []struct {
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+var (
+	coo *COO
+	_   Matrix      = coo
+	_   allMatrix   = coo
+	_   Mutable     = coo
+	_   NonZeroDoer = coo
+)
+
+// COO represents a sparse matrix in coordinate format. Each stored element is
+// held as a row index, column index and value triplet. Elements may be stored
+// in any order and an element may be stored more than once, in which case the
+// value of the element is the sum of the stored values.
+//
+// COO is efficient for incremental construction of sparse matrices using
+// Append. Element access takes time linear in the number of stored elements,
+// so a COO should be converted to a CSR or CSC using CloneFrom before being
+// used in computation.
+type COO struct {
+	r, c int
+	rows []int
+	cols []int
+	data []float64
+}
+
+// NewCOO creates a new r×c sparse matrix in coordinate format. The row and
+// column indices of the stored elements are held in rows and cols and the
+// corresponding values are held in data. If rows, cols and data are all nil,
+// an all zero matrix is returned.
+//
+// The lengths of rows, cols and data must be equal and the indices must be
+// within the dimensions of the matrix, otherwise NewCOO will panic. The slices
+// are used as the backing storage for the matrix. NewCOO will panic if r or c
+// is not positive.
+func NewCOO(r, c int, rows, cols []int, data []float64) *COO {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if len(rows) != len(data) || len(cols) != len(data) {
+		panic(ErrShape)
+	}
+	for k, i := range rows {
+		if uint(i) >= uint(r) || uint(cols[k]) >= uint(c) {
+			panic(ErrSparseIndex)
+		}
+	}
+	return &COO{r: r, c: c, rows: rows, cols: cols, data: data}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *COO) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i, column j.
+func (m *COO) At(i, j int) float64 {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	var v float64
+	for k, r := range m.rows {
+		if r == i && m.cols[k] == j {
+			v += m.data[k]
+		}
+	}
+	return v
+}
+
+// Set sets the element at row i, column j to the value v, removing any
+// other stored values for the element.
+func (m *COO) Set(i, j int, v float64) {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	var n int
+	for k, r := range m.rows {
+		if r == i && m.cols[k] == j {
+			continue
+		}
+		m.rows[n] = r
+		m.cols[n] = m.cols[k]
+		m.data[n] = m.data[k]
+		n++
+	}
+	m.rows = m.rows[:n]
+	m.cols = m.cols[:n]
+	m.data = m.data[:n]
+	if v != 0 {
+		m.append(i, j, v)
+	}
+}
+
+// Append adds v to the element at row i, column j by storing a new element
+// triplet. Append does not check for existing entries for the element.
+func (m *COO) Append(i, j int, v float64) {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	m.append(i, j, v)
+}
+
+func (m *COO) append(i, j int, v float64) {
+	m.rows = append(m.rows, i)
+	m.cols = append(m.cols, j)
+	m.data = append(m.data, v)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *COO) T() Matrix {
+	return Transpose{m}
+}
+
+// NNZ returns the number of stored elements in the matrix, including
+// duplicate elements.
+func (m *COO) NNZ() int {
+	return len(m.data)
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *COO) IsEmpty() bool {
+	return m.r == 0
+}
+
+// Reset empties the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+// See the Reseter interface for more information.
+func (m *COO) Reset() {
+	m.r = 0
+	m.c = 0
+	m.Zero()
+}
+
+// Zero sets all of the matrix elements to zero, removing all stored elements.
+func (m *COO) Zero() {
+	m.rows = m.rows[:0]
+	m.cols = m.cols[:0]
+	m.data = m.data[:0]
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. Only the non-zero elements of a are stored.
+func (m *COO) CloneFrom(a Matrix) {
+	if a == m {
+		return
+	}
+	m.r, m.c = a.Dims()
+	m.rows, m.cols, m.data = nonZeroEntries(a)
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst. dst and x may be
+// the same vector, but MulVecTo panics if they otherwise overlap.
+func (m *COO) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	r, c := m.r, m.c
+	if trans {
+		r, c = c, r
+	}
+	if x.Len() != c {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(r)
+	if dst == x {
+		xCopy := getVecDenseWorkspace(c, false)
+		xCopy.CloneFromVec(x)
+		defer putVecDenseWorkspace(xCopy)
+		x = xCopy
+	} else if xv, ok := x.(*VecDense); ok {
+		dst.checkOverlap(xv.mat)
+	}
+	dst.Zero()
+	for k, v := range m.data {
+		i, j := m.rows[k], m.cols[k]
+		if trans {
+			i, j = j, i
+		}
+		dst.SetVec(i, dst.AtVec(i)+v*x.AtVec(j))
+	}
+}
+
+// DoNonZero calls the function fn for each of the non-zero stored elements of
+// the receiver. The function fn takes a row/column index and the element value
+// of the receiver at (i, j). If an element is stored more than once, fn is
+// called for each of the stored values.
+func (m *COO) DoNonZero(fn func(i, j int, v float64)) {
+	for k, v := range m.data {
+		if v != 0 {
+			fn(m.rows[k], m.cols[k], v)
+		}
+	}
+}
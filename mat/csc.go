@@ -0,0 +1,175 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+var (
+	csc *CSC
+	_   Matrix    = csc
+	_   allMatrix = csc
+	_   Mutable   = csc
+
+	_ NonZeroDoer    = csc
+	_ RowNonZeroDoer = csc
+	_ ColNonZeroDoer = csc
+)
+
+// CSC represents a sparse matrix in compressed sparse column format. Only the
+// non-zero elements of the matrix are stored. The row indices of the elements
+// in column j are held in strictly increasing order, so element access takes
+// time logarithmic in the number of non-zero elements in the column.
+//
+// CSC is efficient for column access and transposed matrix-vector products.
+// Setting a previously zero element of a CSC requires the non-zero elements
+// following it to be moved, so matrices should be constructed using a COO and
+// converted with CloneFrom when many elements need to be set.
+type CSC struct {
+	mat compressed
+}
+
+// NewCSC creates a new r×c sparse matrix in compressed sparse column format.
+// The row indices of the non-zero elements of column j are held in
+// ind[indptr[j]:indptr[j+1]] in strictly increasing order and the
+// corresponding values are held in data. If indptr, ind and data are all
+// nil, an all zero matrix is returned.
+//
+// The length of indptr must be c+1 with indptr[0] == 0 and indptr[c] equal
+// to the lengths of ind and data, otherwise NewCSC will panic. The slices are
+// used as the backing storage for the matrix, so changes to the elements of
+// the returned CSC will be reflected in data. NewCSC will panic if r or c is
+// not positive.
+func NewCSC(r, c int, indptr, ind []int, data []float64) *CSC {
+	return &CSC{mat: newCompressed(c, r, indptr, ind, data)}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSC) Dims() (r, c int) {
+	return m.mat.minor, m.mat.major
+}
+
+// At returns the element at row i, column j.
+func (m *CSC) At(i, j int) float64 {
+	if uint(i) >= uint(m.mat.minor) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.major) {
+		panic(ErrColAccess)
+	}
+	return m.mat.at(j, i)
+}
+
+// Set sets the element at row i, column j to the value v. Setting an element
+// to zero removes it from the stored non-zero elements.
+func (m *CSC) Set(i, j int, v float64) {
+	if uint(i) >= uint(m.mat.minor) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.major) {
+		panic(ErrColAccess)
+	}
+	m.mat.set(j, i, v)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *CSC) T() Matrix {
+	return Transpose{m}
+}
+
+// NNZ returns the number of stored elements in the matrix.
+func (m *CSC) NNZ() int {
+	return m.mat.nnz()
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *CSC) IsEmpty() bool {
+	return m.mat.major == 0
+}
+
+// Reset empties the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+// See the Reseter interface for more information.
+func (m *CSC) Reset() {
+	m.mat.reset()
+}
+
+// Zero sets all of the matrix elements to zero, removing all stored elements.
+func (m *CSC) Zero() {
+	m.mat.zero()
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. Only the non-zero elements of a are stored.
+func (m *CSC) CloneFrom(a Matrix) {
+	if a == m {
+		return
+	}
+	c, shared := compressedOf(a, false)
+	if shared {
+		c = c.clone()
+	}
+	m.mat = c
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver
+// as a sparse matrix. If the receiver is empty it is resized to the
+// dimensions of the product, otherwise the receiver must have the dimensions
+// of the product. If the number of columns in a does not equal the number of
+// rows in b, Mul will panic.
+//
+// Mul is efficient when both a and b are sparse.
+func (m *CSC) Mul(a, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(ErrShape)
+	}
+	if !m.IsEmpty() {
+		if r, c := m.Dims(); r != ar || c != bc {
+			panic(ErrShape)
+		}
+	}
+	// The column compressed representation of A⋅B is
+	// the row compressed representation of Bᵀ⋅Aᵀ.
+	ca, _ := compressedOf(a, false)
+	cb, _ := compressedOf(b, false)
+	m.mat = mulCompressed(cb, ca)
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst. dst and x may be
+// the same vector, but MulVecTo panics if they otherwise overlap.
+func (m *CSC) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	m.mat.mulVecTo(dst, !trans, x)
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of the
+// receiver. The function fn takes a row/column index and the element value of
+// the receiver at (i, j).
+func (m *CSC) DoNonZero(fn func(i, j int, v float64)) {
+	m.mat.doNonZero(func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row
+// i of the receiver. The function fn takes a row/column index and the element
+// value of the receiver at (i, j).
+func (m *CSC) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.mat.minor) {
+		panic(ErrRowAccess)
+	}
+	m.mat.doMinorNonZero(i, func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of the receiver. The function fn takes a row/column index and the
+// element value of the receiver at (i, j).
+func (m *CSC) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.mat.major) {
+		panic(ErrColAccess)
+	}
+	m.mat.doMajorNonZero(j, func(j, i int, v float64) { fn(i, j, v) })
+}
@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+var (
+	csr *CSR
+	_   Matrix    = csr
+	_   allMatrix = csr
+	_   Mutable   = csr
+
+	_ NonZeroDoer    = csr
+	_ RowNonZeroDoer = csr
+	_ ColNonZeroDoer = csr
+)
+
+// CSR represents a sparse matrix in compressed sparse row format. Only the
+// non-zero elements of the matrix are stored. The column indices of the
+// elements in row i are held in strictly increasing order, so element access
+// takes time logarithmic in the number of non-zero elements in the row.
+//
+// CSR is efficient for row access and matrix-vector products. Setting a
+// previously zero element of a CSR requires the non-zero elements following
+// it to be moved, so matrices should be constructed using a COO and converted
+// with CloneFrom when many elements need to be set.
+type CSR struct {
+	mat compressed
+}
+
+// NewCSR creates a new r×c sparse matrix in compressed sparse row format.
+// The column indices of the non-zero elements of row i are held in
+// ind[indptr[i]:indptr[i+1]] in strictly increasing order and the
+// corresponding values are held in data. If indptr, ind and data are all
+// nil, an all zero matrix is returned.
+//
+// The length of indptr must be r+1 with indptr[0] == 0 and indptr[r] equal
+// to the lengths of ind and data, otherwise NewCSR will panic. The slices are
+// used as the backing storage for the matrix, so changes to the elements of
+// the returned CSR will be reflected in data. NewCSR will panic if r or c is
+// not positive.
+func NewCSR(r, c int, indptr, ind []int, data []float64) *CSR {
+	return &CSR{mat: newCompressed(r, c, indptr, ind, data)}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSR) Dims() (r, c int) {
+	return m.mat.major, m.mat.minor
+}
+
+// At returns the element at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	if uint(i) >= uint(m.mat.major) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.minor) {
+		panic(ErrColAccess)
+	}
+	return m.mat.at(i, j)
+}
+
+// Set sets the element at row i, column j to the value v. Setting an element
+// to zero removes it from the stored non-zero elements.
+func (m *CSR) Set(i, j int, v float64) {
+	if uint(i) >= uint(m.mat.major) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.minor) {
+		panic(ErrColAccess)
+	}
+	m.mat.set(i, j, v)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *CSR) T() Matrix {
+	return Transpose{m}
+}
+
+// NNZ returns the number of stored elements in the matrix.
+func (m *CSR) NNZ() int {
+	return m.mat.nnz()
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *CSR) IsEmpty() bool {
+	return m.mat.major == 0
+}
+
+// Reset empties the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+// See the Reseter interface for more information.
+func (m *CSR) Reset() {
+	m.mat.reset()
+}
+
+// Zero sets all of the matrix elements to zero, removing all stored elements.
+func (m *CSR) Zero() {
+	m.mat.zero()
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. Only the non-zero elements of a are stored.
+func (m *CSR) CloneFrom(a Matrix) {
+	if a == m {
+		return
+	}
+	c, shared := compressedOf(a, true)
+	if shared {
+		c = c.clone()
+	}
+	m.mat = c
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver
+// as a sparse matrix. If the receiver is empty it is resized to the
+// dimensions of the product, otherwise the receiver must have the dimensions
+// of the product. If the number of columns in a does not equal the number of
+// rows in b, Mul will panic.
+//
+// Mul is efficient when both a and b are sparse.
+func (m *CSR) Mul(a, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(ErrShape)
+	}
+	if !m.IsEmpty() {
+		if r, c := m.Dims(); r != ar || c != bc {
+			panic(ErrShape)
+		}
+	}
+	ca, _ := compressedOf(a, true)
+	cb, _ := compressedOf(b, true)
+	m.mat = mulCompressed(ca, cb)
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst. dst and x may be
+// the same vector, but MulVecTo panics if they otherwise overlap.
+func (m *CSR) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	m.mat.mulVecTo(dst, trans, x)
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of the
+// receiver. The function fn takes a row/column index and the element value of
+// the receiver at (i, j).
+func (m *CSR) DoNonZero(fn func(i, j int, v float64)) {
+	m.mat.doNonZero(fn)
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row
+// i of the receiver. The function fn takes a row/column index and the element
+// value of the receiver at (i, j).
+func (m *CSR) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.mat.major) {
+		panic(ErrRowAccess)
+	}
+	m.mat.doMajorNonZero(i, fn)
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of the receiver. The function fn takes a row/column index and the
+// element value of the receiver at (i, j).
+func (m *CSR) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.mat.minor) {
+		panic(ErrColAccess)
+	}
+	m.mat.doMinorNonZero(j, fn)
+}
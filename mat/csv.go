@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var errCSVData = errors.New("mat: invalid delimited text data")
+
+// WriteCSV writes the matrix a to w as delimited text with one row of a per
+// line and the elements of a row separated by comma. Elements are formatted
+// with the minimal number of digits needed to represent them exactly.
+func WriteCSV(w io.Writer, a Matrix, comma rune) error {
+	r, c := a.Dims()
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	record := make([]string, c)
+	for i := 0; i < r; i++ {
+		for j := range record {
+			record[j] = strconv.FormatFloat(a.At(i, j), 'g', -1, 64)
+		}
+		err := cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteCCSV writes the complex matrix a to w as delimited text with one row
+// of a per line and the elements of a row separated by comma. Elements are
+// written in the form 1.5+2i.
+func WriteCCSV(w io.Writer, a CMatrix, comma rune) error {
+	r, c := a.Dims()
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	record := make([]string, c)
+	for i := 0; i < r; i++ {
+		for j := range record {
+			record[j] = formatCSVComplex(a.At(i, j))
+		}
+		err := cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCSV reads a matrix stored as delimited text with elements separated by
+// comma from r. Each non-empty line holds one row of the matrix and all rows
+// must have the same number of elements. Lines starting with '#' are ignored
+// and leading white space in an element is trimmed, so white space separated
+// data can be read with comma set to ' ' or '\t'.
+func ReadCSV(r io.Reader, comma rune) (*Dense, error) {
+	records, err := readCSVRecords(r, comma)
+	if err != nil {
+		return nil, err
+	}
+	m := NewDense(len(records), len(records[0]), nil)
+	for i, record := range records {
+		row := m.RawRowView(i)
+		for j, f := range record {
+			row[j], err = strconv.ParseFloat(f, 64)
+			if err != nil {
+				return nil, errCSVData
+			}
+		}
+	}
+	return m, nil
+}
+
+// ReadCCSV reads a complex matrix stored as delimited text with elements
+// separated by comma from r as described by ReadCSV. Elements may be real
+// numbers or complex numbers in the form 1.5+2i, where the imaginary unit
+// may also be written as j and the number may be enclosed in parentheses.
+func ReadCCSV(r io.Reader, comma rune) (*CDense, error) {
+	records, err := readCSVRecords(r, comma)
+	if err != nil {
+		return nil, err
+	}
+	m := NewCDense(len(records), len(records[0]), nil)
+	for i, record := range records {
+		for j, f := range record {
+			v, err := parseCSVComplex(f)
+			if err != nil {
+				return nil, err
+			}
+			m.set(i, j, v)
+		}
+	}
+	return m, nil
+}
+
+// readCSVRecords reads all records from r, dropping the empty fields produced
+// by repeated white space separators.
+func readCSVRecords(r io.Reader, comma rune) ([][]string, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.Comment = '#'
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var n int
+	for _, record := range records {
+		fields := record[:0]
+		for _, f := range record {
+			f = strings.TrimSpace(f)
+			if f != "" {
+				fields = append(fields, f)
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		records[n] = fields
+		n++
+	}
+	records = records[:n]
+	if len(records) == 0 {
+		return nil, ErrZeroLength
+	}
+	for _, record := range records[1:] {
+		if len(record) != len(records[0]) {
+			return nil, ErrRowLength
+		}
+	}
+	return records, nil
+}
+
+// formatCSVComplex formats v as real and imaginary parts followed by i.
+func formatCSVComplex(v complex128) string {
+	im := strconv.FormatFloat(imag(v), 'g', -1, 64)
+	if !strings.HasPrefix(im, "-") && !strings.HasPrefix(im, "+") {
+		im = "+" + im
+	}
+	return strconv.FormatFloat(real(v), 'g', -1, 64) + im + "i"
+}
+
+// parseCSVComplex parses a complex number as written by formatCSVComplex.
+// Real numbers, a j imaginary unit and enclosing parentheses are also
+// accepted.
+func parseCSVComplex(s string) (complex128, error) {
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s = s[1 : len(s)-1]
+	}
+	if !strings.HasSuffix(s, "i") && !strings.HasSuffix(s, "j") {
+		re, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, errCSVData
+		}
+		return complex(re, 0), nil
+	}
+	s = s[:len(s)-1]
+	// Find the sign separating the real and imaginary parts, skipping a
+	// leading sign and the signs of exponents.
+	k := -1
+	for i := len(s) - 1; i > 0; i-- {
+		if (s[i] == '+' || s[i] == '-') && s[i-1] != 'e' && s[i-1] != 'E' {
+			k = i
+			break
+		}
+	}
+	if k < 0 {
+		// Purely imaginary number.
+		im, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, errCSVData
+		}
+		return complex(0, im), nil
+	}
+	re, err := strconv.ParseFloat(s[:k], 64)
+	if err != nil {
+		return 0, errCSVData
+	}
+	im, err := strconv.ParseFloat(s[k:], 64)
+	if err != nil {
+		return 0, errCSVData
+	}
+	return complex(re, im), nil
+}
@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    Matrix
+	}{
+		{name: "Dense", a: randNormalDense(4, 3, rnd)},
+		{name: "SymDense", a: randSymDense(5, rnd)},
+		{name: "TriDense", a: NewTriDense(3, Lower, []float64{1, 0, 0, 2, 3, 0, 4, 5, 6})},
+		{name: "BandDense", a: NewBandDense(3, 4, 1, 1, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8})},
+		{name: "Special", a: NewDense(1, 4, []float64{math.Inf(1), math.Inf(-1), 1e-300, -0.1})},
+	} {
+		for _, comma := range []rune{',', '\t', ' ', ';'} {
+			var buf bytes.Buffer
+			err := WriteCSV(&buf, test.a, comma)
+			if err != nil {
+				t.Fatalf("%s, comma %q: unexpected error writing: %v", test.name, comma, err)
+			}
+			got, err := ReadCSV(&buf, comma)
+			if err != nil {
+				t.Errorf("%s, comma %q: unexpected error reading: %v", test.name, comma, err)
+				continue
+			}
+			if !Equal(got, test.a) {
+				t.Errorf("%s, comma %q: round trip mismatch:\ngot:\n%v\nwant:\n%v",
+					test.name, comma, Formatted(got), Formatted(test.a))
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, NewDense(2, 2, []float64{1, -2.5, 0, 1e21}), ',')
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "1,-2.5\n0,1e+21\n"; buf.String() != want {
+		t.Errorf("unexpected output: got:%q want:%q", buf.String(), want)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		src   string
+		comma rune
+		want  *Dense
+		err   error
+	}{
+		{
+			src:   "# A comment.\n1, 2, 3\n\n4, 5, 6\n",
+			comma: ',',
+			want:  NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		},
+		{
+			src:   "  1   2\n3\t 4  \n",
+			comma: ' ',
+			want:  NewDense(2, 2, []float64{1, 2, 3, 4}),
+		},
+		{
+			src:   "1\t2\n3\t4\n",
+			comma: '\t',
+			want:  NewDense(2, 2, []float64{1, 2, 3, 4}),
+		},
+		{
+			src:   "1,2\n3\n",
+			comma: ',',
+			err:   ErrRowLength,
+		},
+		{
+			src:   "# Only a comment.\n",
+			comma: ',',
+			err:   ErrZeroLength,
+		},
+		{
+			src:   "1,x\n",
+			comma: ',',
+			err:   errCSVData,
+		},
+	} {
+		got, err := ReadCSV(strings.NewReader(test.src), test.comma)
+		if err != test.err {
+			t.Errorf("test %d: unexpected error: got:%v want:%v", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !Equal(got, test.want) {
+			t.Errorf("test %d: unexpected result:\ngot:\n%v\nwant:\n%v", i, Formatted(got), Formatted(test.want))
+		}
+	}
+}
+
+func TestCCSV(t *testing.T) {
+	t.Parallel()
+	a := NewCDense(2, 3, []complex128{1 + 2i, 0, -3i, 4, 5.5e-10 - 1e20i, complex(math.Inf(1), math.Inf(-1))})
+	var buf bytes.Buffer
+	err := WriteCCSV(&buf, a, ',')
+	if err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+	got, err := ReadCCSV(&buf, ',')
+	if err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	}
+	if !CEqual(got, a) {
+		t.Errorf("round trip mismatch:\ngot: %v\nwant:%v", got.mat.Data, a.mat.Data)
+	}
+
+	for _, test := range []struct {
+		s    string
+		want complex128
+		err  error
+	}{
+		{s: "1.5", want: 1.5},
+		{s: "-2i", want: -2i},
+		{s: "(1+2j)", want: 1 + 2i},
+		{s: "-1e-3-2.5e+3i", want: -1e-3 - 2.5e3i},
+		{s: "1+xi", err: errCSVData},
+	} {
+		got, err := parseCSVComplex(test.s)
+		if err != test.err {
+			t.Errorf("%q: unexpected error: got:%v want:%v", test.s, err, test.err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%q: unexpected value: got:%v want:%v", test.s, got, test.want)
+		}
+	}
+}
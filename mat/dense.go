@@ -439,6 +439,9 @@ func (m *Dense) CloneFrom(a Matrix) {
 		mat.Data = make([]float64, aU.mat.N)
 		blas64.Copy(blas64.Vector{N: aU.mat.N, Inc: amat.Inc, Data: amat.Data},
 			blas64.Vector{N: aU.mat.N, Inc: 1, Data: mat.Data})
+	case *CSR, *CSC, *COO:
+		mat.Data = make([]float64, r*c)
+		addSparseTo(mat, aU.(NonZeroDoer), trans, r, c)
 	default:
 		mat.Data = make([]float64, r*c)
 		w := *m
@@ -525,6 +528,11 @@ func (m *Dense) Copy(a Matrix) (r, c int) {
 		default:
 			// Nothing to do.
 		}
+	case *CSR, *CSC, *COO:
+		for i := 0; i < r; i++ {
+			zero(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c])
+		}
+		addSparseTo(m.mat, aU.(NonZeroDoer), trans, r, c)
 	default:
 		m.checkOverlapMatrix(aU)
 		for i := 0; i < r; i++ {
@@ -0,0 +1,245 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// Add adds a and b element-wise, placing the result in the receiver. Add
+// will panic if the two matrices do not have the same shape.
+func (m *Dense32) Add(a, b Matrix32) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans := untransposeExtract32(a)
+	bU, bTrans := untransposeExtract32(b)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*Dense32); ok {
+		if brm, ok := b.(*Dense32); ok {
+			amat, bmat := arm.mat, brm.mat
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = v + bmat.Data[i+jb]
+				}
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if aTrans && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, a.At(r, c)+b.At(r, c))
+		}
+	}
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape.
+func (m *Dense32) Sub(a, b Matrix32) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans := untransposeExtract32(a)
+	bU, bTrans := untransposeExtract32(b)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*Dense32); ok {
+		if brm, ok := b.(*Dense32); ok {
+			amat, bmat := arm.mat, brm.mat
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = v - bmat.Data[i+jb]
+				}
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if aTrans && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, a.At(r, c)-b.At(r, c))
+		}
+	}
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+func (m *Dense32) Scale(f float32, a Matrix32) {
+	ar, ac := a.Dims()
+
+	m.reuseAsNonZeroed(ar, ac)
+
+	aU, aTrans := untransposeExtract32(a)
+	if rm, ok := aU.(*Dense32); ok {
+		amat := rm.mat
+		if m == aU || m.checkOverlap(amat) {
+			var restore func()
+			m, restore = m.isolatedWorkspace(a)
+			defer restore()
+		}
+		if !aTrans {
+			for ja, jm := 0, 0; ja < ar*amat.Stride; ja, jm = ja+amat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = v * f
+				}
+			}
+		} else {
+			for ja, jm := 0, 0; ja < ac*amat.Stride; ja, jm = ja+amat.Stride, jm+1 {
+				for i, v := range amat.Data[ja : ja+ar] {
+					m.mat.Data[i*m.mat.Stride+jm] = v * f
+				}
+			}
+		}
+		return
+	}
+
+	m.checkOverlapMatrix(a)
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, f*a.At(r, c))
+		}
+	}
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver.
+// If the number of columns in a does not equal the number of rows in b, Mul will panic.
+func (m *Dense32) Mul(a, b Matrix32) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+
+	if ac != br {
+		panic(ErrShape)
+	}
+
+	aU, aTrans := untransposeExtract32(a)
+	bU, bTrans := untransposeExtract32(b)
+	m.reuseAsNonZeroed(ar, bc)
+	var restore func()
+	if m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+	aT := blas.NoTrans
+	if aTrans {
+		aT = blas.Trans
+	}
+	bT := blas.NoTrans
+	if bTrans {
+		bT = blas.Trans
+	}
+
+	amat, aGeneral := general32(aU)
+	bmat, bGeneral := general32(bU)
+	if aGeneral {
+		if restore == nil {
+			m.checkOverlap(amat)
+		}
+		if bGeneral {
+			if restore == nil {
+				m.checkOverlap(bmat)
+			}
+			blas32.Gemm(aT, bT, 1, amat, bmat, 0, m.mat)
+			return
+		}
+		if s, ok := bU.(*SymDense32); ok {
+			if aTrans {
+				// C = Aᵀ * S = (S * A)ᵀ
+				c := NewDense32(ac, ar, nil)
+				blas32.Symm(blas.Left, 1, s.mat, amat, 0, c.mat)
+				m.Copy(c.T())
+				return
+			}
+			blas32.Symm(blas.Right, 1, s.mat, amat, 0, m.mat)
+			return
+		}
+	}
+	if s, ok := aU.(*SymDense32); ok && bGeneral {
+		if restore == nil {
+			m.checkOverlap(bmat)
+		}
+		if bTrans {
+			// C = S * Bᵀ = (B * S)ᵀ
+			c := NewDense32(bc, br, nil)
+			blas32.Symm(blas.Right, 1, s.mat, bmat, 0, c.mat)
+			m.Copy(c.T())
+			return
+		}
+		blas32.Symm(blas.Left, 1, s.mat, bmat, 0, m.mat)
+		return
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	row := make([]float32, ac)
+	for r := 0; r < ar; r++ {
+		for i := range row {
+			row[i] = a.At(r, i)
+		}
+		for c := 0; c < bc; c++ {
+			var v float32
+			for i, e := range row {
+				v += e * b.At(i, c)
+			}
+			m.mat.Data[r*m.mat.Stride+c] = v
+		}
+	}
+}
+
+// general32 returns the blas32.General representation of a and true if a is
+// a *Dense32 or a *VecDense32. Otherwise it returns false.
+func general32(a Matrix32) (blas32.General, bool) {
+	switch a := a.(type) {
+	case *Dense32:
+		return a.mat, true
+	case *VecDense32:
+		return a.asGeneral(), true
+	}
+	return blas32.General{}, false
+}
@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func randDense32(r, c int, rnd *rand.Rand) *Dense32 {
+	d := NewDense32(r, c, nil)
+	for i := range d.mat.Data {
+		d.mat.Data[i] = float32(rnd.NormFloat64())
+	}
+	return d
+}
+
+func randSymDense32(n int, rnd *rand.Rand) *SymDense32 {
+	s := NewSymDense32(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, float32(rnd.NormFloat64()))
+		}
+	}
+	return s
+}
+
+// dense64 returns a float64 copy of the single precision matrix a.
+func dense64(a Matrix32) *Dense {
+	r, c := a.Dims()
+	d := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			d.Set(i, j, float64(a.At(i, j)))
+		}
+	}
+	return d
+}
+
+func TestDense32Arithmetic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c int
+	}{
+		{1, 1},
+		{3, 3},
+		{4, 7},
+		{7, 4},
+	} {
+		r, c := test.r, test.c
+		name := fmt.Sprintf("r=%d,c=%d", r, c)
+		a := randDense32(r, c, rnd)
+		b := randDense32(r, c, rnd)
+		bt := randDense32(c, r, rnd)
+		a64, b64, bt64 := dense64(a), dense64(b), dense64(bt)
+
+		for _, test := range []struct {
+			op   string
+			got  func(dst *Dense32)
+			want func(dst *Dense)
+		}{
+			{
+				op:   "Add",
+				got:  func(dst *Dense32) { dst.Add(a, b) },
+				want: func(dst *Dense) { dst.Add(a64, b64) },
+			},
+			{
+				op:   "AddT",
+				got:  func(dst *Dense32) { dst.Add(a, bt.T()) },
+				want: func(dst *Dense) { dst.Add(a64, bt64.T()) },
+			},
+			{
+				op:   "Sub",
+				got:  func(dst *Dense32) { dst.Sub(a, b) },
+				want: func(dst *Dense) { dst.Sub(a64, b64) },
+			},
+			{
+				op:   "SubT",
+				got:  func(dst *Dense32) { dst.Sub(a, bt.T()) },
+				want: func(dst *Dense) { dst.Sub(a64, bt64.T()) },
+			},
+			{
+				op:   "Scale",
+				got:  func(dst *Dense32) { dst.Scale(-2.5, a) },
+				want: func(dst *Dense) { dst.Scale(-2.5, a64) },
+			},
+			{
+				op:   "ScaleT",
+				got:  func(dst *Dense32) { dst.Scale(3, bt.T()) },
+				want: func(dst *Dense) { dst.Scale(3, bt64.T()) },
+			},
+		} {
+			var got Dense32
+			var want Dense
+			test.got(&got)
+			test.want(&want)
+			if !EqualApprox(dense64(&got), &want, 1e-6) {
+				t.Errorf("%s: unexpected result for %s", name, test.op)
+			}
+		}
+
+		// Check that in-place operation is correct.
+		dst := NewDense32(r, c, nil)
+		dst.Copy(a)
+		dst.Add(dst, b)
+		var want Dense
+		want.Add(a64, b64)
+		if !EqualApprox(dense64(dst), &want, 1e-6) {
+			t.Errorf("%s: unexpected result for in-place Add", name)
+		}
+	}
+}
+
+func TestDense32Mul(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, k, n int
+	}{
+		{1, 1, 1},
+		{3, 4, 5},
+		{5, 4, 3},
+		{6, 6, 6},
+		{1, 7, 1},
+		{7, 1, 7},
+	} {
+		m, k, n := test.m, test.k, test.n
+		name := fmt.Sprintf("m=%d,k=%d,n=%d", m, k, n)
+
+		a := randDense32(m, k, rnd)
+		at := randDense32(k, m, rnd)
+		b := randDense32(k, n, rnd)
+		bt := randDense32(n, k, rnd)
+		sa := randSymDense32(m, rnd)
+		sb := randSymDense32(k, rnd)
+		v := randDense32(k, 1, rnd)
+		vec := NewVecDense32(k, v.mat.Data)
+
+		for _, test := range []struct {
+			a, b Matrix32
+		}{
+			{a, b},
+			{at.T(), b},
+			{a, bt.T()},
+			{at.T(), bt.T()},
+			{a, sb},
+			{at.T(), sb},
+			{sa, a},
+			{sa, at.T()},
+			{sb, sb},
+			{a, vec},
+			{vec.T(), b},
+			{vec, vec.T()},
+			{vec.T(), vec},
+		} {
+			var got Dense32
+			got.Mul(test.a, test.b)
+			var want Dense
+			want.Mul(dense64(test.a), dense64(test.b))
+			if !EqualApprox(dense64(&got), &want, 1e-5) {
+				t.Errorf("%s: unexpected result for %T×%T", name, test.a, test.b)
+			}
+		}
+
+		var got VecDense32
+		got.MulVec(a, vec)
+		var want VecDense
+		want.MulVec(dense64(a), dense64(vec).ColView(0))
+		if !EqualApprox(dense64(&got), &want, 1e-5) {
+			t.Errorf("%s: unexpected result for MulVec", name)
+		}
+		got.Reset()
+		got.MulVec(sb, vec)
+		want.Reset()
+		want.MulVec(dense64(sb), dense64(vec).ColView(0))
+		if !EqualApprox(dense64(&got), &want, 1e-5) {
+			t.Errorf("%s: unexpected result for symmetric MulVec", name)
+		}
+
+		var s SymDense32
+		s.SymOuterK(2, a)
+		var s64 SymDense
+		s64.SymOuterK(2, dense64(a))
+		if !EqualApprox(dense64(&s), &s64, 1e-5) {
+			t.Errorf("%s: unexpected result for SymOuterK", name)
+		}
+	}
+
+	// Check that the receiver may be an operand.
+	a := randDense32(5, 5, rnd)
+	b := randDense32(5, 5, rnd)
+	var want Dense
+	want.Mul(dense64(a), dense64(b))
+	a.Mul(a, b)
+	if !EqualApprox(dense64(a), &want, 1e-5) {
+		t.Errorf("unexpected result for in-place Mul")
+	}
+}
+
+func TestVecDense32(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 3, 10} {
+		a := NewVecDense32(n, randDense32(n, 1, rnd).mat.Data)
+		b := NewVecDense32(n, randDense32(n, 1, rnd).mat.Data)
+		a64 := dense64(a).ColView(0)
+		b64 := dense64(b).ColView(0)
+
+		var got VecDense32
+		var want VecDense
+		got.AddVec(a, b)
+		want.AddVec(a64, b64)
+		if !EqualApprox(dense64(&got), &want, 1e-6) {
+			t.Errorf("n=%d: unexpected result for AddVec", n)
+		}
+		got.SubVec(a, b)
+		want.SubVec(a64, b64)
+		if !EqualApprox(dense64(&got), &want, 1e-6) {
+			t.Errorf("n=%d: unexpected result for SubVec", n)
+		}
+		got.AddScaledVec(a, 0.5, b)
+		want.AddScaledVec(a64, 0.5, b64)
+		if !EqualApprox(dense64(&got), &want, 1e-6) {
+			t.Errorf("n=%d: unexpected result for AddScaledVec", n)
+		}
+		got.ScaleVec(-3, a)
+		want.ScaleVec(-3, a64)
+		if !EqualApprox(dense64(&got), &want, 1e-6) {
+			t.Errorf("n=%d: unexpected result for ScaleVec", n)
+		}
+		if d, d64 := Dot32(a, b), Dot(a64, b64); !scalar.EqualWithinAbsOrRel(float64(d), d64, 1e-5, 1e-5) {
+			t.Errorf("n=%d: unexpected result for Dot32: got %v, want %v", n, d, d64)
+		}
+	}
+}
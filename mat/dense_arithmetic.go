@@ -303,6 +303,23 @@ func (m *Dense) Mul(a, b Matrix) {
 		bT = blas.Trans
 	}
 
+	if isSparse(aU) {
+		if restore == nil {
+			m.checkOverlapMatrix(bU)
+		}
+		m.Zero()
+		m.mulSparseLeft(aU.(NonZeroDoer), aTrans, b)
+		return
+	}
+	if isSparse(bU) {
+		if restore == nil {
+			m.checkOverlapMatrix(aU)
+		}
+		m.Zero()
+		m.mulSparseRight(a, bU.(NonZeroDoer), bTrans)
+		return
+	}
+
 	// Some of the cases do not have a transpose option, so create
 	// temporary memory.
 	// C = Aᵀ * B = (Bᵀ * A)ᵀ
@@ -629,6 +646,224 @@ func (m *Dense) Exp(a Matrix) {
 	}
 }
 
+// Log calculates the principal logarithm of the matrix a, log(a), placing the
+// result in the receiver. The principal logarithm is the unique logarithm whose
+// eigenvalues have imaginary parts in the interval (-π, π). It exists when a
+// has no eigenvalues on the closed negative real axis.
+//
+// Log returns ErrSingular if a has a zero eigenvalue, ErrNegativeEigen if a
+// has a negative real eigenvalue and ErrFailedSchur if the Schur decomposition
+// of a could not be computed. If a linear system in the evaluation of the
+// logarithm is ill-conditioned, the Condition error from Solve is returned and
+// the receiver is not modified. Log will panic with ErrShape if a is not square.
+func (m *Dense) Log(a Matrix) error {
+	// The implementation used here is the inverse scaling and squaring method
+	// from Functions of Matrices: Theory and Computation Chapter 11, applied
+	// to the real Schur form of a. https://doi.org/10.1137/1.9780898717778.ch11
+	// The [7/7] Padé approximant of log(I+X) is evaluated as the 7-point
+	// Gauss–Legendre quadrature of log(I+X) = ∫_0^1 X(I+tX)⁻¹ dt.
+
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+
+	var schur Schur
+	if !schur.Factorize(a, true) {
+		return ErrFailedSchur
+	}
+	for _, v := range schur.values {
+		if imag(v) != 0 {
+			continue
+		}
+		switch {
+		case real(v) == 0:
+			return ErrSingular
+		case real(v) < 0:
+			return ErrNegativeEigen
+		}
+	}
+
+	const (
+		// theta7 is the bound on ‖X‖_1 for which the [7/7] Padé
+		// approximant of log(I+X) is accurate to double precision.
+		theta7 = 0.264
+
+		// maxSqrt is the maximum number of square roots taken.
+		maxSqrt = 100
+	)
+	// Gauss–Legendre nodes and weights on [-1, 1].
+	nodes := [...]float64{
+		-0.9491079123427585, -0.7415311855993945, -0.4058451513773972, 0,
+		0.4058451513773972, 0.7415311855993945, 0.9491079123427585,
+	}
+	weights := [...]float64{
+		0.1294849661688697, 0.2797053914892766, 0.3818300505051189, 0.4179591836734694,
+		0.3818300505051189, 0.2797053914892766, 0.1294849661688697,
+	}
+
+	// Take square roots of T until it is close to the identity.
+	t := schur.t
+	w := NewDense(r, r, nil)
+	var s int
+	for ; s < maxSqrt && normOneMinusIdentity(t) > theta7; s++ {
+		err := sqrtQuasiTri(w.mat, t.mat)
+		if err != nil {
+			return err
+		}
+		t, w = w, t
+	}
+
+	// Evaluate log(I+X) with X = T - I.
+	x := t
+	for i := 0; i < r; i++ {
+		x.set(i, i, x.at(i, i)-1)
+	}
+	l := w
+	l.Zero()
+	d := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(d)
+	y := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(y)
+	for k, node := range nodes {
+		d.Scale((node+1)/2, x)
+		for i := 0; i < r; i++ {
+			d.set(i, i, d.at(i, i)+1)
+		}
+		err := y.Solve(d, x)
+		if err != nil {
+			return err
+		}
+		y.Scale(weights[k]/2, y)
+		l.Add(l, y)
+	}
+	l.Scale(math.Ldexp(1, s), l)
+
+	y.Mul(schur.z, l)
+	m.Mul(y, schur.z.T())
+	return nil
+}
+
+// normOneMinusIdentity returns the 1-norm of a-I for the square matrix a.
+func normOneMinusIdentity(a *Dense) float64 {
+	n := a.mat.Rows
+	var norm float64
+	for j := 0; j < n; j++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			v := a.at(i, j)
+			if i == j {
+				v--
+			}
+			sum += math.Abs(v)
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
+
+// Sqrt calculates the principal square root of the matrix a, placing the
+// result in the receiver. The principal square root is the unique square root
+// whose eigenvalues have positive real parts. It exists when a has no
+// eigenvalues on the closed negative real axis, although a square root may be
+// computed when a has a single zero eigenvalue.
+//
+// Sqrt returns ErrNegativeEigen if a has a negative real eigenvalue,
+// ErrSingular if the square root could not be computed because a is singular
+// and ErrFailedSchur if the Schur decomposition of a could not be computed.
+// Sqrt will panic with ErrShape if a is not square.
+func (m *Dense) Sqrt(a Matrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+
+	var schur Schur
+	if !schur.Factorize(a, true) {
+		return ErrFailedSchur
+	}
+	for _, v := range schur.values {
+		if imag(v) == 0 && real(v) < 0 {
+			return ErrNegativeEigen
+		}
+	}
+
+	rt := NewDense(r, r, nil)
+	err := sqrtQuasiTri(rt.mat, schur.t.mat)
+	if err != nil {
+		return err
+	}
+	w := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(w)
+	w.Mul(schur.z, rt)
+	m.Mul(w, schur.z.T())
+	return nil
+}
+
+// Func calculates f(a) for the square matrix a and the analytic function f
+// using the Schur–Parlett algorithm, placing the result in the receiver.
+// f(z, k) must return the k-th derivative of f at z, with k == 0 returning
+// the value of f. The derivatives are only evaluated for clusters of close
+// eigenvalues of a. For example, the matrix sine is computed by
+//  m.Func(a, func(z complex128, k int) complex128 {
+//  	switch k % 4 {
+//  	case 0:
+//  		return cmplx.Sin(z)
+//  	case 1:
+//  		return cmplx.Cos(z)
+//  	case 2:
+//  		return -cmplx.Sin(z)
+//  	default:
+//  		return -cmplx.Cos(z)
+//  	}
+//  })
+// f must satisfy f(conj(z)) = conj(f(z)) so that f(a) is real. The imaginary
+// part of the computed result is discarded.
+//
+// Func returns ErrFailedSchur if the Schur decomposition of a could not be
+// computed and ErrFailedFunc if the evaluation of f on a cluster of
+// eigenvalues did not converge. Func will panic with ErrShape if a is not
+// square.
+func (m *Dense) Func(a Matrix, f func(z complex128, k int) complex128) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+
+	var schur Schur
+	if !schur.Factorize(a, true) {
+		return ErrFailedSchur
+	}
+
+	n := r
+	t := make([]complex128, n*n)
+	u := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			t[i*n+j] = complex(schur.t.at(i, j), 0)
+			u[i*n+j] = complex(schur.z.at(i, j), 0)
+		}
+	}
+	realToComplexSchur(n, t, u)
+	if !schurParlett(n, t, u, f) {
+		return ErrFailedFunc
+	}
+
+	uc := NewCDense(n, n, u)
+	w := NewCDense(n, n, nil)
+	w.Mul(uc, NewCDense(n, n, t))
+	fc := NewCDense(n, n, nil)
+	fc.Mul(w, uc.H())
+
+	m.reuseAsNonZeroed(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.set(i, j, real(fc.at(i, j)))
+		}
+	}
+	return nil
+}
+
 // Pow calculates the integral power of the matrix a to n, placing the result
 // in the receiver. Pow will panic if n is negative or if a is not square.
 func (m *Dense) Pow(a Matrix, n int) {
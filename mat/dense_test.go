@@ -7,6 +7,7 @@ package mat
 import (
 	"fmt"
 	"math"
+	"math/cmplx"
 	"reflect"
 	"strings"
 	"testing"
@@ -1006,6 +1007,296 @@ func TestDenseExp(t *testing.T) {
 	}
 }
 
+func TestDenseLog(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		a    [][]float64
+		want [][]float64
+		err  error
+	}{
+		{
+			a:    [][]float64{{math.E}},
+			want: [][]float64{{1}},
+		},
+		{
+			a:    [][]float64{{1, 1}, {0, 1}},
+			want: [][]float64{{0, 1}, {0, 0}},
+		},
+		{
+			// Rotation by one radian.
+			a:    [][]float64{{math.Cos(1), -math.Sin(1)}, {math.Sin(1), math.Cos(1)}},
+			want: [][]float64{{0, -1}, {1, 0}},
+		},
+		{
+			a:    [][]float64{{4, 0, 0}, {0, 1, 0}, {0, 0, math.Exp(-3)}},
+			want: [][]float64{{math.Log(4), 0, 0}, {0, 0, 0}, {0, 0, -3}},
+		},
+		{
+			a:   [][]float64{{1, 2}, {0, 0}},
+			err: ErrSingular,
+		},
+		{
+			a:   [][]float64{{1, 0}, {0, -1}},
+			err: ErrNegativeEigen,
+		},
+	} {
+		var got Dense
+		err := got.Log(NewDense(flatten(test.a)))
+		if err != test.err {
+			t.Errorf("unexpected error for Log test %d: got %v, want %v", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		want := NewDense(flatten(test.want))
+		if !EqualApprox(&got, want, 1e-14) {
+			t.Errorf("unexpected result for Log test %d\ngot:\n%v\nwant:\n%v",
+				i, Formatted(&got), Formatted(want))
+		}
+	}
+
+	// The off-diagonal element is still too large after the maximum number
+	// of square roots, so the Padé approximant can not be evaluated.
+	var got Dense
+	err := got.Log(NewDense(2, 2, []float64{1, 1e300, 0, 1}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for ill-conditioned Log: got %v, want Condition", err)
+	}
+	if !got.IsEmpty() {
+		t.Errorf("receiver modified by failed Log")
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 20, 50} {
+		for _, scale := range []float64{0.1, 1} {
+			// Choose a such that the eigenvalues of a have imaginary parts
+			// in (-π, π) so that log(exp(a)) == a.
+			a := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, scale*rnd.NormFloat64()/math.Sqrt(float64(n)))
+				}
+			}
+			var e, got Dense
+			e.Exp(a)
+			err := got.Log(&e)
+			if err != nil {
+				t.Errorf("unexpected error for n=%d,scale=%v: %v", n, scale, err)
+				continue
+			}
+			if !EqualApprox(&got, a, 1e-12) {
+				t.Errorf("unexpected result of log(exp(a)) for n=%d,scale=%v", n, scale)
+			}
+		}
+	}
+}
+
+func TestDenseSqrt(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		a    [][]float64
+		want [][]float64
+		err  error
+	}{
+		{
+			a:    [][]float64{{4}},
+			want: [][]float64{{2}},
+		},
+		{
+			a:    [][]float64{{4, 0}, {0, 9}},
+			want: [][]float64{{2, 0}, {0, 3}},
+		},
+		{
+			a:    [][]float64{{1, 2}, {0, 1}},
+			want: [][]float64{{1, 1}, {0, 1}},
+		},
+		{
+			// Rotation by π/2.
+			a:    [][]float64{{0, -1}, {1, 0}},
+			want: [][]float64{{math.Sqrt2 / 2, -math.Sqrt2 / 2}, {math.Sqrt2 / 2, math.Sqrt2 / 2}},
+		},
+		{
+			a:    [][]float64{{0, 0}, {0, 4}},
+			want: [][]float64{{0, 0}, {0, 2}},
+		},
+		{
+			a:   [][]float64{{-4, 1}, {0, 1}},
+			err: ErrNegativeEigen,
+		},
+	} {
+		var got Dense
+		err := got.Sqrt(NewDense(flatten(test.a)))
+		if err != test.err {
+			t.Errorf("unexpected error for Sqrt test %d: got %v, want %v", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		want := NewDense(flatten(test.want))
+		if !EqualApprox(&got, want, 1e-14) {
+			t.Errorf("unexpected result for Sqrt test %d\ngot:\n%v\nwant:\n%v",
+				i, Formatted(&got), Formatted(want))
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 20, 50} {
+		for _, shift := range []float64{0, 1, 10} {
+			a := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+				}
+				a.Set(i, i, a.At(i, i)+shift*math.Sqrt(float64(n)))
+			}
+			var got, sq Dense
+			err := got.Sqrt(a)
+			if err == ErrNegativeEigen {
+				continue
+			}
+			if err != nil {
+				t.Errorf("unexpected error for n=%d,shift=%v: %v", n, shift, err)
+				continue
+			}
+			sq.Mul(&got, &got)
+			if !EqualApprox(&sq, a, 1e-12*math.Max(1, Norm(a, 1))) {
+				t.Errorf("unexpected result of sqrt(a)^2 for n=%d,shift=%v", n, shift)
+			}
+			var eig Eigen
+			eig.Factorize(&got, EigenNone)
+			for _, v := range eig.Values(nil) {
+				if real(v) < 0 {
+					t.Errorf("square root not principal for n=%d,shift=%v: eigenvalue %v", n, shift, v)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestDenseFunc(t *testing.T) {
+	t.Parallel()
+	expFn := func(z complex128, _ int) complex128 { return cmplx.Exp(z) }
+	sinFn := func(z complex128, k int) complex128 {
+		switch k % 4 {
+		case 0:
+			return cmplx.Sin(z)
+		case 1:
+			return cmplx.Cos(z)
+		case 2:
+			return -cmplx.Sin(z)
+		default:
+			return -cmplx.Cos(z)
+		}
+	}
+	cosFn := func(z complex128, k int) complex128 { return sinFn(z, k+1) }
+	logFn := func(z complex128, k int) complex128 {
+		if k == 0 {
+			return cmplx.Log(z)
+		}
+		// d^k/dz^k log z = (-1)^(k-1) (k-1)! z^-k
+		v := 1 / z
+		for j := 1; j < k; j++ {
+			v *= complex(-float64(j), 0) / z
+		}
+		return v
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    *Dense
+	}{
+		{name: "1×1", a: NewDense(1, 1, []float64{0.5})},
+		{
+			// A Jordan block needs derivatives of all orders.
+			name: "Jordan",
+			a:    NewDense(3, 3, []float64{2, 1, 0, 0, 2, 1, 0, 0, 2}),
+		},
+		{
+			// Clustered eigenvalues separated by an eigenvalue
+			// from another cluster require reordering.
+			name: "clustered",
+			a: func() *Dense {
+				tm := NewDense(5, 5, []float64{
+					1, 0.5, 0.3, -0.2, 0.7,
+					0, 3, 0.1, 0.4, -0.6,
+					0, 0, 1.05, 0.2, 0.3,
+					0, 0, 0, 3.02, 0.9,
+					0, 0, 0, 0, 0.98,
+				})
+				var qr QR
+				qr.Factorize(randDenseN(5, rnd))
+				var q, a Dense
+				qr.QTo(&q)
+				a.Product(&q, tm, q.T())
+				return &a
+			}(),
+		},
+		{name: "random 10", a: randDenseN(10, rnd)},
+		{name: "random 40", a: randDenseN(40, rnd)},
+	} {
+		// exp(a) must agree with Exp.
+		var got, want Dense
+		err := got.Func(test.a, expFn)
+		if err != nil {
+			t.Errorf("%v: unexpected error for exp: %v", test.name, err)
+			continue
+		}
+		want.Exp(test.a)
+		if !EqualApprox(&got, &want, 1e-12*math.Max(1, Norm(&want, 1))) {
+			t.Errorf("%v: unexpected result for exp\ngot:\n%v\nwant:\n%v",
+				test.name, Formatted(&got), Formatted(&want))
+		}
+
+		// sin(a)^2 + cos(a)^2 must be the identity.
+		var s, c, s2, c2 Dense
+		if err := s.Func(test.a, sinFn); err != nil {
+			t.Errorf("%v: unexpected error for sin: %v", test.name, err)
+			continue
+		}
+		if err := c.Func(test.a, cosFn); err != nil {
+			t.Errorf("%v: unexpected error for cos: %v", test.name, err)
+			continue
+		}
+		s2.Mul(&s, &s)
+		c2.Mul(&c, &c)
+		s2.Add(&s2, &c2)
+		n, _ := test.a.Dims()
+		if !EqualApprox(&s2, eye(n), 1e-11) {
+			t.Errorf("%v: sin(a)^2 + cos(a)^2 is not the identity", test.name)
+		}
+
+		// log(a) must agree with Log when the principal logarithm exists.
+		var l Dense
+		if l.Log(test.a) != nil {
+			continue
+		}
+		if err := got.Func(test.a, logFn); err != nil {
+			t.Errorf("%v: unexpected error for log: %v", test.name, err)
+			continue
+		}
+		if !EqualApprox(&got, &l, 1e-10*math.Max(1, Norm(&l, 1))) {
+			t.Errorf("%v: unexpected result for log\ngot:\n%v\nwant:\n%v",
+				test.name, Formatted(&got), Formatted(&l))
+		}
+	}
+}
+
+// randDenseN returns an n×n matrix with elements drawn from a standard normal
+// distribution scaled by 1/sqrt(n).
+func randDenseN(n int, rnd *rand.Rand) *Dense {
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64()/math.Sqrt(float64(n)))
+		}
+	}
+	return a
+}
+
 func TestDensePow(t *testing.T) {
 	t.Parallel()
 	for i, test := range []struct {
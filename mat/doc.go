@@ -13,9 +13,11 @@
 // mat provides:
 //  - Interfaces for Matrix classes (Matrix, Symmetric, Triangular)
 //  - Concrete implementations (Dense, SymDense, TriDense, VecDense)
+//  - Sparse matrix implementations (CSR, CSC, COO)
 //  - Methods and functions for using matrix data (Add, Trace, SymRankOne)
 //  - Types for constructing and using matrix factorizations (QR, LU, etc.)
 //  - The complementary types for complex matrices, CMatrix, CSymDense, etc.
+//  - Single precision types, Matrix32, Dense32, SymDense32 and VecDense32
 // In the documentation below, we use "matrix" as a short-hand for all of
 // the FooDense types implemented in this package. We use "Matrix" to
 // refer to the Matrix interface.
@@ -103,11 +105,43 @@
 // without needing to update the original matrix and refactorize, for example with
 // *LU.RankOne.
 //
+// Symmetric matrices that are not positive definite can be factorized with
+// BunchKaufman, which also reports the inertia of the matrix. When only some of
+// the eigenvalues of a symmetric matrix are needed, for example the k smallest,
+// they can be computed with *EigenSym.FactorizeIndex or
+// *EigenSym.FactorizeInterval at a lower cost than the full decomposition.
+// Similarly, a rank-k approximation of a large matrix can be computed with
+// *SVD.FactorizeLanczos or *SVD.FactorizeRandomized, and the full decomposition
+// of a large matrix can be sped up by including SVDDivideConquer in the SVDKind.
+//
+// The rank of a matrix can be revealed by the column-pivoted QR factorization
+// QRP, which also computes minimum-norm solutions to rank-deficient least
+// squares problems without the cost of a full SVD.
+//
+// The generalized eigenvalue problem A*x = λ*B*x is solved by GenEigen for a
+// general matrix pair, returning the eigenvalues as (α, β) pairs so that
+// infinite eigenvalues of a singular B are representable, and by GenEigenSym
+// when A is symmetric and B is symmetric positive definite.
+//
+// The real Schur decomposition of a general square matrix is computed by Schur,
+// whose eigenvalues can be reordered with *Schur.Reorder. It underlies the
+// matrix functions *Dense.Log, *Dense.Sqrt and *Dense.Func, the last of which
+// computes f(A) for a general analytic function f.
+//
+// Complex matrices have the analogous factorization types CLU, CQR, CCholesky,
+// EigenHerm and CSVD, which accept a CMatrix and return their factors as *CDense.
+//
+// Single precision matrices implement the Matrix32 interface and are backed by
+// blas32 and lapack32. They provide the basic arithmetic operations and the
+// Cholesky32 and LU32 factorizations, and halve the memory traffic compared to
+// their float64 counterparts at the cost of accuracy.
+//
 // BLAS and LAPACK
 //
 // BLAS and LAPACK are the standard APIs for linear algebra routines. Many
 // operations in mat are implemented using calls to the wrapper functions
-// in gonum/blas/blas64 and gonum/lapack/lapack64 and their complex equivalents.
+// in gonum/blas/blas64 and gonum/lapack/lapack64 and their complex and single
+// precision equivalents.
 // By default, blas64 and lapack64 call the native Go implementations of the
 // routines. Alternatively, it is possible to use C-based implementations of the
 // APIs through the respective cgo packages and the wrapper packages' "Use"
@@ -5,13 +5,15 @@
 package mat
 
 import (
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
 const (
-	badFact   = "mat: use without successful factorization"
-	noVectors = "mat: eigenvectors not computed"
+	badFact     = "mat: use without successful factorization"
+	noVectors   = "mat: eigenvectors not computed"
+	badInterval = "mat: invalid eigenvalue interval"
 )
 
 // EigenSym is a type for creating and manipulating the Eigen decomposition of
@@ -65,15 +67,109 @@ func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
 	return true
 }
 
+// FactorizeInterval computes the eigenvalues of the symmetric matrix a that lie
+// in the half-open interval (vl,vu] and, if vectors is true, the corresponding
+// eigenvectors. The eigenvalues are computed in ascending order. Computing only
+// a subset of the eigenvalues can be substantially cheaper than computing the
+// full decomposition with Factorize.
+//
+// FactorizeInterval panics if vl >= vu. It returns whether the decomposition
+// succeeded. If the decomposition failed, methods that require a successful
+// factorization will panic. The decomposition may succeed and find no
+// eigenvalues.
+func (e *EigenSym) FactorizeInterval(a Symmetric, vl, vu float64, vectors bool) (ok bool) {
+	if !(vl < vu) {
+		panic(badInterval)
+	}
+	return e.factorizeSubset(a, lapack.EVRangeValue, vl, vu, 0, 0, vectors)
+}
+
+// FactorizeIndex computes the eigenvalues of the symmetric matrix a with
+// indices lo through hi-1, counted from zero in ascending order, and, if
+// vectors is true, the corresponding eigenvectors. For example,
+//  FactorizeIndex(a, 0, k, true)
+// computes the k smallest eigenvalues of a and their eigenvectors. Computing
+// only a subset of the eigenvalues can be substantially cheaper than computing
+// the full decomposition with Factorize.
+//
+// FactorizeIndex panics if the indices do not satisfy 0 <= lo < hi <= n, where
+// n is the order of a. It returns whether the decomposition succeeded. If the
+// decomposition failed, methods that require a successful factorization will
+// panic.
+func (e *EigenSym) FactorizeIndex(a Symmetric, lo, hi int, vectors bool) (ok bool) {
+	n := a.Symmetric()
+	if lo < 0 || hi <= lo || n < hi {
+		panic(ErrIndexOutOfRange)
+	}
+	return e.factorizeSubset(a, lapack.EVRangeIndex, 0, 0, lo, hi-1, vectors)
+}
+
+// factorizeSubset computes the eigenvalues of a selected by rng, and optionally
+// the corresponding eigenvectors, using Dsyevr.
+func (e *EigenSym) factorizeSubset(a Symmetric, rng lapack.EVRange, vl, vu float64, il, iu int, vectors bool) (ok bool) {
+	// kill previous decomposition
+	e.vectorsComputed = false
+	e.values = nil
+	e.vectors = nil
+
+	n := a.Symmetric()
+	sd := NewSymDense(n, nil)
+	sd.CopySym(a)
+
+	jobz := lapack.EVNone
+	mmax := n
+	if rng == lapack.EVRangeIndex {
+		mmax = iu - il + 1
+	}
+	z := blas64.General{Stride: 1}
+	if vectors {
+		jobz = lapack.EVCompute
+		z = blas64.General{
+			Rows:   n,
+			Cols:   mmax,
+			Stride: mmax,
+			Data:   make([]float64, n*mmax),
+		}
+	}
+	w := make([]float64, n)
+	work := []float64{0}
+	iwork := []int{0}
+	lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, 0, w, z, work, -1, iwork, -1)
+
+	work = getFloat64s(int(work[0]), false)
+	iwork = getInts(iwork[0], false)
+	m, ok := lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, 0, w, z, work, len(work), iwork, len(iwork))
+	putFloat64s(work)
+	putInts(iwork)
+	if !ok {
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.values = w[:m]
+	if vectors && m > 0 {
+		if m < mmax {
+			// Compact the computed eigenvectors.
+			for i := 1; i < n; i++ {
+				copy(z.Data[i*m:i*m+m], z.Data[i*mmax:i*mmax+m])
+			}
+		}
+		e.vectors = NewDense(n, m, z.Data[:n*m])
+	}
+	return true
+}
+
 // succFact returns whether the receiver contains a successful factorization.
 func (e *EigenSym) succFact() bool {
-	return len(e.values) != 0
+	return e.values != nil
 }
 
 // Values extracts the eigenvalues of the factorized matrix in ascending order.
 // If dst is non-nil, the values are stored in-place into dst. In this case dst
-// must have length n, otherwise Values will panic. If dst is nil, then a new
-// slice will be allocated of the proper length and filled with the eigenvalues.
+// must have length equal to the number of computed eigenvalues, otherwise
+// Values will panic. If dst is nil, then a new slice will be allocated of the
+// proper length and filled with the eigenvalues. The number of computed
+// eigenvalues is n unless the decomposition was computed by FactorizeInterval
+// or FactorizeIndex.
 //
 // Values panics if the Eigen decomposition was not successful.
 func (e *EigenSym) Values(dst []float64) []float64 {
@@ -91,12 +187,15 @@ func (e *EigenSym) Values(dst []float64) []float64 {
 }
 
 // VectorsTo stores the eigenvectors of the decomposition into the columns of
-// dst.
+// dst. The i-th column of dst is the eigenvector corresponding to the i-th
+// value returned by Values.
 //
-// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
-// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
-// panic if the eigenvectors were not computed during the factorization,
-// or if the receiver does not contain a successful factorization.
+// If dst is empty, VectorsTo will resize dst to be n×m, where m is the number
+// of computed eigenvalues. When dst is non-empty, VectorsTo will panic if dst
+// is not n×m. VectorsTo will also panic if the eigenvectors were not computed
+// during the factorization, or if the receiver does not contain a successful
+// factorization. If no eigenvalues were computed, VectorsTo does not modify
+// an empty dst.
 func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.succFact() {
 		panic(badFact)
@@ -104,6 +203,12 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.vectorsComputed {
 		panic(noVectors)
 	}
+	if e.vectors == nil {
+		if !dst.IsEmpty() {
+			panic(ErrShape)
+		}
+		return
+	}
 	r, c := e.vectors.Dims()
 	if dst.IsEmpty() {
 		dst.ReuseAs(r, c)
@@ -223,14 +328,14 @@ func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
 	var cvl, cvr CDense
 	if left {
 		cvl = *NewCDense(r, r, nil)
-		e.complexEigenTo(&cvl, &vl)
+		complexEigenTo(&cvl, &vl, e.values)
 		e.lVectors = &cvl
 	} else {
 		e.lVectors = nil
 	}
 	if right {
 		cvr = *NewCDense(c, c, nil)
-		e.complexEigenTo(&cvr, &vr)
+		complexEigenTo(&cvr, &vr, e.values)
 		e.rVectors = &cvr
 	} else {
 		e.rVectors = nil
@@ -269,7 +374,8 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 }
 
 // complexEigenTo extracts the complex eigenvectors from the real matrix d
-// and stores them into the complex matrix dst.
+// and stores them into the complex matrix dst, using the imaginary parts of
+// the corresponding eigenvalues in values to identify the conjugate pairs.
 //
 // The columns of the returned n×n dense matrix contain the eigenvectors of the
 // decomposition in the same order as the eigenvalues.
@@ -280,7 +386,7 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 //  dst[:,j]   = d[:,j] + i*d[:,j+1],
 //  dst[:,j+1] = d[:,j] - i*d[:,j+1],
 // where i is the imaginary unit.
-func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
+func complexEigenTo(dst *CDense, d *Dense, values []complex128) {
 	r, c := d.Dims()
 	cr, cc := dst.Dims()
 	if r != cr {
@@ -290,7 +396,7 @@ func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
 		panic("size mismatch")
 	}
 	for j := 0; j < c; j++ {
-		if imag(e.values[j]) == 0 {
+		if imag(values[j]) == 0 {
 			for i := 0; i < r; i++ {
 				dst.set(i, j, complex(d.at(i, j), 0))
 			}
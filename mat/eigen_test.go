@@ -210,3 +210,135 @@ func TestSymEigen(t *testing.T) {
 		}
 	}
 }
+
+func TestSymEigenSubset(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30, 70} {
+		for cas := 0; cas < 10; cas++ {
+			a := NewSymDense(n, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					a.SetSym(i, j, rnd.NormFloat64())
+				}
+			}
+			var full EigenSym
+			ok := full.Factorize(a, false)
+			if !ok {
+				t.Fatalf("bad test: full factorization failed")
+			}
+			all := full.Values(nil)
+
+			lo := rnd.Intn(n)
+			hi := lo + 1 + rnd.Intn(n-lo)
+			var es EigenSym
+			ok = es.FactorizeIndex(a, lo, hi, true)
+			if !ok {
+				t.Errorf("n=%d: index factorization failed", n)
+				continue
+			}
+			checkSymEigenSubset(t, a, &es, all[lo:hi], tol)
+
+			// Select the same eigenvalues by an interval with end points
+			// half way between neighbouring eigenvalues.
+			vl := all[0] - 1
+			if lo > 0 {
+				vl = (all[lo-1] + all[lo]) / 2
+			}
+			vu := all[n-1] + 1
+			if hi < n {
+				vu = (all[hi-1] + all[hi]) / 2
+			}
+			var esi EigenSym
+			ok = esi.FactorizeInterval(a, vl, vu, true)
+			if !ok {
+				t.Errorf("n=%d: interval factorization failed", n)
+				continue
+			}
+			checkSymEigenSubset(t, a, &esi, all[lo:hi], tol)
+
+			var esv EigenSym
+			ok = esv.FactorizeIndex(a, lo, hi, false)
+			if !ok {
+				t.Errorf("n=%d: index factorization without vectors failed", n)
+				continue
+			}
+			if !floats.EqualApprox(esv.Values(nil), all[lo:hi], tol) {
+				t.Errorf("n=%d: eigenvalue mismatch when no vectors computed", n)
+			}
+			if panicked, _ := panics(func() { esv.VectorsTo(&Dense{}) }); !panicked {
+				t.Errorf("n=%d: expected panic when no vectors computed", n)
+			}
+		}
+	}
+
+	// An interval containing no eigenvalues is a successful factorization.
+	a := NewSymDense(3, []float64{1, 0, 0, 0, 2, 0, 0, 0, 3})
+	var es EigenSym
+	ok := es.FactorizeInterval(a, 3.5, 4, true)
+	if !ok {
+		t.Errorf("empty interval factorization failed")
+	}
+	if got := es.Values(nil); len(got) != 0 {
+		t.Errorf("unexpected eigenvalues in empty interval: %v", got)
+	}
+	var v Dense
+	es.VectorsTo(&v)
+	if !v.IsEmpty() {
+		t.Errorf("unexpected eigenvectors for empty interval")
+	}
+
+	for _, test := range []struct {
+		lo, hi int
+	}{
+		{lo: -1, hi: 2},
+		{lo: 1, hi: 1},
+		{lo: 2, hi: 1},
+		{lo: 0, hi: 4},
+	} {
+		if panicked, _ := panics(func() { es.FactorizeIndex(a, test.lo, test.hi, false) }); !panicked {
+			t.Errorf("expected panic for lo=%d, hi=%d", test.lo, test.hi)
+		}
+	}
+	if panicked, _ := panics(func() { es.FactorizeInterval(a, 2, 2, false) }); !panicked {
+		t.Errorf("expected panic for empty interval")
+	}
+}
+
+// checkSymEigenSubset checks that es holds the eigenvalues want of a and
+// orthonormal eigenvectors corresponding to them.
+func checkSymEigenSubset(t *testing.T, a *SymDense, es *EigenSym, want []float64, tol float64) {
+	t.Helper()
+	n := a.Symmetric()
+	m := len(want)
+	values := es.Values(nil)
+	if !floats.EqualApprox(values, want, tol) {
+		t.Errorf("n=%d: eigenvalue mismatch, got %v, want %v", n, values, want)
+		return
+	}
+	var v Dense
+	es.VectorsTo(&v)
+	if r, c := v.Dims(); r != n || c != m {
+		t.Errorf("n=%d: unexpected eigenvector dimensions %d×%d, want %d×%d", n, r, c, n, m)
+		return
+	}
+
+	// Check that the eigenvectors are orthonormal.
+	var vtv Dense
+	vtv.Mul(v.T(), &v)
+	for i := 0; i < m; i++ {
+		vtv.Set(i, i, vtv.At(i, i)-1)
+	}
+	if norm := Norm(&vtv, 1); norm > tol {
+		t.Errorf("n=%d: eigenvectors not orthonormal, |VᵀV-I|=%v", n, norm)
+	}
+
+	// Check that A*V = V*D.
+	var av, vd Dense
+	av.Mul(a, &v)
+	vd.Mul(&v, NewDiagDense(m, values))
+	if !EqualApprox(&av, &vd, tol) {
+		t.Errorf("n=%d: eigenvectors do not satisfy A*V = V*D", n)
+	}
+}
@@ -137,6 +137,10 @@ var (
 	ErrSliceLengthMismatch = Error{"mat: input slice length mismatch"}
 	ErrNotPSD              = Error{"mat: input not positive symmetric definite"}
 	ErrFailedEigen         = Error{"mat: eigendecomposition not successful"}
+	ErrFailedSchur         = Error{"mat: Schur decomposition not successful"}
+	ErrNegativeEigen       = Error{"mat: matrix has negative real eigenvalue"}
+	ErrFailedFunc          = Error{"mat: matrix function evaluation did not converge"}
+	ErrSparseIndex         = Error{"mat: malformed sparse index"}
 )
 
 // ErrorStack represents matrix handling errors that have been recovered by Maybe wrappers.
@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCholesky32(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 40} {
+		name := fmt.Sprintf("n=%d", n)
+
+		// Construct a well-conditioned symmetric positive definite matrix.
+		x := randDense32(n, n, rnd)
+		var a SymDense32
+		a.SymOuterK(1, x)
+		for i := 0; i < n; i++ {
+			a.SetSym(i, i, a.At(i, i)+float32(n))
+		}
+
+		var chol Cholesky32
+		if ok := chol.Factorize(&a); !ok {
+			t.Errorf("%s: unexpected factorization failure", name)
+			continue
+		}
+
+		var u, l, got Dense32
+		chol.UTo(&u)
+		chol.LTo(&l)
+		if !Equal32(&l, u.T()) {
+			t.Errorf("%s: L is not the transpose of U", name)
+		}
+		got.Mul(u.T(), &u)
+		if !EqualApprox32(&got, &a, 1e-5) {
+			t.Errorf("%s: Uᵀ * U does not match A", name)
+		}
+
+		var chol64 Cholesky
+		a64 := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a64.SetSym(i, j, float64(a.At(i, j)))
+			}
+		}
+		chol64.Factorize(a64)
+		if det, want := chol.LogDet(), chol64.LogDet(); math.Abs(det-want) > 1e-4*math.Abs(want)+1e-4 {
+			t.Errorf("%s: unexpected log determinant: got %v, want %v", name, det, want)
+		}
+
+		b := randDense32(n, 3, rnd)
+		var xm, ax Dense32
+		if err := chol.SolveTo(&xm, b); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		ax.Mul(&a, &xm)
+		if !EqualApprox32(&ax, b, 1e-4) {
+			t.Errorf("%s: unexpected solution", name)
+		}
+
+		bv := NewVecDense32(n, nil)
+		for i := 0; i < n; i++ {
+			bv.SetVec(i, b.At(i, 0))
+		}
+		var xv VecDense32
+		if err := chol.SolveVecTo(&xv, bv); err != nil {
+			t.Errorf("%s: unexpected error for vector solve: %v", name, err)
+		}
+		for i := 0; i < n; i++ {
+			if math.Abs(float64(xv.AtVec(i)-xm.At(i, 0))) > 1e-5 {
+				t.Errorf("%s: vector solve mismatch", name)
+				break
+			}
+		}
+
+		// Check that the solve may be performed in place.
+		if err := chol.SolveVecTo(bv, bv); err != nil {
+			t.Errorf("%s: unexpected error for in-place vector solve: %v", name, err)
+		}
+		if !Equal32(bv, &xv) {
+			t.Errorf("%s: in-place vector solve mismatch", name)
+		}
+	}
+
+	// A symmetric indefinite matrix has no Cholesky factorization.
+	var chol Cholesky32
+	if ok := chol.Factorize(NewSymDense32(2, []float32{1, 2, 2, 1})); ok {
+		t.Errorf("unexpected factorization success for indefinite matrix")
+	}
+	if panicked, _ := panics(func() { chol.LogDet() }); !panicked {
+		t.Errorf("expected panic after failed factorization")
+	}
+}
+
+func TestLU32(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 40} {
+		name := fmt.Sprintf("n=%d", n)
+
+		a := randDense32(n, n, rnd)
+		for i := 0; i < n; i++ {
+			a.Set(i, i, a.At(i, i)+float32(n))
+		}
+		var lu LU32
+		lu.Factorize(a)
+
+		var l, u, got Dense32
+		lu.LTo(&l)
+		lu.UTo(&u)
+		got.Mul(&l, &u)
+		// Row i of A is row swaps[i] of L * U, that is A = P * L * U.
+		swaps := lu.Pivot(nil)
+		pa := NewDense32(n, n, nil)
+		for i, v := range swaps {
+			for j := 0; j < n; j++ {
+				pa.Set(v, j, a.At(i, j))
+			}
+		}
+		if !EqualApprox32(&got, pa, 1e-5) {
+			t.Errorf("%s: P * L * U does not match A", name)
+		}
+
+		var lu64 LU
+		lu64.Factorize(dense64(a))
+		if det, want := lu.Det(), lu64.Det(); math.Abs(det-want) > 1e-4*math.Abs(want) {
+			t.Errorf("%s: unexpected determinant: got %v, want %v", name, det, want)
+		}
+
+		for _, trans := range []bool{false, true} {
+			b := randDense32(n, 2, rnd)
+			var x, ax Dense32
+			if err := lu.SolveTo(&x, trans, b); err != nil {
+				t.Errorf("%s: unexpected error for trans=%t: %v", name, trans, err)
+				continue
+			}
+			if trans {
+				ax.Mul(a.T(), &x)
+			} else {
+				ax.Mul(a, &x)
+			}
+			if !EqualApprox32(&ax, b, 1e-4) {
+				t.Errorf("%s: unexpected solution for trans=%t", name, trans)
+			}
+
+			bv := NewVecDense32(n, nil)
+			for i := 0; i < n; i++ {
+				bv.SetVec(i, b.At(i, 1))
+			}
+			var xv VecDense32
+			if err := lu.SolveVecTo(&xv, trans, bv); err != nil {
+				t.Errorf("%s: unexpected error for vector solve trans=%t: %v", name, trans, err)
+			}
+			for i := 0; i < n; i++ {
+				if math.Abs(float64(xv.AtVec(i)-x.At(i, 1))) > 1e-5 {
+					t.Errorf("%s: vector solve mismatch for trans=%t", name, trans)
+					break
+				}
+			}
+		}
+	}
+
+	// A singular matrix must return a Condition error.
+	var lu LU32
+	lu.Factorize(NewDense32(2, 2, []float32{1, 2, 2, 4}))
+	var x Dense32
+	err := lu.SolveTo(&x, false, NewDense32(2, 1, []float32{1, 1}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got %v", err)
+	}
+}
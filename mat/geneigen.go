@@ -0,0 +1,389 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// GenEigenSym is a type for creating and manipulating the generalized Eigen
+// decomposition of a symmetric-definite matrix pair.
+type GenEigenSym struct {
+	vectorsComputed bool
+
+	values  []float64
+	vectors *Dense
+}
+
+// Factorize computes the generalized eigenvalue decomposition of the
+// symmetric matrix a with respect to the symmetric positive definite matrix b.
+// A generalized eigenvalue/eigenvector combination is defined by
+//  A * x = λ * B * x
+// where all the eigenvalues λ are real. Factorize computes the eigenvalues in
+// ascending order. If the vectors input argument is false, the eigenvectors
+// are not computed.
+//
+// Factorize returns whether the decomposition succeeded. The decomposition
+// fails if b is not positive definite. If the decomposition failed, methods
+// that require a successful factorization will panic. Factorize will panic if
+// a and b do not have the same size.
+func (e *GenEigenSym) Factorize(a, b Symmetric, vectors bool) (ok bool) {
+	// kill previous decomposition
+	e.vectorsComputed = false
+	e.values = nil
+
+	n := a.Symmetric()
+	if b.Symmetric() != n {
+		panic(ErrShape)
+	}
+	sa := NewSymDense(n, nil)
+	sa.CopySym(a)
+	sb := NewSymDense(n, nil)
+	sb.CopySym(b)
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	w := make([]float64, n)
+	work := []float64{0}
+	lapack64.Sygv(lapack.GenEVAxBx, jobz, sa.mat, sb.mat, w, work, -1)
+
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Sygv(lapack.GenEVAxBx, jobz, sa.mat, sb.mat, w, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		e.vectors = nil
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.values = w
+	e.vectors = NewDense(n, n, sa.mat.Data)
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *GenEigenSym) succFact() bool {
+	return e.values != nil
+}
+
+// Values extracts the generalized eigenvalues of the factorized matrix pair
+// in ascending order. If dst is non-nil, the values are stored in-place into
+// dst. In this case dst must have length n, otherwise Values will panic. If
+// dst is nil, then a new slice will be allocated of the proper length and
+// filled with the eigenvalues.
+//
+// Values panics if the decomposition was not successful.
+func (e *GenEigenSym) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the generalized eigenvectors of the decomposition into the
+// columns of dst. The i-th column of dst is the eigenvector corresponding to
+// the i-th value returned by Values. The eigenvectors X are normalized so that
+//  Xᵀ * B * X = I.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *GenEigenSym) VectorsTo(dst *Dense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	n := len(e.values)
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
+
+// GenEigen is a type for creating and using the generalized eigenvalue
+// decomposition of a pair of dense matrices.
+type GenEigen struct {
+	n int // The size of the factorized matrices.
+
+	kind EigenKind
+
+	alpha    []complex128
+	beta     []float64
+	rVectors *CDense
+	lVectors *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *GenEigen) succFact() bool {
+	return e.n != 0
+}
+
+// Factorize computes the generalized eigenvalues of the pair of square
+// matrices (a, b), and optionally the generalized eigenvectors, using the QZ
+// algorithm.
+//
+// A right generalized eigenvalue/eigenvector combination is defined by
+//  A * x_r = λ * B * x_r
+// where x_r is the column vector called an eigenvector, and λ is the
+// corresponding eigenvalue.
+//
+// Similarly, a left generalized eigenvalue/eigenvector combination is defined
+// by
+//  x_lᴴ * A = λ * x_lᴴ * B
+// The eigenvalues, but not the eigenvectors, are the same for both
+// decompositions.
+//
+// Each eigenvalue is represented as a pair (α, β) with λ = α/β, where α is
+// complex and β is real and non-negative. The pair representation is
+// meaningful even when B is singular, in which case β may be zero and the
+// corresponding eigenvalue is infinite.
+//
+// In all cases, Factorize computes the eigenvalues of the matrix pair. kind
+// specifies which of the eigenvectors, if any, to compute. See the EigenKind
+// documentation for more information.
+// Factorize panics if the input matrices are not square or do not have the
+// same size.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (e *GenEigen) Factorize(a, b Matrix, kind EigenKind) (ok bool) {
+	// kill previous factorization.
+	e.n = 0
+	e.kind = 0
+	// Copy a and b because they are modified during the Lapack call.
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	br, bc := b.Dims()
+	if br != r || bc != c {
+		panic(ErrShape)
+	}
+	var sa, sb Dense
+	sa.CloneFrom(a)
+	sb.CloneFrom(b)
+
+	left := kind&EigenLeft != 0
+	right := kind&EigenRight != 0
+
+	var vl, vr Dense
+	jobvl := lapack.LeftEVNone
+	jobvr := lapack.RightEVNone
+	if left {
+		vl = *NewDense(r, r, nil)
+		jobvl = lapack.LeftEVCompute
+	}
+	if right {
+		vr = *NewDense(c, c, nil)
+		jobvr = lapack.RightEVCompute
+	}
+
+	alphar := getFloat64s(c, false)
+	defer putFloat64s(alphar)
+	alphai := getFloat64s(c, false)
+	defer putFloat64s(alphai)
+	beta := make([]float64, c)
+
+	work := []float64{0}
+	lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, len(work))
+	putFloat64s(work)
+
+	if !ok {
+		e.alpha = nil
+		e.beta = nil
+		return false
+	}
+	e.n = r
+	e.kind = kind
+
+	// Construct complex alpha values from float64 data.
+	alpha := make([]complex128, r)
+	for i, v := range alphar {
+		alpha[i] = complex(v, alphai[i])
+	}
+	e.alpha = alpha
+	e.beta = beta
+
+	// Construct complex eigenvectors from float64 data.
+	var cvl, cvr CDense
+	if left {
+		cvl = *NewCDense(r, r, nil)
+		complexEigenTo(&cvl, &vl, e.alpha)
+		e.lVectors = &cvl
+	} else {
+		e.lVectors = nil
+	}
+	if right {
+		cvr = *NewCDense(c, c, nil)
+		complexEigenTo(&cvr, &vr, e.alpha)
+		e.rVectors = &cvr
+	} else {
+		e.rVectors = nil
+	}
+	return true
+}
+
+// Kind returns the EigenKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (e *GenEigen) Kind() EigenKind {
+	if !e.succFact() {
+		return -1
+	}
+	return e.kind
+}
+
+// Values extracts the generalized eigenvalues λ = α/β of the factorized
+// matrix pair. If dst is non-nil, the values are stored in-place into dst. In
+// this case dst must have length n, otherwise Values will panic. If dst is
+// nil, then a new slice will be allocated of the proper length and filled
+// with the eigenvalues.
+//
+// An eigenvalue with β == 0 is infinite and is returned as cmplx.Inf(). If
+// both α and β are zero, the matrix pair is singular and the eigenvalue is
+// returned as cmplx.NaN(). Since the ratio α/β may over- or underflow, the
+// eigenvalues are better represented by the pairs returned by Alpha and Beta.
+//
+// Values panics if the decomposition was not successful.
+func (e *GenEigen) Values(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	for i, a := range e.alpha {
+		b := e.beta[i]
+		switch {
+		case b != 0:
+			dst[i] = complex(real(a)/b, imag(a)/b)
+		case a == 0:
+			dst[i] = cmplx.NaN()
+		default:
+			dst[i] = cmplx.Inf()
+		}
+	}
+	return dst
+}
+
+// Alpha extracts the numerators α of the generalized eigenvalues λ = α/β of
+// the factorized matrix pair. If dst is non-nil, the values are stored
+// in-place into dst. In this case dst must have length n, otherwise Alpha will
+// panic. If dst is nil, then a new slice will be allocated of the proper
+// length and filled with the values.
+//
+// Complex conjugate pairs of eigenvalues appear consecutively with the
+// eigenvalue having the positive imaginary part first.
+//
+// Alpha panics if the decomposition was not successful.
+func (e *GenEigen) Alpha(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.alpha)
+	return dst
+}
+
+// Beta extracts the non-negative denominators β of the generalized
+// eigenvalues λ = α/β of the factorized matrix pair. If dst is non-nil, the
+// values are stored in-place into dst. In this case dst must have length n,
+// otherwise Beta will panic. If dst is nil, then a new slice will be allocated
+// of the proper length and filled with the values.
+//
+// Beta panics if the decomposition was not successful.
+func (e *GenEigen) Beta(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.beta)
+	return dst
+}
+
+// VectorsTo stores the right generalized eigenvectors of the decomposition
+// into the columns of dst. Each eigenvector is scaled so the largest component
+// has |real part| + |imag. part| == 1.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *GenEigen) VectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenRight == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.rVectors)
+}
+
+// LeftVectorsTo stores the left generalized eigenvectors of the decomposition
+// into the columns of dst. Each eigenvector is scaled so the largest component
+// has |real part| + |imag. part| == 1.
+//
+// If dst is empty, LeftVectorsTo will resize dst to be n×n. When dst is
+// non-empty, LeftVectorsTo will panic if dst is not n×n. LeftVectorsTo will also
+// panic if the left eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *GenEigen) LeftVectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenLeft == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.lVectors)
+}
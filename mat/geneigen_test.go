@@ -0,0 +1,233 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestGenEigen(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 31} {
+		for _, kind := range []string{"random", "identity", "singular"} {
+			name := fmt.Sprintf("n=%d,kind=%s", n, kind)
+			a := NewDense(n, n, nil)
+			b := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			switch kind {
+			case "identity":
+				b.Zero()
+				for i := 0; i < n; i++ {
+					b.Set(i, i, 1)
+				}
+			case "singular":
+				// Zero the last row of B so that at least one
+				// eigenvalue is infinite.
+				for j := 0; j < n; j++ {
+					b.Set(n-1, j, 0)
+				}
+			}
+
+			var ge GenEigen
+			if !ge.Factorize(a, b, EigenBoth) {
+				t.Errorf("%v: unexpected failure", name)
+				continue
+			}
+			if ge.Kind() != EigenBoth {
+				t.Errorf("%v: unexpected kind", name)
+			}
+			alpha := ge.Alpha(nil)
+			beta := ge.Beta(nil)
+			values := ge.Values(nil)
+
+			var vr, vl CDense
+			ge.VectorsTo(&vr)
+			ge.LeftVectorsTo(&vl)
+			anorm := Norm(a, 1)
+			bnorm := Norm(b, 1)
+			for j := 0; j < n; j++ {
+				if beta[j] < 0 {
+					t.Errorf("%v: negative beta[%d]", name, j)
+				}
+				if beta[j] == 0 && !cmplx.IsInf(values[j]) {
+					t.Errorf("%v: eigenvalue %d not infinite for zero beta", name, j)
+				}
+				scale := beta[j]*anorm + cmplx.Abs(alpha[j])*bnorm
+				// Check that β*A*v = α*B*v.
+				if resid := genEigenResid(a, b, alpha[j], beta[j], &vr, j, false); resid > tol*scale {
+					t.Errorf("%v: right eigenvector %d mismatch; resid=%v", name, j, resid)
+				}
+				// Check that β*uᴴ*A = α*uᴴ*B.
+				if resid := genEigenResid(a, b, alpha[j], beta[j], &vl, j, true); resid > tol*scale {
+					t.Errorf("%v: left eigenvector %d mismatch; resid=%v", name, j, resid)
+				}
+			}
+
+			switch kind {
+			case "identity":
+				var eig Eigen
+				if !eig.Factorize(a, EigenNone) {
+					t.Fatalf("%v: unexpected failure of Eigen", name)
+				}
+				if !sameComplexSet(values, eig.Values(nil), 1e-10) {
+					t.Errorf("%v: eigenvalue mismatch with Eigen\ngot  %v\nwant %v", name, values, eig.Values(nil))
+				}
+			case "singular":
+				var nInf int
+				for _, v := range values {
+					if cmplx.IsInf(v) {
+						nInf++
+					}
+				}
+				if nInf == 0 {
+					t.Errorf("%v: no infinite eigenvalue for singular B", name)
+				}
+			}
+
+			var noVecs GenEigen
+			if !noVecs.Factorize(a, b, EigenNone) {
+				t.Errorf("%v: unexpected failure without eigenvectors", name)
+				continue
+			}
+			if noVecs.Kind() != EigenNone {
+				t.Errorf("%v: unexpected kind without eigenvectors", name)
+			}
+			if kind != "singular" && !sameComplexSet(noVecs.Values(nil), values, 1e-8) {
+				t.Errorf("%v: eigenvalue mismatch without eigenvectors", name)
+			}
+			if panicked, _ := panics(func() { noVecs.VectorsTo(&CDense{}) }); !panicked {
+				t.Errorf("%v: no panic for VectorsTo without eigenvectors", name)
+			}
+			if panicked, _ := panics(func() { noVecs.LeftVectorsTo(&CDense{}) }); !panicked {
+				t.Errorf("%v: no panic for LeftVectorsTo without eigenvectors", name)
+			}
+		}
+	}
+
+	if panicked, _ := panics(func() {
+		var ge GenEigen
+		ge.Factorize(NewDense(3, 3, nil), NewDense(2, 2, nil), EigenNone)
+	}); !panicked {
+		t.Errorf("no panic for mismatched matrix sizes")
+	}
+}
+
+// genEigenResid returns |β*A*v - α*B*v| for the j-th column v of vecs, or
+// |β*vᴴ*A - α*vᴴ*B| if left is true, in the max-abs norm.
+func genEigenResid(a, b *Dense, alpha complex128, beta float64, vecs *CDense, j int, left bool) float64 {
+	n, _ := a.Dims()
+	var resid float64
+	for i := 0; i < n; i++ {
+		var av, bv complex128
+		for k := 0; k < n; k++ {
+			if left {
+				v := cmplx.Conj(vecs.At(k, j))
+				av += v * complex(a.At(k, i), 0)
+				bv += v * complex(b.At(k, i), 0)
+			} else {
+				v := vecs.At(k, j)
+				av += complex(a.At(i, k), 0) * v
+				bv += complex(b.At(i, k), 0) * v
+			}
+		}
+		resid = math.Max(resid, cmplx.Abs(complex(beta, 0)*av-alpha*bv))
+	}
+	return resid
+}
+
+func TestGenEigenSym(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 70} {
+		for cas := 0; cas < 5; cas++ {
+			name := fmt.Sprintf("n=%d,case=%d", n, cas)
+			a := NewSymDense(n, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					a.SetSym(i, j, rnd.NormFloat64())
+				}
+			}
+			g := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					g.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			b := NewSymDense(n, nil)
+			b.SymOuterK(1, g)
+			for i := 0; i < n; i++ {
+				b.SetSym(i, i, b.At(i, i)+float64(n))
+			}
+
+			var ge GenEigenSym
+			if !ge.Factorize(a, b, true) {
+				t.Errorf("%v: unexpected failure", name)
+				continue
+			}
+			values := ge.Values(nil)
+			if !sort.Float64sAreSorted(values) {
+				t.Errorf("%v: eigenvalues not ascending", name)
+			}
+			var x Dense
+			ge.VectorsTo(&x)
+
+			// Check that A*X = B*X*Λ.
+			var ax, bx, bxl Dense
+			ax.Mul(a, &x)
+			bx.Mul(b, &x)
+			bxl.Mul(&bx, NewDiagDense(n, values))
+			if !EqualApprox(&ax, &bxl, tol) {
+				t.Errorf("%v: A*X != B*X*Λ", name)
+			}
+
+			// Check that Xᵀ*B*X = I.
+			var xbx Dense
+			xbx.Mul(x.T(), &bx)
+			if !EqualApprox(&xbx, eye(n), tol) {
+				t.Errorf("%v: Xᵀ*B*X != I", name)
+			}
+
+			var noVecs GenEigenSym
+			if !noVecs.Factorize(a, b, false) {
+				t.Errorf("%v: unexpected failure without eigenvectors", name)
+				continue
+			}
+			if !floats.EqualApprox(noVecs.Values(nil), values, tol) {
+				t.Errorf("%v: eigenvalue mismatch without eigenvectors", name)
+			}
+			if panicked, _ := panics(func() { noVecs.VectorsTo(&Dense{}) }); !panicked {
+				t.Errorf("%v: no panic for VectorsTo without eigenvectors", name)
+			}
+		}
+	}
+
+	// B is not positive definite.
+	var ge GenEigenSym
+	if ge.Factorize(NewSymDense(2, []float64{1, 0, 0, 1}), NewSymDense(2, []float64{1, 0, 0, -1}), true) {
+		t.Errorf("unexpected success for indefinite B")
+	}
+	if panicked, _ := panics(func() { ge.Values(nil) }); !panicked {
+		t.Errorf("no panic for Values after failed factorization")
+	}
+	if panicked, _ := panics(func() { ge.Factorize(NewSymDense(3, nil), NewSymDense(2, nil), false) }); !panicked {
+		t.Errorf("no panic for mismatched matrix sizes")
+	}
+}
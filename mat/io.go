@@ -11,16 +11,25 @@ import (
 	"fmt"
 	"io"
 	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
 )
 
-// version is the current on-disk codec version.
+// version is the on-disk codec version used for Dense and VecDense.
 const version uint32 = 0x1
 
+// version2 is the on-disk codec version that extends the storage header with
+// the kind of the serialised value and its element type. It is used for all
+// types other than Dense and VecDense.
+const version2 uint32 = 0x2
+
 // maxLen is the biggest slice/array len one can create on a 32/64b platform.
 const maxLen = int64(int(^uint(0) >> 1))
 
 var (
 	headerSize  = binary.Size(storage{})
+	header2Size = binary.Size(storage2{})
 	sizeFloat64 = binary.Size(float64(0))
 
 	errWrongType = errors.New("mat: wrong data type")
@@ -47,6 +56,14 @@ var (
 // G - general, S - symmetric, T - triangular
 // F - full, B - band, P - packed
 // A - all, U - upper, L - lower
+//
+// Version 2 headers append the following fields to the version 1 header:
+//
+// Kind 		Elem 		Reserved
+// uint8 [MCL] 	uint8 [DZ] 	[6]uint8
+//
+// M - matrix, C - Cholesky factorization, L - LU factorization
+// D - float64, Z - complex128
 
 // MarshalBinary encodes the receiver into a binary form and returns the result.
 //
@@ -424,6 +441,719 @@ func (v *VecDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
 	return n, nil
 }
 
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// SymDense is little-endian encoded as a version 2 header with Form 'S',
+// Packing 'P', Uplo 'U', Kind 'M' and Elem 'D' and with the order n of the
+// matrix as the number of rows and columns, followed by the n*(n+1)/2
+// elements of the upper triangle in row-major order (float64).
+func (s SymDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(s.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (s SymDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n := s.mat.N
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{Form: 'S', Packing: 'P', Uplo: 'U', Rows: int64(n), Cols: int64(n)},
+		Kind:    kindMatrix, Elem: elemFloat64,
+	})
+	for i := 0; i < n; i++ {
+		e.float64s(s.mat.Data[i*s.mat.Stride+i : i*s.mat.Stride+n])
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty SymDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (s *SymDense) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, s.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-empty SymDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (s *SymDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !s.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.is('S', 'P', 'U', kindMatrix, elemFloat64) || h.Rows != h.Cols || h.KU != 0 || h.KL != 0 {
+		return d.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(words)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n := int(h.Rows)
+	*s = *NewSymDense(n, nil)
+	unpackTriangle(blas64.Triangular{N: n, Stride: s.mat.Stride, Data: s.mat.Data, Uplo: blas.Upper}, data)
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// TriDense is little-endian encoded as a version 2 header with Form 'T',
+// Packing 'P', Uplo 'U' or 'L' depending on the kind of the matrix, Unit
+// indicating a unit diagonal, Kind 'M' and Elem 'D' and with the order n of
+// the matrix as the number of rows and columns, followed by the n*(n+1)/2
+// elements of the triangle in row-major order (float64).
+func (t TriDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(t.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (t TriDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n := t.mat.N
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{
+			Form: 'T', Packing: 'P', Uplo: uploByte(t.mat.Uplo), Unit: t.mat.Diag == blas.Unit,
+			Rows: int64(n), Cols: int64(n),
+		},
+		Kind: kindMatrix, Elem: elemFloat64,
+	})
+	encodeTriangle(&e, t.mat)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty TriDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (t *TriDense) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, t.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-empty TriDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (t *TriDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !t.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.isTriangular('P', kindMatrix) || h.KU != 0 || h.KL != 0 {
+		return d.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(words)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	*t = *NewTriDense(int(h.Rows), h.Uplo == 'U', nil)
+	if h.Unit {
+		t.mat.Diag = blas.Unit
+	}
+	unpackTriangle(t.mat, data)
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// BandDense is little-endian encoded as a version 2 header with Form 'G',
+// Packing 'B', Uplo 'A', Kind 'M' and Elem 'D' and with the number of rows
+// and columns and the numbers of super- and sub-diagonals kU and kL of the
+// matrix, followed by the rows of the band storage of the matrix, each of
+// length kL+kU+1, as described by NewBandDense (float64). Elements outside
+// the matrix are encoded as zero.
+func (b BandDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(b.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (b BandDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	r, c, kl, ku := b.mat.Rows, b.mat.Cols, b.mat.KL, b.mat.KU
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{
+			Form: 'G', Packing: 'B', Uplo: 'A',
+			Rows: int64(r), Cols: int64(c), KU: int64(ku), KL: int64(kl),
+		},
+		Kind: kindMatrix, Elem: elemFloat64,
+	})
+	for i := 0; i < min(r, c+kl); i++ {
+		for k := 0; k < kl+ku+1; k++ {
+			var v float64
+			if j := i - kl + k; 0 <= j && j < c {
+				v = b.mat.Data[i*b.mat.Stride+k]
+			}
+			e.float64(v)
+		}
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty BandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (b *BandDense) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, b.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-empty BandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (b *BandDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !b.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.is('G', 'B', 'A', kindMatrix, elemFloat64) {
+		return d.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(words)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	*b = *NewBandDense(int(h.Rows), int(h.Cols), int(h.KL), int(h.KU), data)
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// SymBandDense is little-endian encoded as a version 2 header with Form 'S',
+// Packing 'B', Uplo 'U', Kind 'M' and Elem 'D' and with the order n of the
+// matrix as the number of rows and columns and the number of super-diagonals
+// k as kU and kL, followed by the n rows of the band storage of the upper
+// triangle, each of length k+1, as described by NewSymBandDense (float64).
+// Elements outside the matrix are encoded as zero.
+func (s SymBandDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(s.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (s SymBandDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n, k := s.mat.N, s.mat.K
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{
+			Form: 'S', Packing: 'B', Uplo: 'U',
+			Rows: int64(n), Cols: int64(n), KU: int64(k), KL: int64(k),
+		},
+		Kind: kindMatrix, Elem: elemFloat64,
+	})
+	encodeUpperBand(&e, n, k, s.mat.Data, s.mat.Stride)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty SymBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (s *SymBandDense) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, s.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-empty SymBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (s *SymBandDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !s.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.is('S', 'B', 'U', kindMatrix, elemFloat64) || h.Rows != h.Cols || h.KU != h.KL {
+		return d.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(words)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	*s = *NewSymBandDense(int(h.Rows), int(h.KU), data)
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// TriBandDense is little-endian encoded as a version 2 header with Form 'T',
+// Packing 'B', Uplo 'U' or 'L' depending on the kind of the matrix, Unit
+// indicating a unit diagonal, Kind 'M' and Elem 'D' and with the order n of
+// the matrix as the number of rows and columns and the number of bands k as
+// kU and kL, followed by the n rows of the band storage, each of length k+1,
+// as described by NewTriBandDense (float64). Elements outside the matrix are
+// encoded as zero.
+func (t TriBandDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(t.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (t TriBandDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n, k := t.mat.N, t.mat.K
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{
+			Form: 'T', Packing: 'B', Uplo: uploByte(t.mat.Uplo), Unit: t.mat.Diag == blas.Unit,
+			Rows: int64(n), Cols: int64(n), KU: int64(k), KL: int64(k),
+		},
+		Kind: kindMatrix, Elem: elemFloat64,
+	})
+	if t.mat.Uplo == blas.Upper {
+		encodeUpperBand(&e, n, k, t.mat.Data, t.mat.Stride)
+		return e.n, e.err
+	}
+	for i := 0; i < n; i++ {
+		for l := 0; l < k+1; l++ {
+			var v float64
+			if i-k+l >= 0 {
+				v = t.mat.Data[i*t.mat.Stride+l]
+			}
+			e.float64(v)
+		}
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty TriBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (t *TriBandDense) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, t.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-empty TriBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (t *TriBandDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !t.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.isTriangular('B', kindMatrix) || h.KU != h.KL {
+		return d.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(words)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	*t = *NewTriBandDense(int(h.Rows), int(h.KU), h.Uplo == 'U', data)
+	if h.Unit {
+		t.mat.Diag = blas.Unit
+	}
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// DiagDense is little-endian encoded as a version 2 header with Form 'S',
+// Packing 'B', Uplo 'U', Kind 'M' and Elem 'D' and with the order n of the
+// matrix as the number of rows and columns and zero bands, followed by the n
+// diagonal elements (float64). This is the same layout as a SymBandDense
+// with no off-diagonal bands.
+func (d DiagDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(d.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (d DiagDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n := d.mat.N
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{Form: 'S', Packing: 'B', Uplo: 'U', Rows: int64(n), Cols: int64(n)},
+		Kind:    kindMatrix, Elem: elemFloat64,
+	})
+	for i := 0; i < n; i++ {
+		e.float64(d.mat.Data[i*d.mat.Inc])
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty DiagDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (d *DiagDense) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, d.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-empty DiagDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (d *DiagDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !d.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	dec := decoder{r: r}
+	h := dec.header()
+	if dec.err != nil {
+		return dec.n, dec.err
+	}
+	if !h.is('S', 'B', 'U', kindMatrix, elemFloat64) || h.Rows != h.Cols || h.KU != 0 || h.KL != 0 {
+		return dec.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return dec.n, err
+	}
+	data := dec.readFloat64s(words)
+	if dec.err != nil {
+		return dec.n, dec.err
+	}
+	*d = *NewDiagDense(int(h.Rows), data)
+	return dec.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CDense is little-endian encoded as a version 2 header with Form 'G',
+// Packing 'F', Uplo 'A', Kind 'M' and Elem 'Z' and with the number of rows
+// and columns of the matrix, followed by the matrix elements in row-major
+// order, each encoded as its real and imaginary part (float64).
+func (m CDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(m.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (m CDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	r, c := m.mat.Rows, m.mat.Cols
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{Form: 'G', Packing: 'F', Uplo: 'A', Rows: int64(r), Cols: int64(c)},
+		Kind:    kindMatrix, Elem: elemComplex128,
+	})
+	for i := 0; i < r; i++ {
+		for _, v := range m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c] {
+			e.float64(real(v))
+			e.float64(imag(v))
+		}
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty CDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (m *CDense) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, m.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-empty CDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (m *CDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !m.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.is('G', 'F', 'A', kindMatrix, elemComplex128) || h.KU != 0 || h.KL != 0 {
+		return d.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(words)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	*m = *NewCDense(int(h.Rows), int(h.Cols), nil)
+	for i := range m.mat.Data {
+		m.mat.Data[i] = complex(data[2*i], data[2*i+1])
+	}
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+// MarshalBinary returns an error if the receiver does not contain a
+// factorization.
+//
+// Cholesky is little-endian encoded as a version 2 header with Form 'T',
+// Packing 'P', Uplo 'U', Kind 'C' and Elem 'D' and with the order n of the
+// factorized matrix as the number of rows and columns, followed by the
+// n*(n+1)/2 elements of the upper triangular factor U in row-major order and
+// the condition number estimate (float64).
+func (c *Cholesky) MarshalBinary() ([]byte, error) {
+	return marshalBinary(c.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (c *Cholesky) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !c.valid() {
+		return 0, errors.New(badCholesky)
+	}
+	n := c.chol.mat.N
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{Form: 'T', Packing: 'P', Uplo: 'U', Rows: int64(n), Cols: int64(n)},
+		Kind:    kindCholesky, Elem: elemFloat64,
+	})
+	encodeTriangle(&e, c.chol.mat)
+	e.float64(c.cond)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver contains a factorization.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. Storage for the elements is allocated as they are
+// read, so the memory used is bounded by the length of the data rather than
+// by the dimensions in the header.
+func (c *Cholesky) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, c.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver contains a factorization.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (c *Cholesky) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if c.valid() {
+		panic("mat: unmarshal into non-empty factorization")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.is('T', 'P', 'U', kindCholesky, elemFloat64) || h.Rows != h.Cols || h.KU != 0 || h.KL != 0 {
+		return d.n, errWrongType
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(words)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	chol := NewTriDense(int(h.Rows), Upper, nil)
+	unpackTriangle(chol.mat, data[:words-1])
+	c.chol = chol
+	c.cond = data[words-1]
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+// MarshalBinary returns an error if the receiver does not contain a
+// factorization.
+//
+// LU is little-endian encoded as a version 2 header with Form 'G', Packing
+// 'F', Uplo 'A', Kind 'L' and Elem 'D' and with the order n of the factorized
+// matrix as the number of rows and columns, followed by the n×n matrix
+// holding the factors L and U in row-major order (float64), the n pivot
+// indices (int64) and the condition number estimate (float64).
+func (lu *LU) MarshalBinary() ([]byte, error) {
+	return marshalBinary(lu.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (lu *LU) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !lu.isValid() {
+		return 0, errors.New(badLU)
+	}
+	n := lu.lu.mat.Rows
+	e := encoder{w: w}
+	e.header(storage2{
+		storage: storage{Form: 'G', Packing: 'F', Uplo: 'A', Rows: int64(n), Cols: int64(n)},
+		Kind:    kindLU, Elem: elemFloat64,
+	})
+	for i := 0; i < n; i++ {
+		e.float64s(lu.lu.mat.Data[i*lu.lu.mat.Stride : i*lu.lu.mat.Stride+n])
+	}
+	for _, p := range lu.pivot {
+		e.int64(int64(p))
+	}
+	e.float64(lu.cond)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver contains a factorization.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed, see
+// Dense.UnmarshalBinary. In addition ErrPivot is returned if a pivot index
+// is out of range. Storage for the elements is allocated as they are read, so
+// the memory used is bounded by the length of the data rather than by the
+// dimensions in the header.
+func (lu *LU) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, lu.UnmarshalBinaryFrom)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver contains a factorization.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (lu *LU) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if lu.isValid() {
+		panic("mat: unmarshal into non-empty factorization")
+	}
+	d := decoder{r: r}
+	h := d.header()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if !h.is('G', 'F', 'A', kindLU, elemFloat64) || h.Rows != h.Cols || h.KU != 0 || h.KL != 0 {
+		return d.n, errWrongType
+	}
+	_, err := h.dataLen()
+	if err != nil {
+		return d.n, err
+	}
+	data := d.readFloat64s(h.Rows * h.Cols)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n := int(h.Rows)
+	f := NewDense(n, n, data)
+	pivot := make([]int, n)
+	for i := range pivot {
+		p := d.int64()
+		if d.err == nil && (p < int64(i) || int64(n) <= p) {
+			return d.n, ErrPivot
+		}
+		pivot[i] = int(p)
+	}
+	cond := d.float64()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	lu.lu = f
+	lu.pivot = pivot
+	lu.cond = cond
+	return d.n, nil
+}
+
 // storage is the internal representation of the storage format of a
 // serialised matrix.
 type storage struct {
@@ -489,3 +1219,322 @@ func readFull(r io.Reader, buf []byte) (int, error) {
 	}
 	return n, err
 }
+
+// Kinds of values serialised with a version 2 header.
+const (
+	kindMatrix   = 'M'
+	kindCholesky = 'C'
+	kindLU       = 'L'
+)
+
+// Element types of values serialised with a version 2 header.
+const (
+	elemFloat64    = 'D'
+	elemComplex128 = 'Z'
+)
+
+// storage2 is the internal representation of the version 2 storage format
+// of a serialised matrix or factorization.
+type storage2 struct {
+	storage
+	Kind byte // [MCL]
+	Elem byte // [DZ]
+	_    [6]byte
+}
+
+// is returns whether the header describes a value with the given form,
+// packing, triangle, kind and element type.
+func (s storage2) is(form, packing, uplo, kind, elem byte) bool {
+	return s.Form == form && s.Packing == packing && s.Uplo == uplo && !s.Unit &&
+		s.Kind == kind && s.Elem == elem
+}
+
+// isTriangular returns whether the header describes a float64 triangular
+// value with the given packing and kind.
+func (s storage2) isTriangular(packing, kind byte) bool {
+	return s.Form == 'T' && s.Packing == packing && (s.Uplo == 'U' || s.Uplo == 'L') &&
+		s.Rows == s.Cols && s.Kind == kind && s.Elem == elemFloat64
+}
+
+// maxWords is the largest number of 8-byte words that can follow a header.
+var maxWords = maxLen / int64(sizeFloat64)
+
+// dataLen returns the number of 8-byte words that follow a version 2 header
+// describing a value with valid non-zero dimensions. It returns an error if
+// the dimensions are invalid or the data could not be held in memory.
+func (s storage2) dataLen() (int64, error) {
+	switch {
+	case s.Rows < 0 || s.Cols < 0 || s.KU < 0 || s.KL < 0:
+		return 0, errBadSize
+	case s.Rows == 0 || s.Cols == 0:
+		return 0, ErrZeroLength
+	case s.Rows > maxWords || s.Cols > maxWords || s.KU > maxWords || s.KL > maxWords:
+		return 0, errTooBig
+	}
+	var n, extra int64
+	switch s.Packing {
+	case 'F':
+		if s.Rows > maxWords/s.Cols {
+			return 0, errTooBig
+		}
+		n = s.Rows * s.Cols
+		if s.Kind == kindLU {
+			// Pivot indices and condition number.
+			extra = s.Rows + 1
+		}
+	case 'B':
+		rows := s.Rows
+		width := s.KU + 1
+		if s.Form == 'G' {
+			if s.Rows <= s.KL || s.Cols <= s.KU {
+				return 0, errBadSize
+			}
+			// Rows below the last sub-diagonal are not stored.
+			if s.Cols+s.KL < rows {
+				rows = s.Cols + s.KL
+			}
+			width += s.KL
+		} else if s.Rows <= s.KU {
+			return 0, errBadSize
+		}
+		if rows > maxWords/width {
+			return 0, errTooBig
+		}
+		n = rows * width
+	case 'P':
+		if s.Rows > 2*maxWords/(s.Rows+1) {
+			return 0, errTooBig
+		}
+		n = s.Rows * (s.Rows + 1) / 2
+		if s.Kind == kindCholesky {
+			// Condition number.
+			extra = 1
+		}
+	default:
+		return 0, errWrongType
+	}
+	switch s.Elem {
+	case elemFloat64:
+	case elemComplex128:
+		if n > maxWords/2 {
+			return 0, errTooBig
+		}
+		n *= 2
+	default:
+		return 0, errWrongType
+	}
+	if n > maxWords-extra {
+		return 0, errTooBig
+	}
+	return n + extra, nil
+}
+
+func uploByte(uplo blas.Uplo) byte {
+	if uplo == blas.Upper {
+		return 'U'
+	}
+	return 'L'
+}
+
+// marshalBinary returns the binary form written by the marshalBinaryTo
+// function of a value.
+func marshalBinary(marshalBinaryTo func(io.Writer) (int, error)) ([]byte, error) {
+	var buf bytes.Buffer
+	_, err := marshalBinaryTo(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshalBinary decodes data with the unmarshalBinaryFrom function of a
+// value and checks that the length of data matches its header and that all
+// of data has been consumed.
+func unmarshalBinary(data []byte, unmarshalBinaryFrom func(io.Reader) (int, error)) error {
+	if len(data) < header2Size {
+		return errTooSmall
+	}
+	// Check that the size of data matches the header before the header
+	// is used to allocate the value.
+	d := decoder{r: bytes.NewReader(data[:header2Size])}
+	h := d.header()
+	if d.err != nil {
+		return d.err
+	}
+	words, err := h.dataLen()
+	if err != nil {
+		return err
+	}
+	if int64(len(data)-header2Size) != words*int64(sizeFloat64) {
+		return errBadBuffer
+	}
+	n, err := unmarshalBinaryFrom(bytes.NewReader(data))
+	if err == io.ErrUnexpectedEOF {
+		return errBadBuffer
+	}
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return errBadBuffer
+	}
+	return nil
+}
+
+// encoder writes little-endian encoded values to w, recording the number of
+// bytes written and the first error.
+type encoder struct {
+	w   io.Writer
+	n   int
+	err error
+	buf [8]byte
+}
+
+func (e *encoder) write(b []byte) {
+	if e.err != nil {
+		return
+	}
+	n, err := e.w.Write(b)
+	e.n += n
+	e.err = err
+}
+
+func (e *encoder) header(s storage2) {
+	s.Version = version2
+	buf := bytes.NewBuffer(make([]byte, 0, header2Size))
+	err := binary.Write(buf, binary.LittleEndian, s)
+	if err != nil {
+		if e.err == nil {
+			e.err = err
+		}
+		return
+	}
+	e.write(buf.Bytes())
+}
+
+func (e *encoder) float64(v float64) {
+	binary.LittleEndian.PutUint64(e.buf[:], math.Float64bits(v))
+	e.write(e.buf[:])
+}
+
+func (e *encoder) float64s(s []float64) {
+	for _, v := range s {
+		e.float64(v)
+	}
+}
+
+func (e *encoder) int64(v int64) {
+	binary.LittleEndian.PutUint64(e.buf[:], uint64(v))
+	e.write(e.buf[:])
+}
+
+// decoder reads little-endian encoded values from r, recording the number of
+// bytes read and the first error.
+type decoder struct {
+	r   io.Reader
+	n   int
+	err error
+	buf [8]byte
+}
+
+func (d *decoder) read(b []byte) {
+	if d.err != nil {
+		return
+	}
+	n, err := readFull(d.r, b)
+	d.n += n
+	d.err = err
+}
+
+// header reads a version 2 header.
+func (d *decoder) header() storage2 {
+	var s storage2
+	buf := make([]byte, header2Size)
+	d.read(buf)
+	if d.err != nil {
+		return s
+	}
+	d.err = binary.Read(bytes.NewReader(buf), binary.LittleEndian, &s)
+	if d.err == nil && s.Version != version2 {
+		d.err = fmt.Errorf("mat: incorrect version: %d", s.Version)
+	}
+	return s
+}
+
+func (d *decoder) float64() float64 {
+	d.read(d.buf[:])
+	if d.err != nil {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(d.buf[:]))
+}
+
+func (d *decoder) float64s(s []float64) {
+	for i := range s {
+		s[i] = d.float64()
+	}
+}
+
+// readChunk is the number of words read by readFloat64s before growing its
+// result.
+const readChunk = 1 << 16
+
+// readFloat64s reads n float64 values. The result is grown as the values
+// are read so that a corrupt header can not cause the allocation of more
+// memory than the data that is available.
+func (d *decoder) readFloat64s(n int64) []float64 {
+	var s []float64
+	for int64(len(s)) < n && d.err == nil {
+		m := n - int64(len(s))
+		if m > readChunk {
+			m = readChunk
+		}
+		s = append(s, make([]float64, m)...)
+		d.float64s(s[len(s)-int(m):])
+	}
+	return s
+}
+
+func (d *decoder) int64() int64 {
+	d.read(d.buf[:])
+	if d.err != nil {
+		return 0
+	}
+	return int64(binary.LittleEndian.Uint64(d.buf[:]))
+}
+
+// encodeTriangle encodes the triangle of t in row-major order.
+func encodeTriangle(e *encoder, t blas64.Triangular) {
+	for i := 0; i < t.N; i++ {
+		if t.Uplo == blas.Upper {
+			e.float64s(t.Data[i*t.Stride+i : i*t.Stride+t.N])
+		} else {
+			e.float64s(t.Data[i*t.Stride : i*t.Stride+i+1])
+		}
+	}
+}
+
+// unpackTriangle copies the triangle of t in row-major order from data.
+func unpackTriangle(t blas64.Triangular, data []float64) {
+	for i := 0; i < t.N; i++ {
+		if t.Uplo == blas.Upper {
+			data = data[copy(t.Data[i*t.Stride+i:i*t.Stride+t.N], data):]
+		} else {
+			data = data[copy(t.Data[i*t.Stride:i*t.Stride+i+1], data):]
+		}
+	}
+}
+
+// encodeUpperBand encodes the n rows of the upper band storage in data,
+// encoding elements outside the matrix as zero.
+func encodeUpperBand(e *encoder, n, k int, data []float64, stride int) {
+	for i := 0; i < n; i++ {
+		for l := 0; l < k+1; l++ {
+			var v float64
+			if i+l < n {
+				v = data[i*stride+l]
+			}
+			e.float64(v)
+		}
+	}
+}
@@ -13,6 +13,9 @@ import (
 	"math"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
 )
 
@@ -21,6 +24,24 @@ var (
 	_ encoding.BinaryUnmarshaler = (*Dense)(nil)
 	_ encoding.BinaryMarshaler   = (*VecDense)(nil)
 	_ encoding.BinaryUnmarshaler = (*VecDense)(nil)
+	_ encoding.BinaryMarshaler   = (*SymDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*SymDense)(nil)
+	_ encoding.BinaryMarshaler   = (*TriDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*TriDense)(nil)
+	_ encoding.BinaryMarshaler   = (*BandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*BandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*SymBandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*SymBandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*TriBandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*TriBandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*DiagDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*DiagDense)(nil)
+	_ encoding.BinaryMarshaler   = (*CDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*CDense)(nil)
+	_ encoding.BinaryMarshaler   = (*Cholesky)(nil)
+	_ encoding.BinaryUnmarshaler = (*Cholesky)(nil)
+	_ encoding.BinaryMarshaler   = (*LU)(nil)
+	_ encoding.BinaryUnmarshaler = (*LU)(nil)
 )
 
 var sizeInt64 = binary.Size(int64(0))
@@ -582,6 +603,340 @@ func TestVecDenseIORoundTrip(t *testing.T) {
 	}
 }
 
+// binaryValue is a value that can be marshaled and unmarshaled using the
+// version 2 binary format.
+type binaryValue interface {
+	MarshalBinary() ([]byte, error)
+	MarshalBinaryTo(io.Writer) (int, error)
+	UnmarshalBinary([]byte) error
+	UnmarshalBinaryFrom(io.Reader) (int, error)
+}
+
+func TestIORoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	matrixEqual := func(a, b binaryValue) bool { return Equal(a.(Matrix), b.(Matrix)) }
+
+	spd := NewSymDense(4, nil)
+	spd.SymOuterK(1, randNormalDense(4, 4, rnd))
+	for i := 0; i < 4; i++ {
+		spd.SetSym(i, i, spd.At(i, i)+1)
+	}
+	var chol Cholesky
+	if !chol.Factorize(spd) {
+		t.Fatal("bad test, Cholesky factorization failed")
+	}
+	var lu LU
+	lu.Factorize(randNormalDense(5, 5, rnd))
+
+	triUnit := NewTriDense(3, Lower, []float64{1, 0, 0, 2, 3, 0, 4, 5, 6})
+	triUnit.mat.Diag = blas.Unit
+
+	for _, test := range []struct {
+		name string
+		src  binaryValue
+		dst  func() binaryValue
+		eq   func(a, b binaryValue) bool
+	}{
+		{
+			name: "SymDense",
+			src:  randSymDense(5, rnd),
+			dst:  func() binaryValue { return &SymDense{} },
+			eq:   matrixEqual,
+		},
+		{
+			name: "TriDenseUpper",
+			src:  NewTriDense(3, Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6}),
+			dst:  func() binaryValue { return &TriDense{} },
+			eq:   matrixEqual,
+		},
+		{
+			name: "TriDenseLowerUnit",
+			src:  triUnit,
+			dst:  func() binaryValue { return &TriDense{} },
+			eq:   matrixEqual,
+		},
+		{
+			name: "BandDense",
+			src: NewBandDense(5, 4, 2, 1, []float64{
+				0, 0, 1, 2,
+				0, 3, 4, 5,
+				6, 7, 8, 9,
+				10, 11, 12, 0,
+				13, 14, 0, 0,
+			}),
+			dst: func() binaryValue { return &BandDense{} },
+			eq:  matrixEqual,
+		},
+		{
+			name: "SymBandDense",
+			src:  NewSymBandDense(4, 2, []float64{1, 2, 3, 4, 5, 6, 7, 8, 0, 9, 0, 0}),
+			dst:  func() binaryValue { return &SymBandDense{} },
+			eq:   matrixEqual,
+		},
+		{
+			name: "TriBandDenseUpper",
+			src:  NewTriBandDense(3, 1, Upper, []float64{1, 2, 3, 4, 5, 0}),
+			dst:  func() binaryValue { return &TriBandDense{} },
+			eq:   matrixEqual,
+		},
+		{
+			name: "TriBandDenseLower",
+			src:  NewTriBandDense(3, 1, Lower, []float64{0, 1, 2, 3, 4, 5}),
+			dst:  func() binaryValue { return &TriBandDense{} },
+			eq:   matrixEqual,
+		},
+		{
+			name: "DiagDense",
+			src:  NewDiagDense(3, []float64{1, -2, math.Inf(1)}),
+			dst:  func() binaryValue { return &DiagDense{} },
+			eq:   matrixEqual,
+		},
+		{
+			name: "CDense",
+			src:  NewCDense(2, 3, []complex128{1 + 2i, 0, -3i, 4, 5.5 - 1i, complex(math.Inf(1), 0)}),
+			dst:  func() binaryValue { return &CDense{} },
+			eq:   func(a, b binaryValue) bool { return CEqual(a.(*CDense), b.(*CDense)) },
+		},
+		{
+			name: "Cholesky",
+			src:  &chol,
+			dst:  func() binaryValue { return &Cholesky{} },
+			eq: func(a, b binaryValue) bool {
+				return equalChol(a.(*Cholesky), b.(*Cholesky))
+			},
+		},
+		{
+			name: "LU",
+			src:  &lu,
+			dst:  func() binaryValue { return &LU{} },
+			eq: func(a, b binaryValue) bool {
+				alu, blu := a.(*LU), b.(*LU)
+				if !Equal(alu.lu, blu.lu) || alu.cond != blu.cond || len(alu.pivot) != len(blu.pivot) {
+					return false
+				}
+				for i, p := range alu.pivot {
+					if blu.pivot[i] != p {
+						return false
+					}
+				}
+				return true
+			},
+		},
+	} {
+		buf, err := test.src.MarshalBinary()
+		if err != nil {
+			t.Errorf("%s: unexpected error marshaling: %v", test.name, err)
+			continue
+		}
+		var w bytes.Buffer
+		n, err := test.src.MarshalBinaryTo(&w)
+		if err != nil {
+			t.Errorf("%s: unexpected error marshaling to writer: %v", test.name, err)
+			continue
+		}
+		if n != len(buf) || !bytes.Equal(w.Bytes(), buf) {
+			t.Errorf("%s: MarshalBinary and MarshalBinaryTo mismatch", test.name)
+		}
+
+		got := test.dst()
+		err = got.UnmarshalBinary(buf)
+		if err != nil {
+			t.Errorf("%s: unexpected error unmarshaling: %v", test.name, err)
+			continue
+		}
+		if !test.eq(got, test.src) {
+			t.Errorf("%s: round trip mismatch for UnmarshalBinary", test.name)
+		}
+
+		got = test.dst()
+		n, err = got.UnmarshalBinaryFrom(bytes.NewReader(buf))
+		if err != nil {
+			t.Errorf("%s: unexpected error unmarshaling from reader: %v", test.name, err)
+			continue
+		}
+		if n != len(buf) {
+			t.Errorf("%s: unexpected number of bytes read: got:%d want:%d", test.name, n, len(buf))
+		}
+		if !test.eq(got, test.src) {
+			t.Errorf("%s: round trip mismatch for UnmarshalBinaryFrom", test.name)
+		}
+
+		// Truncated and extended data must be rejected.
+		err = test.dst().UnmarshalBinary(buf[:len(buf)-1])
+		if err != errBadBuffer {
+			t.Errorf("%s: unexpected error for truncated data: got:%v want:%v", test.name, err, errBadBuffer)
+		}
+		err = test.dst().UnmarshalBinary(append(buf[:len(buf):len(buf)], 0))
+		if err != errBadBuffer {
+			t.Errorf("%s: unexpected error for extended data: got:%v want:%v", test.name, err, errBadBuffer)
+		}
+
+		// Non-empty receivers must not be overwritten.
+		if panicked, _ := panics(func() { got.UnmarshalBinary(buf) }); !panicked {
+			t.Errorf("%s: expected panic unmarshaling into non-empty receiver", test.name)
+		}
+	}
+
+	// The factorized values must behave identically after a round trip.
+	b := randNormalDense(5, 2, rnd)
+	buf, err := lu.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error marshaling LU: %v", err)
+	}
+	var luGot LU
+	err = luGot.UnmarshalBinary(buf)
+	if err != nil {
+		t.Fatalf("unexpected error unmarshaling LU: %v", err)
+	}
+	var x, xGot Dense
+	_ = lu.SolveTo(&x, false, b)
+	_ = luGot.SolveTo(&xGot, false, b)
+	if !Equal(&x, &xGot) {
+		t.Errorf("unexpected LU solution after round trip")
+	}
+	if lu.Det() != luGot.Det() {
+		t.Errorf("unexpected LU determinant after round trip")
+	}
+}
+
+func TestIOUnmarshalError(t *testing.T) {
+	t.Parallel()
+	sym, err := NewSymDense(2, []float64{1, 2, 2, 3}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dense, err := NewDense(2, 2, []float64{1, 2, 3, 4}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var tri TriDense
+	err = tri.UnmarshalBinary(sym)
+	if err != errWrongType {
+		t.Errorf("unexpected error unmarshaling SymDense into TriDense: got:%v want:%v", err, errWrongType)
+	}
+	var chol Cholesky
+	err = chol.UnmarshalBinary(sym)
+	if err != errWrongType {
+		t.Errorf("unexpected error unmarshaling SymDense into Cholesky: got:%v want:%v", err, errWrongType)
+	}
+	var s SymDense
+	err = s.UnmarshalBinary(dense)
+	if err == nil || err.Error() != "mat: incorrect version: 1" {
+		t.Errorf("unexpected error unmarshaling Dense into SymDense: %v", err)
+	}
+	var m Dense
+	err = m.UnmarshalBinary(sym)
+	if err == nil || err.Error() != "mat: incorrect version: 2" {
+		t.Errorf("unexpected error unmarshaling SymDense into Dense: %v", err)
+	}
+	err = s.UnmarshalBinary(sym[:header2Size-1])
+	if err != errTooSmall {
+		t.Errorf("unexpected error for short data: got:%v want:%v", err, errTooSmall)
+	}
+
+	// Corrupt the size of the matrix.
+	bad := append([]byte(nil), sym...)
+	binary.LittleEndian.PutUint64(bad[8:], 0)
+	binary.LittleEndian.PutUint64(bad[16:], 0)
+	err = s.UnmarshalBinary(bad)
+	if err != ErrZeroLength {
+		t.Errorf("unexpected error for zero size: got:%v want:%v", err, ErrZeroLength)
+	}
+
+	// Corrupt a pivot index of an LU factorization.
+	var lu LU
+	lu.Factorize(NewDense(2, 2, []float64{1, 2, 3, 4}))
+	buf, err := lu.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binary.LittleEndian.PutUint64(buf[header2Size+4*sizeFloat64:], 5)
+	var luGot LU
+	err = luGot.UnmarshalBinary(buf)
+	if err != ErrPivot {
+		t.Errorf("unexpected error for bad pivot: got:%v want:%v", err, ErrPivot)
+	}
+
+	_, err = (&Cholesky{}).MarshalBinary()
+	if err == nil {
+		t.Errorf("expected error marshaling empty Cholesky")
+	}
+}
+
+func TestIOUnmarshalCorruptHeader(t *testing.T) {
+	t.Parallel()
+	var chol Cholesky
+	if !chol.Factorize(NewSymDense(2, []float64{4, 2, 2, 3})) {
+		t.Fatal("bad test, Cholesky factorization failed")
+	}
+	var lu LU
+	lu.Factorize(NewDense(2, 2, []float64{1, 2, 3, 4}))
+
+	for _, test := range []struct {
+		name string
+		src  binaryValue
+		dst  func() binaryValue
+	}{
+		{"SymDense", NewSymDense(2, []float64{1, 2, 2, 3}), func() binaryValue { return &SymDense{} }},
+		{"TriDense", NewTriDense(2, Lower, []float64{1, 0, 2, 3}), func() binaryValue { return &TriDense{} }},
+		{"BandDense", NewBandDense(3, 2, 1, 0, []float64{0, 1, 2, 3, 4, 0}), func() binaryValue { return &BandDense{} }},
+		{"SymBandDense", NewSymBandDense(2, 1, []float64{1, 2, 3, 0}), func() binaryValue { return &SymBandDense{} }},
+		{"TriBandDense", NewTriBandDense(2, 1, Upper, []float64{1, 2, 3, 0}), func() binaryValue { return &TriBandDense{} }},
+		{"DiagDense", NewDiagDense(2, []float64{1, 2}), func() binaryValue { return &DiagDense{} }},
+		{"CDense", NewCDense(1, 2, []complex128{1 + 2i, 3}), func() binaryValue { return &CDense{} }},
+		{"Cholesky", &chol, func() binaryValue { return &Cholesky{} }},
+		{"LU", &lu, func() binaryValue { return &LU{} }},
+	} {
+		buf, err := test.src.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: unexpected error marshaling: %v", test.name, err)
+		}
+		// Replace each header byte with values that flip each bit and
+		// that make the dimension fields large or negative. Corrupt input
+		// must be rejected or decoded without panicking or allocating
+		// beyond the size of the input.
+		for i := 0; i < header2Size; i++ {
+			orig := buf[i]
+			vals := []byte{0, 0x7f, 0xff}
+			for bit := uint(0); bit < 8; bit++ {
+				vals = append(vals, orig^1<<bit)
+			}
+			for _, v := range vals {
+				bad := append([]byte(nil), buf...)
+				bad[i] = v
+				if panicked, msg := panics(func() { test.dst().UnmarshalBinary(bad) }); panicked {
+					t.Errorf("%s: unexpected panic for byte %d set to %#x: %s", test.name, i, v, msg)
+				}
+				if panicked, msg := panics(func() { test.dst().UnmarshalBinaryFrom(bytes.NewReader(bad)) }); panicked {
+					t.Errorf("%s: unexpected panic reading byte %d set to %#x: %s", test.name, i, v, msg)
+				}
+			}
+		}
+
+		// Dimensions far larger than the data must be rejected.
+		for _, dims := range [][2]int64{
+			{1 << 20, 1 << 20},
+			{1 << 40, 1 << 40},
+			{math.MaxInt64, math.MaxInt64},
+			{-1, 2},
+		} {
+			bad := append([]byte(nil), buf...)
+			binary.LittleEndian.PutUint64(bad[8:], uint64(dims[0]))
+			binary.LittleEndian.PutUint64(bad[16:], uint64(dims[1]))
+			err := test.dst().UnmarshalBinary(bad)
+			if err == nil {
+				t.Errorf("%s: expected error for dimensions %v", test.name, dims)
+			}
+			_, err = test.dst().UnmarshalBinaryFrom(bytes.NewReader(bad))
+			if err == nil {
+				t.Errorf("%s: expected error reading dimensions %v", test.name, dims)
+			}
+		}
+	}
+}
+
 func BenchmarkMarshalDense10(b *testing.B)    { marshalBinaryBenchDense(b, 10) }
 func BenchmarkMarshalDense100(b *testing.B)   { marshalBinaryBenchDense(b, 100) }
 func BenchmarkMarshalDense1000(b *testing.B)  { marshalBinaryBenchDense(b, 1000) }
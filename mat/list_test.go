@@ -248,6 +248,8 @@ func legalDims(a Matrix, m, n int) bool {
 			return false
 		}
 		return n == 1
+	case *CSR, *CSC, *COO:
+		return m >= 0 && n >= 0
 	}
 }
 
@@ -327,6 +329,21 @@ func returnAs(a, t Matrix) Matrix {
 		case *Tridiag:
 			return mat
 		}
+	case *COO:
+		switch t.(type) {
+		default:
+			panic("bad type")
+		case *COO:
+			return mat
+		case *CSR:
+			var m CSR
+			m.CloneFrom(mat)
+			return &m
+		case *CSC:
+			var m CSC
+			m.CloneFrom(mat)
+			return &m
+		}
 	}
 }
 
@@ -550,6 +567,21 @@ func makeRandOf(a Matrix, m, n int, src rand.Source) Matrix {
 			}
 		}
 		rMatrix = returnAs(mat, t)
+	case *CSR, *CSC, *COO:
+		mat := &COO{}
+		if m != 0 && n != 0 {
+			mat = NewCOO(m, n, nil, nil, nil)
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				// Always fill the diagonal so that the
+				// matrix is almost surely full rank.
+				if i == j || rnd.Float64() < 0.5 {
+					mat.Append(i, j, rnd.NormFloat64())
+				}
+			}
+		}
+		rMatrix = returnAs(mat, t)
 	}
 	if mr, mc := rMatrix.Dims(); mr != m || mc != n {
 		panic(fmt.Sprintf("makeRandOf for %T returns wrong size: %d×%d != %d×%d", a, m, n, mr, mc))
@@ -746,6 +778,17 @@ func makeNaNOf(a Matrix, m, n int) Matrix {
 			mat.SetDiag(i, math.NaN())
 		}
 		rMatrix = returnAs(mat, t)
+	case *CSR, *CSC, *COO:
+		mat := &COO{}
+		if m != 0 && n != 0 {
+			mat = NewCOO(m, n, nil, nil, nil)
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				mat.Append(i, j, math.NaN())
+			}
+		}
+		rMatrix = returnAs(mat, t)
 	}
 	if mr, mc := rMatrix.Dims(); mr != m || mc != n {
 		panic(fmt.Sprintf("makeNaNOf for %T returns wrong size: %d×%d != %d×%d", a, m, n, mr, mc))
@@ -870,6 +913,18 @@ func makeCopyOf(a Matrix) Matrix {
 		var m Tridiag
 		m.CloneFromTridiag(a.(*Tridiag))
 		return returnAs(&m, t)
+	case *CSR:
+		var m CSR
+		m.CloneFrom(t)
+		return &m
+	case *CSC:
+		var m CSC
+		m.CloneFrom(t)
+		return &m
+	case *COO:
+		var m COO
+		m.CloneFrom(t)
+		return &m
 	}
 }
 
@@ -1084,6 +1139,13 @@ var testMatrices = []Matrix{
 	&Tridiag{},
 	Transpose{&Tridiag{}},
 	TransposeBand{&Tridiag{}},
+
+	&CSR{},
+	Transpose{&CSR{}},
+	&CSC{},
+	Transpose{&CSC{}},
+	&COO{},
+	Transpose{&COO{}},
 }
 
 var sizes = []struct {
@@ -437,3 +437,336 @@ func (lu *LU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
 		return nil
 	}
 }
+
+// SolveRefinedTo solves a system of linear equations using the LU decomposition
+// of the matrix A and improves the solution by iterative refinement. It computes
+//  A * X = B if trans == false
+//  Aᵀ * X = B if trans == true
+// In both cases, A is represented in LU factorized form, and the matrix X is
+// stored into dst.
+//
+// a must be the matrix that was factorized by the receiver. It is used to
+// compute the residuals of the solution.
+//
+// SolveRefinedTo returns for each column of X an estimated forward error bound
+// ferr and the componentwise relative backward error berr. ferr[j] bounds the
+// largest element of the difference between the j-th column of X and the true
+// solution relative to the largest element of that column of X. berr[j] is the
+// smallest relative change in any element of A or B that makes the j-th column
+// of X an exact solution.
+//
+// If A is singular or near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+// SolveRefinedTo will panic if the receiver does not contain a factorization.
+func (lu *LU) SolveRefinedTo(dst *Dense, trans bool, a, b Matrix) (ferr, berr []float64, err error) {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	_, n := lu.lu.Dims()
+	if r, c := a.Dims(); r != n || c != n {
+		panic(ErrShape)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if lu.Det() == 0 {
+		return nil, nil, Condition(math.Inf(1))
+	}
+
+	// Keep a copy of B since it is needed for computing the residuals and
+	// dst may share its storage.
+	bw := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bw)
+	bw.Copy(b)
+
+	var am blas64.General
+	if rm, ok := a.(RawMatrixer); ok {
+		am = rm.RawMatrix()
+		dst.reuseAsNonZeroed(n, bc)
+		dst.checkOverlap(am)
+	} else {
+		aw := getDenseWorkspace(n, n, false)
+		defer putDenseWorkspace(aw)
+		aw.Copy(a)
+		am = aw.mat
+	}
+
+	err = lu.SolveTo(dst, trans, bw)
+
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	ferr = make([]float64, bc)
+	berr = make([]float64, bc)
+	work := getFloat64s(3*n, false)
+	iwork := getInts(n, false)
+	lapack64.Gerfs(t, am, lu.lu.mat, lu.pivot, bw.mat, dst.mat, ferr, berr, work, iwork)
+	putFloat64s(work)
+	putInts(iwork)
+	return ferr, berr, err
+}
+
+// SolveVecRefinedTo solves a system of linear equations using the LU
+// decomposition of the matrix A and improves the solution by iterative
+// refinement. It computes
+//  A * x = b if trans == false
+//  Aᵀ * x = b if trans == true
+// In both cases, A is represented in LU factorized form, and the vector x is
+// stored into dst.
+//
+// a must be the matrix that was factorized by the receiver. SolveVecRefinedTo
+// returns the estimated forward error bound and the componentwise relative
+// backward error of x. See the documentation for SolveRefinedTo for more
+// information.
+//
+// If A is singular or near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+// SolveVecRefinedTo will panic if the receiver does not contain a factorization.
+func (lu *LU) SolveVecRefinedTo(dst *VecDense, trans bool, a Matrix, b Vector) (ferr, berr float64, err error) {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	_, n := lu.lu.Dims()
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(n)
+	fe, be, err := lu.SolveRefinedTo(dst.asDense(), trans, a, b)
+	if fe == nil {
+		return 0, 0, err
+	}
+	return fe[0], be[0], err
+}
+
+// BandLU is a type for creating and using the LU factorization of a band
+// matrix.
+type BandLU struct {
+	// lu holds the factors L and U in the band storage format used by
+	// lapack64.Gbtrf. It has KL sub-diagonals and KU = kl+ku super-diagonals,
+	// where kl and ku are the bandwidths of the factorized matrix. The
+	// additional kl super-diagonals store the fill-in of U caused by pivoting.
+	lu    blas64.Band
+	pivot []int
+	cond  float64
+}
+
+// Factorize computes the LU factorization of the square band matrix a and
+// stores the result. The LU decomposition will complete regardless of the
+// singularity of a.
+//
+// The LU factorization is computed with partial pivoting, and so really the
+// decomposition is a PLU decomposition where P is a permutation matrix.
+func (lu *BandLU) Factorize(a Banded) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	n := r
+	kl, ku := a.Bandwidth()
+	ldab := 2*kl + ku + 1
+	data := lu.lu.Data
+	if cap(data) < n*ldab {
+		data = make([]float64, n*ldab)
+	}
+	data = data[:n*ldab]
+	lu.lu = blas64.Band{
+		Rows:   n,
+		Cols:   n,
+		KL:     kl,
+		KU:     kl + ku,
+		Stride: ldab,
+		Data:   data,
+	}
+	// The elements A[i,j] of a and the elements of the factors are both stored
+	// in lu.lu.Data[i*ldab+kl+j-i].
+	if rb, ok := a.(RawBander); ok {
+		mat := rb.RawBand()
+		for i := 0; i < n; i++ {
+			for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+				data[i*ldab+kl+j-i] = mat.Data[i*mat.Stride+mat.KL+j-i]
+			}
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+				data[i*ldab+kl+j-i] = a.At(i, j)
+			}
+		}
+	}
+	if cap(lu.pivot) < n {
+		lu.pivot = make([]int, n)
+	}
+	lu.pivot = lu.pivot[:n]
+
+	orig := lu.lu
+	orig.KU = ku
+	anorm := lapack64.Langb(CondNorm, orig)
+	lapack64.Gbtrf(lu.lu, lu.pivot)
+
+	work := getFloat64s(3*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Gbcon(CondNorm, lu.lu, lu.pivot, anorm, work, iwork)
+	lu.cond = 1 / v
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *BandLU) isValid() bool {
+	return lu.lu.Rows > 0
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Cond() float64 {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	return lu.cond
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *BandLU) Reset() {
+	lu.lu.Rows = 0
+	lu.lu.Cols = 0
+	lu.lu.KL = 0
+	lu.lu.KU = 0
+	lu.lu.Stride = 0
+	lu.lu.Data = lu.lu.Data[:0]
+	lu.pivot = lu.pivot[:0]
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Det() float64 {
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (lu *BandLU) LogDet() (det float64, sign float64) {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n := lu.lu.Rows
+	logDiag := getFloat64s(n, false)
+	defer putFloat64s(logDiag)
+	sign = 1.0
+	for i := 0; i < n; i++ {
+		v := lu.lu.Data[i*lu.lu.Stride+lu.lu.KL]
+		if v < 0 {
+			sign *= -1
+		}
+		if lu.pivot[i] != i {
+			sign *= -1
+		}
+		logDiag[i] = math.Log(math.Abs(v))
+	}
+	return floats.Sum(logDiag), sign
+}
+
+// SolveTo solves a system of linear equations using the LU decomposition of a
+// band matrix. It computes
+//  A * X = B if trans == false
+//  Aᵀ * X = B if trans == true
+// In both cases, A is represented in LU factorized form, and the matrix X is
+// stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (lu *BandLU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n := lu.lu.Rows
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if lu.Det() == 0 {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	var restore func()
+	if dst == bU {
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+
+	dst.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack64.Gbtrs(t, lu.lu, dst.mat, lu.pivot)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations using the LU decomposition of
+// a band matrix. It computes
+//  A * x = b if trans == false
+//  Aᵀ * x = b if trans == true
+// In both cases, A is represented in LU factorized form, and the vector x is
+// stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (lu *BandLU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n := lu.lu.Rows
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	switch rv := b.(type) {
+	default:
+		dst.reuseAsNonZeroed(n)
+		return lu.SolveTo(dst.asDense(), trans, b)
+	case RawVectorer:
+		if dst != b {
+			dst.checkOverlap(rv.RawVector())
+		}
+		if lu.Det() == 0 {
+			return Condition(math.Inf(1))
+		}
+
+		dst.reuseAsNonZeroed(n)
+		var restore func()
+		if dst == b {
+			dst, restore = dst.isolatedWorkspace(b)
+			defer restore()
+		}
+		dst.CopyVec(b)
+		t := blas.NoTrans
+		if trans {
+			t = blas.Trans
+		}
+		lapack64.Gbtrs(t, lu.lu, dst.asGeneral(), lu.pivot)
+		if lu.cond > ConditionTolerance {
+			return Condition(lu.cond)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack32"
+)
+
+const badLU32 = "mat: invalid LU32 factorization"
+
+// LU32 is a type for creating and using the LU factorization of a single
+// precision matrix.
+type LU32 struct {
+	lu    *Dense32
+	pivot []int
+}
+
+// Factorize computes the LU factorization of the square matrix a and stores the
+// result. The LU decomposition will complete regardless of the singularity of a.
+//
+// The LU factorization is computed with pivoting, and so really the decomposition
+// is a PLU decomposition where P is a permutation matrix. The individual matrix
+// factors can be extracted from the factorization using the LU32.Pivot,
+// LU32.LTo and LU32.UTo methods.
+func (lu *LU32) Factorize(a Matrix32) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	if lu.lu == nil {
+		lu.lu = NewDense32(r, r, nil)
+	} else {
+		lu.lu.Reset()
+		lu.lu.reuseAsNonZeroed(r, r)
+	}
+	lu.lu.Copy(a)
+	if cap(lu.pivot) < r {
+		lu.pivot = make([]int, r)
+	}
+	lu.pivot = lu.pivot[:r]
+	lapack32.Getrf(lu.lu.mat, lu.pivot)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *LU32) isValid() bool {
+	return lu.lu != nil && !lu.lu.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *LU32) Reset() {
+	if lu.lu != nil {
+		lu.lu.Reset()
+	}
+	lu.pivot = lu.pivot[:0]
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable. The determinant
+// is computed in double precision.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *LU32) Det() float64 {
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (lu *LU32) LogDet() (det float64, sign float64) {
+	if !lu.isValid() {
+		panic(badLU32)
+	}
+
+	_, n := lu.lu.Dims()
+	sign = 1.0
+	for i := 0; i < n; i++ {
+		v := float64(lu.lu.at(i, i))
+		if v < 0 {
+			sign *= -1
+		}
+		if lu.pivot[i] != i {
+			sign *= -1
+		}
+		det += math.Log(math.Abs(v))
+	}
+	return det, sign
+}
+
+// Pivot returns pivot indices that enable the construction of the permutation
+// matrix P (see Dense.Permutation). If swaps == nil, then new memory will be
+// allocated, otherwise the length of the input must be equal to the size of the
+// factorized matrix.
+// Pivot will panic if the receiver does not contain a factorization.
+func (lu *LU32) Pivot(swaps []int) []int {
+	if !lu.isValid() {
+		panic(badLU32)
+	}
+
+	_, n := lu.lu.Dims()
+	if swaps == nil {
+		swaps = make([]int, n)
+	}
+	if len(swaps) != n {
+		panic(badSliceLength)
+	}
+	// Perform the inverse of the row swaps in order to find the final
+	// row swap position.
+	for i := range swaps {
+		swaps[i] = i
+	}
+	for i := n - 1; i >= 0; i-- {
+		v := lu.pivot[i]
+		swaps[i], swaps[v] = swaps[v], swaps[i]
+	}
+	return swaps
+}
+
+// LTo extracts the unit lower triangular matrix from an LU factorization.
+//
+// If dst is empty, LTo will resize dst to be n×n. When dst is non-empty,
+// LTo will panic if dst is not n×n. LTo will also panic if the receiver
+// does not contain a factorization.
+func (lu *LU32) LTo(dst *Dense32) {
+	if !lu.isValid() {
+		panic(badLU32)
+	}
+
+	_, n := lu.lu.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+	// Extract the lower triangular elements and set ones on the diagonal.
+	for i := 0; i < n; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride:i*dst.mat.Stride+i], lu.lu.mat.Data[i*lu.lu.mat.Stride:])
+		dst.mat.Data[i*dst.mat.Stride+i] = 1
+	}
+}
+
+// UTo extracts the upper triangular matrix from an LU factorization.
+//
+// If dst is empty, UTo will resize dst to be n×n. When dst is non-empty,
+// UTo will panic if dst is not n×n. UTo will also panic if the receiver
+// does not contain a factorization.
+func (lu *LU32) UTo(dst *Dense32) {
+	if !lu.isValid() {
+		panic(badLU32)
+	}
+
+	_, n := lu.lu.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+	// Extract the upper triangular elements.
+	for i := 0; i < n; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+n], lu.lu.mat.Data[i*lu.lu.mat.Stride+i:])
+	}
+}
+
+// SolveTo solves a system of linear equations using the LU decomposition of a matrix.
+// It computes
+//  A * X = B if trans == false
+//  Aᵀ * X = B if trans == true
+// In both cases, A is represented in LU factorized form, and the matrix X is
+// stored into dst.
+//
+// If A is exactly singular a Condition error is returned. See the documentation
+// for Condition for more information.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (lu *LU32) SolveTo(dst *Dense32, trans bool, b Matrix32) error {
+	if !lu.isValid() {
+		panic(badLU32)
+	}
+
+	_, n := lu.lu.Dims()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	for i := 0; i < n; i++ {
+		if lu.lu.at(i, i) == 0 {
+			return Condition(math.Inf(1))
+		}
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose32(b)
+	var restore func()
+	if dst == bU {
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else {
+		dst.checkOverlapMatrix(bU)
+	}
+
+	dst.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack32.Getrs(t, lu.lu.mat, dst.mat, lu.pivot)
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations using the LU decomposition
+// of a matrix. It computes
+//  A * x = b if trans == false
+//  Aᵀ * x = b if trans == true
+// In both cases, A is represented in LU factorized form, and the vector x is
+// stored into dst.
+//
+// If A is exactly singular a Condition error is returned. See the documentation
+// for Condition for more information.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (lu *LU32) SolveVecTo(dst *VecDense32, trans bool, b Vector32) error {
+	if !lu.isValid() {
+		panic(badLU32)
+	}
+
+	_, n := lu.lu.Dims()
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(n)
+	d := dst.asDense32()
+	if dst == b {
+		return lu.SolveTo(d, trans, d)
+	}
+	return lu.SolveTo(d, trans, b)
+}
@@ -5,6 +5,8 @@
 package mat
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
 	"golang.org/x/exp/rand"
@@ -198,3 +200,310 @@ func TestLUSolveVecTo(t *testing.T) {
 	}
 	// TODO(btracey): Add testOneInput test when such a function exists.
 }
+
+func TestLUSolveRefinedTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		for _, bc := range []int{1, 3} {
+			for _, trans := range []bool{false, true} {
+				for _, kind := range []string{"random", "hilbert"} {
+					if kind == "hilbert" && n > 10 {
+						continue
+					}
+					var a *Dense
+					if kind == "random" {
+						a = NewDense(n, n, nil)
+						for i := 0; i < n; i++ {
+							for j := 0; j < n; j++ {
+								a.Set(i, j, rnd.NormFloat64())
+							}
+						}
+					} else {
+						a = hilbertDense(n)
+					}
+					want := NewDense(n, bc, nil)
+					for i := 0; i < n; i++ {
+						for j := 0; j < bc; j++ {
+							want.Set(i, j, rnd.NormFloat64())
+						}
+					}
+					var b Dense
+					if trans {
+						b.Mul(a.T(), want)
+					} else {
+						b.Mul(a, want)
+					}
+
+					name := fmt.Sprintf("n=%v,bc=%v,trans=%v,kind=%v", n, bc, trans, kind)
+
+					var lu LU
+					lu.Factorize(a)
+					// Pass A as a non-RawMatrixer to exercise the copying path
+					// for half of the cases.
+					var am Matrix = a
+					if bc == 3 {
+						am = asBasicMatrix(a)
+					}
+					var x Dense
+					ferr, berr, err := lu.SolveRefinedTo(&x, trans, am, &b)
+					if err != nil {
+						t.Errorf("%v: unexpected error: %v", name, err)
+						continue
+					}
+					checkRefinedErrors(t, name, &x, want, ferr, berr)
+
+					// Check that solving in place gives the same result.
+					bCopy := DenseCopyOf(&b)
+					_, _, err = lu.SolveRefinedTo(bCopy, trans, am, bCopy)
+					if err != nil {
+						t.Errorf("%v: unexpected error in place: %v", name, err)
+						continue
+					}
+					if !Equal(bCopy, &x) {
+						t.Errorf("%v: in-place solution mismatch", name)
+					}
+
+					var xvec VecDense
+					fe, be, err := lu.SolveVecRefinedTo(&xvec, trans, am, b.ColView(0))
+					if err != nil {
+						t.Errorf("%v: unexpected error from SolveVecRefinedTo: %v", name, err)
+						continue
+					}
+					if !Equal(&xvec, x.ColView(0)) {
+						t.Errorf("%v: vector solution mismatch", name)
+					}
+					if fe != ferr[0] || be != berr[0] {
+						t.Errorf("%v: vector error bounds mismatch: got (%v,%v), want (%v,%v)", name, fe, be, ferr[0], berr[0])
+					}
+				}
+			}
+		}
+	}
+
+	// Check that a singular matrix is reported.
+	a := NewDense(2, 2, []float64{1, 2, 2, 4})
+	var lu LU
+	lu.Factorize(a)
+	var x Dense
+	_, _, err := lu.SolveRefinedTo(&x, false, a, NewDense(2, 1, []float64{1, 1}))
+	if err == nil {
+		t.Error("no error for singular matrix in refined solve")
+	}
+}
+
+// hilbertDense returns the n×n Hilbert matrix.
+func hilbertDense(n int) *Dense {
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	return a
+}
+
+// checkRefinedErrors checks that the backward errors berr of the refined
+// solution x are of the order of machine precision and that the forward error
+// bounds ferr are not smaller than the actual errors with respect to the exact
+// solution want.
+func checkRefinedErrors(t *testing.T, name string, x, want *Dense, ferr, berr []float64) {
+	t.Helper()
+	n, bc := x.Dims()
+	if len(ferr) != bc || len(berr) != bc {
+		t.Errorf("%v: unexpected length of error bounds", name)
+		return
+	}
+	for j := 0; j < bc; j++ {
+		if berr[j] > float64(n+1)*1e-15 {
+			t.Errorf("%v: backward error for column %v too large: %v", name, j, berr[j])
+		}
+		var diff, xmax float64
+		for i := 0; i < n; i++ {
+			diff = math.Max(diff, math.Abs(x.At(i, j)-want.At(i, j)))
+			xmax = math.Max(xmax, math.Abs(x.At(i, j)))
+		}
+		if diff/xmax > ferr[j] {
+			t.Errorf("%v: forward error bound for column %v too small: ferr=%v, actual=%v", name, j, ferr[j], diff/xmax)
+		}
+	}
+}
+
+func randBandDense(n, kl, ku int, rnd *rand.Rand) *BandDense {
+	a := NewBandDense(n, n, kl, ku, nil)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			a.SetBand(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// bandLUResidual returns the scaled residual of the solution x of
+//  A * X = B if trans == false
+//  Aᵀ * X = B if trans == true
+// computed as |op(A)*X - B|_1 / (|A|_1 * |X|_1). The random band matrices
+// used in the tests may be ill-conditioned, so the residual is a more reliable
+// measure of the accuracy of the solution than the error in X.
+func bandLUResidual(a *BandDense, x, b Matrix, trans bool) float64 {
+	var op Matrix = a
+	if trans {
+		op = a.T()
+	}
+	var resid Dense
+	resid.Mul(op, x)
+	resid.Sub(&resid, b)
+	return Norm(&resid, 1) / (Norm(op, 1) * Norm(x, 1))
+}
+
+func TestBandLUDetCond(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 50} {
+		for _, kl := range []int{0, 1, n / 2, n - 1} {
+			for _, ku := range []int{0, 1, n / 2, n - 1} {
+				kl := min(kl, n-1)
+				ku := min(ku, n-1)
+				a := randBandDense(n, kl, ku, rnd)
+
+				var want LU
+				want.Factorize(a)
+
+				for _, typ := range []Banded{a, (*basicBanded)(a)} {
+					name := fmt.Sprintf("n=%d,kl=%d,ku=%d,type=%T", n, kl, ku, typ)
+
+					var lu BandLU
+					lu.Factorize(typ)
+
+					det, sign := lu.LogDet()
+					detWant, signWant := want.LogDet()
+					if sign != signWant || math.Abs(det-detWant) > tol*math.Max(1, math.Abs(detWant)) {
+						t.Errorf("%v: unexpected LogDet: got (%v,%v), want (%v,%v)", name, det, sign, detWant, signWant)
+					}
+					if d, dWant := lu.Det(), want.Det(); math.Abs(d-dWant) > tol*math.Max(1, math.Abs(dWant)) {
+						t.Errorf("%v: unexpected Det: got %v, want %v", name, d, dWant)
+					}
+					if c, cWant := lu.Cond(), want.Cond(); math.Abs(c-cWant) > tol*cWant {
+						t.Errorf("%v: unexpected Cond: got %v, want %v", name, c, cWant)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBandLUSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 50} {
+		for _, kl := range []int{0, 1, n / 2, n - 1} {
+			for _, ku := range []int{0, 1, n / 2, n - 1} {
+				for _, bc := range []int{1, 3} {
+					kl := min(kl, n-1)
+					ku := min(ku, n-1)
+					a := randBandDense(n, kl, ku, rnd)
+					b := NewDense(n, bc, nil)
+					for i := 0; i < n; i++ {
+						for j := 0; j < bc; j++ {
+							b.Set(i, j, rnd.NormFloat64())
+						}
+					}
+
+					for _, typ := range []Banded{a, (*basicBanded)(a)} {
+						for _, trans := range []bool{false, true} {
+							name := fmt.Sprintf("n=%d,kl=%d,ku=%d,bc=%d,type=%T,trans=%t", n, kl, ku, bc, typ, trans)
+
+							var lu BandLU
+							lu.Factorize(typ)
+							var x Dense
+							if err := lu.SolveTo(&x, trans, b); err != nil {
+								continue
+							}
+							if resid := bandLUResidual(a, &x, b, trans); resid > tol {
+								t.Errorf("%v: SolveTo mismatch for non-singular matrix, resid=%v", name, resid)
+							}
+
+							x.Copy(b)
+							if err := lu.SolveTo(&x, trans, &x); err != nil {
+								t.Errorf("%v: unexpected error from SolveTo when dst==b: %v", name, err)
+								continue
+							}
+							if resid := bandLUResidual(a, &x, b, trans); resid > tol {
+								t.Errorf("%v: SolveTo mismatch for non-singular matrix when dst==b, resid=%v", name, resid)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBandLUSolveToCond(t *testing.T) {
+	t.Parallel()
+	for _, test := range []*BandDense{
+		NewBandDense(2, 2, 1, 0, []float64{0, 1, 0, 1e-20}),
+		NewBandDense(3, 3, 1, 1, []float64{0, 1, 1, 1, 1, 0, 0, 0, 0}),
+	} {
+		m, _ := test.Dims()
+		var lu BandLU
+		lu.Factorize(test)
+		b := NewDense(m, 2, nil)
+		var x Dense
+		if err := lu.SolveTo(&x, false, b); err == nil {
+			t.Error("No error for near-singular matrix in matrix solve.")
+		}
+
+		bvec := NewVecDense(m, nil)
+		var xvec VecDense
+		if err := lu.SolveVecTo(&xvec, false, bvec); err == nil {
+			t.Error("No error for near-singular matrix in matrix solve.")
+		}
+	}
+}
+
+func TestBandLUSolveVecTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 50} {
+		for _, kl := range []int{0, 1, n / 2, n - 1} {
+			for _, ku := range []int{0, 1, n / 2, n - 1} {
+				kl := min(kl, n-1)
+				ku := min(ku, n-1)
+				a := randBandDense(n, kl, ku, rnd)
+				b := NewVecDense(n, nil)
+				for i := 0; i < n; i++ {
+					b.SetVec(i, rnd.NormFloat64())
+				}
+
+				for _, typ := range []Banded{a, (*basicBanded)(a)} {
+					for _, trans := range []bool{false, true} {
+						name := fmt.Sprintf("n=%d,kl=%d,ku=%d,type=%T,trans=%t", n, kl, ku, typ, trans)
+
+						var lu BandLU
+						lu.Factorize(typ)
+						var x VecDense
+						if err := lu.SolveVecTo(&x, trans, b); err != nil {
+							continue
+						}
+						if resid := bandLUResidual(a, &x, b, trans); resid > tol {
+							t.Errorf("%v: SolveVecTo mismatch for non-singular matrix, resid=%v", name, resid)
+						}
+
+						x.CopyVec(b)
+						if err := lu.SolveVecTo(&x, trans, &x); err != nil {
+							t.Errorf("%v: unexpected error from SolveVecTo when dst==b: %v", name, err)
+							continue
+						}
+						if resid := bandLUResidual(a, &x, b, trans); resid > tol {
+							t.Errorf("%v: SolveVecTo mismatch for non-singular matrix when dst==b, resid=%v", name, resid)
+						}
+					}
+				}
+			}
+		}
+	}
+}
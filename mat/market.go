@@ -0,0 +1,580 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/cmplx"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MarketFormat specifies the storage format of a Matrix Market file.
+type MarketFormat byte
+
+const (
+	// MarketArray stores all elements of a matrix in column-major order.
+	MarketArray MarketFormat = 'A'
+	// MarketCoordinate stores the non-zero elements of a matrix together
+	// with their row and column indices.
+	MarketCoordinate MarketFormat = 'C'
+)
+
+const marketBanner = "%%MatrixMarket"
+
+var (
+	errMarketHeader = errors.New("mat: invalid Matrix Market header")
+	errMarketFormat = errors.New("mat: invalid Matrix Market format")
+	errMarketData   = errors.New("mat: invalid Matrix Market data")
+)
+
+// WriteMatrixMarket writes the matrix a to w in the Matrix Market exchange
+// format described at https://math.nist.gov/MatrixMarket/formats.html.
+//
+// If a implements Symmetric, only its lower triangle is written and the
+// symmetry of the file is symmetric, otherwise it is general. In the
+// MarketCoordinate format only the non-zero elements are written. If a
+// implements NonZeroDoer, as the sparse matrix types do, the elements are
+// obtained with DoNonZero, otherwise for a Banded or Triangular matrix only the
+// elements within its band or triangle are considered.
+func WriteMatrixMarket(w io.Writer, a Matrix, format MarketFormat) error {
+	if format != MarketArray && format != MarketCoordinate {
+		return errMarketFormat
+	}
+	r, c := a.Dims()
+	symmetry := "general"
+	_, isSym := a.(Symmetric)
+	if isSym {
+		symmetry = "symmetric"
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s matrix %s real %s\n", marketBanner, marketFormatString(format), symmetry)
+
+	if format == MarketArray {
+		fmt.Fprintf(bw, "%d %d\n", r, c)
+		for j := 0; j < c; j++ {
+			i0 := 0
+			if isSym {
+				i0 = j
+			}
+			for i := i0; i < r; i++ {
+				bw.WriteString(formatMarketFloat(a.At(i, j)))
+				bw.WriteByte('\n')
+			}
+		}
+		return bw.Flush()
+	}
+
+	rows, cols, vals := marketEntries(a, isSym)
+	fmt.Fprintf(bw, "%d %d %d\n", r, c, len(vals))
+	for k, v := range vals {
+		fmt.Fprintf(bw, "%d %d %s\n", rows[k]+1, cols[k]+1, formatMarketFloat(v))
+	}
+	return bw.Flush()
+}
+
+// WriteCMatrixMarket writes the complex matrix a to w in the Matrix Market
+// exchange format. The symmetry of the file is always general. In the
+// MarketCoordinate format only the non-zero elements are written.
+func WriteCMatrixMarket(w io.Writer, a CMatrix, format MarketFormat) error {
+	if format != MarketArray && format != MarketCoordinate {
+		return errMarketFormat
+	}
+	r, c := a.Dims()
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s matrix %s complex general\n", marketBanner, marketFormatString(format))
+
+	if format == MarketArray {
+		fmt.Fprintf(bw, "%d %d\n", r, c)
+		for j := 0; j < c; j++ {
+			for i := 0; i < r; i++ {
+				v := a.At(i, j)
+				fmt.Fprintf(bw, "%s %s\n", formatMarketFloat(real(v)), formatMarketFloat(imag(v)))
+			}
+		}
+		return bw.Flush()
+	}
+
+	// Collect the non-zero elements in column-major order so that the number
+	// of entries is known before writing them.
+	var rows, cols []int
+	var vals []complex128
+	for j := 0; j < c; j++ {
+		for i := 0; i < r; i++ {
+			v := a.At(i, j)
+			if v == 0 {
+				continue
+			}
+			rows = append(rows, i)
+			cols = append(cols, j)
+			vals = append(vals, v)
+		}
+	}
+	fmt.Fprintf(bw, "%d %d %d\n", r, c, len(vals))
+	for k, v := range vals {
+		fmt.Fprintf(bw, "%d %d %s %s\n", rows[k]+1, cols[k]+1, formatMarketFloat(real(v)), formatMarketFloat(imag(v)))
+	}
+	return bw.Flush()
+}
+
+// ReadMatrixMarket reads a real matrix in the Matrix Market exchange format
+// from r. Files with real, integer and pattern fields are accepted, pattern
+// entries are read as 1.
+//
+// ReadMatrixMarket returns a *COO for files in the coordinate format, with
+// both triangles stored explicitly for symmetric and skew-symmetric files. The
+// result can be converted for computation with CSR.CloneFrom or CSC.CloneFrom.
+// For files in the array format, ReadMatrixMarket returns a *SymDense if the
+// symmetry of the file is symmetric and a *Dense otherwise. Skew-symmetric
+// matrices are expanded into a *Dense. The result can be copied into a
+// structured type, for example with TriDense.Copy or BandDense.Copy.
+func ReadMatrixMarket(r io.Reader) (Matrix, error) {
+	h, sc, err := readMarketHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if h.field == "complex" {
+		return nil, errWrongType
+	}
+	if h.symmetry == "hermitian" {
+		return nil, errMarketHeader
+	}
+	if h.symmetry != "general" && h.rows != h.cols {
+		return nil, errMarketData
+	}
+
+	if h.format == MarketCoordinate {
+		m := &COO{r: h.rows, c: h.cols}
+		err = readMarketEntries(sc, h, func(i, j int, v complex128) {
+			m.append(i, j, real(v))
+			if i == j {
+				return
+			}
+			switch h.symmetry {
+			case "symmetric":
+				m.append(j, i, real(v))
+			case "skew-symmetric":
+				m.append(j, i, -real(v))
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	// Read the values before allocating the matrix so that the allocation
+	// is bounded by the size of the input.
+	var vals []float64
+	err = readMarketEntries(sc, h, func(_, _ int, v complex128) {
+		vals = append(vals, real(v))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if h.symmetry == "symmetric" {
+		s := NewSymDense(h.rows, nil)
+		doMarketArray(h, func(i, j, k int) {
+			s.SetSym(i, j, vals[k])
+		})
+		return s, nil
+	}
+	m := NewDense(h.rows, h.cols, nil)
+	doMarketArray(h, func(i, j, k int) {
+		m.set(i, j, vals[k])
+		if h.symmetry == "skew-symmetric" {
+			m.set(j, i, -vals[k])
+		}
+	})
+	return m, nil
+}
+
+// maxMarketDense is the largest number of elements of the dense matrix
+// returned by ReadCMatrixMarket for a file in the coordinate format.
+const maxMarketDense = 1 << 27
+
+// ReadCMatrixMarket reads a complex matrix in the Matrix Market exchange
+// format from r and returns it as a *CDense. Files with real, integer and
+// pattern fields are accepted and converted to complex values, and symmetric,
+// skew-symmetric and Hermitian matrices are expanded.
+//
+// Since the result is dense, ReadCMatrixMarket returns an error for files in
+// the coordinate format that describe a matrix with more than 2^27 elements.
+func ReadCMatrixMarket(r io.Reader) (*CDense, error) {
+	h, sc, err := readMarketHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if h.symmetry != "general" && h.rows != h.cols {
+		return nil, errMarketData
+	}
+
+	var m *CDense
+	set := func(i, j int, v complex128) {
+		m.set(i, j, v)
+		switch h.symmetry {
+		case "symmetric":
+			m.set(j, i, v)
+		case "skew-symmetric":
+			m.set(j, i, -v)
+		case "hermitian":
+			m.set(j, i, cmplx.Conj(v))
+		}
+	}
+	if h.format == MarketCoordinate {
+		if h.rows > maxMarketDense/h.cols {
+			return nil, errTooBig
+		}
+		m = NewCDense(h.rows, h.cols, nil)
+		err = readMarketEntries(sc, h, set)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	// Read the values before allocating the matrix so that the allocation
+	// is bounded by the size of the input.
+	var vals []complex128
+	err = readMarketEntries(sc, h, func(_, _ int, v complex128) {
+		vals = append(vals, v)
+	})
+	if err != nil {
+		return nil, err
+	}
+	m = NewCDense(h.rows, h.cols, nil)
+	doMarketArray(h, func(i, j, k int) {
+		set(i, j, vals[k])
+	})
+	return m, nil
+}
+
+// marketHeader holds the banner and size information of a Matrix Market file.
+type marketHeader struct {
+	format   MarketFormat
+	field    string
+	symmetry string
+
+	rows, cols, nnz int
+}
+
+// readMarketHeader reads the banner, comments and size line of a Matrix Market
+// file from r. It returns the header and the scanner positioned at the first
+// data line.
+func readMarketHeader(r io.Reader) (marketHeader, *bufio.Scanner, error) {
+	var h marketHeader
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	if !sc.Scan() {
+		if err := sc.Err(); err != nil {
+			return h, nil, err
+		}
+		return h, nil, io.ErrUnexpectedEOF
+	}
+	banner := strings.Fields(strings.ToLower(sc.Text()))
+	if len(banner) != 5 || banner[0] != strings.ToLower(marketBanner) || banner[1] != "matrix" {
+		return h, nil, errMarketHeader
+	}
+	switch banner[2] {
+	case "array":
+		h.format = MarketArray
+	case "coordinate":
+		h.format = MarketCoordinate
+	default:
+		return h, nil, errMarketHeader
+	}
+	h.field = banner[3]
+	switch h.field {
+	case "real", "integer", "complex":
+	case "pattern":
+		if h.format == MarketArray {
+			return h, nil, errMarketHeader
+		}
+	default:
+		return h, nil, errMarketHeader
+	}
+	h.symmetry = banner[4]
+	switch h.symmetry {
+	case "general", "symmetric", "skew-symmetric":
+	case "hermitian":
+		if h.field != "complex" {
+			return h, nil, errMarketHeader
+		}
+	default:
+		return h, nil, errMarketHeader
+	}
+
+	fields, err := nextMarketLine(sc)
+	if err != nil {
+		return h, nil, err
+	}
+	want := 2
+	if h.format == MarketCoordinate {
+		want = 3
+	}
+	if len(fields) != want {
+		return h, nil, errMarketData
+	}
+	var size [3]int
+	for k, f := range fields {
+		size[k], err = strconv.Atoi(f)
+		if err != nil || size[k] < 0 {
+			return h, nil, errMarketData
+		}
+	}
+	h.rows, h.cols, h.nnz = size[0], size[1], size[2]
+	if h.rows == 0 || h.cols == 0 {
+		return h, nil, ErrZeroLength
+	}
+	if h.format == MarketArray && h.rows > int(maxLen)/h.cols {
+		return h, nil, errTooBig
+	}
+	return h, sc, nil
+}
+
+// readMarketEntries reads the data lines of a Matrix Market file described by
+// h from sc and calls set for each entry with zero-based indices.
+func readMarketEntries(sc *bufio.Scanner, h marketHeader, set func(i, j int, v complex128)) error {
+	nvals := 1
+	switch h.field {
+	case "complex":
+		nvals = 2
+	case "pattern":
+		nvals = 0
+	}
+
+	if h.format == MarketCoordinate {
+		for k := 0; k < h.nnz; k++ {
+			fields, err := nextMarketLine(sc)
+			if err != nil {
+				return err
+			}
+			if len(fields) != 2+nvals {
+				return errMarketData
+			}
+			i, err := strconv.Atoi(fields[0])
+			if err != nil || i < 1 || h.rows < i {
+				return errMarketData
+			}
+			j, err := strconv.Atoi(fields[1])
+			if err != nil || j < 1 || h.cols < j {
+				return errMarketData
+			}
+			v, err := parseMarketValue(fields[2:], nvals)
+			if err != nil {
+				return err
+			}
+			if !validMarketEntry(h.symmetry, i-1, j-1) {
+				return errMarketData
+			}
+			set(i-1, j-1, v)
+		}
+		return nil
+	}
+
+	var err error
+	doMarketArray(h, func(i, j, _ int) {
+		if err != nil {
+			return
+		}
+		var fields []string
+		fields, err = nextMarketLine(sc)
+		if err != nil {
+			return
+		}
+		if len(fields) != nvals {
+			err = errMarketData
+			return
+		}
+		var v complex128
+		v, err = parseMarketValue(fields, nvals)
+		if err != nil {
+			return
+		}
+		set(i, j, v)
+	})
+	return err
+}
+
+// doMarketArray calls fn with the indices of each entry stored in a file in
+// the array format described by h, in the order of storage. The index k of
+// the entry in the file is passed along with the row and column indices.
+func doMarketArray(h marketHeader, fn func(i, j, k int)) {
+	var k int
+	for j := 0; j < h.cols; j++ {
+		i0 := 0
+		switch h.symmetry {
+		case "symmetric", "hermitian":
+			i0 = j
+		case "skew-symmetric":
+			i0 = j + 1
+		}
+		for i := i0; i < h.rows; i++ {
+			fn(i, j, k)
+			k++
+		}
+	}
+}
+
+// validMarketEntry returns whether the entry (i, j) lies in the stored lower
+// triangle of a matrix with the given symmetry.
+func validMarketEntry(symmetry string, i, j int) bool {
+	switch symmetry {
+	case "symmetric", "hermitian":
+		return i >= j
+	case "skew-symmetric":
+		return i > j
+	}
+	return true
+}
+
+// parseMarketValue parses the nvals fields of an entry as a complex value.
+func parseMarketValue(fields []string, nvals int) (complex128, error) {
+	switch nvals {
+	case 0:
+		return 1, nil
+	case 1:
+		re, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, errMarketData
+		}
+		return complex(re, 0), nil
+	default:
+		re, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, errMarketData
+		}
+		im, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return 0, errMarketData
+		}
+		return complex(re, im), nil
+	}
+}
+
+// nextMarketLine returns the fields of the next line in sc that is neither
+// empty nor a comment.
+func nextMarketLine(sc *bufio.Scanner) ([]string, error) {
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "%") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		return fields, nil
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+// marketStructure returns the number of sub- and super-diagonals of a that
+// may hold non-zero elements.
+// marketEntries returns the non-zero elements of a in column-major order. If
+// lower is true, only the elements of the lower triangle are returned.
+func marketEntries(a Matrix, lower bool) (rows, cols []int, vals []float64) {
+	aU, _ := untranspose(a)
+	if _, ok := aU.(NonZeroDoer); ok {
+		rows, cols, vals = nonZeroEntries(a)
+		sort.Sort(marketTriplets{rows: rows, cols: cols, vals: vals})
+
+		// Sum duplicates and remove zero and upper triangular
+		// elements, compacting the storage as we go.
+		var n int
+		for k := range vals {
+			if n > 0 && rows[n-1] == rows[k] && cols[n-1] == cols[k] {
+				vals[n-1] += vals[k]
+				continue
+			}
+			if n > 0 && vals[n-1] == 0 {
+				n--
+			}
+			if lower && rows[k] < cols[k] {
+				continue
+			}
+			rows[n] = rows[k]
+			cols[n] = cols[k]
+			vals[n] = vals[k]
+			n++
+		}
+		if n > 0 && vals[n-1] == 0 {
+			n--
+		}
+		return rows[:n], cols[:n], vals[:n]
+	}
+
+	r, c := a.Dims()
+	lo, hi := marketStructure(a)
+	for j := 0; j < c; j++ {
+		i0 := max(0, j-hi)
+		if lower {
+			i0 = j
+		}
+		i1 := min(r, j+lo+1)
+		for i := i0; i < i1; i++ {
+			v := a.At(i, j)
+			if v == 0 {
+				continue
+			}
+			rows = append(rows, i)
+			cols = append(cols, j)
+			vals = append(vals, v)
+		}
+	}
+	return rows, cols, vals
+}
+
+// marketTriplets sorts matrix elements into column-major order.
+type marketTriplets struct {
+	rows, cols []int
+	vals       []float64
+}
+
+func (t marketTriplets) Len() int { return len(t.vals) }
+func (t marketTriplets) Less(i, j int) bool {
+	if t.cols[i] != t.cols[j] {
+		return t.cols[i] < t.cols[j]
+	}
+	return t.rows[i] < t.rows[j]
+}
+func (t marketTriplets) Swap(i, j int) {
+	t.rows[i], t.rows[j] = t.rows[j], t.rows[i]
+	t.cols[i], t.cols[j] = t.cols[j], t.cols[i]
+	t.vals[i], t.vals[j] = t.vals[j], t.vals[i]
+}
+
+func marketStructure(a Matrix) (lo, hi int) {
+	r, c := a.Dims()
+	switch t := a.(type) {
+	case Banded:
+		return t.Bandwidth()
+	case Triangular:
+		_, kind := t.Triangle()
+		if kind == Upper {
+			return 0, c
+		}
+		return r, 0
+	}
+	return r, c
+}
+
+func marketFormatString(format MarketFormat) string {
+	if format == MarketArray {
+		return "array"
+	}
+	return "coordinate"
+}
+
+func formatMarketFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,414 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name    string
+		a       Matrix
+		wantSym bool
+	}{
+		{name: "Dense", a: randNormalDense(4, 3, rnd)},
+		{name: "SymDense", a: randSymDense(5, rnd), wantSym: true},
+		{name: "TriDenseUpper", a: NewTriDense(3, Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6})},
+		{name: "TriDenseLower", a: NewTriDense(3, Lower, []float64{1, 0, 0, 2, 3, 0, 4, 5, 6})},
+		{name: "BandDense", a: NewBandDense(4, 5, 1, 2, []float64{
+			-1, 1, 2, 3,
+			4, 5, 6, 7,
+			8, 9, 10, 11,
+			12, 13, 14, -1,
+		})},
+		{name: "SymBandDense", a: NewSymBandDense(4, 1, []float64{1, 2, 3, 4, 5, 6, 7, -1}), wantSym: true},
+		{name: "DiagDense", a: NewDiagDense(3, []float64{1, 0, -2}), wantSym: true},
+		{name: "Special", a: NewDense(1, 3, []float64{1e-300, -0.1, 1.0 / 3})},
+	} {
+		for _, format := range []MarketFormat{MarketArray, MarketCoordinate} {
+			var buf bytes.Buffer
+			err := WriteMatrixMarket(&buf, test.a, format)
+			if err != nil {
+				t.Fatalf("%s, format %c: unexpected error writing: %v", test.name, format, err)
+			}
+			got, err := ReadMatrixMarket(&buf)
+			if err != nil {
+				t.Errorf("%s, format %c: unexpected error reading: %v", test.name, format, err)
+				continue
+			}
+			switch got.(type) {
+			case *COO:
+				if format != MarketCoordinate {
+					t.Errorf("%s, format %c: unexpected type %T", test.name, format, got)
+				}
+			case *SymDense:
+				if format != MarketArray || !test.wantSym {
+					t.Errorf("%s, format %c: unexpected type %T", test.name, format, got)
+				}
+			case *Dense:
+				if format != MarketArray || test.wantSym {
+					t.Errorf("%s, format %c: unexpected type %T", test.name, format, got)
+				}
+			default:
+				t.Errorf("%s, format %c: unexpected type %T", test.name, format, got)
+			}
+			if !Equal(got, test.a) {
+				t.Errorf("%s, format %c: round trip mismatch:\ngot:\n%v\nwant:\n%v",
+					test.name, format, Formatted(got), Formatted(test.a))
+			}
+		}
+	}
+}
+
+func TestWriteMatrixMarket(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a      Matrix
+		format MarketFormat
+		want   string
+	}{
+		{
+			a:      NewDense(2, 2, []float64{1, 2, 0, 4.5}),
+			format: MarketArray,
+			want: `%%MatrixMarket matrix array real general
+2 2
+1
+0
+2
+4.5
+`,
+		},
+		{
+			a:      NewDense(2, 2, []float64{1, 2, 0, 4.5}),
+			format: MarketCoordinate,
+			want: `%%MatrixMarket matrix coordinate real general
+2 2 3
+1 1 1
+1 2 2
+2 2 4.5
+`,
+		},
+		{
+			a:      NewSymDense(2, []float64{1, 2, 2, 3}),
+			format: MarketArray,
+			want: `%%MatrixMarket matrix array real symmetric
+2 2
+1
+2
+3
+`,
+		},
+		{
+			a:      NewSymDense(2, []float64{1, 0, 0, 3}),
+			format: MarketCoordinate,
+			want: `%%MatrixMarket matrix coordinate real symmetric
+2 2 2
+1 1 1
+2 2 3
+`,
+		},
+		{
+			// Duplicates are summed and entries summing to zero are
+			// omitted.
+			a:      NewCOO(3, 2, []int{2, 0, 2, 1, 1}, []int{0, 1, 0, 1, 1}, []float64{1, 2, 3, 4, -4}),
+			format: MarketCoordinate,
+			want: `%%MatrixMarket matrix coordinate real general
+3 2 2
+3 1 4
+1 2 2
+`,
+		},
+		{
+			a:      NewCSR(2, 3, []int{0, 2, 3}, []int{0, 2, 1}, []float64{1, 2, 3}),
+			format: MarketCoordinate,
+			want: `%%MatrixMarket matrix coordinate real general
+2 3 3
+1 1 1
+2 2 3
+1 3 2
+`,
+		},
+		{
+			a:      NewCSC(3, 2, []int{0, 2, 3}, []int{0, 2, 1}, []float64{1, 2, 3}).T(),
+			format: MarketCoordinate,
+			want: `%%MatrixMarket matrix coordinate real general
+2 3 3
+1 1 1
+2 2 3
+1 3 2
+`,
+		},
+		{
+			// Sparse matrices are written without visiting every element.
+			a:      NewCOO(1e8, 1e8, []int{99999999, 0}, []int{5, 99999999}, []float64{1, 2}),
+			format: MarketCoordinate,
+			want: `%%MatrixMarket matrix coordinate real general
+100000000 100000000 2
+100000000 6 1
+1 100000000 2
+`,
+		},
+	} {
+		var buf bytes.Buffer
+		err := WriteMatrixMarket(&buf, test.a, test.format)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if buf.String() != test.want {
+			t.Errorf("unexpected output:\ngot:\n%s\nwant:\n%s", buf.String(), test.want)
+		}
+	}
+
+	err := WriteMatrixMarket(&bytes.Buffer{}, NewDense(1, 1, nil), 'X')
+	if err != errMarketFormat {
+		t.Errorf("unexpected error for invalid format: got:%v want:%v", err, errMarketFormat)
+	}
+}
+
+func TestReadMatrixMarket(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		src  string
+		want Matrix
+		err  error
+	}{
+		{
+			src: `%%MatrixMarket matrix coordinate real general
+% A comment.
+
+3 2 2
+1 2 1.5
+3 1 -2
+`,
+			want: NewCOO(3, 2, []int{0, 2}, []int{1, 0}, []float64{1.5, -2}),
+		},
+		{
+			src: `%%MatrixMarket matrix coordinate integer symmetric
+3 3 3
+1 1 1
+3 1 2
+3 3 3
+`,
+			want: NewCOO(3, 3, []int{0, 2, 0, 2}, []int{0, 0, 2, 2}, []float64{1, 2, 2, 3}),
+		},
+		{
+			src: `%%MatrixMarket matrix coordinate pattern general
+2 2 2
+1 1
+2 1
+`,
+			want: NewCOO(2, 2, []int{0, 1}, []int{0, 0}, []float64{1, 1}),
+		},
+		{
+			src: `%%MatrixMarket matrix coordinate real skew-symmetric
+2 2 1
+2 1 3
+`,
+			want: NewCOO(2, 2, []int{1, 0}, []int{0, 1}, []float64{3, -3}),
+		},
+		{
+			src: `%%MatrixMarket matrix coordinate real symmetric
+2 3 0
+`,
+			err: errMarketData,
+		},
+		{
+			src: `%%MatrixMarket matrix array real skew-symmetric
+3 3
+1
+2
+3
+`,
+			want: NewDense(3, 3, []float64{0, -1, -2, 1, 0, -3, 2, 3, 0}),
+		},
+		{
+			src: `%%MATRIXMARKET MATRIX ARRAY REAL GENERAL
+2 1
+1
+2
+`,
+			want: NewDense(2, 1, []float64{1, 2}),
+		},
+		{
+			src: `%%MatrixMarket matrix array real general
+2 1
+1
+`,
+			err: io.ErrUnexpectedEOF,
+		},
+		{
+			src: `%%MatrixMarket matrix coordinate real general
+2 2 1
+3 1 1
+`,
+			err: errMarketData,
+		},
+		{
+			src: `%%MatrixMarket matrix coordinate real symmetric
+2 2 1
+1 2 1
+`,
+			err: errMarketData,
+		},
+		{
+			src: `%%MatrixMarket matrix array pattern general
+2 2
+`,
+			err: errMarketHeader,
+		},
+		{
+			src: `%%MatrixMarket matrix array complex general
+1 1
+1 2
+`,
+			err: errWrongType,
+		},
+		{
+			src: `%%MatrixMarket matrix array real general
+0 2
+`,
+			err: ErrZeroLength,
+		},
+		{
+			src: `%%MatrixMarket vector array real general
+1 1
+`,
+			err: errMarketHeader,
+		},
+		{
+			src: `%%MatrixMarket matrix array real general
+1 1
+x
+`,
+			err: errMarketData,
+		},
+	} {
+		got, err := ReadMatrixMarket(strings.NewReader(test.src))
+		if err != test.err {
+			t.Errorf("test %d: unexpected error: got:%v want:%v", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if reflect.TypeOf(got) != reflect.TypeOf(test.want) {
+			t.Errorf("test %d: unexpected type: got:%T want:%T", i, got, test.want)
+		}
+		if !Equal(got, test.want) {
+			t.Errorf("test %d: unexpected result:\ngot:\n%v\nwant:\n%v", i, Formatted(got), Formatted(test.want))
+		}
+	}
+
+	// A large sparse matrix must be read without allocating dense storage.
+	src := `%%MatrixMarket matrix coordinate real symmetric
+100000000 100000000 2
+1 1 1.5
+100000000 2 -2
+`
+	got, err := ReadMatrixMarket(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error reading large sparse matrix: %v", err)
+	}
+	m, ok := got.(*COO)
+	if !ok {
+		t.Fatalf("unexpected type for large sparse matrix: %T", got)
+	}
+	if r, c := m.Dims(); r != 1e8 || c != 1e8 {
+		t.Errorf("unexpected dimensions: got:%d×%d want:%d×%d", r, c, int(1e8), int(1e8))
+	}
+	if m.NNZ() != 3 {
+		t.Errorf("unexpected number of entries: got:%d want:3", m.NNZ())
+	}
+	for _, e := range []struct {
+		i, j int
+		v    float64
+	}{
+		{i: 0, j: 0, v: 1.5},
+		{i: 99999999, j: 1, v: -2},
+		{i: 1, j: 99999999, v: -2},
+		{i: 1, j: 1, v: 0},
+	} {
+		if v := m.At(e.i, e.j); v != e.v {
+			t.Errorf("unexpected value at (%d, %d): got:%v want:%v", e.i, e.j, v, e.v)
+		}
+	}
+
+	// Dense storage for an array file is only allocated after the values
+	// have been read.
+	_, err = ReadMatrixMarket(strings.NewReader(`%%MatrixMarket matrix array real general
+100000 100000
+1
+`))
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("unexpected error for short array file: got:%v want:%v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestCMatrixMarket(t *testing.T) {
+	t.Parallel()
+	a := NewCDense(2, 3, []complex128{1 + 2i, 0, -3i, 4, 5.5 - 1i, 0})
+	for _, format := range []MarketFormat{MarketArray, MarketCoordinate} {
+		var buf bytes.Buffer
+		err := WriteCMatrixMarket(&buf, a, format)
+		if err != nil {
+			t.Fatalf("format %c: unexpected error writing: %v", format, err)
+		}
+		got, err := ReadCMatrixMarket(&buf)
+		if err != nil {
+			t.Errorf("format %c: unexpected error reading: %v", format, err)
+			continue
+		}
+		if !CEqual(got, a) {
+			t.Errorf("format %c: round trip mismatch:\ngot: %v\nwant:%v", format, got.mat.Data, a.mat.Data)
+		}
+	}
+
+	src := `%%MatrixMarket matrix coordinate complex hermitian
+2 2 2
+1 1 1 0
+2 1 2 3
+`
+	got, err := ReadCMatrixMarket(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error reading Hermitian matrix: %v", err)
+	}
+	want := NewCDense(2, 2, []complex128{1, 2 - 3i, 2 + 3i, 0})
+	if !CEqual(got, want) {
+		t.Errorf("unexpected Hermitian matrix: got: %v want: %v", got.mat.Data, want.mat.Data)
+	}
+
+	src = `%%MatrixMarket matrix array real general
+1 2
+1
+2
+`
+	got, err = ReadCMatrixMarket(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error reading real matrix: %v", err)
+	}
+	want = NewCDense(1, 2, []complex128{1, 2})
+	if !CEqual(got, want) {
+		t.Errorf("unexpected real matrix: got: %v want: %v", got.mat.Data, want.mat.Data)
+	}
+
+	src = `%%MatrixMarket matrix coordinate complex general
+100000000 100000000 1
+1 1 1 0
+`
+	_, err = ReadCMatrixMarket(strings.NewReader(src))
+	if err != errTooBig {
+		t.Errorf("unexpected error for large sparse matrix: got:%v want:%v", err, errTooBig)
+	}
+}
@@ -219,6 +219,36 @@ type ColNonZeroDoer interface {
 	DoColNonZero(j int, fn func(i, j int, v float64))
 }
 
+// A MulVecToer represents a matrix A by means of a matrix-vector
+// multiplication. It is used by iterative methods that only access A
+// through products with vectors.
+type MulVecToer interface {
+	// MulVecTo computes A*x or Aᵀ*x and stores the result into dst.
+	MulVecTo(dst *VecDense, trans bool, x Vector)
+}
+
+// Operator returns a MulVecToer that computes products with the matrix a.
+// If a implements MulVecToer, it is returned unaltered.
+func Operator(a Matrix) MulVecToer {
+	if m, ok := a.(MulVecToer); ok {
+		return m
+	}
+	return matrixOperator{a}
+}
+
+// matrixOperator is a MulVecToer computing products with a Matrix.
+type matrixOperator struct {
+	a Matrix
+}
+
+func (m matrixOperator) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	if trans {
+		dst.MulVec(m.a.T(), x)
+		return
+	}
+	dst.MulVec(m.a, x)
+}
+
 // untranspose untransposes a matrix if applicable. If a is an Untransposer, then
 // untranspose returns the underlying matrix and true. If it is not, then it returns
 // the input matrix and false.
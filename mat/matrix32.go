@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// Matrix32 is the basic matrix interface type for single precision matrices.
+type Matrix32 interface {
+	// Dims returns the dimensions of a Matrix32.
+	Dims() (r, c int)
+
+	// At returns the value of a matrix element at row i, column j.
+	// It will panic if i or j are out of bounds for the matrix.
+	At(i, j int) float32
+
+	// T returns the transpose of the Matrix32. Whether T returns a copy of the
+	// underlying data is implementation dependent.
+	// This method may be implemented using the Transpose32 type, which
+	// provides an implicit matrix transpose.
+	T() Matrix32
+}
+
+// A RawMatrixer32 can return a blas32.General representation of the receiver.
+// Changes to the blas32.General.Data slice will be reflected in the original
+// matrix, changes to the Rows, Cols and Stride fields will not.
+type RawMatrixer32 interface {
+	RawMatrix32() blas32.General
+}
+
+var (
+	_ Matrix32       = Transpose32{}
+	_ Untransposer32 = Transpose32{}
+)
+
+// Transpose32 is a type for performing an implicit matrix transpose. It
+// implements the Matrix32 interface, returning values from the transpose of
+// the matrix within.
+type Transpose32 struct {
+	Matrix32 Matrix32
+}
+
+// At returns the value of the element at row i and column j of the transposed
+// matrix, that is, row j and column i of the Matrix32 field.
+func (t Transpose32) At(i, j int) float32 {
+	return t.Matrix32.At(j, i)
+}
+
+// Dims returns the dimensions of the transposed matrix. The number of rows
+// returned is the number of columns in the Matrix32 field, and the number of
+// columns is the number of rows in the Matrix32 field.
+func (t Transpose32) Dims() (r, c int) {
+	c, r = t.Matrix32.Dims()
+	return r, c
+}
+
+// T performs an implicit transpose by returning the Matrix32 field.
+func (t Transpose32) T() Matrix32 {
+	return t.Matrix32
+}
+
+// Untranspose returns the Matrix32 field.
+func (t Transpose32) Untranspose() Matrix32 {
+	return t.Matrix32
+}
+
+// Untransposer32 is a type that can undo an implicit transpose.
+type Untransposer32 interface {
+	// Untranspose returns the underlying Matrix32 stored for the implicit
+	// transpose.
+	Untranspose() Matrix32
+}
+
+// use32 returns a float32 slice with l elements, using f if it
+// has the necessary capacity, otherwise creating a new slice.
+func use32(f []float32, l int) []float32 {
+	if l <= cap(f) {
+		return f[:l]
+	}
+	return make([]float32, l)
+}
+
+// useZeroed32 returns a float32 slice with l elements, using f if it
+// has the necessary capacity, otherwise creating a new slice. The
+// elements of the returned slice are guaranteed to be zero.
+func useZeroed32(f []float32, l int) []float32 {
+	if l <= cap(f) {
+		f = f[:l]
+		zero32(f)
+		return f
+	}
+	return make([]float32, l)
+}
+
+// zero32 zeros the given slice's elements.
+func zero32(f []float32) {
+	for i := range f {
+		f[i] = 0
+	}
+}
+
+// untranspose32 untransposes a matrix if applicable. If a is an
+// Untransposer32, then untranspose32 returns the underlying matrix and true.
+// If it is not, then it returns the input matrix and false.
+func untranspose32(a Matrix32) (Matrix32, bool) {
+	if ut, ok := a.(Untransposer32); ok {
+		return ut.Untranspose(), true
+	}
+	return a, false
+}
+
+// untransposeExtract32 returns an untransposed matrix in a built-in matrix
+// type.
+//
+// The untransposed matrix is returned unaltered if it is a built-in matrix
+// type. Otherwise, if it implements RawMatrixer32, a *Dense32 holding the raw
+// matrix value of the input is returned. If neither of these is possible, the
+// untransposed matrix is returned.
+func untransposeExtract32(a Matrix32) (Matrix32, bool) {
+	ut, trans := untranspose32(a)
+	switch m := ut.(type) {
+	case *Dense32, *VecDense32, *SymDense32:
+		return m, trans
+	case RawMatrixer32:
+		var d Dense32
+		d.SetRawMatrix32(m.RawMatrix32())
+		return &d, trans
+	default:
+		return ut, trans
+	}
+}
+
+// Equal32 returns whether the matrices a and b have the same size
+// and are element-wise equal.
+func Equal32(a, b Matrix32) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			if a.At(i, j) != b.At(i, j) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// EqualApprox32 returns whether the matrices a and b have the same size and
+// contain all equal elements with tolerance for element-wise equality
+// specified by epsilon. Matrices with non-equal shapes are not equal.
+func EqualApprox32(a, b Matrix32, epsilon float32) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	eps := float64(epsilon)
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			if !scalar.EqualWithinAbsOrRel(float64(a.At(i, j)), float64(b.At(i, j)), eps, eps) {
+				return false
+			}
+		}
+	}
+	return true
+}
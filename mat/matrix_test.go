@@ -685,6 +685,27 @@ func TestDoer(t *testing.T) {
 	}
 }
 
+func TestOperator(t *testing.T) {
+	t.Parallel()
+	band := NewBandDense(3, 3, 1, 1, []float64{0, 1, 2, 3, 4, 5, 6, 7, 0})
+	if op, ok := Operator(band).(*BandDense); !ok || op != band {
+		t.Errorf("MulVecToer not returned unaltered")
+	}
+
+	a := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	op := Operator(a)
+	var got VecDense
+	op.MulVecTo(&got, false, NewVecDense(3, []float64{1, -1, 2}))
+	if want := NewVecDense(2, []float64{5, 11}); !Equal(&got, want) {
+		t.Errorf("unexpected product: got %v, want %v", got.RawVector().Data, want.RawVector().Data)
+	}
+	var gotT VecDense
+	op.MulVecTo(&gotT, true, NewVecDense(2, []float64{1, 2}))
+	if want := NewVecDense(3, []float64{9, 12, 15}); !Equal(&gotT, want) {
+		t.Errorf("unexpected transposed product: got %v, want %v", gotT.RawVector().Data, want.RawVector().Data)
+	}
+}
+
 func TestMulVecToer(t *testing.T) {
 	t.Parallel()
 	const tol = 1e-14
@@ -0,0 +1,446 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// npyMagic is the magic string at the start of every NumPy .npy file.
+const npyMagic = "\x93NUMPY"
+
+var (
+	errNPYHeader = errors.New("mat: invalid NumPy header")
+	errNPYShape  = errors.New("mat: unsupported NumPy array shape")
+	errNPYType   = errors.New("mat: unsupported NumPy data type")
+	errNPZName   = errors.New("mat: no such array in NumPy archive")
+)
+
+// WriteNPY writes the matrix a to w in the NumPy .npy format described at
+// https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html.
+// The data are written as little-endian float64 values in C order. A Vector
+// is written as a one-dimensional array, any other matrix as a
+// two-dimensional array.
+func WriteNPY(w io.Writer, a Matrix) error {
+	r, c := a.Dims()
+	shape := fmt.Sprintf("(%d, %d)", r, c)
+	if v, ok := a.(Vector); ok {
+		shape = fmt.Sprintf("(%d,)", v.Len())
+	}
+	bw := bufio.NewWriter(w)
+	err := writeNPYHeader(bw, "<f8", shape)
+	if err != nil {
+		return err
+	}
+	var buf [8]byte
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			binary.LittleEndian.PutUint64(buf[:], math.Float64bits(a.At(i, j)))
+			bw.Write(buf[:])
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteCNPY writes the complex matrix a to w in the NumPy .npy format as a
+// two-dimensional array of little-endian complex128 values in C order.
+func WriteCNPY(w io.Writer, a CMatrix) error {
+	r, c := a.Dims()
+	bw := bufio.NewWriter(w)
+	err := writeNPYHeader(bw, "<c16", fmt.Sprintf("(%d, %d)", r, c))
+	if err != nil {
+		return err
+	}
+	var buf [16]byte
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := a.At(i, j)
+			binary.LittleEndian.PutUint64(buf[:8], math.Float64bits(real(v)))
+			binary.LittleEndian.PutUint64(buf[8:], math.Float64bits(imag(v)))
+			bw.Write(buf[:])
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadNPY reads a float64 array in the NumPy .npy format from r. Arrays in
+// both C and Fortran order and in either byte order are accepted.
+//
+// ReadNPY returns a *VecDense for a one-dimensional array and a *Dense for a
+// two-dimensional array. Arrays with zero elements or more than two
+// dimensions are not supported.
+func ReadNPY(r io.Reader) (Matrix, error) {
+	h, err := readNPYHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if h.complex {
+		return nil, errWrongType
+	}
+	data, err := readNPYFloats(r, h.rows*h.cols, h.order)
+	if err != nil {
+		return nil, err
+	}
+	if h.vector {
+		return NewVecDense(h.rows, data), nil
+	}
+	m := NewDense(h.rows, h.cols, data)
+	if h.fortran {
+		m = DenseCopyOf(NewDense(h.cols, h.rows, data).T())
+	}
+	return m, nil
+}
+
+// ReadCNPY reads a complex128 or float64 array in the NumPy .npy format from
+// r and returns it as a *CDense. Arrays in both C and Fortran order and in
+// either byte order are accepted. A one-dimensional array of length n is
+// returned as an n×1 matrix.
+func ReadCNPY(r io.Reader) (*CDense, error) {
+	h, err := readNPYHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	n := h.rows * h.cols
+	var data []complex128
+	if h.complex {
+		parts, err := readNPYFloats(r, 2*n, h.order)
+		if err != nil {
+			return nil, err
+		}
+		data = make([]complex128, n)
+		for i := range data {
+			data[i] = complex(parts[2*i], parts[2*i+1])
+		}
+	} else {
+		parts, err := readNPYFloats(r, n, h.order)
+		if err != nil {
+			return nil, err
+		}
+		data = make([]complex128, n)
+		for i, v := range parts {
+			data[i] = complex(v, 0)
+		}
+	}
+	if !h.fortran {
+		return NewCDense(h.rows, h.cols, data), nil
+	}
+	m := NewCDense(h.rows, h.cols, nil)
+	for j := 0; j < h.cols; j++ {
+		for i := 0; i < h.rows; i++ {
+			m.set(i, j, data[j*h.rows+i])
+		}
+	}
+	return m, nil
+}
+
+// NPZWriter writes matrices to a NumPy .npz archive.
+type NPZWriter struct {
+	zw *zip.Writer
+}
+
+// NewNPZWriter returns a new NPZWriter writing an archive to w.
+func NewNPZWriter(w io.Writer) *NPZWriter {
+	return &NPZWriter{zw: zip.NewWriter(w)}
+}
+
+// Write adds the matrix a with the given name to the archive as described by
+// WriteNPY.
+func (w *NPZWriter) Write(name string, a Matrix) error {
+	f, err := w.zw.Create(name + ".npy")
+	if err != nil {
+		return err
+	}
+	return WriteNPY(f, a)
+}
+
+// WriteC adds the complex matrix a with the given name to the archive as
+// described by WriteCNPY.
+func (w *NPZWriter) WriteC(name string, a CMatrix) error {
+	f, err := w.zw.Create(name + ".npy")
+	if err != nil {
+		return err
+	}
+	return WriteCNPY(f, a)
+}
+
+// Close finishes writing the archive. It does not close the underlying
+// writer.
+func (w *NPZWriter) Close() error {
+	return w.zw.Close()
+}
+
+// NPZReader reads matrices from a NumPy .npz archive.
+type NPZReader struct {
+	files map[string]*zip.File
+	names []string
+}
+
+// NewNPZReader returns a new NPZReader reading an archive from r, which is
+// assumed to have the given size in bytes. Both compressed and uncompressed
+// archives are accepted.
+func NewNPZReader(r io.ReaderAt, size int64) (*NPZReader, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	npz := &NPZReader{files: make(map[string]*zip.File)}
+	for _, f := range zr.File {
+		name := strings.TrimSuffix(f.Name, ".npy")
+		npz.files[name] = f
+		npz.names = append(npz.names, name)
+	}
+	sort.Strings(npz.names)
+	return npz, nil
+}
+
+// Names returns the sorted names of the arrays in the archive.
+func (r *NPZReader) Names() []string {
+	return append([]string(nil), r.names...)
+}
+
+// Read returns the float64 array with the given name as described by
+// ReadNPY.
+func (r *NPZReader) Read(name string) (Matrix, error) {
+	rc, err := r.open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ReadNPY(rc)
+}
+
+// ReadC returns the complex128 or float64 array with the given name as
+// described by ReadCNPY.
+func (r *NPZReader) ReadC(name string) (*CDense, error) {
+	rc, err := r.open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ReadCNPY(rc)
+}
+
+func (r *NPZReader) open(name string) (io.ReadCloser, error) {
+	f, ok := r.files[name]
+	if !ok {
+		return nil, errNPZName
+	}
+	return f.Open()
+}
+
+// npyHeader holds the array description of a NumPy .npy file.
+type npyHeader struct {
+	order   binary.ByteOrder
+	complex bool
+	fortran bool
+	vector  bool
+
+	rows, cols int
+}
+
+// writeNPYHeader writes the magic string, version and header dictionary of a
+// .npy file for an array with the given descr and shape to w.
+func writeNPYHeader(w io.Writer, descr, shape string) error {
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': %s, }", descr, shape)
+	// The total header length, including the magic string, version, header
+	// length and terminating newline, is padded with spaces to a multiple
+	// of 64 bytes for alignment.
+	const prefixLen = len(npyMagic) + 2 + 2
+	pad := 64 - (prefixLen+len(dict)+1)%64
+	if pad == 64 {
+		pad = 0
+	}
+	hlen := len(dict) + pad + 1
+
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	if hlen <= math.MaxUint16 {
+		buf.Write([]byte{1, 0})
+		binary.Write(&buf, binary.LittleEndian, uint16(hlen))
+	} else {
+		// Version 2.0 uses a four byte header length, so the padding has
+		// to be recomputed.
+		pad = 64 - (prefixLen+2+len(dict)+1)%64
+		if pad == 64 {
+			pad = 0
+		}
+		hlen = len(dict) + pad + 1
+		buf.Write([]byte{2, 0})
+		binary.Write(&buf, binary.LittleEndian, uint32(hlen))
+	}
+	buf.WriteString(dict)
+	buf.WriteString(strings.Repeat(" ", pad))
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// readNPYHeader reads the magic string, version and header dictionary of a
+// .npy file from r.
+func readNPYHeader(r io.Reader) (npyHeader, error) {
+	var h npyHeader
+	var pre [len(npyMagic) + 2]byte
+	_, err := io.ReadFull(r, pre[:])
+	if err != nil {
+		return h, err
+	}
+	if string(pre[:len(npyMagic)]) != npyMagic {
+		return h, errNPYHeader
+	}
+	var hlen int
+	switch major := pre[len(npyMagic)]; major {
+	case 1:
+		var n uint16
+		err = binary.Read(r, binary.LittleEndian, &n)
+		hlen = int(n)
+	case 2, 3:
+		var n uint32
+		err = binary.Read(r, binary.LittleEndian, &n)
+		if n > 1<<24 {
+			return h, errNPYHeader
+		}
+		hlen = int(n)
+	default:
+		return h, errNPYHeader
+	}
+	if err != nil {
+		return h, err
+	}
+	buf := make([]byte, hlen)
+	_, err = io.ReadFull(r, buf)
+	if err != nil {
+		return h, err
+	}
+	dict := strings.Replace(string(buf), `"`, `'`, -1)
+
+	descr, err := npyDictValue(dict, "descr")
+	if err != nil {
+		return h, err
+	}
+	descr = strings.Trim(descr, "'")
+	if len(descr) < 2 {
+		return h, errNPYType
+	}
+	switch descr[0] {
+	case '<', '=':
+		h.order = binary.LittleEndian
+	case '>':
+		h.order = binary.BigEndian
+	default:
+		return h, errNPYType
+	}
+	switch descr[1:] {
+	case "f8":
+	case "c16":
+		h.complex = true
+	default:
+		return h, errNPYType
+	}
+
+	fortran, err := npyDictValue(dict, "fortran_order")
+	if err != nil {
+		return h, err
+	}
+	switch fortran {
+	case "True":
+		h.fortran = true
+	case "False":
+	default:
+		return h, errNPYHeader
+	}
+
+	shape, err := npyDictValue(dict, "shape")
+	if err != nil {
+		return h, err
+	}
+	if !strings.HasPrefix(shape, "(") || !strings.HasSuffix(shape, ")") {
+		return h, errNPYHeader
+	}
+	var dims []int
+	for _, f := range strings.Split(shape[1:len(shape)-1], ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		d, err := strconv.Atoi(f)
+		if err != nil || d < 0 {
+			return h, errNPYHeader
+		}
+		dims = append(dims, d)
+	}
+	switch len(dims) {
+	case 1:
+		h.vector = true
+		h.rows, h.cols = dims[0], 1
+	case 2:
+		h.rows, h.cols = dims[0], dims[1]
+	default:
+		return h, errNPYShape
+	}
+	if h.rows == 0 || h.cols == 0 {
+		return h, ErrZeroLength
+	}
+	if int64(h.rows) > maxLen/16/int64(h.cols) {
+		return h, errTooBig
+	}
+	return h, nil
+}
+
+// npyDictValue returns the literal value for key in the header dictionary of a
+// .npy file.
+func npyDictValue(dict, key string) (string, error) {
+	k := strings.Index(dict, "'"+key+"'")
+	if k < 0 {
+		return "", errNPYHeader
+	}
+	rest := strings.TrimSpace(dict[k+len(key)+2:])
+	if !strings.HasPrefix(rest, ":") {
+		return "", errNPYHeader
+	}
+	rest = strings.TrimSpace(rest[1:])
+	var end int
+	switch {
+	case strings.HasPrefix(rest, "'"):
+		end = strings.Index(rest[1:], "'") + 2
+	case strings.HasPrefix(rest, "("):
+		end = strings.Index(rest, ")") + 1
+	default:
+		end = strings.IndexAny(rest, ",}")
+	}
+	if end <= 0 {
+		return "", errNPYHeader
+	}
+	return strings.TrimSpace(rest[:end]), nil
+}
+
+// readNPYFloats reads n float64 values from r in the given byte order. The
+// result is grown as the values are read so that a corrupt header can not
+// cause the allocation of more memory than the data that is available.
+func readNPYFloats(r io.Reader, n int, order binary.ByteOrder) ([]float64, error) {
+	br := bufio.NewReader(r)
+	data := make([]float64, 0, min(n, readChunk))
+	var buf [8]byte
+	for len(data) < n {
+		_, err := io.ReadFull(br, buf[:])
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		data = append(data, math.Float64frombits(order.Uint64(buf[:])))
+	}
+	return data, nil
+}
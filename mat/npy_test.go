@@ -0,0 +1,292 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestNPYRoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    Matrix
+	}{
+		{name: "Dense", a: randNormalDense(4, 3, rnd)},
+		{name: "Row", a: randNormalDense(1, 5, rnd)},
+		{name: "VecDense", a: randNormalVec(6, rnd)},
+		{name: "SymDense", a: randSymDense(5, rnd)},
+		{name: "TriDense", a: NewTriDense(3, Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6})},
+		{name: "BandDense", a: NewBandDense(3, 4, 1, 1, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8})},
+		{name: "Special", a: NewDense(1, 4, []float64{math.Inf(1), math.Inf(-1), 0, -0.1})},
+	} {
+		var buf bytes.Buffer
+		err := WriteNPY(&buf, test.a)
+		if err != nil {
+			t.Fatalf("%s: unexpected error writing: %v", test.name, err)
+		}
+		if buf.Len()%8 != 0 {
+			t.Errorf("%s: data not aligned", test.name)
+		}
+		got, err := ReadNPY(&buf)
+		if err != nil {
+			t.Errorf("%s: unexpected error reading: %v", test.name, err)
+			continue
+		}
+		_, isVec := test.a.(Vector)
+		if _, ok := got.(*VecDense); ok != isVec {
+			t.Errorf("%s: unexpected type %T", test.name, got)
+		}
+		if !Equal(got, test.a) {
+			t.Errorf("%s: round trip mismatch:\ngot:\n%v\nwant:\n%v",
+				test.name, Formatted(got), Formatted(test.a))
+		}
+	}
+}
+
+func TestWriteNPY(t *testing.T) {
+	t.Parallel()
+	// The header written by numpy.save for a float64 array of shape (2, 3).
+	dict := "{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }"
+	want := "\x93NUMPY\x01\x00v\x00" + dict + strings.Repeat(" ", 58) + "\n"
+
+	var buf bytes.Buffer
+	err := WriteNPY(&buf, NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := buf.Bytes()
+	if len(got) != len(want)+6*8 {
+		t.Fatalf("unexpected length: got:%d want:%d", len(got), len(want)+6*8)
+	}
+	if string(got[:len(want)]) != want {
+		t.Errorf("unexpected header:\ngot: %q\nwant:%q", got[:len(want)], want)
+	}
+	for i, v := range []float64{1, 2, 3, 4, 5, 6} {
+		bits := binary.LittleEndian.Uint64(got[len(want)+8*i:])
+		if math.Float64frombits(bits) != v {
+			t.Errorf("unexpected element %d: got:%v want:%v", i, math.Float64frombits(bits), v)
+		}
+	}
+}
+
+func TestReadNPY(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		descr   string
+		fortran bool
+		shape   string
+		data    []float64
+		order   binary.ByteOrder
+
+		want Matrix
+		err  error
+	}{
+		{
+			descr: "<f8", shape: "(2, 3)", data: []float64{1, 2, 3, 4, 5, 6}, order: binary.LittleEndian,
+			want: NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		},
+		{
+			descr: "<f8", fortran: true, shape: "(2, 3)", data: []float64{1, 4, 2, 5, 3, 6}, order: binary.LittleEndian,
+			want: NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		},
+		{
+			descr: ">f8", shape: "(3,)", data: []float64{1, 2, 3}, order: binary.BigEndian,
+			want: NewVecDense(3, []float64{1, 2, 3}),
+		},
+		{
+			descr: "<f4", shape: "(1,)", data: []float64{1}, order: binary.LittleEndian,
+			err: errNPYType,
+		},
+		{
+			descr: "<c16", shape: "(1,)", data: []float64{1, 0}, order: binary.LittleEndian,
+			err: errWrongType,
+		},
+		{
+			descr: "<f8", shape: "(1, 1, 1)", data: []float64{1}, order: binary.LittleEndian,
+			err: errNPYShape,
+		},
+		{
+			descr: "<f8", shape: "(0, 3)", order: binary.LittleEndian,
+			err: ErrZeroLength,
+		},
+		{
+			descr: "<f8", shape: "(2, 2)", data: []float64{1, 2, 3}, order: binary.LittleEndian,
+			err: io.ErrUnexpectedEOF,
+		},
+		{
+			// The number of elements overflows int64.
+			descr: "<f8", shape: "(4294967296, 4294967296)", data: []float64{1}, order: binary.LittleEndian,
+			err: errTooBig,
+		},
+		{
+			// Storage for a large array is only allocated as the data
+			// is read.
+			descr: "<f8", shape: "(100000, 100000)", data: []float64{1}, order: binary.LittleEndian,
+			err: io.ErrUnexpectedEOF,
+		},
+	} {
+		src := npyTestFile(test.descr, test.fortran, test.shape, test.data, test.order)
+		got, err := ReadNPY(bytes.NewReader(src))
+		if err != test.err {
+			t.Errorf("test %d: unexpected error: got:%v want:%v", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if _, ok := test.want.(*VecDense); ok {
+			if _, ok := got.(*VecDense); !ok {
+				t.Errorf("test %d: unexpected type %T", i, got)
+			}
+		}
+		if !Equal(got, test.want) {
+			t.Errorf("test %d: unexpected result:\ngot:\n%v\nwant:\n%v", i, Formatted(got), Formatted(test.want))
+		}
+	}
+
+	_, err := ReadNPY(strings.NewReader("\x93NUMPX\x01\x00\x00\x00"))
+	if err != errNPYHeader {
+		t.Errorf("unexpected error for invalid magic string: got:%v want:%v", err, errNPYHeader)
+	}
+}
+
+func TestCNPY(t *testing.T) {
+	t.Parallel()
+	a := NewCDense(2, 3, []complex128{1 + 2i, 0, -3i, 4, 5.5 - 1i, complex(math.Inf(1), 0)})
+	var buf bytes.Buffer
+	err := WriteCNPY(&buf, a)
+	if err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+	got, err := ReadCNPY(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	}
+	if !CEqual(got, a) {
+		t.Errorf("round trip mismatch:\ngot: %v\nwant:%v", got.mat.Data, a.mat.Data)
+	}
+
+	src := npyTestFile(">c16", true, "(2, 2)", []float64{1, 2, 3, 4, 5, 6, 7, 8}, binary.BigEndian)
+	got, err = ReadCNPY(bytes.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error reading Fortran ordered array: %v", err)
+	}
+	want := NewCDense(2, 2, []complex128{1 + 2i, 5 + 6i, 3 + 4i, 7 + 8i})
+	if !CEqual(got, want) {
+		t.Errorf("unexpected Fortran ordered array: got: %v want: %v", got.mat.Data, want.mat.Data)
+	}
+
+	src = npyTestFile("<f8", false, "(2,)", []float64{1, 2}, binary.LittleEndian)
+	got, err = ReadCNPY(bytes.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error reading real array: %v", err)
+	}
+	want = NewCDense(2, 1, []complex128{1, 2})
+	if !CEqual(got, want) {
+		t.Errorf("unexpected real array: got: %v want: %v", got.mat.Data, want.mat.Data)
+	}
+	for _, test := range []struct {
+		descr, shape string
+		err          error
+	}{
+		{descr: "<c16", shape: "(4294967296, 4294967296)", err: errTooBig},
+		{descr: "<c16", shape: "(100000, 100000)", err: io.ErrUnexpectedEOF},
+		{descr: "<f8", shape: "(100000, 100000)", err: io.ErrUnexpectedEOF},
+	} {
+		src = npyTestFile(test.descr, false, test.shape, []float64{1, 2}, binary.LittleEndian)
+		_, err = ReadCNPY(bytes.NewReader(src))
+		if err != test.err {
+			t.Errorf("unexpected error for %s array with shape %s: got:%v want:%v", test.descr, test.shape, err, test.err)
+		}
+	}
+}
+
+func TestNPZ(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 2, []float64{1, 2, 3, 4})
+	v := NewVecDense(3, []float64{5, 6, 7})
+	c := NewCDense(1, 2, []complex128{1i, 2})
+
+	var buf bytes.Buffer
+	w := NewNPZWriter(&buf)
+	for _, err := range []error{
+		w.Write("a", a),
+		w.Write("v", v),
+		w.WriteC("c", c),
+		w.Close(),
+	} {
+		if err != nil {
+			t.Fatalf("unexpected error writing: %v", err)
+		}
+	}
+
+	r, err := NewNPZReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error opening archive: %v", err)
+	}
+	names := r.Names()
+	if want := []string{"a", "c", "v"}; !equalStrings(names, want) {
+		t.Errorf("unexpected names: got:%v want:%v", names, want)
+	}
+	gotA, err := r.Read("a")
+	if err != nil || !Equal(gotA, a) {
+		t.Errorf("unexpected result for a: got:%v err:%v", gotA, err)
+	}
+	gotV, err := r.Read("v")
+	if err != nil || !Equal(gotV, v) {
+		t.Errorf("unexpected result for v: got:%v err:%v", gotV, err)
+	}
+	gotC, err := r.ReadC("c")
+	if err != nil || !CEqual(gotC, c) {
+		t.Errorf("unexpected result for c: got:%v err:%v", gotC, err)
+	}
+	_, err = r.Read("c")
+	if err != errWrongType {
+		t.Errorf("unexpected error reading complex array as real: got:%v want:%v", err, errWrongType)
+	}
+	_, err = r.Read("x")
+	if err != errNPZName {
+		t.Errorf("unexpected error for missing array: got:%v want:%v", err, errNPZName)
+	}
+}
+
+// npyTestFile returns a .npy file holding data with the given header fields.
+func npyTestFile(descr string, fortran bool, shape string, data []float64, order binary.ByteOrder) []byte {
+	f := "False"
+	if fortran {
+		f = "True"
+	}
+	dict := "{'descr': '" + descr + "', 'fortran_order': " + f + ", 'shape': " + shape + ", }\n"
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(len(dict)))
+	buf.WriteString(dict)
+	for _, v := range data {
+		binary.Write(&buf, order, v)
+	}
+	return buf.Bytes()
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
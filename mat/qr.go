@@ -20,6 +20,12 @@ type QR struct {
 	qr   *Dense
 	tau  []float64
 	cond float64
+
+	// q holds the explicit m×m orthogonal factor Q once the factorization
+	// has been modified by RankOne, InsertRow or DeleteRow. If q is not nil,
+	// the upper trapezoid of qr holds R, its strictly lower triangle is zero
+	// and tau is not used.
+	q *Dense
 }
 
 func (qr *QR) updateCond(norm lapack.MatrixNorm) {
@@ -60,6 +66,7 @@ func (qr *QR) factorize(a Matrix, norm lapack.MatrixNorm) {
 		qr.qr = &Dense{}
 	}
 	qr.qr.CloneFrom(a)
+	qr.q = nil
 	work := []float64{0}
 	qr.tau = make([]float64, k)
 	lapack64.Geqrf(qr.qr.mat, qr.tau, work, -1)
@@ -146,6 +153,11 @@ func (qr *QR) QTo(dst *Dense) {
 		dst.Zero()
 	}
 
+	if qr.q != nil {
+		dst.Copy(qr.q)
+		return
+	}
+
 	// Set Q = I.
 	for i := 0; i < r*r; i += r + 1 {
 		dst.mat.Data[i] = 1
@@ -205,17 +217,9 @@ func (qr *QR) SolveTo(dst *Dense, trans bool, b Matrix) error {
 		for i := c; i < r; i++ {
 			zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
 		}
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.applyQTo(w, false)
 	} else {
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.applyQTo(w, true)
 
 		ok := lapack64.Trtrs(blas.NoTrans, t, w.mat)
 		if !ok {
@@ -231,6 +235,31 @@ func (qr *QR) SolveTo(dst *Dense, trans bool, b Matrix) error {
 	return nil
 }
 
+// applyQTo overwrites the r×k matrix w with Q*w if trans is false and with
+// Qᵀ*w if trans is true.
+func (qr *QR) applyQTo(w *Dense, trans bool) {
+	if qr.q != nil {
+		tmp := getDenseWorkspace(w.mat.Rows, w.mat.Cols, false)
+		defer putDenseWorkspace(tmp)
+		if trans {
+			tmp.Mul(qr.q.T(), w)
+		} else {
+			tmp.Mul(qr.q, w)
+		}
+		w.Copy(tmp)
+		return
+	}
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, t, qr.qr.mat, qr.tau, w.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, t, qr.qr.mat, qr.tau, w.mat, work, len(work))
+	putFloat64s(work)
+}
+
 // SolveVecTo finds a minimum-norm solution to a system of linear equations,
 //  Ax = b.
 // See QR.SolveTo for the full documentation.
@@ -264,3 +293,236 @@ func (qr *QR) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
 	return qr.SolveTo(dst.asDense(), trans, bm)
 
 }
+
+// RankOne updates a QR factorization as if a rank-one update had been applied
+// to the original matrix A, storing the result into the receiver. That is, if
+// in the original QR decomposition Q * R = A, in the updated decomposition
+//  Q * R = A + alpha * x * yᵀ.
+// RankOne will panic if orig does not contain a factorization or if the
+// lengths of x and y do not match the dimensions of A.
+//
+// RankOne uses Givens rotations and updates the factorization in O(m²) time,
+// compared to O(m*n²) for the computation from scratch. The first
+// modification of a factorization forms the m×m factor Q explicitly.
+func (qr *QR) RankOne(orig *QR, alpha float64, x, y Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.qr.Dims()
+	if r, c := x.Dims(); r != m || c != 1 {
+		panic(ErrShape)
+	}
+	if r, c := y.Dims(); r != n || c != 1 {
+		panic(ErrShape)
+	}
+
+	// The algorithm is described in section 12.5.1 of
+	//  G. H. Golub, C. F. Van Loan: Matrix Computations, 4th edition.
+	//  Johns Hopkins University Press (2013).
+	q, r := orig.explicitFactors()
+
+	// Compute w = Qᵀ * x.
+	w := NewVecDense(m, nil)
+	w.MulVec(q.T(), x)
+	wd := w.mat.Data
+
+	// Reduce w to a multiple of e_0 by Givens rotations from the bottom,
+	// applying them to R, which becomes upper Hessenberg, and to Q.
+	for i := m - 1; i > 0; i-- {
+		c, s, rr, _ := blas64.Rotg(wd[i-1], wd[i])
+		wd[i-1] = rr
+		wd[i] = 0
+		if i-1 < n {
+			rotateRows(r, i-1, i, i-1, c, s)
+		}
+		rotateCols(q, i-1, i, c, s)
+	}
+
+	// Add the rank-one update to the first row of R.
+	f := alpha * wd[0]
+	row := r.mat.Data[:n]
+	for j := range row {
+		row[j] += f * y.AtVec(j)
+	}
+
+	// Restore the upper triangular form of R.
+	for i := 0; i < n && i+1 < m; i++ {
+		rmat := r.mat
+		c, s, rr, _ := blas64.Rotg(rmat.Data[i*rmat.Stride+i], rmat.Data[(i+1)*rmat.Stride+i])
+		rmat.Data[i*rmat.Stride+i] = rr
+		rmat.Data[(i+1)*rmat.Stride+i] = 0
+		rotateRows(r, i, i+1, i+1, c, s)
+		rotateCols(q, i, i+1, c, s)
+	}
+
+	qr.setExplicit(q, r)
+}
+
+// InsertRow updates a QR factorization as if the row vector xᵀ had been
+// inserted into the original matrix A before its k-th row, storing the result
+// into the receiver. That is, if in the original QR decomposition Q * R = A
+// where A is m×n, in the updated decomposition Q * R = A' where A' is the
+// (m+1)×n matrix
+//  [ A[:k,:] ]
+//  [   xᵀ    ]
+//  [ A[k:,:] ]
+// InsertRow will panic if orig does not contain a factorization, if k is not
+// in the range [0, m] or if the length of x is not n.
+//
+// InsertRow uses Givens rotations and updates the factorization in O(m*n)
+// time apart from copying Q. The first modification of a factorization forms
+// the m×m factor Q explicitly.
+func (qr *QR) InsertRow(orig *QR, k int, x Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.qr.Dims()
+	if k < 0 || m < k {
+		panic(ErrRowAccess)
+	}
+	if r, c := x.Dims(); r != n || c != 1 {
+		panic(ErrShape)
+	}
+
+	// The algorithm is described in section 12.5.3 of
+	//  G. H. Golub, C. F. Van Loan: Matrix Computations, 4th edition.
+	//  Johns Hopkins University Press (2013).
+	q0, r0 := orig.explicitFactors()
+
+	// Form the extended factors
+	//  Q = P * [Q 0]  and  R = [R ]
+	//          [0 1]           [xᵀ]
+	// where the permutation P moves the last row to the k-th position.
+	q := NewDense(m+1, m+1, nil)
+	for i := 0; i < m; i++ {
+		ii := i
+		if i >= k {
+			ii++
+		}
+		copy(q.mat.Data[ii*q.mat.Stride:ii*q.mat.Stride+m], q0.mat.Data[i*q0.mat.Stride:i*q0.mat.Stride+m])
+	}
+	q.mat.Data[k*q.mat.Stride+m] = 1
+	r := NewDense(m+1, n, nil)
+	r.slice(0, m, 0, n).Copy(r0)
+	for j := 0; j < n; j++ {
+		r.mat.Data[m*r.mat.Stride+j] = x.AtVec(j)
+	}
+
+	// Eliminate the last row of R by Givens rotations.
+	for j := 0; j < n; j++ {
+		rmat := r.mat
+		c, s, rr, _ := blas64.Rotg(rmat.Data[j*rmat.Stride+j], rmat.Data[m*rmat.Stride+j])
+		rmat.Data[j*rmat.Stride+j] = rr
+		rmat.Data[m*rmat.Stride+j] = 0
+		rotateRows(r, j, m, j+1, c, s)
+		rotateCols(q, j, m, c, s)
+	}
+
+	qr.setExplicit(q, r)
+}
+
+// DeleteRow updates a QR factorization as if the k-th row had been deleted
+// from the original matrix A, storing the result into the receiver. That is,
+// if in the original QR decomposition Q * R = A where A is m×n, in the updated
+// decomposition Q * R = A' where A' is the (m-1)×n matrix
+//  [ A[:k,:]   ]
+//  [ A[k+1:,:] ]
+// DeleteRow will panic if orig does not contain a factorization, if k is not
+// in the range [0, m) or if m-1 < n.
+//
+// DeleteRow uses Givens rotations and updates the factorization in O(m²)
+// time. The first modification of a factorization forms the m×m factor Q
+// explicitly.
+func (qr *QR) DeleteRow(orig *QR, k int) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.qr.Dims()
+	if k < 0 || m <= k {
+		panic(ErrRowAccess)
+	}
+	if m-1 < n {
+		panic(ErrShape)
+	}
+
+	// The algorithm is described in section 12.5.3 of
+	//  G. H. Golub, C. F. Van Loan: Matrix Computations, 4th edition.
+	//  Johns Hopkins University Press (2013).
+	q, r := orig.explicitFactors()
+
+	// Reduce the k-th row of Q to a multiple of e_0 by Givens rotations from
+	// the right, applying them also to R, which becomes upper Hessenberg.
+	// Since Q is orthogonal, its first column then becomes ±e_k.
+	w := make([]float64, m)
+	copy(w, q.mat.Data[k*q.mat.Stride:k*q.mat.Stride+m])
+	for i := m - 1; i > 0; i-- {
+		c, s, rr, _ := blas64.Rotg(w[i-1], w[i])
+		w[i-1] = rr
+		w[i] = 0
+		if i-1 < n {
+			rotateRows(r, i-1, i, i-1, c, s)
+		}
+		rotateCols(q, i-1, i, c, s)
+	}
+
+	// Remove the k-th row and the first column of Q and the first row of R,
+	// which leaves R upper triangular.
+	newQ := NewDense(m-1, m-1, nil)
+	for i := 0; i < m; i++ {
+		if i == k {
+			continue
+		}
+		ii := i
+		if i > k {
+			ii--
+		}
+		copy(newQ.mat.Data[ii*newQ.mat.Stride:ii*newQ.mat.Stride+m-1], q.mat.Data[i*q.mat.Stride+1:i*q.mat.Stride+m])
+	}
+	newR := NewDense(m-1, n, nil)
+	newR.Copy(r.slice(1, m, 0, n))
+
+	qr.setExplicit(newQ, newR)
+}
+
+// explicitFactors returns newly allocated copies of the m×m orthogonal factor
+// Q and the m×n upper trapezoidal factor R of the factorization.
+func (qr *QR) explicitFactors() (q, r *Dense) {
+	m, n := qr.qr.Dims()
+	q = NewDense(m, m, nil)
+	qr.QTo(q)
+	r = NewDense(m, n, nil)
+	t := qr.qr.asTriDense(n, blas.NonUnit, blas.Upper)
+	r.slice(0, n, 0, n).Copy(t)
+	return q, r
+}
+
+// setExplicit sets the factorization held by the receiver to the explicit
+// factors q and r and updates the condition number.
+func (qr *QR) setExplicit(q, r *Dense) {
+	qr.q = q
+	qr.qr = r
+	qr.tau = nil
+	qr.updateCond(CondNorm)
+}
+
+// rotateRows applies the Givens rotation defined by c and s to the rows i and
+// k of a in the columns from j onwards.
+func rotateRows(a *Dense, i, k, j int, c, s float64) {
+	n := a.mat.Cols - j
+	if n <= 0 {
+		return
+	}
+	blas64.Rot(
+		blas64.Vector{N: n, Data: a.mat.Data[i*a.mat.Stride+j:], Inc: 1},
+		blas64.Vector{N: n, Data: a.mat.Data[k*a.mat.Stride+j:], Inc: 1},
+		c, s)
+}
+
+// rotateCols applies the Givens rotation defined by c and s to the columns i
+// and k of a.
+func rotateCols(a *Dense, i, k int, c, s float64) {
+	blas64.Rot(
+		blas64.Vector{N: a.mat.Rows, Data: a.mat.Data[i:], Inc: a.mat.Stride},
+		blas64.Vector{N: a.mat.Rows, Data: a.mat.Data[k:], Inc: a.mat.Stride},
+		c, s)
+}
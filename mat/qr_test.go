@@ -11,6 +11,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 func TestQR(t *testing.T) {
@@ -73,6 +74,205 @@ func isOrthonormal(q *Dense, tol float64) bool {
 	return true
 }
 
+func TestQRRankOne(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{3, 3},
+		{5, 3},
+		{10, 4},
+		{10, 10},
+	} {
+		m := test.m
+		n := test.n
+		for _, inPlace := range []bool{false, true} {
+			a := randNormalDense(m, n, rnd)
+			var qr QR
+			qr.Factorize(a)
+
+			dst := &QR{}
+			if inPlace {
+				dst = &qr
+			}
+			// Apply two updates to test both the update of the compact
+			// and of the explicit representation.
+			want := DenseCopyOf(a)
+			for k := 0; k < 2; k++ {
+				x := randNormalVec(m, rnd)
+				y := randNormalVec(n, rnd)
+				alpha := rnd.NormFloat64()
+				want.RankOne(want, alpha, x, y)
+				if k == 0 {
+					dst.RankOne(&qr, alpha, x, y)
+				} else {
+					dst.RankOne(dst, alpha, x, y)
+				}
+				if err := checkQRFactors(dst, want, 1e-12); err != "" {
+					t.Errorf("m=%d,n=%d,inPlace=%t,update %d: %s", m, n, inPlace, k, err)
+				}
+			}
+		}
+	}
+}
+
+func TestQRInsertRow(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{3, 3},
+		{5, 3},
+		{10, 4},
+	} {
+		m := test.m
+		n := test.n
+		for k := 0; k <= m; k++ {
+			a := randNormalDense(m, n, rnd)
+			x := randNormalVec(n, rnd)
+			want := NewDense(m+1, n, nil)
+			for i := 0; i < m+1; i++ {
+				switch {
+				case i < k:
+					want.SetRow(i, a.RawRowView(i))
+				case i == k:
+					want.SetRow(i, x.RawVector().Data)
+				default:
+					want.SetRow(i, a.RawRowView(i-1))
+				}
+			}
+
+			var qr QR
+			qr.Factorize(a)
+			var dst QR
+			dst.InsertRow(&qr, k, x)
+			if err := checkQRFactors(&dst, want, 1e-12); err != "" {
+				t.Errorf("m=%d,n=%d,k=%d: %s", m, n, k, err)
+			}
+
+			// Deleting the inserted row in-place must recover the
+			// original matrix.
+			dst.DeleteRow(&dst, k)
+			if err := checkQRFactors(&dst, a, 1e-12); err != "" {
+				t.Errorf("m=%d,n=%d,k=%d: after deletion: %s", m, n, k, err)
+			}
+		}
+	}
+}
+
+func TestQRDeleteRow(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{2, 1},
+		{4, 3},
+		{6, 3},
+		{10, 4},
+	} {
+		m := test.m
+		n := test.n
+		for k := 0; k < m; k++ {
+			a := randNormalDense(m, n, rnd)
+			want := NewDense(m-1, n, nil)
+			for i := 0; i < m-1; i++ {
+				if i < k {
+					want.SetRow(i, a.RawRowView(i))
+				} else {
+					want.SetRow(i, a.RawRowView(i+1))
+				}
+			}
+
+			var qr QR
+			qr.Factorize(a)
+			var dst QR
+			dst.DeleteRow(&qr, k)
+			if err := checkQRFactors(&dst, want, 1e-12); err != "" {
+				t.Errorf("m=%d,n=%d,k=%d: %s", m, n, k, err)
+			}
+		}
+	}
+
+	var qr QR
+	qr.Factorize(randNormalDense(3, 3, rnd))
+	if panicked, _ := panics(func() { qr.DeleteRow(&qr, 0) }); !panicked {
+		t.Errorf("expected panic when deleting a row from a square factorization")
+	}
+}
+
+// randNormalDense returns an m×n matrix with elements drawn from a standard
+// normal distribution.
+func randNormalDense(m, n int, rnd *rand.Rand) *Dense {
+	a := NewDense(m, n, nil)
+	for i := range a.mat.Data {
+		a.mat.Data[i] = rnd.NormFloat64()
+	}
+	return a
+}
+
+// randNormalVec returns a vector of length n with elements drawn from a
+// standard normal distribution.
+func randNormalVec(n int, rnd *rand.Rand) *VecDense {
+	v := NewVecDense(n, nil)
+	for i := range v.mat.Data {
+		v.mat.Data[i] = rnd.NormFloat64()
+	}
+	return v
+}
+
+// checkQRFactors checks that the factorization held in qr is a valid QR
+// factorization of want and that it solves a linear system with want. It
+// returns a non-empty string describing the first failed check.
+func checkQRFactors(qr *QR, want *Dense, tol float64) string {
+	m, n := want.Dims()
+	var q, r Dense
+	qr.QTo(&q)
+	qr.RTo(&r)
+	if qm, qn := q.Dims(); qm != m || qn != m {
+		return "unexpected shape of Q"
+	}
+	if rm, rn := r.Dims(); rm != m || rn != n {
+		return "unexpected shape of R"
+	}
+	if !isOrthonormal(&q, tol) {
+		return "Q is not orthonormal"
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < min(i, n); j++ {
+			if r.At(i, j) != 0 {
+				return "R is not upper trapezoidal"
+			}
+		}
+	}
+	var got Dense
+	got.Mul(&q, &r)
+	if !EqualApprox(&got, want, tol) {
+		return "Q*R does not equal the updated matrix"
+	}
+
+	b := randNormalDense(m, 2, rand.New(rand.NewSource(1)))
+	var x, xWant Dense
+	err := qr.SolveTo(&x, false, b)
+	if err != nil {
+		return "unexpected error from SolveTo: " + err.Error()
+	}
+	var qrWant QR
+	qrWant.Factorize(want)
+	_ = qrWant.SolveTo(&xWant, false, b)
+	if !EqualApprox(&x, &xWant, 1e-10) {
+		return "SolveTo mismatch with solution from full factorization"
+	}
+	if !scalar.EqualWithinAbsOrRel(qr.Cond(), qrWant.Cond(), 1e-10, 1e-10) {
+		return "condition number mismatch"
+	}
+	return ""
+}
+
 func TestQRSolveTo(t *testing.T) {
 	t.Parallel()
 	rnd := rand.New(rand.NewSource(1))
@@ -0,0 +1,299 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badQRP = "mat: invalid QRP factorization"
+
+// QRP is a type for creating and using the QR factorization with column
+// pivoting of a matrix. The factorization is rank-revealing, and can be used
+// to find minimum-norm solutions to rank-deficient least squares problems.
+type QRP struct {
+	qr   *Dense
+	tau  []float64
+	jpvt []int
+}
+
+// Factorize computes the QR factorization with column pivoting of an m×n
+// matrix a. The factorization always exists even if A is singular or m < n.
+//
+// The pivoted QR decomposition is a factorization of the matrix A such that
+//  A * P = Q * R,
+// where P is an n×n permutation matrix, Q is an orthonormal m×m matrix and R
+// is an m×n upper trapezoidal matrix whose diagonal elements are
+// non-increasing in magnitude. Q and R can be extracted using the QTo and RTo
+// methods, and the permutation using the Pivot method.
+func (qr *QRP) Factorize(a Matrix) {
+	m, n := a.Dims()
+	k := min(m, n)
+	if qr.qr == nil {
+		qr.qr = &Dense{}
+	}
+	qr.qr.CloneFrom(a)
+	qr.tau = make([]float64, k)
+	qr.jpvt = make([]int, n)
+	for i := range qr.jpvt {
+		// All columns are free.
+		qr.jpvt[i] = -1
+	}
+	work := []float64{0}
+	lapack64.Geqp3(qr.qr.mat, qr.jpvt, qr.tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Geqp3(qr.qr.mat, qr.jpvt, qr.tau, work, len(work))
+	putFloat64s(work)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (qr *QRP) isValid() bool {
+	return qr.qr != nil && !qr.qr.IsEmpty()
+}
+
+// Rank returns the numerical rank of the factorized matrix, that is the
+// number of diagonal elements of R whose magnitude is greater than
+// tol times the magnitude of the largest diagonal element R[0,0].
+// Rank will panic if tol is negative or if the receiver does not contain a
+// factorization.
+func (qr *QRP) Rank(tol float64) int {
+	if !qr.isValid() {
+		panic(badQRP)
+	}
+	if tol < 0 {
+		panic("mat: negative tolerance")
+	}
+	m, n := qr.qr.Dims()
+	k := min(m, n)
+	if k == 0 {
+		return 0
+	}
+	r := qr.qr.mat
+	thresh := tol * math.Abs(r.Data[0])
+	var rank int
+	for rank < k && math.Abs(r.Data[rank*r.Stride+rank]) > thresh {
+		rank++
+	}
+	return rank
+}
+
+// Pivot returns the column pivot indices of the factorization. Column j of
+// A * P is column pivot[j] of A, so the permutation matrix P has P[pivot[j],j]
+// equal to one. It is the transpose of the matrix constructed by
+//  p.Permutation(n, pivot)
+// (see Dense.Permutation). If dst == nil, then new memory will be allocated,
+// otherwise the length of the input must be equal to the number of columns of
+// the factorized matrix.
+// Pivot will panic if the receiver does not contain a factorization.
+func (qr *QRP) Pivot(dst []int) []int {
+	if !qr.isValid() {
+		panic(badQRP)
+	}
+
+	n := len(qr.jpvt)
+	if dst == nil {
+		dst = make([]int, n)
+	}
+	if len(dst) != n {
+		panic(badSliceLength)
+	}
+	copy(dst, qr.jpvt)
+	return dst
+}
+
+// RTo extracts the m×n upper trapezoidal matrix from a QRP decomposition.
+//
+// If dst is empty, RTo will resize dst to be m×n. When dst is non-empty,
+// RTo will panic if dst is not m×n. RTo will also panic if the receiver
+// does not contain a successful factorization.
+func (qr *QRP) RTo(dst *Dense) {
+	if !qr.isValid() {
+		panic(badQRP)
+	}
+
+	r, c := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+
+	// Copy the upper trapezoid and zero below the diagonal.
+	for i := 0; i < r; i++ {
+		row := dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+c]
+		zero(row[:min(i, c)])
+		if i < c {
+			copy(row[i:], qr.qr.mat.Data[i*qr.qr.mat.Stride+i:i*qr.qr.mat.Stride+c])
+		}
+	}
+}
+
+// QTo extracts the m×m orthonormal matrix Q from a QRP decomposition.
+//
+// If dst is empty, QTo will resize dst to be m×m. When dst is non-empty,
+// QTo will panic if dst is not m×m. QTo will also panic if the receiver
+// does not contain a successful factorization.
+func (qr *QRP) QTo(dst *Dense) {
+	if !qr.isValid() {
+		panic(badQRP)
+	}
+
+	r, _ := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, r)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || r != c2 {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+
+	// Set Q = I.
+	for i := 0; i < r*r; i += r + 1 {
+		dst.mat.Data[i] = 1
+	}
+
+	// Construct Q from the elementary reflectors.
+	q := qr.reflectors()
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, blas.NoTrans, q, qr.tau, dst.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, blas.NoTrans, q, qr.tau, dst.mat, work, len(work))
+	putFloat64s(work)
+}
+
+// reflectors returns the m×min(m,n) view of the factorization holding the
+// elementary reflectors that define Q.
+func (qr *QRP) reflectors() blas64.General {
+	q := qr.qr.mat
+	q.Cols = len(qr.tau)
+	return q
+}
+
+// SolveTo finds the minimum-norm solution to the least squares problem
+//  minimize ||A*X - B||_2,
+// where A is an m×n matrix represented in its pivoted QR factorized form, and
+// stores the solution X into dst. A may be rank-deficient and m may be less
+// than n.
+//
+// The numerical rank of A is determined as by Rank(tol), and the trailing
+// part of R that is negligible at that tolerance is treated as zero. The
+// remaining leading rows of R are reduced to triangular form by a complete
+// orthogonal decomposition
+//  A * P = Q * [ T 0 ] * Z,
+//              [ 0 0 ]
+// from which the minimum-norm solution is computed. SolveTo returns the rank
+// that was used.
+//
+// SolveTo will panic if tol is negative, if b does not have m rows, or if
+// the receiver does not contain a factorization.
+func (qr *QRP) SolveTo(dst *Dense, b Matrix, tol float64) (rank int) {
+	if !qr.isValid() {
+		panic(badQRP)
+	}
+
+	m, n := qr.qr.Dims()
+	br, bc := b.Dims()
+	if br != m {
+		panic(ErrShape)
+	}
+	rank = qr.Rank(tol)
+	dst.reuseAsNonZeroed(n, bc)
+	if rank == 0 {
+		dst.Zero()
+		return 0
+	}
+
+	// The solve is performed in place in the workspace w which must be large
+	// enough to hold both B and X.
+	w := getDenseWorkspace(max(m, n), bc, false)
+	w.Copy(b)
+	wb := w.mat
+	wb.Rows = m
+
+	// Compute W = Qᵀ * B.
+	q := qr.reflectors()
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, blas.Trans, q, qr.tau, wb, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, blas.Trans, q, qr.tau, wb, work, len(work))
+	putFloat64s(work)
+
+	// Reduce the leading rank×n block [ R11 R12 ] of R to [ T 0 ] * Z.
+	t := getDenseWorkspace(rank, n, true)
+	for i := 0; i < rank; i++ {
+		copy(t.mat.Data[i*t.mat.Stride+i:i*t.mat.Stride+n], qr.qr.mat.Data[i*qr.qr.mat.Stride+i:i*qr.qr.mat.Stride+n])
+	}
+	tau := getFloat64s(rank, false)
+	work = []float64{0}
+	lapack64.Tzrzf(t.mat, tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Tzrzf(t.mat, tau, work, len(work))
+	putFloat64s(work)
+
+	// Solve T * Y = W[0:rank,:] and set W[rank:n,:] to zero.
+	wr := w.mat
+	wr.Rows = rank
+	tri := t.asTriDense(rank, blas.NonUnit, blas.Upper).mat
+	lapack64.Trtrs(blas.NoTrans, tri, wr)
+	for i := rank; i < n; i++ {
+		zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+	}
+
+	// Compute W = Zᵀ * W.
+	wn := w.mat
+	wn.Rows = n
+	work = []float64{0}
+	lapack64.Ormrz(blas.Left, blas.Trans, t.mat, n-rank, tau, wn, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormrz(blas.Left, blas.Trans, t.mat, n-rank, tau, wn, work, len(work))
+	putFloat64s(work)
+	putFloat64s(tau)
+	putDenseWorkspace(t)
+
+	// Undo the column permutation, X = P * W.
+	for i, p := range qr.jpvt {
+		copy(dst.mat.Data[p*dst.mat.Stride:p*dst.mat.Stride+bc], w.mat.Data[i*w.mat.Stride:i*w.mat.Stride+bc])
+	}
+	putDenseWorkspace(w)
+	return rank
+}
+
+// SolveVecTo finds the minimum-norm solution to the least squares problem
+//  minimize ||A*x - b||_2.
+// See QRP.SolveTo for the full documentation.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (qr *QRP) SolveVecTo(dst *VecDense, b Vector, tol float64) (rank int) {
+	if !qr.isValid() {
+		panic(badQRP)
+	}
+
+	_, n := qr.qr.Dims()
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+
+	// The Solve implementation is non-trivial, so rather than duplicate the code,
+	// instead recast the VecDenses as Dense and call the matrix code.
+	bm := Matrix(b)
+	if rv, ok := b.(RawVectorer); ok {
+		bmat := rv.RawVector()
+		if dst != b {
+			dst.checkOverlap(bmat)
+		}
+		b := VecDense{mat: bmat}
+		bm = b.asDense()
+	}
+	dst.reuseAsNonZeroed(n)
+	return qr.SolveTo(dst.asDense(), bm, tol)
+}
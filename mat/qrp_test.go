@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// randomRankDeficient returns a random m×n matrix of rank r.
+func randomRankDeficient(m, n, r int, rnd *rand.Rand) *Dense {
+	a := NewDense(m, n, nil)
+	if r == 0 {
+		return a
+	}
+	u := NewDense(m, r, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < r; j++ {
+			u.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	v := NewDense(r, n, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < n; j++ {
+			v.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	a.Mul(u, v)
+	return a
+}
+
+func TestQRP(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{1, 1, 1},
+		{5, 5, 5},
+		{10, 5, 5},
+		{5, 10, 5},
+		{8, 8, 3},
+		{12, 7, 4},
+		{7, 12, 4},
+		{6, 6, 0},
+		{40, 30, 20},
+		{30, 40, 30},
+	} {
+		m, n, rank := test.m, test.n, test.rank
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", m, n, rank)
+
+		a := randomRankDeficient(m, n, rank, rnd)
+		var qr QRP
+		qr.Factorize(a)
+
+		if got := qr.Rank(1e-12); got != rank {
+			t.Errorf("%s: unexpected rank: got %d, want %d", name, got, rank)
+		}
+
+		var q, r Dense
+		qr.QTo(&q)
+		if !isOrthonormal(&q, 1e-10) {
+			t.Errorf("%s: Q is not orthonormal", name)
+		}
+		qr.RTo(&r)
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("%s: R is not upper trapezoidal", name)
+				}
+			}
+		}
+		for i := 1; i < min(m, n); i++ {
+			if math.Abs(r.At(i, i)) > math.Abs(r.At(i-1, i-1)) {
+				t.Errorf("%s: diagonal of R is not non-increasing in magnitude", name)
+				break
+			}
+		}
+
+		// Check that A * P = Q * R, with P the transpose of the matrix
+		// constructed by Dense.Permutation.
+		pivot := qr.Pivot(nil)
+		var p, ap, qrm Dense
+		p.Permutation(n, pivot)
+		ap.Mul(a, p.T())
+		qrm.Mul(&q, &r)
+		if !EqualApprox(&ap, &qrm, 1e-12) {
+			t.Errorf("%s: A*P != Q*R", name)
+		}
+	}
+}
+
+func TestQRPSolveTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank, bc int
+	}{
+		{1, 1, 1, 1},
+		{5, 5, 5, 2},
+		{10, 5, 5, 3},
+		{5, 10, 5, 1},
+		{8, 8, 3, 4},
+		{12, 7, 4, 2},
+		{7, 12, 4, 3},
+		{6, 4, 0, 2},
+		{40, 30, 20, 5},
+		{30, 50, 25, 5},
+	} {
+		m, n, rank, bc := test.m, test.n, test.rank, test.bc
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d,bc=%d", m, n, rank, bc)
+
+		a := randomRankDeficient(m, n, rank, rnd)
+		b := NewDense(m, bc, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < bc; j++ {
+				b.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		bCopy := DenseCopyOf(b)
+
+		var qr QRP
+		qr.Factorize(a)
+		var x Dense
+		gotRank := qr.SolveTo(&x, b, 1e-12)
+		if gotRank != rank {
+			t.Errorf("%s: unexpected rank: got %d, want %d", name, gotRank, rank)
+		}
+		if !Equal(b, bCopy) {
+			t.Errorf("%s: b modified", name)
+		}
+
+		// Compare with the minimum-norm solution from the SVD.
+		want := NewDense(n, bc, nil)
+		if rank > 0 {
+			var svd SVD
+			if ok := svd.Factorize(a, SVDFull); !ok {
+				t.Fatalf("%s: SVD factorization failed", name)
+			}
+			svd.SolveTo(want, b, rank)
+		}
+		if !EqualApprox(&x, want, 1e-10) {
+			t.Errorf("%s: unexpected solution\ngot: %v\nwant: %v", name, Formatted(&x), Formatted(want))
+		}
+
+		// Check the vector solve against the columns of the matrix solve.
+		for j := 0; j < bc; j++ {
+			var xv VecDense
+			qr.SolveVecTo(&xv, b.ColView(j), 1e-12)
+			if !EqualApprox(&xv, x.ColView(j), 1e-14) {
+				t.Errorf("%s: vector solve mismatch for column %d", name, j)
+			}
+		}
+	}
+}
+
+func TestQRPPanics(t *testing.T) {
+	t.Parallel()
+	var qr QRP
+	if panicked, _ := panics(func() { qr.Rank(0) }); !panicked {
+		t.Errorf("expected panic for Rank with empty receiver")
+	}
+	qr.Factorize(NewDense(3, 2, []float64{1, 2, 3, 4, 5, 6}))
+	if panicked, _ := panics(func() { qr.Rank(-1) }); !panicked {
+		t.Errorf("expected panic for negative tolerance")
+	}
+	if panicked, _ := panics(func() { qr.Pivot(make([]int, 3)) }); !panicked {
+		t.Errorf("expected panic for bad pivot length")
+	}
+	var x Dense
+	if panicked, _ := panics(func() { qr.SolveTo(&x, NewDense(2, 1, nil), 0) }); !panicked {
+		t.Errorf("expected panic for mismatched right-hand side")
+	}
+}
@@ -0,0 +1,724 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const noSchurVectors = "mat: Schur vectors not computed"
+
+// Schur is a type for creating and using the real Schur decomposition of a
+// square matrix.
+//
+// The real Schur decomposition of an n×n matrix A is
+//  A = Z * T * Zᵀ
+// where Z is an n×n orthogonal matrix of Schur vectors and T is an n×n upper
+// quasi-triangular matrix in Schur canonical form. T is block upper triangular
+// with 1×1 and 2×2 diagonal blocks. Each 2×2 diagonal block has its diagonal
+// elements equal and its off-diagonal elements of opposite sign, and
+// corresponds to a complex conjugate pair of eigenvalues of A.
+type Schur struct {
+	vectorsComputed bool
+
+	t      *Dense
+	z      *Dense
+	values []complex128
+}
+
+// Factorize computes the real Schur decomposition of the square matrix a. If
+// the vectors input argument is false, the Schur vectors are not computed.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (s *Schur) Factorize(a Matrix, vectors bool) (ok bool) {
+	// kill previous factorization.
+	s.vectorsComputed = false
+	s.t = nil
+	s.z = nil
+	s.values = nil
+
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	n := r
+	t := NewDense(n, n, nil)
+	t.Copy(a)
+
+	jobvs := lapack.SchurNone
+	z := blas64.General{Stride: 1}
+	if vectors {
+		jobvs = lapack.SchurOrig
+		z = blas64.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: n,
+			Data:   make([]float64, n*n),
+		}
+	}
+	wr := make([]float64, n)
+	wi := make([]float64, n)
+	work := []float64{0}
+	lapack64.Gees(jobvs, t.mat, wr, wi, z, work, -1)
+
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Gees(jobvs, t.mat, wr, wi, z, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		return false
+	}
+
+	s.vectorsComputed = vectors
+	s.t = t
+	if vectors {
+		s.z = NewDense(n, n, z.Data)
+	}
+	s.values = make([]complex128, n)
+	for i := range s.values {
+		s.values[i] = complex(wr[i], wi[i])
+	}
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (s *Schur) succFact() bool {
+	return s.t != nil
+}
+
+// Values extracts the eigenvalues of the factorized matrix in the order in
+// which they appear on the diagonal of T. Complex conjugate pairs of
+// eigenvalues appear consecutively with the eigenvalue having the positive
+// imaginary part first. If dst is non-nil, the values are stored in-place into
+// dst. In this case dst must have length n, otherwise Values will panic. If
+// dst is nil, then a new slice will be allocated of the proper length and
+// filled with the eigenvalues.
+//
+// Values panics if the receiver does not contain a successful factorization.
+func (s *Schur) Values(dst []complex128) []complex128 {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, len(s.values))
+	}
+	if len(dst) != len(s.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, s.values)
+	return dst
+}
+
+// TTo stores the n×n upper quasi-triangular Schur form T into dst.
+//
+// If dst is empty, TTo will resize dst to be n×n. When dst is non-empty, TTo
+// will panic if dst is not n×n. TTo will also panic if the receiver does not
+// contain a successful factorization.
+func (s *Schur) TTo(dst *Dense) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	n := s.t.mat.Rows
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(s.t)
+}
+
+// ZTo stores the n×n orthogonal matrix of Schur vectors Z into dst.
+//
+// If dst is empty, ZTo will resize dst to be n×n. When dst is non-empty, ZTo
+// will panic if dst is not n×n. ZTo will also panic if the Schur vectors were
+// not computed during the factorization, or if the receiver does not contain
+// a successful factorization.
+func (s *Schur) ZTo(dst *Dense) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	if !s.vectorsComputed {
+		panic(noSchurVectors)
+	}
+	n := s.t.mat.Rows
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(s.z)
+}
+
+// Reorder reorders the Schur factorization so that the diagonal block of T
+// with row index ifst is moved to row ilst by an orthogonal similarity
+// transformation. The Schur vectors are updated if they were computed. All
+// other eigenvalues keep their relative order, and T remains in Schur
+// canonical form.
+//
+// If ifst points to the second row of a 2×2 block, it is treated as pointing
+// to the first row. Reorder returns the row index of the first row of the
+// moved block in its final position, which may differ from ilst by one when
+// 2×2 blocks are involved.
+//
+// If ok is false, two adjacent blocks were too close to swap and the
+// factorization may have been partially reordered. It is still a valid Schur
+// factorization.
+//
+// Reorder panics if ifst or ilst is out of range, or if the receiver does not
+// contain a successful factorization.
+func (s *Schur) Reorder(ifst, ilst int) (ilstOut int, ok bool) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	n := s.t.mat.Rows
+	if ifst < 0 || n <= ifst || ilst < 0 || n <= ilst {
+		panic(ErrIndexOutOfRange)
+	}
+	work := getFloat64s(n, false)
+	_, ilstOut, ok = s.trexc(ifst, ilst, work)
+	putFloat64s(work)
+	s.updateValues()
+	return ilstOut, ok
+}
+
+// ReorderSelect reorders the Schur factorization so that the eigenvalues for
+// which sel returns true are moved to the leading diagonal blocks of T by an
+// orthogonal similarity transformation. The Schur vectors are updated if they
+// were computed. The selected and the unselected eigenvalues each keep their
+// relative order.
+//
+// A complex conjugate pair of eigenvalues cannot be split, so the pair is
+// selected if sel returns true for either of its eigenvalues.
+//
+// ReorderSelect returns the number of selected eigenvalues k. The columns
+// 0 to k-1 of Z then span the invariant subspace of A corresponding to the
+// selected eigenvalues. If ok is false, two adjacent blocks were too close to
+// swap and the factorization may have been partially reordered. It is still
+// a valid Schur factorization.
+//
+// ReorderSelect panics if the receiver does not contain a successful
+// factorization.
+func (s *Schur) ReorderSelect(sel func(complex128) bool) (k int, ok bool) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	n := s.t.mat.Rows
+	t := s.t.mat
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	defer s.updateValues()
+
+	// The blocks below the current block have not been moved yet, so
+	// their eigenvalues in s.values are still valid.
+	for i := 0; i < n; {
+		bs := 1
+		if i < n-1 && t.Data[(i+1)*t.Stride+i] != 0 {
+			bs = 2
+		}
+		swap := sel(s.values[i])
+		if bs == 2 {
+			swap = swap || sel(s.values[i+1])
+		}
+		if swap {
+			if i != k {
+				_, _, ok = s.trexc(i, k, work)
+				if !ok {
+					return k, false
+				}
+			}
+			k += bs
+		}
+		i += bs
+	}
+	return k, true
+}
+
+// trexc moves the diagonal block of T at ifst to ilst using Dtrexc, updating
+// Z if it has been computed.
+func (s *Schur) trexc(ifst, ilst int, work []float64) (ifstOut, ilstOut int, ok bool) {
+	compq := lapack.UpdateSchurNone
+	z := blas64.General{Stride: 1}
+	if s.vectorsComputed {
+		compq = lapack.UpdateSchur
+		z = s.z.mat
+	}
+	return lapack64.Trexc(compq, s.t.mat, z, ifst, ilst, work)
+}
+
+// updateValues recomputes the eigenvalues from the diagonal blocks of T.
+func (s *Schur) updateValues() {
+	schurValues(s.values, s.t.mat)
+}
+
+// schurValues stores into dst the eigenvalues of the upper quasi-triangular
+// matrix t in Schur canonical form.
+func schurValues(dst []complex128, t blas64.General) {
+	n := t.Rows
+	for i := 0; i < n; {
+		tii := t.Data[i*t.Stride+i]
+		if i == n-1 || t.Data[(i+1)*t.Stride+i] == 0 {
+			dst[i] = complex(tii, 0)
+			i++
+			continue
+		}
+		im := math.Sqrt(math.Abs(t.Data[i*t.Stride+i+1])) * math.Sqrt(math.Abs(t.Data[(i+1)*t.Stride+i]))
+		dst[i] = complex(tii, im)
+		dst[i+1] = complex(tii, -im)
+		i += 2
+	}
+}
+
+// sqrtQuasiTri computes the principal square root of the n×n upper
+// quasi-triangular matrix t in Schur canonical form, placing the result in
+// the quasi-triangular matrix r. t must not have real negative eigenvalues.
+// sqrtQuasiTri returns ErrSingular if the square root could not be computed
+// accurately.
+//
+// The algorithm is from N. J. Higham, Computing real square roots of a real
+// matrix, Linear Algebra Appl. 88/89 (1987), pp. 405-430.
+// https://doi.org/10.1016/0024-3795(87)90118-2
+func sqrtQuasiTri(r, t blas64.General) error {
+	n := t.Rows
+	for i := 0; i < n; i++ {
+		zero(r.Data[i*r.Stride : i*r.Stride+n])
+	}
+
+	// Compute the square roots of the diagonal blocks.
+	for i := 0; i < n; {
+		tii := t.Data[i*t.Stride+i]
+		if i == n-1 || t.Data[(i+1)*t.Stride+i] == 0 {
+			r.Data[i*r.Stride+i] = math.Sqrt(tii)
+			i++
+			continue
+		}
+		// The 2×2 block has eigenvalues θ ± iµ with θ = tii. Its
+		// principal square root is α*I + (T_ii - θ*I)/(2α) where
+		// α = sqrt((|λ|+θ)/2).
+		tij := t.Data[i*t.Stride+i+1]
+		tji := t.Data[(i+1)*t.Stride+i]
+		mu := math.Sqrt(math.Abs(tij)) * math.Sqrt(math.Abs(tji))
+		alpha := math.Sqrt((math.Hypot(tii, mu) + math.Abs(tii)) / 2)
+		if tii < 0 {
+			// Avoid cancellation for eigenvalues in the left
+			// half-plane.
+			alpha = mu / (2 * alpha)
+		}
+		r.Data[i*r.Stride+i] = alpha
+		r.Data[i*r.Stride+i+1] = tij / (2 * alpha)
+		r.Data[(i+1)*r.Stride+i] = tji / (2 * alpha)
+		r.Data[(i+1)*r.Stride+i+1] = alpha
+		i += 2
+	}
+
+	// Compute the off-diagonal blocks one block column at a time. The block
+	// column X = R[:j,j] above the diagonal block R[j,j] satisfies the
+	// Sylvester equation
+	//  R[:j,:j]*X + X*R[j,j] = T[:j,j]
+	// which only involves the block columns to its left.
+	bi := blas64.Implementation()
+	for j := 0; j < n; {
+		bs := 1
+		if j < n-1 && t.Data[(j+1)*t.Stride+j] != 0 {
+			bs = 2
+		}
+		if j > 0 {
+			x := blas64.General{
+				Rows:   j,
+				Cols:   bs,
+				Stride: r.Stride,
+				Data:   r.Data[j:],
+			}
+			for i := 0; i < j; i++ {
+				copy(x.Data[i*x.Stride:i*x.Stride+bs], t.Data[i*t.Stride+j:i*t.Stride+j+bs])
+			}
+			rjj := blas64.General{
+				Rows:   bs,
+				Cols:   bs,
+				Stride: r.Stride,
+				Data:   r.Data[j*r.Stride+j:],
+			}
+			rii := blas64.General{
+				Rows:   j,
+				Cols:   j,
+				Stride: r.Stride,
+				Data:   r.Data,
+			}
+			scale, ok := lapack64.Trsyl(blas.NoTrans, blas.NoTrans, 1, rii, rjj, x)
+			if !ok || scale == 0 {
+				return ErrSingular
+			}
+			if scale != 1 {
+				for i := 0; i < j; i++ {
+					bi.Dscal(bs, 1/scale, x.Data[i*x.Stride:], 1)
+				}
+			}
+		}
+		j += bs
+	}
+	return nil
+}
+
+// schurParlett computes f(A) for the n×n matrix A with the complex Schur
+// factorization A = U*T*Uᴴ, where t and u are stored in row-major order with
+// stride n, using the Schur–Parlett algorithm. The eigenvalues of T are
+// grouped into clusters of close eigenvalues that are moved into contiguous
+// diagonal blocks, f is evaluated on each diagonal block by a Taylor series
+// and the off-diagonal blocks are computed from the block Parlett recurrence.
+// On return, t contains f(T) and u is updated with the reordering.
+// schurParlett returns false if a Taylor series did not converge.
+//
+// The algorithm is from P. I. Davies and N. J. Higham, A Schur–Parlett
+// algorithm for computing matrix functions, SIAM J. Matrix Anal. Appl. 25(2)
+// (2003), pp. 464-485. https://doi.org/10.1137/S0895479802410815
+func schurParlett(n int, t, u []complex128, f func(z complex128, k int) complex128) (ok bool) {
+	// delta is the separation of eigenvalues below which eigenvalues are
+	// placed in the same cluster.
+	const delta = 0.1
+
+	// Group the eigenvalues into clusters such that eigenvalues in
+	// different clusters are separated by more than delta.
+	cluster := make([]int, n)
+	for i := range cluster {
+		cluster[i] = -1
+	}
+	var p int
+	for i := 0; i < n; i++ {
+		if cluster[i] == -1 {
+			cluster[i] = p
+			p++
+		}
+		for j := i + 1; j < n; j++ {
+			if cluster[i] == cluster[j] || cmplx.Abs(t[i*n+i]-t[j*n+j]) > delta {
+				continue
+			}
+			if cluster[j] == -1 {
+				cluster[j] = cluster[i]
+				continue
+			}
+			// Merge the two clusters.
+			lo, hi := cluster[i], cluster[j]
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			for k, c := range cluster {
+				switch {
+				case c == hi:
+					cluster[k] = lo
+				case c > hi:
+					cluster[k]--
+				}
+			}
+			p--
+		}
+	}
+
+	// Order the clusters by the mean position of their eigenvalues and
+	// move the eigenvalues into contiguous blocks with adjacent swaps.
+	pos := make([]float64, p)
+	size := make([]int, p)
+	for i, c := range cluster {
+		pos[c] += float64(i)
+		size[c]++
+	}
+	for c := range pos {
+		pos[c] /= float64(size[c])
+	}
+	rank := make([]int, p)
+	for c := range rank {
+		for d := range rank {
+			if pos[d] < pos[c] || (pos[d] == pos[c] && d < c) {
+				rank[c]++
+			}
+		}
+	}
+	key := make([]int, n)
+	for i, c := range cluster {
+		key[i] = rank[c]
+	}
+	for i := 1; i < n; i++ {
+		for j := i; j > 0 && key[j-1] > key[j]; j-- {
+			swapComplexSchur(n, t, u, j-1)
+			key[j-1], key[j] = key[j], key[j-1]
+		}
+	}
+	blocks := make([]int, 0, p+1)
+	for i := 0; i < n; i++ {
+		if i == 0 || key[i] != key[i-1] {
+			blocks = append(blocks, i)
+		}
+	}
+	blocks = append(blocks, n)
+
+	// Evaluate f on the diagonal blocks.
+	fm := make([]complex128, n*n)
+	for b := 0; b < len(blocks)-1; b++ {
+		s, e := blocks[b], blocks[b+1]
+		if !funmAtom(e-s, t[s*n+s:], n, fm[s*n+s:], n, f) {
+			return false
+		}
+	}
+
+	// Compute the off-diagonal blocks F_ij column by column using the
+	// block Parlett recurrence
+	//  T_ii*F_ij - F_ij*T_jj = F_ii*T_ij - T_ij*F_jj + sum_k (F_ik*T_kj - T_ik*F_kj)
+	// where the sum is over the blocks between i and j.
+	for bj := 1; bj < len(blocks)-1; bj++ {
+		sj, ej := blocks[bj], blocks[bj+1]
+		for bi := bj - 1; bi >= 0; bi-- {
+			si, ei := blocks[bi], blocks[bi+1]
+			for q := sj; q < ej; q++ {
+				for r := ei - 1; r >= si; r-- {
+					var c complex128
+					for k := si; k < sj; k++ {
+						c += fm[r*n+k] * t[k*n+q]
+					}
+					for k := ei; k < ej; k++ {
+						c -= t[r*n+k] * fm[k*n+q]
+					}
+					// Solve the triangular Sylvester equation for
+					// the element (r,q).
+					for k := r + 1; k < ei; k++ {
+						c -= t[r*n+k] * fm[k*n+q]
+					}
+					for k := sj; k < q; k++ {
+						c += fm[r*n+k] * t[k*n+q]
+					}
+					fm[r*n+q] = c / (t[r*n+r] - t[q*n+q])
+				}
+			}
+		}
+	}
+	copy(t, fm)
+	return true
+}
+
+// funmAtom evaluates f at the n×n upper triangular matrix T whose eigenvalues
+// are close, using a Taylor series about the mean of the eigenvalues and
+// placing the result in the upper triangle of dst. f(z, k) must return the
+// k-th derivative of f at z. funmAtom returns false if the Taylor series did
+// not converge.
+func funmAtom(n int, t []complex128, ldt int, dst []complex128, ldd int, f func(z complex128, k int) complex128) (ok bool) {
+	const (
+		tol      = 1.0 / (1 << 52)
+		maxTerms = 500
+	)
+
+	if n == 1 {
+		dst[0] = f(t[0], 0)
+		return true
+	}
+
+	var lambda complex128
+	for i := 0; i < n; i++ {
+		lambda += t[i*ldt+i]
+	}
+	lambda /= complex(float64(n), 0)
+
+	// N = T - λI is strictly upper triangular up to the spread of the
+	// eigenvalues. P holds N^k/k!.
+	nm := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			nm[i*n+j] = t[i*ldt+j]
+		}
+		nm[i*n+i] -= lambda
+	}
+	pm := make([]complex128, n*n)
+	copy(pm, nm)
+	tmp := make([]complex128, n*n)
+
+	fm := make([]complex128, n*n)
+	f0 := f(lambda, 0)
+	for i := 0; i < n; i++ {
+		fm[i*n+i] = f0
+	}
+
+	// mu = ‖(I - |N_u|)⁻¹ e‖_∞ where N_u is the strictly upper triangular
+	// part of T, and e is the vector of ones.
+	y := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		y[i] = 1
+		for j := i + 1; j < n; j++ {
+			y[i] += cmplx.Abs(t[i*ldt+j]) * y[j]
+		}
+	}
+	var mu float64
+	for _, v := range y {
+		mu = math.Max(mu, v)
+	}
+
+	derivMax := make([]float64, maxTerms+n)
+	maxd := 1
+	for k := 1; k <= maxTerms; k++ {
+		fk := f(lambda, k)
+		oldNorm := normInfUpperC(n, fm)
+		var diff float64
+		for i := 0; i < n; i++ {
+			var sum float64
+			for j := i; j < n; j++ {
+				d := pm[i*n+j] * fk
+				fm[i*n+j] += d
+				sum += cmplx.Abs(d)
+			}
+			diff = math.Max(diff, sum)
+		}
+		fNorm := normInfUpperC(n, fm)
+
+		// P = P*N/(k+1).
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				var sum complex128
+				for l := i; l <= j; l++ {
+					sum += pm[i*n+l] * nm[l*n+j]
+				}
+				tmp[i*n+j] = sum / complex(float64(k+1), 0)
+			}
+		}
+		pm, tmp = tmp, pm
+
+		if diff > tol*(tol+oldNorm) {
+			continue
+		}
+		// Approximate the maximum of the derivatives on the convex hull
+		// of the eigenvalues by their maximum at the eigenvalues.
+		for j := maxd; j <= k+n-1; j++ {
+			var dm float64
+			for i := 0; i < n; i++ {
+				dm = math.Max(dm, cmplx.Abs(f(t[i*ldt+i], j)))
+			}
+			derivMax[j] = dm
+		}
+		maxd = k + n
+		var omega float64
+		fact := 1.0
+		for j := 0; j < n; j++ {
+			if j > 0 {
+				fact *= float64(j)
+			}
+			omega = math.Max(omega, derivMax[k+j]/fact)
+		}
+		if normInfUpperC(n, pm)*mu*omega <= tol*fNorm {
+			for i := 0; i < n; i++ {
+				copy(dst[i*ldd+i:i*ldd+n], fm[i*n+i:i*n+n])
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// normInfUpperC returns the infinity norm of the n×n upper triangular matrix
+// a stored with stride n.
+func normInfUpperC(n int, a []complex128) float64 {
+	var norm float64
+	for i := 0; i < n; i++ {
+		var sum float64
+		for _, v := range a[i*n+i : i*n+n] {
+			sum += cmplx.Abs(v)
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
+
+// realToComplexSchur converts the real Schur factorization A = Z*T*Zᵀ, with t
+// and z stored in row-major order with stride n, to a complex Schur
+// factorization A = U*T*Uᴴ with upper triangular T by applying a complex
+// rotation to each 2×2 diagonal block. t and z are overwritten with the
+// complex T and U.
+func realToComplexSchur(n int, t, z []complex128) {
+	for m := n - 1; m > 0; m-- {
+		k := m - 1
+		if t[m*n+k] == 0 {
+			continue
+		}
+		a, b := real(t[k*n+k]), real(t[k*n+m])
+		c, d := real(t[m*n+k]), real(t[m*n+m])
+		p := (a - d) / 2
+		mu := cmplx.Sqrt(complex(p*p+b*c, 0)) + complex(p, 0)
+		r := math.Hypot(cmplx.Abs(mu), c)
+		cs := mu / complex(r, 0)
+		sn := complex(c/r, 0)
+
+		// T[k:m+1,k:] = G*T[k:m+1,k:] with G = [cs' sn; -sn cs].
+		for j := k; j < n; j++ {
+			x, y := t[k*n+j], t[m*n+j]
+			t[k*n+j] = cmplx.Conj(cs)*x + sn*y
+			t[m*n+j] = -sn*x + cs*y
+		}
+		// T[:m+1,k:m+1] = T[:m+1,k:m+1]*Gᴴ.
+		for i := 0; i <= m; i++ {
+			x, y := t[i*n+k], t[i*n+m]
+			t[i*n+k] = cs*x + sn*y
+			t[i*n+m] = -sn*x + cmplx.Conj(cs)*y
+		}
+		// Z[:,k:m+1] = Z[:,k:m+1]*Gᴴ.
+		for i := 0; i < n; i++ {
+			x, y := z[i*n+k], z[i*n+m]
+			z[i*n+k] = cs*x + sn*y
+			z[i*n+m] = -sn*x + cmplx.Conj(cs)*y
+		}
+		t[m*n+k] = 0
+	}
+}
+
+// swapComplexSchur swaps the adjacent diagonal elements k and k+1 of the
+// n×n upper triangular matrix T in the complex Schur factorization
+// A = U*T*Uᴴ by a unitary similarity transformation, updating U.
+func swapComplexSchur(n int, t, u []complex128, k int) {
+	t11 := t[k*n+k]
+	t22 := t[(k+1)*n+k+1]
+
+	// Determine the rotation to perform the interchange.
+	cs, sn := complexGivens(t[k*n+k+1], t22-t11)
+	c := complex(cs, 0)
+
+	for j := k + 2; j < n; j++ {
+		x, y := t[k*n+j], t[(k+1)*n+j]
+		t[k*n+j] = c*x + sn*y
+		t[(k+1)*n+j] = c*y - cmplx.Conj(sn)*x
+	}
+	for i := 0; i < k; i++ {
+		x, y := t[i*n+k], t[i*n+k+1]
+		t[i*n+k] = c*x + cmplx.Conj(sn)*y
+		t[i*n+k+1] = c*y - sn*x
+	}
+	t[k*n+k] = t22
+	t[(k+1)*n+k+1] = t11
+
+	for i := 0; i < n; i++ {
+		x, y := u[i*n+k], u[i*n+k+1]
+		u[i*n+k] = c*x + cmplx.Conj(sn)*y
+		u[i*n+k+1] = c*y - sn*x
+	}
+}
+
+// complexGivens returns the cosine and sine of the plane rotation such that
+//  [  cs     sn ] [f]   [r]
+//  [ -conj(sn) cs ] [g] = [0]
+// where cs is real.
+func complexGivens(f, g complex128) (cs float64, sn complex128) {
+	if g == 0 {
+		return 1, 0
+	}
+	if f == 0 {
+		return 0, cmplx.Conj(g) / complex(cmplx.Abs(g), 0)
+	}
+	fa := cmplx.Abs(f)
+	norm := math.Hypot(fa, cmplx.Abs(g))
+	return fa / norm, f / complex(fa, 0) * cmplx.Conj(g) / complex(norm, 0)
+}
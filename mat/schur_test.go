@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSchur(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 31} {
+		for cas := 0; cas < 5; cas++ {
+			a := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			name := fmt.Sprintf("n=%d,case=%d", n, cas)
+
+			var schur Schur
+			ok := schur.Factorize(a, true)
+			if !ok {
+				t.Errorf("%v: unexpected failure", name)
+				continue
+			}
+			checkSchur(t, name, a, &schur, tol)
+
+			// Compare the eigenvalues with those computed by Eigen and
+			// by a factorization without Schur vectors.
+			var eig Eigen
+			if !eig.Factorize(a, EigenNone) {
+				t.Fatalf("%v: unexpected failure of Eigen", name)
+			}
+			got := schur.Values(nil)
+			if !sameComplexSet(got, eig.Values(nil), tol) {
+				t.Errorf("%v: eigenvalue mismatch\ngot  %v\nwant %v", name, got, eig.Values(nil))
+			}
+			var noVecs Schur
+			if !noVecs.Factorize(a, false) {
+				t.Errorf("%v: unexpected failure without Schur vectors", name)
+			} else if !cmplxEqual(noVecs.Values(nil), schur.Values(nil)) {
+				t.Errorf("%v: eigenvalue mismatch without Schur vectors", name)
+			}
+
+			// Move the last diagonal block to the top.
+			values := schur.Values(nil)
+			last := values[n-1]
+			ilst, ok := schur.Reorder(n-1, 0)
+			if !ok {
+				t.Errorf("%v: unexpected failure of Reorder", name)
+				continue
+			}
+			if ilst != 0 {
+				t.Errorf("%v: unexpected final row index of Reorder; got %d, want 0", name, ilst)
+			}
+			got = schur.Values(nil)
+			if imag(last) != 0 {
+				// The block was a complex conjugate pair.
+				last = values[n-2]
+			}
+			if math.Abs(real(got[0]-last)) > tol*math.Max(1, math.Abs(real(last))) ||
+				math.Abs(imag(got[0]-last)) > tol*math.Max(1, math.Abs(imag(last))) {
+				t.Errorf("%v: unexpected leading eigenvalue after Reorder; got %v, want %v", name, got[0], last)
+			}
+			checkSchur(t, name+",Reorder", a, &schur, tol)
+
+			// Move the eigenvalues in the right half-plane to the top.
+			var want int
+			for _, v := range got {
+				if real(v) > 0 {
+					want++
+				}
+			}
+			k, ok := schur.ReorderSelect(func(v complex128) bool { return real(v) > 0 })
+			if !ok {
+				t.Errorf("%v: unexpected failure of ReorderSelect", name)
+				continue
+			}
+			if k != want {
+				t.Errorf("%v: unexpected number of selected eigenvalues; got %d, want %d", name, k, want)
+			}
+			for i, v := range schur.Values(nil) {
+				if (i < k) != (real(v) > 0) {
+					t.Errorf("%v: eigenvalue %d not in expected position after ReorderSelect", name, i)
+				}
+			}
+			checkSchur(t, name+",ReorderSelect", a, &schur, tol)
+		}
+	}
+}
+
+// checkSchur checks that the Schur factorization of a has T in Schur
+// canonical form, Z orthogonal and that Z*T*Zᵀ multiply back to a.
+func checkSchur(t *testing.T, name string, a *Dense, schur *Schur, tol float64) {
+	t.Helper()
+	n, _ := a.Dims()
+
+	var tm, z Dense
+	schur.TTo(&tm)
+	schur.ZTo(&z)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			v := tm.At(i, j)
+			if v == 0 {
+				continue
+			}
+			if j != i-1 {
+				t.Errorf("%v: T not quasi-triangular; T[%d,%d]=%v", name, i, j, v)
+				continue
+			}
+			if (i > 1 && tm.At(i-1, i-2) != 0) || (i < n-1 && tm.At(i+1, i) != 0) {
+				t.Errorf("%v: overlapping diagonal blocks in T at row %d", name, i)
+			}
+			if tm.At(i, i) != tm.At(i-1, i-1) || math.Signbit(tm.At(i-1, i)) == math.Signbit(v) {
+				t.Errorf("%v: 2×2 block at row %d not in Schur canonical form", name, i-1)
+			}
+		}
+	}
+
+	var zzt Dense
+	zzt.Mul(&z, z.T())
+	if !EqualApprox(&zzt, eye(n), tol) {
+		t.Errorf("%v: Z not orthogonal", name)
+	}
+
+	var ztzt Dense
+	ztzt.Product(&z, &tm, z.T())
+	if !EqualApprox(&ztzt, a, tol*math.Max(1, Norm(a, 1))) {
+		t.Errorf("%v: A not recovered from Z*T*Zᵀ", name)
+	}
+
+	values := schur.Values(nil)
+	for i := 0; i < n; i++ {
+		if imag(values[i]) == 0 && values[i] != complex(tm.At(i, i), 0) {
+			t.Errorf("%v: eigenvalue %d does not match T", name, i)
+		}
+	}
+}
+
+func TestSchurNoVectors(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 2, []float64{1, 2, 3, 4})
+	var schur Schur
+	if !schur.Factorize(a, false) {
+		t.Fatal("unexpected failure")
+	}
+	if panicked, _ := panics(func() { schur.ZTo(&Dense{}) }); !panicked {
+		t.Error("expected panic for ZTo without Schur vectors")
+	}
+	var empty Schur
+	if panicked, _ := panics(func() { empty.Values(nil) }); !panicked {
+		t.Error("expected panic for Values without factorization")
+	}
+}
+
+// sameComplexSet returns whether the elements of a and b are equal within tol
+// up to a permutation.
+func sameComplexSet(a, b []complex128, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	used := make([]bool, len(b))
+	for _, v := range a {
+		best := -1
+		for j, w := range b {
+			if !used[j] && cmplx.Abs(v-w) <= tol*math.Max(1, cmplx.Abs(v)) && (best < 0 || cmplx.Abs(v-w) < cmplx.Abs(v-b[best])) {
+				best = j
+			}
+		}
+		if best < 0 {
+			return false
+		}
+		used[best] = true
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "gonum.org/v1/gonum/blas/blas32"
+
+// checkOverlap32 returns false if the receiver does not overlap data elements
+// referenced by the parameter and panics otherwise.
+//
+// checkOverlap32 methods return a boolean to allow the check call to be added to a
+// boolean expression, making use of short-circuit operators.
+func checkOverlap32(a, b blas32.General) bool {
+	if cap(a.Data) == 0 || cap(b.Data) == 0 {
+		return false
+	}
+
+	off := offsetFloat32(a.Data[:1], b.Data[:1])
+
+	if off == 0 {
+		// At least one element overlaps.
+		if a.Cols == b.Cols && a.Rows == b.Rows && a.Stride == b.Stride {
+			panic(regionIdentity)
+		}
+		panic(regionOverlap)
+	}
+
+	if off > 0 && len(a.Data) <= off {
+		// We know a is completely before b.
+		return false
+	}
+	if off < 0 && len(b.Data) <= -off {
+		// We know a is completely after b.
+		return false
+	}
+
+	if a.Stride != b.Stride && a.Stride != 1 && b.Stride != 1 {
+		// Too hard, so assume the worst; if either stride
+		// is one it will be caught in rectanglesOverlap.
+		panic(mismatchedStrides)
+	}
+
+	if off < 0 {
+		off = -off
+		a.Cols, b.Cols = b.Cols, a.Cols
+	}
+	if rectanglesOverlap(off, a.Cols, b.Cols, min(a.Stride, b.Stride)) {
+		panic(regionOverlap)
+	}
+	return false
+}
+
+func (m *Dense32) checkOverlap(a blas32.General) bool {
+	return checkOverlap32(m.RawMatrix32(), a)
+}
+
+func (m *Dense32) checkOverlapMatrix(a Matrix32) bool {
+	if m == a {
+		return false
+	}
+	return m.checkOverlap(rawGeneral32(a))
+}
+
+func (s *SymDense32) checkOverlap(a blas32.General) bool {
+	return checkOverlap32(generalFromSymmetric32(s.RawSymmetric32()), a)
+}
+
+func (s *SymDense32) checkOverlapMatrix(a Matrix32) bool {
+	if s == a {
+		return false
+	}
+	return s.checkOverlap(rawGeneral32(a))
+}
+
+func (v *VecDense32) checkOverlap(a blas32.Vector) bool {
+	mat := v.mat
+	if cap(mat.Data) == 0 || cap(a.Data) == 0 {
+		return false
+	}
+
+	off := offsetFloat32(mat.Data[:1], a.Data[:1])
+
+	if off == 0 {
+		// At least one element overlaps.
+		if mat.Inc == a.Inc && len(mat.Data) == len(a.Data) {
+			panic(regionIdentity)
+		}
+		panic(regionOverlap)
+	}
+
+	if off > 0 && len(mat.Data) <= off {
+		// We know v is completely before a.
+		return false
+	}
+	if off < 0 && len(a.Data) <= -off {
+		// We know v is completely after a.
+		return false
+	}
+
+	if mat.Inc != a.Inc && mat.Inc != 1 && a.Inc != 1 {
+		// Too hard, so assume the worst; if either
+		// increment is one it will be caught below.
+		panic(mismatchedStrides)
+	}
+	inc := min(mat.Inc, a.Inc)
+
+	if inc == 1 || off&inc == 0 {
+		panic(regionOverlap)
+	}
+	return false
+}
+
+// rawGeneral32 returns the backing data of a as a blas32.General if a is
+// one of the float32 matrix types, and an empty blas32.General otherwise.
+func rawGeneral32(a Matrix32) blas32.General {
+	switch ar := a.(type) {
+	case RawMatrixer32:
+		return ar.RawMatrix32()
+	case RawSymmetricer32:
+		return generalFromSymmetric32(ar.RawSymmetric32())
+	case RawVectorer32:
+		r, c := a.Dims()
+		return generalFromVector32(ar.RawVector32(), r, c)
+	}
+	return blas32.General{}
+}
+
+func generalFromSymmetric32(a blas32.Symmetric) blas32.General {
+	return blas32.General{
+		Rows:   a.N,
+		Cols:   a.N,
+		Stride: a.Stride,
+		Data:   a.Data,
+	}
+}
+
+func generalFromVector32(a blas32.Vector, r, c int) blas32.General {
+	return blas32.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: a.Inc,
+		Data:   a.Data,
+	}
+}
@@ -5,8 +5,11 @@
 package mat
 
 import (
+	"math"
+
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
@@ -162,3 +165,344 @@ func (v *VecDense) SolveVec(a Matrix, b Vector) error {
 	m := v.asDense()
 	return m.Solve(a, b)
 }
+
+// SolveMixed solves the system of linear equations
+//  A * X = B
+// where A is an n×n matrix and B is an n×k matrix, and stores the solution X
+// into the receiver. SolveMixed factorizes A in single precision and uses
+// iterative refinement to compute a solution with double precision accuracy.
+// For large systems this is faster than a double precision factorization. If
+// the refinement does not converge, because A is too ill-conditioned or its
+// elements are too large to be represented in single precision, the solution
+// is computed in double precision.
+//
+// If a implements Symmetric, a single precision Cholesky factorization is
+// attempted first. If A is not positive definite, a single precision LU
+// factorization is used.
+//
+// The returned iter is the number of refinement steps that were needed. A
+// negative value of iter indicates that the solution has been computed in
+// double precision. See the documentation for lapack/gonum.Implementation.Dsgesv
+// for the meaning of the individual negative values.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+func (m *Dense) SolveMixed(a, b Matrix) (iter int, err error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrShape)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	// Keep copies of A and B since the solvers may overwrite A and the
+	// receiver may share storage with either of them.
+	bw := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bw)
+	bw.Copy(b)
+
+	m.reuseAsNonZeroed(n, bc)
+
+	work := getFloat64s(4*n+n*bc, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	swork := make([]float32, n*(n+bc))
+
+	if s, ok := a.(Symmetric); ok {
+		aw := getSymDenseWorkspace(n, false)
+		defer putSymDenseWorkspace(aw)
+		aw.CopySym(s)
+		anorm := lapack64.Lansy(CondNorm, aw.mat, work)
+		iter, ok = lapack64.Sposv(aw.mat, bw.mat, m.mat, work[:n*bc], swork)
+		if ok {
+			if iter < 0 {
+				// The solution was computed using the double precision
+				// Cholesky factorization stored in aw.
+				cond := 1 / lapack64.Pocon(aw.mat, anorm, work, iwork)
+				if cond > ConditionTolerance {
+					return iter, Condition(cond)
+				}
+			}
+			return iter, nil
+		}
+		// A is not positive definite, so fall through to the LU
+		// factorization.
+	}
+
+	aw := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(aw)
+	aw.Copy(a)
+	anorm := lapack64.Lange(CondNorm, aw.mat, work)
+	ipiv := getInts(n, false)
+	defer putInts(ipiv)
+	iter, ok := lapack64.Sgesv(aw.mat, ipiv, bw.mat, m.mat, work[:n*bc], swork)
+	if !ok {
+		return iter, Condition(math.Inf(1))
+	}
+	if iter < 0 {
+		// The solution was computed using the double precision LU
+		// factorization stored in aw.
+		cond := 1 / lapack64.Gecon(CondNorm, aw.mat, anorm, work, iwork)
+		if cond > ConditionTolerance {
+			return iter, Condition(cond)
+		}
+	}
+	return iter, nil
+}
+
+// SolveVecMixed solves the system of linear equations
+//  A * x = b
+// where A is an n×n matrix, and stores the solution x into the receiver.
+// SolveVecMixed factorizes A in single precision and uses iterative refinement
+// to compute a solution with double precision accuracy. See the documentation
+// for SolveMixed for more information.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+func (v *VecDense) SolveVecMixed(a Matrix, b Vector) (iter int, err error) {
+	n, _ := a.Dims()
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	v.reuseAsNonZeroed(n)
+	return v.asDense().SolveMixed(a, b)
+}
+
+// SolveInfo holds information about the solution of a system of linear
+// equations computed by SolveExpert or SolveVecExpert.
+type SolveInfo struct {
+	// Equilibration is the form of equilibration that was applied to A
+	// before it was factorized.
+	Equilibration lapack.EquilibrationType
+
+	// R and C hold the row and column scale factors of the equilibrated
+	// matrix diag(R)*A*diag(C). R is nil if no row scaling was applied and
+	// C is nil if no column scaling was applied. For a symmetric positive
+	// definite matrix A, R and C are equal if scaling was applied.
+	R, C []float64
+
+	// RCond is an estimate of the reciprocal condition number of the
+	// equilibrated matrix in the 1-norm.
+	RCond float64
+
+	// PivotGrowth is the reciprocal pivot growth factor
+	//  max |A[i,j]| / max |U[i,j]|
+	// of the LU factorization of the equilibrated matrix. If PivotGrowth is
+	// much less than 1, the stability of the LU factorization could be poor
+	// and the solution, RCond and the error bounds could be unreliable.
+	// PivotGrowth is 1 if A was factorized using the Cholesky factorization.
+	PivotGrowth float64
+
+	// FErr and BErr hold for each column of X the estimated forward error
+	// bound and the componentwise relative backward error. See the
+	// documentation for LU.SolveRefinedTo for more information.
+	FErr, BErr []float64
+}
+
+// SolveExpert solves the system of linear equations
+//  A * X = B
+// where A is an n×n matrix and B is an n×k matrix, and stores the solution X
+// into the receiver. SolveExpert equilibrates the rows and columns of A if it
+// is badly scaled, factorizes the equilibrated matrix, solves the system and
+// improves the solution by iterative refinement. Information about the
+// equilibration, the condition of A and error bounds for the solution are
+// returned in info.
+//
+// If a implements Symmetric and is positive definite, the symmetric scaling
+// and the Cholesky factorization are used, otherwise row and column scaling
+// and the LU factorization are used.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. If A is singular, the
+// fields of info other than Equilibration, R and C are not valid.
+func (m *Dense) SolveExpert(a, b Matrix) (info SolveInfo, err error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrShape)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	// Keep a copy of B since it is scaled during the solution and the
+	// receiver may share storage with it.
+	bw := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bw)
+	bw.Copy(b)
+	m.reuseAsNonZeroed(n, bc)
+
+	if s, ok := a.(Symmetric); ok {
+		info, ok, err = m.solveExpertSym(s, bw)
+		if ok {
+			return info, err
+		}
+		// A is not positive definite, so fall through to the LU
+		// factorization.
+	}
+
+	ae := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(ae)
+	ae.Copy(a)
+
+	r := make([]float64, n)
+	cs := make([]float64, n)
+	rowcnd, colcnd, amax, ok := lapack64.Geequ(ae.mat, r, cs)
+	info.Equilibration = lapack.EquilibrationNone
+	if ok {
+		info.Equilibration = lapack64.Laqge(ae.mat, r, cs, rowcnd, colcnd, amax)
+	}
+	rowScaled := info.Equilibration == lapack.EquilibrationRow || info.Equilibration == lapack.EquilibrationBoth
+	colScaled := info.Equilibration == lapack.EquilibrationCol || info.Equilibration == lapack.EquilibrationBoth
+	if rowScaled {
+		info.R = r
+		for i, ri := range r {
+			blas64.Implementation().Dscal(bc, ri, bw.mat.Data[i*bw.mat.Stride:], 1)
+		}
+	}
+	if colScaled {
+		info.C = cs
+	}
+
+	af := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(af)
+	af.Copy(ae)
+	ipiv := getInts(n, false)
+	defer putInts(ipiv)
+	if !lapack64.Getrf(af.mat, ipiv) {
+		return info, Condition(math.Inf(1))
+	}
+
+	work := getFloat64s(4*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+
+	// Compute the reciprocal pivot growth factor.
+	info.PivotGrowth = 1
+	u := blas64.Triangular{
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Stride: af.mat.Stride,
+		Data:   af.mat.Data,
+	}
+	if unorm := lapack64.Lantr(lapack.MaxAbs, u, work); unorm != 0 {
+		info.PivotGrowth = lapack64.Lange(lapack.MaxAbs, ae.mat, work) / unorm
+	}
+
+	anorm := lapack64.Lange(lapack.MaxColumnSum, ae.mat, work)
+	info.RCond = lapack64.Gecon(lapack.MaxColumnSum, af.mat, anorm, work, iwork)
+
+	// Compute the solution and improve it by iterative refinement.
+	m.Copy(bw)
+	lapack64.Getrs(blas.NoTrans, af.mat, m.mat, ipiv)
+	info.FErr = make([]float64, bc)
+	info.BErr = make([]float64, bc)
+	lapack64.Gerfs(blas.NoTrans, ae.mat, af.mat, ipiv, bw.mat, m.mat, info.FErr, info.BErr, work[:3*n], iwork)
+
+	// Transform the solution to the solution of the original system.
+	if colScaled {
+		for i, ci := range cs {
+			blas64.Implementation().Dscal(bc, ci, m.mat.Data[i*m.mat.Stride:], 1)
+		}
+		for j := range info.FErr {
+			info.FErr[j] /= colcnd
+		}
+	}
+
+	if cond := 1 / info.RCond; cond > ConditionTolerance {
+		return info, Condition(cond)
+	}
+	return info, nil
+}
+
+// solveExpertSym solves the system of linear equations A * X = B for the
+// symmetric matrix A using symmetric scaling and the Cholesky factorization,
+// and stores the solution into the receiver. The right-hand side b is
+// overwritten. solveExpertSym returns whether A is positive definite. If ok
+// is false, the receiver and b are not modified.
+func (m *Dense) solveExpertSym(a Symmetric, b *Dense) (info SolveInfo, ok bool, err error) {
+	n := a.Symmetric()
+	bc := b.mat.Cols
+
+	ae := getSymDenseWorkspace(n, false)
+	defer putSymDenseWorkspace(ae)
+	ae.CopySym(a)
+
+	s := make([]float64, n)
+	scond, amax, ok := lapack64.Poequ(ae.mat, s)
+	if !ok {
+		return info, false, nil
+	}
+	info.Equilibration = lapack64.Laqsy(ae.mat, s, scond, amax)
+	scaled := info.Equilibration == lapack.EquilibrationBoth
+
+	af := getSymDenseWorkspace(n, false)
+	defer putSymDenseWorkspace(af)
+	af.CopySym(ae)
+	t, ok := lapack64.Potrf(af.mat)
+	if !ok {
+		return SolveInfo{}, false, nil
+	}
+
+	if scaled {
+		info.R = s
+		info.C = s
+		for i, si := range s {
+			blas64.Implementation().Dscal(bc, si, b.mat.Data[i*b.mat.Stride:], 1)
+		}
+	}
+	info.PivotGrowth = 1
+
+	work := getFloat64s(3*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+
+	anorm := lapack64.Lansy(lapack.MaxColumnSum, ae.mat, work)
+	info.RCond = lapack64.Pocon(af.mat, anorm, work, iwork)
+
+	// Compute the solution and improve it by iterative refinement.
+	m.Copy(b)
+	lapack64.Potrs(t, m.mat)
+	info.FErr = make([]float64, bc)
+	info.BErr = make([]float64, bc)
+	lapack64.Porfs(ae.mat, t, b.mat, m.mat, info.FErr, info.BErr, work, iwork)
+
+	// Transform the solution to the solution of the original system.
+	if scaled {
+		for i, si := range s {
+			blas64.Implementation().Dscal(bc, si, m.mat.Data[i*m.mat.Stride:], 1)
+		}
+		for j := range info.FErr {
+			info.FErr[j] /= scond
+		}
+	}
+
+	if cond := 1 / info.RCond; cond > ConditionTolerance {
+		return info, true, Condition(cond)
+	}
+	return info, true, nil
+}
+
+// SolveVecExpert solves the system of linear equations
+//  A * x = b
+// where A is an n×n matrix, and stores the solution x into the receiver.
+// SolveVecExpert equilibrates A if it is badly scaled and improves the
+// solution by iterative refinement. See the documentation for SolveExpert for
+// more information.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+func (v *VecDense) SolveVecExpert(a Matrix, b Vector) (info SolveInfo, err error) {
+	n, _ := a.Dims()
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	v.reuseAsNonZeroed(n)
+	return v.asDense().SolveExpert(a, b)
+}
@@ -5,9 +5,13 @@
 package mat
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack"
 )
 
 func TestSolve(t *testing.T) {
@@ -305,3 +309,245 @@ func TestSolveVec(t *testing.T) {
 	}
 	testTwoInput(t, "SolveVec", &VecDense{}, method, denseComparison, legalTypesMatrixVector, legalSizeSolve, 1e-12)
 }
+
+func TestSolveMixed(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	rnd := rand.New(src)
+	for _, n := range []int{1, 2, 5, 10, 50, 100} {
+		for _, bc := range []int{1, 4} {
+			for _, kind := range []string{"general", "posdef", "indefinite", "hilbert"} {
+				if kind == "hilbert" && n > 10 {
+					continue
+				}
+				var a Matrix
+				switch kind {
+				case "general":
+					d := NewDense(n, n, nil)
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							d.Set(i, j, rnd.NormFloat64())
+						}
+					}
+					a = d
+				case "posdef":
+					s := randSymDense(n, src)
+					for i := 0; i < n; i++ {
+						s.SetSym(i, i, s.At(i, i)+float64(n))
+					}
+					a = s
+				case "indefinite":
+					s := randSymDense(n, src)
+					for i := 0; i < n; i++ {
+						s.SetSym(i, i, s.At(i, i)-float64(n))
+					}
+					a = s
+				case "hilbert":
+					a = hilbertDense(n)
+				}
+				b := NewDense(n, bc, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < bc; j++ {
+						b.Set(i, j, rnd.NormFloat64())
+					}
+				}
+
+				name := fmt.Sprintf("n=%v,bc=%v,kind=%v", n, bc, kind)
+
+				var want Dense
+				err := want.Solve(a, b)
+				if err != nil {
+					t.Fatalf("%v: unexpected error from Solve: %v", name, err)
+				}
+
+				var x Dense
+				iter, err := x.SolveMixed(a, b)
+				if err != nil {
+					t.Errorf("%v: unexpected error: %v", name, err)
+					continue
+				}
+				switch {
+				case kind == "hilbert" && n == 10 && iter >= 0:
+					t.Errorf("%v: unexpected convergence of single precision refinement, iter=%v", name, iter)
+				case kind != "hilbert" && n >= 5 && iter < 0:
+					t.Errorf("%v: unexpected fallback to double precision, iter=%v", name, iter)
+				}
+
+				tol := 1e-12
+				if kind == "hilbert" {
+					tol = 1e-3
+				}
+				if !EqualApprox(&x, &want, tol) {
+					t.Errorf("%v: solution mismatch, iter=%v\ngot: %v\nwant:%v", name, iter, Formatted(&x), Formatted(&want))
+				}
+
+				// Check that solving in place gives the same result.
+				bCopy := DenseCopyOf(b)
+				_, err = bCopy.SolveMixed(a, bCopy)
+				if err != nil {
+					t.Errorf("%v: unexpected error in place: %v", name, err)
+					continue
+				}
+				if !Equal(bCopy, &x) {
+					t.Errorf("%v: in-place solution mismatch", name)
+				}
+
+				var xvec VecDense
+				_, err = xvec.SolveVecMixed(a, b.ColView(0))
+				if err != nil {
+					t.Errorf("%v: unexpected error from SolveVecMixed: %v", name, err)
+					continue
+				}
+				if !Equal(&xvec, x.ColView(0)) {
+					t.Errorf("%v: vector solution mismatch", name)
+				}
+			}
+		}
+	}
+
+	// Check that a singular matrix is reported.
+	a := NewDense(3, 3, []float64{
+		1, 2, 3,
+		2, 4, 6,
+		0, 1, 1,
+	})
+	var x Dense
+	_, err := x.SolveMixed(a, NewDense(3, 1, []float64{1, 2, 3}))
+	if err == nil {
+		t.Error("no error for singular matrix in mixed precision solve")
+	}
+}
+
+func TestSolveExpert(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	rnd := rand.New(src)
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		for _, bc := range []int{1, 3} {
+			for _, kind := range []string{"general", "posdef", "indefinite"} {
+				for _, badlyScaled := range []bool{false, true} {
+					// Generate a random matrix that is well-conditioned
+					// after equilibration.
+					var a Matrix
+					switch kind {
+					case "general":
+						d := NewDense(n, n, nil)
+						for i := 0; i < n; i++ {
+							for j := 0; j < n; j++ {
+								d.Set(i, j, rnd.NormFloat64())
+							}
+						}
+						if badlyScaled {
+							for i := 0; i < n; i++ {
+								s := math.Pow(10, float64(rnd.Intn(17)-8))
+								for j := 0; j < n; j++ {
+									d.Set(i, j, s*d.At(i, j))
+								}
+							}
+							for j := 0; j < n; j++ {
+								s := math.Pow(10, float64(rnd.Intn(17)-8))
+								for i := 0; i < n; i++ {
+									d.Set(i, j, s*d.At(i, j))
+								}
+							}
+						}
+						a = d
+					case "posdef", "indefinite":
+						sym := randSymDense(n, src)
+						for i := 0; i < n; i++ {
+							if kind == "posdef" {
+								sym.SetSym(i, i, sym.At(i, i)+float64(n))
+							} else {
+								sym.SetSym(i, i, sym.At(i, i)-float64(n))
+							}
+						}
+						if badlyScaled {
+							for i := 0; i < n; i++ {
+								s := math.Pow(10, float64(rnd.Intn(17)-8))
+								for j := 0; j < n; j++ {
+									sym.SetSym(i, j, s*sym.At(i, j))
+								}
+								sym.SetSym(i, i, s*sym.At(i, i))
+							}
+						}
+						a = sym
+					}
+					want := NewDense(n, bc, nil)
+					for i := 0; i < n; i++ {
+						for j := 0; j < bc; j++ {
+							want.Set(i, j, rnd.NormFloat64())
+						}
+					}
+					var b Dense
+					b.Mul(a, want)
+
+					name := fmt.Sprintf("n=%v,bc=%v,kind=%v,badlyScaled=%v", n, bc, kind, badlyScaled)
+
+					var x Dense
+					info, err := x.SolveExpert(a, &b)
+					if err != nil {
+						t.Errorf("%v: unexpected error: %v", name, err)
+						continue
+					}
+
+					switch info.Equilibration {
+					case lapack.EquilibrationNone:
+						if info.R != nil || info.C != nil {
+							t.Errorf("%v: unexpected scale factors without equilibration", name)
+						}
+					case lapack.EquilibrationRow:
+						if info.R == nil || info.C != nil {
+							t.Errorf("%v: unexpected scale factors for row equilibration", name)
+						}
+					case lapack.EquilibrationCol:
+						if info.R != nil || info.C == nil {
+							t.Errorf("%v: unexpected scale factors for column equilibration", name)
+						}
+					case lapack.EquilibrationBoth:
+						if info.R == nil || info.C == nil {
+							t.Errorf("%v: unexpected scale factors for row and column equilibration", name)
+						}
+					}
+					if badlyScaled && n > 2 && info.Equilibration == lapack.EquilibrationNone {
+						t.Errorf("%v: badly scaled matrix not equilibrated", name)
+					}
+					if !badlyScaled && kind != "general" && info.Equilibration != lapack.EquilibrationNone {
+						t.Errorf("%v: unexpected equilibration of well-scaled matrix: %c", name, info.Equilibration)
+					}
+					if kind == "posdef" && info.PivotGrowth != 1 {
+						t.Errorf("%v: unexpected pivot growth for Cholesky factorization: %v", name, info.PivotGrowth)
+					}
+					if !(0 < info.RCond && info.RCond <= 1+1e-14) {
+						t.Errorf("%v: unexpected RCond: %v", name, info.RCond)
+					}
+					if !(0 < info.PivotGrowth) {
+						t.Errorf("%v: unexpected PivotGrowth: %v", name, info.PivotGrowth)
+					}
+					checkRefinedErrors(t, name, &x, want, info.FErr, info.BErr)
+
+					var xvec VecDense
+					_, err = xvec.SolveVecExpert(a, b.ColView(0))
+					if err != nil {
+						t.Errorf("%v: unexpected error from SolveVecExpert: %v", name, err)
+						continue
+					}
+					if !Equal(&xvec, x.ColView(0)) {
+						t.Errorf("%v: vector solution mismatch", name)
+					}
+				}
+			}
+		}
+	}
+
+	// Check that a singular matrix is reported.
+	a := NewDense(3, 3, []float64{
+		1, 2, 3,
+		2, 4, 6,
+		0, 1, 1,
+	})
+	var x Dense
+	_, err := x.SolveExpert(a, NewDense(3, 1, []float64{1, 2, 3}))
+	if err == nil {
+		t.Error("no error for singular matrix in expert solve")
+	}
+}
@@ -0,0 +1,446 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// compressed is the storage shared by the compressed sparse row and
+// compressed sparse column matrix formats. Elements are grouped by their
+// major index, rows for CSR and columns for CSC. The minor indices of the
+// elements with major index k are held in ind[indptr[k]:indptr[k+1]] in
+// strictly increasing order, and their values are held in the corresponding
+// elements of data.
+type compressed struct {
+	major, minor int
+	indptr       []int
+	ind          []int
+	data         []float64
+}
+
+// newCompressed returns a compressed matrix with the provided dimensions and
+// backing slices. If indptr, ind and data are all nil, new backing slices are
+// allocated for an all zero matrix. newCompressed panics if the input does not
+// describe a valid compressed matrix.
+func newCompressed(major, minor int, indptr, ind []int, data []float64) compressed {
+	if major <= 0 || minor <= 0 {
+		if major == 0 || minor == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if indptr == nil && ind == nil && data == nil {
+		return compressed{major: major, minor: minor, indptr: make([]int, major+1)}
+	}
+	if len(indptr) != major+1 || len(ind) != len(data) {
+		panic(ErrShape)
+	}
+	if indptr[0] != 0 || indptr[major] != len(ind) {
+		panic(ErrSparseIndex)
+	}
+	for k := 0; k < major; k++ {
+		start, end := indptr[k], indptr[k+1]
+		if end < start {
+			panic(ErrSparseIndex)
+		}
+		for p := start; p < end; p++ {
+			if uint(ind[p]) >= uint(minor) || (p > start && ind[p] <= ind[p-1]) {
+				panic(ErrSparseIndex)
+			}
+		}
+	}
+	return compressed{major: major, minor: minor, indptr: indptr, ind: ind, data: data}
+}
+
+// compressEntries returns the compressed representation of the major×minor
+// matrix with elements specified by the triplets in maj, mnr and val. Values
+// of duplicate entries are summed. The input slices are not retained.
+func compressEntries(major, minor int, maj, mnr []int, val []float64) compressed {
+	indptr := make([]int, major+1)
+	for _, k := range maj {
+		indptr[k+1]++
+	}
+	for k := 0; k < major; k++ {
+		indptr[k+1] += indptr[k]
+	}
+	ind := make([]int, len(maj))
+	data := make([]float64, len(maj))
+	next := getInts(major, false)
+	copy(next, indptr[:major])
+	for i, k := range maj {
+		p := next[k]
+		ind[p] = mnr[i]
+		data[p] = val[i]
+		next[k]++
+	}
+	putInts(next)
+
+	// Sort each major segment and sum duplicates,
+	// compacting the storage as we go.
+	var n int
+	for k := 0; k < major; k++ {
+		start, end := indptr[k], indptr[k+1]
+		sort.Sort(sparseSegment{ind: ind[start:end], data: data[start:end]})
+		indptr[k] = n
+		for p := start; p < end; p++ {
+			if n > indptr[k] && ind[n-1] == ind[p] {
+				data[n-1] += data[p]
+				continue
+			}
+			ind[n] = ind[p]
+			data[n] = data[p]
+			n++
+		}
+	}
+	indptr[major] = n
+	return compressed{major: major, minor: minor, indptr: indptr, ind: ind[:n], data: data[:n]}
+}
+
+// sparseSegment sorts the elements of a compressed major segment by their
+// minor index.
+type sparseSegment struct {
+	ind  []int
+	data []float64
+}
+
+func (s sparseSegment) Len() int           { return len(s.ind) }
+func (s sparseSegment) Less(i, j int) bool { return s.ind[i] < s.ind[j] }
+func (s sparseSegment) Swap(i, j int) {
+	s.ind[i], s.ind[j] = s.ind[j], s.ind[i]
+	s.data[i], s.data[j] = s.data[j], s.data[i]
+}
+
+// nnz returns the number of stored elements.
+func (c *compressed) nnz() int {
+	if c.major == 0 {
+		return 0
+	}
+	return c.indptr[c.major]
+}
+
+// find returns the position of element (k, l) in ind and data and whether
+// the element is stored. If the element is not stored, the returned position
+// is where it would be inserted.
+func (c *compressed) find(k, l int) (int, bool) {
+	start, end := c.indptr[k], c.indptr[k+1]
+	p := start + sort.SearchInts(c.ind[start:end], l)
+	return p, p < end && c.ind[p] == l
+}
+
+func (c *compressed) at(k, l int) float64 {
+	p, ok := c.find(k, l)
+	if !ok {
+		return 0
+	}
+	return c.data[p]
+}
+
+// set sets the element (k, l) to v. Setting an element to zero removes it
+// from the stored elements.
+func (c *compressed) set(k, l int, v float64) {
+	p, ok := c.find(k, l)
+	if ok {
+		if v != 0 {
+			c.data[p] = v
+			return
+		}
+		c.ind = append(c.ind[:p], c.ind[p+1:]...)
+		c.data = append(c.data[:p], c.data[p+1:]...)
+		for i := k + 1; i <= c.major; i++ {
+			c.indptr[i]--
+		}
+		return
+	}
+	if v == 0 {
+		return
+	}
+	c.ind = append(c.ind, 0)
+	copy(c.ind[p+1:], c.ind[p:])
+	c.ind[p] = l
+	c.data = append(c.data, 0)
+	copy(c.data[p+1:], c.data[p:])
+	c.data[p] = v
+	for i := k + 1; i <= c.major; i++ {
+		c.indptr[i]++
+	}
+}
+
+// reset empties the storage, retaining the backing slices.
+func (c *compressed) reset() {
+	c.major = 0
+	c.minor = 0
+	c.indptr = c.indptr[:0]
+	c.ind = c.ind[:0]
+	c.data = c.data[:0]
+}
+
+// zero removes all stored elements, retaining the dimensions.
+func (c *compressed) zero() {
+	for i := range c.indptr {
+		c.indptr[i] = 0
+	}
+	c.ind = c.ind[:0]
+	c.data = c.data[:0]
+}
+
+// clone returns a deep copy of the receiver.
+func (c *compressed) clone() compressed {
+	return compressed{
+		major:  c.major,
+		minor:  c.minor,
+		indptr: append([]int(nil), c.indptr...),
+		ind:    append([]int(nil), c.ind...),
+		data:   append([]float64(nil), c.data...),
+	}
+}
+
+// doNonZero calls fn for each non-zero stored element with its major and
+// minor index.
+func (c *compressed) doNonZero(fn func(k, l int, v float64)) {
+	for k := 0; k < c.major; k++ {
+		c.doMajorNonZero(k, fn)
+	}
+}
+
+// doMajorNonZero calls fn for each non-zero stored element with major index k.
+func (c *compressed) doMajorNonZero(k int, fn func(k, l int, v float64)) {
+	for p := c.indptr[k]; p < c.indptr[k+1]; p++ {
+		if v := c.data[p]; v != 0 {
+			fn(k, c.ind[p], v)
+		}
+	}
+}
+
+// doMinorNonZero calls fn for each non-zero stored element with minor index l.
+func (c *compressed) doMinorNonZero(l int, fn func(k, l int, v float64)) {
+	for k := 0; k < c.major; k++ {
+		p, ok := c.find(k, l)
+		if ok && c.data[p] != 0 {
+			fn(k, l, c.data[p])
+		}
+	}
+}
+
+// mulVecTo computes M⋅x or Mᵀ⋅x, storing the result into dst, where M is the
+// major×minor matrix held by the receiver.
+func (c *compressed) mulVecTo(dst *VecDense, trans bool, x Vector) {
+	m, n := c.major, c.minor
+	if trans {
+		m, n = n, m
+	}
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(m)
+
+	var xd []float64
+	xv, ok := x.(*VecDense)
+	if ok && xv != dst {
+		dst.checkOverlap(xv.mat)
+	}
+	if ok && xv != dst && xv.mat.Inc == 1 {
+		xd = xv.mat.Data[:n]
+	} else {
+		xd = getFloat64s(n, false)
+		defer putFloat64s(xd)
+		for i := range xd {
+			xd[i] = x.AtVec(i)
+		}
+	}
+
+	y := dst.mat
+	if !trans {
+		for k := 0; k < c.major; k++ {
+			var sum float64
+			for p := c.indptr[k]; p < c.indptr[k+1]; p++ {
+				sum += c.data[p] * xd[c.ind[p]]
+			}
+			y.Data[k*y.Inc] = sum
+		}
+		return
+	}
+	dst.Zero()
+	for k, xk := range xd {
+		if xk == 0 {
+			continue
+		}
+		for p := c.indptr[k]; p < c.indptr[k+1]; p++ {
+			y.Data[c.ind[p]*y.Inc] += c.data[p] * xk
+		}
+	}
+}
+
+// mulCompressed returns the row compressed product of a and b where a and
+// b are row compressed. The number of minor elements of a must equal the
+// number of major elements of b.
+func mulCompressed(a, b compressed) compressed {
+	acc := getFloat64s(b.minor, false)
+	defer putFloat64s(acc)
+	mark := getInts(b.minor, false)
+	defer putInts(mark)
+	for i := range mark {
+		mark[i] = -1
+	}
+
+	indptr := make([]int, a.major+1)
+	var (
+		ind  []int
+		data []float64
+	)
+	for i := 0; i < a.major; i++ {
+		start := len(ind)
+		for p := a.indptr[i]; p < a.indptr[i+1]; p++ {
+			k, v := a.ind[p], a.data[p]
+			for q := b.indptr[k]; q < b.indptr[k+1]; q++ {
+				j := b.ind[q]
+				if mark[j] != i {
+					mark[j] = i
+					acc[j] = 0
+					ind = append(ind, j)
+				}
+				acc[j] += v * b.data[q]
+			}
+		}
+		sort.Ints(ind[start:])
+		for _, j := range ind[start:] {
+			data = append(data, acc[j])
+		}
+		indptr[i+1] = len(ind)
+	}
+	return compressed{major: a.major, minor: b.minor, indptr: indptr, ind: ind, data: data}
+}
+
+// compressedOf returns the compressed representation of a. If byRow is true,
+// the returned representation is row compressed, otherwise it is column
+// compressed. The returned boolean indicates whether the returned value
+// shares backing data with a.
+func compressedOf(a Matrix, byRow bool) (c compressed, shared bool) {
+	aU, trans := untranspose(a)
+	switch aU := aU.(type) {
+	case *CSR:
+		if trans != byRow {
+			return aU.mat, true
+		}
+	case *CSC:
+		if trans == byRow {
+			return aU.mat, true
+		}
+	}
+	r, cols := a.Dims()
+	ri, ci, data := nonZeroEntries(a)
+	if byRow {
+		return compressEntries(r, cols, ri, ci, data), false
+	}
+	return compressEntries(cols, r, ci, ri, data), false
+}
+
+// nonZeroEntries returns the row indices, column indices and values of the
+// non-zero elements of a. For *COO matrices with duplicate entries, each of
+// the duplicates is returned.
+func nonZeroEntries(a Matrix) (rows, cols []int, data []float64) {
+	aU, trans := untranspose(a)
+	switch aU := aU.(type) {
+	case *COO:
+		rows = append([]int(nil), aU.rows...)
+		cols = append([]int(nil), aU.cols...)
+		data = append([]float64(nil), aU.data...)
+		if trans {
+			rows, cols = cols, rows
+		}
+		return rows, cols, data
+	case NonZeroDoer:
+		aU.DoNonZero(func(i, j int, v float64) {
+			if trans {
+				i, j = j, i
+			}
+			rows = append(rows, i)
+			cols = append(cols, j)
+			data = append(data, v)
+		})
+		return rows, cols, data
+	}
+	r, c := a.Dims()
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if v := a.At(i, j); v != 0 {
+				rows = append(rows, i)
+				cols = append(cols, j)
+				data = append(data, v)
+			}
+		}
+	}
+	return rows, cols, data
+}
+
+// isSparse returns whether a is one of the sparse matrix types.
+func isSparse(a Matrix) bool {
+	switch a.(type) {
+	case *CSR, *CSC, *COO:
+		return true
+	}
+	return false
+}
+
+// addSparseTo adds the elements of the sparse matrix a, transposed if trans
+// is true, to the r×c top-left corner of dst. Elements outside that corner
+// are ignored.
+func addSparseTo(dst blas64.General, a NonZeroDoer, trans bool, r, c int) {
+	a.DoNonZero(func(i, j int, v float64) {
+		if trans {
+			i, j = j, i
+		}
+		if i < r && j < c {
+			dst.Data[i*dst.Stride+j] += v
+		}
+	})
+}
+
+// mulSparseLeft computes a⋅b where a is a sparse matrix, transposed if
+// aTrans is true, placing the result in the zeroed receiver.
+func (m *Dense) mulSparseLeft(a NonZeroDoer, aTrans bool, b Matrix) {
+	br, bc := b.Dims()
+	bU, bTrans := untranspose(b)
+	var bmat blas64.General
+	if bd, ok := bU.(*Dense); ok && !bTrans {
+		bmat = bd.mat
+	} else {
+		w := getDenseWorkspace(br, bc, false)
+		defer putDenseWorkspace(w)
+		w.Copy(b)
+		bmat = w.mat
+	}
+	a.DoNonZero(func(i, k int, v float64) {
+		if aTrans {
+			i, k = k, i
+		}
+		f64.AxpyUnitary(v, bmat.Data[k*bmat.Stride:k*bmat.Stride+bc], m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+bc])
+	})
+}
+
+// mulSparseRight computes a⋅b where b is a sparse matrix, transposed if
+// bTrans is true, placing the result in the zeroed receiver.
+func (m *Dense) mulSparseRight(a Matrix, b NonZeroDoer, bTrans bool) {
+	ar, ac := a.Dims()
+	aU, aTrans := untranspose(a)
+	var amat blas64.General
+	if ad, ok := aU.(*Dense); ok && !aTrans {
+		amat = ad.mat
+	} else {
+		w := getDenseWorkspace(ar, ac, false)
+		defer putDenseWorkspace(w)
+		w.Copy(a)
+		amat = w.mat
+	}
+	b.DoNonZero(func(k, j int, v float64) {
+		if bTrans {
+			k, j = j, k
+		}
+		f64.AxpyInc(v, amat.Data, m.mat.Data, uintptr(ar), uintptr(amat.Stride), uintptr(m.mat.Stride), uintptr(k), uintptr(j))
+	})
+}
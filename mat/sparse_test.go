@@ -0,0 +1,446 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// randSparse returns a random r×c matrix in COO format with approximately
+// the given density of non-zero elements.
+func randSparse(r, c int, density float64, rnd *rand.Rand) *COO {
+	m := NewCOO(r, c, nil, nil, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if rnd.Float64() < density {
+				m.Append(i, j, rnd.NormFloat64())
+			}
+		}
+	}
+	return m
+}
+
+func TestNewCSR(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		r, c   int
+		indptr []int
+		ind    []int
+		data   []float64
+		panics bool
+		want   *Dense
+	}{
+		{
+			r: 2, c: 3,
+			want: NewDense(2, 3, nil),
+		},
+		{
+			r: 3, c: 4,
+			indptr: []int{0, 2, 2, 4},
+			ind:    []int{0, 3, 1, 2},
+			data:   []float64{1, 2, 3, 4},
+			want: NewDense(3, 4, []float64{
+				1, 0, 0, 2,
+				0, 0, 0, 0,
+				0, 3, 4, 0,
+			}),
+		},
+		{
+			r: 0, c: 3,
+			panics: true,
+		},
+		{
+			r: 2, c: 3,
+			indptr: []int{0, 1},
+			ind:    []int{0},
+			data:   []float64{1},
+			panics: true,
+		},
+		{
+			r: 2, c: 3,
+			indptr: []int{0, 1, 2},
+			ind:    []int{0, 3},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+		{
+			r: 1, c: 3,
+			indptr: []int{0, 2},
+			ind:    []int{1, 1},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+		{
+			r: 2, c: 3,
+			indptr: []int{0, 2, 1},
+			ind:    []int{0, 1},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+	} {
+		var csr *CSR
+		panicked, msg := panics(func() { csr = NewCSR(test.r, test.c, test.indptr, test.ind, test.data) })
+		if panicked != test.panics {
+			t.Errorf("unexpected panic status for test %d: got:%t want:%t: %s", i, panicked, test.panics, msg)
+			continue
+		}
+		if test.panics {
+			continue
+		}
+		if !Equal(csr, test.want) {
+			t.Errorf("unexpected CSR value for test %d:\ngot:\n%v\nwant:\n%v", i, Formatted(csr), Formatted(test.want))
+		}
+
+		// The CSC of the transposed data is the transpose of the CSR.
+		csc := NewCSC(test.c, test.r, test.indptr, test.ind, test.data)
+		if !Equal(csc, test.want.T()) {
+			t.Errorf("unexpected CSC value for test %d:\ngot:\n%v\nwant:\n%v", i, Formatted(csc), Formatted(test.want.T()))
+		}
+	}
+}
+
+func TestSparseAtSet(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, r := range []int{1, 2, 5, 10} {
+		for _, c := range []int{1, 3, 7} {
+			for _, typ := range []string{"CSR", "CSC", "COO"} {
+				var m Mutable
+				switch typ {
+				case "CSR":
+					m = NewCSR(r, c, nil, nil, nil)
+				case "CSC":
+					m = NewCSC(r, c, nil, nil, nil)
+				case "COO":
+					m = NewCOO(r, c, nil, nil, nil)
+				}
+				want := NewDense(r, c, nil)
+				for k := 0; k < 4*r*c; k++ {
+					i := rnd.Intn(r)
+					j := rnd.Intn(c)
+					v := rnd.NormFloat64()
+					if rnd.Float64() < 0.3 {
+						v = 0
+					}
+					m.Set(i, j, v)
+					want.Set(i, j, v)
+				}
+				if !Equal(m, want) {
+					t.Errorf("unexpected %s value for %d×%d after Set:\ngot:\n%v\nwant:\n%v",
+						typ, r, c, Formatted(m), Formatted(want))
+				}
+
+				var nnz int
+				for _, v := range want.RawMatrix().Data {
+					if v != 0 {
+						nnz++
+					}
+				}
+				var got int
+				m.(NonZeroDoer).DoNonZero(func(i, j int, v float64) {
+					got++
+					if want.At(i, j) != v {
+						t.Errorf("unexpected DoNonZero value for %s at (%d,%d): got:%v want:%v", typ, i, j, v, want.At(i, j))
+					}
+				})
+				if got != nnz {
+					t.Errorf("unexpected number of non-zero elements for %s: got:%d want:%d", typ, got, nnz)
+				}
+			}
+		}
+	}
+}
+
+func TestCOODuplicates(t *testing.T) {
+	t.Parallel()
+	m := NewCOO(2, 3,
+		[]int{0, 1, 0, 1, 0},
+		[]int{2, 0, 2, 0, 1},
+		[]float64{1, 2, 3, 4, 5},
+	)
+	want := NewDense(2, 3, []float64{
+		0, 5, 4,
+		6, 0, 0,
+	})
+	if !Equal(m, want) {
+		t.Errorf("unexpected COO value:\ngot:\n%v\nwant:\n%v", Formatted(m), Formatted(want))
+	}
+	var csr CSR
+	csr.CloneFrom(m)
+	if !Equal(&csr, want) {
+		t.Errorf("unexpected CSR value:\ngot:\n%v\nwant:\n%v", Formatted(&csr), Formatted(want))
+	}
+	if csr.NNZ() != 3 {
+		t.Errorf("unexpected number of CSR stored elements: got:%d want:3", csr.NNZ())
+	}
+	var csc CSC
+	csc.CloneFrom(m)
+	if !Equal(&csc, want) {
+		t.Errorf("unexpected CSC value:\ngot:\n%v\nwant:\n%v", Formatted(&csc), Formatted(want))
+	}
+	var d Dense
+	d.CloneFrom(m)
+	if !Equal(&d, want) {
+		t.Errorf("unexpected Dense value:\ngot:\n%v\nwant:\n%v", Formatted(&d), Formatted(want))
+	}
+	m.Set(0, 2, 7)
+	want.Set(0, 2, 7)
+	if !Equal(m, want) {
+		t.Errorf("unexpected COO value after Set:\ngot:\n%v\nwant:\n%v", Formatted(m), Formatted(want))
+	}
+	if m.NNZ() != 4 {
+		t.Errorf("unexpected number of COO stored elements: got:%d want:4", m.NNZ())
+	}
+}
+
+func TestSparseConvert(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, r := range []int{1, 3, 10} {
+		for _, c := range []int{1, 4, 9} {
+			src := randSparse(r, c, 0.3, rnd)
+			var want Dense
+			want.CloneFrom(src)
+
+			for _, a := range []Matrix{src, &want, want.T()} {
+				var csr CSR
+				csr.CloneFrom(a)
+				var csc CSC
+				csc.CloneFrom(a)
+				var coo COO
+				coo.CloneFrom(a)
+				wantA := DenseCopyOf(a)
+				for _, got := range []Matrix{&csr, &csc, &coo} {
+					if !Equal(got, wantA) {
+						t.Errorf("unexpected %T clone of %T:\ngot:\n%v\nwant:\n%v", got, a, Formatted(got), Formatted(wantA))
+					}
+					var d Dense
+					d.CloneFrom(got)
+					if !Equal(&d, wantA) {
+						t.Errorf("unexpected Dense clone of %T", got)
+					}
+					ar, ac := a.Dims()
+					dt := NewDense(ac, ar, nil)
+					dt.Copy(got.T())
+					if !Equal(dt, wantA.T()) {
+						t.Errorf("unexpected Dense copy of transposed %T", got)
+					}
+				}
+
+				// Cloning a CSR from a CSR must not share backing data.
+				var clone CSR
+				clone.CloneFrom(&csr)
+				if clone.NNZ() > 0 {
+					clone.mat.data[0]++
+					if Equal(&clone, &csr) {
+						t.Errorf("CSR clone shares backing data")
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSparseMul(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, k, n int }{
+		{1, 1, 1},
+		{1, 5, 1},
+		{4, 1, 3},
+		{5, 7, 3},
+		{10, 10, 10},
+		{20, 15, 8},
+	} {
+		a := randSparse(test.m, test.k, 0.3, rnd)
+		b := randSparse(test.k, test.n, 0.3, rnd)
+		aDense := DenseCopyOf(a)
+		bDense := DenseCopyOf(b)
+		var want Dense
+		want.Mul(aDense, bDense)
+
+		var aCSR, bCSR CSR
+		aCSR.CloneFrom(a)
+		bCSR.CloneFrom(b)
+		var aCSC, bCSC CSC
+		aCSC.CloneFrom(a)
+		bCSC.CloneFrom(b)
+		// Transposed operands whose transpose is stored.
+		var aT, bT CSC
+		aT.CloneFrom(a.T())
+		bT.CloneFrom(b.T())
+
+		for _, ops := range []struct {
+			name string
+			a, b Matrix
+		}{
+			{"CSR×CSR", &aCSR, &bCSR},
+			{"CSC×CSC", &aCSC, &bCSC},
+			{"CSR×CSC", &aCSR, &bCSC},
+			{"COO×COO", a, b},
+			{"CSR×Dense", &aCSR, bDense},
+			{"Dense×CSC", aDense, &bCSC},
+			{"CSCᵀ×CSCᵀ", aT.T(), bT.T()},
+			{"Denseᵀ×CSR", DenseCopyOf(a.T()).T(), &bCSR},
+			{"CSR×Denseᵀ", &aCSR, DenseCopyOf(b.T()).T()},
+		} {
+			name := fmt.Sprintf("%s %d×%d×%d", ops.name, test.m, test.k, test.n)
+
+			var gotCSR CSR
+			gotCSR.Mul(ops.a, ops.b)
+			if !EqualApprox(&gotCSR, &want, 1e-14) {
+				t.Errorf("unexpected CSR.Mul result for %s:\ngot:\n%v\nwant:\n%v", name, Formatted(&gotCSR), Formatted(&want))
+			}
+			var gotCSC CSC
+			gotCSC.Mul(ops.a, ops.b)
+			if !EqualApprox(&gotCSC, &want, 1e-14) {
+				t.Errorf("unexpected CSC.Mul result for %s:\ngot:\n%v\nwant:\n%v", name, Formatted(&gotCSC), Formatted(&want))
+			}
+			var gotDense Dense
+			gotDense.Mul(ops.a, ops.b)
+			if !EqualApprox(&gotDense, &want, 1e-14) {
+				t.Errorf("unexpected Dense.Mul result for %s:\ngot:\n%v\nwant:\n%v", name, Formatted(&gotDense), Formatted(&want))
+			}
+		}
+
+		var wrong CSR
+		wrong.CloneFrom(NewDense(test.m+1, test.n, nil))
+		if panicked, _ := panics(func() { wrong.Mul(&aCSR, &bCSR) }); !panicked {
+			t.Errorf("expected panic for mismatched receiver")
+		}
+	}
+}
+
+func TestSparseMulVecTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ r, c int }{
+		{1, 1},
+		{1, 5},
+		{5, 1},
+		{7, 4},
+		{10, 10},
+	} {
+		src := randSparse(test.r, test.c, 0.4, rnd)
+		dense := DenseCopyOf(src)
+		var csr CSR
+		csr.CloneFrom(src)
+		var csc CSC
+		csc.CloneFrom(src)
+
+		for _, trans := range []bool{false, true} {
+			n := test.c
+			a := Matrix(dense)
+			if trans {
+				n = test.r
+				a = dense.T()
+			}
+			x := NewVecDense(n, nil)
+			for i := 0; i < n; i++ {
+				x.SetVec(i, rnd.NormFloat64())
+			}
+			var want VecDense
+			want.MulVec(a, x)
+
+			for _, m := range []interface {
+				Matrix
+				MulVecTo(*VecDense, bool, Vector)
+			}{&csr, &csc, src} {
+				var got VecDense
+				m.MulVecTo(&got, trans, x)
+				if !EqualApprox(&got, &want, 1e-14) {
+					t.Errorf("unexpected %T.MulVecTo result for %d×%d trans=%t:\ngot:  %v\nwant: %v",
+						m, test.r, test.c, trans, Formatted(got.T()), Formatted(want.T()))
+				}
+
+				var strided VecDense
+				strided.SetRawVector(x.RawVector())
+				strided.mat.Data = make([]float64, 3*n)
+				strided.mat.Inc = 3
+				strided.CopyVec(x)
+				got.Reset()
+				m.MulVecTo(&got, trans, &strided)
+				if !EqualApprox(&got, &want, 1e-14) {
+					t.Errorf("unexpected %T.MulVecTo result with strided x for %d×%d trans=%t", m, test.r, test.c, trans)
+				}
+
+				got.Reset()
+				mm := Matrix(m)
+				if trans {
+					mm = m.T()
+				}
+				got.MulVec(mm, x)
+				if !EqualApprox(&got, &want, 1e-14) {
+					t.Errorf("unexpected VecDense.MulVec result for %T %d×%d trans=%t", m, test.r, test.c, trans)
+				}
+			}
+		}
+	}
+}
+
+func TestSparseMulOverlap(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const n = 4
+	src := randSparse(n, n, 0.5, rnd)
+	var csr CSR
+	csr.CloneFrom(src)
+	var csc CSC
+	csc.CloneFrom(src)
+
+	for _, sp := range []interface {
+		Matrix
+		MulVecTo(*VecDense, bool, Vector)
+	}{&csr, &csc, src} {
+		// The receiver of Dense.Mul may be the dense operand.
+		d := randNormalDense(n, n, rnd)
+		var want Dense
+		want.Mul(sp, d)
+		d.Mul(sp, d)
+		if !EqualApprox(d, &want, 1e-14) {
+			t.Errorf("unexpected Dense.Mul result for %T with aliased receiver", sp)
+		}
+		d = randNormalDense(n, n, rnd)
+		want.Mul(d, sp)
+		d.Mul(d, sp)
+		if !EqualApprox(d, &want, 1e-14) {
+			t.Errorf("unexpected Dense.Mul result for %T with aliased receiver", sp)
+		}
+
+		// A receiver partially overlapping the dense operand must panic.
+		backing := randNormalDense(n+1, n+1, rnd)
+		b := backing.Slice(0, n, 0, n)
+		m := backing.Slice(1, n+1, 1, n+1).(*Dense)
+		if panicked, _ := panics(func() { m.Mul(sp, b) }); !panicked {
+			t.Errorf("expected panic for %T×Dense with overlapping receiver", sp)
+		}
+		if panicked, _ := panics(func() { m.Mul(b, sp) }); !panicked {
+			t.Errorf("expected panic for Dense×%T with overlapping receiver", sp)
+		}
+
+		// The destination of MulVecTo may be x.
+		x := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			x.SetVec(i, rnd.NormFloat64())
+		}
+		var wantVec VecDense
+		wantVec.MulVec(sp, x)
+		sp.MulVecTo(x, false, x)
+		if !EqualApprox(x, &wantVec, 1e-14) {
+			t.Errorf("unexpected %T.MulVecTo result with aliased destination", sp)
+		}
+
+		// A destination partially overlapping x must panic.
+		vec := NewVecDense(n+1, nil)
+		xv := vec.SliceVec(0, n).(*VecDense)
+		dst := vec.SliceVec(1, n+1).(*VecDense)
+		if panicked, _ := panics(func() { sp.MulVecTo(dst, false, xv) }); !panicked {
+			t.Errorf("expected panic for %T.MulVecTo with overlapping destination", sp)
+		}
+	}
+}
@@ -5,6 +5,11 @@
 package mat
 
 import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
@@ -44,6 +49,13 @@ const (
 	SVDThin SVDKind = SVDThinU | SVDThinV
 	// SVDFull is a convenience value for computing both full vectors.
 	SVDFull SVDKind = SVDFullU | SVDFullV
+
+	// SVDDivideConquer specifies that the decomposition should be computed
+	// using the divide and conquer method of lapack.Float64.Dgesdd. It is
+	// combined with the other kinds and is usually much faster than the
+	// default implicit QR method for large matrices when singular vectors
+	// are computed, at the cost of additional workspace.
+	SVDDivideConquer SVDKind = 1 << 4
 )
 
 // succFact returns whether the receiver contains a successful factorization.
@@ -70,6 +82,10 @@ func (svd *SVD) succFact() bool {
 // where U~ is of size m×min(m,n), Σ is a diagonal matrix of size min(m,n)×min(m,n)
 // and V~ is of size n×min(m,n).
 //
+// If kind includes SVDDivideConquer, the decomposition is computed with the
+// divide and conquer algorithm which is typically much faster for large
+// matrices when singular vectors are requested.
+//
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
 func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
@@ -129,15 +145,82 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	svd.kind = kind
 	svd.s = use(svd.s, min(m, n))
 
+	if kind&SVDDivideConquer != 0 {
+		ok = svd.factorizeDivideConquer(aCopy.mat, kind)
+	} else {
+		work := []float64{0}
+		lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
+		work = getFloat64s(int(work[0]), false)
+		ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
+		putFloat64s(work)
+	}
+	if !ok {
+		svd.kind = 0
+	}
+	return ok
+}
+
+// factorizeDivideConquer computes the SVD of a using lapack64.Gesdd. The
+// receiver's u and vt must have been allocated according to kind. Dgesdd
+// computes U and Vᵀ with the same shape, so the vectors that were not
+// requested, or were requested with a different shape, are computed into
+// temporary storage.
+func (svd *SVD) factorizeDivideConquer(a blas64.General, kind SVDKind) (ok bool) {
+	m, n := a.Rows, a.Cols
+	minmn := min(m, n)
+
+	var jobz lapack.SVDJob
+	uc, vr := minmn, minmn
+	switch {
+	case kind&(SVDFullU|SVDFullV) != 0:
+		jobz = lapack.SVDAll
+		uc, vr = m, n
+	case kind&(SVDThinU|SVDThinV) != 0:
+		jobz = lapack.SVDStore
+	default:
+		jobz = lapack.SVDNone
+	}
+
+	wantU := kind&(SVDThinU|SVDFullU) != 0
+	wantV := kind&(SVDThinV|SVDFullV) != 0
+	var u, vt blas64.General
+	if jobz != lapack.SVDNone {
+		u = svd.u
+		if !wantU || svd.u.Cols != uc {
+			u = blas64.General{Rows: m, Cols: uc, Stride: uc, Data: getFloat64s(m*uc, false)}
+			defer putFloat64s(u.Data)
+		}
+		vt = svd.vt
+		if !wantV || svd.vt.Rows != vr {
+			vt = blas64.General{Rows: vr, Cols: n, Stride: n, Data: getFloat64s(vr*n, false)}
+			defer putFloat64s(vt.Data)
+		}
+	}
+
+	iwork := getInts(8*minmn, false)
 	work := []float64{0}
-	lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
+	lapack64.Gesdd(jobz, a, u, vt, svd.s, work, -1, iwork)
 	work = getFloat64s(int(work[0]), false)
-	ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
+	ok = lapack64.Gesdd(jobz, a, u, vt, svd.s, work, len(work), iwork)
 	putFloat64s(work)
+	putInts(iwork)
 	if !ok {
-		svd.kind = 0
+		return false
 	}
-	return ok
+
+	// Copy the leading columns of U and rows of Vᵀ if only the thin
+	// vectors were requested.
+	if wantU && svd.u.Cols != uc {
+		for i := 0; i < m; i++ {
+			copy(svd.u.Data[i*svd.u.Stride:i*svd.u.Stride+svd.u.Cols], u.Data[i*u.Stride:])
+		}
+	}
+	if wantV && svd.vt.Rows != vr {
+		for i := 0; i < svd.vt.Rows; i++ {
+			copy(svd.vt.Data[i*svd.vt.Stride:i*svd.vt.Stride+n], vt.Data[i*vt.Stride:])
+		}
+	}
+	return true
 }
 
 // Kind returns the SVDKind of the decomposition. If no decomposition has been
@@ -150,7 +233,8 @@ func (svd *SVD) Kind() SVDKind {
 }
 
 // Rank returns the rank of A based on the count of singular values greater than
-// rcond scaled by the largest singular value.
+// rcond scaled by the largest singular value. For a truncated factorization,
+// only the computed singular values are counted.
 // Rank will panic if the receiver does not contain a successful factorization or
 // rcond is negative.
 func (svd *SVD) Rank(rcond float64) int {
@@ -169,8 +253,10 @@ func (svd *SVD) Rank(rcond float64) int {
 	return len(svd.s)
 }
 
-// Cond returns the 2-norm condition number for the factorized matrix. Cond will
-// panic if the receiver does not contain a successful factorization.
+// Cond returns the 2-norm condition number for the factorized matrix. For a
+// truncated factorization, Cond returns the ratio of the largest and smallest
+// computed singular values. Cond will panic if the receiver does not contain a
+// successful factorization.
 func (svd *SVD) Cond() float64 {
 	if !svd.succFact() {
 		panic(badFact)
@@ -181,8 +267,9 @@ func (svd *SVD) Cond() float64 {
 // Values returns the singular values of the factorized matrix in descending order.
 //
 // If the input slice is non-nil, the values will be stored in-place into
-// the slice. In this case, the slice must have length min(m,n), and Values will
-// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// the slice. In this case, the slice must have length min(m,n), or k for a
+// truncated factorization, and Values will panic with ErrSliceLengthMismatch
+// otherwise. If the input slice is nil, a new
 // slice of the appropriate length will be allocated and returned.
 //
 // Values will panic if the receiver does not contain a successful factorization.
@@ -204,8 +291,9 @@ func (svd *SVD) Values(s []float64) []float64 {
 // min(m,n) columns are the left singular vectors and correspond to the singular
 // values as returned from SVD.Values.
 //
-// If dst is empty, UTo will resize dst to be m×m if the full U was computed
-// and size m×min(m,n) if the thin U was computed. When dst is non-empty, then
+// If dst is empty, UTo will resize dst to be m×m if the full U was computed,
+// size m×min(m,n) if the thin U was computed and size m×k for a truncated
+// factorization. When dst is non-empty, then
 // UTo will panic if dst is not the appropriate size. UTo will also panic if
 // the receiver does not contain a successful factorization, or if U was
 // not computed during factorization.
@@ -240,8 +328,9 @@ func (svd *SVD) UTo(dst *Dense) {
 // min(m,n) columns are the right singular vectors and correspond to the singular
 // values as returned from SVD.Values.
 //
-// If dst is empty, VTo will resize dst to be n×n if the full V was computed
-// and size n×min(m,n) if the thin V was computed. When dst is non-empty, then
+// If dst is empty, VTo will resize dst to be n×n if the full V was computed,
+// size n×min(m,n) if the thin V was computed and size n×k for a truncated
+// factorization. When dst is non-empty, then
 // VTo will panic if dst is not the appropriate size. VTo will also panic if
 // the receiver does not contain a successful factorization, or if V was
 // not computed during factorization.
@@ -411,3 +500,371 @@ func (svd *SVD) SolveVecTo(dst *VecDense, b Vector, rank int) float64 {
 	}
 	return res
 }
+
+// FactorizeRandomized computes an approximation to the rank-k truncated
+// singular value decomposition of the m×n matrix A,
+//  A ≈ U_k * Σ_k * V_kᵀ,
+// where Σ_k is a k×k diagonal matrix with the k largest singular values of A,
+// and U_k and V_k are m×k and n×k matrices with the corresponding left and
+// right singular vectors in their columns.
+//
+// FactorizeRandomized uses the randomized range finder of Halko, Martinsson
+// and Tropp. The range of A is sampled by the product of A with a Gaussian
+// random matrix with k+oversample columns, and the sample is refined by iters
+// steps of the power iteration
+//  Y = (A * Aᵀ)^iters * A * Ω
+// with reorthonormalization after each product. Oversampling of 5 to 10 and
+// a small number of power iterations are usually sufficient, more iterations
+// improve the accuracy when the singular values of A decay slowly. If src is
+// nil, the global random number generator of golang.org/x/exp/rand is used.
+//
+// Only the thin vectors can be computed, so kind must be one of SVDNone,
+// SVDThinU, SVDThinV and SVDThin. After a successful factorization, Values
+// returns the k computed singular values and UTo and VTo return matrices with
+// k columns. FactorizeRandomized panics if k is not in the range
+// [1, min(m,n)], if oversample or iters is negative, or if kind is not valid.
+//
+// FactorizeRandomized returns whether the decomposition succeeded. If the
+// decomposition failed, routines that require a successful factorization will
+// panic.
+//
+// Reference:
+//  Halko, N., Martinsson, P. G. and Tropp, J. A. (2011). Finding structure with
+//  randomness: Probabilistic algorithms for constructing approximate matrix
+//  decompositions. SIAM Review, 53(2), 217-288.
+func (svd *SVD) FactorizeRandomized(a Matrix, kind SVDKind, k, oversample, iters int, src rand.Source) (ok bool) {
+	m, n := a.Dims()
+	checkTruncatedSVD(m, n, k, kind)
+	if oversample < 0 {
+		panic("mat: negative oversampling")
+	}
+	if iters < 0 {
+		panic("mat: negative number of power iterations")
+	}
+	// kill previous factorization
+	svd.s = svd.s[:0]
+
+	normFloat64 := rand.NormFloat64
+	if src != nil {
+		normFloat64 = rand.New(src).NormFloat64
+	}
+
+	l := min(k+oversample, min(m, n))
+	omega := NewDense(n, l, nil)
+	for i := range omega.mat.Data {
+		omega.mat.Data[i] = normFloat64()
+	}
+
+	// Find an orthonormal basis Q for the approximate range of A.
+	var y, z Dense
+	y.Mul(a, omega)
+	q := thinQ(&y)
+	for it := 0; it < iters; it++ {
+		z.Mul(a.T(), q)
+		qz := thinQ(&z)
+		y.Mul(a, qz)
+		q = thinQ(&y)
+	}
+
+	// Compute the SVD of the small l×n matrix B = Qᵀ * A and lift the left
+	// singular vectors.
+	var b Dense
+	b.Mul(q.T(), a)
+	var small SVD
+	ok = small.Factorize(&b, SVDThin)
+	if !ok {
+		svd.kind = 0
+		return false
+	}
+	var ub, vb Dense
+	small.UTo(&ub)
+	small.VTo(&vb)
+	var u Dense
+	u.Mul(q, ub.Slice(0, l, 0, k))
+	svd.setTruncated(kind, small.s[:k], &u, vb.Slice(0, n, 0, k))
+	return true
+}
+
+// FactorizeLanczos computes the rank-k truncated singular value decomposition
+// of the m×n matrix A,
+//  A ≈ U_k * Σ_k * V_kᵀ,
+// where Σ_k is a k×k diagonal matrix with the k largest singular values of A,
+// and U_k and V_k are m×k and n×k matrices with the corresponding left and
+// right singular vectors in their columns.
+//
+// FactorizeLanczos uses the Golub-Kahan-Lanczos bidiagonalization with full
+// reorthogonalization, which only accesses A through products with vectors.
+// The bidiagonalization is extended until the residual norms of the k largest
+// singular triplets are below tol times the largest singular value. If tol is
+// zero, a tolerance of 1e-13 is used.
+//
+// Only the thin vectors can be computed, so kind must be one of SVDNone,
+// SVDThinU, SVDThinV and SVDThin. After a successful factorization, Values
+// returns the k computed singular values and UTo and VTo return matrices with
+// k columns. FactorizeLanczos panics if k is not in the range [1, min(m,n)],
+// if tol is not in the range [0, 1), or if kind is not valid.
+//
+// FactorizeLanczos returns whether the decomposition succeeded. If the
+// decomposition failed, routines that require a successful factorization will
+// panic.
+func (svd *SVD) FactorizeLanczos(a Matrix, kind SVDKind, k int, tol float64) (ok bool) {
+	m, n := a.Dims()
+	checkTruncatedSVD(m, n, k, kind)
+	if tol < 0 || 1 <= tol {
+		panic("mat: invalid tolerance")
+	}
+	if tol == 0 {
+		tol = 1e-13
+	}
+	// kill previous factorization
+	svd.s = svd.s[:0]
+
+	// The bidiagonalization is performed on the matrix with more rows than
+	// columns so that the right Krylov subspace can span the whole space.
+	trans := m < n
+	if trans {
+		a = a.T()
+		m, n = n, m
+	}
+
+	gkl := newBidiagonalization(a, m, n)
+	p := min(n, max(2*k, k+10))
+	for {
+		gkl.extend(p)
+		s, x, y, ok := gkl.svd()
+		if !ok {
+			svd.kind = 0
+			return false
+		}
+		done := p == n
+		if !done {
+			done = true
+			beta := gkl.beta[p-1]
+			for j := 0; j < k; j++ {
+				if beta*math.Abs(x.At(p-1, j)) > tol*s[0] {
+					done = false
+					break
+				}
+			}
+		}
+		if done {
+			u := NewDense(m, k, nil)
+			v := NewDense(n, k, nil)
+			u.Mul(gkl.u.slice(0, m, 0, p), x.Slice(0, p, 0, k))
+			v.Mul(gkl.v.slice(0, n, 0, p), y.Slice(0, p, 0, k))
+			if trans {
+				u, v = v, u
+			}
+			svd.setTruncated(kind, s[:k], u, v)
+			return true
+		}
+		p = min(n, 2*p)
+	}
+}
+
+// checkTruncatedSVD panics if the arguments of a truncated SVD are not valid.
+func checkTruncatedSVD(m, n, k int, kind SVDKind) {
+	if k < 1 || min(m, n) < k {
+		panic(ErrIndexOutOfRange)
+	}
+	if kind&(SVDFullU|SVDFullV) != 0 || kind&^SVDThin != 0 {
+		panic("mat: improper SVD kind for truncated factorization")
+	}
+}
+
+// setTruncated stores the singular values s and the singular vectors u and v
+// of a truncated factorization into the receiver according to kind.
+func (svd *SVD) setTruncated(kind SVDKind, s []float64, u, v Matrix) {
+	m, k := u.Dims()
+	n, _ := v.Dims()
+	svd.kind = kind
+	svd.s = use(svd.s, k)
+	copy(svd.s, s)
+	if kind&SVDThinU != 0 {
+		svd.u = blas64.General{
+			Rows:   m,
+			Cols:   k,
+			Stride: k,
+			Data:   use(svd.u.Data, m*k),
+		}
+		dst := Dense{mat: svd.u, capRows: m, capCols: k}
+		dst.Copy(u)
+	}
+	if kind&SVDThinV != 0 {
+		svd.vt = blas64.General{
+			Rows:   k,
+			Cols:   n,
+			Stride: n,
+			Data:   use(svd.vt.Data, k*n),
+		}
+		dst := Dense{mat: svd.vt, capRows: k, capCols: n}
+		dst.Copy(v.T())
+	}
+}
+
+// thinQ returns the m×n matrix with orthonormal columns Q from the QR
+// factorization of the m×n matrix a with m >= n. a is overwritten.
+func thinQ(a *Dense) *Dense {
+	m, n := a.Dims()
+	tau := make([]float64, n)
+	work := []float64{0}
+	lapack64.Geqrf(a.mat, tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Geqrf(a.mat, tau, work, len(work))
+	putFloat64s(work)
+
+	q := NewDense(m, n, nil)
+	for i := 0; i < n; i++ {
+		q.mat.Data[i*q.mat.Stride+i] = 1
+	}
+	work = []float64{0}
+	lapack64.Ormqr(blas.Left, blas.NoTrans, a.mat, tau, q.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, blas.NoTrans, a.mat, tau, q.mat, work, len(work))
+	putFloat64s(work)
+	return q
+}
+
+// bidiagonalization holds a p-step Golub-Kahan-Lanczos bidiagonalization of the
+// m×n matrix A with m >= n,
+//  A * V_p = U_p * B_p,
+//  Aᵀ * U_p = V_p * B_pᵀ + beta_p * v_{p+1} * e_pᵀ,
+// where U_p and V_p have orthonormal columns and B_p is upper bidiagonal with
+// alpha on its diagonal and beta on its superdiagonal.
+type bidiagonalization struct {
+	a    Matrix
+	m, n int
+
+	u, v        *Dense
+	alpha, beta []float64
+	p           int
+
+	// next is the unnormalized v_{p+1}.
+	next *VecDense
+	rnd  *rand.Rand
+}
+
+func newBidiagonalization(a Matrix, m, n int) *bidiagonalization {
+	b := &bidiagonalization{
+		a:    a,
+		m:    m,
+		n:    n,
+		next: NewVecDense(n, nil),
+		rnd:  rand.New(rand.NewSource(1)),
+	}
+	return b
+}
+
+// extend extends the bidiagonalization to p steps. The bases U and V are
+// grown to p columns, so only O((m+n)*p) storage is used.
+func (b *bidiagonalization) extend(p int) {
+	b.u = growCols(b.u, b.m, b.p, p)
+	b.v = growCols(b.v, b.n, b.p, p)
+	var au, atv VecDense
+	for j := b.p; j < p; j++ {
+		vj := b.v.ColView(j).(*VecDense)
+		if j == 0 {
+			b.randomOrthogonal(vj, b.v, 0)
+		} else if b.beta[j-1] == 0 {
+			b.randomOrthogonal(vj, b.v, j)
+		} else {
+			vj.ScaleVec(1/b.beta[j-1], b.next)
+		}
+
+		// u_j = A*v_j - beta_{j-1}*u_{j-1}, orthogonalized against U.
+		uj := b.u.ColView(j).(*VecDense)
+		au.MulVec(b.a, vj)
+		alpha := orthogonalizeTo(uj, &au, b.u.slice(0, b.m, 0, j))
+		if alpha == 0 {
+			b.randomOrthogonal(uj, b.u, j)
+		} else {
+			uj.ScaleVec(1/alpha, uj)
+		}
+		b.alpha = append(b.alpha, alpha)
+
+		// v_{j+1} = Aᵀ*u_j - alpha_j*v_j, orthogonalized against V.
+		atv.MulVec(b.a.T(), uj)
+		var beta float64
+		if j+1 < b.n {
+			beta = orthogonalizeTo(b.next, &atv, b.v.slice(0, b.n, 0, j+1))
+		}
+		b.beta = append(b.beta, beta)
+	}
+	b.p = p
+}
+
+// growCols returns an r×c matrix whose first j columns are copied from a.
+func growCols(a *Dense, r, j, c int) *Dense {
+	g := NewDense(r, c, nil)
+	if j > 0 {
+		g.slice(0, r, 0, j).Copy(a.slice(0, r, 0, j))
+	}
+	return g
+}
+
+// randomOrthogonal stores into dst a random unit vector orthogonal to the first
+// j columns of basis.
+func (b *bidiagonalization) randomOrthogonal(dst *VecDense, basis *Dense, j int) {
+	r, _ := basis.Dims()
+	x := NewVecDense(r, nil)
+	for {
+		for i := 0; i < r; i++ {
+			x.SetVec(i, b.rnd.NormFloat64())
+		}
+		norm := orthogonalizeTo(dst, x, basis.slice(0, r, 0, j))
+		if norm != 0 {
+			dst.ScaleVec(1/norm, dst)
+			return
+		}
+	}
+}
+
+// svd returns the singular values and vectors of the p×p bidiagonal matrix B_p.
+func (b *bidiagonalization) svd() (s []float64, x, y *Dense, ok bool) {
+	p := b.p
+	bd := NewDense(p, p, nil)
+	for i := 0; i < p; i++ {
+		bd.set(i, i, b.alpha[i])
+		if i < p-1 {
+			bd.set(i, i+1, b.beta[i])
+		}
+	}
+	var f SVD
+	ok = f.Factorize(bd, SVDThin)
+	if !ok {
+		return nil, nil, nil, false
+	}
+	x = &Dense{}
+	y = &Dense{}
+	f.UTo(x)
+	f.VTo(y)
+	return f.s, x, y, true
+}
+
+// orthogonalizeTo stores into dst the component of x orthogonal to the columns
+// of the matrix q, which must be orthonormal, and returns its norm. The
+// classical Gram-Schmidt process with reorthogonalization is used, and if x is
+// numerically in the span of q, dst is set to zero and zero is returned.
+func orthogonalizeTo(dst, x *VecDense, q *Dense) float64 {
+	const eta = 0.717
+
+	dst.CopyVec(x)
+	_, j := q.Dims()
+	if j == 0 {
+		return Norm(dst, 2)
+	}
+	var c, qc VecDense
+	prev := Norm(dst, 2)
+	for pass := 0; pass < 3; pass++ {
+		c.MulVec(q.T(), dst)
+		qc.MulVec(q, &c)
+		dst.SubVec(dst, &qc)
+		norm := Norm(dst, 2)
+		if norm >= eta*prev {
+			return norm
+		}
+		prev = norm
+	}
+	dst.Zero()
+	return 0
+}
@@ -5,6 +5,8 @@
 package mat
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	"golang.org/x/exp/rand"
@@ -213,6 +215,103 @@ func extractSVD(svd *SVD) (s []float64, u, v *Dense) {
 	return svd.Values(nil), u, v
 }
 
+func TestSVDDivideConquer(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{5, 3},
+		{3, 5},
+		{60, 60},
+		{100, 20},
+		{20, 100},
+		{150, 120},
+		{120, 150},
+	} {
+		m := test.m
+		n := test.n
+		a := NewDense(m, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+		aCopy := DenseCopyOf(a)
+
+		var want SVD
+		ok := want.Factorize(a, SVDNone)
+		if !ok {
+			t.Fatalf("unexpected SVD failure for m=%d, n=%d", m, n)
+		}
+		sWant := want.Values(nil)
+
+		for _, kind := range []SVDKind{
+			SVDNone, SVDThinU, SVDFullU, SVDThinV, SVDFullV,
+			SVDThin, SVDFull, SVDThinU | SVDFullV, SVDFullU | SVDThinV,
+		} {
+			var svd SVD
+			ok := svd.Factorize(a, kind|SVDDivideConquer)
+			if !ok {
+				t.Errorf("unexpected SVD failure for m=%d, n=%d, kind=%d", m, n, kind)
+				continue
+			}
+			if !Equal(a, aCopy) {
+				t.Errorf("A changed during call to SVD for m=%d, n=%d, kind=%d", m, n, kind)
+			}
+			s := svd.Values(nil)
+			if !floats.EqualApprox(s, sWant, 1e-12) {
+				t.Errorf("singular value mismatch for m=%d, n=%d, kind=%d", m, n, kind)
+			}
+
+			var u, v Dense
+			if kind&(SVDThinU|SVDFullU) != 0 {
+				svd.UTo(&u)
+				r, c := u.Dims()
+				wantCols := min(m, n)
+				if kind&SVDFullU != 0 {
+					wantCols = m
+				}
+				if r != m || c != wantCols {
+					t.Errorf("unexpected U shape for m=%d, n=%d, kind=%d: got %d×%d", m, n, kind, r, c)
+					continue
+				}
+				var utu Dense
+				utu.Mul(u.T(), &u)
+				if !EqualApprox(&utu, eye(c), 1e-12) {
+					t.Errorf("U not orthonormal for m=%d, n=%d, kind=%d", m, n, kind)
+				}
+			}
+			if kind&(SVDThinV|SVDFullV) != 0 {
+				svd.VTo(&v)
+				r, c := v.Dims()
+				wantCols := min(m, n)
+				if kind&SVDFullV != 0 {
+					wantCols = n
+				}
+				if r != n || c != wantCols {
+					t.Errorf("unexpected V shape for m=%d, n=%d, kind=%d: got %d×%d", m, n, kind, r, c)
+					continue
+				}
+				var vtv Dense
+				vtv.Mul(v.T(), &v)
+				if !EqualApprox(&vtv, eye(c), 1e-12) {
+					t.Errorf("V not orthonormal for m=%d, n=%d, kind=%d", m, n, kind)
+				}
+			}
+			if kind&(SVDThinU|SVDFullU) != 0 && kind&(SVDThinV|SVDFullV) != 0 {
+				k := min(m, n)
+				sigma := NewDiagDense(k, s)
+				var ans Dense
+				ans.Product(u.Slice(0, m, 0, k), sigma, v.Slice(0, n, 0, k).T())
+				if !EqualApprox(&ans, a, 1e-10) {
+					t.Errorf("A not recovered for m=%d, n=%d, kind=%d", m, n, kind)
+				}
+			}
+		}
+	}
+}
+
 func TestSVDSolveTo(t *testing.T) {
 	t.Parallel()
 	rnd := rand.New(rand.NewSource(1))
@@ -661,3 +760,233 @@ func TestSVDSolveVecTo(t *testing.T) {
 		}
 	}
 }
+
+func TestSVDTruncated(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1}, {5, 5}, {40, 10}, {10, 40}, {60, 60}, {100, 30},
+	} {
+		m, n := test.m, test.n
+		r := min(m, n)
+
+		// Construct a matrix with geometrically decaying singular values.
+		u := randomOrthonormal(m, r, rnd)
+		v := randomOrthonormal(n, r, rnd)
+		s := make([]float64, r)
+		for i := range s {
+			s[i] = math.Pow(0.7, float64(i))
+		}
+		var us, a Dense
+		us.Mul(u, NewDiagDense(r, s))
+		a.Mul(&us, v.T())
+
+		for _, k := range []int{1, r / 2, r} {
+			if k == 0 {
+				continue
+			}
+			for _, method := range []struct {
+				name      string
+				factorize func(svd *SVD, kind SVDKind) bool
+				tol       float64
+			}{
+				{
+					name: "Lanczos",
+					factorize: func(svd *SVD, kind SVDKind) bool {
+						return svd.FactorizeLanczos(&a, kind, k, 0)
+					},
+					tol: 1e-12,
+				},
+				{
+					name: "Randomized",
+					factorize: func(svd *SVD, kind SVDKind) bool {
+						return svd.FactorizeRandomized(&a, kind, k, 10, 4, rand.NewSource(rnd.Uint64()))
+					},
+					// The accuracy depends on the decay of the singular
+					// values beyond k+oversample.
+					tol: 1e-5,
+				},
+			} {
+				var svd SVD
+				ok := method.factorize(&svd, SVDThin)
+				if !ok {
+					t.Errorf("%s: factorization failed for m=%d, n=%d, k=%d", method.name, m, n, k)
+					continue
+				}
+				gotS, gotU, gotV := extractSVD(&svd)
+				if len(gotS) != k {
+					t.Errorf("%s: unexpected number of singular values for m=%d, n=%d, k=%d", method.name, m, n, k)
+					continue
+				}
+				if !floats.EqualApprox(gotS, s[:k], method.tol) {
+					t.Errorf("%s: singular value mismatch for m=%d, n=%d, k=%d: got %v, want %v",
+						method.name, m, n, k, gotS, s[:k])
+				}
+				if r, c := gotU.Dims(); r != m || c != k {
+					t.Errorf("%s: unexpected size of U for m=%d, n=%d, k=%d", method.name, m, n, k)
+					continue
+				}
+				if r, c := gotV.Dims(); r != n || c != k {
+					t.Errorf("%s: unexpected size of V for m=%d, n=%d, k=%d", method.name, m, n, k)
+					continue
+				}
+				var utu, vtv Dense
+				utu.Mul(gotU.T(), gotU)
+				vtv.Mul(gotV.T(), gotV)
+				if !EqualApprox(&utu, eye(k), 1e-13) || !EqualApprox(&vtv, eye(k), 1e-13) {
+					t.Errorf("%s: singular vectors not orthonormal for m=%d, n=%d, k=%d", method.name, m, n, k)
+				}
+				// Check that A*V = U*Σ.
+				var av, us Dense
+				av.Mul(&a, gotV)
+				us.Mul(gotU, NewDiagDense(k, gotS))
+				if !EqualApprox(&av, &us, method.tol) {
+					t.Errorf("%s: A*V != U*Σ for m=%d, n=%d, k=%d", method.name, m, n, k)
+				}
+
+				// Check that the other kinds give the same values.
+				for _, kind := range []SVDKind{SVDNone, SVDThinU, SVDThinV} {
+					var other SVD
+					if !method.factorize(&other, kind) {
+						t.Errorf("%s: factorization failed for kind=%v", method.name, kind)
+						continue
+					}
+					if other.Kind() != kind {
+						t.Errorf("%s: unexpected kind: got %v, want %v", method.name, other.Kind(), kind)
+					}
+					if !floats.EqualApprox(other.Values(nil), s[:k], method.tol) {
+						t.Errorf("%s: singular value mismatch for kind=%v", method.name, kind)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSVDTruncatedLowRank(t *testing.T) {
+	t.Parallel()
+	// For a matrix of rank k both methods are exact.
+	const m, n, k = 50, 30, 4
+	rnd := rand.New(rand.NewSource(1))
+	x := NewDense(m, k, nil)
+	y := NewDense(k, n, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < k; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	for i := 0; i < k; i++ {
+		for j := 0; j < n; j++ {
+			y.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var a Dense
+	a.Mul(x, y)
+
+	var full SVD
+	full.Factorize(&a, SVDNone)
+	want := full.Values(nil)[:k]
+
+	var lanczos, randomized SVD
+	if !lanczos.FactorizeLanczos(&a, SVDThin, k, 0) {
+		t.Fatal("Lanczos factorization failed")
+	}
+	if !randomized.FactorizeRandomized(&a, SVDThin, k, 0, 0, rand.NewSource(1)) {
+		t.Fatal("randomized factorization failed")
+	}
+	for _, svd := range []*SVD{&lanczos, &randomized} {
+		if got := svd.Values(nil); !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("singular value mismatch: got %v, want %v", got, want)
+		}
+
+		// The truncated factorization reproduces A and solves least
+		// squares problems with right-hand sides in its range.
+		s, u, v := extractSVD(svd)
+		var us, usv Dense
+		us.Mul(u, NewDiagDense(k, s))
+		usv.Mul(&us, v.T())
+		if !EqualApprox(&usv, &a, 1e-12) {
+			t.Errorf("U*Σ*Vᵀ != A")
+		}
+		xWant := NewDense(n, 1, nil)
+		xWant.Mul(v, NewDense(k, 1, []float64{1, 2, 3, 4}))
+		var b, xGot Dense
+		b.Mul(&a, xWant)
+		svd.SolveTo(&xGot, &b, k)
+		if !EqualApprox(&xGot, xWant, 1e-10) {
+			t.Errorf("unexpected solution")
+		}
+	}
+}
+
+func TestSVDLanczosLarge(t *testing.T) {
+	t.Parallel()
+	// The bases of the bidiagonalization must only hold the columns
+	// needed by the iteration. Storing n columns for this matrix would
+	// require hundreds of gigabytes.
+	const n, k = 200000, 2
+	ind := make([]int, n)
+	indptr := make([]int, n+1)
+	data := make([]float64, n)
+	for i := range data {
+		ind[i] = i
+		indptr[i+1] = i + 1
+		data[i] = 1 / float64(i+1)
+	}
+	a := NewCSR(n, n, indptr, ind, data)
+
+	var svd SVD
+	if !svd.FactorizeLanczos(a, SVDThin, k, 0) {
+		t.Fatal("Lanczos factorization failed")
+	}
+	if got, want := svd.Values(nil), []float64{1, 0.5}; !floats.EqualApprox(got, want, 1e-12) {
+		t.Errorf("singular value mismatch: got %v, want %v", got, want)
+	}
+
+	gkl := newBidiagonalization(a, n, n)
+	gkl.extend(12)
+	for _, basis := range []*Dense{gkl.u, gkl.v} {
+		if r, c := basis.Dims(); r != n || c != 12 {
+			t.Errorf("unexpected basis size: got %d×%d, want %d×12", r, c, n)
+		}
+	}
+}
+
+func TestSVDTruncatedPanics(t *testing.T) {
+	t.Parallel()
+	a := NewDense(4, 3, nil)
+	var svd SVD
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"k zero", func() { svd.FactorizeLanczos(a, SVDThin, 0, 0) }},
+		{"k large", func() { svd.FactorizeLanczos(a, SVDThin, 4, 0) }},
+		{"full kind", func() { svd.FactorizeLanczos(a, SVDFullU, 1, 0) }},
+		{"tolerance", func() { svd.FactorizeLanczos(a, SVDThin, 1, 1) }},
+		{"randomized k", func() { svd.FactorizeRandomized(a, SVDThin, 4, 0, 0, nil) }},
+		{"randomized kind", func() { svd.FactorizeRandomized(a, SVDFullV, 1, 0, 0, nil) }},
+		{"oversample", func() { svd.FactorizeRandomized(a, SVDThin, 1, -1, 0, nil) }},
+		{"iters", func() { svd.FactorizeRandomized(a, SVDThin, 1, 0, -1, nil) }},
+	} {
+		panicked, message := panics(test.fn)
+		if !panicked {
+			t.Errorf("%s: expected panic", test.name)
+			continue
+		}
+		if !strings.HasPrefix(message, "mat: ") {
+			t.Errorf("%s: unexpected panic message: %q", test.name, message)
+		}
+	}
+}
+
+// randomOrthonormal returns a random m×n matrix with orthonormal columns.
+func randomOrthonormal(m, n int, rnd *rand.Rand) *Dense {
+	a := NewDense(m, n, nil)
+	for i := range a.mat.Data {
+		a.mat.Data[i] = rnd.NormFloat64()
+	}
+	return thinQ(a)
+}
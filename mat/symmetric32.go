@@ -0,0 +1,305 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+var (
+	symDense32 *SymDense32
+
+	_ Matrix32         = symDense32
+	_ allMatrix        = symDense32
+	_ Symmetric32      = symDense32
+	_ RawSymmetricer32 = symDense32
+)
+
+const badSymTriangle32 = "mat: blas32.Symmetric not upper"
+
+// SymDense32 is a symmetric matrix with float32 data that uses dense storage.
+// SymDense32 matrices are stored in the upper triangle.
+type SymDense32 struct {
+	mat blas32.Symmetric
+	cap int
+}
+
+// Symmetric32 represents a single precision symmetric matrix (where the
+// element at {i, j} equals the element at {j, i}). Symmetric matrices are
+// always square.
+type Symmetric32 interface {
+	Matrix32
+	// Symmetric returns the number of rows/columns in the matrix.
+	Symmetric() int
+}
+
+// A RawSymmetricer32 can return a view of itself as a BLAS Symmetric matrix.
+type RawSymmetricer32 interface {
+	RawSymmetric32() blas32.Symmetric
+}
+
+// NewSymDense32 creates a new Symmetric32 matrix with n rows and columns. If
+// data == nil, a new slice is allocated for the backing slice. If
+// len(data) == n*n, data is used as the backing slice, and changes to the
+// elements of the returned SymDense32 will be reflected in data. If neither of
+// these is true, NewSymDense32 will panic.
+// NewSymDense32 will panic if n is zero.
+//
+// The data must be arranged in row-major order, i.e. the (i*c + j)-th
+// element in the data slice is the {i, j}-th element in the matrix.
+// Only the values in the upper triangular portion of the matrix are used.
+func NewSymDense32(n int, data []float32) *SymDense32 {
+	if n <= 0 {
+		if n == 0 {
+			panic(ErrZeroLength)
+		}
+		panic("mat: negative dimension")
+	}
+	if data != nil && n*n != len(data) {
+		panic(ErrShape)
+	}
+	if data == nil {
+		data = make([]float32, n*n)
+	}
+	return &SymDense32{
+		mat: blas32.Symmetric{
+			N:      n,
+			Stride: n,
+			Data:   data,
+			Uplo:   blas.Upper,
+		},
+		cap: n,
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (s *SymDense32) Dims() (r, c int) {
+	return s.mat.N, s.mat.N
+}
+
+// T returns the receiver, the transpose of a symmetric matrix.
+func (s *SymDense32) T() Matrix32 {
+	return s
+}
+
+// Symmetric implements the Symmetric32 interface and returns the number of
+// rows and columns in the matrix.
+func (s *SymDense32) Symmetric() int {
+	return s.mat.N
+}
+
+// RawSymmetric32 returns the matrix as a blas32.Symmetric. The returned
+// value must be stored in upper triangular format.
+func (s *SymDense32) RawSymmetric32() blas32.Symmetric {
+	return s.mat
+}
+
+// SetRawSymmetric32 sets the underlying blas32.Symmetric used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in the input.
+//
+// The supplied Symmetric must use blas.Upper storage format.
+func (s *SymDense32) SetRawSymmetric32(mat blas32.Symmetric) {
+	if mat.Uplo != blas.Upper {
+		panic(badSymTriangle32)
+	}
+	s.cap = mat.N
+	s.mat = mat
+}
+
+// Reset empties the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+// See the Reseter interface for more information.
+func (s *SymDense32) Reset() {
+	// N and Stride must be zeroed in unison.
+	s.mat.N, s.mat.Stride = 0, 0
+	s.mat.Data = s.mat.Data[:0]
+}
+
+// Zero sets all of the matrix elements to zero.
+func (s *SymDense32) Zero() {
+	for i := 0; i < s.mat.N; i++ {
+		zero32(s.mat.Data[i*s.mat.Stride+i : i*s.mat.Stride+s.mat.N])
+	}
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (s *SymDense32) IsEmpty() bool {
+	// It must be the case that m.Dims() returns
+	// zeros in this case. See comment in Reset().
+	return s.mat.N == 0
+}
+
+// reuseAsNonZeroed resizes an empty matrix to a n×n matrix,
+// or checks that a non-empty matrix is n×n.
+func (s *SymDense32) reuseAsNonZeroed(n int) {
+	if n == 0 {
+		panic(ErrZeroLength)
+	}
+	if s.mat.N > s.cap {
+		// Panic as a string, not a mat.Error.
+		panic(badCap)
+	}
+	if s.IsEmpty() {
+		s.mat = blas32.Symmetric{
+			N:      n,
+			Stride: n,
+			Data:   use32(s.mat.Data, n*n),
+			Uplo:   blas.Upper,
+		}
+		s.cap = n
+		return
+	}
+	if s.mat.Uplo != blas.Upper {
+		panic(badSymTriangle32)
+	}
+	if s.mat.N != n {
+		panic(ErrShape)
+	}
+}
+
+// CopySym makes a copy of elements of a into the receiver. It is similar to
+// the built-in copy; it copies as much as the overlap between the two
+// matrices and returns the number of rows and columns it copied.
+func (s *SymDense32) CopySym(a Symmetric32) int {
+	n := a.Symmetric()
+	n = min(n, s.mat.N)
+	if n == 0 {
+		return 0
+	}
+	switch a := a.(type) {
+	case RawSymmetricer32:
+		amat := a.RawSymmetric32()
+		if amat.Uplo != blas.Upper {
+			panic(badSymTriangle32)
+		}
+		for i := 0; i < n; i++ {
+			copy(s.mat.Data[i*s.mat.Stride+i:i*s.mat.Stride+n], amat.Data[i*amat.Stride+i:i*amat.Stride+n])
+		}
+	default:
+		for i := 0; i < n; i++ {
+			stmp := s.mat.Data[i*s.mat.Stride : i*s.mat.Stride+n]
+			for j := i; j < n; j++ {
+				stmp[j] = a.At(i, j)
+			}
+		}
+	}
+	return n
+}
+
+// AddSym adds the symmetric matrices a and b, placing the result in the
+// receiver.
+func (s *SymDense32) AddSym(a, b Symmetric32) {
+	n := a.Symmetric()
+	if n != b.Symmetric() {
+		panic(ErrShape)
+	}
+	s.reuseAsNonZeroed(n)
+
+	if a, ok := a.(RawSymmetricer32); ok {
+		if b, ok := b.(RawSymmetricer32); ok {
+			amat, bmat := a.RawSymmetric32(), b.RawSymmetric32()
+			if s != a {
+				s.checkOverlap(generalFromSymmetric32(amat))
+			}
+			if s != b {
+				s.checkOverlap(generalFromSymmetric32(bmat))
+			}
+			for i := 0; i < n; i++ {
+				btmp := bmat.Data[i*bmat.Stride+i : i*bmat.Stride+n]
+				stmp := s.mat.Data[i*s.mat.Stride+i : i*s.mat.Stride+n]
+				for j, v := range amat.Data[i*amat.Stride+i : i*amat.Stride+n] {
+					stmp[j] = v + btmp[j]
+				}
+			}
+			return
+		}
+	}
+
+	s.checkOverlapMatrix(a)
+	s.checkOverlapMatrix(b)
+	for i := 0; i < n; i++ {
+		stmp := s.mat.Data[i*s.mat.Stride : i*s.mat.Stride+n]
+		for j := i; j < n; j++ {
+			stmp[j] = a.At(i, j) + b.At(i, j)
+		}
+	}
+}
+
+// ScaleSym multiplies the elements of a by f, placing the result in the receiver.
+func (s *SymDense32) ScaleSym(f float32, a Symmetric32) {
+	n := a.Symmetric()
+	s.reuseAsNonZeroed(n)
+	if a, ok := a.(RawSymmetricer32); ok {
+		amat := a.RawSymmetric32()
+		if s != a {
+			s.checkOverlap(generalFromSymmetric32(amat))
+		}
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				s.mat.Data[i*s.mat.Stride+j] = f * amat.Data[i*amat.Stride+j]
+			}
+		}
+		return
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.mat.Data[i*s.mat.Stride+j] = f * a.At(i, j)
+		}
+	}
+}
+
+// SymOuterK calculates the outer product of x with itself and stores
+// the result into the receiver. It is equivalent to the matrix
+// multiplication
+//  s = alpha * x * xᵀ.
+func (s *SymDense32) SymOuterK(alpha float32, x Matrix32) {
+	n, c := x.Dims()
+	if s.IsEmpty() {
+		s.mat = blas32.Symmetric{
+			N:      n,
+			Stride: n,
+			Data:   useZeroed32(s.mat.Data, n*n),
+			Uplo:   blas.Upper,
+		}
+		s.cap = n
+	} else {
+		if s.mat.Uplo != blas.Upper {
+			panic(badSymTriangle32)
+		}
+		if s.mat.N != n {
+			panic(ErrShape)
+		}
+		if s != x {
+			s.checkOverlapMatrix(x)
+		}
+	}
+
+	xU, trans := untransposeExtract32(x)
+	var g blas32.General
+	if rm, ok := xU.(*Dense32); ok && s != x {
+		g = rm.mat
+	} else {
+		d := NewDense32(n, c, nil)
+		d.Copy(x)
+		g = d.mat
+		trans = false
+	}
+	// Only zero the upper triangle.
+	for i := 0; i < n; i++ {
+		ri := i * s.mat.Stride
+		zero32(s.mat.Data[ri+i : ri+n])
+	}
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	blas32.Syrk(t, alpha, g, 0, s.mat)
+}
@@ -611,6 +611,15 @@ func (v *VecDense) MulVec(a Matrix, b Vector) {
 
 	// TODO(kortschak): Improve the non-fast paths.
 	switch aU := aU.(type) {
+	case *CSR:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *CSC:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *COO:
+		aU.MulVecTo(v, trans, b)
+		return
 	case Vector:
 		if b.Len() == 1 {
 			// {n,1} x {1,1}
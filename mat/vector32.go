@@ -0,0 +1,508 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+var (
+	vecDense32 *VecDense32
+
+	_ Matrix32      = vecDense32
+	_ allMatrix     = vecDense32
+	_ Vector32      = vecDense32
+	_ RawVectorer32 = vecDense32
+)
+
+// Vector32 is a single precision vector.
+type Vector32 interface {
+	Matrix32
+	AtVec(int) float32
+	Len() int
+}
+
+// A RawVectorer32 can return a blas32.Vector representation of the receiver.
+// Changes to the blas32.Vector.Data slice will be reflected in the original
+// matrix, changes to the Inc field will not.
+type RawVectorer32 interface {
+	RawVector32() blas32.Vector
+}
+
+// VecDense32 represents a column vector with float32 data.
+type VecDense32 struct {
+	mat blas32.Vector
+	// A BLAS vector can have a negative increment, but allowing this
+	// in the mat type complicates a lot of code, and doesn't gain anything.
+	// VecDense32 must have positive increment in this package.
+}
+
+// NewVecDense32 creates a new VecDense32 of length n. If data == nil,
+// a new slice is allocated for the backing slice. If len(data) == n, data is
+// used as the backing slice, and changes to the elements of the returned
+// VecDense32 will be reflected in data. If neither of these is true,
+// NewVecDense32 will panic.
+// NewVecDense32 will panic if n is zero.
+func NewVecDense32(n int, data []float32) *VecDense32 {
+	if n <= 0 {
+		if n == 0 {
+			panic(ErrZeroLength)
+		}
+		panic("mat: negative dimension")
+	}
+	if len(data) != n && data != nil {
+		panic(ErrShape)
+	}
+	if data == nil {
+		data = make([]float32, n)
+	}
+	return &VecDense32{
+		mat: blas32.Vector{
+			N:    n,
+			Inc:  1,
+			Data: data,
+		},
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix. Columns is always 1
+// for a non-Reset vector.
+func (v *VecDense32) Dims() (r, c int) {
+	if v.IsEmpty() {
+		return 0, 0
+	}
+	return v.mat.N, 1
+}
+
+// Len returns the length of the vector.
+func (v *VecDense32) Len() int {
+	return v.mat.N
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose32.
+func (v *VecDense32) T() Matrix32 {
+	return Transpose32{v}
+}
+
+// Reset empties the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+// See the Reseter interface for more information.
+func (v *VecDense32) Reset() {
+	// No change of Inc or N to 0 may be
+	// made unless both are set to 0.
+	v.mat.Inc = 0
+	v.mat.N = 0
+	v.mat.Data = v.mat.Data[:0]
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (v *VecDense32) IsEmpty() bool {
+	// It must be the case that v.Dims() returns
+	// zeros in this case. See comment in Reset().
+	return v.mat.Inc == 0
+}
+
+// Zero sets all of the matrix elements to zero.
+func (v *VecDense32) Zero() {
+	for i := 0; i < v.mat.N; i++ {
+		v.mat.Data[v.mat.Inc*i] = 0
+	}
+}
+
+// RawVector32 returns the underlying blas32.Vector used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in returned blas32.Vector.
+func (v *VecDense32) RawVector32() blas32.Vector {
+	return v.mat
+}
+
+// SetRawVector32 sets the underlying blas32.Vector used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in the input.
+//
+// The supplied Vector must not use a negative increment.
+func (v *VecDense32) SetRawVector32(a blas32.Vector) {
+	if a.Inc < 0 {
+		panic("mat: negative increment")
+	}
+	v.mat = a
+}
+
+// reuseAsNonZeroed resizes an empty vector to a r×1 vector,
+// or checks that a non-empty matrix is r×1.
+func (v *VecDense32) reuseAsNonZeroed(r int) {
+	if r == 0 {
+		panic(ErrZeroLength)
+	}
+	if v.IsEmpty() {
+		v.mat = blas32.Vector{
+			N:    r,
+			Inc:  1,
+			Data: use32(v.mat.Data, r),
+		}
+		return
+	}
+	if r != v.mat.N {
+		panic(ErrShape)
+	}
+}
+
+// asDense32 returns a Dense32 representation of the receiver with the same
+// underlying data.
+func (v *VecDense32) asDense32() *Dense32 {
+	return &Dense32{
+		mat:     v.asGeneral(),
+		capRows: v.mat.N,
+		capCols: 1,
+	}
+}
+
+// asGeneral returns a blas32.General representation of the receiver with the
+// same underlying data.
+func (v *VecDense32) asGeneral() blas32.General {
+	return blas32.General{
+		Rows:   v.mat.N,
+		Cols:   1,
+		Stride: v.mat.Inc,
+		Data:   v.mat.Data,
+	}
+}
+
+// CopyVec makes a copy of elements of a into the receiver. It is similar to
+// the built-in copy; it copies as much as the overlap between the two vectors
+// and returns the number of elements it copied.
+func (v *VecDense32) CopyVec(a Vector32) int {
+	n := min(v.Len(), a.Len())
+	if v == a {
+		return n
+	}
+	if r, ok := a.(RawVectorer32); ok {
+		src := r.RawVector32()
+		src.N = n
+		dst := v.mat
+		dst.N = n
+		blas32.Copy(src, dst)
+		return n
+	}
+	for i := 0; i < n; i++ {
+		v.setVec(i, a.AtVec(i))
+	}
+	return n
+}
+
+// ScaleVec scales the vector a by alpha, placing the result in the receiver.
+func (v *VecDense32) ScaleVec(alpha float32, a Vector32) {
+	n := a.Len()
+
+	if v == a {
+		if v.mat.Inc == 1 {
+			blas32.Implementation().Sscal(n, alpha, v.mat.Data, 1)
+			return
+		}
+		blas32.Scal(alpha, v.mat)
+		return
+	}
+
+	v.reuseAsNonZeroed(n)
+
+	if rv, ok := a.(RawVectorer32); ok {
+		mat := rv.RawVector32()
+		v.checkOverlap(mat)
+		blas32.Copy(mat, v.mat)
+		blas32.Scal(alpha, v.mat)
+		return
+	}
+
+	for i := 0; i < n; i++ {
+		v.setVec(i, alpha*a.AtVec(i))
+	}
+}
+
+// AddScaledVec adds the vectors a and alpha*b, placing the result in the receiver.
+func (v *VecDense32) AddScaledVec(a Vector32, alpha float32, b Vector32) {
+	if alpha == 1 {
+		v.AddVec(a, b)
+		return
+	}
+	if alpha == -1 {
+		v.SubVec(a, b)
+		return
+	}
+
+	ar := a.Len()
+	br := b.Len()
+
+	if ar != br {
+		panic(ErrShape)
+	}
+
+	var amat, bmat blas32.Vector
+	fast := true
+	aU, _ := untranspose32(a)
+	if rv, ok := aU.(RawVectorer32); ok {
+		amat = rv.RawVector32()
+		if v != a {
+			v.checkOverlap(amat)
+		}
+	} else {
+		fast = false
+	}
+	bU, _ := untranspose32(b)
+	if rv, ok := bU.(RawVectorer32); ok {
+		bmat = rv.RawVector32()
+		if v != b {
+			v.checkOverlap(bmat)
+		}
+	} else {
+		fast = false
+	}
+
+	v.reuseAsNonZeroed(ar)
+
+	switch {
+	case alpha == 0: // v <- a
+		if v == a {
+			return
+		}
+		v.CopyVec(a)
+	case v == a && v == b: // v <- v + alpha * v = (alpha + 1) * v
+		blas32.Scal(alpha+1, v.mat)
+	case !fast: // v <- a + alpha * b without blas32 support.
+		for i := 0; i < ar; i++ {
+			v.setVec(i, a.AtVec(i)+alpha*b.AtVec(i))
+		}
+	case v == a && v != b: // v <- v + alpha * b
+		blas32.Axpy(alpha, bmat, v.mat)
+	case v != a && v == b: // v <- a + alpha * v
+		blas32.Scal(alpha, v.mat)
+		blas32.Axpy(1, amat, v.mat)
+	default: // v <- a + alpha * b
+		blas32.Copy(amat, v.mat)
+		blas32.Axpy(alpha, bmat, v.mat)
+	}
+}
+
+// AddVec adds the vectors a and b, placing the result in the receiver.
+func (v *VecDense32) AddVec(a, b Vector32) {
+	ar := a.Len()
+	br := b.Len()
+
+	if ar != br {
+		panic(ErrShape)
+	}
+
+	v.reuseAsNonZeroed(ar)
+
+	aU, _ := untranspose32(a)
+	bU, _ := untranspose32(b)
+
+	if arv, ok := aU.(RawVectorer32); ok {
+		if brv, ok := bU.(RawVectorer32); ok {
+			amat := arv.RawVector32()
+			bmat := brv.RawVector32()
+
+			if v != a {
+				v.checkOverlap(amat)
+			}
+			if v != b {
+				v.checkOverlap(bmat)
+			}
+
+			if v == b {
+				blas32.Axpy(1, amat, v.mat)
+				return
+			}
+			if v != a {
+				blas32.Copy(amat, v.mat)
+			}
+			blas32.Axpy(1, bmat, v.mat)
+			return
+		}
+	}
+
+	for i := 0; i < ar; i++ {
+		v.setVec(i, a.AtVec(i)+b.AtVec(i))
+	}
+}
+
+// SubVec subtracts the vector b from a, placing the result in the receiver.
+func (v *VecDense32) SubVec(a, b Vector32) {
+	ar := a.Len()
+	br := b.Len()
+
+	if ar != br {
+		panic(ErrShape)
+	}
+
+	v.reuseAsNonZeroed(ar)
+
+	aU, _ := untranspose32(a)
+	bU, _ := untranspose32(b)
+
+	if arv, ok := aU.(RawVectorer32); ok {
+		if brv, ok := bU.(RawVectorer32); ok {
+			amat := arv.RawVector32()
+			bmat := brv.RawVector32()
+
+			if v != a {
+				v.checkOverlap(amat)
+			}
+			if v != b {
+				v.checkOverlap(bmat)
+			}
+
+			if v == b {
+				blas32.Scal(-1, v.mat)
+				blas32.Axpy(1, amat, v.mat)
+				return
+			}
+			if v != a {
+				blas32.Copy(amat, v.mat)
+			}
+			blas32.Axpy(-1, bmat, v.mat)
+			return
+		}
+	}
+
+	for i := 0; i < ar; i++ {
+		v.setVec(i, a.AtVec(i)-b.AtVec(i))
+	}
+}
+
+// MulVec computes a * b. The result is stored into the receiver.
+// MulVec panics if the number of columns in a does not equal the number of rows in b
+// or if the number of columns in b does not equal 1.
+func (v *VecDense32) MulVec(a Matrix32, b Vector32) {
+	r, c := a.Dims()
+	br, bc := b.Dims()
+	if c != br || bc != 1 {
+		panic(ErrShape)
+	}
+
+	aU, trans := untransposeExtract32(a)
+	var bmat blas32.Vector
+	fast := true
+	bU, _ := untransposeExtract32(b)
+	if rv, ok := bU.(*VecDense32); ok {
+		bmat = rv.mat
+		if v != b {
+			v.checkOverlap(bmat)
+		}
+	} else {
+		fast = false
+	}
+
+	v.reuseAsNonZeroed(r)
+	var restore func()
+	if v == aU {
+		v, restore = v.isolatedWorkspace(aU.(*VecDense32))
+		defer restore()
+	} else if v == b {
+		v, restore = v.isolatedWorkspace(b)
+		defer restore()
+	}
+
+	switch aU := aU.(type) {
+	case Vector32:
+		if b.Len() == 1 {
+			// {n,1} x {1,1}
+			v.ScaleVec(b.AtVec(0), aU)
+			return
+		}
+
+		// {1,n} x {n,1}
+		if fast {
+			if rv, ok := aU.(*VecDense32); ok {
+				if v != aU {
+					v.checkOverlap(rv.mat)
+				}
+				v.setVec(0, blas32.Dot(rv.mat, bmat))
+				return
+			}
+		}
+		var sum float32
+		for i := 0; i < c; i++ {
+			sum += aU.AtVec(i) * b.AtVec(i)
+		}
+		v.setVec(0, sum)
+		return
+	case *SymDense32:
+		if fast {
+			aU.checkOverlap(v.asGeneral())
+			blas32.Symv(1, aU.mat, bmat, 0, v.mat)
+			return
+		}
+	case *Dense32:
+		if fast {
+			aU.checkOverlap(v.asGeneral())
+			t := blas.NoTrans
+			if trans {
+				t = blas.Trans
+			}
+			blas32.Gemv(t, 1, aU.mat, bmat, 0, v.mat)
+			return
+		}
+	default:
+		if fast {
+			for i := 0; i < r; i++ {
+				var f float32
+				for j := 0; j < c; j++ {
+					f += a.At(i, j) * bmat.Data[j*bmat.Inc]
+				}
+				v.setVec(i, f)
+			}
+			return
+		}
+	}
+
+	for i := 0; i < r; i++ {
+		var f float32
+		for j := 0; j < c; j++ {
+			f += a.At(i, j) * b.AtVec(j)
+		}
+		v.setVec(i, f)
+	}
+}
+
+// isolatedWorkspace returns a new vector w with the size of a and returns a
+// callback to defer which performs cleanup at the return of the call. This
+// should be used when a method receiver is the same pointer as an input
+// argument.
+func (v *VecDense32) isolatedWorkspace(a Vector32) (w *VecDense32, restore func()) {
+	l := a.Len()
+	if l == 0 {
+		panic(ErrZeroLength)
+	}
+	w = NewVecDense32(l, nil)
+	return w, func() {
+		v.CopyVec(w)
+	}
+}
+
+// Dot32 returns the sum of the element-wise product of a and b.
+// Dot32 panics with ErrShape if the vector sizes are unequal.
+func Dot32(a, b Vector32) float32 {
+	la := a.Len()
+	lb := b.Len()
+	if la != lb {
+		panic(ErrShape)
+	}
+	if arv, ok := a.(RawVectorer32); ok {
+		if brv, ok := b.(RawVectorer32); ok {
+			return blas32.Dot(arv.RawVector32(), brv.RawVector32())
+		}
+	}
+	var sum float32
+	for i := 0; i < la; i++ {
+		sum += a.At(i, 0) * b.At(i, 0)
+	}
+	return sum
+}
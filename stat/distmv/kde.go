@@ -0,0 +1,428 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/kdtree"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// gaussianTail is twice the logarithm of the ratio of the largest to the
+// smallest Gaussian kernel contribution that is included when evaluating a
+// kernel density estimate. Contributions smaller than e^(-gaussianTail/2) of
+// the largest contribution are below the floating point precision.
+const gaussianTail = 75
+
+// KDE is a kernel density estimate of a multivariate distribution from a set
+// of weighted samples. The kernel density estimate has density function
+//  Σ_i w_i |H|^(-1/2) K(H^(-1/2) (x - x_i))
+// where x_i are the samples, w_i are the normalized weights of the samples, H
+// is the bandwidth matrix and K is a radially symmetric kernel with zero mean
+// and identity covariance. The bandwidth matrix is the covariance matrix of
+// the kernel centered on each sample.
+//
+// The supported kernels are distuv.GaussianKernel and the kernels
+//  (1 - |u|^2)^p
+// on the unit ball for p = 0, 1, 2, 3, which are distuv.RectangularKernel,
+// distuv.EpanechnikovKernel, distuv.BiweightKernel and distuv.TriweightKernel
+// respectively, scaled to have identity covariance.
+//
+// The samples are stored in a k-d tree in the coordinates in which the kernel
+// is spherical, so the density at x is evaluated using only the samples near
+// x. This makes evaluation fast for large numbers of samples when the
+// bandwidth is small relative to the spread of the samples.
+//
+// For more information, see https://en.wikipedia.org/wiki/Multivariate_kernel_density_estimation.
+type KDE struct {
+	dim       int
+	samples   mat.Dense
+	weights   []float64
+	cumWeight []float64 // cumWeight[i] is the sum of the weights of the first i samples.
+
+	kernel distuv.Kernel
+	// profile is the power p of the kernel (1 - |u|^2)^p,
+	// or -1 for the Gaussian kernel.
+	profile int
+	// scale is the radius of the support of a kernel
+	// with bounded support.
+	scale float64
+	// logNorm is the logarithm of the normalization
+	// constant of the kernel.
+	logNorm float64
+
+	bandwidth  mat.SymDense
+	lower      mat.TriDense // lower is the Cholesky factor of the bandwidth.
+	logSqrtDet float64
+	tree       *kdtree.Tree
+
+	src rand.Source
+}
+
+// NewKDE returns a kernel density estimate from the samples with relative
+// weights using the given kernel and bandwidth matrix. Each row of samples is
+// a sample. If weights is nil, then all the weights are 1. The weights must be
+// non-negative and at least one of the weights must be positive. The samples,
+// weights and bandwidth are copied. The bandwidth matrix can be chosen with
+// ScottBandwidth or SilvermanBandwidth.
+//
+// NewKDE panics if samples has no rows, if the number of columns of samples
+// does not equal the size of bandwidth, if weights is not nil and len(weights)
+// does not equal the number of rows of samples, or if the kernel is not
+// supported. If the bandwidth matrix is not positive definite, the returned
+// boolean is false.
+func NewKDE(samples mat.Matrix, weights []float64, kernel distuv.Kernel, bandwidth mat.Symmetric, src rand.Source) (*KDE, bool) {
+	n, dim := samples.Dims()
+	if n == 0 || dim == 0 {
+		panic(badZeroDimension)
+	}
+	if bandwidth.Symmetric() != dim {
+		panic(badSizeMismatch)
+	}
+	if weights != nil && len(weights) != n {
+		panic(badInputLength)
+	}
+	k := &KDE{
+		dim:       dim,
+		weights:   make([]float64, n),
+		cumWeight: make([]float64, n+1),
+		kernel:    kernel,
+		src:       src,
+	}
+	switch kernel {
+	case distuv.GaussianKernel:
+		k.profile = -1
+		k.logNorm = -0.5 * float64(dim) * logTwoPi
+	case distuv.RectangularKernel:
+		k.profile = 0
+	case distuv.EpanechnikovKernel:
+		k.profile = 1
+	case distuv.BiweightKernel:
+		k.profile = 2
+	case distuv.TriweightKernel:
+		k.profile = 3
+	default:
+		panic("kde: unsupported kernel")
+	}
+	if k.profile >= 0 {
+		// The kernel c (1 - |u|^2)^p on the unit ball has covariance
+		// I/(d + 2p + 2), and is normalized by
+		//  c = Γ(d/2 + p + 1) / (π^(d/2) Γ(p + 1)).
+		d := float64(dim)
+		p := float64(k.profile)
+		k.scale = math.Sqrt(d + 2*p + 2)
+		a, _ := math.Lgamma(d/2 + p + 1)
+		b, _ := math.Lgamma(p + 1)
+		k.logNorm = a - b - d/2*math.Log(math.Pi) - d*math.Log(k.scale)
+	}
+
+	var chol mat.Cholesky
+	if !chol.Factorize(bandwidth) {
+		return nil, false
+	}
+	k.bandwidth = *mat.NewSymDense(dim, nil)
+	k.bandwidth.CopySym(bandwidth)
+	chol.LTo(&k.lower)
+	k.logSqrtDet = 0.5 * chol.LogDet()
+
+	var sum float64
+	for i := range k.weights {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		if w < 0 {
+			panic("kde: negative weight")
+		}
+		k.weights[i] = w
+		sum += w
+	}
+	if !(sum > 0) {
+		panic("kde: no positive weight")
+	}
+	k.samples.CloneFrom(samples)
+	points := make(kdePoints, 0, n)
+	for i, w := range k.weights {
+		k.weights[i] = w / sum
+		k.cumWeight[i+1] = k.cumWeight[i] + k.weights[i]
+		if w == 0 {
+			// Samples with zero weight do not contribute
+			// to the density.
+			continue
+		}
+		points = append(points, kdePoint{x: k.whiten(k.samples.RawRowView(i)), weight: k.weights[i]})
+	}
+	k.tree = kdtree.New(points, false)
+	return k, true
+}
+
+// Bandwidth returns the bandwidth matrix of the kernel density estimate. If
+// the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise dst must match the dimension of the receiver or Bandwidth will
+// panic.
+func (k *KDE) Bandwidth(dst *mat.SymDense) {
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(k.dim).(*mat.SymDense))
+	} else if dst.Symmetric() != k.dim {
+		panic("kde: input matrix size mismatch")
+	}
+	dst.CopySym(&k.bandwidth)
+}
+
+// CovarianceMatrix calculates the covariance matrix of the distribution,
+// storing the result in dst. The covariance matrix is the weighted covariance
+// of the samples plus the bandwidth matrix. If the dst matrix is empty it will
+// be resized to the correct dimensions, otherwise dst must match the dimension
+// of the receiver or CovarianceMatrix will panic.
+func (k *KDE) CovarianceMatrix(dst *mat.SymDense) {
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(k.dim).(*mat.SymDense))
+	} else if dst.Symmetric() != k.dim {
+		panic("kde: input matrix size mismatch")
+	}
+	mean := k.Mean(nil)
+	d := make([]float64, k.dim)
+	dst.CopySym(&k.bandwidth)
+	for i, w := range k.weights {
+		if w == 0 {
+			continue
+		}
+		floats.SubTo(d, k.samples.RawRowView(i), mean)
+		dst.SymRankOne(dst, w, mat.NewVecDense(k.dim, d))
+	}
+}
+
+// Dim returns the dimension of the distribution.
+func (k *KDE) Dim() int {
+	return k.dim
+}
+
+// LogProb computes the log of the pdf of the point x.
+func (k *KDE) LogProb(x []float64) float64 {
+	if len(x) != k.dim {
+		panic(badSizeMismatch)
+	}
+	q := kdePoint{x: k.whiten(x)}
+	logNorm := k.logNorm - k.logSqrtDet
+	if k.profile < 0 {
+		// Include the samples whose contributions are not negligible
+		// relative to the contribution of the sample nearest to x.
+		nearest := kdtree.NewNKeeper(1)
+		k.tree.NearestSet(nearest, q)
+		keep := kdtree.NewDistKeeper(nearest.Heap[0].Dist + gaussianTail)
+		k.tree.NearestSet(keep, q)
+		max := math.Inf(-1)
+		for _, c := range keep.Heap {
+			max = math.Max(max, math.Log(c.Comparable.(kdePoint).weight)-c.Dist/2)
+		}
+		var sum float64
+		for _, c := range keep.Heap {
+			sum += math.Exp(math.Log(c.Comparable.(kdePoint).weight) - c.Dist/2 - max)
+		}
+		return logNorm + max + math.Log(sum)
+	}
+
+	a2 := k.scale * k.scale
+	keep := kdtree.NewDistKeeper(a2)
+	k.tree.NearestSet(keep, q)
+	var sum float64
+	for _, c := range keep.Heap {
+		v := math.Max(0, 1-c.Dist/a2)
+		w := c.Comparable.(kdePoint).weight
+		for i := 0; i < k.profile; i++ {
+			w *= v
+		}
+		sum += w
+	}
+	return logNorm + math.Log(sum)
+}
+
+// Mean returns the mean of the probability distribution at x. If the
+// input argument is nil, a new slice will be allocated, otherwise the result
+// will be put in-place into the receiver.
+func (k *KDE) Mean(x []float64) []float64 {
+	x = reuseAs(x, k.dim)
+	for j := range x {
+		x[j] = 0
+	}
+	for i, w := range k.weights {
+		floats.AddScaled(x, w, k.samples.RawRowView(i))
+	}
+	return x
+}
+
+// Prob computes the value of the probability density function at x.
+func (k *KDE) Prob(x []float64) float64 {
+	return math.Exp(k.LogProb(x))
+}
+
+// Rand generates a random number according to the distributon.
+// If the input slice is nil, new memory is allocated, otherwise the result is stored
+// in place.
+func (k *KDE) Rand(x []float64) []float64 {
+	x = reuseAs(x, k.dim)
+	rnd := rand.Float64
+	norm := rand.NormFloat64
+	if k.src != nil {
+		r := rand.New(k.src)
+		rnd = r.Float64
+		norm = r.NormFloat64
+	}
+	n := len(k.weights)
+	u := rnd()
+	i := sort.Search(n, func(i int) bool { return k.cumWeight[i+1] > u })
+	if i == n {
+		// Guard against rounding in the cumulative weights.
+		i = n - 1
+		for k.weights[i] == 0 {
+			i--
+		}
+	}
+
+	z := make([]float64, k.dim)
+	for j := range z {
+		z[j] = norm()
+	}
+	if k.profile >= 0 {
+		// The squared radius of a sample from the kernel on the unit
+		// ball is Beta(d/2, p+1) distributed, and its direction is
+		// uniform.
+		b := distuv.Beta{Alpha: float64(k.dim) / 2, Beta: float64(k.profile) + 1, Src: k.src}
+		floats.Scale(k.scale*math.Sqrt(b.Rand())/floats.Norm(z, 2), z)
+	}
+	xVec := mat.NewVecDense(k.dim, x)
+	xVec.MulVec(&k.lower, mat.NewVecDense(k.dim, z))
+	floats.Add(x, k.samples.RawRowView(i))
+	return x
+}
+
+// whiten returns L^-1 x where L is the Cholesky factor of the bandwidth
+// matrix.
+func (k *KDE) whiten(x []float64) []float64 {
+	z := make([]float64, k.dim)
+	copy(z, x)
+	blas64.Trsv(blas.NoTrans, k.lower.RawTriangular(), blas64.Vector{N: k.dim, Data: z, Inc: 1})
+	return z
+}
+
+// ScottBandwidth returns the bandwidth matrix of a kernel density estimate of
+// the samples with relative weights using Scott's rule
+//  n^(-2/(d+4)) Σ
+// where Σ is the sample covariance matrix, d is the dimension and n is the
+// effective number of samples
+//  (Σ_i w_i)^2 / Σ_i w_i^2
+// Each row of samples is a sample. If weights is nil, then all the weights
+// are 1 and n is the number of samples. If weights is not nil, then
+// len(weights) must equal the number of rows of samples.
+//
+// See Scott, D. W. Multivariate Density Estimation: Theory, Practice, and
+// Visualization, Wiley, 1992.
+func ScottBandwidth(samples mat.Matrix, weights []float64) *mat.SymDense {
+	cov, n := kdeCovariance(samples, weights)
+	_, d := samples.Dims()
+	cov.ScaleSym(math.Pow(n, -2/float64(d+4)), cov)
+	return cov
+}
+
+// SilvermanBandwidth returns the bandwidth matrix of a kernel density estimate
+// of the samples with relative weights using Silverman's rule
+//  (4 / ((d+2) n))^(2/(d+4)) Σ
+// where Σ is the sample covariance matrix, d is the dimension and n is the
+// effective number of samples as for ScottBandwidth. Each row of samples is a
+// sample. If weights is nil, then all the weights are 1. If weights is not
+// nil, then len(weights) must equal the number of rows of samples.
+//
+// Silverman's rule minimizes the asymptotic mean integrated squared error of
+// a Gaussian kernel density estimate of normal data.
+//
+// See Silverman, B. W. Density Estimation for Statistics and Data Analysis,
+// Chapman and Hall, 1986.
+func SilvermanBandwidth(samples mat.Matrix, weights []float64) *mat.SymDense {
+	cov, n := kdeCovariance(samples, weights)
+	_, d := samples.Dims()
+	cov.ScaleSym(math.Pow(4/(float64(d+2)*n), 2/float64(d+4)), cov)
+	return cov
+}
+
+// kdeCovariance returns the sample covariance matrix of the samples with
+// relative weights and the effective number of samples.
+func kdeCovariance(samples mat.Matrix, weights []float64) (*mat.SymDense, float64) {
+	r, _ := samples.Dims()
+	if r == 0 {
+		panic(badZeroDimension)
+	}
+	var cov mat.SymDense
+	if weights == nil {
+		stat.CovarianceMatrix(&cov, samples, nil)
+		return &cov, float64(r)
+	}
+	if len(weights) != r {
+		panic(badInputLength)
+	}
+	sum := floats.Sum(weights)
+	n := sum * sum / floats.Dot(weights, weights)
+	// The covariance with weights scaled to sum to the effective number
+	// of samples is unbiased for relative weights.
+	scaled := make([]float64, r)
+	floats.ScaleTo(scaled, n/sum, weights)
+	stat.CovarianceMatrix(&cov, samples, scaled)
+	return &cov, n
+}
+
+// kdePoint is a whitened sample and its weight stored in the k-d tree of a
+// KDE.
+type kdePoint struct {
+	x      []float64
+	weight float64
+}
+
+func (p kdePoint) Compare(c kdtree.Comparable, d kdtree.Dim) float64 {
+	return p.x[d] - c.(kdePoint).x[d]
+}
+func (p kdePoint) Dims() int { return len(p.x) }
+func (p kdePoint) Distance(c kdtree.Comparable) float64 {
+	q := c.(kdePoint)
+	var sum float64
+	for i, v := range p.x {
+		d := v - q.x[i]
+		sum += d * d
+	}
+	return sum
+}
+
+// kdePoints is a collection of kdePoint values that satisfies kdtree.Interface.
+type kdePoints []kdePoint
+
+func (p kdePoints) Index(i int) kdtree.Comparable         { return p[i] }
+func (p kdePoints) Len() int                              { return len(p) }
+func (p kdePoints) Pivot(d kdtree.Dim) int                { return kdePlane{kdePoints: p, Dim: d}.Pivot() }
+func (p kdePoints) Slice(start, end int) kdtree.Interface { return p[start:end] }
+
+// kdePlane allows kdePoints to be pivoted on a dimension.
+type kdePlane struct {
+	kdtree.Dim
+	kdePoints
+}
+
+func (p kdePlane) Less(i, j int) bool {
+	return p.kdePoints[i].x[p.Dim] < p.kdePoints[j].x[p.Dim]
+}
+func (p kdePlane) Pivot() int { return kdtree.Partition(p, kdtree.MedianOfRandoms(p, 100)) }
+func (p kdePlane) Slice(start, end int) kdtree.SortSlicer {
+	p.kdePoints = p.kdePoints[start:end]
+	return p
+}
+func (p kdePlane) Swap(i, j int) {
+	p.kdePoints[i], p.kdePoints[j] = p.kdePoints[j], p.kdePoints[i]
+}
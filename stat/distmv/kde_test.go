@@ -0,0 +1,301 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+var kdeKernels = []distuv.Kernel{
+	distuv.GaussianKernel,
+	distuv.RectangularKernel,
+	distuv.EpanechnikovKernel,
+	distuv.BiweightKernel,
+	distuv.TriweightKernel,
+}
+
+var _ RandLogProber = (*KDE)(nil)
+
+func TestKDEUnivariate(t *testing.T) {
+	// A one-dimensional KDE matches the univariate KDE.
+	src := rand.New(rand.NewSource(1))
+	samples := make([]float64, 200)
+	weights := make([]float64, len(samples))
+	for i := range samples {
+		samples[i] = src.NormFloat64()
+		weights[i] = src.Float64()
+	}
+	const h = 0.3
+	bandwidth := mat.NewSymDense(1, []float64{h * h})
+	for _, kernel := range kdeKernels {
+		want := distuv.NewKDE(samples, weights, kernel, h, nil)
+		got, ok := NewKDE(mat.NewDense(len(samples), 1, samples), weights, kernel, bandwidth, nil)
+		if !ok {
+			t.Fatalf("unexpected failure for kernel %d", kernel)
+		}
+		for x := -5.0; x <= 5; x += 0.1 {
+			if lp, lpWant := got.LogProb([]float64{x}), want.LogProb(x); !scalar.EqualWithinAbsOrRel(lp, lpWant, 1e-12, 1e-12) {
+				t.Errorf("LogProb mismatch for kernel %d at %v: got %v, want %v", kernel, x, lp, lpWant)
+			}
+		}
+	}
+}
+
+func TestKDEProb(t *testing.T) {
+	// The tree search matches the density summed over all the samples.
+	src := rand.NewSource(1)
+	const n = 2000
+	normal, _ := NewNormal([]float64{1, -1}, mat.NewSymDense(2, []float64{4, 1.5, 1.5, 2}), src)
+	samples := mat.NewDense(n, 2, nil)
+	generateSamples(samples, normal)
+	bandwidth := mat.NewSymDense(2, []float64{0.1, 0.02, 0.02, 0.05})
+	var chol mat.Cholesky
+	chol.Factorize(bandwidth)
+	rnd := rand.New(src)
+	queries := make([][]float64, 50)
+	for i := range queries[:len(queries)-2] {
+		queries[i] = normal.Rand(nil)
+	}
+	// Far from the samples the density underflows, but the log density
+	// is accurate.
+	queries[len(queries)-2] = []float64{40, -30}
+	queries[len(queries)-1] = []float64{-25, 0}
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = rnd.Float64()
+	}
+	sum := floats.Sum(weights)
+
+	for _, kernel := range kdeKernels {
+		k, ok := NewKDE(samples, weights, kernel, bandwidth, nil)
+		if !ok {
+			t.Fatalf("unexpected failure for kernel %d", kernel)
+		}
+		for _, x := range queries {
+			logProbs := make([]float64, n)
+			for i := range logProbs {
+				logProbs[i] = math.Log(weights[i]/sum) + kernelLogProb(kernel, x, samples.RawRowView(i), &chol)
+			}
+			want := floats.LogSumExp(logProbs)
+			got := k.LogProb(x)
+			if math.IsInf(want, -1) {
+				if !math.IsInf(got, -1) {
+					t.Errorf("LogProb mismatch for kernel %d at %v: got %v, want %v", kernel, x, got, want)
+				}
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+				t.Errorf("LogProb mismatch for kernel %d at %v: got %v, want %v", kernel, x, got, want)
+			}
+		}
+	}
+}
+
+// kernelLogProb returns the log density at x of the two-dimensional kernel
+// centered at mu with the bandwidth matrix with Cholesky decomposition chol.
+func kernelLogProb(kernel distuv.Kernel, x, mu []float64, chol *mat.Cholesky) float64 {
+	if kernel == distuv.GaussianKernel {
+		return NormalLogProb(x, mu, chol)
+	}
+	// In two dimensions the kernel (p+1)/π (1 - |u|^2)^p on the unit ball
+	// has covariance I/(2p+4).
+	p := map[distuv.Kernel]float64{
+		distuv.RectangularKernel:  0,
+		distuv.EpanechnikovKernel: 1,
+		distuv.BiweightKernel:     2,
+		distuv.TriweightKernel:    3,
+	}[kernel]
+	a2 := 2*p + 4
+	r := stat.Mahalanobis(mat.NewVecDense(2, x), mat.NewVecDense(2, mu), chol)
+	if r*r >= a2 {
+		return math.Inf(-1)
+	}
+	return math.Log((p+1)/(math.Pi*a2)) + p*math.Log(1-r*r/a2) - 0.5*chol.LogDet()
+}
+
+func TestKDENormalization(t *testing.T) {
+	// The kernels integrate to 1 in two dimensions.
+	bandwidth := mat.NewSymDense(2, []float64{1, 0.5, 0.5, 2})
+	const (
+		n     = 400
+		bound = 12.0
+		step  = 2 * bound / n
+	)
+	for _, kernel := range kdeKernels {
+		k, ok := NewKDE(mat.NewDense(1, 2, []float64{1, 2}), nil, kernel, bandwidth, nil)
+		if !ok {
+			t.Fatalf("unexpected failure for kernel %d", kernel)
+		}
+		var sum float64
+		x := make([]float64, 2)
+		for i := 0; i < n; i++ {
+			x[0] = 1 - bound + (float64(i)+0.5)*step
+			for j := 0; j < n; j++ {
+				x[1] = 2 - bound + (float64(j)+0.5)*step
+				sum += k.Prob(x)
+			}
+		}
+		sum *= step * step
+		if !scalar.EqualWithinAbs(sum, 1, 2e-3) {
+			t.Errorf("kernel %d does not integrate to 1: got %v", kernel, sum)
+		}
+	}
+}
+
+func TestKDERand(t *testing.T) {
+	src := rand.NewSource(1)
+	samples := mat.NewDense(4, 3, []float64{
+		0, 0, 0,
+		1, 2, 3,
+		-1, 4, 0,
+		2, -2, 1,
+	})
+	weights := []float64{1, 2, 0, 3}
+	bandwidth := mat.NewSymDense(3, []float64{
+		0.5, 0.1, 0,
+		0.1, 0.3, -0.1,
+		0, -0.1, 0.4,
+	})
+	for _, kernel := range kdeKernels {
+		k, ok := NewKDE(samples, weights, kernel, bandwidth, src)
+		if !ok {
+			t.Fatalf("unexpected failure for kernel %d", kernel)
+		}
+		const nSamples = 500000
+		x := mat.NewDense(nSamples, 3, nil)
+		generateSamples(x, k)
+		estMean := make([]float64, 3)
+		for i := range estMean {
+			estMean[i] = stat.Mean(mat.Col(nil, i, x), nil)
+		}
+		if mean := k.Mean(nil); !floats.EqualApprox(estMean, mean, 1e-2) {
+			t.Errorf("Mean mismatch for kernel %d: want %v, got %v", kernel, mean, estMean)
+		}
+		var cov, estCov mat.SymDense
+		k.CovarianceMatrix(&cov)
+		stat.CovarianceMatrix(&estCov, x, nil)
+		if !mat.EqualApprox(&estCov, &cov, 2e-2) {
+			t.Errorf("Covariance mismatch for kernel %d: want %v, got %v", kernel, mat.Formatted(&cov), mat.Formatted(&estCov))
+		}
+		// All samples are in the support of the density.
+		for i := 0; i < nSamples; i += 100 {
+			if lp := k.LogProb(x.RawRowView(i)); math.IsInf(lp, -1) {
+				t.Errorf("Sample outside support for kernel %d: %v", kernel, x.RawRowView(i))
+				break
+			}
+		}
+	}
+}
+
+func TestKDEWeights(t *testing.T) {
+	// Integer weights are equivalent to repeated samples.
+	src := rand.New(rand.NewSource(1))
+	const n = 100
+	samples := mat.NewDense(n, 2, nil)
+	weights := make([]float64, n)
+	var repeated []float64
+	for i := 0; i < n; i++ {
+		row := []float64{src.NormFloat64(), src.NormFloat64()}
+		samples.SetRow(i, row)
+		weights[i] = float64(i % 3)
+		for j := 0; j < i%3; j++ {
+			repeated = append(repeated, row...)
+		}
+	}
+	rep := mat.NewDense(len(repeated)/2, 2, repeated)
+	bandwidth := mat.NewSymDense(2, []float64{0.2, 0, 0, 0.3})
+	for _, kernel := range kdeKernels {
+		weighted, _ := NewKDE(samples, weights, kernel, bandwidth, nil)
+		unweighted, _ := NewKDE(rep, nil, kernel, bandwidth, nil)
+		for i := 0; i < 20; i++ {
+			x := []float64{2 * src.NormFloat64(), 2 * src.NormFloat64()}
+			if got, want := weighted.LogProb(x), unweighted.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("LogProb mismatch for kernel %d at %v: got %v, want %v", kernel, x, got, want)
+			}
+		}
+	}
+}
+
+func TestKDEBandwidth(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	const n = 1000
+	samples := mat.NewDense(n, 3, nil)
+	for i := 0; i < n; i++ {
+		samples.SetRow(i, []float64{src.NormFloat64(), 2 * src.NormFloat64(), src.Float64()})
+	}
+	var cov mat.SymDense
+	stat.CovarianceMatrix(&cov, samples, nil)
+
+	var want mat.SymDense
+	want.ScaleSym(math.Pow(n, -2.0/7), &cov)
+	if got := ScottBandwidth(samples, nil); !mat.EqualApprox(got, &want, 1e-14) {
+		t.Errorf("unexpected Scott bandwidth: got %v, want %v", mat.Formatted(got), mat.Formatted(&want))
+	}
+	want.ScaleSym(math.Pow(4.0/(5*n), 2.0/7), &cov)
+	if got := SilvermanBandwidth(samples, nil); !mat.EqualApprox(got, &want, 1e-14) {
+		t.Errorf("unexpected Silverman bandwidth: got %v, want %v", mat.Formatted(got), mat.Formatted(&want))
+	}
+
+	// Constant weights have no effect.
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 0.25
+	}
+	if got := SilvermanBandwidth(samples, weights); !mat.EqualApprox(got, &want, 1e-12) {
+		t.Errorf("unexpected Silverman bandwidth with constant weights: got %v, want %v", mat.Formatted(got), mat.Formatted(&want))
+	}
+
+	// In one dimension Silverman's rule is the normal reference
+	// bandwidth (4/(3n))^(1/5) σ.
+	one := samples.Slice(0, n, 0, 1)
+	sigma := stat.StdDev(mat.Col(nil, 0, one), nil)
+	h := math.Pow(4.0/(3*n), 0.2) * sigma
+	if got := SilvermanBandwidth(one, nil).At(0, 0); !scalar.EqualWithinRel(got, h*h, 1e-14) {
+		t.Errorf("unexpected univariate Silverman bandwidth: got %v, want %v", got, h*h)
+	}
+}
+
+func TestKDEPanics(t *testing.T) {
+	samples := mat.NewDense(2, 2, []float64{1, 2, 3, 4})
+	id := mat.NewSymDense(2, []float64{1, 0, 0, 1})
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"size mismatch", func() { NewKDE(samples, nil, distuv.GaussianKernel, mat.NewSymDense(1, []float64{1}), nil) }},
+		{"weight length", func() { NewKDE(samples, []float64{1}, distuv.GaussianKernel, id, nil) }},
+		{"negative weight", func() { NewKDE(samples, []float64{1, -1}, distuv.GaussianKernel, id, nil) }},
+		{"zero weights", func() { NewKDE(samples, []float64{0, 0}, distuv.GaussianKernel, id, nil) }},
+		{"unsupported kernel", func() { NewKDE(samples, nil, distuv.TriangularKernel, id, nil) }},
+		{"input length", func() {
+			k, _ := NewKDE(samples, nil, distuv.GaussianKernel, id, nil)
+			k.LogProb([]float64{1})
+		}},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+	if _, ok := NewKDE(samples, nil, distuv.GaussianKernel, mat.NewSymDense(2, []float64{1, 2, 2, 1}), nil); ok {
+		t.Errorf("expected failure for bandwidth that is not positive definite")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -12,6 +12,9 @@ import (
 
 // AlphaStable represents an α-stable distribution with four parameters.
 // See https://en.wikipedia.org/wiki/Stable_distribution for more information.
+//
+// AlphaStable does not have a Fit method because its density, and so its
+// likelihood, is not available in closed form.
 type AlphaStable struct {
 	// Alpha is the stability parameter.
 	// It is valid within the range 0 < α ≤ 2.
@@ -8,6 +8,8 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
 )
 
 // Bernoulli represents a random variable whose value is 1 with probability p and
@@ -44,6 +46,17 @@ func (b Bernoulli) ExKurtosis() float64 {
 	return (1 - 6*pq) / pq
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The samples must be 0 or 1.
+func (b *Bernoulli) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	b.P = stat.Mean(samples, weights)
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (b Bernoulli) LogProb(x float64) float64 {
 	if x == 0 {
@@ -139,3 +152,27 @@ func (b Bernoulli) Survival(x float64) float64 {
 func (b Bernoulli) Variance() float64 {
 	return b.P * (1 - b.P)
 }
+
+// parameters returns the parameters of the distribution.
+func (b Bernoulli) parameters(p []Parameter) []Parameter {
+	nParam := b.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("bernoulli: improper parameter length")
+	}
+	p[0].Name = "P"
+	p[0].Value = b.P
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (b *Bernoulli) setParameters(p []Parameter) {
+	if len(p) != b.NumParameters() {
+		panic("bernoulli: incorrect number of parameters to set")
+	}
+	if p[0].Name != "P" {
+		panic("bernoulli: " + panicNameMismatch)
+	}
+	b.P = p[0].Value
+}
@@ -163,3 +163,8 @@ func testBernoulliQuantile(t *testing.T, dist Bernoulli) {
 		}
 	}
 }
+
+func TestBernoulliFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Bernoulli", &Bernoulli{P: 0.3, Src: rand.NewSource(1)}, func() fitTester { return &Bernoulli{} }, 10000, 0.05, true)
+}
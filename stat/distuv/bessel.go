@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import "math"
+
+// besselIe returns the exponentially scaled modified Bessel function of the
+// first kind of order nu, exp(-x) I_nu(x), for nu equal to 0 or 1 and x ≥ 0.
+func besselIe(nu int, x float64) float64 {
+	if x < 0 {
+		panic("distuv: negative Bessel argument")
+	}
+	if x > 25 {
+		// Asymptotic expansion for large arguments.
+		//  I_nu(x) e^-x ≈ 1/sqrt(2πx) Σ_k (-1)^k a_k(nu) / x^k
+		//  a_k(nu) = Π_{j=1..k} (4nu^2 - (2j-1)^2) / (k! 8^k)
+		mu := 4 * float64(nu*nu)
+		sum := 1.0
+		term := 1.0
+		for k := 1; k < 60; k++ {
+			odd := float64(2*k - 1)
+			term *= -(mu - odd*odd) / (float64(k) * 8 * x)
+			sum += term
+			if math.Abs(term) < 1e-17*math.Abs(sum) {
+				break
+			}
+		}
+		return sum / math.Sqrt(2*math.Pi*x)
+	}
+	// Power series for small arguments. All terms are positive.
+	//  I_nu(x) = Σ_k (x/2)^(2k+nu) / (k! (k+nu)!)
+	q := x * x / 4
+	term := 1.0
+	if nu == 1 {
+		term = x / 2
+	}
+	sum := term
+	for k := 1; k < 200; k++ {
+		term *= q / (float64(k) * float64(k+nu))
+		sum += term
+		if term < 1e-17*sum {
+			break
+		}
+	}
+	return sum * math.Exp(-x)
+}
+
+// besselRatio returns the ratio I_1(x)/I_0(x) of modified Bessel functions
+// of the first kind for x ≥ 0.
+func besselRatio(x float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return besselIe(1, x) / besselIe(0, x)
+}
+
+// besselRatios stores the ratios I_j(x)/I_0(x) for j = 1, ..., len(dst) into
+// dst. The ratios are computed by backward recurrence, which is stable.
+func besselRatios(dst []float64, x float64) {
+	n := len(dst)
+	if n == 0 {
+		return
+	}
+	if x == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return
+	}
+	// r_j = I_j/I_{j-1} satisfies r_j = 1 / (2j/x + r_{j+1}), which converges
+	// from any starting value well beyond max(j, x).
+	start := n + int(x) + 50
+	var r float64
+	for j := start; j > n; j-- {
+		r = 1 / (2*float64(j)/x + r)
+	}
+	for j := n; j >= 1; j-- {
+		r = 1 / (2*float64(j)/x + r)
+		dst[j-1] = r
+	}
+	for j := 1; j < n; j++ {
+		dst[j] *= dst[j-1]
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
 )
 
 // Beta implements the Beta distribution, a two-parameter continuous distribution
@@ -57,6 +58,57 @@ func (b Beta) ExKurtosis() float64 {
 	return num / den
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (b *Beta) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	logs := make([]float64, len(samples))
+	log1m := make([]float64, len(samples))
+	for i, x := range samples {
+		logs[i] = math.Log(x)
+		log1m[i] = math.Log1p(-x)
+	}
+	meanLog := stat.Mean(logs, weights)
+	meanLog1m := stat.Mean(log1m, weights)
+
+	// Initialize with the method of moments estimates.
+	alpha, beta := 1.0, 1.0
+	mean, variance := stat.MeanVariance(samples, weights)
+	if c := mean*(1-mean)/variance - 1; c > 0 {
+		alpha = mean * c
+		beta = (1 - mean) * c
+	}
+
+	// The log-likelihood is concave in alpha and beta, so Newton's method
+	// on its gradient converges to the maximum.
+	for i := 0; i < 100; i++ {
+		psiSum := mathext.Digamma(alpha + beta)
+		triSum := trigamma(alpha + beta)
+		g1 := mathext.Digamma(alpha) - psiSum - meanLog
+		g2 := mathext.Digamma(beta) - psiSum - meanLog1m
+		h11 := trigamma(alpha) - triSum
+		h22 := trigamma(beta) - triSum
+		h12 := -triSum
+		det := h11*h22 - h12*h12
+		da := (h22*g1 - h12*g2) / det
+		db := (h11*g2 - h12*g1) / det
+		// Keep the parameters positive.
+		step := 1.0
+		for alpha-step*da <= 0 || beta-step*db <= 0 {
+			step /= 2
+		}
+		alpha -= step * da
+		beta -= step * db
+		if math.Abs(step*da) <= 1e-12*alpha && math.Abs(step*db) <= 1e-12*beta {
+			break
+		}
+	}
+	b.Alpha = alpha
+	b.Beta = beta
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (b Beta) LogProb(x float64) float64 {
@@ -149,3 +201,33 @@ func (b Beta) Survival(x float64) float64 {
 func (b Beta) Variance() float64 {
 	return b.Alpha * b.Beta / ((b.Alpha + b.Beta) * (b.Alpha + b.Beta) * (b.Alpha + b.Beta + 1))
 }
+
+// parameters returns the parameters of the distribution.
+func (b Beta) parameters(p []Parameter) []Parameter {
+	nParam := b.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("beta: improper parameter length")
+	}
+	p[0].Name = "Alpha"
+	p[0].Value = b.Alpha
+	p[1].Name = "Beta"
+	p[1].Value = b.Beta
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (b *Beta) setParameters(p []Parameter) {
+	if len(p) != b.NumParameters() {
+		panic("beta: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Alpha" {
+		panic("beta: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("beta: " + panicNameMismatch)
+	}
+	b.Alpha = p[0].Value
+	b.Beta = p[1].Value
+}
@@ -172,3 +172,8 @@ func TestBetaIssue1377(t *testing.T) {
 		t.Errorf("NaN PDF at x == 1 for Alpha > 1 and Beta == 1")
 	}
 }
+
+func TestBetaFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Beta", &Beta{Alpha: 2, Beta: 5, Src: rand.NewSource(1)}, func() fitTester { return &Beta{} }, 10000, 0.05, true)
+}
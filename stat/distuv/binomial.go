@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
 	"gonum.org/v1/gonum/stat/combin"
 )
 
@@ -46,6 +47,19 @@ func (b Binomial) ExKurtosis() float64 {
 	return (1 - 6*v) / (b.N * v)
 }
 
+// Fit sets the probability of success P to its maximum likelihood estimate
+// from the data samples with relative weights. The number of trials N is not
+// estimated and must be positive, otherwise Fit will panic.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (b *Binomial) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	if b.N <= 0 {
+		panic("distuv: number of trials must be positive")
+	}
+	b.P = stat.Mean(samples, weights) / b.N
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (b Binomial) LogProb(x float64) float64 {
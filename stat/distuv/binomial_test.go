@@ -5,6 +5,7 @@
 package distuv
 
 import (
+	"math"
 	"sort"
 	"testing"
 
@@ -151,3 +152,27 @@ func testBinomial(t *testing.T, b Binomial, i int) {
 		t.Errorf("Wrong number of parameters")
 	}
 }
+
+func TestBinomialFit(t *testing.T) {
+	t.Parallel()
+	want := Binomial{N: 10, P: 0.3, Src: rand.NewSource(1)}
+	samples := randn(want, 10000)
+	b := &Binomial{N: 10}
+	b.Fit(samples, nil)
+	if b.N != 10 {
+		t.Errorf("Fit modified N: got:%v want:10", b.N)
+	}
+	if math.Abs(b.P-want.P) > 0.01 {
+		t.Errorf("unexpected P estimate: got:%v want:%v", b.P, want.P)
+	}
+
+	weighted := &Binomial{N: 10}
+	weighted.Fit([]float64{1, 4, 2}, []float64{1, 2, 0.5})
+	if got, want := weighted.P, (1+8+1)/(3.5*10); math.Abs(got-want) > 1e-14 {
+		t.Errorf("unexpected weighted P estimate: got:%v want:%v", got, want)
+	}
+
+	if !panics(func() { (&Binomial{}).Fit([]float64{0, 1}, nil) }) {
+		t.Errorf("expected panic for zero number of trials")
+	}
+}
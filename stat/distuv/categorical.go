@@ -74,6 +74,31 @@ func (c Categorical) Entropy() float64 {
 	return -ent
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimated probability of each category is the weighted proportion of
+// samples in that category. The number of categories is not changed, and the
+// samples must be integers in [0, c.Len()).
+func (c *Categorical) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	counts := make([]float64, c.Len())
+	for i, x := range samples {
+		k := int(x)
+		if float64(k) != x || k < 0 || k >= len(counts) {
+			panic("categorical: bad sample")
+		}
+		if weights == nil {
+			counts[k]++
+		} else {
+			counts[k] += weights[i]
+		}
+	}
+	c.ReweightAll(counts)
+}
+
 // Len returns the number of values x could possibly take (the length of the
 // initial supplied weight vector).
 func (c Categorical) Len() int {
@@ -273,3 +273,20 @@ func benchmarkCategoricalRand(b *testing.B, size int) {
 		dist.Rand()
 	}
 }
+
+func TestCategoricalFit(t *testing.T) {
+	t.Parallel()
+	c := NewCategorical([]float64{1, 1, 1, 1}, nil)
+	c.Fit([]float64{0, 2, 2, 3, 3, 3}, []float64{1, 1, 1, 0.5, 0.5, 2})
+	for i, want := range []float64{1.0 / 6, 0, 2.0 / 6, 3.0 / 6} {
+		if got := c.Prob(float64(i)); math.Abs(got-want) > 1e-14 {
+			t.Errorf("unexpected probability for %d: got:%v want:%v", i, got, want)
+		}
+	}
+	if !panics(func() { c.Fit([]float64{0, 4}, nil) }) {
+		t.Errorf("expected panic for sample out of range")
+	}
+	if !panics(func() { c.Fit([]float64{0.5}, nil) }) {
+		t.Errorf("expected panic for non-integer sample")
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Cauchy implements the Cauchy distribution, a two-parameter continuous
+// distribution with support over the real numbers. The Cauchy distribution is
+// also known as the Lorentz distribution. Its mean and variance are undefined.
+//
+// The Cauchy distribution has density function
+//  1 / (π * γ * (1 + z^2))
+//  z = (x - x0)/γ
+// where x0 is the location parameter Mu and γ is the scale parameter Scale.
+// Scale must be greater than 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Cauchy_distribution.
+type Cauchy struct {
+	Mu    float64 // Location of the peak
+	Scale float64 // Half width at half maximum
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (c Cauchy) CDF(x float64) float64 {
+	return 0.5 + math.Atan((x-c.Mu)/c.Scale)/math.Pi
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (c Cauchy) Entropy() float64 {
+	return math.Log(4 * math.Pi * c.Scale)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution, which is
+// undefined and returned as NaN.
+func (Cauchy) ExKurtosis() float64 {
+	return math.NaN()
+}
+
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The Cauchy likelihood may have several local maxima. Fit finds the
+// maximum closest to the sample median and half the interquartile range.
+func (c *Cauchy) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mu := weightedQuantile(0.5, samples, weights)
+	scale := (weightedQuantile(0.75, samples, weights) - weightedQuantile(0.25, samples, weights)) / 2
+	if !(scale > 0) {
+		scale = 1
+	}
+	x := []float64{0, 0}
+	maximize(func(x []float64) float64 {
+		d := Cauchy{Mu: mu + scale*x[0], Scale: scale * math.Exp(x[1])}
+		return logLikelihood(d, samples, weights)
+	}, x)
+	c.Mu = mu + scale*x[0]
+	c.Scale = scale * math.Exp(x[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (c Cauchy) LogProb(x float64) float64 {
+	z := (x - c.Mu) / c.Scale
+	return -math.Log(math.Pi*c.Scale) - math.Log1p(z*z)
+}
+
+// Mean returns the mean of the probability distribution, which is undefined
+// and returned as NaN.
+func (Cauchy) Mean() float64 {
+	return math.NaN()
+}
+
+// Median returns the median of the probability distribution.
+func (c Cauchy) Median() float64 {
+	return c.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (c Cauchy) Mode() float64 {
+	return c.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Cauchy) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (c Cauchy) Prob(x float64) float64 {
+	return math.Exp(c.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+func (c Cauchy) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	switch p {
+	case 0:
+		return math.Inf(-1)
+	case 1:
+		return math.Inf(1)
+	}
+	return c.Mu + c.Scale*math.Tan(math.Pi*(p-0.5))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (c Cauchy) Rand() float64 {
+	var u float64
+	if c.Src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(c.Src).Float64()
+	}
+	return c.Mu + c.Scale*math.Tan(math.Pi*(u-0.5))
+}
+
+// Score returns the score function with respect to the parameters of the
+// distribution at the input location x. The score function is the derivative
+// of the log-likelihood at x with respect to the parameters
+//  (∂/∂θ) log(p(x;θ))
+// If deriv is non-nil, len(deriv) must equal the number of parameters otherwise
+// Score will panic, and the derivative is stored in-place into deriv. If deriv
+// is nil a new slice will be allocated and returned.
+//
+// The order is [∂LogProb / ∂Mu, ∂LogProb / ∂Scale].
+//
+// For more information, see https://en.wikipedia.org/wiki/Score_%28statistics%29.
+func (c Cauchy) Score(deriv []float64, x float64) []float64 {
+	if deriv == nil {
+		deriv = make([]float64, c.NumParameters())
+	}
+	if len(deriv) != c.NumParameters() {
+		panic(badLength)
+	}
+	z := (x - c.Mu) / c.Scale
+	deriv[0] = 2 * z / (c.Scale * (1 + z*z))
+	deriv[1] = (z*z - 1) / (c.Scale * (1 + z*z))
+	return deriv
+}
+
+// ScoreInput returns the score function with respect to the input of the
+// distribution at the input location specified by x. The score function is the
+// derivative of the log-likelihood
+//  (d/dx) log(p(x)) .
+func (c Cauchy) ScoreInput(x float64) float64 {
+	z := (x - c.Mu) / c.Scale
+	return -2 * z / (c.Scale * (1 + z*z))
+}
+
+// Skewness returns the skewness of the distribution, which is undefined and
+// returned as NaN.
+func (Cauchy) Skewness() float64 {
+	return math.NaN()
+}
+
+// StdDev returns the standard deviation of the probability distribution,
+// which is undefined and returned as NaN.
+func (Cauchy) StdDev() float64 {
+	return math.NaN()
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (c Cauchy) Survival(x float64) float64 {
+	return 0.5 - math.Atan((x-c.Mu)/c.Scale)/math.Pi
+}
+
+// Variance returns the variance of the probability distribution, which is
+// undefined and returned as NaN.
+func (Cauchy) Variance() float64 {
+	return math.NaN()
+}
+
+// parameters returns the parameters of the distribution.
+func (c Cauchy) parameters(p []Parameter) []Parameter {
+	nParam := c.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("cauchy: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = c.Mu
+	p[1].Name = "Scale"
+	p[1].Value = c.Scale
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (c *Cauchy) setParameters(p []Parameter) {
+	if len(p) != c.NumParameters() {
+		panic("cauchy: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("cauchy: " + panicNameMismatch)
+	}
+	if p[1].Name != "Scale" {
+		panic("cauchy: " + panicNameMismatch)
+	}
+	c.Mu = p[0].Value
+	c.Scale = p[1].Value
+}
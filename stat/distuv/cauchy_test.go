@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCauchyMoments(t *testing.T) {
+	t.Parallel()
+	c := Cauchy{Mu: -5, Scale: 6}
+	for _, v := range []float64{c.Mean(), c.Variance(), c.StdDev(), c.Skewness(), c.ExKurtosis()} {
+		if !math.IsNaN(v) {
+			t.Errorf("Expected NaN for undefined moment, got %v", v)
+		}
+	}
+}
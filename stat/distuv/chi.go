@@ -45,6 +45,16 @@ func (c Chi) ExKurtosis() float64 {
 	return 2 / v * (1 - c.Mean()*s*c.Skewness() - v)
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (c *Chi) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// The likelihood is maximized where ψ(K/2) = 2 E[log x] - log 2.
+	c.K = 2 * invDigamma(2*weightedLogMean(samples, weights)-math.Ln2)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (c Chi) LogProb(x float64) float64 {
@@ -122,3 +132,27 @@ func (c Chi) Variance() float64 {
 	m := c.Mean()
 	return math.Max(0, c.K-m*m)
 }
+
+// parameters returns the parameters of the distribution.
+func (c Chi) parameters(p []Parameter) []Parameter {
+	nParam := c.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("chi: improper parameter length")
+	}
+	p[0].Name = "K"
+	p[0].Value = c.K
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (c *Chi) setParameters(p []Parameter) {
+	if len(p) != c.NumParameters() {
+		panic("chi: incorrect number of parameters to set")
+	}
+	if p[0].Name != "K" {
+		panic("chi: " + panicNameMismatch)
+	}
+	c.K = p[0].Value
+}
@@ -97,3 +97,8 @@ func testChi(t *testing.T, c Chi, i int) {
 		t.Errorf("Survival is not 1 for negative argument. Got %v", survival)
 	}
 }
+
+func TestChiFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Chi", &Chi{K: 4, Src: rand.NewSource(1)}, func() fitTester { return &Chi{} }, 10000, 0.05, true)
+}
@@ -38,6 +38,16 @@ func (c ChiSquared) ExKurtosis() float64 {
 	return 12 / c.K
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (c *ChiSquared) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// The likelihood is maximized where ψ(K/2) = E[log x] - log 2.
+	c.K = 2 * invDigamma(weightedLogMean(samples, weights)-math.Ln2)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (c ChiSquared) LogProb(x float64) float64 {
@@ -98,3 +108,27 @@ func (c ChiSquared) Survival(x float64) float64 {
 func (c ChiSquared) Variance() float64 {
 	return 2 * c.K
 }
+
+// parameters returns the parameters of the distribution.
+func (c ChiSquared) parameters(p []Parameter) []Parameter {
+	nParam := c.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("chisquared: improper parameter length")
+	}
+	p[0].Name = "K"
+	p[0].Value = c.K
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (c *ChiSquared) setParameters(p []Parameter) {
+	if len(p) != c.NumParameters() {
+		panic("chisquared: incorrect number of parameters to set")
+	}
+	if p[0].Name != "K" {
+		panic("chisquared: " + panicNameMismatch)
+	}
+	c.K = p[0].Value
+}
@@ -95,3 +95,8 @@ func testChiSquared(t *testing.T, c ChiSquared, i int) {
 		t.Errorf("Survival is not 1 for negative argument. Got %v", survival)
 	}
 }
+
+func TestChiSquaredFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "ChiSquared", &ChiSquared{K: 5, Src: rand.NewSource(1)}, func() fitTester { return &ChiSquared{} }, 10000, 0.05, true)
+}
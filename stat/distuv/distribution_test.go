@@ -292,3 +292,30 @@ func panics(fun func()) (b bool) {
 	fun()
 	return
 }
+
+// checkQuantileDiscrete checks that Quantile returns the smallest integer k
+// for which the CDF is at least p, and that CDF and Survival are consistent.
+func checkQuantileDiscrete(t *testing.T, cas int, c cumulanter) {
+	t.Helper()
+	for _, p := range []float64{0, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99} {
+		k := c.Quantile(p)
+		if k != math.Floor(k) {
+			t.Errorf("Quantile not an integer case %v: p = %v, got %v", cas, p, k)
+		}
+		if cdf := c.CDF(k); cdf < p {
+			t.Errorf("CDF at quantile less than p case %v: p = %v, CDF(%v) = %v", cas, p, k, cdf)
+		}
+		if cdf := c.CDF(k - 1); cdf >= p && p > 0 {
+			t.Errorf("Quantile not minimal case %v: p = %v, CDF(%v) = %v", cas, p, k-1, cdf)
+		}
+		if math.Abs(1-c.CDF(k)-c.Survival(k)) > 1e-14 {
+			t.Errorf("Survival/CDF mismatch case %v: want: %v, got: %v", cas, 1-c.CDF(k), c.Survival(k))
+		}
+	}
+	if !panics(func() { c.Quantile(-0.0001) }) {
+		t.Errorf("Expected panic with negative argument to Quantile")
+	}
+	if !panics(func() { c.Quantile(1.0001) }) {
+		t.Errorf("Expected panic with Quantile argument above 1")
+	}
+}
@@ -3,4 +3,9 @@
 // license that can be found in the LICENSE file.
 
 // Package distuv provides univariate random distribution types.
+//
+// The parametric distributions implement Fitter, which estimates their
+// parameters from data by maximum likelihood. AlphaStable does not implement
+// Fitter since its density has no closed form and the package does not
+// provide LogProb for it.
 package distuv // import "gonum.org/v1/gonum/stat/distuv"
@@ -161,3 +161,8 @@ func TestExponentialCDFSmallArgument(t *testing.T) {
 		t.Errorf("Wrong CDF value for small argument. Got: %v, want: %g", p, x)
 	}
 }
+
+func TestExponentialFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Exponential", &Exponential{Rate: 2, Src: rand.NewSource(1)}, func() fitTester { return &Exponential{} }, 10000, 0.05, true)
+}
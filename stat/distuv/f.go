@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
 )
 
 // F implements the F-distribution, a two-parameter continuous distribution
@@ -41,6 +42,36 @@ func (f F) ExKurtosis() float64 {
 	return (12 / (f.D2 - 6)) * ((5*f.D2-22)/(f.D2-8) + ((f.D2-4)/f.D1)*((f.D2-2)/(f.D2-8))*((f.D2-2)/(f.D1+f.D2-2)))
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// There is no closed form for the estimates, so they are found numerically
+// starting from the method of moments estimates where these exist.
+func (f *F) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	d2 := 10.0
+	mean, variance := stat.MeanVariance(samples, weights)
+	if mean > 1 {
+		d2 = 2 * mean / (mean - 1)
+	}
+	d1 := d2
+	if d2 > 4 {
+		if c := variance * (d2 - 2) * (d2 - 2) * (d2 - 4) / (2 * d2 * d2); c > 1 {
+			d1 = (d2 - 2) / (c - 1)
+		}
+	}
+
+	p := []float64{math.Log(d1), math.Log(d2)}
+	maximize(func(p []float64) float64 {
+		d := F{D1: math.Exp(p[0]), D2: math.Exp(p[1])}
+		return logLikelihood(d, samples, weights)
+	}, p)
+	f.D1 = math.Exp(p[0])
+	f.D2 = math.Exp(p[1])
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (f F) LogProb(x float64) float64 {
@@ -131,3 +162,33 @@ func (f F) Variance() float64 {
 	den := f.D1 * (f.D2 - 2) * (f.D2 - 2) * (f.D2 - 4)
 	return num / den
 }
+
+// parameters returns the parameters of the distribution.
+func (f F) parameters(p []Parameter) []Parameter {
+	nParam := f.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("f: improper parameter length")
+	}
+	p[0].Name = "D1"
+	p[0].Value = f.D1
+	p[1].Name = "D2"
+	p[1].Value = f.D2
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (f *F) setParameters(p []Parameter) {
+	if len(p) != f.NumParameters() {
+		panic("f: incorrect number of parameters to set")
+	}
+	if p[0].Name != "D1" {
+		panic("f: " + panicNameMismatch)
+	}
+	if p[1].Name != "D2" {
+		panic("f: " + panicNameMismatch)
+	}
+	f.D1 = p[0].Value
+	f.D2 = p[1].Value
+}
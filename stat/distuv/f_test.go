@@ -150,3 +150,8 @@ func TestFUndefined(t *testing.T) {
 		}
 	}
 }
+
+func TestFFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "F", &F{D1: 5, D2: 10, Src: rand.NewSource(1)}, func() fitTester { return &F{} }, 10000, 0.3, true)
+}
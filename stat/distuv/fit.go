@@ -0,0 +1,257 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Parametric is a distribution with continuous parameters that can be
+// estimated from data. Parametric is implemented by pointers to all the
+// distributions in this package that have a Fit method, except for Binomial,
+// where the number of trials is fixed, Hypergeometric, whose parameters are
+// integers, and Categorical.
+type Parametric interface {
+	LogProber
+	NumParameters() int
+
+	parameters([]Parameter) []Parameter
+	setParameters([]Parameter)
+}
+
+// StdErr returns the standard errors of the parameters of d estimated from
+// the observed Fisher information of the samples with relative weights. The
+// parameters of d are typically the maximum likelihood estimates found by
+// d.Fit(samples, weights). The weights are frequency weights, so a weight of 2
+// is equivalent to observing the sample twice. If weights is nil, then all the
+// weights are 1. If weights is not nil, then the len(weights) must equal
+// len(samples).
+//
+// The observed Fisher information is the negative Hessian of the weighted
+// log-likelihood with respect to the parameters, and the standard errors are
+// the square roots of the diagonal of its inverse. The Hessian is approximated
+// by finite differences. The standard errors are NaN if the observed
+// information is not positive definite, for example when the parameters of d
+// are not at a maximum of the likelihood. The standard errors are not
+// meaningful for parameters that the likelihood is not twice differentiable
+// in, such as the bounds of Uniform and Triangle, the mode of Triangle, Xm of
+// Pareto and Mu of Laplace.
+//
+// The standard errors are stored in the order the parameters are declared in
+// the distribution. If dst is not nil, the result is stored in-place into dst
+// and returned, otherwise a new slice is allocated first. If dst is not nil,
+// it must have length equal to d.NumParameters(), otherwise StdErr will panic.
+func StdErr(dst []float64, d Parametric, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	n := d.NumParameters()
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic(badLength)
+	}
+
+	params := d.parameters(nil)
+	defer d.setParameters(params)
+
+	// The Hessian is computed with respect to relative changes in the
+	// parameters so that a fixed finite difference step is appropriate.
+	scale := make([]float64, n)
+	for i, p := range params {
+		scale[i] = math.Abs(p.Value)
+		if scale[i] == 0 {
+			scale[i] = 1
+		}
+	}
+	work := d.parameters(nil)
+	logLik := func(u []float64) float64 {
+		for i, v := range u {
+			work[i].Value = params[i].Value + scale[i]*v
+		}
+		d.setParameters(work)
+		return logLikelihood(d, samples, weights)
+	}
+	var hess mat.SymDense
+	fd.Hessian(&hess, logLik, make([]float64, n), &fd.Settings{Formula: fd.Central})
+
+	info := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			info.SetSym(i, j, -hess.At(i, j)/(scale[i]*scale[j]))
+		}
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(info) {
+		for i := range dst {
+			dst[i] = math.NaN()
+		}
+		return dst
+	}
+	var cov mat.SymDense
+	// An ill-conditioned information matrix still gives usable, if
+	// inaccurate, standard errors so the condition error is ignored.
+	_ = chol.InverseTo(&cov)
+	for i := range dst {
+		dst[i] = math.Sqrt(cov.At(i, i))
+	}
+	return dst
+}
+
+// checkFitInput panics if samples is empty or if weights is not nil and
+// does not have the same length as samples.
+func checkFitInput(samples, weights []float64) {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(errNoSamples)
+	}
+}
+
+// logLikelihood returns the sum of the log probabilities of the samples under
+// d weighted by weights. Samples with zero weight are ignored.
+func logLikelihood(d LogProber, samples, weights []float64) float64 {
+	var ll float64
+	if weights == nil {
+		for _, x := range samples {
+			ll += d.LogProb(x)
+		}
+		return ll
+	}
+	for i, x := range samples {
+		if weights[i] != 0 {
+			ll += weights[i] * d.LogProb(x)
+		}
+	}
+	return ll
+}
+
+// weightedLogMean returns the weighted mean of the logarithm of the samples.
+func weightedLogMean(samples, weights []float64) float64 {
+	logs := make([]float64, len(samples))
+	for i, x := range samples {
+		logs[i] = math.Log(x)
+	}
+	return stat.Mean(logs, weights)
+}
+
+// sampleRange returns the minimum and maximum of the samples with non-zero
+// weight.
+func sampleRange(samples, weights []float64) (min, max float64) {
+	min = math.Inf(1)
+	max = math.Inf(-1)
+	for i, x := range samples {
+		if weights != nil && weights[i] == 0 {
+			continue
+		}
+		min = math.Min(min, x)
+		max = math.Max(max, x)
+	}
+	return min, max
+}
+
+// weightedQuantile returns the empirical p-quantile of the samples with
+// relative weights without modifying the inputs.
+func weightedQuantile(p float64, samples, weights []float64) float64 {
+	x := make([]float64, len(samples))
+	copy(x, samples)
+	var w []float64
+	if weights != nil {
+		w = make([]float64, len(weights))
+		copy(w, weights)
+	}
+	stat.SortWeighted(x, w)
+	return stat.Quantile(p, stat.Empirical, x, w)
+}
+
+// trigamma returns the trigamma function, the derivative of the digamma
+// function, at x.
+func trigamma(x float64) float64 {
+	return mathext.Zeta(2, x)
+}
+
+// invDigamma returns the inverse of the digamma function at y.
+func invDigamma(y float64) float64 {
+	// Initialization and Newton iteration from
+	// Minka, T. P. Estimating a Dirichlet distribution. 2000.
+	var x float64
+	if y >= -2.22 {
+		x = math.Exp(y) + 0.5
+	} else {
+		x = -1 / (y + eulerMascheroni)
+	}
+	for i := 0; i < 100; i++ {
+		dx := (mathext.Digamma(x) - y) / trigamma(x)
+		x -= dx
+		if math.Abs(dx) <= 1e-14*x {
+			break
+		}
+	}
+	return x
+}
+
+// maximize finds a local maximum of f starting from x using Newton's method
+// with a backtracking line search and stores it into x. Derivatives are
+// approximated by finite differences, so f must be smooth and x must be well
+// scaled. Gradient ascent steps are taken where f is not locally concave.
+func maximize(f func([]float64) float64, x []float64) {
+	const (
+		maxIter     = 200
+		maxHalvings = 60
+		stepTol     = 1e-10
+		armijo      = 1e-4
+	)
+	n := len(x)
+	settings := &fd.Settings{Formula: fd.Central}
+	grad := make([]float64, n)
+	dir := make([]float64, n)
+	xNew := make([]float64, n)
+	var (
+		hess mat.SymDense
+		chol mat.Cholesky
+	)
+	dirVec := mat.NewVecDense(n, dir)
+	gradVec := mat.NewVecDense(n, grad)
+	fx := f(x)
+	for iter := 0; iter < maxIter; iter++ {
+		fd.Gradient(grad, f, x, settings)
+		fd.Hessian(&hess, f, x, settings)
+		hess.ScaleSym(-1, &hess)
+		if !chol.Factorize(&hess) || chol.SolveVecTo(dirVec, gradVec) != nil {
+			copy(dir, grad)
+			if norm := floats.Norm(dir, math.Inf(1)); norm > 1 {
+				floats.Scale(1/norm, dir)
+			}
+		}
+		slope := floats.Dot(dir, grad)
+		if slope <= 0 {
+			return
+		}
+		step := 1.0
+		var fNew float64
+		for i := 0; ; i++ {
+			if i == maxHalvings {
+				return
+			}
+			floats.AddScaledTo(xNew, x, step, dir)
+			fNew = f(xNew)
+			if fNew >= fx+armijo*step*slope {
+				break
+			}
+			step /= 2
+		}
+		copy(x, xNew)
+		fx = fNew
+		if step*floats.Norm(dir, math.Inf(1)) < stepTol {
+			return
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+)
+
+func TestStdErr(t *testing.T) {
+	t.Parallel()
+	const n = 1000
+	src := rand.NewSource(1)
+
+	normal := &Normal{}
+	samples := randn(&Normal{Mu: 1, Sigma: 2, Src: src}, n)
+	normal.Fit(samples, nil)
+	got := StdErr(nil, normal, samples, nil)
+	want := []float64{normal.Sigma / math.Sqrt(n), normal.Sigma / math.Sqrt(2*n)}
+	if !floats.EqualApprox(got, want, 1e-5) {
+		t.Errorf("unexpected Normal standard errors: got:%v want:%v", got, want)
+	}
+
+	exp := &Exponential{}
+	samples = randn(&Exponential{Rate: 3, Src: src}, n)
+	exp.Fit(samples, nil)
+	got = StdErr(nil, exp, samples, nil)
+	want = []float64{exp.Rate / math.Sqrt(n)}
+	if !floats.EqualApprox(got, want, 1e-5) {
+		t.Errorf("unexpected Exponential standard errors: got:%v want:%v", got, want)
+	}
+
+	poisson := &Poisson{}
+	samples = randn(&Poisson{Lambda: 4, Src: src}, n)
+	poisson.Fit(samples, nil)
+	got = StdErr(nil, poisson, samples, nil)
+	want = []float64{math.Sqrt(poisson.Lambda / n)}
+	if !floats.EqualApprox(got, want, 1e-5) {
+		t.Errorf("unexpected Poisson standard errors: got:%v want:%v", got, want)
+	}
+
+	gamma := &Gamma{}
+	samples = randn(&Gamma{Alpha: 3, Beta: 2, Src: src}, n)
+	gamma.Fit(samples, nil)
+	got = StdErr(nil, gamma, samples, nil)
+	info := mat.NewSymDense(2, []float64{
+		n * trigamma(gamma.Alpha), -n / gamma.Beta,
+		-n / gamma.Beta, n * gamma.Alpha / (gamma.Beta * gamma.Beta),
+	})
+	var cov mat.Dense
+	err := cov.Inverse(info)
+	if err != nil {
+		t.Fatalf("unexpected error inverting information: %v", err)
+	}
+	want = []float64{math.Sqrt(cov.At(0, 0)), math.Sqrt(cov.At(1, 1))}
+	if !floats.EqualApprox(got, want, 1e-5) {
+		t.Errorf("unexpected Gamma standard errors: got:%v want:%v", got, want)
+	}
+
+	// Frequency weights are equivalent to repeated samples.
+	samples = randn(&Weibull{K: 2, Lambda: 1, Src: src}, 100)
+	weights := make([]float64, len(samples))
+	var repeated []float64
+	for i, x := range samples {
+		weights[i] = float64(i%2 + 1)
+		for j := 0; j < i%2+1; j++ {
+			repeated = append(repeated, x)
+		}
+	}
+	weibull := &Weibull{}
+	weibull.Fit(samples, weights)
+	params := weibull.parameters(nil)
+	dst := make([]float64, 2)
+	got = StdErr(dst, weibull, samples, weights)
+	if &got[0] != &dst[0] {
+		t.Errorf("StdErr did not return dst")
+	}
+	want = StdErr(nil, weibull, repeated, nil)
+	if !floats.EqualApprox(got, want, 1e-6) {
+		t.Errorf("unexpected weighted standard errors: got:%v want:%v", got, want)
+	}
+	if !parametersEqual(weibull.parameters(nil), params, 0) {
+		t.Errorf("StdErr modified the distribution: got:%v want:%v", weibull.parameters(nil), params)
+	}
+
+	// A point that is not a maximum of the likelihood gives NaN.
+	got = StdErr(nil, &Normal{Mu: 100, Sigma: 0.1}, []float64{0, 1, 2}, nil)
+	for _, v := range got {
+		if !math.IsNaN(v) {
+			t.Errorf("expected NaN standard errors away from the maximum, got:%v", got)
+			break
+		}
+	}
+
+	if !panics(func() { StdErr(make([]float64, 1), normal, samples, nil) }) {
+		t.Errorf("expected panic for wrong dst length")
+	}
+	if !panics(func() { StdErr(nil, normal, nil, nil) }) {
+		t.Errorf("expected panic for no samples")
+	}
+}
+
+func TestFitNoSamples(t *testing.T) {
+	t.Parallel()
+	for _, d := range []Fitter{
+		&Bernoulli{}, &Beta{}, &Binomial{N: 1}, &Cauchy{}, &Chi{}, &ChiSquared{},
+		&F{}, &Gamma{}, &Geometric{}, &GumbelRight{}, &Hypergeometric{N: 1}, &InverseGamma{},
+		&Laplace{}, &Logistic{}, &LogNormal{}, &Nakagami{},
+		&NegativeBinomial{}, &Pareto{}, &Poisson{}, &Rice{}, &StudentsT{},
+		&Uniform{}, &VonMises{}, &Weibull{},
+	} {
+		if !panics(func() { d.Fit(nil, nil) }) {
+			t.Errorf("%T: expected panic for no samples", d)
+		}
+	}
+}
+
+func TestInvDigamma(t *testing.T) {
+	t.Parallel()
+	for _, x := range []float64{1e-3, 0.1, 0.5, 1, 2.5, 10, 1e4} {
+		got := invDigamma(mathext.Digamma(x))
+		if math.Abs(got-x) > 1e-10*x {
+			t.Errorf("unexpected inverse digamma for %v: got:%v", x, got)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
 )
 
 // Gamma implements the Gamma distribution, a two-parameter continuous distribution
@@ -43,6 +44,17 @@ func (g Gamma) ExKurtosis() float64 {
 	return 6 / g.Alpha
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (g *Gamma) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := stat.Mean(samples, weights)
+	g.Alpha = gammaShapeMLE(math.Log(mean) - weightedLogMean(samples, weights))
+	g.Beta = g.Alpha / mean
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (g Gamma) LogProb(x float64) float64 {
@@ -198,3 +210,52 @@ func (g Gamma) StdDev() float64 {
 func (g Gamma) Variance() float64 {
 	return g.Alpha / g.Beta / g.Beta
 }
+
+// parameters returns the parameters of the distribution.
+func (g Gamma) parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("gamma: improper parameter length")
+	}
+	p[0].Name = "Alpha"
+	p[0].Value = g.Alpha
+	p[1].Name = "Beta"
+	p[1].Value = g.Beta
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (g *Gamma) setParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("gamma: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Alpha" {
+		panic("gamma: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("gamma: " + panicNameMismatch)
+	}
+	g.Alpha = p[0].Value
+	g.Beta = p[1].Value
+}
+
+// gammaShapeMLE returns the maximum likelihood estimate of the shape parameter
+// of the gamma distribution, where s is the difference between the logarithm
+// of the mean of the samples and the mean of their logarithms.
+func gammaShapeMLE(s float64) float64 {
+	// Initialization and generalized Newton iteration from
+	// Minka, T. P. Estimating a Gamma distribution. 2002.
+	alpha := (3 - s + math.Sqrt((s-3)*(s-3)+24*s)) / (12 * s)
+	for i := 0; i < 100; i++ {
+		inv := 1/alpha + (math.Log(alpha)-mathext.Digamma(alpha)-s)/(alpha*alpha*(1/alpha-trigamma(alpha)))
+		next := 1 / inv
+		done := math.Abs(next-alpha) <= 1e-14*alpha
+		alpha = next
+		if done {
+			break
+		}
+	}
+	return alpha
+}
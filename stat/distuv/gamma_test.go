@@ -134,3 +134,8 @@ func BenchmarkGammaRand(b *testing.B) {
 		})
 	}
 }
+
+func TestGammaFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Gamma", &Gamma{Alpha: 3, Beta: 2, Src: rand.NewSource(1)}, func() fitTester { return &Gamma{} }, 10000, 0.05, true)
+}
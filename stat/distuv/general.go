@@ -4,6 +4,8 @@
 
 package distuv
 
+import "math"
+
 // Parameter represents a parameter of a probability distribution
 type Parameter struct {
 	Name  string
@@ -22,3 +24,64 @@ const (
 	eulerMascheroni = 0.5772156649015328606065120900824024310421 // https://oeis.org/A001620
 	apery           = 1.2020569031595942853997381615114499907649 // https://oeis.org/A002117
 )
+
+// discreteQuantile returns the smallest integer k ≥ min for which cdf(k) ≥ p,
+// where cdf is the non-decreasing cumulative distribution function of a
+// distribution with support on the integers from min. The search starts at
+// guess.
+func discreteQuantile(p float64, cdf func(float64) float64, min, guess float64) float64 {
+	k := math.Max(math.Floor(guess), min)
+	// Find lo and hi such that cdf(lo) < p ≤ cdf(hi), with lo = min-1
+	// if p ≤ cdf(min).
+	var lo, hi float64
+	if cdf(k) >= p {
+		hi = k
+		for step := 1.0; ; step *= 2 {
+			lo = hi - step
+			if lo < min {
+				lo = min - 1
+				break
+			}
+			if cdf(lo) < p {
+				break
+			}
+			hi = lo
+		}
+	} else {
+		lo = k
+		for step := 1.0; ; step *= 2 {
+			hi = lo + step
+			if cdf(hi) >= p {
+				break
+			}
+			lo = hi
+		}
+	}
+	for hi-lo > 1 {
+		mid := math.Floor(lo + (hi-lo)/2)
+		if cdf(mid) >= p {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi
+}
+
+// continuousQuantile returns the smallest x in (lo, hi] for which cdf(x) ≥ p,
+// where cdf is a non-decreasing cumulative distribution function with
+// cdf(lo) < p ≤ cdf(hi). The result is found by bisection to full floating
+// point precision.
+func continuousQuantile(p float64, cdf func(float64) float64, lo, hi float64) float64 {
+	for {
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi {
+			return hi
+		}
+		if cdf(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+}
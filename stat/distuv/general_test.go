@@ -11,6 +11,7 @@ import (
 
 	"gonum.org/v1/gonum/diff/fd"
 	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 type univariateProbPoint struct {
@@ -272,3 +273,133 @@ func testDerivParam(t *testing.T, d derivParamTester) {
 		}
 	}
 }
+
+type scoreParamTester interface {
+	LogProb(x float64) float64
+	Score(deriv []float64, x float64) []float64
+	NumParameters() int
+	parameters([]Parameter) []Parameter
+	setParameters([]Parameter)
+}
+
+// testScoreDiscrete checks the Score of a distribution with continuous
+// parameters and discrete support against finite differences of LogProb at
+// each of xs.
+func testScoreDiscrete(t *testing.T, name string, d scoreParamTester, xs []float64) {
+	t.Helper()
+	if !panics(func() { d.Score(make([]float64, d.NumParameters()+1), 0) }) {
+		t.Errorf("%s: expected panic for wrong derivative slice length", name)
+	}
+	initParams := d.parameters(nil)
+	init := make([]float64, len(initParams))
+	for i, v := range initParams {
+		init[i] = v.Value
+	}
+	fdDerivParam := make([]float64, len(init))
+	for _, x := range xs {
+		d.setParameters(initParams)
+		score := d.Score(nil, x)
+		logProbParams := func(p []float64) float64 {
+			params := d.parameters(nil)
+			for i, v := range p {
+				params[i].Value = v
+			}
+			d.setParameters(params)
+			return d.LogProb(x)
+		}
+		fd.Gradient(fdDerivParam, logProbParams, init, nil)
+		if !floats.EqualApprox(score, fdDerivParam, 1e-6) {
+			t.Errorf("%s: score mismatch at x = %g. Want %v, got %v", name, x, fdDerivParam, score)
+		}
+	}
+	d.setParameters(initParams)
+}
+
+type fitTester interface {
+	Parametric
+	Fitter
+}
+
+// testFit checks that fitting a distribution returned by newFit to samples
+// drawn from want recovers the parameters of want within the relative
+// tolerance tol, that the fitted parameters maximize the likelihood, and that
+// integer weights are equivalent to repeated samples. If checkStdErr is true,
+// the fitted parameters must also be within five standard errors of the
+// parameters of want.
+func testFit(t *testing.T, name string, want interface {
+	Parametric
+	Rander
+}, newFit func() fitTester, n int, tol float64, checkStdErr bool) {
+	t.Helper()
+	samples := randn(want, n)
+	got := newFit()
+	got.Fit(samples, nil)
+	wantParams := want.parameters(nil)
+	gotParams := got.parameters(nil)
+	for i, p := range gotParams {
+		if !scalar.EqualWithinRel(p.Value, wantParams[i].Value, tol) {
+			t.Errorf("%s: unexpected %s estimate: got:%v want:%v", name, p.Name, p.Value, wantParams[i].Value)
+		}
+	}
+
+	ll := logLikelihood(got, samples, nil)
+	for i, p := range gotParams {
+		for _, f := range []float64{1 - 1e-3, 1 + 1e-3} {
+			params := got.parameters(nil)
+			params[i].Value = p.Value * f
+			got.setParameters(params)
+			if llPert := logLikelihood(got, samples, nil); llPert > ll+1e-8*math.Abs(ll) {
+				t.Errorf("%s: fit is not a maximum of the likelihood: perturbing %s gives %v > %v", name, p.Name, llPert, ll)
+			}
+		}
+		got.setParameters(gotParams)
+	}
+
+	if checkStdErr {
+		stdErr := StdErr(nil, got, samples, nil)
+		for i, se := range stdErr {
+			if !(se > 0) || math.IsInf(se, 1) {
+				t.Errorf("%s: unexpected standard error for %s: %v", name, gotParams[i].Name, se)
+				continue
+			}
+			if math.Abs(gotParams[i].Value-wantParams[i].Value) > 5*se {
+				t.Errorf("%s: %s estimate %v not within five standard errors %v of %v",
+					name, gotParams[i].Name, gotParams[i].Value, se, wantParams[i].Value)
+			}
+		}
+	}
+
+	const nSub = 200
+	weights := make([]float64, nSub)
+	var repeated []float64
+	for i, x := range samples[:nSub] {
+		weights[i] = float64(i%3 + 1)
+		for j := 0; j < i%3+1; j++ {
+			repeated = append(repeated, x)
+		}
+	}
+	weighted := newFit()
+	weighted.Fit(samples[:nSub], weights)
+	unweighted := newFit()
+	unweighted.Fit(repeated, nil)
+	if !parametersEqualRel(weighted.parameters(nil), unweighted.parameters(nil), 1e-6) {
+		t.Errorf("%s: weighted fit does not match fit with repeated samples: got:%v want:%v",
+			name, weighted.parameters(nil), unweighted.parameters(nil))
+	}
+
+	if !panics(func() { newFit().Fit(samples, make([]float64, len(samples)+1)) }) {
+		t.Errorf("%s: expected panic for mismatched samples and weights lengths", name)
+	}
+}
+
+func parametersEqualRel(p1, p2 []Parameter, tol float64) bool {
+	for i, p := range p1 {
+		if p.Name != p2[i].Name {
+			return false
+		}
+		if !scalar.EqualWithinAbsOrRel(p.Value, p2[i].Value, tol, tol) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// Geometric implements the geometric distribution, a discrete probability
+// distribution of the number of failures in a sequence of independent
+// Bernoulli trials before the first success.
+// The geometric distribution has probability mass function:
+//  f(k) = p (1-p)^k
+// for k = 0, 1, 2, ...
+// For more information, see https://en.wikipedia.org/wiki/Geometric_distribution.
+type Geometric struct {
+	// P is the probability of success in each trial.
+	// P must be greater than 0 and at most 1.
+	P float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (g Geometric) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1((math.Floor(x) + 1) * math.Log1p(-g.P))
+}
+
+// Entropy returns the entropy of the distribution.
+func (g Geometric) Entropy() float64 {
+	q := 1 - g.P
+	if q == 0 {
+		return 0
+	}
+	return -(q*math.Log(q) + g.P*math.Log(g.P)) / g.P
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (g Geometric) ExKurtosis() float64 {
+	return 6 + g.P*g.P/(1-g.P)
+}
+
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (g *Geometric) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	g.P = 1 / (1 + stat.Mean(samples, weights))
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (g Geometric) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	if x == 0 {
+		return math.Log(g.P)
+	}
+	return math.Log(g.P) + x*math.Log1p(-g.P)
+}
+
+// Mean returns the mean of the probability distribution.
+func (g Geometric) Mean() float64 {
+	return (1 - g.P) / g.P
+}
+
+// Median returns the median of the probability distribution.
+func (g Geometric) Median() float64 {
+	return g.Quantile(0.5)
+}
+
+// Mode returns the mode of the probability distribution.
+func (Geometric) Mode() float64 {
+	return 0
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Geometric) NumParameters() int {
+	return 1
+}
+
+// Prob computes the value of the probability density function at x.
+func (g Geometric) Prob(x float64) float64 {
+	return math.Exp(g.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (g Geometric) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	if p == 1 {
+		if g.P == 1 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	guess := math.Ceil(math.Log1p(-p)/math.Log1p(-g.P)) - 1
+	return discreteQuantile(p, g.CDF, 0, guess)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (g Geometric) Rand() float64 {
+	var e float64
+	if g.Src == nil {
+		e = rand.ExpFloat64()
+	} else {
+		e = rand.New(g.Src).ExpFloat64()
+	}
+	// The floor of an exponential random variable with rate -log(1-p)
+	// is geometrically distributed.
+	return math.Floor(e / -math.Log1p(-g.P))
+}
+
+// Score returns the score function with respect to the parameters of the
+// distribution at the input location x. The score function is the derivative
+// of the log-likelihood at x with respect to the parameters
+//  (∂/∂θ) log(p(x;θ))
+// If deriv is non-nil, len(deriv) must equal the number of parameters otherwise
+// Score will panic, and the derivative is stored in-place into deriv. If deriv
+// is nil a new slice will be allocated and returned.
+//
+// The order is [∂LogProb / ∂P].
+//
+// For more information, see https://en.wikipedia.org/wiki/Score_%28statistics%29.
+func (g Geometric) Score(deriv []float64, x float64) []float64 {
+	if deriv == nil {
+		deriv = make([]float64, g.NumParameters())
+	}
+	if len(deriv) != g.NumParameters() {
+		panic(badLength)
+	}
+	deriv[0] = 1/g.P - x/(1-g.P)
+	return deriv
+}
+
+// Skewness returns the skewness of the distribution.
+func (g Geometric) Skewness() float64 {
+	return (2 - g.P) / math.Sqrt(1-g.P)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (g Geometric) StdDev() float64 {
+	return math.Sqrt(g.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (g Geometric) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return math.Exp((math.Floor(x) + 1) * math.Log1p(-g.P))
+}
+
+// Variance returns the variance of the probability distribution.
+func (g Geometric) Variance() float64 {
+	return (1 - g.P) / (g.P * g.P)
+}
+
+// parameters returns the parameters of the distribution.
+func (g Geometric) parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("geometric: improper parameter length")
+	}
+	p[0].Name = "P"
+	p[0].Value = g.P
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (g *Geometric) setParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("geometric: incorrect number of parameters to set")
+	}
+	if p[0].Name != "P" {
+		panic("geometric: " + panicNameMismatch)
+	}
+	g.P = p[0].Value
+}
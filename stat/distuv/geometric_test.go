@@ -0,0 +1,25 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGeometricCDF(t *testing.T) {
+	t.Parallel()
+	for _, p := range []float64{0.05, 0.3, 0.9} {
+		g := Geometric{P: p}
+		for _, k := range []float64{0, 2, 3, 10} {
+			if cdf, cdf2 := g.CDF(k), g.CDF(k+0.5); cdf2 != cdf {
+				t.Errorf("CDF mismatch between integers, p = %v, k = %v: got %v, want %v", p, k, cdf2, cdf)
+			}
+		}
+		if q := g.Quantile(1); !math.IsInf(q, 1) {
+			t.Errorf("Mismatch in Quantile(1), p = %v: got %v, want +Inf", p, q)
+		}
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat"
 )
 
 // GumbelRight implements the right-skewed Gumbel distribution, a two-parameter
@@ -46,6 +49,68 @@ func (g GumbelRight) ExKurtosis() float64 {
 	return 12.0 / 5
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (g *GumbelRight) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// Standardize the samples so that the estimates are well scaled.
+	mean, std := stat.MeanStdDev(samples, weights)
+	z := make([]float64, len(samples))
+	for i, x := range samples {
+		z[i] = (x - mean) / std
+	}
+	zMin, _ := sampleRange(z, weights)
+
+	// sums returns the weighted sums of e, e*z and e*z^2 where
+	// e = exp(-(z-zMin)/beta).
+	sums := func(beta float64) (s0, s1, s2 float64) {
+		for i, v := range z {
+			wt := 1.0
+			if weights != nil {
+				wt = weights[i]
+				if wt == 0 {
+					continue
+				}
+			}
+			e := wt * math.Exp(-(v-zMin)/beta)
+			s0 += e
+			s1 += e * v
+			s2 += e * v * v
+		}
+		return s0, s1, s2
+	}
+
+	// Initialize with the method of moments estimate and solve the
+	// likelihood equation beta = mean(z) - Σ z e / Σ e with Newton's
+	// method. The mean of z is zero.
+	beta := math.Sqrt(6) / math.Pi
+	for i := 0; i < 100; i++ {
+		s0, s1, s2 := sums(beta)
+		m := s1 / s0
+		f := beta + m
+		df := 1 + (s2/s0-m*m)/(beta*beta)
+		next := beta - f/df
+		if next <= 0 {
+			next = beta / 2
+		}
+		done := math.Abs(next-beta) <= 1e-14*beta
+		beta = next
+		if done {
+			break
+		}
+	}
+	s0, _, _ := sums(beta)
+	sumWeights := float64(len(samples))
+	if weights != nil {
+		sumWeights = floats.Sum(weights)
+	}
+	mu := zMin - beta*math.Log(s0/sumWeights)
+	g.Mu = mean + std*mu
+	g.Beta = std * beta
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (g GumbelRight) LogProb(x float64) float64 {
 	z := g.z(x)
@@ -115,3 +180,33 @@ func (g GumbelRight) Survival(x float64) float64 {
 func (g GumbelRight) Variance() float64 {
 	return math.Pi * math.Pi * g.Beta * g.Beta / 6
 }
+
+// parameters returns the parameters of the distribution.
+func (g GumbelRight) parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("gumbel: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = g.Mu
+	p[1].Name = "Beta"
+	p[1].Value = g.Beta
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (g *GumbelRight) setParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("gumbel: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("gumbel: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("gumbel: " + panicNameMismatch)
+	}
+	g.Mu = p[0].Value
+	g.Beta = p[1].Value
+}
@@ -79,3 +79,8 @@ func testGumbelRight(t *testing.T, g GumbelRight, i int) {
 		t.Errorf("Mismatch in NumParameters: got %v, want 2", g.NumParameters())
 	}
 }
+
+func TestGumbelRightFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "GumbelRight", &GumbelRight{Mu: 1, Beta: 2, Src: rand.NewSource(1)}, func() fitTester { return &GumbelRight{} }, 10000, 0.05, true)
+}
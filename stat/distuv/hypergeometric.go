@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// Hypergeometric implements the hypergeometric distribution, a discrete
+// probability distribution of the number of successes in a fixed number of
+// draws without replacement from a finite population containing a fixed number
+// of successes.
+// The hypergeometric distribution has probability mass function:
+//  f(k) = C(K, k) C(N-K, n-k) / C(N, n)
+// for max(0, n+K-N) ≤ k ≤ min(n, K), where N is the population size, K is the
+// number of successes in the population, n is the number of draws and C is
+// the binomial coefficient.
+//
+// The parameters of the hypergeometric distribution are integers, so it does
+// not provide a score function.
+//
+// For more information, see https://en.wikipedia.org/wiki/Hypergeometric_distribution.
+type Hypergeometric struct {
+	// N is the population size. N must be a non-negative integer.
+	N float64
+	// K is the number of successes in the population. K must be an
+	// integer between 0 and N.
+	K float64
+	// Draws is the number of draws. Draws must be an integer between 0 and N.
+	Draws float64
+
+	Src rand.Source
+}
+
+// support returns the smallest and largest values in the support of the
+// distribution.
+func (h Hypergeometric) support() (lo, hi float64) {
+	return math.Max(0, h.Draws+h.K-h.N), math.Min(h.Draws, h.K)
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (h Hypergeometric) CDF(x float64) float64 {
+	lo, hi := h.support()
+	if x < lo {
+		return 0
+	}
+	if x >= hi {
+		return 1
+	}
+	k := math.Floor(x)
+	// Sum the smaller tail for accuracy.
+	if k-lo < hi-k {
+		var cdf float64
+		for i := lo; i <= k; i++ {
+			cdf += h.Prob(i)
+		}
+		return math.Min(cdf, 1)
+	}
+	return math.Max(1-h.Survival(x), 0)
+}
+
+// Entropy returns the entropy of the distribution.
+func (h Hypergeometric) Entropy() float64 {
+	lo, hi := h.support()
+	var entropy float64
+	for k := lo; k <= hi; k++ {
+		lp := h.LogProb(k)
+		entropy -= math.Exp(lp) * lp
+	}
+	return entropy
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (h Hypergeometric) ExKurtosis() float64 {
+	n, k, d := h.N, h.K, h.Draws
+	num := (n-1)*n*n*(n*(n+1)-6*k*(n-k)-6*d*(n-d)) + 6*d*k*(n-k)*(n-d)*(5*n-6)
+	return num / (d * k * (n - k) * (n - d) * (n - 2) * (n - 3))
+}
+
+// Fit sets the number of successes in the population K to its maximum
+// likelihood estimate from the data samples with relative weights. The
+// population size N and the number of draws Draws are not estimated and must
+// be set before calling Fit.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (h *Hypergeometric) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// Each sample x requires at least x successes and Draws-x failures
+	// in the population.
+	lo, hi := 0.0, h.N
+	for i, x := range samples {
+		if weights != nil && weights[i] == 0 {
+			continue
+		}
+		lo = math.Max(lo, x)
+		hi = math.Min(hi, h.N-h.Draws+x)
+	}
+	if lo > hi {
+		panic("distuv: samples not consistent with population size and draws")
+	}
+	// The likelihood is log-concave in K, so it is maximized by stepping
+	// from the method of moments estimate while the likelihood increases.
+	k := lo
+	if h.Draws > 0 {
+		k = math.Max(lo, math.Min(hi, math.Round(stat.Mean(samples, weights)*h.N/h.Draws)))
+	}
+	ll := func(k float64) float64 {
+		return logLikelihood(Hypergeometric{N: h.N, K: k, Draws: h.Draws}, samples, weights)
+	}
+	for k < hi && ll(k+1) > ll(k) {
+		k++
+	}
+	for k > lo && ll(k-1) > ll(k) {
+		k--
+	}
+	h.K = k
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (h Hypergeometric) LogProb(x float64) float64 {
+	lo, hi := h.support()
+	if x < lo || x > hi || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	return logChoose(h.K, x) + logChoose(h.N-h.K, h.Draws-x) - logChoose(h.N, h.Draws)
+}
+
+// Mean returns the mean of the probability distribution.
+func (h Hypergeometric) Mean() float64 {
+	return h.Draws * h.K / h.N
+}
+
+// Mode returns the mode of the probability distribution.
+func (h Hypergeometric) Mode() float64 {
+	return math.Floor((h.Draws + 1) * (h.K + 1) / (h.N + 2))
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Hypergeometric) NumParameters() int {
+	return 3
+}
+
+// Prob computes the value of the probability density function at x.
+func (h Hypergeometric) Prob(x float64) float64 {
+	return math.Exp(h.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (h Hypergeometric) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	lo, hi := h.support()
+	if p == 1 {
+		return hi
+	}
+	var cdf float64
+	for k := lo; k < hi; k++ {
+		cdf += h.Prob(k)
+		if cdf >= p {
+			return k
+		}
+	}
+	return hi
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (h Hypergeometric) Rand() float64 {
+	var u float64
+	if h.Src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(h.Src).Float64()
+	}
+	// Invert the CDF, updating the probability mass with the recurrence
+	//  f(k+1) = f(k) (K-k)(n-k) / ((k+1)(N-K-n+k+1)).
+	lo, hi := h.support()
+	k := lo
+	prob := h.Prob(lo)
+	for k < hi {
+		if u < prob {
+			return k
+		}
+		u -= prob
+		prob *= (h.K - k) * (h.Draws - k) / ((k + 1) * (h.N - h.K - h.Draws + k + 1))
+		k++
+	}
+	return hi
+}
+
+// Skewness returns the skewness of the distribution.
+func (h Hypergeometric) Skewness() float64 {
+	n, k, d := h.N, h.K, h.Draws
+	return (n - 2*k) * math.Sqrt(n-1) * (n - 2*d) / (math.Sqrt(d*k*(n-k)*(n-d)) * (n - 2))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (h Hypergeometric) StdDev() float64 {
+	return math.Sqrt(h.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (h Hypergeometric) Survival(x float64) float64 {
+	lo, hi := h.support()
+	if x < lo {
+		return 1
+	}
+	if x >= hi {
+		return 0
+	}
+	k := math.Floor(x)
+	if k-lo < hi-k {
+		return math.Max(1-h.CDF(x), 0)
+	}
+	var surv float64
+	for i := k + 1; i <= hi; i++ {
+		surv += h.Prob(i)
+	}
+	return math.Min(surv, 1)
+}
+
+// Variance returns the variance of the probability distribution.
+func (h Hypergeometric) Variance() float64 {
+	n, k, d := h.N, h.K, h.Draws
+	return d * k / n * (n - k) / n * (n - d) / (n - 1)
+}
+
+// logChoose returns the natural logarithm of the binomial coefficient n
+// choose k.
+func logChoose(n, k float64) float64 {
+	a, _ := math.Lgamma(n + 1)
+	b, _ := math.Lgamma(k + 1)
+	c, _ := math.Lgamma(n - k + 1)
+	return a - b - c
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestHypergeometricSupport(t *testing.T) {
+	t.Parallel()
+	for i, h := range []Hypergeometric{
+		{N: 50, K: 5, Draws: 10},
+		{N: 20, K: 7, Draws: 12},
+		{N: 100, K: 60, Draws: 80},
+		{N: 500, K: 250, Draws: 100},
+	} {
+		lo, hi := h.support()
+		var sum float64
+		for k := lo; k <= hi; k++ {
+			sum += h.Prob(k)
+		}
+		if math.Abs(sum-1) > 1e-12 {
+			t.Errorf("Probabilities do not sum to 1 case %d: got %v", i, sum)
+		}
+		if h.NumParameters() != 3 {
+			t.Errorf("Mismatch in NumParameters: got %v, want 3", h.NumParameters())
+		}
+		if cdf := h.CDF(lo - 0.5); cdf != 0 {
+			t.Errorf("Mismatch in CDF below support case %d: got %v, want 0", i, cdf)
+		}
+		if surv := h.Survival(hi); surv != 0 {
+			t.Errorf("Mismatch in Survival at upper bound case %d: got %v, want 0", i, surv)
+		}
+		if q := h.Quantile(1); q != hi {
+			t.Errorf("Mismatch in Quantile(1) case %d: got %v, want %v", i, q, hi)
+		}
+		x := make([]float64, 1e4)
+		generateSamples(x, Hypergeometric{N: h.N, K: h.K, Draws: h.Draws, Src: rand.NewSource(1)})
+		if min, max := floats.Min(x), floats.Max(x); min < lo || max > hi {
+			t.Errorf("Sample outside of support case %d: [%v, %v] not in [%v, %v]", i, min, max, lo, hi)
+		}
+	}
+}
+
+func TestHypergeometricFit(t *testing.T) {
+	t.Parallel()
+	// For a single sample x the maximum likelihood estimate of K is
+	// min(N, ⌊x(N+1)/Draws⌋) when x(N+1)/Draws is not an integer.
+	for _, x := range []float64{0, 3, 7, 10} {
+		h := Hypergeometric{N: 50, Draws: 10}
+		h.Fit([]float64{x}, nil)
+		if want := math.Min(50, math.Floor(x*51/10)); h.K != want {
+			t.Errorf("unexpected estimate of K for sample %v: got:%v want:%v", x, h.K, want)
+		}
+	}
+
+	want := Hypergeometric{N: 100, K: 20, Draws: 30, Src: rand.NewSource(1)}
+	samples := make([]float64, 2000)
+	generateSamples(samples, want)
+	got := Hypergeometric{N: want.N, Draws: want.Draws}
+	got.Fit(samples, nil)
+	if math.Abs(got.K-want.K) > 1 {
+		t.Errorf("unexpected estimate of K: got:%v want:%v", got.K, want.K)
+	}
+	ll := logLikelihood(got, samples, nil)
+	for _, k := range []float64{got.K - 1, got.K + 1} {
+		d := Hypergeometric{N: got.N, K: k, Draws: got.Draws}
+		if llPert := logLikelihood(d, samples, nil); llPert > ll {
+			t.Errorf("fit is not a maximum of the likelihood: K=%v gives %v > %v", k, llPert, ll)
+		}
+	}
+
+	if !panics(func() { (&Hypergeometric{N: 10, Draws: 5}).Fit([]float64{0, 5, 6}, nil) }) {
+		t.Errorf("expected panic for samples outside the support")
+	}
+}
@@ -30,3 +30,30 @@ type Quantiler interface {
 	// all those values whose CDF value exceeds or equals p.
 	Quantile(p float64) float64
 }
+
+// CDFer wraps the CDF method.
+type CDFer interface {
+	// CDF returns the value of the cumulative
+	// distribution function at x.
+	CDF(x float64) float64
+}
+
+// Distribution is the interface that groups the Rander, LogProber, Quantiler
+// and CDFer methods. It is satisfied by most of the distributions in this
+// package and is the type of distribution wrapped by Truncated, LocationScale
+// and Mixture.
+type Distribution interface {
+	Rander
+	LogProber
+	Quantiler
+	CDFer
+}
+
+// Fitter wraps the Fit method.
+type Fitter interface {
+	// Fit sets the parameters of the distribution to the maximum
+	// likelihood estimates for the samples with relative weights.
+	// If weights is nil, then all the weights are 1. If weights is
+	// not nil, then the len(weights) must equal len(samples).
+	Fit(samples, weights []float64)
+}
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
 )
 
 // InverseGamma implements the inverse gamma distribution, a two-parameter
@@ -49,6 +50,23 @@ func (g InverseGamma) ExKurtosis() float64 {
 	return (30*g.Alpha - 66) / (g.Alpha - 3) / (g.Alpha - 4)
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (g *InverseGamma) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// The reciprocals of the samples are gamma distributed with shape
+	// Alpha and rate Beta.
+	inv := make([]float64, len(samples))
+	for i, x := range samples {
+		inv[i] = 1 / x
+	}
+	mean := stat.Mean(inv, weights)
+	g.Alpha = gammaShapeMLE(math.Log(mean) + weightedLogMean(samples, weights))
+	g.Beta = g.Alpha / mean
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (g InverseGamma) LogProb(x float64) float64 {
@@ -121,3 +139,33 @@ func (g InverseGamma) Variance() float64 {
 	v := g.Beta / (g.Alpha - 1)
 	return v * v / (g.Alpha - 2)
 }
+
+// parameters returns the parameters of the distribution.
+func (g InverseGamma) parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("inversegamma: improper parameter length")
+	}
+	p[0].Name = "Alpha"
+	p[0].Value = g.Alpha
+	p[1].Name = "Beta"
+	p[1].Value = g.Beta
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (g *InverseGamma) setParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("inversegamma: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Alpha" {
+		panic("inversegamma: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("inversegamma: " + panicNameMismatch)
+	}
+	g.Alpha = p[0].Value
+	g.Beta = p[1].Value
+}
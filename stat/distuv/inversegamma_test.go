@@ -108,3 +108,8 @@ func TestInverseGammaLowAlpha(t *testing.T) {
 		t.Errorf("Expected +Inf excess kurtosis for alpha <= 4, got %v", exKurt)
 	}
 }
+
+func TestInverseGammaFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "InverseGamma", &InverseGamma{Alpha: 3, Beta: 2, Src: rand.NewSource(1)}, func() fitTester { return &InverseGamma{} }, 10000, 0.05, true)
+}
@@ -0,0 +1,641 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// Kernel specifies the kernel function of a kernel density estimate. All of
+// the kernels are symmetric probability density functions scaled to have zero
+// mean and unit variance, so the bandwidth of a kernel density estimate is the
+// standard deviation of its kernel for every kind of kernel. The kernels other
+// than GaussianKernel have bounded support, and are given below on their
+// standard support [-1, 1] before scaling.
+type Kernel int
+
+const (
+	// GaussianKernel is the standard normal density function.
+	GaussianKernel Kernel = iota
+	// EpanechnikovKernel is the kernel
+	//  3/4 (1 - u^2)
+	// It is scaled by sqrt(5) to have unit variance.
+	EpanechnikovKernel
+	// BiweightKernel is the kernel
+	//  15/16 (1 - u^2)^2
+	// It is scaled by sqrt(7) to have unit variance.
+	BiweightKernel
+	// TriweightKernel is the kernel
+	//  35/32 (1 - u^2)^3
+	// It is scaled by 3 to have unit variance.
+	TriweightKernel
+	// TriangularKernel is the kernel
+	//  1 - |u|
+	// It is scaled by sqrt(6) to have unit variance.
+	TriangularKernel
+	// RectangularKernel is the kernel
+	//  1/2
+	// It is scaled by sqrt(3) to have unit variance.
+	RectangularKernel
+)
+
+const badKernel = "kde: unknown kernel"
+
+// gaussianTail is twice the logarithm of the ratio of the largest to the
+// smallest Gaussian kernel contribution that is included when evaluating a
+// kernel density estimate. Contributions smaller than e^(-gaussianTail/2) of
+// the largest contribution are below the floating point precision.
+const gaussianTail = 75
+
+// minNormal is the smallest normal positive float64.
+const minNormal = 0x1p-1022
+
+// scale returns the factor by which the standard form of the kernel is scaled
+// to have unit variance. For kernels with bounded support, this is the radius
+// of the support of the scaled kernel.
+func (k Kernel) scale() float64 {
+	switch k {
+	case GaussianKernel:
+		return 1
+	case EpanechnikovKernel:
+		return math.Sqrt(5)
+	case BiweightKernel:
+		return math.Sqrt(7)
+	case TriweightKernel:
+		return 3
+	case TriangularKernel:
+		return math.Sqrt(6)
+	case RectangularKernel:
+		return math.Sqrt(3)
+	}
+	panic(badKernel)
+}
+
+// prob returns the value of the kernel with unit variance at x.
+func (k Kernel) prob(x float64) float64 {
+	if k == GaussianKernel {
+		return oneOverRoot2Pi * math.Exp(-x*x/2)
+	}
+	a := k.scale()
+	u := x / a
+	if u <= -1 || 1 <= u {
+		return 0
+	}
+	var p float64
+	switch k {
+	case EpanechnikovKernel:
+		p = 0.75 * (1 - u*u)
+	case BiweightKernel:
+		v := 1 - u*u
+		p = 15.0 / 16 * v * v
+	case TriweightKernel:
+		v := 1 - u*u
+		p = 35.0 / 32 * v * v * v
+	case TriangularKernel:
+		p = 1 - math.Abs(u)
+	case RectangularKernel:
+		p = 0.5
+	}
+	return p / a
+}
+
+// logProb returns the logarithm of the value of the kernel with unit variance
+// at x.
+func (k Kernel) logProb(x float64) float64 {
+	if k == GaussianKernel {
+		return -x*x/2 - logRoot2Pi
+	}
+	return math.Log(k.prob(x))
+}
+
+// cdf returns the value of the cumulative distribution function of the kernel
+// with unit variance at x.
+func (k Kernel) cdf(x float64) float64 {
+	if k == GaussianKernel {
+		return 0.5 * math.Erfc(-x/math.Sqrt2)
+	}
+	u := x / k.scale()
+	if u <= -1 {
+		return 0
+	}
+	if u >= 1 {
+		return 1
+	}
+	var c float64
+	switch k {
+	case EpanechnikovKernel:
+		c = 0.5 + 0.25*u*(3-u*u)
+	case BiweightKernel:
+		u2 := u * u
+		c = 0.5 + 15.0/16*u*(1-u2*(2.0/3-u2/5))
+	case TriweightKernel:
+		u2 := u * u
+		c = 0.5 + 35.0/32*u*(1-u2*(1-u2*(3.0/5-u2/7)))
+	case TriangularKernel:
+		if u < 0 {
+			c = (1 + u) * (1 + u) / 2
+		} else {
+			c = 1 - (1-u)*(1-u)/2
+		}
+	case RectangularKernel:
+		c = (1 + u) / 2
+	}
+	return math.Max(0, math.Min(1, c))
+}
+
+// rand returns a random sample drawn from the kernel with unit variance using
+// the uniform and standard normal generators rnd and norm.
+func (k Kernel) rand(rnd, norm func() float64) float64 {
+	// The median of 2m+1 uniform random variables is Beta(m+1, m+1)
+	// distributed, which is the standard form of the Epanechnikov,
+	// biweight and triweight kernels shifted to [0, 1] for m = 1, 2, 3.
+	// The Kernel values of these kernels are equal to m.
+	switch k {
+	case GaussianKernel:
+		return norm()
+	case EpanechnikovKernel, BiweightKernel, TriweightKernel:
+		var buf [7]float64
+		u := buf[:2*int(k)+1]
+		for i := range u {
+			u[i] = rnd()
+		}
+		sort.Float64s(u)
+		return k.scale() * (2*u[len(u)/2] - 1)
+	case TriangularKernel:
+		return k.scale() * (rnd() + rnd() - 1)
+	case RectangularKernel:
+		return k.scale() * (2*rnd() - 1)
+	}
+	panic(badKernel)
+}
+
+// roughness returns the integral of the square of the kernel with unit
+// variance.
+func (k Kernel) roughness() float64 {
+	var r float64
+	switch k {
+	case GaussianKernel:
+		return 0.5 / math.SqrtPi
+	case EpanechnikovKernel:
+		r = 3.0 / 5
+	case BiweightKernel:
+		r = 5.0 / 7
+	case TriweightKernel:
+		r = 350.0 / 429
+	case TriangularKernel:
+		r = 2.0 / 3
+	case RectangularKernel:
+		r = 1.0 / 2
+	default:
+		panic(badKernel)
+	}
+	return r / k.scale()
+}
+
+// KDE is a kernel density estimate of a univariate distribution from a set of
+// weighted samples. The kernel density estimate has density function
+//  Σ_i w_i K((x - x_i)/h) / h
+// where x_i are the samples, w_i are the normalized weights of the samples, K
+// is the kernel and h is the bandwidth. KDE must be initialized with NewKDE.
+//
+// The density, CDF and survival function are evaluated using only the samples
+// that contribute to the estimate at x, so evaluation takes logarithmic time
+// in the number of samples when the bandwidth is small relative to the spread
+// of the samples.
+//
+// For more information, see https://en.wikipedia.org/wiki/Kernel_density_estimation.
+type KDE struct {
+	samples   []float64
+	weights   []float64
+	cumWeight []float64 // cumWeight[i] is the sum of the weights of samples[:i].
+	kernel    Kernel
+	bandwidth float64
+
+	src rand.Source
+}
+
+// NewKDE returns a kernel density estimate from the samples with relative
+// weights using the given kernel and bandwidth. If weights is nil, then all
+// the weights are 1. The weights must be non-negative and at least one of the
+// weights must be positive. The samples and weights are copied. The bandwidth
+// is the standard deviation of the kernel and can be chosen with
+// SilvermanBandwidth, ScottBandwidth or PluginBandwidth.
+//
+// NewKDE panics if len(samples) is zero, if weights is not nil and
+// len(weights) does not equal len(samples), or if bandwidth is not positive.
+func NewKDE(samples, weights []float64, kernel Kernel, bandwidth float64, src rand.Source) KDE {
+	checkFitInput(samples, weights)
+	if !(bandwidth > 0) {
+		panic("kde: bandwidth not positive")
+	}
+	kernel.scale() // Check the kernel is valid.
+	n := len(samples)
+	k := KDE{
+		samples:   make([]float64, n),
+		weights:   make([]float64, n),
+		cumWeight: make([]float64, n+1),
+		kernel:    kernel,
+		bandwidth: bandwidth,
+		src:       src,
+	}
+	copy(k.samples, samples)
+	if weights == nil {
+		for i := range k.weights {
+			k.weights[i] = 1
+		}
+	} else {
+		copy(k.weights, weights)
+	}
+	stat.SortWeighted(k.samples, k.weights)
+	var sum float64
+	for _, w := range k.weights {
+		if w < 0 {
+			panic("kde: negative weight")
+		}
+		sum += w
+	}
+	if !(sum > 0) {
+		panic("kde: no positive weight")
+	}
+	for i, w := range k.weights {
+		k.weights[i] = w / sum
+		k.cumWeight[i+1] = k.cumWeight[i] + k.weights[i]
+	}
+	return k
+}
+
+// Bandwidth returns the bandwidth of the kernel density estimate.
+func (k KDE) Bandwidth() float64 {
+	return k.bandwidth
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (k KDE) CDF(x float64) float64 {
+	if math.IsInf(x, 0) {
+		if x < 0 {
+			return 0
+		}
+		return 1
+	}
+	lo, hi := k.window(x)
+	cdf := k.cumWeight[lo]
+	for i := lo; i < hi; i++ {
+		cdf += k.weights[i] * k.kernel.cdf((x-k.samples[i])/k.bandwidth)
+	}
+	return math.Min(cdf, 1)
+}
+
+// Kernel returns the kernel of the kernel density estimate.
+func (k KDE) Kernel() Kernel {
+	return k.kernel
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (k KDE) LogProb(x float64) float64 {
+	p := k.Prob(x)
+	if p >= minNormal || math.IsInf(x, 0) {
+		return math.Log(p)
+	}
+	// Sum the contributions relative to the largest to avoid underflow
+	// far from the samples.
+	lo, hi := k.window(x)
+	max := math.Inf(-1)
+	for i := lo; i < hi; i++ {
+		max = math.Max(max, math.Log(k.weights[i])+k.kernel.logProb((x-k.samples[i])/k.bandwidth))
+	}
+	if math.IsInf(max, -1) {
+		return max
+	}
+	var sum float64
+	for i := lo; i < hi; i++ {
+		sum += math.Exp(math.Log(k.weights[i]) + k.kernel.logProb((x-k.samples[i])/k.bandwidth) - max)
+	}
+	return max + math.Log(sum) - math.Log(k.bandwidth)
+}
+
+// Mean returns the mean of the probability distribution.
+func (k KDE) Mean() float64 {
+	var mean float64
+	for i, x := range k.samples {
+		mean += k.weights[i] * x
+	}
+	return mean
+}
+
+// Prob computes the value of the probability density function at x.
+func (k KDE) Prob(x float64) float64 {
+	if math.IsInf(x, 0) {
+		return 0
+	}
+	lo, hi := k.window(x)
+	var p float64
+	for i := lo; i < hi; i++ {
+		p += k.weights[i] * k.kernel.prob((x-k.samples[i])/k.bandwidth)
+	}
+	return p / k.bandwidth
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+// The quantile is found numerically from the CDF.
+func (k KDE) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	n := len(k.samples)
+	if k.kernel == GaussianKernel {
+		switch p {
+		case 0:
+			return math.Inf(-1)
+		case 1:
+			return math.Inf(1)
+		}
+	}
+	lo := k.samples[0] - k.kernel.scale()*k.bandwidth
+	hi := k.samples[n-1] + k.kernel.scale()*k.bandwidth
+	if k.kernel == GaussianKernel {
+		for step := k.bandwidth; k.CDF(lo) >= p; step *= 2 {
+			lo -= step
+		}
+		for step := k.bandwidth; k.CDF(hi) < p; step *= 2 {
+			hi += step
+		}
+	}
+	if p == 0 || k.CDF(lo) >= p {
+		return lo
+	}
+	return continuousQuantile(p, k.CDF, lo, hi)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (k KDE) Rand() float64 {
+	rnd := rand.Float64
+	norm := rand.NormFloat64
+	if k.src != nil {
+		r := rand.New(k.src)
+		rnd = r.Float64
+		norm = r.NormFloat64
+	}
+	n := len(k.samples)
+	u := rnd()
+	i := sort.Search(n, func(i int) bool { return k.cumWeight[i+1] > u })
+	if i == n {
+		// Guard against rounding in the cumulative weights.
+		i = n - 1
+		for k.weights[i] == 0 {
+			i--
+		}
+	}
+	return k.samples[i] + k.bandwidth*k.kernel.rand(rnd, norm)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (k KDE) StdDev() float64 {
+	return math.Sqrt(k.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (k KDE) Survival(x float64) float64 {
+	if math.IsInf(x, 0) {
+		if x < 0 {
+			return 1
+		}
+		return 0
+	}
+	lo, hi := k.window(x)
+	// The kernels are symmetric, so the survival function of a kernel
+	// at x is its CDF at -x.
+	surv := k.cumWeight[len(k.samples)] - k.cumWeight[hi]
+	for i := lo; i < hi; i++ {
+		surv += k.weights[i] * k.kernel.cdf((k.samples[i]-x)/k.bandwidth)
+	}
+	return math.Max(0, math.Min(surv, 1))
+}
+
+// Variance returns the variance of the probability distribution.
+func (k KDE) Variance() float64 {
+	mean := k.Mean()
+	var variance float64
+	for i, x := range k.samples {
+		d := x - mean
+		variance += k.weights[i] * d * d
+	}
+	return variance + k.bandwidth*k.bandwidth
+}
+
+// window returns the range [lo, hi) of the indices of the sorted samples whose
+// kernels are not negligible at x. The samples below lo have kernels that
+// are entirely below x.
+func (k KDE) window(x float64) (lo, hi int) {
+	n := len(k.samples)
+	r := k.kernel.scale()
+	if k.kernel == GaussianKernel {
+		// Include the samples whose contributions are not negligible
+		// relative to the contribution of the sample nearest to x.
+		i := sort.SearchFloat64s(k.samples, x)
+		d := math.Inf(1)
+		if i < n {
+			d = k.samples[i] - x
+		}
+		if i > 0 {
+			d = math.Min(d, x-k.samples[i-1])
+		}
+		u := d / k.bandwidth
+		r = math.Sqrt(u*u + gaussianTail)
+	}
+	r *= k.bandwidth
+	lo = sort.SearchFloat64s(k.samples, x-r)
+	hi = lo + sort.Search(n-lo, func(i int) bool { return k.samples[lo+i] > x+r })
+	return lo, hi
+}
+
+// SilvermanBandwidth returns the bandwidth of a kernel density estimate of the
+// samples with relative weights using Silverman's rule of thumb
+//  0.9 min(σ, IQR/1.34) n^(-1/5)
+// where σ is the sample standard deviation, IQR is the sample interquartile
+// range and n is the effective number of samples
+//  (Σ_i w_i)^2 / Σ_i w_i^2
+// If weights is nil, then all the weights are 1 and n is the number of
+// samples. If weights is not nil, then len(weights) must equal len(samples).
+//
+// The rule minimizes the asymptotic mean integrated squared error for normal
+// data and is robust to moderate departures from normality, but it
+// oversmooths multimodal densities.
+//
+// See Silverman, B. W. Density Estimation for Statistics and Data Analysis,
+// Chapman and Hall, 1986.
+func SilvermanBandwidth(samples, weights []float64) float64 {
+	scale, n := kdeScale(samples, weights)
+	return 0.9 * scale * math.Pow(n, -0.2)
+}
+
+// ScottBandwidth returns the bandwidth of a kernel density estimate of the
+// samples with relative weights using Scott's rule
+//  1.06 min(σ, IQR/1.34) n^(-1/5)
+// where σ is the sample standard deviation, IQR is the sample interquartile
+// range and n is the effective number of samples as for SilvermanBandwidth.
+// If weights is nil, then all the weights are 1. If weights is not nil, then
+// len(weights) must equal len(samples).
+//
+// The rule is the bandwidth minimizing the asymptotic mean integrated squared
+// error of a Gaussian kernel density estimate of normal data.
+//
+// See Scott, D. W. Multivariate Density Estimation: Theory, Practice, and
+// Visualization, Wiley, 1992.
+func ScottBandwidth(samples, weights []float64) float64 {
+	scale, n := kdeScale(samples, weights)
+	return 1.06 * scale * math.Pow(n, -0.2)
+}
+
+// PluginBandwidth returns the bandwidth of a kernel density estimate of the
+// samples with relative weights and the given kernel using the two-stage
+// direct plug-in rule of Sheather and Jones. The bandwidth minimizes the
+// asymptotic mean integrated squared error
+//  h = (R(K) / (R(f'') n))^(1/5)
+// where R(g) is the integral of g^2, f is the density of the samples and n is
+// the effective number of samples as for SilvermanBandwidth. The functional
+// R(f'') is itself estimated with Gaussian kernel density estimates whose
+// bandwidths are chosen in two stages starting from a normal reference. If
+// weights is nil, then all the weights are 1. If weights is not nil, then
+// len(weights) must equal len(samples).
+//
+// The samples are linearly binned onto a grid of 401 points, so the cost is
+// linear in the number of samples. The plug-in rule adapts to the shape of
+// the density and gives smaller bandwidths than the rules of thumb for
+// multimodal data.
+//
+// See Wand, M. P. and Jones, M. C. Kernel Smoothing, Chapman and Hall, 1995,
+// section 3.6, and Sheather, S. J. and Jones, M. C. A reliable data-based
+// bandwidth selection method for kernel density estimation, Journal of the
+// Royal Statistical Society, Series B, 53(3), 683-690, 1991.
+func PluginBandwidth(samples, weights []float64, kernel Kernel) float64 {
+	roughness := kernel.roughness()
+	scale, n := kdeScale(samples, weights)
+
+	// Linearly bin the normalized weights onto a regular grid.
+	const m = 401
+	lo, hi := samples[0], samples[0]
+	for _, x := range samples {
+		lo = math.Min(lo, x)
+		hi = math.Max(hi, x)
+	}
+	if lo == hi {
+		return math.Pow(roughness/(3/(8*math.SqrtPi*math.Pow(scale, 5))*n), 0.2)
+	}
+	delta := (hi - lo) / (m - 1)
+	var sum float64
+	for i := range samples {
+		sum += weightAt(weights, i)
+	}
+	var counts [m]float64
+	for i, x := range samples {
+		w := weightAt(weights, i) / sum
+		pos := (x - lo) / delta
+		j := int(pos)
+		if j >= m-1 {
+			j = m - 2
+		}
+		frac := pos - float64(j)
+		counts[j] += w * (1 - frac)
+		counts[j+1] += w * frac
+	}
+	// corr[d] is the sum of the products of the bin counts at lag d.
+	var corr [m]float64
+	for d := range corr {
+		for j := 0; j+d < m; j++ {
+			corr[d] += counts[j] * counts[j+d]
+		}
+	}
+	// psi returns the binned estimate of the integral of f^(r) f for the
+	// Gaussian kernel derivative hermite with bandwidth g.
+	psi := func(r int, g float64, hermite func(float64) float64) float64 {
+		var s float64
+		for d, c := range corr {
+			u := float64(d) * delta / g
+			v := c * hermite(u) * math.Exp(-u*u/2)
+			if d > 0 {
+				v *= 2
+			}
+			s += v
+		}
+		return oneOverRoot2Pi * s / math.Pow(g, float64(r+1))
+	}
+
+	// Normal reference estimate of R(f^(4)) determines the bandwidth for
+	// estimating R(f^(3)), which determines the bandwidth for R(f'').
+	g1 := math.Pow(2*math.Pow(math.Sqrt2*scale, 9)/(7*n), 1.0/9)
+	psi6 := psi(6, g1, func(u float64) float64 {
+		u2 := u * u
+		return ((u2-15)*u2+45)*u2 - 15
+	})
+	if !(psi6 < 0) {
+		return SilvermanBandwidth(samples, weights)
+	}
+	g2 := math.Pow(-3*math.Sqrt(2/math.Pi)/(psi6*n), 1.0/7)
+	psi4 := psi(4, g2, func(u float64) float64 {
+		u2 := u * u
+		return (u2-6)*u2 + 3
+	})
+	if !(psi4 > 0) {
+		return SilvermanBandwidth(samples, weights)
+	}
+	return math.Pow(roughness/(psi4*n), 0.2)
+}
+
+// kdeScale returns the robust scale estimate min(σ, IQR/1.34) of the samples
+// with relative weights and the effective number of samples. If the estimate
+// is zero, the standard deviation is returned, or 1 if the samples are all
+// equal.
+func kdeScale(samples, weights []float64) (scale, n float64) {
+	checkFitInput(samples, weights)
+	x := make([]float64, len(samples))
+	copy(x, samples)
+	var w []float64
+	if weights != nil {
+		w = make([]float64, len(weights))
+		copy(w, weights)
+	}
+	stat.SortWeighted(x, w)
+
+	var sum, sumSq, mean float64
+	for i, v := range x {
+		wi := weightAt(w, i)
+		sum += wi
+		sumSq += wi * wi
+		mean += wi * v
+	}
+	mean /= sum
+	var ss float64
+	for i, v := range x {
+		d := v - mean
+		ss += weightAt(w, i) * d * d
+	}
+	n = sum * sum / sumSq
+	var std float64
+	if n > 1 {
+		std = math.Sqrt(ss / (sum - sumSq/sum))
+	}
+	iqr := stat.Quantile(0.75, stat.Empirical, x, w) - stat.Quantile(0.25, stat.Empirical, x, w)
+	scale = math.Min(std, iqr/1.34)
+	if scale == 0 {
+		scale = std
+	}
+	if scale == 0 {
+		scale = 1
+	}
+	return scale, n
+}
+
+// weightAt returns the i-th weight, or 1 if weights is nil.
+func weightAt(weights []float64, i int) float64 {
+	if weights == nil {
+		return 1
+	}
+	return weights[i]
+}
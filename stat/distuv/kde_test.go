@@ -0,0 +1,264 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var kernels = []Kernel{
+	GaussianKernel,
+	EpanechnikovKernel,
+	BiweightKernel,
+	TriweightKernel,
+	TriangularKernel,
+	RectangularKernel,
+}
+
+func TestKDE(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	samples := randn(NewMixture([]Distribution{
+		Normal{Mu: -2, Sigma: 1, Src: src},
+		Gamma{Alpha: 3, Beta: 2, Src: src},
+	}, []float64{1, 2}, src), 100)
+	weights := make([]float64, len(samples))
+	for i := range weights {
+		weights[i] = src.Float64()
+	}
+	for i, kernel := range kernels {
+		w := weights
+		if i%2 == 0 {
+			w = nil
+		}
+		k := NewKDE(samples, w, kernel, 0.3, src)
+		testKDE(t, k, i)
+	}
+}
+
+func testKDE(t *testing.T, k KDE, i int) {
+	const (
+		tol  = 1e-2
+		n    = 5e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, k)
+	sort.Float64s(x)
+
+	lower := k.Quantile(0)
+	testRandLogProbContinuous(t, i, math.Max(lower, x[0]-10), x, k, tol, bins)
+	checkProbContinuous(t, i, x, lower, k.Quantile(1), k, 1e-6)
+	if k.Kernel() != RectangularKernel {
+		// The quadrature in checkProbQuantContinuous is not accurate
+		// enough for the discontinuous density of the rectangular kernel.
+		checkProbQuantContinuous(t, i, x, k, tol)
+	}
+	checkQuantileCDFSurvival(t, i, x, k, 5e-3)
+	checkMean(t, i, x, k, tol)
+	checkVarAndStd(t, i, x, k, tol)
+}
+
+func TestKDEKernels(t *testing.T) {
+	t.Parallel()
+	// The kernel density estimate of a single sample is the kernel, which
+	// matches a shifted and scaled distribution.
+	const (
+		x0 = 1.5
+		h  = 0.7
+	)
+	for _, test := range []struct {
+		kernel Kernel
+		dist   cumulantProber
+	}{
+		{GaussianKernel, Normal{Mu: x0, Sigma: h}},
+		{EpanechnikovKernel, LocationScale{Dist: Beta{Alpha: 2, Beta: 2}, Loc: x0 - math.Sqrt(5)*h, Scale: 2 * math.Sqrt(5) * h}},
+		{BiweightKernel, LocationScale{Dist: Beta{Alpha: 3, Beta: 3}, Loc: x0 - math.Sqrt(7)*h, Scale: 2 * math.Sqrt(7) * h}},
+		{TriweightKernel, LocationScale{Dist: Beta{Alpha: 4, Beta: 4}, Loc: x0 - 3*h, Scale: 6 * h}},
+		{TriangularKernel, NewTriangle(x0-math.Sqrt(6)*h, x0+math.Sqrt(6)*h, x0, nil)},
+		{RectangularKernel, Uniform{Min: x0 - math.Sqrt(3)*h, Max: x0 + math.Sqrt(3)*h}},
+	} {
+		k := NewKDE([]float64{x0}, nil, test.kernel, h, nil)
+		for _, x := range []float64{-1, 0, 0.5, 1, 1.4, 1.5, 1.6, 2, 2.5, 3, 4} {
+			if got, want := k.Prob(x), test.dist.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-12) {
+				t.Errorf("Prob mismatch for kernel %d at %v: got %v, want %v", test.kernel, x, got, want)
+			}
+			if got, want := k.CDF(x), test.dist.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-12) {
+				t.Errorf("CDF mismatch for kernel %d at %v: got %v, want %v", test.kernel, x, got, want)
+			}
+		}
+		for _, p := range []float64{0.01, 0.1, 0.5, 0.75, 0.99} {
+			if got, want := k.Quantile(p), test.dist.Quantile(p); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("Quantile mismatch for kernel %d at %v: got %v, want %v", test.kernel, p, got, want)
+			}
+		}
+		if got := k.Variance(); !scalar.EqualWithinAbsOrRel(got, h*h, 1e-14, 1e-14) {
+			t.Errorf("Variance mismatch for kernel %d: got %v, want %v", test.kernel, got, h*h)
+		}
+	}
+}
+
+func TestKDEWeights(t *testing.T) {
+	t.Parallel()
+	// Integer weights are equivalent to repeated samples.
+	src := rand.New(rand.NewSource(1))
+	samples := randn(Normal{Mu: 0, Sigma: 2, Src: src}, 50)
+	weights := make([]float64, len(samples))
+	var repeated []float64
+	for i, x := range samples {
+		weights[i] = float64(i % 4)
+		for j := 0; j < i%4; j++ {
+			repeated = append(repeated, x)
+		}
+	}
+	for _, kernel := range kernels {
+		weighted := NewKDE(samples, weights, kernel, 0.5, nil)
+		unweighted := NewKDE(repeated, nil, kernel, 0.5, nil)
+		for x := -6.0; x <= 6; x += 0.25 {
+			if got, want := weighted.LogProb(x), unweighted.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("LogProb mismatch for kernel %d at %v: got %v, want %v", kernel, x, got, want)
+			}
+			if got, want := weighted.CDF(x), unweighted.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("CDF mismatch for kernel %d at %v: got %v, want %v", kernel, x, got, want)
+			}
+		}
+		if got, want := weighted.Mean(), unweighted.Mean(); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("Mean mismatch for kernel %d: got %v, want %v", kernel, got, want)
+		}
+	}
+
+	// Samples with zero weight are never drawn.
+	k := NewKDE([]float64{-100, 0, 100}, []float64{0, 1, 0}, RectangularKernel, 1, rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		if x := k.Rand(); math.Abs(x) > math.Sqrt(3) {
+			t.Fatalf("Sample drawn from kernel with zero weight: %v", x)
+		}
+	}
+}
+
+func TestKDEGaussianTail(t *testing.T) {
+	t.Parallel()
+	// The log density far from the samples is accurate when the density
+	// underflows.
+	samples := []float64{-1, 0, 0.5, 2}
+	const h = 0.1
+	k := NewKDE(samples, nil, GaussianKernel, h, nil)
+	for _, x := range []float64{-50, -10, 3, 5, 20, 100} {
+		logProbs := make([]float64, len(samples))
+		for i, s := range samples {
+			logProbs[i] = Normal{Mu: s, Sigma: h}.LogProb(x)
+		}
+		want := floats.LogSumExp(logProbs) - math.Log(float64(len(samples)))
+		if got := k.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("LogProb mismatch at %v: got %v, want %v", x, got, want)
+		}
+	}
+	if s := k.Survival(-5); s != 1 {
+		t.Errorf("Survival far below the samples not 1: %v", s)
+	}
+	if c := k.CDF(5); c != 1 {
+		t.Errorf("CDF far above the samples not 1: %v", c)
+	}
+}
+
+func TestKDEBandwidth(t *testing.T) {
+	t.Parallel()
+	samples := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if got, want := SilvermanBandwidth(samples, nil), 1.719286404692283; !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected Silverman bandwidth: got %v, want %v", got, want)
+	}
+	if got, want := ScottBandwidth(samples, nil), 2.0249373210820227; !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected Scott bandwidth: got %v, want %v", got, want)
+	}
+	// The interquartile range is used for heavy tailed samples.
+	heavy := []float64{-20, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if got, want := SilvermanBandwidth(heavy, nil), 2.1188866045976638; !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected Silverman bandwidth for heavy tails: got %v, want %v", got, want)
+	}
+	// Constant weights have no effect.
+	weights := make([]float64, len(samples))
+	for i := range weights {
+		weights[i] = 3
+	}
+	if got, want := SilvermanBandwidth(samples, weights), SilvermanBandwidth(samples, nil); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected Silverman bandwidth with constant weights: got %v, want %v", got, want)
+	}
+	if got, want := PluginBandwidth(samples, weights, GaussianKernel), PluginBandwidth(samples, nil, GaussianKernel); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected plug-in bandwidth with constant weights: got %v, want %v", got, want)
+	}
+
+	// The plug-in bandwidth is close to the optimal bandwidth for normal
+	// and bimodal samples.
+	src := rand.New(rand.NewSource(1))
+	const n = 10000
+	normal := randn(Normal{Mu: 3, Sigma: 2, Src: src}, n)
+	optimal := 2 * math.Pow(4.0/(3*n), 0.2)
+	if got := PluginBandwidth(normal, nil, GaussianKernel); !scalar.EqualWithinRel(got, optimal, 0.05) {
+		t.Errorf("unexpected plug-in bandwidth for normal samples: got %v, want %v", got, optimal)
+	}
+	bimodal := randn(NewMixture([]Distribution{
+		Normal{Mu: -3, Sigma: 1, Src: src},
+		Normal{Mu: 3, Sigma: 1, Src: src},
+	}, []float64{1, 1}, src), n)
+	// The optimal bandwidth for the equal mixture of N(-3, 1) and N(3, 1).
+	optimal = 0.19249291342781386
+	if got := PluginBandwidth(bimodal, nil, GaussianKernel); !scalar.EqualWithinRel(got, optimal, 0.1) {
+		t.Errorf("unexpected plug-in bandwidth for bimodal samples: got %v, want about %v", got, optimal)
+	}
+
+	// The binned plug-in bandwidth matches the direct estimate.
+	small := normal[:200]
+	if got, want := PluginBandwidth(small, nil, EpanechnikovKernel), directPluginBandwidth(small, EpanechnikovKernel); !scalar.EqualWithinRel(got, want, 1e-3) {
+		t.Errorf("binned plug-in bandwidth mismatch: got %v, want %v", got, want)
+	}
+}
+
+// directPluginBandwidth computes the plug-in bandwidth without binning.
+func directPluginBandwidth(x []float64, kernel Kernel) float64 {
+	scale, _ := kdeScale(x, nil)
+	n := float64(len(x))
+	psi := func(r int, g float64, hermite func(float64) float64) float64 {
+		var s float64
+		for _, xi := range x {
+			for _, xj := range x {
+				u := (xi - xj) / g
+				s += hermite(u) * math.Exp(-u*u/2)
+			}
+		}
+		return oneOverRoot2Pi * s / (n * n * math.Pow(g, float64(r+1)))
+	}
+	g1 := math.Pow(2*math.Pow(math.Sqrt2*scale, 9)/(7*n), 1.0/9)
+	psi6 := psi(6, g1, func(u float64) float64 { return math.Pow(u, 6) - 15*math.Pow(u, 4) + 45*u*u - 15 })
+	g2 := math.Pow(-3*math.Sqrt(2/math.Pi)/(psi6*n), 1.0/7)
+	psi4 := psi(4, g2, func(u float64) float64 { return math.Pow(u, 4) - 6*u*u + 3 })
+	return math.Pow(kernel.roughness()/(psi4*n), 0.2)
+}
+
+func TestKDEPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"no samples", func() { NewKDE(nil, nil, GaussianKernel, 1, nil) }},
+		{"mismatched weights", func() { NewKDE([]float64{1, 2}, []float64{1}, GaussianKernel, 1, nil) }},
+		{"zero bandwidth", func() { NewKDE([]float64{1, 2}, nil, GaussianKernel, 0, nil) }},
+		{"negative weight", func() { NewKDE([]float64{1, 2}, []float64{1, -1}, GaussianKernel, 1, nil) }},
+		{"zero weights", func() { NewKDE([]float64{1, 2}, []float64{0, 0}, GaussianKernel, 1, nil) }},
+		{"unknown kernel", func() { NewKDE([]float64{1, 2}, nil, Kernel(-1), 1, nil) }},
+		{"bad percentile", func() { NewKDE([]float64{1, 2}, nil, GaussianKernel, 1, nil).Quantile(1.5) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("Expected panic for %s", test.name)
+		}
+	}
+}
@@ -200,3 +200,8 @@ func TestLaplaceFitPanic(t *testing.T) {
 		t.Errorf("Expected panic in Fit for len(sample) == 0")
 	}
 }
+
+func TestLaplaceFitMLE(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Laplace", &Laplace{Mu: 1, Scale: 2, Src: rand.NewSource(1)}, func() fitTester { return &Laplace{} }, 10000, 0.05, false)
+}
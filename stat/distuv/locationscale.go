@@ -0,0 +1,63 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import "math"
+
+// LocationScale is the distribution of Loc + Scale*X where X is a random
+// variable with the continuous distribution Dist. LocationScale shifts Dist by
+// Loc and scales it by Scale, and has density function
+//  f((x - Loc)/Scale) / Scale
+// where f is the density function of Dist. Scale must be greater than 0.
+//
+// LocationScale uses the random source of Dist.
+//
+// For more information, see https://en.wikipedia.org/wiki/Location–scale_family.
+type LocationScale struct {
+	Dist  Distribution
+	Loc   float64
+	Scale float64
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (l LocationScale) CDF(x float64) float64 {
+	return l.Dist.CDF((x - l.Loc) / l.Scale)
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (l LocationScale) LogProb(x float64) float64 {
+	return l.Dist.LogProb((x-l.Loc)/l.Scale) - math.Log(l.Scale)
+}
+
+// Prob computes the value of the probability density function at x.
+func (l LocationScale) Prob(x float64) float64 {
+	return math.Exp(l.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+func (l LocationScale) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	return l.Loc + l.Scale*l.Dist.Quantile(p)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (l LocationScale) Rand() float64 {
+	return l.Loc + l.Scale*l.Dist.Rand()
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (l LocationScale) Survival(x float64) float64 {
+	if s, ok := l.Dist.(survivaler); ok {
+		return s.Survival((x - l.Loc) / l.Scale)
+	}
+	return 1 - l.CDF(x)
+}
+
+// survivaler wraps the Survival method.
+type survivaler interface {
+	Survival(x float64) float64
+}
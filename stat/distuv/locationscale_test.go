@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLocationScale(t *testing.T) {
+	t.Parallel()
+	// A location-scale transform of the standard normal is a normal.
+	std := Normal{Mu: 0, Sigma: 1}
+	ls := LocationScale{Dist: std, Loc: 3, Scale: 2}
+	n := Normal{Mu: 3, Sigma: 2}
+	for _, x := range []float64{-5, 0, 3, 4.5, 10} {
+		if got, want := ls.LogProb(x), n.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("LogProb mismatch at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := ls.CDF(x), n.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := ls.Survival(x), n.Survival(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Survival mismatch at %v: got %v, want %v", x, got, want)
+		}
+	}
+	for _, p := range []float64{0, 0.01, 0.3, 0.5, 0.9, 1} {
+		if got, want := ls.Quantile(p), n.Quantile(p); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Quantile mismatch at %v: got %v, want %v", p, got, want)
+		}
+	}
+
+	src := rand.New(rand.NewSource(1))
+	for i, l := range []LocationScale{
+		{Dist: Gamma{Alpha: 2, Beta: 1, Src: src}, Loc: 5, Scale: 1},
+		{Dist: Gamma{Alpha: 0.8, Beta: 3, Src: src}, Loc: -1, Scale: 4},
+		{Dist: Beta{Alpha: 2, Beta: 5, Src: src}, Loc: -2, Scale: 0.5},
+	} {
+		testLocationScale(t, l, i)
+	}
+}
+
+func testLocationScale(t *testing.T, l LocationScale, i int) {
+	const (
+		tol  = 1e-2
+		n    = 5e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, l)
+	sort.Float64s(x)
+
+	lower := l.Quantile(0)
+	if x[0] < lower {
+		t.Errorf("Sample below support case %d: %v < %v", i, x[0], lower)
+	}
+	testRandLogProbContinuous(t, i, lower, x, l, tol, bins)
+	checkProbContinuous(t, i, x, lower, l.Quantile(1), l, 1e-6)
+	checkProbQuantContinuous(t, i, x, l, tol)
+	checkQuantileCDFSurvival(t, i, x, l, 5e-3)
+	if d, ok := l.Dist.(meaner); ok {
+		checkMean(t, i, x, meanOf(l.Loc+l.Scale*d.Mean()), tol)
+	}
+	if p := l.Prob(lower - 1); p != 0 {
+		t.Errorf("Non-zero probability below support case %d: %v", i, p)
+	}
+	if math.IsNaN(l.CDF(lower - 1)) {
+		t.Errorf("NaN CDF below support case %d", i)
+	}
+}
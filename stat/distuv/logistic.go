@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Logistic implements the logistic distribution, a two-parameter continuous
+// distribution with support over the real numbers. Its cumulative distribution
+// function is the logistic function.
+//
+// The logistic distribution has density function
+//  exp(-z) / (s * (1 + exp(-z))^2)
+//  z = (x - μ)/s
+// where μ is the location parameter Mu and s is the scale parameter Scale.
+// Scale must be greater than 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Logistic_distribution.
+type Logistic struct {
+	Mu    float64 // Mean value
+	Scale float64 // Scale parameter proportional to standard deviation
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (l Logistic) CDF(x float64) float64 {
+	return 1 / (1 + math.Exp(-(x-l.Mu)/l.Scale))
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (l Logistic) Entropy() float64 {
+	return math.Log(l.Scale) + 2
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (Logistic) ExKurtosis() float64 {
+	return 6.0 / 5
+}
+
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (l *Logistic) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// Start from the median and the scale matching the interquartile range,
+	// and optimize over the log of the scale so that it stays positive.
+	mu := weightedQuantile(0.5, samples, weights)
+	iqr := weightedQuantile(0.75, samples, weights) - weightedQuantile(0.25, samples, weights)
+	scale := iqr / (2 * math.Log(3))
+	if !(scale > 0) {
+		scale = 1
+	}
+	x := []float64{0, 0}
+	maximize(func(x []float64) float64 {
+		d := Logistic{Mu: mu + scale*x[0], Scale: scale * math.Exp(x[1])}
+		return logLikelihood(d, samples, weights)
+	}, x)
+	l.Mu = mu + scale*x[0]
+	l.Scale = scale * math.Exp(x[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (l Logistic) LogProb(x float64) float64 {
+	z := math.Abs(x-l.Mu) / l.Scale
+	return -z - math.Log(l.Scale) - 2*math.Log1p(math.Exp(-z))
+}
+
+// Mean returns the mean of the probability distribution.
+func (l Logistic) Mean() float64 {
+	return l.Mu
+}
+
+// Median returns the median of the probability distribution.
+func (l Logistic) Median() float64 {
+	return l.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (l Logistic) Mode() float64 {
+	return l.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Logistic) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (l Logistic) Prob(x float64) float64 {
+	return math.Exp(l.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+func (l Logistic) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	return l.Mu + l.Scale*math.Log(p/(1-p))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (l Logistic) Rand() float64 {
+	var u float64
+	if l.Src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(l.Src).Float64()
+	}
+	return l.Mu + l.Scale*math.Log(u/(1-u))
+}
+
+// Score returns the score function with respect to the parameters of the
+// distribution at the input location x. The score function is the derivative
+// of the log-likelihood at x with respect to the parameters
+//  (∂/∂θ) log(p(x;θ))
+// If deriv is non-nil, len(deriv) must equal the number of parameters otherwise
+// Score will panic, and the derivative is stored in-place into deriv. If deriv
+// is nil a new slice will be allocated and returned.
+//
+// The order is [∂LogProb / ∂Mu, ∂LogProb / ∂Scale].
+//
+// For more information, see https://en.wikipedia.org/wiki/Score_%28statistics%29.
+func (l Logistic) Score(deriv []float64, x float64) []float64 {
+	if deriv == nil {
+		deriv = make([]float64, l.NumParameters())
+	}
+	if len(deriv) != l.NumParameters() {
+		panic(badLength)
+	}
+	z := (x - l.Mu) / l.Scale
+	th := math.Tanh(z / 2)
+	deriv[0] = th / l.Scale
+	deriv[1] = (z*th - 1) / l.Scale
+	return deriv
+}
+
+// ScoreInput returns the score function with respect to the input of the
+// distribution at the input location specified by x. The score function is the
+// derivative of the log-likelihood
+//  (d/dx) log(p(x)) .
+func (l Logistic) ScoreInput(x float64) float64 {
+	return -math.Tanh((x-l.Mu)/(2*l.Scale)) / l.Scale
+}
+
+// Skewness returns the skewness of the distribution.
+func (Logistic) Skewness() float64 {
+	return 0
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (l Logistic) StdDev() float64 {
+	return l.Scale * math.Pi / math.Sqrt(3)
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (l Logistic) Survival(x float64) float64 {
+	return 1 / (1 + math.Exp((x-l.Mu)/l.Scale))
+}
+
+// Variance returns the variance of the probability distribution.
+func (l Logistic) Variance() float64 {
+	return l.Scale * l.Scale * math.Pi * math.Pi / 3
+}
+
+// parameters returns the parameters of the distribution.
+func (l Logistic) parameters(p []Parameter) []Parameter {
+	nParam := l.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("logistic: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = l.Mu
+	p[1].Name = "Scale"
+	p[1].Value = l.Scale
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (l *Logistic) setParameters(p []Parameter) {
+	if len(p) != l.NumParameters() {
+		panic("logistic: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("logistic: " + panicNameMismatch)
+	}
+	if p[1].Name != "Scale" {
+		panic("logistic: " + panicNameMismatch)
+	}
+	l.Mu = p[0].Value
+	l.Scale = p[1].Value
+}
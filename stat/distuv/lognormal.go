@@ -8,6 +8,8 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
 )
 
 // LogNormal represents a random variable whose log is normally distributed.
@@ -35,6 +37,20 @@ func (l LogNormal) ExKurtosis() float64 {
 	return math.Exp(4*s2) + 2*math.Exp(3*s2) + 3*math.Exp(2*s2) - 6
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (l *LogNormal) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	logs := make([]float64, len(samples))
+	for i, x := range samples {
+		logs[i] = math.Log(x)
+	}
+	l.Mu = stat.Mean(logs, weights)
+	l.Sigma = math.Sqrt(stat.MomentAbout(2, logs, l.Mu, weights))
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (l LogNormal) LogProb(x float64) float64 {
 	if x < 0 {
@@ -111,3 +127,33 @@ func (l LogNormal) Variance() float64 {
 	s2 := l.Sigma * l.Sigma
 	return (math.Exp(s2) - 1) * math.Exp(2*l.Mu+s2)
 }
+
+// parameters returns the parameters of the distribution.
+func (l LogNormal) parameters(p []Parameter) []Parameter {
+	nParam := l.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("lognormal: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = l.Mu
+	p[1].Name = "Sigma"
+	p[1].Value = l.Sigma
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (l *LogNormal) setParameters(p []Parameter) {
+	if len(p) != l.NumParameters() {
+		panic("lognormal: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("lognormal: " + panicNameMismatch)
+	}
+	if p[1].Name != "Sigma" {
+		panic("lognormal: " + panicNameMismatch)
+	}
+	l.Mu = p[0].Value
+	l.Sigma = p[1].Value
+}
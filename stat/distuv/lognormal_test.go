@@ -62,3 +62,8 @@ func TestLognormalIssue577(t *testing.T) {
 		t.Errorf("LogNormal{0,1}.CDF(%e) is greater than %e. got: %e", x, max, cdf)
 	}
 }
+
+func TestLogNormalFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "LogNormal", &LogNormal{Mu: 0.5, Sigma: 0.8, Src: rand.NewSource(1)}, func() fitTester { return &LogNormal{} }, 10000, 0.05, true)
+}
@@ -0,0 +1,212 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// Mixture is a finite mixture of univariate distributions. A sample from the
+// mixture is drawn by choosing a component with probability equal to its
+// weight and drawing a sample from that component. The mixture has density
+// function
+//  Σ_k w_k f_k(x)
+// where w_k and f_k are the weight and density function of component k.
+// Mixture must be initialized with NewMixture.
+//
+// For more information, see https://en.wikipedia.org/wiki/Mixture_distribution.
+type Mixture struct {
+	components []Distribution
+	weights    Categorical
+}
+
+// NewMixture returns a new mixture of the components where the weight of
+// component i is proportional to weights[i]. All of the weights must be
+// non-negative, and at least one of the weights must be positive. The random
+// source src is used to choose components when sampling, and the components
+// use their own random sources. The components are not copied, so Fit modifies
+// the components passed to NewMixture.
+//
+// NewMixture panics if len(components) is zero or if len(weights) does not
+// equal len(components).
+func NewMixture(components []Distribution, weights []float64, src rand.Source) Mixture {
+	if len(components) == 0 {
+		panic("mixture: no components")
+	}
+	if len(weights) != len(components) {
+		panic(badLength)
+	}
+	return Mixture{
+		components: components,
+		weights:    NewCategorical(weights, src),
+	}
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (m Mixture) CDF(x float64) float64 {
+	var cdf float64
+	for k, c := range m.components {
+		if w := m.Weight(k); w != 0 {
+			cdf += w * c.CDF(x)
+		}
+	}
+	return math.Min(cdf, 1)
+}
+
+// Component returns the i-th component of the mixture.
+func (m Mixture) Component(i int) Distribution {
+	return m.components[i]
+}
+
+// Fit sets the weights and the parameters of the components of the mixture to
+// the maximum likelihood estimates from the data samples with relative weights
+// using the expectation-maximization algorithm.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// All the components must be pointers to distributions implementing Fitter,
+// otherwise Fit will panic. The parameters of the components and the weights
+// of the mixture are used as the initial estimates and must be distinct, since
+// the expectation-maximization algorithm cannot separate identical components.
+// The algorithm converges to a local maximum of the likelihood, which depends
+// on the initial estimates. The likelihood of some mixtures is unbounded, for
+// example when a Normal component collapses onto a single sample.
+func (m *Mixture) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	fitters := make([]Fitter, len(m.components))
+	for k, c := range m.components {
+		f, ok := c.(Fitter)
+		if !ok {
+			panic("mixture: component does not implement Fitter")
+		}
+		fitters[k] = f
+	}
+
+	const (
+		maxIter = 1000
+		tol     = 1e-10
+	)
+	n := len(samples)
+	nComp := len(m.components)
+	// resp[k] holds the weighted responsibilities of component k
+	// for each of the samples.
+	resp := make([][]float64, nComp)
+	for k := range resp {
+		resp[k] = make([]float64, n)
+	}
+	logProbs := make([]float64, nComp)
+	mixWeights := make([]float64, nComp)
+	llOld := math.Inf(-1)
+	for iter := 0; iter < maxIter; iter++ {
+		// Expectation step.
+		var ll float64
+		for i, x := range samples {
+			for k, c := range m.components {
+				logProbs[k] = math.Log(m.Weight(k)) + c.LogProb(x)
+			}
+			lse := floats.LogSumExp(logProbs)
+			wt := 1.0
+			if weights != nil {
+				wt = weights[i]
+			}
+			for k := range resp {
+				resp[k][i] = wt * math.Exp(logProbs[k]-lse)
+			}
+			if wt != 0 {
+				ll += wt * lse
+			}
+		}
+		if ll-llOld <= tol*math.Abs(ll) {
+			break
+		}
+		llOld = ll
+
+		// Maximization step. Components with no responsibility
+		// keep their parameters.
+		for k, f := range fitters {
+			mixWeights[k] = floats.Sum(resp[k])
+			if mixWeights[k] > 0 {
+				f.Fit(samples, resp[k])
+			}
+		}
+		m.weights.ReweightAll(mixWeights)
+	}
+}
+
+// Len returns the number of components in the mixture.
+func (m Mixture) Len() int {
+	return len(m.components)
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (m Mixture) LogProb(x float64) float64 {
+	logProbs := make([]float64, 0, len(m.components))
+	for k, c := range m.components {
+		if w := m.Weight(k); w != 0 {
+			logProbs = append(logProbs, math.Log(w)+c.LogProb(x))
+		}
+	}
+	return floats.LogSumExp(logProbs)
+}
+
+// Prob computes the value of the probability density function at x.
+func (m Mixture) Prob(x float64) float64 {
+	return math.Exp(m.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+// The quantile is found numerically from the CDF.
+func (m Mixture) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	// The quantile of the mixture lies between the smallest and the largest
+	// quantiles of the components with non-zero weight.
+	lo := math.Inf(1)
+	hi := math.Inf(-1)
+	for k, c := range m.components {
+		if m.Weight(k) == 0 {
+			continue
+		}
+		q := c.Quantile(p)
+		lo = math.Min(lo, q)
+		hi = math.Max(hi, q)
+	}
+	if lo == hi || m.CDF(lo) >= p {
+		return lo
+	}
+	return continuousQuantile(p, m.CDF, lo, hi)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (m Mixture) Rand() float64 {
+	return m.components[int(m.weights.Rand())].Rand()
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (m Mixture) Survival(x float64) float64 {
+	var surv float64
+	for k, c := range m.components {
+		w := m.Weight(k)
+		if w == 0 {
+			continue
+		}
+		if s, ok := c.(survivaler); ok {
+			surv += w * s.Survival(x)
+		} else {
+			surv += w * (1 - c.CDF(x))
+		}
+	}
+	return math.Min(surv, 1)
+}
+
+// Weight returns the weight of the i-th component of the mixture.
+func (m Mixture) Weight(i int) float64 {
+	return m.weights.Prob(float64(i))
+}
@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var (
+	_ Distribution = Bernoulli{}
+	_ Distribution = Beta{}
+	_ Distribution = Cauchy{}
+	_ Distribution = Chi{}
+	_ Distribution = ChiSquared{}
+	_ Distribution = Exponential{}
+	_ Distribution = F{}
+	_ Distribution = Gamma{}
+	_ Distribution = Geometric{}
+	_ Distribution = GumbelRight{}
+	_ Distribution = Hypergeometric{}
+	_ Distribution = InverseGamma{}
+	_ Distribution = Laplace{}
+	_ Distribution = LogNormal{}
+	_ Distribution = Logistic{}
+	_ Distribution = Nakagami{}
+	_ Distribution = NegativeBinomial{}
+	_ Distribution = Normal{}
+	_ Distribution = Pareto{}
+	_ Distribution = Rice{}
+	_ Distribution = StudentsT{}
+	_ Distribution = Triangle{}
+	_ Distribution = Uniform{}
+	_ Distribution = VonMises{}
+	_ Distribution = Weibull{}
+
+	_ Distribution = KDE{}
+	_ Distribution = LocationScale{}
+	_ Distribution = Mixture{}
+	_ Distribution = Truncated{}
+)
+
+func TestMixture(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	for i, test := range []struct {
+		components []Distribution
+		weights    []float64
+		lower      float64
+	}{
+		{
+			components: []Distribution{Normal{Mu: -2, Sigma: 1, Src: src}, Normal{Mu: 3, Sigma: 0.5, Src: src}},
+			weights:    []float64{0.3, 0.7},
+			lower:      math.Inf(-1),
+		},
+		{
+			components: []Distribution{Gamma{Alpha: 2, Beta: 1, Src: src}, Exponential{Rate: 0.2, Src: src}, Weibull{K: 3, Lambda: 1, Src: src}},
+			weights:    []float64{1, 2, 3},
+			lower:      0,
+		},
+		{
+			components: []Distribution{Normal{Mu: 0, Sigma: 1, Src: src}, Normal{Mu: 10, Sigma: 1, Src: src}},
+			weights:    []float64{1, 0},
+			lower:      math.Inf(-1),
+		},
+	} {
+		m := NewMixture(test.components, test.weights, src)
+		testMixture(t, m, test.lower, i)
+
+		var sum float64
+		for _, w := range test.weights {
+			sum += w
+		}
+		for _, x := range []float64{0.5, 1, 3} {
+			var want float64
+			for k, c := range test.components {
+				want += test.weights[k] / sum * math.Exp(c.LogProb(x))
+			}
+			if got := m.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+				t.Errorf("Prob mismatch case %d at %v: got %v, want %v", i, x, got, want)
+			}
+		}
+	}
+
+	if !panics(func() { NewMixture(nil, nil, nil) }) {
+		t.Errorf("Expected panic for no components")
+	}
+	if !panics(func() { NewMixture([]Distribution{Normal{Mu: 0, Sigma: 1}}, []float64{1, 2}, nil) }) {
+		t.Errorf("Expected panic for mismatched weights")
+	}
+}
+
+func testMixture(t *testing.T, m Mixture, lower float64, i int) {
+	const (
+		tol  = 1e-2
+		n    = 5e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, m)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, math.Max(lower, x[0]-10), x, m, tol, bins)
+	checkProbContinuous(t, i, x, lower, math.Inf(1), m, 1e-8)
+	checkProbQuantContinuous(t, i, x, m, tol)
+	checkQuantileCDFSurvival(t, i, x, m, 5e-3)
+}
+
+func TestMixtureDiscrete(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	m := NewMixture([]Distribution{Geometric{P: 0.5, Src: src}, NegativeBinomial{R: 10, P: 0.3, Src: src}}, []float64{0.4, 0.6}, src)
+	x := make([]float64, 1e6)
+	generateSamples(x, m)
+	sort.Float64s(x)
+	checkProbDiscrete(t, 0, x, m, 2e-3)
+	checkQuantileDiscrete(t, 0, m)
+}
+
+func TestMixtureFit(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	want := NewMixture([]Distribution{
+		Normal{Mu: -2, Sigma: 1, Src: src},
+		Normal{Mu: 3, Sigma: 0.5, Src: src},
+		Normal{Mu: 6, Sigma: 2, Src: src},
+	}, []float64{0.3, 0.5, 0.2}, src)
+	samples := randn(want, 20000)
+
+	got := NewMixture([]Distribution{
+		&Normal{Mu: -1, Sigma: 2},
+		&Normal{Mu: 2, Sigma: 2},
+		&Normal{Mu: 5, Sigma: 2},
+	}, []float64{1, 1, 1}, nil)
+	got.Fit(samples, nil)
+	for k := 0; k < want.Len(); k++ {
+		w := want.Component(k).(Normal)
+		g := got.Component(k).(*Normal)
+		if !scalar.EqualWithinAbs(g.Mu, w.Mu, 0.1) || !scalar.EqualWithinRel(g.Sigma, w.Sigma, 0.1) {
+			t.Errorf("unexpected component %d: got %v, want %v", k, *g, w)
+		}
+		if !scalar.EqualWithinAbs(got.Weight(k), want.Weight(k), 0.02) {
+			t.Errorf("unexpected weight %d: got %v, want %v", k, got.Weight(k), want.Weight(k))
+		}
+	}
+	ll := logLikelihood(got, samples, nil)
+	if llWant := logLikelihood(want, samples, nil); ll < llWant {
+		t.Errorf("fitted likelihood %v less than true likelihood %v", ll, llWant)
+	}
+
+	// Integer weights are equivalent to repeated samples.
+	const nSub = 200
+	weights := make([]float64, nSub)
+	var repeated []float64
+	for i, x := range samples[:nSub] {
+		weights[i] = float64(i%3 + 1)
+		for j := 0; j < i%3+1; j++ {
+			repeated = append(repeated, x)
+		}
+	}
+	newFit := func() Mixture {
+		return NewMixture([]Distribution{&Normal{Mu: -1, Sigma: 2}, &Normal{Mu: 4, Sigma: 2}}, []float64{1, 1}, nil)
+	}
+	weighted := newFit()
+	weighted.Fit(samples[:nSub], weights)
+	unweighted := newFit()
+	unweighted.Fit(repeated, nil)
+	for k := 0; k < 2; k++ {
+		w := weighted.Component(k).(*Normal)
+		u := unweighted.Component(k).(*Normal)
+		if !scalar.EqualWithinAbsOrRel(w.Mu, u.Mu, 1e-6, 1e-6) || !scalar.EqualWithinAbsOrRel(w.Sigma, u.Sigma, 1e-6, 1e-6) ||
+			!scalar.EqualWithinAbsOrRel(weighted.Weight(k), unweighted.Weight(k), 1e-6, 1e-6) {
+			t.Errorf("weighted fit does not match fit with repeated samples for component %d", k)
+		}
+	}
+
+	if !panics(func() {
+		m := NewMixture([]Distribution{Normal{Mu: 0, Sigma: 1}}, []float64{1}, nil)
+		m.Fit(samples, nil)
+	}) {
+		t.Errorf("Expected panic for component not implementing Fitter")
+	}
+	if !panics(func() { got.Fit(samples, make([]float64, len(samples)+1)) }) {
+		t.Errorf("Expected panic for mismatched samples and weights lengths")
+	}
+}
+
+func TestMixtureFitDiscrete(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	samples := make([]float64, 20000)
+	for i := range samples {
+		if i%4 == 0 {
+			samples[i] = Geometric{P: 0.5, Src: src}.Rand()
+		} else {
+			samples[i] = NegativeBinomial{R: 20, P: 0.5, Src: src}.Rand()
+		}
+	}
+	m := NewMixture([]Distribution{&Geometric{P: 0.3}, &NegativeBinomial{R: 5, P: 0.2}}, []float64{1, 1}, nil)
+	m.Fit(samples, nil)
+	if g := m.Component(0).(*Geometric); !scalar.EqualWithinRel(g.P, 0.5, 0.1) {
+		t.Errorf("unexpected geometric component: got %v, want P = 0.5", *g)
+	}
+	if nb := m.Component(1).(*NegativeBinomial); !scalar.EqualWithinRel(nb.R, 20, 0.3) || !scalar.EqualWithinRel(nb.P, 0.5, 0.1) {
+		t.Errorf("unexpected negative binomial component: got %v, want R = 20, P = 0.5", *nb)
+	}
+	if !scalar.EqualWithinAbs(m.Weight(0), 0.25, 0.03) {
+		t.Errorf("unexpected weight: got %v, want 0.25", m.Weight(0))
+	}
+}
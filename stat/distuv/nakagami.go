@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Nakagami implements the Nakagami distribution, a two-parameter continuous
+// distribution with support over the positive real numbers. The square of a
+// Nakagami random variable is Gamma distributed with shape m and mean Ω.
+//
+// The Nakagami distribution has density function
+//  2 m^m / (Γ(m) Ω^m) x^(2m-1) exp(-m x^2/Ω)
+// where m is the shape parameter Mu and Ω is the spread parameter Omega.
+// Mu must be at least 0.5 and Omega must be greater than 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Nakagami_distribution.
+type Nakagami struct {
+	Mu    float64 // Shape parameter
+	Omega float64 // Spread parameter, the mean of the square of the variable
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (n Nakagami) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return mathext.GammaIncReg(n.Mu, n.Mu*x*x/n.Omega)
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (n Nakagami) Entropy() float64 {
+	lg, _ := math.Lgamma(n.Mu)
+	return n.Mu + lg + (0.5-n.Mu)*mathext.Digamma(n.Mu) - ln2 - 0.5*math.Log(n.Mu/n.Omega)
+}
+
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// Fit may set Mu below 0.5 if that fits the samples best.
+func (n *Nakagami) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// The squared samples are Gamma distributed with shape Mu and mean
+	// Omega.
+	sq := make([]float64, len(samples))
+	for i, x := range samples {
+		sq[i] = x * x
+	}
+	omega := stat.Mean(sq, weights)
+	n.Omega = omega
+	n.Mu = gammaShapeMLE(math.Log(omega) - weightedLogMean(sq, weights))
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (n Nakagami) LogProb(x float64) float64 {
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	lg, _ := math.Lgamma(n.Mu)
+	return ln2 + n.Mu*math.Log(n.Mu/n.Omega) - lg + (2*n.Mu-1)*math.Log(x) - n.Mu*x*x/n.Omega
+}
+
+// Mean returns the mean of the probability distribution.
+func (n Nakagami) Mean() float64 {
+	a, _ := math.Lgamma(n.Mu + 0.5)
+	b, _ := math.Lgamma(n.Mu)
+	return math.Exp(a-b) * math.Sqrt(n.Omega/n.Mu)
+}
+
+// Median returns the median of the probability distribution.
+func (n Nakagami) Median() float64 {
+	return n.Quantile(0.5)
+}
+
+// Mode returns the mode of the probability distribution.
+func (n Nakagami) Mode() float64 {
+	return math.Sqrt((2*n.Mu - 1) * n.Omega / (2 * n.Mu))
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Nakagami) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (n Nakagami) Prob(x float64) float64 {
+	return math.Exp(n.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+func (n Nakagami) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	return math.Sqrt(mathext.GammaIncRegInv(n.Mu, p) * n.Omega / n.Mu)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (n Nakagami) Rand() float64 {
+	return math.Sqrt(Gamma{Alpha: n.Mu, Beta: n.Mu / n.Omega, Src: n.Src}.Rand())
+}
+
+// Score returns the score function with respect to the parameters of the
+// distribution at the input location x. The score function is the derivative
+// of the log-likelihood at x with respect to the parameters
+//  (∂/∂θ) log(p(x;θ))
+// If deriv is non-nil, len(deriv) must equal the number of parameters otherwise
+// Score will panic, and the derivative is stored in-place into deriv. If deriv
+// is nil a new slice will be allocated and returned.
+//
+// The order is [∂LogProb / ∂Mu, ∂LogProb / ∂Omega].
+//
+// For more information, see https://en.wikipedia.org/wiki/Score_%28statistics%29.
+func (n Nakagami) Score(deriv []float64, x float64) []float64 {
+	if deriv == nil {
+		deriv = make([]float64, n.NumParameters())
+	}
+	if len(deriv) != n.NumParameters() {
+		panic(badLength)
+	}
+	r := x * x / n.Omega
+	deriv[0] = math.Log(n.Mu) + 1 - mathext.Digamma(n.Mu) + math.Log(r) - r
+	deriv[1] = n.Mu * (r - 1) / n.Omega
+	return deriv
+}
+
+// ScoreInput returns the score function with respect to the input of the
+// distribution at the input location specified by x. The score function is the
+// derivative of the log-likelihood
+//  (d/dx) log(p(x)) .
+func (n Nakagami) ScoreInput(x float64) float64 {
+	return (2*n.Mu-1)/x - 2*n.Mu*x/n.Omega
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (n Nakagami) StdDev() float64 {
+	return math.Sqrt(n.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (n Nakagami) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return mathext.GammaIncRegComp(n.Mu, n.Mu*x*x/n.Omega)
+}
+
+// Variance returns the variance of the probability distribution.
+func (n Nakagami) Variance() float64 {
+	mean := n.Mean()
+	return n.Omega - mean*mean
+}
+
+// parameters returns the parameters of the distribution.
+func (n Nakagami) parameters(p []Parameter) []Parameter {
+	nParam := n.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("nakagami: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = n.Mu
+	p[1].Name = "Omega"
+	p[1].Value = n.Omega
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (n *Nakagami) setParameters(p []Parameter) {
+	if len(p) != n.NumParameters() {
+		panic("nakagami: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("nakagami: " + panicNameMismatch)
+	}
+	if p[1].Name != "Omega" {
+		panic("nakagami: " + panicNameMismatch)
+	}
+	n.Mu = p[0].Value
+	n.Omega = p[1].Value
+}
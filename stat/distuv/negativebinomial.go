@@ -0,0 +1,253 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+// NegativeBinomial implements the negative binomial distribution, a discrete
+// probability distribution of the number of failures in a sequence of
+// independent Bernoulli trials before a given number of successes occurs.
+// The negative binomial distribution has probability mass function:
+//  f(k) = Γ(k+r)/(k! Γ(r)) p^r (1-p)^k
+// for k = 0, 1, 2, ...
+// The number of successes r need not be an integer, in which case the
+// distribution is also known as the Pólya distribution and is a Gamma mixture
+// of Poisson distributions.
+// For more information, see https://en.wikipedia.org/wiki/Negative_binomial_distribution.
+type NegativeBinomial struct {
+	// R is the number of successes. R must be greater than 0.
+	R float64
+	// P is the probability of success in each trial.
+	// P must be greater than 0 and at most 1.
+	P float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (n NegativeBinomial) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	if n.P == 1 {
+		return 1
+	}
+	return mathext.RegIncBeta(n.R, math.Floor(x)+1, n.P)
+}
+
+// Entropy returns the entropy of the distribution.
+func (n NegativeBinomial) Entropy() float64 {
+	// The entropy is summed directly starting from the mode, where the
+	// summands are largest, outwards until the summands are negligible.
+	mode := n.Mode()
+	var entropy float64
+	add := func(k float64) float64 {
+		lp := n.LogProb(k)
+		if math.IsInf(lp, -1) {
+			return 0
+		}
+		v := -math.Exp(lp) * lp
+		entropy += v
+		return v
+	}
+	for k := mode; k >= 0; k-- {
+		if v := add(k); k < mode && v <= 1e-17*entropy {
+			break
+		}
+	}
+	for k := mode + 1; ; k++ {
+		if v := add(k); v <= 1e-17*entropy {
+			break
+		}
+	}
+	return entropy
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (n NegativeBinomial) ExKurtosis() float64 {
+	return 6/n.R + n.P*n.P/((1-n.P)*n.R)
+}
+
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of R is unbounded if the samples are not
+// overdispersed, that is if their variance does not exceed their mean. In that
+// case Fit returns a large R approximating a Poisson distribution.
+func (n *NegativeBinomial) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean, variance := stat.MeanVariance(samples, weights)
+	if mean == 0 {
+		n.R = 1
+		n.P = 1
+		return
+	}
+	// For fixed R, the likelihood is maximized by P = R/(R+mean). The
+	// profile likelihood is maximized over the log of R starting from
+	// the method of moments estimate.
+	r := mean * mean / (variance - mean)
+	if !(r > 0) || math.IsInf(r, 1) {
+		r = 100 * mean
+	}
+	x := []float64{0}
+	maximize(func(x []float64) float64 {
+		rx := r * math.Exp(x[0])
+		d := NegativeBinomial{R: rx, P: rx / (rx + mean)}
+		return logLikelihood(d, samples, weights)
+	}, x)
+	n.R = r * math.Exp(x[0])
+	n.P = n.R / (n.R + mean)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (n NegativeBinomial) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	lp := n.R * math.Log(n.P)
+	if x != 0 {
+		lp += x * math.Log1p(-n.P)
+	}
+	a, _ := math.Lgamma(x + n.R)
+	b, _ := math.Lgamma(x + 1)
+	c, _ := math.Lgamma(n.R)
+	return lp + a - b - c
+}
+
+// Mean returns the mean of the probability distribution.
+func (n NegativeBinomial) Mean() float64 {
+	return n.R * (1 - n.P) / n.P
+}
+
+// Mode returns the mode of the probability distribution.
+func (n NegativeBinomial) Mode() float64 {
+	if n.R <= 1 {
+		return 0
+	}
+	return math.Floor((n.R - 1) * (1 - n.P) / n.P)
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (NegativeBinomial) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (n NegativeBinomial) Prob(x float64) float64 {
+	return math.Exp(n.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (n NegativeBinomial) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	if p == 1 {
+		if n.P == 1 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return discreteQuantile(p, n.CDF, 0, n.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (n NegativeBinomial) Rand() float64 {
+	if n.P == 1 {
+		return 0
+	}
+	// Draw from the Gamma mixture of Poisson distributions.
+	lambda := Gamma{Alpha: n.R, Beta: n.P / (1 - n.P), Src: n.Src}.Rand()
+	return Poisson{Lambda: lambda, Src: n.Src}.Rand()
+}
+
+// Score returns the score function with respect to the parameters of the
+// distribution at the input location x. The score function is the derivative
+// of the log-likelihood at x with respect to the parameters
+//  (∂/∂θ) log(p(x;θ))
+// If deriv is non-nil, len(deriv) must equal the number of parameters otherwise
+// Score will panic, and the derivative is stored in-place into deriv. If deriv
+// is nil a new slice will be allocated and returned.
+//
+// The order is [∂LogProb / ∂R, ∂LogProb / ∂P].
+//
+// For more information, see https://en.wikipedia.org/wiki/Score_%28statistics%29.
+func (n NegativeBinomial) Score(deriv []float64, x float64) []float64 {
+	if deriv == nil {
+		deriv = make([]float64, n.NumParameters())
+	}
+	if len(deriv) != n.NumParameters() {
+		panic(badLength)
+	}
+	deriv[0] = mathext.Digamma(x+n.R) - mathext.Digamma(n.R) + math.Log(n.P)
+	deriv[1] = n.R/n.P - x/(1-n.P)
+	return deriv
+}
+
+// Skewness returns the skewness of the distribution.
+func (n NegativeBinomial) Skewness() float64 {
+	return (2 - n.P) / math.Sqrt((1-n.P)*n.R)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (n NegativeBinomial) StdDev() float64 {
+	return math.Sqrt(n.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (n NegativeBinomial) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	if n.P == 1 {
+		return 0
+	}
+	return mathext.RegIncBeta(math.Floor(x)+1, n.R, 1-n.P)
+}
+
+// Variance returns the variance of the probability distribution.
+func (n NegativeBinomial) Variance() float64 {
+	return n.R * (1 - n.P) / (n.P * n.P)
+}
+
+// parameters returns the parameters of the distribution.
+func (n NegativeBinomial) parameters(p []Parameter) []Parameter {
+	nParam := n.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("negativebinomial: improper parameter length")
+	}
+	p[0].Name = "R"
+	p[0].Value = n.R
+	p[1].Name = "P"
+	p[1].Value = n.P
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (n *NegativeBinomial) setParameters(p []Parameter) {
+	if len(p) != n.NumParameters() {
+		panic("negativebinomial: incorrect number of parameters to set")
+	}
+	if p[0].Name != "R" {
+		panic("negativebinomial: " + panicNameMismatch)
+	}
+	if p[1].Name != "P" {
+		panic("negativebinomial: " + panicNameMismatch)
+	}
+	n.R = p[0].Value
+	n.P = p[1].Value
+}
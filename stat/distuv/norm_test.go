@@ -229,3 +229,8 @@ func TestNormalIssue577(t *testing.T) {
 		t.Errorf("Normal{0,1}.CDF(%e) is greater than %e. got: %e", x, max, cdf)
 	}
 }
+
+func TestNormalFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Normal", &Normal{Mu: 2, Sigma: 3, Src: rand.NewSource(1)}, func() fitTester { return &Normal{} }, 10000, 0.05, true)
+}
@@ -51,6 +51,16 @@ func (p Pareto) ExKurtosis() float64 {
 
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (p *Pareto) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	p.Xm, _ = sampleRange(samples, weights)
+	p.Alpha = 1 / (weightedLogMean(samples, weights) - math.Log(p.Xm))
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (p Pareto) LogProb(x float64) float64 {
@@ -128,3 +138,33 @@ func (p Pareto) Variance() float64 {
 	am1 := p.Alpha - 1
 	return p.Xm * p.Xm * p.Alpha / (am1 * am1 * (p.Alpha - 2))
 }
+
+// parameters returns the parameters of the distribution.
+func (p Pareto) parameters(params []Parameter) []Parameter {
+	nParam := p.NumParameters()
+	if params == nil {
+		params = make([]Parameter, nParam)
+	} else if len(params) != nParam {
+		panic("pareto: improper parameter length")
+	}
+	params[0].Name = "Xm"
+	params[0].Value = p.Xm
+	params[1].Name = "Alpha"
+	params[1].Value = p.Alpha
+	return params
+}
+
+// setParameters modifies the parameters of the distribution.
+func (p *Pareto) setParameters(params []Parameter) {
+	if len(params) != p.NumParameters() {
+		panic("pareto: incorrect number of parameters to set")
+	}
+	if params[0].Name != "Xm" {
+		panic("pareto: " + panicNameMismatch)
+	}
+	if params[1].Name != "Alpha" {
+		panic("pareto: " + panicNameMismatch)
+	}
+	p.Xm = params[0].Value
+	p.Alpha = params[1].Value
+}
@@ -204,3 +204,8 @@ func TestParetoNotExists(t *testing.T) {
 		t.Errorf("Expected standard deviation == +Inf for Alpha == 1, got %v", stdDev)
 	}
 }
+
+func TestParetoFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Pareto", &Pareto{Xm: 1, Alpha: 3, Src: rand.NewSource(1)}, func() fitTester { return &Pareto{} }, 10000, 0.05, false)
+}
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
 )
 
 // Poisson implements the Poisson distribution, a discrete probability distribution
@@ -39,6 +40,15 @@ func (p Poisson) ExKurtosis() float64 {
 	return 1 / p.Lambda
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (p *Poisson) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	p.Lambda = stat.Mean(samples, weights)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (p Poisson) LogProb(x float64) float64 {
@@ -141,3 +151,27 @@ func (p Poisson) Survival(x float64) float64 {
 func (p Poisson) Variance() float64 {
 	return p.Lambda
 }
+
+// parameters returns the parameters of the distribution.
+func (p Poisson) parameters(params []Parameter) []Parameter {
+	nParam := p.NumParameters()
+	if params == nil {
+		params = make([]Parameter, nParam)
+	} else if len(params) != nParam {
+		panic("poisson: improper parameter length")
+	}
+	params[0].Name = "Lambda"
+	params[0].Value = p.Lambda
+	return params
+}
+
+// setParameters modifies the parameters of the distribution.
+func (p *Poisson) setParameters(params []Parameter) {
+	if len(params) != p.NumParameters() {
+		panic("poisson: incorrect number of parameters to set")
+	}
+	if params[0].Name != "Lambda" {
+		panic("poisson: " + panicNameMismatch)
+	}
+	p.Lambda = params[0].Value
+}
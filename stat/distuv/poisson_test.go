@@ -179,3 +179,8 @@ func BenchmarkPoissonRand(b *testing.B) {
 		})
 	}
 }
+
+func TestPoissonFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Poisson", &Poisson{Lambda: 4, Src: rand.NewSource(1)}, func() fitTester { return &Poisson{} }, 10000, 0.05, true)
+}
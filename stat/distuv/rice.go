@@ -0,0 +1,307 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sync"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Rice implements the Rice distribution, a two-parameter continuous
+// distribution with support over the non-negative real numbers. The Rice
+// distribution is the distribution of the length of a two-dimensional vector
+// whose components are independent normal random variables with standard
+// deviation σ and means whose vector has length ν. When ν is 0, the Rice
+// distribution is the Rayleigh distribution.
+//
+// The Rice distribution has density function
+//  x/σ^2 exp(-(x^2 + ν^2)/(2σ^2)) I0(xν/σ^2)
+// where ν is the parameter Nu, σ is the parameter Sigma and I0 is the
+// modified Bessel function of order 0. Nu must be non-negative and Sigma
+// must be greater than 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Rice_distribution.
+type Rice struct {
+	Nu    float64 // Distance from the origin of the center of the bivariate distribution
+	Sigma float64 // Standard deviation of each component
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (r Rice) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return r.poissonMixture(x, mathext.GammaIncReg)
+}
+
+// poissonMixture returns
+//  Σ_j e^-λ λ^j/j! g(j+1, x^2/(2σ^2))
+// with λ = ν^2/(2σ^2). The squared Rice random variable scaled by 1/(2σ^2)
+// is a Poisson mixture of Gamma random variables, so with g the regularized
+// lower or upper incomplete Gamma function this is the CDF or survival
+// function.
+func (r Rice) poissonMixture(x float64, g func(a, x float64) float64) float64 {
+	lambda := r.Nu * r.Nu / (2 * r.Sigma * r.Sigma)
+	y := x * x / (2 * r.Sigma * r.Sigma)
+	// The Poisson weights are negligible beyond ten standard deviations
+	// from the mean.
+	spread := 10*math.Sqrt(lambda) + 40
+	lo := math.Max(0, math.Floor(lambda-spread))
+	hi := math.Ceil(lambda + spread)
+	var sum float64
+	for j := lo; j <= hi; j++ {
+		lg, _ := math.Lgamma(j + 1)
+		w := -lambda - lg
+		if j > 0 {
+			w += j * math.Log(lambda)
+		}
+		sum += math.Exp(w) * g(j+1, y)
+	}
+	return math.Max(0, math.Min(1, sum))
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (r Rice) Entropy() float64 {
+	// The entropy has no closed form and is computed by quadrature over
+	// the region where the density is not negligible.
+	lo := math.Max(0, r.Nu-12*r.Sigma)
+	hi := r.Nu + 12*r.Sigma
+	integrand := func(x float64) float64 {
+		lp := r.LogProb(x)
+		if math.IsInf(lp, -1) {
+			return 0
+		}
+		return -math.Exp(lp) * lp
+	}
+	riceLegendre.once.Do(func() {
+		riceLegendre.x, riceLegendre.w = legendreRule(1000)
+	})
+	mid, half := (lo+hi)/2, (hi-lo)/2
+	var sum float64
+	for i, x := range riceLegendre.x {
+		if x == 0 {
+			sum += riceLegendre.w[i] * integrand(mid)
+			continue
+		}
+		sum += riceLegendre.w[i] * (integrand(mid-half*x) + integrand(mid+half*x))
+	}
+	return half * sum
+}
+
+// riceLegendre holds the Gauss–Legendre quadrature rule used by Rice.Entropy.
+// The rule is computed here rather than by the integrate/quad package, since
+// quad depends on distuv in its tests.
+var riceLegendre struct {
+	once sync.Once
+	x, w []float64
+}
+
+// legendreRule returns the non-negative nodes of the n-point Gauss–Legendre
+// quadrature rule on [-1, 1] and their weights. The remaining nodes are the
+// negated positive nodes with the same weights.
+func legendreRule(n int) (x, w []float64) {
+	x = make([]float64, (n+1)/2)
+	w = make([]float64, len(x))
+	for i := range x {
+		// Find the i-th root of the Legendre polynomial of degree n by
+		// Newton's method and compute its weight from the derivative.
+		xi := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+		var dp float64
+		for iter := 0; iter < 100; iter++ {
+			p0, p1 := 1.0, xi
+			for k := 2; k <= n; k++ {
+				p0, p1 = p1, ((2*float64(k)-1)*xi*p1-(float64(k)-1)*p0)/float64(k)
+			}
+			dp = float64(n) * (xi*p1 - p0) / (xi*xi - 1)
+			dx := p1 / dp
+			xi -= dx
+			if math.Abs(dx) <= 1e-15 {
+				break
+			}
+		}
+		x[i] = xi
+		w[i] = 2 / ((1 - xi*xi) * dp * dp)
+	}
+	if n%2 == 1 {
+		// The middle node is the root at zero.
+		x[len(x)-1] = 0
+	}
+	return x, w
+}
+
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (r *Rice) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// Start from moment estimates that are exact for large ν/σ, scaled by
+	// the root mean square of the samples, and optimize over ν and the log
+	// of σ. The likelihood is symmetric in ν, so the sign is discarded.
+	sq := make([]float64, len(samples))
+	for i, x := range samples {
+		sq[i] = x * x
+	}
+	m2 := stat.Mean(sq, weights)
+	variance := stat.Variance(samples, weights)
+	scale := math.Sqrt(m2)
+	nu := math.Sqrt(math.Max(m2-2*variance, m2/2))
+	sigma := math.Sqrt((m2 - nu*nu) / 2)
+	x := []float64{nu / scale, math.Log(sigma / scale)}
+	maximize(func(x []float64) float64 {
+		d := Rice{Nu: scale * math.Abs(x[0]), Sigma: scale * math.Exp(x[1])}
+		return logLikelihood(d, samples, weights)
+	}, x)
+	r.Nu = scale * math.Abs(x[0])
+	r.Sigma = scale * math.Exp(x[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (r Rice) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	s2 := r.Sigma * r.Sigma
+	d := x - r.Nu
+	// I0(z) = exp(z) I0e(z) with z = xν/σ^2.
+	return math.Log(x/s2) - d*d/(2*s2) + math.Log(besselIe(0, x*r.Nu/s2))
+}
+
+// Mean returns the mean of the probability distribution.
+func (r Rice) Mean() float64 {
+	// The mean is σ sqrt(π/2) L_1/2(-ν^2/(2σ^2)) where the Laguerre
+	// polynomial is
+	//  L_1/2(-λ) = e^(-λ/2) ((1+λ) I0(λ/2) + λ I1(λ/2)).
+	lambda := r.Nu * r.Nu / (2 * r.Sigma * r.Sigma)
+	l := (1+lambda)*besselIe(0, lambda/2) + lambda*besselIe(1, lambda/2)
+	return r.Sigma * math.Sqrt(math.Pi/2) * l
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Rice) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (r Rice) Prob(x float64) float64 {
+	return math.Exp(r.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+func (r Rice) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	switch p {
+	case 0:
+		return 0
+	case 1:
+		return math.Inf(1)
+	}
+	hi := r.Nu + r.Sigma
+	for r.CDF(hi) < p {
+		hi *= 2
+	}
+	return continuousQuantile(p, r.CDF, 0, hi)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (r Rice) Rand() float64 {
+	normrnd := rand.NormFloat64
+	if r.Src != nil {
+		normrnd = rand.New(r.Src).NormFloat64
+	}
+	return math.Hypot(r.Nu+r.Sigma*normrnd(), r.Sigma*normrnd())
+}
+
+// Score returns the score function with respect to the parameters of the
+// distribution at the input location x. The score function is the derivative
+// of the log-likelihood at x with respect to the parameters
+//  (∂/∂θ) log(p(x;θ))
+// If deriv is non-nil, len(deriv) must equal the number of parameters otherwise
+// Score will panic, and the derivative is stored in-place into deriv. If deriv
+// is nil a new slice will be allocated and returned.
+//
+// The order is [∂LogProb / ∂Nu, ∂LogProb / ∂Sigma].
+//
+// For more information, see https://en.wikipedia.org/wiki/Score_%28statistics%29.
+func (r Rice) Score(deriv []float64, x float64) []float64 {
+	if deriv == nil {
+		deriv = make([]float64, r.NumParameters())
+	}
+	if len(deriv) != r.NumParameters() {
+		panic(badLength)
+	}
+	s2 := r.Sigma * r.Sigma
+	a := besselRatio(x * r.Nu / s2)
+	deriv[0] = (a*x - r.Nu) / s2
+	deriv[1] = -2/r.Sigma + (x*x+r.Nu*r.Nu-2*a*x*r.Nu)/(s2*r.Sigma)
+	return deriv
+}
+
+// ScoreInput returns the score function with respect to the input of the
+// distribution at the input location specified by x. The score function is the
+// derivative of the log-likelihood
+//  (d/dx) log(p(x)) .
+func (r Rice) ScoreInput(x float64) float64 {
+	s2 := r.Sigma * r.Sigma
+	return 1/x + (besselRatio(x*r.Nu/s2)*r.Nu-x)/s2
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (r Rice) StdDev() float64 {
+	return math.Sqrt(r.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (r Rice) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return r.poissonMixture(x, mathext.GammaIncRegComp)
+}
+
+// Variance returns the variance of the probability distribution.
+func (r Rice) Variance() float64 {
+	mean := r.Mean()
+	return 2*r.Sigma*r.Sigma + r.Nu*r.Nu - mean*mean
+}
+
+// parameters returns the parameters of the distribution.
+func (r Rice) parameters(p []Parameter) []Parameter {
+	nParam := r.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("rice: improper parameter length")
+	}
+	p[0].Name = "Nu"
+	p[0].Value = r.Nu
+	p[1].Name = "Sigma"
+	p[1].Value = r.Sigma
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (r *Rice) setParameters(p []Parameter) {
+	if len(p) != r.NumParameters() {
+		panic("rice: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Nu" {
+		panic("rice: " + panicNameMismatch)
+	}
+	if p[1].Name != "Sigma" {
+		panic("rice: " + panicNameMismatch)
+	}
+	r.Nu = p[0].Value
+	r.Sigma = p[1].Value
+}
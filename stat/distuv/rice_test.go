@@ -0,0 +1,32 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestRiceRayleigh(t *testing.T) {
+	t.Parallel()
+	// With Nu = 0 the Rice distribution is the Rayleigh distribution.
+	r := Rice{Nu: 0, Sigma: 2}
+	for _, x := range []float64{0.1, 1, 3, 10} {
+		want := -math.Expm1(-x * x / 8)
+		if got := r.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Rayleigh CDF mismatch at %v: got %v, want %v", x, got, want)
+		}
+	}
+	wantMean := 2 * math.Sqrt(math.Pi/2)
+	if got := r.Mean(); !scalar.EqualWithinAbsOrRel(got, wantMean, 1e-14, 1e-14) {
+		t.Errorf("Rayleigh mean mismatch: got %v, want %v", got, wantMean)
+	}
+	wantEntropy := 1 + math.Log(2/math.Sqrt2) + eulerMascheroni/2
+	if got := r.Entropy(); !scalar.EqualWithinAbsOrRel(got, wantEntropy, 1e-10, 1e-10) {
+		t.Errorf("Rayleigh entropy mismatch: got %v, want %v", got, wantEntropy)
+	}
+}
@@ -61,6 +61,42 @@ func (s StudentsT) CDF(x float64) float64 {
 	return 0.5 * mathext.RegIncBeta(s.Nu/2, 0.5, t)
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// There is no closed form for the estimates, so they are found numerically
+// starting from the median and the scaled median absolute deviation of the
+// samples.
+func (s *StudentsT) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	loc := weightedQuantile(0.5, samples, weights)
+	dev := make([]float64, len(samples))
+	for i, x := range samples {
+		dev[i] = math.Abs(x - loc)
+	}
+	// The median absolute deviation of the standard normal distribution.
+	const madNormal = 0.6744897501960817
+	scale := weightedQuantile(0.5, dev, weights) / madNormal
+	if scale == 0 {
+		scale = 1
+	}
+	z := make([]float64, len(samples))
+	for i, x := range samples {
+		z[i] = (x - loc) / scale
+	}
+
+	p := []float64{0, 0, math.Log(5)}
+	maximize(func(p []float64) float64 {
+		d := StudentsT{Mu: p[0], Sigma: math.Exp(p[1]), Nu: math.Exp(p[2])}
+		return logLikelihood(d, z, weights)
+	}, p)
+	s.Mu = loc + scale*p[0]
+	s.Sigma = scale * math.Exp(p[1])
+	s.Nu = math.Exp(p[2])
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (s StudentsT) LogProb(x float64) float64 {
@@ -159,3 +195,39 @@ func (s StudentsT) Variance() float64 {
 	}
 	return s.Sigma * s.Sigma * s.Nu / (s.Nu - 2)
 }
+
+// parameters returns the parameters of the distribution.
+func (s StudentsT) parameters(p []Parameter) []Parameter {
+	nParam := s.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("studentst: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = s.Mu
+	p[1].Name = "Sigma"
+	p[1].Value = s.Sigma
+	p[2].Name = "Nu"
+	p[2].Value = s.Nu
+	return p
+}
+
+// setParameters modifies the parameters of the distribution.
+func (s *StudentsT) setParameters(p []Parameter) {
+	if len(p) != s.NumParameters() {
+		panic("studentst: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("studentst: " + panicNameMismatch)
+	}
+	if p[1].Name != "Sigma" {
+		panic("studentst: " + panicNameMismatch)
+	}
+	if p[2].Name != "Nu" {
+		panic("studentst: " + panicNameMismatch)
+	}
+	s.Mu = p[0].Value
+	s.Sigma = p[1].Value
+	s.Nu = p[2].Value
+}
@@ -108,3 +108,8 @@ func TestStudentsVarianceSpecial(t *testing.T) {
 		t.Errorf("Expected +Inf variance for 1 < Nu <= 2, got %v", variance)
 	}
 }
+
+func TestStudentsTFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "StudentsT", &StudentsT{Mu: 1, Sigma: 2, Nu: 5, Src: rand.NewSource(1)}, func() fitTester { return &StudentsT{} }, 10000, 0.2, true)
+}
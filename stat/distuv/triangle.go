@@ -8,6 +8,8 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
 )
 
 // Triangle represents a triangle distribution (https://en.wikipedia.org/wiki/Triangular_distribution).
@@ -62,7 +64,77 @@ func (Triangle) ExKurtosis() float64 {
 	return -3.0 / 5.0
 }
 
-// Fit is not appropriate for Triangle, because the distribution is generally used when there is little data.
+// Fit sets the mode of the distribution to its maximum likelihood estimate
+// from the data samples with relative weights. The lower and upper limits are
+// not estimated, and all the samples must lie between them, otherwise Fit will
+// panic. The estimate is one of the samples.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (t *Triangle) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	x := make([]float64, 0, len(samples))
+	var w []float64
+	if weights != nil {
+		w = make([]float64, 0, len(samples))
+	}
+	for i, v := range samples {
+		if v < t.a || t.b < v {
+			panic("triangle: sample out of range")
+		}
+		if weights != nil {
+			if weights[i] == 0 {
+				continue
+			}
+			w = append(w, weights[i])
+		}
+		x = append(x, v)
+	}
+	stat.SortWeighted(x, w)
+	weight := func(i int) float64 {
+		if w == nil {
+			return 1
+		}
+		return w[i]
+	}
+
+	// The log-likelihood for a mode c with the samples x[:k] below c and
+	// the samples x[m:] above it, up to a constant, is
+	//  Σ_{i<k} w_i log(x_i-a) - W_{<k} log(c-a) + Σ_{i≥m} w_i log(b-x_i) - W_{≥m} log(b-c).
+	// Samples equal to c contribute a constant.
+	n := len(x)
+	upperLog := make([]float64, n+1)
+	upperWeight := make([]float64, n+1)
+	for i := n - 1; i >= 0; i-- {
+		upperLog[i] = upperLog[i+1] + weight(i)*math.Log(t.b-x[i])
+		upperWeight[i] = upperWeight[i+1] + weight(i)
+	}
+	var lowerLog, lowerWeight float64
+	best := math.Inf(-1)
+	mode := t.c
+	for k := 0; k < n; {
+		c := x[k]
+		m := k
+		for m < n && x[m] == c {
+			m++
+		}
+		var ll float64
+		if k > 0 {
+			ll += lowerLog - lowerWeight*math.Log(c-t.a)
+		}
+		if m < n {
+			ll += upperLog[m] - upperWeight[m]*math.Log(t.b-c)
+		}
+		if ll > best {
+			best = ll
+			mode = c
+		}
+		for ; k < m; k++ {
+			lowerLog += weight(k) * math.Log(x[k]-t.a)
+			lowerWeight += weight(k)
+		}
+	}
+	t.c = mode
+}
 
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (t Triangle) LogProb(x float64) float64 {
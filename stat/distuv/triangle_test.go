@@ -219,3 +219,39 @@ func TestTriangleScoreInput(t *testing.T) {
 		}
 	}
 }
+
+func TestTriangleFit(t *testing.T) {
+	t.Parallel()
+	want := NewTriangle(0, 4, 1, rand.NewSource(1))
+	samples := randn(want, 10000)
+	tri := NewTriangle(0, 4, 3, nil)
+	tri.Fit(samples, nil)
+	if tri.a != 0 || tri.b != 4 {
+		t.Errorf("Fit modified the limits: got:%v, %v want:0, 4", tri.a, tri.b)
+	}
+	if math.Abs(tri.c-1) > 0.1 {
+		t.Errorf("unexpected mode estimate: got:%v want:1", tri.c)
+	}
+	ll := logLikelihood(tri, samples, nil)
+	for _, x := range samples[:100] {
+		other := NewTriangle(0, 4, x, nil)
+		if llOther := logLikelihood(other, samples, nil); llOther > ll {
+			t.Errorf("mode %v has greater likelihood %v than the estimate %v with %v", x, llOther, tri.c, ll)
+		}
+	}
+
+	// Samples with zero weight are ignored.
+	tri = NewTriangle(0, 4, 3, nil)
+	tri.Fit([]float64{0.5, 1, 3.9}, []float64{1, 1, 0})
+	ll = logLikelihood(tri, []float64{0.5, 1}, nil)
+	for _, c := range []float64{0, 0.5, 1, 2, 4} {
+		other := NewTriangle(0, 4, c, nil)
+		if llOther := logLikelihood(other, []float64{0.5, 1}, nil); llOther > ll {
+			t.Errorf("mode %v has greater likelihood %v than the estimate %v with %v", c, llOther, tri.c, ll)
+		}
+	}
+
+	if !panics(func() { tri.Fit([]float64{1, 5}, nil) }) {
+		t.Errorf("expected panic for sample out of range")
+	}
+}
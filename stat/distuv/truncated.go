@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Truncated is a continuous distribution restricted to the interval
+// [Lower, Upper]. The truncated distribution has density function
+//  f(x) / (F(Upper) - F(Lower))
+// for Lower ≤ x ≤ Upper and zero otherwise, where f and F are the density and
+// cumulative distribution functions of Dist. Lower and Upper may be infinite.
+// Lower must be less than Upper and Dist must have positive probability in
+// the interval.
+//
+// The accuracy of Truncated is limited by the accuracy of the CDF of Dist at
+// the bounds, so truncation to an interval far into the tail of Dist, where
+// the CDF rounds to 0 or 1, is not supported.
+//
+// For more information, see https://en.wikipedia.org/wiki/Truncated_distribution.
+type Truncated struct {
+	Dist  Distribution
+	Lower float64
+	Upper float64
+
+	Src rand.Source
+}
+
+// bounds returns the values of the CDF of the underlying distribution at the
+// lower and upper bounds of the truncation interval.
+func (t Truncated) bounds() (lo, hi float64) {
+	if t.Lower > t.Upper {
+		panic("truncated: lower bound greater than upper bound")
+	}
+	return t.Dist.CDF(t.Lower), t.Dist.CDF(t.Upper)
+}
+
+// CDF computes the value of the cumulative density function at x.
+func (t Truncated) CDF(x float64) float64 {
+	if x < t.Lower {
+		return 0
+	}
+	if x >= t.Upper {
+		return 1
+	}
+	lo, hi := t.bounds()
+	return math.Min(1, (t.Dist.CDF(x)-lo)/(hi-lo))
+}
+
+// LogProb computes the natural logarithm of the value of the probability density function at x.
+func (t Truncated) LogProb(x float64) float64 {
+	if x < t.Lower || x > t.Upper {
+		return math.Inf(-1)
+	}
+	lo, hi := t.bounds()
+	return t.Dist.LogProb(x) - math.Log(hi-lo)
+}
+
+// Prob computes the value of the probability density function at x.
+func (t Truncated) Prob(x float64) float64 {
+	return math.Exp(t.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative probability distribution.
+func (t Truncated) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	switch p {
+	case 0:
+		return t.Lower
+	case 1:
+		return t.Upper
+	}
+	lo, hi := t.bounds()
+	x := t.Dist.Quantile(lo + p*(hi-lo))
+	return math.Max(t.Lower, math.Min(t.Upper, x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (t Truncated) Rand() float64 {
+	var u float64
+	if t.Src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(t.Src).Float64()
+	}
+	return t.Quantile(u)
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (t Truncated) Survival(x float64) float64 {
+	return 1 - t.CDF(x)
+}
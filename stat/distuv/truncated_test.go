@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestTruncatedNormal(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		mu, sigma, lower, upper float64
+	}{
+		{0, 1, -1, 2},
+		{2, 3, 0, math.Inf(1)},
+		{-1, 0.5, math.Inf(-1), -1.5},
+		{0, 1, 2, 3},
+	} {
+		n := Normal{Mu: test.mu, Sigma: test.sigma}
+		tr := Truncated{Dist: n, Lower: test.lower, Upper: test.upper}
+
+		// The truncated normal distribution has a closed form mean.
+		alpha := (test.lower - test.mu) / test.sigma
+		beta := (test.upper - test.mu) / test.sigma
+		std := Normal{Mu: 0, Sigma: 1}
+		z := std.CDF(beta) - std.CDF(alpha)
+		for _, x := range []float64{test.lower, test.lower + 0.1, test.upper - 0.1, test.upper} {
+			if math.IsInf(x, 0) {
+				continue
+			}
+			want := n.Prob(x) / z
+			if got := tr.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("Prob mismatch case %d at %v: got %v, want %v", i, x, got, want)
+			}
+		}
+		wantMean := test.mu + test.sigma*(std.Prob(alpha)-std.Prob(beta))/z
+
+		const n5 = 5e5
+		x := make([]float64, n5)
+		tr.Src = rand.NewSource(uint64(i + 1))
+		generateSamples(x, tr)
+		sort.Float64s(x)
+		if x[0] < test.lower || x[len(x)-1] > test.upper {
+			t.Errorf("Sample outside of truncation interval case %d: [%v, %v]", i, x[0], x[len(x)-1])
+		}
+		checkMean(t, i, x, meanOf(wantMean), 1e-2)
+		testRandLogProbContinuous(t, i, math.Max(test.lower, test.mu-20*test.sigma), x, tr, 1e-2, 50)
+		checkProbContinuous(t, i, x, test.lower, test.upper, tr, 1e-10)
+		checkProbQuantContinuous(t, i, x, tr, 1e-2)
+		checkQuantileCDFSurvival(t, i, x, tr, 5e-3)
+
+		if p := tr.Prob(test.lower - 1); p != 0 {
+			t.Errorf("Non-zero probability below lower bound case %d: %v", i, p)
+		}
+		if p := tr.Prob(test.upper + 1); p != 0 {
+			t.Errorf("Non-zero probability above upper bound case %d: %v", i, p)
+		}
+		if c := tr.CDF(test.upper); c != 1 {
+			t.Errorf("CDF at upper bound not 1 case %d: %v", i, c)
+		}
+	}
+
+	if !panics(func() { Truncated{Dist: Normal{0, 1, nil}, Lower: 1, Upper: 0}.Quantile(0.5) }) {
+		t.Errorf("Expected panic for lower bound greater than upper bound")
+	}
+}
+
+func TestTruncatedGamma(t *testing.T) {
+	t.Parallel()
+	g := Gamma{Alpha: 2, Beta: 1}
+	tr := Truncated{Dist: g, Lower: 1, Upper: math.Inf(1), Src: rand.NewSource(1)}
+	x := make([]float64, 5e5)
+	generateSamples(x, tr)
+	sort.Float64s(x)
+	checkProbContinuous(t, 0, x, 1, math.Inf(1), tr, 1e-10)
+	checkProbQuantContinuous(t, 0, x, tr, 1e-2)
+	checkQuantileCDFSurvival(t, 0, x, tr, 5e-3)
+	// The Gamma(2, 1) distribution truncated below at 1 has
+	// mean E[X; X>1]/P(X>1) = (3/e)/(2/e) = 1.5+1 = 2.5.
+	checkMean(t, 0, x, meanOf(2.5), 1e-2)
+}
+
+// meanOf is a meaner with a known mean.
+type meanOf float64
+
+func (m meanOf) Mean() float64 { return float64(m) }
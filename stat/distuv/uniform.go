@@ -45,7 +45,17 @@ func (Uniform) ExKurtosis() float64 {
 	return -6.0 / 5.0
 }
 
-// Uniform doesn't have Fit because it's a bad idea to fit a uniform from data.
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates of Min and Max are the minimum and maximum of the samples
+// with non-zero weight.
+func (u *Uniform) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	u.Min, u.Max = sampleRange(samples, weights)
+}
 
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (u Uniform) LogProb(x float64) float64 {
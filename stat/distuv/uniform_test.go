@@ -135,3 +135,8 @@ func TestUniformScoreInput(t *testing.T) {
 		}
 	}
 }
+
+func TestUniformFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Uniform", &Uniform{Min: 1, Max: 3, Src: rand.NewSource(1)}, func() fitTester { return &Uniform{} }, 10000, 0.05, false)
+}
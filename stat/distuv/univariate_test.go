@@ -0,0 +1,319 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestProbCDFValues(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		dist interface {
+			Prob(float64) float64
+			CDF(float64) float64
+		}
+		x, wantProb, wantCDF float64
+		probTol, cdfTol      float64
+	}{
+		// Values calculated from the closed form expressions.
+		{"Logistic", Logistic{Mu: 0, Scale: 1}, 0, 0.25, 0.5, 1e-14, 1e-14},
+		{"Logistic", Logistic{Mu: 0, Scale: 1}, 1.5, 0.14914645207033284, 0.8175744761936437, 1e-14, 1e-14},
+		{"Logistic", Logistic{Mu: 1, Scale: 2}, -3, 0.05249679270175326, 0.11920292202211755, 1e-14, 1e-14},
+		{"Logistic", Logistic{Mu: 2, Scale: 0.5}, 10, 2.2507029878186457e-07, 0.9999998874648379, 1e-14, 1e-14},
+		{"Cauchy", Cauchy{Mu: 0, Scale: 1}, 0, 0.3183098861837907, 0.5, 1e-14, 1e-14},
+		{"Cauchy", Cauchy{Mu: 0, Scale: 1}, 1.5, 0.09794150344116635, 0.8128329581890013, 1e-14, 1e-14},
+		{"Cauchy", Cauchy{Mu: 1, Scale: 2}, -3, 0.03183098861837907, 0.14758361765043326, 1e-14, 1e-14},
+		{"Cauchy", Cauchy{Mu: 2, Scale: 0.5}, 10, 0.002477119736838838, 0.9801314756944592, 1e-14, 1e-14},
+
+		// Values calculated from the closed form of the density and of the
+		// CDF for integer Mu, and by Simpson's rule integration of the
+		// density otherwise.
+		{"Nakagami", Nakagami{Mu: 1, Omega: 1}, 0.5, 0.7788007830714049, 0.22119921692859512, 1e-13, 1e-10},
+		{"Nakagami", Nakagami{Mu: 2, Omega: 1}, 1, 1.0826822658929016, 0.5939941502901619, 1e-13, 1e-10},
+		{"Nakagami", Nakagami{Mu: 0.75, Omega: 3}, 2, 0.3002076276840174, 0.7399800305302534, 1e-13, 1e-10},
+		{"Nakagami", Nakagami{Mu: 3, Omega: 2}, 1.3, 0.9932396016698992, 0.46513300145817416, 1e-13, 1e-10},
+
+		// Values calculated from the power series of I0 and by Simpson's
+		// rule integration of the density.
+		{"Rice", Rice{Nu: 0, Sigma: 1}, 0.5, 0.4412484512922977, 0.1175030974154051, 1e-13, 1e-12},
+		{"Rice", Rice{Nu: 1, Sigma: 1}, 1, 0.4657596075936404, 0.2671201962031792, 1e-13, 1e-12},
+		{"Rice", Rice{Nu: 2, Sigma: 0.5}, 2.5, 0.544545117967095, 0.8125952832811961, 1e-13, 1e-12},
+		{"Rice", Rice{Nu: 3, Sigma: 1.5}, 4, 0.25246381966838694, 0.6713352125729616, 1e-13, 1e-12},
+		{"VonMises", VonMises{Mu: 0, Kappa: 1}, 0, 0.3417104886234632, 0.5, 1e-13, 1e-12},
+		{"VonMises", VonMises{Mu: 0, Kappa: 1}, 1, 0.21578146511029628, 0.7943553074346813, 1e-13, 1e-12},
+		{"VonMises", VonMises{Mu: 0.5, Kappa: 4}, -2, 0.0005713981395560911, 0.00022303809883820398, 1e-13, 1e-12},
+		{"VonMises", VonMises{Mu: 0, Kappa: 0.5}, 0.3, 0.2412893264075786, 0.5734725265508175, 1e-13, 1e-12},
+		{"VonMises", VonMises{Mu: 1, Kappa: 10}, 2.5, 0.00011466713180239101, 0.9999884638831714, 1e-13, 1e-12},
+
+		// Values calculated exactly with rational arithmetic for integer
+		// parameters and by direct summation otherwise.
+		{"NegativeBinomial", NegativeBinomial{R: 3, P: 0.4}, 0, 0.064, 0.064, 1e-12, 1e-12},
+		{"NegativeBinomial", NegativeBinomial{R: 3, P: 0.4}, 2, 0.13824, 0.31744, 1e-12, 1e-12},
+		{"NegativeBinomial", NegativeBinomial{R: 5, P: 0.6}, 7, 0.04204265472, 0.94269007872, 1e-12, 1e-12},
+		{"NegativeBinomial", NegativeBinomial{R: 1, P: 0.25}, 4, 0.0791015625, 0.7626953125, 1e-12, 1e-12},
+		{"NegativeBinomial", NegativeBinomial{R: 2.5, P: 0.5}, 3, 0.14501213286052236, 0.7361092077586519, 1e-12, 1e-12},
+		{"Geometric", Geometric{P: 0.3}, 0, 0.3, 0.3, 1e-14, 1e-14},
+		{"Geometric", Geometric{P: 0.3}, 3, 0.1029, 0.7599, 1e-14, 1e-14},
+		{"Geometric", Geometric{P: 0.05}, 10, 0.029936846961918947, 0.43119990772354005, 1e-14, 1e-14},
+		{"Geometric", Geometric{P: 0.9}, 2, 0.009, 0.999, 1e-14, 1e-14},
+		{"Hypergeometric", Hypergeometric{N: 50, K: 5, Draws: 10}, 0, 0.3105627820045687, 0.3105627820045687, 1e-12, 1e-12},
+		{"Hypergeometric", Hypergeometric{N: 50, K: 5, Draws: 10}, 1, 0.43133719722856767, 0.7418999792331363, 1e-12, 1e-12},
+		{"Hypergeometric", Hypergeometric{N: 20, K: 7, Draws: 12}, 3, 0.19865841073271415, 0.25077399380804954, 1e-12, 1e-12},
+		{"Hypergeometric", Hypergeometric{N: 20, K: 7, Draws: 12}, 5, 0.28606811145510835, 0.8944272445820434, 1e-12, 1e-12},
+		{"Hypergeometric", Hypergeometric{N: 10, K: 4, Draws: 3}, 2, 0.3, 0.9666666666666667, 1e-12, 1e-12},
+	} {
+		prob := test.dist.Prob(test.x)
+		if !scalar.EqualWithinAbsOrRel(prob, test.wantProb, test.probTol, test.probTol) {
+			t.Errorf("%s %+v: Prob mismatch at %v: got %v, want %v", test.name, test.dist, test.x, prob, test.wantProb)
+		}
+		cdf := test.dist.CDF(test.x)
+		if !scalar.EqualWithinAbsOrRel(cdf, test.wantCDF, test.cdfTol, test.cdfTol) {
+			t.Errorf("%s %+v: CDF mismatch at %v: got %v, want %v", test.name, test.dist, test.x, cdf, test.wantCDF)
+		}
+	}
+}
+
+// continuousDist is a continuous distribution checked by TestContinuous.
+type continuousDist interface {
+	Rander
+	cumulantProber
+	entropyer
+	meaner
+	varStder
+}
+
+func TestContinuous(t *testing.T) {
+	t.Parallel()
+	const (
+		tol  = 1e-2
+		n    = 5e5
+		bins = 50
+	)
+	src := rand.New(rand.NewSource(1))
+	for i, test := range []struct {
+		dist         continuousDist
+		lower, upper float64
+		// probTol is the tolerance of the integral of the density.
+		probTol float64
+		// medianTol is the tolerance of the median if it is not tol.
+		medianTol float64
+		// checkMode is whether the mode is compared to the samples.
+		checkMode bool
+	}{
+		{dist: Logistic{0, 1, src}, lower: math.Inf(-1), upper: math.Inf(1), probTol: 1e-10},
+		{dist: Logistic{-5, 6, src}, lower: math.Inf(-1), upper: math.Inf(1), probTol: 1e-10},
+		{dist: Logistic{3, 0.1, src}, lower: math.Inf(-1), upper: math.Inf(1), probTol: 1e-10},
+		{dist: Cauchy{0, 1, src}, lower: math.Inf(-1), upper: math.Inf(1), probTol: 1e-8},
+		{dist: Cauchy{-5, 6, src}, lower: math.Inf(-1), upper: math.Inf(1), probTol: 1e-8},
+		{dist: Cauchy{3, 0.1, src}, lower: math.Inf(-1), upper: math.Inf(1), probTol: 1e-8},
+		{dist: Nakagami{0.5, 1, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10},
+		{dist: Nakagami{1, 2, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10, checkMode: true},
+		{dist: Nakagami{3, 0.5, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10, checkMode: true},
+		{dist: Nakagami{10, 4, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10, checkMode: true},
+		{dist: Rice{0, 1, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10},
+		{dist: Rice{1, 1, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10},
+		{dist: Rice{5, 0.5, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10},
+		{dist: Rice{2, 3, src}, lower: 0, upper: math.Inf(1), probTol: 1e-10},
+		{dist: VonMises{0, 1, src}, lower: -math.Pi, upper: math.Pi, probTol: 1e-10, medianTol: 2e-2},
+		{dist: VonMises{1, 0.1, src}, lower: 1 - math.Pi, upper: 1 + math.Pi, probTol: 1e-10, medianTol: 2e-2},
+		{dist: VonMises{-2, 4, src}, lower: -2 - math.Pi, upper: -2 + math.Pi, probTol: 1e-10, medianTol: 2e-2},
+		{dist: VonMises{0.5, 50, src}, lower: 0.5 - math.Pi, upper: 0.5 + math.Pi, probTol: 1e-10, medianTol: 2e-2},
+	} {
+		d := test.dist
+		x := make([]float64, n)
+		generateSamples(x, d)
+		sort.Float64s(x)
+
+		if x[0] < test.lower || x[len(x)-1] > test.upper {
+			t.Errorf("Sample outside of support case %d: [%v, %v] not in [%v, %v]", i, x[0], x[len(x)-1], test.lower, test.upper)
+		}
+		testRandLogProbContinuous(t, i, test.lower, x, d, tol, bins)
+		checkProbContinuous(t, i, x, test.lower, test.upper, d, test.probTol)
+		checkEntropy(t, i, x, d, tol)
+		checkQuantileCDFSurvival(t, i, x, d, 5e-3)
+		if m, ok := d.(medianer); ok {
+			medianTol := test.medianTol
+			if medianTol == 0 {
+				medianTol = tol
+			}
+			checkMedian(t, i, x, m, medianTol)
+		}
+		if test.checkMode {
+			checkMode(t, i, x, d.(moder), 5e-2, 1e-1)
+		}
+
+		// The remaining checks require finite moments.
+		if math.IsNaN(d.Mean()) {
+			continue
+		}
+		checkProbQuantContinuous(t, i, x, d, tol)
+		checkMean(t, i, x, d, tol)
+		checkVarAndStd(t, i, x, d, tol)
+		if s, ok := d.(skewnesser); ok {
+			checkSkewness(t, i, x, s, 5e-2)
+		}
+		if k, ok := d.(exKurtosiser); ok {
+			checkExKurtosis(t, i, x, k, 5e-2)
+		}
+	}
+}
+
+// discreteDist is a discrete distribution checked by TestDiscrete.
+type discreteDist interface {
+	Rander
+	cumulantProber
+	entropyer
+	varStder
+	skewnesser
+	exKurtosiser
+}
+
+func TestDiscrete(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-2
+	src := rand.New(rand.NewSource(1))
+	for i, test := range []struct {
+		dist    discreteDist
+		n       int
+		kurtTol float64
+	}{
+		{dist: NegativeBinomial{1, 0.5, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: NegativeBinomial{3, 0.4, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: NegativeBinomial{0.5, 0.2, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: NegativeBinomial{20, 0.7, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: Geometric{0.5, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: Geometric{0.1, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: Geometric{0.9, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: Geometric{0.02, src}, n: 1e6, kurtTol: 1e-1},
+		{dist: Hypergeometric{50, 5, 10, src}, n: 5e5, kurtTol: 5e-2},
+		{dist: Hypergeometric{20, 7, 12, src}, n: 5e5, kurtTol: 5e-2},
+		{dist: Hypergeometric{100, 60, 80, src}, n: 5e5, kurtTol: 5e-2},
+		{dist: Hypergeometric{500, 250, 100, src}, n: 5e5, kurtTol: 5e-2},
+	} {
+		d := test.dist
+		x := make([]float64, test.n)
+		generateSamples(x, d)
+		sort.Float64s(x)
+
+		checkProbDiscrete(t, i, x, d, 2e-3)
+		checkMean(t, i, x, d, tol)
+		checkVarAndStd(t, i, x, d, tol)
+		checkEntropy(t, i, x, d, tol)
+		checkExKurtosis(t, i, x, d, test.kurtTol)
+		checkSkewness(t, i, x, d, 2e-2)
+		checkQuantileDiscrete(t, i, d)
+		if m, ok := d.(moder); ok {
+			checkMode(t, i, x, m, 1, 0)
+		}
+		if m, ok := d.(medianer); ok {
+			checkMedian(t, i, x, m, tol)
+		}
+		if cdf := d.CDF(-0.0001); cdf != 0 {
+			t.Errorf("Mismatch in CDF for x < 0 case %d: got %v, want 0", i, cdf)
+		}
+		if surv := d.Survival(-0.0001); surv != 1 {
+			t.Errorf("Mismatch in Survival for x < 0 case %d: got %v, want 1", i, surv)
+		}
+		if lp := d.LogProb(x[0] + 0.5); !math.IsInf(lp, -1) {
+			t.Errorf("Mismatch in LogProb for non-integer x case %d: got %v, want -Inf", i, lp)
+		}
+	}
+}
+
+func TestScoreContinuous(t *testing.T) {
+	t.Parallel()
+	for _, test := range []derivParamTester{
+		&Logistic{Mu: 0, Scale: 1},
+		&Logistic{Mu: -2, Scale: 3.5},
+		&Logistic{Mu: 4, Scale: 0.7},
+		&Cauchy{Mu: 0, Scale: 1},
+		&Cauchy{Mu: -2, Scale: 3.5},
+		&Cauchy{Mu: 4, Scale: 0.7},
+		&Nakagami{Mu: 1, Omega: 1},
+		&Nakagami{Mu: 0.6, Omega: 3},
+		&Nakagami{Mu: 4, Omega: 0.5},
+		&Rice{Nu: 1, Sigma: 1},
+		&Rice{Nu: 0.2, Sigma: 2},
+		&Rice{Nu: 5, Sigma: 1.5},
+		&VonMises{Mu: 0, Kappa: 1},
+		&VonMises{Mu: -1, Kappa: 0.3},
+		&VonMises{Mu: 2, Kappa: 5},
+	} {
+		testDerivParam(t, test)
+	}
+}
+
+func TestScoreDiscrete(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		dist scoreParamTester
+	}{
+		{"NegativeBinomial", &NegativeBinomial{R: 1, P: 0.5}},
+		{"NegativeBinomial", &NegativeBinomial{R: 3, P: 0.4}},
+		{"NegativeBinomial", &NegativeBinomial{R: 0.5, P: 0.2}},
+		{"NegativeBinomial", &NegativeBinomial{R: 20, P: 0.7}},
+		{"Geometric", &Geometric{P: 0.5}},
+		{"Geometric", &Geometric{P: 0.1}},
+		{"Geometric", &Geometric{P: 0.9}},
+		{"Geometric", &Geometric{P: 0.02}},
+	} {
+		testScoreDiscrete(t, test.name, test.dist, []float64{0, 1, 5, 20})
+	}
+}
+
+func TestModeSymmetric(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		dist moder
+		want float64
+	}{
+		{Logistic{Mu: 0, Scale: 1}, 0},
+		{Logistic{Mu: -5, Scale: 6}, -5},
+		{Cauchy{Mu: 0, Scale: 1}, 0},
+		{Cauchy{Mu: 3, Scale: 0.1}, 3},
+		{VonMises{Mu: 1, Kappa: 0.1}, 1},
+		{VonMises{Mu: -2, Kappa: 4}, -2},
+	} {
+		if got := test.dist.Mode(); got != test.want {
+			t.Errorf("Mismatch in mode value of %T: got %v, want %g", test.dist, got, test.want)
+		}
+	}
+}
+
+func TestFitDistributions(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		want interface {
+			Parametric
+			Rander
+		}
+		newFit    func() fitTester
+		tol       float64
+		numParams int
+	}{
+		{"Cauchy", &Cauchy{Mu: 1, Scale: 2, Src: rand.NewSource(1)}, func() fitTester { return &Cauchy{} }, 0.05, 2},
+		{"Geometric", &Geometric{P: 0.2, Src: rand.NewSource(1)}, func() fitTester { return &Geometric{} }, 0.05, 1},
+		{"Logistic", &Logistic{Mu: 1, Scale: 2, Src: rand.NewSource(1)}, func() fitTester { return &Logistic{} }, 0.05, 2},
+		{"Nakagami", &Nakagami{Mu: 2, Omega: 3, Src: rand.NewSource(1)}, func() fitTester { return &Nakagami{} }, 0.05, 2},
+		{"NegativeBinomial", &NegativeBinomial{R: 3, P: 0.4, Src: rand.NewSource(1)}, func() fitTester { return &NegativeBinomial{} }, 0.1, 2},
+		{"Rice", &Rice{Nu: 3, Sigma: 1.5, Src: rand.NewSource(1)}, func() fitTester { return &Rice{} }, 0.05, 2},
+		{"VonMises", &VonMises{Mu: 1, Kappa: 2, Src: rand.NewSource(1)}, func() fitTester { return &VonMises{} }, 0.05, 2},
+	} {
+		if got := test.want.NumParameters(); got != test.numParams {
+			t.Errorf("%s: mismatch in NumParameters: got %v, want %v", test.name, got, test.numParams)
+		}
+		testFit(t, test.name, test.want, test.newFit, 10000, test.tol, true)
+	}
+}
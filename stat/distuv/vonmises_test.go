@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestVonMisesPeriodic(t *testing.T) {
+	t.Parallel()
+	for _, v := range []VonMises{
+		{Mu: 0, Kappa: 1},
+		{Mu: 0.5, Kappa: 4},
+		{Mu: 0, Kappa: 0.5},
+		{Mu: 1, Kappa: 10},
+	} {
+		for _, x := range []float64{-2, 0, 0.3, 1, 2.5} {
+			pdf := v.Prob(x)
+			if pdf2 := v.Prob(x + 2*math.Pi); !scalar.EqualWithinAbsOrRel(pdf2, pdf, 1e-13, 1e-13) {
+				t.Errorf("Prob not periodic, x = %v, mu = %v, kappa = %v. Got %v, want %v", x, v.Mu, v.Kappa, pdf2, pdf)
+			}
+		}
+	}
+}
+
+func TestVonMisesFitAngles(t *testing.T) {
+	t.Parallel()
+	// The fit must not depend on the representation of the angles.
+	samples := randn(VonMises{Mu: 3, Kappa: 5, Src: rand.NewSource(1)}, 1000)
+	var v1, v2 VonMises
+	v1.Fit(samples, nil)
+	for i := range samples {
+		samples[i] = math.Remainder(samples[i], 2*math.Pi)
+	}
+	v2.Fit(samples, nil)
+	if !scalar.EqualWithinAbsOrRel(v1.Mu, v2.Mu, 1e-12, 1e-12) || !scalar.EqualWithinAbsOrRel(v1.Kappa, v2.Kappa, 1e-12, 1e-12) {
+		t.Errorf("Fit depends on angle representation: got %v, want %v", v2, v1)
+	}
+}
+
+func TestBesselIe(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, want0, want1 float64
+	}{
+		// Values calculated from the power series in 60 digit decimal arithmetic.
+		{0, 1, 0},
+		{0.5, 0.6450352704491501, 0.1564208031848717},
+		{2, 0.30850832255367105, 0.21526928924893765},
+		{20, 0.08978031188482602, 0.08750622218328867},
+		{30, 0.0731459464822373, 0.07191633059864755},
+		{100, 0.03994437929909668, 0.03974415302513025},
+	} {
+		got0 := besselIe(0, test.x)
+		if !scalar.EqualWithinAbsOrRel(got0, test.want0, 1e-14, 1e-14) {
+			t.Errorf("unexpected I0e(%v): got %v, want %v", test.x, got0, test.want0)
+		}
+		got1 := besselIe(1, test.x)
+		if !scalar.EqualWithinAbsOrRel(got1, test.want1, 1e-14, 1e-14) {
+			t.Errorf("unexpected I1e(%v): got %v, want %v", test.x, got1, test.want1)
+		}
+		if test.x == 0 {
+			continue
+		}
+		r := make([]float64, 3)
+		besselRatios(r, test.x)
+		if !scalar.EqualWithinAbsOrRel(r[0], besselRatio(test.x), 1e-14, 1e-14) {
+			t.Errorf("unexpected I1/I0 at %v: got %v, want %v", test.x, r[0], besselRatio(test.x))
+		}
+		// Check the recurrence I_{j+1} = I_{j-1} - 2j/x I_j.
+		if want := 1 - 2/test.x*r[0]; !scalar.EqualWithinAbsOrRel(r[1], want, 1e-12, 1e-12) {
+			t.Errorf("unexpected I2/I0 at %v: got %v, want %v", test.x, r[1], want)
+		}
+		if !scalar.EqualWithinAbsOrRel(invBesselRatio(r[0]), test.x, 1e-10, 1e-10) {
+			t.Errorf("unexpected inverse of I1/I0 at %v: got %v", test.x, invBesselRatio(r[0]))
+		}
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat"
 )
 
 // Weibull distribution. Valid range for x is [0,+∞).
@@ -45,6 +48,67 @@ func (w Weibull) gammaIPow(i, pow float64) float64 {
 	return math.Pow(math.Gamma(1+i/w.K), pow)
 }
 
+// Fit sets the parameters of the probability distribution to the maximum
+// likelihood estimates from the data samples with relative weights.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (w *Weibull) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	// The estimate of K does not depend on the scale of the samples, so
+	// they are scaled by their maximum to avoid overflow.
+	_, max := sampleRange(samples, weights)
+	logs := make([]float64, len(samples))
+	for i, x := range samples {
+		logs[i] = math.Log(x / max)
+	}
+	meanLog := stat.Mean(logs, weights)
+
+	// sums returns the weighted sums of x^k, x^k log(x) and x^k log(x)^2.
+	sums := func(k float64) (s0, s1, s2 float64) {
+		for i, l := range logs {
+			wt := 1.0
+			if weights != nil {
+				wt = weights[i]
+				if wt == 0 {
+					continue
+				}
+			}
+			e := wt * math.Exp(k*l)
+			s0 += e
+			s1 += e * l
+			s2 += e * l * l
+		}
+		return s0, s1, s2
+	}
+
+	// Initialize with the method of moments estimate for the logarithms
+	// of the samples, which are Gumbel distributed, and solve the
+	// likelihood equation for K with Newton's method.
+	k := math.Pi / (math.Sqrt(6) * stat.StdDev(logs, weights))
+	for i := 0; i < 100; i++ {
+		s0, s1, s2 := sums(k)
+		mean := s1 / s0
+		f := mean - 1/k - meanLog
+		df := s2/s0 - mean*mean + 1/(k*k)
+		next := k - f/df
+		if next <= 0 {
+			next = k / 2
+		}
+		done := math.Abs(next-k) <= 1e-14*k
+		k = next
+		if done {
+			break
+		}
+	}
+	s0, _, _ := sums(k)
+	sumWeights := float64(len(samples))
+	if weights != nil {
+		sumWeights = floats.Sum(weights)
+	}
+	w.K = k
+	w.Lambda = max * math.Pow(s0/sumWeights, 1/k)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x. -Inf is returned if x is less than zero.
 //
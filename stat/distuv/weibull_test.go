@@ -270,3 +270,8 @@ func testWeibull(t *testing.T, dist Weibull, i int) {
 	checkProbQuantContinuous(t, i, x, dist, tol)
 	checkMode(t, i, x, dist, 1e-1, 2e-1)
 }
+
+func TestWeibullFit(t *testing.T) {
+	t.Parallel()
+	testFit(t, "Weibull", &Weibull{K: 1.5, Lambda: 2, Src: rand.NewSource(1)}, func() fitTester { return &Weibull{} }, 10000, 0.05, true)
+}
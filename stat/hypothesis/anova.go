@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// OneWayANOVA performs the one-way analysis of variance test of the null
+// hypothesis that the populations from which the groups are drawn have equal
+// means. The statistic is
+//  F = (SSB / (k-1)) / (SSW / (N-k))
+// where SSB and SSW are the between-group and within-group sums of squares,
+// k is the number of groups and N is the total number of samples. Under the
+// null hypothesis, when the populations are normal with equal variances, F
+// has an F distribution with DF = k-1 and DF2 = N-k degrees of freedom. The
+// returned Result has a NaN confidence interval.
+//
+// OneWayANOVA panics if there are fewer than two groups, if any group is
+// empty or if N is not greater than k.
+func OneWayANOVA(groups [][]float64) Result {
+	k := len(groups)
+	if k < 2 {
+		panic(tooFewSamples)
+	}
+	var n int
+	var sum float64
+	for _, g := range groups {
+		if len(g) == 0 {
+			panic(tooFewSamples)
+		}
+		n += len(g)
+		sum += floats.Sum(g)
+	}
+	if n <= k {
+		panic(tooFewSamples)
+	}
+	mean := sum / float64(n)
+	var ssb, ssw float64
+	for _, g := range groups {
+		gm := floats.Sum(g) / float64(len(g))
+		d := gm - mean
+		ssb += float64(len(g)) * d * d
+		for _, v := range g {
+			d := v - gm
+			ssw += d * d
+		}
+	}
+	df1 := float64(k - 1)
+	df2 := float64(n - k)
+	f := (ssb / df1) / (ssw / df2)
+	r := newResult(f, distuv.F{D1: df1, D2: df2}.Survival(f))
+	r.DF = df1
+	r.DF2 = df2
+	return r
+}
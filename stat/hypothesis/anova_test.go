@@ -0,0 +1,43 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOneWayANOVA(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	// R's PlantGrowth data set. Values were computed using R's
+	// anova(lm(weight ~ group, PlantGrowth)).
+	plantGrowth := [][]float64{
+		{4.17, 5.58, 5.18, 6.11, 4.50, 4.61, 5.17, 4.53, 5.33, 5.14},
+		{4.81, 4.17, 4.41, 3.59, 5.87, 3.83, 6.03, 4.89, 4.32, 4.69},
+		{6.31, 5.12, 5.54, 5.50, 5.37, 5.29, 4.92, 6.15, 5.80, 5.26},
+	}
+	got := OneWayANOVA(plantGrowth)
+	want := Result{Statistic: 4.846088, DF: 2, DF2: 27, PValue: 0.01590996, Lower: nan, Upper: nan}
+	checkResult(t, "PlantGrowth", got, want, 1e-6)
+
+	// The one-way ANOVA of two groups is the square of the pooled
+	// two-sample t-test.
+	got = OneWayANOVA([][]float64{sleep1, sleep2})
+	tt := TwoSampleTTest(sleep1, sleep2, 0, TwoSided, 0.95)
+	want = Result{Statistic: tt.Statistic * tt.Statistic, DF: 1, DF2: tt.DF, PValue: tt.PValue, Lower: nan, Upper: nan}
+	checkResult(t, "sleep", got, want, 1e-10)
+
+	for _, groups := range [][][]float64{
+		nil,
+		{sleep1},
+		{sleep1, nil},
+		{{1}, {2}},
+	} {
+		if !panics(func() { OneWayANOVA(groups) }) {
+			t.Errorf("expected panic for groups %v", groups)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hypothesis provides statistical hypothesis tests.
+//
+// Each test returns a Result holding the test statistic, the degrees of
+// freedom of its reference distribution, the p-value and, where the test
+// estimates a parameter, a confidence interval. The p-value is the
+// probability under the null hypothesis of a statistic at least as extreme
+// as the one observed, so small p-values are evidence against the null
+// hypothesis.
+package hypothesis // import "gonum.org/v1/gonum/stat/hypothesis"
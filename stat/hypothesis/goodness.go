@@ -0,0 +1,280 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// exactKSLimit is the sample size below which the exact distribution of the
+// one-sample Kolmogorov–Smirnov statistic is used.
+const exactKSLimit = 1000
+
+// KolmogorovSmirnov performs the one-sample Kolmogorov–Smirnov test of the
+// null hypothesis that x is drawn from the continuous distribution with the
+// cumulative distribution function cdf, which must be fully specified rather
+// than estimated from x. The statistic is
+//  D = sup_t |F_n(t) - F(t)|
+// where F_n is the empirical distribution function of x. The p-value is
+// computed with the exact method of Marsaglia, Tsang and Wang for fewer than
+// 1000 samples and from the asymptotic Kolmogorov distribution otherwise.
+// The returned Result has NaN degrees of freedom and confidence interval.
+//
+// References:
+//  Marsaglia, G., Tsang, W. W. and Wang, J. (2003). Evaluating Kolmogorov's
+//  distribution. Journal of Statistical Software, 8(18), 1-4.
+//
+// KolmogorovSmirnov panics if len(x) is zero.
+func KolmogorovSmirnov(x []float64, cdf func(float64) float64) Result {
+	n := len(x)
+	if n == 0 {
+		panic(tooFewSamples)
+	}
+	x = append([]float64(nil), x...)
+	sort.Float64s(x)
+	nf := float64(n)
+	var d float64
+	for i, v := range x {
+		f := cdf(v)
+		d = math.Max(d, math.Max(float64(i+1)/nf-f, f-float64(i)/nf))
+	}
+	var p float64
+	if n < exactKSLimit {
+		p = 1 - kolmogorovCDF(n, d)
+	} else {
+		p = kolmogorovSurvival(math.Sqrt(nf) * d)
+	}
+	return newResult(d, math.Max(0, math.Min(1, p)))
+}
+
+// KolmogorovSmirnovTwoSample performs the two-sample Kolmogorov–Smirnov test
+// of the null hypothesis that x and y are drawn from the same continuous
+// distribution. The statistic is the largest distance between the empirical
+// distribution functions of x and y as returned by stat.KolmogorovSmirnov.
+// The p-value is computed from the exact null distribution of the statistic
+// when len(x)*len(y) is less than 10000 and there are no ties between the
+// samples, and from the asymptotic Kolmogorov distribution otherwise. The
+// returned Result has NaN degrees of freedom and confidence interval.
+//
+// KolmogorovSmirnovTwoSample panics if len(x) or len(y) is zero.
+func KolmogorovSmirnovTwoSample(x, y []float64) Result {
+	if len(x) == 0 || len(y) == 0 {
+		panic(tooFewSamples)
+	}
+	x = append([]float64(nil), x...)
+	y = append([]float64(nil), y...)
+	sort.Float64s(x)
+	sort.Float64s(y)
+	d := stat.KolmogorovSmirnov(x, nil, y, nil)
+
+	_, ties := rank(append(append(make([]float64, 0, len(x)+len(y)), x...), y...))
+	n := float64(len(x))
+	m := float64(len(y))
+	var p float64
+	if n*m < 10000 && ties == 0 {
+		p = 1 - smirnovCDF(len(x), len(y), d)
+	} else {
+		p = kolmogorovSurvival(math.Sqrt(n*m/(n+m)) * d)
+	}
+	return newResult(d, math.Max(0, math.Min(1, p)))
+}
+
+// ChiSquare performs Pearson's chi-square goodness of fit test of the null
+// hypothesis that the observed frequencies obs are drawn from a distribution
+// with the expected frequencies exp. The statistic is
+//  χ² = Σ_i (obs_i - exp_i)² / exp_i
+// as returned by stat.ChiSquare. Under the null hypothesis, the statistic has
+// approximately a chi-square distribution with DF = len(obs)-1-ddof degrees
+// of freedom, where ddof is the number of parameters of the distribution
+// estimated from the observations. The returned Result has a NaN confidence
+// interval.
+//
+// ChiSquare panics if len(obs) and len(exp) are not equal or if the degrees
+// of freedom are not positive.
+func ChiSquare(obs, exp []float64, ddof int) Result {
+	if len(obs) != len(exp) {
+		panic(badLength)
+	}
+	df := len(obs) - 1 - ddof
+	if df < 1 {
+		panic(tooFewSamples)
+	}
+	return chiSquareResult(stat.ChiSquare(obs, exp), float64(df))
+}
+
+// ChiSquareIndependence performs Pearson's chi-square test of the null
+// hypothesis that the row and column variables of the contingency table of
+// observed frequencies are independent. The expected frequency of each cell
+// is the product of its row and column totals divided by the table total.
+// Under the null hypothesis, the statistic has approximately a chi-square
+// distribution with DF = (r-1)(c-1) degrees of freedom for a table with r rows
+// and c columns. No continuity correction is applied. The returned Result has
+// a NaN confidence interval.
+//
+// ChiSquareIndependence panics if the table has fewer than two rows or
+// columns.
+func ChiSquareIndependence(table mat.Matrix) Result {
+	r, c := table.Dims()
+	if r < 2 || c < 2 {
+		panic(tooFewSamples)
+	}
+	rows := make([]float64, r)
+	cols := make([]float64, c)
+	var total float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := table.At(i, j)
+			rows[i] += v
+			cols[j] += v
+			total += v
+		}
+	}
+	var chi2 float64
+	for i, rv := range rows {
+		for j, cv := range cols {
+			e := rv * cv / total
+			d := table.At(i, j) - e
+			chi2 += d * d / e
+		}
+	}
+	return chiSquareResult(chi2, float64((r-1)*(c-1)))
+}
+
+func chiSquareResult(chi2, df float64) Result {
+	r := newResult(chi2, distuv.ChiSquared{K: df}.Survival(chi2))
+	r.DF = df
+	return r
+}
+
+// kolmogorovCDF returns the probability that the one-sample
+// Kolmogorov–Smirnov statistic of n samples is less than d using the method
+// of Marsaglia, Tsang and Wang.
+func kolmogorovCDF(n int, d float64) float64 {
+	nf := float64(n)
+	nd := nf * d
+	s := nd * d
+	if s > 7.24 || (s > 3.76 && n > 99) {
+		return 1 - 2*math.Exp(-(2.000071+0.331/math.Sqrt(nf)+1.409/nf)*s)
+	}
+	k := int(nd) + 1
+	m := 2*k - 1
+	h := float64(k) - nd
+
+	hm := mat.NewDense(m, m, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i-j+1 >= 0 {
+				hm.Set(i, j, 1)
+			}
+		}
+	}
+	for i := 0; i < m; i++ {
+		hm.Set(i, 0, hm.At(i, 0)-math.Pow(h, float64(i+1)))
+		hm.Set(m-1, i, hm.At(m-1, i)-math.Pow(h, float64(m-i)))
+	}
+	if 2*h-1 > 0 {
+		hm.Set(m-1, 0, hm.At(m-1, 0)+math.Pow(2*h-1, float64(m)))
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i-j+1 > 0 {
+				hm.Set(i, j, hm.At(i, j)/math.Gamma(float64(i-j+2)))
+			}
+		}
+	}
+
+	q, e := kolmogorovPower(hm, n)
+	s = q.At(k-1, k-1)
+	for i := 1; i <= n; i++ {
+		s *= float64(i) / nf
+		if s < 1e-140 {
+			s *= 1e140
+			e -= 140
+		}
+	}
+	return s * math.Pow(10, float64(e))
+}
+
+// kolmogorovPower returns a matrix q and exponent e such that a^n = q×10^e,
+// rescaling the intermediate powers to avoid overflow.
+func kolmogorovPower(a *mat.Dense, n int) (q *mat.Dense, e int) {
+	if n == 1 {
+		return mat.DenseCopyOf(a), 0
+	}
+	v, ev := kolmogorovPower(a, n/2)
+	var b mat.Dense
+	b.Mul(v, v)
+	e = 2 * ev
+	if n%2 == 0 {
+		q = &b
+	} else {
+		q = &mat.Dense{}
+		q.Mul(a, &b)
+	}
+	m, _ := q.Dims()
+	if q.At(m/2, m/2) > 1e140 {
+		q.Scale(1e-140, q)
+		e += 140
+	}
+	return q, e
+}
+
+// kolmogorovSurvival returns the probability that a random variable with
+// the asymptotic Kolmogorov distribution is greater than x.
+func kolmogorovSurvival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	if x < 1.18 {
+		z := -math.Pi * math.Pi / (8 * x * x)
+		var s float64
+		for k := 1; k <= 7; k += 2 {
+			s += math.Exp(float64(k*k) * z)
+		}
+		return 1 - math.Sqrt(2*math.Pi)/x*s
+	}
+	z := math.Exp(-2 * x * x)
+	return 2 * (z - math.Pow(z, 4) + math.Pow(z, 9) - math.Pow(z, 16))
+}
+
+// smirnovCDF returns the probability that the two-sample Kolmogorov–Smirnov
+// statistic of samples of sizes n and m without ties is less than d.
+func smirnovCDF(n, m int, d float64) float64 {
+	if n > m {
+		n, m = m, n
+	}
+	nf := float64(n)
+	mf := float64(m)
+	// The statistic is a multiple of 1/(nm), so q lies between the
+	// attainable values below and at d.
+	q := (0.5 + math.Floor(d*nf*mf-1e-7)) / (nf * mf)
+	u := make([]float64, m+1)
+	for j := range u {
+		if float64(j)/mf <= q {
+			u[j] = 1
+		}
+	}
+	for i := 1; i <= n; i++ {
+		w := float64(i) / float64(i+m)
+		if float64(i)/nf > q {
+			u[0] = 0
+		} else {
+			u[0] *= w
+		}
+		for j := 1; j <= m; j++ {
+			if math.Abs(float64(i)/nf-float64(j)/mf) > q {
+				u[j] = 0
+			} else {
+				u[j] = w*u[j] + u[j-1]
+			}
+		}
+	}
+	return u[m]
+}
@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/combin"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestKolmogorovCDF(t *testing.T) {
+	t.Parallel()
+	// For a single sample D = max(u, 1-u) with u uniform.
+	for _, d := range []float64{0.55, 0.7, 0.9} {
+		got := kolmogorovCDF(1, d)
+		if !scalar.EqualWithinAbsOrRel(got, 2*d-1, 1e-12, 1e-12) {
+			t.Errorf("unexpected CDF for n=1 at %v: got:%v want:%v", d, got, 2*d-1)
+		}
+	}
+	// Critical values at the 5% level from Massey (1951).
+	for _, test := range []struct {
+		n int
+		d float64
+	}{
+		{n: 5, d: 0.56328},
+		{n: 10, d: 0.40925},
+		{n: 20, d: 0.29408},
+	} {
+		got := kolmogorovCDF(test.n, test.d)
+		if !scalar.EqualWithinAbs(got, 0.95, 1e-3) {
+			t.Errorf("unexpected CDF for n=%d at %v: got:%v want:0.95", test.n, test.d, got)
+		}
+	}
+	// The exact distribution approaches the asymptotic distribution.
+	for _, x := range []float64{0.6, 1, 1.4} {
+		const n = exactKSLimit - 1
+		got := 1 - kolmogorovCDF(n, x/math.Sqrt(n))
+		want := kolmogorovSurvival(x)
+		if !scalar.EqualWithinAbs(got, want, 0.01) {
+			t.Errorf("unexpected survival for n=%d at %v: got:%v want:%v", n, x, got, want)
+		}
+	}
+}
+
+func TestKolmogorovSurvival(t *testing.T) {
+	t.Parallel()
+	// Percentage points of the Kolmogorov distribution.
+	for _, test := range []struct {
+		x, p float64
+	}{
+		{x: 1.2239, p: 0.1},
+		{x: 1.3581, p: 0.05},
+		{x: 1.6276, p: 0.01},
+	} {
+		got := kolmogorovSurvival(test.x)
+		if !scalar.EqualWithinAbs(got, test.p, 1e-4) {
+			t.Errorf("unexpected survival at %v: got:%v want:%v", test.x, got, test.p)
+		}
+	}
+	// The two series agree where they are switched.
+	const x = 1.18
+	z := math.Exp(-2 * x * x)
+	want := 2 * (z - math.Pow(z, 4) + math.Pow(z, 9) - math.Pow(z, 16))
+	if got := kolmogorovSurvival(x - 1e-12); !scalar.EqualWithinAbs(got, want, 1e-10) {
+		t.Errorf("unexpected survival below %v: got:%v want:%v", x, got, want)
+	}
+	if got := kolmogorovSurvival(0); got != 1 {
+		t.Errorf("unexpected survival at 0: got:%v want:1", got)
+	}
+}
+
+func TestSmirnovCDF(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 5; n++ {
+		for m := 1; m <= 6; m++ {
+			// Count the statistic of every assignment of n of the
+			// positions 0, ..., n+m-1 to x.
+			counts := make(map[int]float64)
+			combs := combin.Combinations(n+m, n)
+			for _, c := range combs {
+				var (
+					isX  = make([]bool, n+m)
+					fx   int
+					fy   int
+					dmax int
+				)
+				for _, i := range c {
+					isX[i] = true
+				}
+				for _, x := range isX {
+					if x {
+						fx++
+					} else {
+						fy++
+					}
+					// The statistic scaled by nm.
+					d := fx*m - fy*n
+					if d < 0 {
+						d = -d
+					}
+					if d > dmax {
+						dmax = d
+					}
+				}
+				counts[dmax]++
+			}
+			for d := 0; d <= n*m; d++ {
+				var want float64
+				for k, c := range counts {
+					if k < d {
+						want += c
+					}
+				}
+				want /= float64(len(combs))
+				got := smirnovCDF(n, m, float64(d)/float64(n*m))
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+					t.Errorf("unexpected CDF for n=%d m=%d at %d/%d: got:%v want:%v", n, m, d, n*m, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	t.Parallel()
+	const (
+		trials = 2000
+		alpha  = 0.05
+		tol    = 0.015
+	)
+	rnd := rand.New(rand.NewSource(1))
+	norm := distuv.Normal{Mu: 0, Sigma: 1, Src: rnd}
+	for _, n := range []int{5, 40, 1500} {
+		var oneSample, twoSample int
+		x := make([]float64, n)
+		y := make([]float64, n/2+3)
+		for i := 0; i < trials; i++ {
+			for j := range x {
+				x[j] = norm.Rand()
+			}
+			for j := range y {
+				y[j] = norm.Rand()
+			}
+			if KolmogorovSmirnov(x, norm.CDF).PValue < alpha {
+				oneSample++
+			}
+			if n < 1000 && KolmogorovSmirnovTwoSample(x, y).PValue < alpha {
+				twoSample++
+			}
+		}
+		if got := float64(oneSample) / trials; math.Abs(got-alpha) > tol {
+			t.Errorf("unexpected one-sample rejection rate under null for n=%d: got:%v want:%v", n, got, alpha)
+		}
+		if n >= 1000 {
+			continue
+		}
+		// The two-sample test is conservative because the statistic
+		// is discrete.
+		if got := float64(twoSample) / trials; got > alpha+tol {
+			t.Errorf("unexpected two-sample rejection rate under null for n=%d: got:%v want:%v", n, got, alpha)
+		}
+	}
+
+	x := []float64{0.61, 0.29, 0.06, 0.59, -1.73, -0.74, 0.51, -0.56, 0.39, 1.64, 0.05, -0.06, 0.64, -0.82, 0.37, 1.77, 1.09, -1.28, 2.36, 1.31, 1.05, -0.32, -0.4, 1.06, -2.47}
+	y := []float64{2.2, 1.66, 1.38, 0.2, 0.36, 0, 0.96, 1.56, 0.44, 1.5, -0.3, 0.66, 2.31, 3.29, -0.27, -0.37, 0.38, 0.7, 0.52, -0.71}
+	r := KolmogorovSmirnov(x, distuv.UnitNormal.CDF)
+	if r.Statistic <= 0 || r.Statistic >= 1 || r.PValue < 0.05 {
+		t.Errorf("unexpected one-sample result for normal sample: got:%+v", r)
+	}
+	r = KolmogorovSmirnovTwoSample(x, y)
+	sx := append([]float64(nil), x...)
+	sy := append([]float64(nil), y...)
+	stat.SortWeighted(sx, nil)
+	stat.SortWeighted(sy, nil)
+	if want := stat.KolmogorovSmirnov(sx, nil, sy, nil); r.Statistic != want {
+		t.Errorf("unexpected two-sample statistic: got:%v want:%v", r.Statistic, want)
+	}
+	if want := 1 - smirnovCDF(len(x), len(y), r.Statistic); r.PValue != want {
+		t.Errorf("unexpected two-sample p-value: got:%v want:%v", r.PValue, want)
+	}
+
+	if !panics(func() { KolmogorovSmirnov(nil, distuv.UnitNormal.CDF) }) {
+		t.Errorf("expected panic for no samples")
+	}
+	if !panics(func() { KolmogorovSmirnovTwoSample(x, nil) }) {
+		t.Errorf("expected panic for no samples")
+	}
+}
+
+func TestChiSquare(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	// Mendel's pea crosses tested against the 9:3:3:1 ratio.
+	obs := []float64{315, 108, 101, 32}
+	exp := []float64{312.75, 104.25, 104.25, 34.75}
+	got := ChiSquare(obs, exp, 0)
+	want := Result{Statistic: 0.4700240, DF: 3, DF2: nan, PValue: 0.9254259, Lower: nan, Upper: nan}
+	checkResult(t, "Mendel", got, want, 1e-5)
+
+	got = ChiSquare(obs, exp, 1)
+	want.DF = 2
+	want.PValue = math.Exp(-want.Statistic / 2)
+	checkResult(t, "Mendel ddof", got, want, 1e-5)
+
+	// Party identification by gender from Agresti (2007), as used in the
+	// documentation of R's chisq.test.
+	table := mat.NewDense(2, 3, []float64{
+		762, 327, 468,
+		484, 239, 477,
+	})
+	got = ChiSquareIndependence(table)
+	want = Result{Statistic: 30.07015, DF: 2, DF2: nan, PValue: 2.953589e-07, Lower: nan, Upper: nan}
+	checkResult(t, "Agresti", got, want, 1e-5)
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"length", func() { ChiSquare(obs, exp[1:], 0) }},
+		{"degrees of freedom", func() { ChiSquare(obs, exp, 3) }},
+		{"single row", func() { ChiSquareIndependence(mat.NewDense(1, 3, nil)) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	badAlternative = "hypothesis: unknown alternative"
+	badLevel       = "hypothesis: confidence level not between 0 and 1"
+	badLength      = "hypothesis: slice length mismatch"
+	tooFewSamples  = "hypothesis: too few samples"
+)
+
+// Alternative specifies the alternative hypothesis of a test.
+type Alternative int
+
+const (
+	// TwoSided is the alternative that the tested parameter is not equal
+	// to its value under the null hypothesis.
+	TwoSided Alternative = iota
+	// Less is the alternative that the tested parameter is less than its
+	// value under the null hypothesis.
+	Less
+	// Greater is the alternative that the tested parameter is greater
+	// than its value under the null hypothesis.
+	Greater
+)
+
+// Result is the result of a hypothesis test.
+type Result struct {
+	// Statistic is the value of the test statistic.
+	Statistic float64
+
+	// DF is the degrees of freedom of the reference distribution of
+	// the statistic, and DF2 is the denominator degrees of freedom for
+	// statistics with an F reference distribution. DF and DF2 are NaN
+	// if the reference distribution has no such parameter.
+	DF  float64
+	DF2 float64
+
+	// PValue is the p-value of the test.
+	PValue float64
+
+	// Lower and Upper are the bounds of the confidence interval for the
+	// tested parameter. One of the bounds is infinite for a one-sided
+	// alternative. Lower and Upper are NaN if the test does not compute
+	// a confidence interval.
+	Lower float64
+	Upper float64
+}
+
+// newResult returns a Result with the statistic and p-value and with NaN
+// degrees of freedom and confidence interval.
+func newResult(statistic, p float64) Result {
+	nan := math.NaN()
+	return Result{
+		Statistic: statistic,
+		DF:        nan,
+		DF2:       nan,
+		PValue:    p,
+		Lower:     nan,
+		Upper:     nan,
+	}
+}
+
+// pValue returns the p-value for the alternative given the lower and upper
+// tail probabilities of the observed statistic.
+func pValue(alt Alternative, lower, upper float64) float64 {
+	switch alt {
+	case TwoSided:
+		return math.Min(1, 2*math.Min(lower, upper))
+	case Less:
+		return lower
+	case Greater:
+		return upper
+	}
+	panic(badAlternative)
+}
+
+// normalPValue returns the p-value of a discrete statistic with deviation d
+// from its mean and standard deviation sigma using the normal approximation
+// with a continuity correction.
+func normalPValue(alt Alternative, d, sigma float64) float64 {
+	var correction float64
+	switch alt {
+	case TwoSided:
+		if d > 0 {
+			correction = 0.5
+		} else if d < 0 {
+			correction = -0.5
+		}
+	case Less:
+		correction = -0.5
+	case Greater:
+		correction = 0.5
+	default:
+		panic(badAlternative)
+	}
+	z := (d - correction) / sigma
+	return pValue(alt, distuv.UnitNormal.CDF(z), distuv.UnitNormal.Survival(z))
+}
+
+// rank returns the ranks of the values of x starting from 1, where tied
+// values are given the mean of their ranks, and the tie correction
+//  Σ_j t_j^3 - t_j
+// where t_j is the number of values in the j-th group of tied values.
+func rank(x []float64) (ranks []float64, ties float64) {
+	idx := make([]int, len(x))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return x[idx[i]] < x[idx[j]] })
+	ranks = make([]float64, len(x))
+	for i := 0; i < len(idx); {
+		j := i + 1
+		for j < len(idx) && x[idx[j]] == x[idx[i]] {
+			j++
+		}
+		// Elements i to j-1 are tied with ranks i+1 to j.
+		r := float64(i+j+1) / 2
+		for _, k := range idx[i:j] {
+			ranks[k] = r
+		}
+		t := float64(j - i)
+		ties += t*t*t - t
+		i = j
+	}
+	return ranks, ties
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// checkResult reports fields of got that differ from want by more than the
+// relative tolerance tol. NaN fields of want must be NaN in got.
+func checkResult(t *testing.T, name string, got, want Result, tol float64) {
+	t.Helper()
+	for _, f := range []struct {
+		field     string
+		got, want float64
+	}{
+		{"Statistic", got.Statistic, want.Statistic},
+		{"DF", got.DF, want.DF},
+		{"DF2", got.DF2, want.DF2},
+		{"PValue", got.PValue, want.PValue},
+		{"Lower", got.Lower, want.Lower},
+		{"Upper", got.Upper, want.Upper},
+	} {
+		if math.IsNaN(f.want) {
+			if !math.IsNaN(f.got) {
+				t.Errorf("%s: unexpected %s: got:%v want:NaN", name, f.field, f.got)
+			}
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(f.got, f.want, tol, tol) {
+			t.Errorf("%s: unexpected %s: got:%v want:%v", name, f.field, f.got, f.want)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
+
+func TestRank(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x     []float64
+		ranks []float64
+		ties  float64
+	}{
+		{
+			x:     []float64{3, 1, 2},
+			ranks: []float64{3, 1, 2},
+		},
+		{
+			x:     []float64{2, 1, 2, 5, 2, 1},
+			ranks: []float64{4, 1.5, 4, 6, 4, 1.5},
+			ties:  24 + 6,
+		},
+		{
+			x:     []float64{7, 7, 7, 7},
+			ranks: []float64{2.5, 2.5, 2.5, 2.5},
+			ties:  60,
+		},
+	} {
+		ranks, ties := rank(test.x)
+		if !floats.Equal(ranks, test.ranks) {
+			t.Errorf("unexpected ranks for %v: got:%v want:%v", test.x, ranks, test.ranks)
+		}
+		if ties != test.ties {
+			t.Errorf("unexpected tie correction for %v: got:%v want:%v", test.x, ties, test.ties)
+		}
+	}
+}
+
+func TestPValue(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		alt          Alternative
+		lower, upper float64
+		want         float64
+	}{
+		{alt: TwoSided, lower: 0.01, upper: 0.99, want: 0.02},
+		{alt: TwoSided, lower: 0.98, upper: 0.02, want: 0.04},
+		{alt: TwoSided, lower: 0.6, upper: 0.7, want: 1},
+		{alt: Less, lower: 0.01, upper: 0.99, want: 0.01},
+		{alt: Greater, lower: 0.01, upper: 0.99, want: 0.99},
+	} {
+		got := pValue(test.alt, test.lower, test.upper)
+		if got != test.want {
+			t.Errorf("unexpected p-value for alternative %d with tails %v and %v: got:%v want:%v",
+				test.alt, test.lower, test.upper, got, test.want)
+		}
+	}
+	if !panics(func() { pValue(Alternative(-1), 0.5, 0.5) }) {
+		t.Errorf("expected panic for unknown alternative")
+	}
+}
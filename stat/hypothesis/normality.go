@@ -0,0 +1,254 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// ShapiroWilk performs the Shapiro–Wilk test of the null hypothesis that x is
+// drawn from a normal distribution. The statistic W lies in (0, 1], and small
+// values of W are evidence against normality. The coefficients of W and the
+// p-value are computed with the approximations of Royston's algorithm AS R94.
+// The statistic and p-value are NaN if all elements of x are equal. The
+// returned Result has NaN degrees of freedom and confidence interval.
+//
+// References:
+//  Royston, P. (1995). Remark AS R94: A remark on algorithm AS 181: The
+//  W-test for normality. Journal of the Royal Statistical Society. Series C
+//  (Applied Statistics), 44(4), 547-551.
+//
+// ShapiroWilk panics if len(x) is less than 3 or greater than 5000.
+func ShapiroWilk(x []float64) Result {
+	n := len(x)
+	if n < 3 || n > 5000 {
+		panic("hypothesis: sample size out of range for Shapiro–Wilk test")
+	}
+	x = append([]float64(nil), x...)
+	sort.Float64s(x)
+	scale := x[n-1] - x[0]
+	if scale == 0 {
+		return newResult(math.NaN(), math.NaN())
+	}
+
+	// Compute the antisymmetric coefficients a of the sorted sample.
+	nf := float64(n)
+	half := n / 2
+	a := make([]float64, half)
+	if n == 3 {
+		a[0] = math.Sqrt2 / 2
+	} else {
+		m := make([]float64, half)
+		var summ2 float64
+		for i := range m {
+			m[i] = distuv.UnitNormal.Quantile((float64(i+1) - 0.375) / (nf + 0.25))
+			summ2 += m[i] * m[i]
+		}
+		summ2 *= 2
+		ssumm2 := math.Sqrt(summ2)
+		rsn := 1 / math.Sqrt(nf)
+		a[0] = poly(swC1, rsn) - m[0]/ssumm2
+		var fac float64
+		first := 1
+		if n > 5 {
+			first = 2
+			a[1] = poly(swC2, rsn) - m[1]/ssumm2
+			fac = math.Sqrt((summ2 - 2*m[0]*m[0] - 2*m[1]*m[1]) / (1 - 2*a[0]*a[0] - 2*a[1]*a[1]))
+		} else {
+			fac = math.Sqrt((summ2 - 2*m[0]*m[0]) / (1 - 2*a[0]*a[0]))
+		}
+		for i := first; i < half; i++ {
+			a[i] = -m[i] / fac
+		}
+	}
+
+	// W is the squared correlation between the coefficients and the sample.
+	coef := make([]float64, n)
+	for i, v := range a {
+		coef[i] = -v
+		coef[n-1-i] = v
+	}
+	for i := range x {
+		x[i] /= scale
+	}
+	ma := stat.Mean(coef, nil)
+	mx := stat.Mean(x, nil)
+	var ssa, ssx, sax float64
+	for i, v := range x {
+		da := coef[i] - ma
+		dx := v - mx
+		ssa += da * da
+		ssx += dx * dx
+		sax += da * dx
+	}
+	ssassx := math.Sqrt(ssa * ssx)
+	w1 := (ssassx - sax) * (ssassx + sax) / (ssa * ssx)
+	w := 1 - w1
+
+	if n == 3 {
+		p := 6 / math.Pi * (math.Asin(math.Sqrt(w)) - math.Pi/3)
+		return newResult(w, math.Max(0, math.Min(1, p)))
+	}
+	y := math.Log(w1)
+	var mu, sigma float64
+	if n <= 11 {
+		gamma := poly(swG, nf)
+		if y >= gamma {
+			return newResult(w, 0)
+		}
+		y = -math.Log(gamma - y)
+		mu = poly(swC3, nf)
+		sigma = math.Exp(poly(swC4, nf))
+	} else {
+		ln := math.Log(nf)
+		mu = poly(swC5, ln)
+		sigma = math.Exp(poly(swC6, ln))
+	}
+	return newResult(w, distuv.Normal{Mu: mu, Sigma: sigma}.Survival(y))
+}
+
+// Polynomial coefficients of the Shapiro–Wilk approximations in order of
+// increasing degree.
+var (
+	swC1 = []float64{0, 0.221157, -0.147981, -2.07119, 4.434685, -2.706056}
+	swC2 = []float64{0, 0.042981, -0.293762, -1.752461, 5.682633, -3.582633}
+	swC3 = []float64{0.544, -0.39978, 0.025054, -6.714e-4}
+	swC4 = []float64{1.3822, -0.77857, 0.062767, -0.0020322}
+	swC5 = []float64{-1.5861, -0.31082, -0.083751, 0.0038915}
+	swC6 = []float64{-0.4803, -0.082676, 0.0030302}
+	swG  = []float64{-2.273, 0.459}
+)
+
+// poly evaluates the polynomial with coefficients c in order of increasing
+// degree at x.
+func poly(c []float64, x float64) float64 {
+	var p float64
+	for i := len(c) - 1; i >= 0; i-- {
+		p = p*x + c[i]
+	}
+	return p
+}
+
+// AndersonDarlingNormal performs the Anderson–Darling test of the null
+// hypothesis that x is drawn from a normal distribution with unspecified
+// mean and variance, which are estimated from x. The statistic is the
+// Anderson–Darling statistic A² of the standardized sample against the
+// standard normal distribution. The p-value is computed from the modified
+// statistic
+//  A²(1 + 0.75/n + 2.25/n²)
+// using the approximation of D'Agostino and Stephens. The statistic and
+// p-value are NaN if all elements of x are equal. The returned Result has NaN
+// degrees of freedom and confidence interval.
+//
+// References:
+//  D'Agostino, R. B. and Stephens, M. A. (1986). Goodness-of-Fit Techniques.
+//  Marcel Dekker, New York. Table 4.9.
+//
+// AndersonDarlingNormal panics if len(x) is less than 8.
+func AndersonDarlingNormal(x []float64) Result {
+	n := len(x)
+	if n < 8 {
+		panic(tooFewSamples)
+	}
+	mean, std := stat.MeanStdDev(x, nil)
+	if std == 0 {
+		return newResult(math.NaN(), math.NaN())
+	}
+	z := make([]float64, n)
+	for i, v := range x {
+		z[i] = (v - mean) / std
+	}
+	sort.Float64s(z)
+	a2 := andersonDarling(z, distuv.UnitNormal.CDF, distuv.UnitNormal.Survival)
+
+	nf := float64(n)
+	s := a2 * (1 + 0.75/nf + 2.25/(nf*nf))
+	var p float64
+	switch {
+	case s >= 0.6:
+		p = math.Exp(1.2937 - 5.709*s + 0.0186*s*s)
+	case s >= 0.34:
+		p = math.Exp(0.9177 - 4.279*s - 1.38*s*s)
+	case s >= 0.2:
+		p = -math.Expm1(-8.318 + 42.796*s - 59.938*s*s)
+	default:
+		p = -math.Expm1(-13.436 + 101.14*s - 223.73*s*s)
+	}
+	return newResult(a2, math.Max(0, math.Min(1, p)))
+}
+
+// AndersonDarling performs the Anderson–Darling test of the null hypothesis
+// that x is drawn from the continuous distribution with the cumulative
+// distribution function cdf, which must be fully specified rather than
+// estimated from x. The statistic is
+//  A² = -n - 1/n Σ_i (2i-1) (log F(x_(i)) + log(1 - F(x_(n+1-i))))
+// where x_(i) is the i-th smallest element of x. The p-value is computed with
+// the approximation of Marsaglia and Marsaglia. The returned Result has NaN
+// degrees of freedom and confidence interval.
+//
+// References:
+//  Marsaglia, G. and Marsaglia, J. (2004). Evaluating the Anderson-Darling
+//  distribution. Journal of Statistical Software, 9(2), 1-5.
+//
+// AndersonDarling panics if len(x) is zero.
+func AndersonDarling(x []float64, cdf func(float64) float64) Result {
+	n := len(x)
+	if n == 0 {
+		panic(tooFewSamples)
+	}
+	x = append([]float64(nil), x...)
+	sort.Float64s(x)
+	a2 := andersonDarling(x, cdf, func(v float64) float64 { return 1 - cdf(v) })
+	return newResult(a2, 1-andersonDarlingCDF(n, a2))
+}
+
+// andersonDarling returns the Anderson–Darling statistic of the sorted
+// sample x with respect to the distribution with the given cumulative
+// distribution and survival functions.
+func andersonDarling(x []float64, cdf, survival func(float64) float64) float64 {
+	n := len(x)
+	var s float64
+	for i, v := range x {
+		s += float64(2*i+1) * (math.Log(cdf(v)) + math.Log(survival(x[n-1-i])))
+	}
+	return -float64(n) - s/float64(n)
+}
+
+// andersonDarlingCDF returns the cumulative distribution function of the
+// Anderson–Darling statistic of n samples from a fully specified distribution
+// evaluated at z.
+func andersonDarlingCDF(n int, z float64) float64 {
+	if z <= 0 {
+		return 0
+	}
+	var x float64
+	if z < 2 {
+		x = math.Exp(-1.2337141/z) / math.Sqrt(z) * (2.00012 + (0.247105-(0.0649821-(0.0347962-(0.011672-0.00168691*z)*z)*z)*z)*z)
+	} else {
+		x = math.Exp(-math.Exp(1.0776 - (2.30695-(0.43424-(0.082433-(0.008056-0.0003146*z)*z)*z)*z)*z))
+	}
+	return math.Max(0, math.Min(1, x+andersonDarlingErr(float64(n), x)))
+}
+
+// andersonDarlingErr returns the correction to the asymptotic cumulative
+// distribution function x of the Anderson–Darling statistic for n samples.
+func andersonDarlingErr(n, x float64) float64 {
+	if x > 0.8 {
+		return (-130.2137 + (745.2337-(1705.091-(1950.646-(1116.360-255.7844*x)*x)*x)*x)*x) / n
+	}
+	c := 0.01265 + 0.1757/n
+	if x < c {
+		t := x / c
+		t = math.Sqrt(t) * (1 - t) * (49*t - 102)
+		return t * (0.0037/(n*n) + 0.00078/n + 0.00006) / n
+	}
+	t := (x - c) / (0.8 - c)
+	t = -0.00022633 + (6.54034-(14.6538-(14.458-(8.259-1.91864*t)*t)*t)*t)*t
+	return t * (0.04213/n + 0.01365/(n*n)) / n
+}
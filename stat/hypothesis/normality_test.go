@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestShapiroWilk(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	// The len variable of R's ToothGrowth data set.
+	toothGrowth := []float64{
+		4.2, 11.5, 7.3, 5.8, 6.4, 10, 11.2, 11.2, 5.2, 7,
+		16.5, 16.5, 15.2, 17.3, 22.5, 17.3, 13.6, 14.5, 18.8, 15.5,
+		23.6, 18.5, 33.9, 25.5, 26.4, 32.5, 26.7, 21.5, 23.3, 29.5,
+		15.2, 21.5, 17.6, 9.7, 14.5, 10, 8.2, 9.4, 16.5, 9.7,
+		19.7, 23.3, 23.6, 26.4, 20, 25.2, 25.8, 21.2, 14.5, 27.3,
+		25.5, 26.4, 22.4, 24.5, 24.8, 30.9, 26.4, 27.3, 29.4, 23,
+	}
+	for _, test := range []struct {
+		name string
+		x    []float64
+		want Result
+		tol  float64
+	}{
+		{
+			// Value was computed using R's shapiro.test.
+			name: "ToothGrowth",
+			x:    toothGrowth,
+			want: Result{Statistic: 0.96743, DF: nan, DF2: nan, PValue: 0.1091, Lower: nan, Upper: nan},
+			tol:  1e-3,
+		},
+		{
+			// For three samples the coefficients are ±1/√2 and
+			// the p-value is exact.
+			name: "three",
+			x:    []float64{4, 1, 2},
+			want: Result{
+				Statistic: 27.0 / 28,
+				DF:        nan, DF2: nan,
+				PValue: 6 / math.Pi * (math.Asin(math.Sqrt(27.0/28)) - math.Pi/3),
+				Lower:  nan, Upper: nan,
+			},
+			tol: 1e-14,
+		},
+		{
+			// Weights of men from Shapiro and Wilk (1965).
+			name: "Shapiro and Wilk",
+			x:    []float64{148, 154, 158, 160, 161, 162, 166, 170, 182, 195, 236},
+			want: Result{Statistic: 0.79, DF: nan, DF2: nan, PValue: 0.01, Lower: nan, Upper: nan},
+			tol:  0.01,
+		},
+		{
+			name: "constant",
+			x:    []float64{1, 1, 1, 1},
+			want: Result{Statistic: nan, DF: nan, DF2: nan, PValue: nan, Lower: nan, Upper: nan},
+		},
+	} {
+		checkResult(t, test.name, ShapiroWilk(test.x), test.want, test.tol)
+	}
+
+	for _, n := range []int{2, 5001} {
+		if !panics(func() { ShapiroWilk(make([]float64, n)) }) {
+			t.Errorf("expected panic for n=%d", n)
+		}
+	}
+}
+
+func TestNormalityNull(t *testing.T) {
+	t.Parallel()
+	const (
+		trials = 5000
+		alpha  = 0.05
+		tol    = 0.015
+	)
+	rnd := rand.New(rand.NewSource(1))
+	norm := distuv.Normal{Mu: 10, Sigma: 3, Src: rnd}
+	for _, n := range []int{4, 10, 30, 200} {
+		var sw, ad, adFull int
+		x := make([]float64, n)
+		for i := 0; i < trials; i++ {
+			for j := range x {
+				x[j] = norm.Rand()
+			}
+			if ShapiroWilk(x).PValue < alpha {
+				sw++
+			}
+			if n >= 8 && AndersonDarlingNormal(x).PValue < alpha {
+				ad++
+			}
+			if AndersonDarling(x, norm.CDF).PValue < alpha {
+				adFull++
+			}
+		}
+		for _, test := range []struct {
+			name     string
+			rejected int
+		}{
+			{"Shapiro–Wilk", sw},
+			{"Anderson–Darling normal", ad},
+			{"Anderson–Darling", adFull},
+		} {
+			if n < 8 && test.name == "Anderson–Darling normal" {
+				continue
+			}
+			if got := float64(test.rejected) / trials; math.Abs(got-alpha) > tol {
+				t.Errorf("unexpected %s rejection rate under null for n=%d: got:%v want:%v", test.name, n, got, alpha)
+			}
+		}
+	}
+}
+
+func TestNormalityPower(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	exp := distuv.Exponential{Rate: 1, Src: rnd}
+	x := make([]float64, 100)
+	for i := range x {
+		x[i] = exp.Rand()
+	}
+	if p := ShapiroWilk(x).PValue; p > 1e-4 {
+		t.Errorf("unexpected Shapiro–Wilk p-value for exponential sample: got:%v", p)
+	}
+	if p := AndersonDarlingNormal(x).PValue; p > 1e-4 {
+		t.Errorf("unexpected Anderson–Darling p-value for exponential sample: got:%v", p)
+	}
+	if p := AndersonDarling(x, distuv.Normal{Mu: 1, Sigma: 1}.CDF).PValue; p > 0.01 {
+		t.Errorf("unexpected Anderson–Darling p-value for exponential sample: got:%v", p)
+	}
+}
+
+func TestAndersonDarlingCDF(t *testing.T) {
+	t.Parallel()
+	// Percentage points of the asymptotic distribution of the
+	// Anderson–Darling statistic from Anderson and Darling (1954).
+	for _, test := range []struct {
+		z, p float64
+	}{
+		{z: 1.933, p: 0.9},
+		{z: 2.492, p: 0.95},
+		{z: 3.857, p: 0.99},
+	} {
+		got := andersonDarlingCDF(100000, test.z)
+		if !scalar.EqualWithinAbs(got, test.p, 1e-3) {
+			t.Errorf("unexpected CDF at %v: got:%v want:%v", test.z, got, test.p)
+		}
+	}
+	if !panics(func() { AndersonDarlingNormal(make([]float64, 7)) }) {
+		t.Errorf("expected panic for too few samples")
+	}
+	if !panics(func() { AndersonDarling(nil, distuv.UnitNormal.CDF) }) {
+		t.Errorf("expected panic for no samples")
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+)
+
+// exactRankLimit is the sample size below which the exact null distribution
+// of the rank statistics is used when there are no ties.
+const exactRankLimit = 50
+
+// MannWhitneyU performs the Mann–Whitney U test, also known as the Wilcoxon
+// rank-sum test, of the null hypothesis that the populations from which x
+// and y are drawn are identical against the alternative that values from the
+// population of x tend to be less than or greater than values from the
+// population of y. The statistic is
+//  U = R_x - n_x(n_x+1)/2
+// where R_x is the sum of the ranks of x in the combined sample, which is the
+// number of pairs (x[i], y[j]) with x[i] > y[j], counting ties as one half.
+//
+// The p-value is computed from the exact null distribution of U when both
+// samples have fewer than 50 elements and there are no ties, and otherwise
+// from the normal approximation with a tie correction and a continuity
+// correction. The returned Result has NaN degrees of freedom and confidence
+// interval.
+//
+// MannWhitneyU panics if len(x) or len(y) is zero.
+func MannWhitneyU(x, y []float64, alt Alternative) Result {
+	if len(x) == 0 || len(y) == 0 {
+		panic(tooFewSamples)
+	}
+	n := len(x)
+	m := len(y)
+	ranks, ties := rank(append(append(make([]float64, 0, n+m), x...), y...))
+	var rx float64
+	for _, r := range ranks[:n] {
+		rx += r
+	}
+	nf := float64(n)
+	mf := float64(m)
+	u := rx - nf*(nf+1)/2
+	if n < exactRankLimit && m < exactRankLimit && ties == 0 {
+		dist := newRankDist(mannWhitneyCounts(n, m))
+		return newResult(u, pValue(alt, dist.cdf(u), dist.survival(u)))
+	}
+	N := nf + mf
+	sigma := math.Sqrt(nf * mf / 12 * ((N + 1) - ties/(N*(N-1))))
+	return newResult(u, normalPValue(alt, u-nf*mf/2, sigma))
+}
+
+// WilcoxonSignedRank performs the Wilcoxon signed-rank test of the null
+// hypothesis that the distribution of the differences x[i] - y[i] - mu is
+// symmetric about zero. If y is nil, the one-sample test of x[i] - mu is
+// performed. The statistic is the sum of the ranks of the absolute values of
+// the positive differences, where zero differences are discarded.
+//
+// The p-value is computed from the exact null distribution of the statistic
+// when there are fewer than 50 differences and there are no ties or zero
+// differences, and otherwise from the normal approximation with a tie
+// correction and a continuity correction. The p-value is NaN if all
+// differences are zero. The returned Result has NaN degrees of freedom and
+// confidence interval.
+//
+// WilcoxonSignedRank panics if y is not nil and len(x) and len(y) are not
+// equal, or if len(x) is zero.
+func WilcoxonSignedRank(x, y []float64, mu float64, alt Alternative) Result {
+	if y != nil && len(x) != len(y) {
+		panic(badLength)
+	}
+	if len(x) == 0 {
+		panic(tooFewSamples)
+	}
+	var (
+		d     = make([]float64, 0, len(x))
+		abs   = make([]float64, 0, len(x))
+		zeros bool
+	)
+	for i, v := range x {
+		if y != nil {
+			v -= y[i]
+		}
+		v -= mu
+		if v == 0 {
+			zeros = true
+			continue
+		}
+		d = append(d, v)
+		abs = append(abs, math.Abs(v))
+	}
+	ranks, ties := rank(abs)
+	var w float64
+	for i, v := range d {
+		if v > 0 {
+			w += ranks[i]
+		}
+	}
+	n := len(d)
+	if n < exactRankLimit && !zeros && ties == 0 {
+		dist := newRankDist(signedRankCounts(n))
+		return newResult(w, pValue(alt, dist.cdf(w), dist.survival(w)))
+	}
+	nf := float64(n)
+	sigma := math.Sqrt(nf*(nf+1)*(2*nf+1)/24 - ties/48)
+	return newResult(w, normalPValue(alt, w-nf*(nf+1)/4, sigma))
+}
+
+// rankDist is the null distribution of an integer valued rank statistic
+// that is symmetric about the middle of its support [0, len(counts)-1].
+// Only the lower half of the counts is used to avoid relying on counts
+// that are subject to cancellation error.
+type rankDist struct {
+	counts []float64
+	total  float64
+}
+
+// newRankDist returns the distribution with the given number of
+// arrangements for each value of the statistic.
+func newRankDist(counts []float64) rankDist {
+	max := len(counts) - 1
+	var total float64
+	for u := 0; 2*u < max; u++ {
+		total += 2 * counts[u]
+	}
+	if max%2 == 0 {
+		total += counts[max/2]
+	}
+	return rankDist{counts: counts, total: total}
+}
+
+// cdf returns the probability that the statistic is less than or equal to u.
+func (d rankDist) cdf(u float64) float64 {
+	max := len(d.counts) - 1
+	k := int(math.Floor(u))
+	if k < 0 {
+		return 0
+	}
+	if 2*k >= max {
+		// Use P(U ≤ k) = 1 - P(U ≥ k+1) = 1 - P(U ≤ max-k-1).
+		return 1 - d.cdf(float64(max-k-1))
+	}
+	var c float64
+	for _, v := range d.counts[:k+1] {
+		c += v
+	}
+	return c / d.total
+}
+
+// survival returns the probability that the statistic is greater than or
+// equal to u.
+func (d rankDist) survival(u float64) float64 {
+	return d.cdf(float64(len(d.counts)-1) - u)
+}
+
+// mannWhitneyCounts returns the number of arrangements of n x values and
+// m y values for each value of the Mann–Whitney U statistic. The counts are
+// the coefficients of the Gaussian binomial coefficient
+//  Π_{i=1}^n (1-q^{m+i})/(1-q^i).
+// Each coefficient depends only on coefficients of lower order, so the
+// lower half of the counts is computed exactly while it is representable.
+func mannWhitneyCounts(n, m int) []float64 {
+	c := make([]float64, n*m+1)
+	c[0] = 1
+	for i := 1; i <= n; i++ {
+		// Multiply by 1-q^{m+i}.
+		for u := len(c) - 1; u >= m+i; u-- {
+			c[u] -= c[u-m-i]
+		}
+		// Divide by 1-q^i.
+		for u := i; u < len(c); u++ {
+			c[u] += c[u-i]
+		}
+	}
+	return c
+}
+
+// signedRankCounts returns the number of subsets of {1, ..., n} with each
+// sum, which are the coefficients of
+//  Π_{i=1}^n (1+q^i).
+func signedRankCounts(n int) []float64 {
+	c := make([]float64, n*(n+1)/2+1)
+	c[0] = 1
+	for i := 1; i <= n; i++ {
+		for u := i * (i + 1) / 2; u >= i; u-- {
+			c[u] += c[u-i]
+		}
+	}
+	return c
+}
@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/stat/combin"
+)
+
+func TestRankTests(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	// Examples from Hollander and Wolfe, Nonparametric Statistical Methods,
+	// as used in the documentation of R's wilcox.test, and Student's sleep
+	// data. Values were computed using R's wilcox.test.
+	var (
+		depression1 = []float64{1.83, 0.50, 1.62, 2.48, 1.68, 1.88, 1.55, 3.06, 1.30}
+		depression2 = []float64{0.878, 0.647, 0.598, 2.05, 1.06, 1.29, 1.06, 3.14, 1.29}
+
+		permeability1 = []float64{0.80, 0.83, 1.89, 1.04, 1.45, 1.38, 1.91, 1.64, 0.73, 1.46}
+		permeability2 = []float64{1.15, 0.88, 0.90, 0.74, 1.21}
+	)
+	for _, test := range []struct {
+		name string
+		fn   func() Result
+		want Result
+		tol  float64
+	}{
+		{
+			name: "signed-rank exact",
+			fn:   func() Result { return WilcoxonSignedRank(depression1, depression2, 0, Greater) },
+			want: Result{Statistic: 40, DF: nan, DF2: nan, PValue: 0.01953125, Lower: nan, Upper: nan},
+			tol:  1e-12,
+		},
+		{
+			name: "signed-rank exact two-sided",
+			fn:   func() Result { return WilcoxonSignedRank(depression1, depression2, 0, TwoSided) },
+			want: Result{Statistic: 40, DF: nan, DF2: nan, PValue: 0.0390625, Lower: nan, Upper: nan},
+			tol:  1e-12,
+		},
+		{
+			name: "signed-rank normal",
+			fn:   func() Result { return WilcoxonSignedRank(sleep1, sleep2, 0, TwoSided) },
+			want: Result{Statistic: 0, DF: nan, DF2: nan, PValue: 0.009091, Lower: nan, Upper: nan},
+			tol:  1e-3,
+		},
+		{
+			name: "rank-sum exact",
+			fn:   func() Result { return MannWhitneyU(permeability1, permeability2, Greater) },
+			want: Result{Statistic: 35, DF: nan, DF2: nan, PValue: 0.1272061, Lower: nan, Upper: nan},
+			tol:  1e-6,
+		},
+		{
+			name: "rank-sum normal",
+			fn:   func() Result { return MannWhitneyU(sleep1, sleep2, TwoSided) },
+			want: Result{Statistic: 25.5, DF: nan, DF2: nan, PValue: 0.06933, Lower: nan, Upper: nan},
+			tol:  1e-3,
+		},
+	} {
+		checkResult(t, test.name, test.fn(), test.want, test.tol)
+	}
+
+	// The one-sample test of differences is the paired test.
+	d := make([]float64, len(depression1))
+	for i, v := range depression1 {
+		d[i] = v - depression2[i]
+	}
+	got := WilcoxonSignedRank(d, nil, 0, Greater)
+	want := WilcoxonSignedRank(depression1, depression2, 0, Greater)
+	checkResult(t, "one-sample", got, want, 1e-14)
+
+	// Swapping the samples reflects the rank-sum statistic.
+	got = MannWhitneyU(permeability2, permeability1, Less)
+	want = MannWhitneyU(permeability1, permeability2, Greater)
+	if got.Statistic != float64(len(permeability1)*len(permeability2))-want.Statistic || got.PValue != want.PValue {
+		t.Errorf("unexpected swapped rank-sum result: got:%+v want reflection of:%+v", got, want)
+	}
+}
+
+func TestMannWhitneyCounts(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 6; n++ {
+		for m := 1; m <= 6; m++ {
+			// Count the U statistic of every assignment of n of the
+			// ranks 0, ..., n+m-1 to x.
+			want := make([]float64, n*m+1)
+			for _, c := range combin.Combinations(n+m, n) {
+				var u int
+				for i, r := range c {
+					// r-i elements of y are less than the i-th
+					// element of x.
+					u += r - i
+				}
+				want[u]++
+			}
+			got := mannWhitneyCounts(n, m)
+			for u := 0; 2*u <= n*m; u++ {
+				if got[u] != want[u] {
+					t.Errorf("unexpected count for n=%d m=%d U=%d: got:%v want:%v", n, m, u, got[u], want[u])
+				}
+			}
+			dist := newRankDist(got)
+			if dist.total != float64(combin.Binomial(n+m, n)) {
+				t.Errorf("unexpected total for n=%d m=%d: got:%v want:%v", n, m, dist.total, combin.Binomial(n+m, n))
+			}
+			var cum float64
+			for u := range want {
+				cum += want[u]
+				if got := dist.cdf(float64(u)); !scalar.EqualWithinAbsOrRel(got, cum/dist.total, 1e-14, 1e-14) {
+					t.Errorf("unexpected CDF for n=%d m=%d U=%d: got:%v want:%v", n, m, u, got, cum/dist.total)
+				}
+				if got := dist.survival(float64(u)); !scalar.EqualWithinAbsOrRel(got, 1-(cum-want[u])/dist.total, 1e-14, 1e-14) {
+					t.Errorf("unexpected survival for n=%d m=%d U=%d: got:%v want:%v", n, m, u, got, 1-(cum-want[u])/dist.total)
+				}
+			}
+		}
+	}
+
+	// The total of the largest exact distribution must match the binomial
+	// coefficient.
+	const n = exactRankLimit - 1
+	dist := newRankDist(mannWhitneyCounts(n, n))
+	lw := math.Log(dist.total)
+	lb := 2*lgamma(n+1) - lgamma(2*n+1)
+	if !scalar.EqualWithinAbsOrRel(lw, -lb, 1e-10, 1e-10) {
+		t.Errorf("unexpected log total for n=m=%d: got:%v want:%v", n, lw, -lb)
+	}
+}
+
+func TestSignedRankCounts(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 12; n++ {
+		want := make([]float64, n*(n+1)/2+1)
+		for s := 0; s < 1<<uint(n); s++ {
+			var w int
+			for i := 0; i < n; i++ {
+				if s&(1<<uint(i)) != 0 {
+					w += i + 1
+				}
+			}
+			want[w]++
+		}
+		got := signedRankCounts(n)
+		for w := range want {
+			if got[w] != want[w] {
+				t.Errorf("unexpected count for n=%d W=%d: got:%v want:%v", n, w, got[w], want[w])
+			}
+		}
+		if dist := newRankDist(got); dist.total != math.Exp2(float64(n)) {
+			t.Errorf("unexpected total for n=%d: got:%v want:%v", n, dist.total, math.Exp2(float64(n)))
+		}
+	}
+}
+
+func TestRankTestsPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"rank-sum empty", func() { MannWhitneyU(sleep1, nil, TwoSided) }},
+		{"rank-sum bad alternative", func() { MannWhitneyU(sleep1, sleep2, Alternative(3)) }},
+		{"signed-rank empty", func() { WilcoxonSignedRank(nil, nil, 0, TwoSided) }},
+		{"signed-rank length", func() { WilcoxonSignedRank(sleep1, sleep2[1:], 0, TwoSided) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func lgamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// TTest performs the one-sample Student's t-test of the null hypothesis that
+// the mean of the population from which x is drawn is mu. The statistic is
+//  t = (x̄ - mu) / (s / sqrt(n))
+// where x̄ and s are the sample mean and standard deviation of x and n is the
+// number of samples. The statistic has a Student's t distribution with n-1
+// degrees of freedom under the null hypothesis when the population is normal.
+// The confidence interval is for the mean with confidence level level.
+//
+// If all elements of x are equal, the standard error is zero and the mean is
+// known exactly. The statistic is then zero with a p-value of one when x̄
+// equals mu, and otherwise it is infinite with the sign of x̄ - mu and the
+// p-value is zero or one according to alt. The confidence interval
+// contains only x̄, extended to infinity on the side of a one-sided
+// alternative.
+//
+// TTest panics if len(x) is less than 2 or if level is not between 0 and 1.
+func TTest(x []float64, mu float64, alt Alternative, level float64) Result {
+	if len(x) < 2 {
+		panic(tooFewSamples)
+	}
+	mean, variance := stat.MeanVariance(x, nil)
+	n := float64(len(x))
+	return tTest(mean, mu, math.Sqrt(variance/n), n-1, alt, level)
+}
+
+// PairedTTest performs the paired Student's t-test of the null hypothesis
+// that the mean of the differences x[i] - y[i] is mu. The test is the
+// one-sample t-test of the differences. The confidence interval is for the
+// mean of the differences with confidence level level. Constant differences
+// are handled as described for TTest.
+//
+// PairedTTest panics if len(x) and len(y) are not equal, if len(x) is less
+// than 2 or if level is not between 0 and 1.
+func PairedTTest(x, y []float64, mu float64, alt Alternative, level float64) Result {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	d := make([]float64, len(x))
+	for i, v := range x {
+		d[i] = v - y[i]
+	}
+	return TTest(d, mu, alt, level)
+}
+
+// TwoSampleTTest performs Student's two-sample t-test of the null hypothesis
+// that the difference between the means of the populations from which x and
+// y are drawn is mu, assuming that the populations have equal variances. The
+// statistic is
+//  t = (x̄ - ȳ - mu) / (s_p sqrt(1/n_x + 1/n_y))
+// where s_p is the pooled sample standard deviation. The statistic has a
+// Student's t distribution with n_x+n_y-2 degrees of freedom under the null
+// hypothesis when the populations are normal. The confidence interval is for
+// the difference between the means with confidence level level. If x and y
+// are each constant, the pooled standard deviation is zero and the result is
+// that described for TTest with x̄ - ȳ in place of x̄.
+//
+// TwoSampleTTest panics if len(x) or len(y) is zero, if len(x)+len(y) is less
+// than 3 or if level is not between 0 and 1.
+func TwoSampleTTest(x, y []float64, mu float64, alt Alternative, level float64) Result {
+	nx := float64(len(x))
+	ny := float64(len(y))
+	if nx == 0 || ny == 0 || nx+ny < 3 {
+		panic(tooFewSamples)
+	}
+	mx, vx := sampleMeanVariance(x)
+	my, vy := sampleMeanVariance(y)
+	df := nx + ny - 2
+	pooled := ((nx-1)*vx + (ny-1)*vy) / df
+	se := math.Sqrt(pooled * (1/nx + 1/ny))
+	return tTest(mx-my, mu, se, df, alt, level)
+}
+
+// WelchTTest performs Welch's t-test of the null hypothesis that the
+// difference between the means of the populations from which x and y are
+// drawn is mu, without assuming that the populations have equal variances.
+// The statistic is
+//  t = (x̄ - ȳ - mu) / sqrt(s_x^2/n_x + s_y^2/n_y)
+// and under the null hypothesis it has approximately a Student's t
+// distribution with degrees of freedom given by the Welch–Satterthwaite
+// equation. The confidence interval is for the difference between the means
+// with confidence level level. If x and y are each constant, the result is
+// that described for TTest with x̄ - ȳ in place of x̄, and the degrees of
+// freedom are NaN.
+//
+// WelchTTest panics if len(x) or len(y) is less than 2 or if level is not
+// between 0 and 1.
+func WelchTTest(x, y []float64, mu float64, alt Alternative, level float64) Result {
+	if len(x) < 2 || len(y) < 2 {
+		panic(tooFewSamples)
+	}
+	nx := float64(len(x))
+	ny := float64(len(y))
+	mx, vx := stat.MeanVariance(x, nil)
+	my, vy := stat.MeanVariance(y, nil)
+	a := vx / nx
+	b := vy / ny
+	df := (a + b) * (a + b) / (a*a/(nx-1) + b*b/(ny-1))
+	return tTest(mx-my, mu, math.Sqrt(a+b), df, alt, level)
+}
+
+// sampleMeanVariance returns the mean and the unbiased variance of x, with
+// zero variance for a single sample.
+func sampleMeanVariance(x []float64) (mean, variance float64) {
+	if len(x) == 1 {
+		return x[0], 0
+	}
+	return stat.MeanVariance(x, nil)
+}
+
+// tTest returns the result of a t-test of an estimate est of a parameter
+// with hypothesized value mu, standard error se and df degrees of freedom.
+func tTest(est, mu, se, df float64, alt Alternative, level float64) Result {
+	if !(0 < level && level < 1) {
+		panic(badLevel)
+	}
+	if se == 0 {
+		return exactTTest(est, mu, df, alt)
+	}
+	dist := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: df}
+	t := (est - mu) / se
+	r := newResult(t, pValue(alt, dist.CDF(t), dist.Survival(t)))
+	r.DF = df
+	switch alt {
+	case TwoSided:
+		q := dist.Quantile(1 - (1-level)/2)
+		r.Lower = est - q*se
+		r.Upper = est + q*se
+	case Less:
+		r.Lower = math.Inf(-1)
+		r.Upper = est + dist.Quantile(level)*se
+	case Greater:
+		r.Lower = est - dist.Quantile(level)*se
+		r.Upper = math.Inf(1)
+	}
+	return r
+}
+
+// exactTTest returns the result of a t-test of an estimate est with zero
+// standard error. The statistic is zero if est equals mu, so that the null
+// hypothesis holds exactly, and infinite otherwise.
+func exactTTest(est, mu, df float64, alt Alternative) Result {
+	var t float64
+	lower, upper := 1.0, 1.0
+	switch {
+	case est < mu:
+		t = math.Inf(-1)
+		lower = 0
+	case est > mu:
+		t = math.Inf(1)
+		upper = 0
+	}
+	r := newResult(t, pValue(alt, lower, upper))
+	r.DF = df
+	r.Lower = est
+	r.Upper = est
+	switch alt {
+	case Less:
+		r.Lower = math.Inf(-1)
+	case Greater:
+		r.Upper = math.Inf(1)
+	}
+	return r
+}
@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Student's sleep data: the extra hours of sleep of ten patients given each
+// of two soporific drugs.
+var (
+	sleep1 = []float64{0.7, -1.6, -0.2, -1.2, -0.1, 3.4, 3.7, 0.8, 0.0, 2.0}
+	sleep2 = []float64{1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4}
+)
+
+func TestTTest(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	inf := math.Inf(1)
+	// Values were computed using R's t.test.
+	for _, test := range []struct {
+		name string
+		fn   func() Result
+		want Result
+		tol  float64
+	}{
+		{
+			name: "Welch",
+			fn:   func() Result { return WelchTTest(sleep1, sleep2, 0, TwoSided, 0.95) },
+			want: Result{Statistic: -1.860813, DF: 17.77647, DF2: nan, PValue: 0.07939414, Lower: -3.3654832, Upper: 0.2054832},
+			tol:  1e-6,
+		},
+		{
+			name: "Student",
+			fn:   func() Result { return TwoSampleTTest(sleep1, sleep2, 0, TwoSided, 0.95) },
+			want: Result{Statistic: -1.860813, DF: 18, DF2: nan, PValue: 0.07918671, Lower: -3.363874, Upper: 0.203874},
+			tol:  1e-6,
+		},
+		{
+			name: "Paired",
+			fn:   func() Result { return PairedTTest(sleep1, sleep2, 0, TwoSided, 0.95) },
+			want: Result{Statistic: -4.062128, DF: 9, DF2: nan, PValue: 0.002832890, Lower: -2.4598858, Upper: -0.7001142},
+			tol:  1e-6,
+		},
+		{
+			name: "Paired less",
+			fn:   func() Result { return PairedTTest(sleep1, sleep2, 0, Less, 0.95) },
+			want: Result{Statistic: -4.062128, DF: 9, DF2: nan, PValue: 0.001416445, Lower: -inf, Upper: -0.8669947},
+			tol:  1e-6,
+		},
+		{
+			name: "One-sample greater",
+			fn:   func() Result { return TTest(sleep1, 0, Greater, 0.9) },
+			want: Result{Statistic: 1.325710, DF: 9, DF2: nan, PValue: 0.1088, Lower: -0.0324275, Upper: inf},
+			tol:  1e-3,
+		},
+	} {
+		checkResult(t, test.name, test.fn(), test.want, test.tol)
+	}
+}
+
+func TestTTestCoverage(t *testing.T) {
+	t.Parallel()
+	const (
+		n      = 5000
+		level  = 0.9
+		tol    = 0.015
+		mu     = 3.0
+		sample = 8
+	)
+	rnd := rand.New(rand.NewSource(1))
+	norm := distuv.Normal{Mu: mu, Sigma: 2, Src: rnd}
+	var covered [3]int
+	var rejected [3]int
+	x := make([]float64, sample)
+	y := make([]float64, sample+3)
+	for i := 0; i < n; i++ {
+		for j := range x {
+			x[j] = norm.Rand()
+		}
+		for j := range y {
+			y[j] = norm.Rand()
+		}
+		for k, r := range []Result{
+			TTest(x, mu, TwoSided, level),
+			TwoSampleTTest(x, y, 0, Greater, level),
+			WelchTTest(x, y, 0, Less, level),
+		} {
+			want := mu
+			if k > 0 {
+				want = 0
+			}
+			if r.Lower <= want && want <= r.Upper {
+				covered[k]++
+			}
+			if r.PValue < 1-level {
+				rejected[k]++
+			}
+		}
+	}
+	for k := range covered {
+		if got := float64(covered[k]) / n; math.Abs(got-level) > tol {
+			t.Errorf("unexpected coverage for test %d: got:%v want:%v", k, got, level)
+		}
+		if got := float64(rejected[k]) / n; math.Abs(got-(1-level)) > tol {
+			t.Errorf("unexpected rejection rate for test %d: got:%v want:%v", k, got, 1-level)
+		}
+	}
+}
+
+func TestTTestConstant(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	inf := math.Inf(1)
+	x := []float64{2, 2, 2, 2}
+	for _, test := range []struct {
+		name string
+		fn   func() Result
+		want Result
+	}{
+		{
+			name: "equal",
+			fn:   func() Result { return TTest(x, 2, TwoSided, 0.95) },
+			want: Result{Statistic: 0, DF: 3, DF2: nan, PValue: 1, Lower: 2, Upper: 2},
+		},
+		{
+			name: "equal less",
+			fn:   func() Result { return TTest(x, 2, Less, 0.95) },
+			want: Result{Statistic: 0, DF: 3, DF2: nan, PValue: 1, Lower: -inf, Upper: 2},
+		},
+		{
+			name: "above",
+			fn:   func() Result { return TTest(x, 1, TwoSided, 0.95) },
+			want: Result{Statistic: inf, DF: 3, DF2: nan, PValue: 0, Lower: 2, Upper: 2},
+		},
+		{
+			name: "above less",
+			fn:   func() Result { return TTest(x, 1, Less, 0.95) },
+			want: Result{Statistic: inf, DF: 3, DF2: nan, PValue: 1, Lower: -inf, Upper: 2},
+		},
+		{
+			name: "below greater",
+			fn:   func() Result { return TTest(x, 3, Greater, 0.95) },
+			want: Result{Statistic: -inf, DF: 3, DF2: nan, PValue: 1, Lower: 2, Upper: inf},
+		},
+		{
+			name: "paired",
+			fn:   func() Result { return PairedTTest([]float64{3, 4, 5}, []float64{1, 2, 3}, 0, Greater, 0.95) },
+			want: Result{Statistic: inf, DF: 2, DF2: nan, PValue: 0, Lower: 2, Upper: inf},
+		},
+		{
+			name: "two-sample",
+			fn:   func() Result { return TwoSampleTTest([]float64{1}, []float64{3, 3}, 0, TwoSided, 0.95) },
+			want: Result{Statistic: -inf, DF: 1, DF2: nan, PValue: 0, Lower: -2, Upper: -2},
+		},
+		{
+			name: "Welch",
+			fn:   func() Result { return WelchTTest([]float64{1, 1}, []float64{3, 3}, -2, Less, 0.95) },
+			want: Result{Statistic: 0, DF: nan, DF2: nan, PValue: 1, Lower: -inf, Upper: -2},
+		},
+	} {
+		checkResult(t, test.name, test.fn(), test.want, 0)
+	}
+
+	if !panics(func() { TTest(x, 2, Alternative(3), 0.95) }) {
+		t.Errorf("expected panic for bad alternative with constant samples")
+	}
+}
+
+func TestTTestPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"one sample", func() { TTest([]float64{1}, 0, TwoSided, 0.95) }},
+		{"level zero", func() { TTest(sleep1, 0, TwoSided, 0) }},
+		{"level one", func() { TTest(sleep1, 0, TwoSided, 1) }},
+		{"bad alternative", func() { TTest(sleep1, 0, Alternative(3), 0.95) }},
+		{"paired length", func() { PairedTTest(sleep1, sleep2[1:], 0, TwoSided, 0.95) }},
+		{"two-sample empty", func() { TwoSampleTTest(sleep1, nil, 0, TwoSided, 0.95) }},
+		{"two-sample too few", func() { TwoSampleTTest([]float64{1}, []float64{2}, 0, TwoSided, 0.95) }},
+		{"Welch too few", func() { WelchTTest(sleep1, []float64{2}, 0, TwoSided, 0.95) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
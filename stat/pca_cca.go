@@ -17,6 +17,7 @@ import (
 // if the call to PrincipalComponents was successful.
 type PC struct {
 	n, d    int
+	k       int
 	weights []float64
 	svd     *mat.SVD
 	ok      bool
@@ -41,26 +42,60 @@ func (c *PC) PrincipalComponents(a mat.Matrix, weights []float64) (ok bool) {
 
 	c.svd, c.ok = svdFactorizeCentered(c.svd, a, weights)
 	if c.ok {
+		c.k = min(c.n, c.d)
+		c.weights = append(c.weights[:0], weights...)
+	}
+	return c.ok
+}
+
+// TruncatedPrincipalComponents performs a weighted principal components
+// analysis like PrincipalComponents, but only the first k components with the
+// largest variances are computed. The components are computed iteratively
+// using mat.SVD.FactorizeLanczos which is considerably faster than computing
+// all the components when k is small compared to the number of variables.
+//
+// TruncatedPrincipalComponents will panic if k is not in the range
+// [1, min(n, d)] or if weights is not nil and its length does not match the
+// number of observations.
+//
+// TruncatedPrincipalComponents returns whether the analysis was successful.
+func (c *PC) TruncatedPrincipalComponents(a mat.Matrix, weights []float64, k int) (ok bool) {
+	c.n, c.d = a.Dims()
+	if weights != nil && len(weights) != c.n {
+		panic("stat: len(weights) != observations")
+	}
+	if k < 1 || min(c.n, c.d) < k {
+		panic("stat: number of components out of range")
+	}
+
+	if c.svd == nil {
+		c.svd = &mat.SVD{}
+	}
+	c.ok = c.svd.FactorizeLanczos(centerWeighted(a, weights), mat.SVDThinV, k, 0)
+	if c.ok {
+		c.k = k
 		c.weights = append(c.weights[:0], weights...)
 	}
 	return c.ok
 }
 
 // VectorsTo returns the component direction vectors of a principal components
-// analysis. The vectors are returned in the columns of a d×min(n, d) matrix.
+// analysis. The vectors are returned in the columns of a d×min(n, d) matrix,
+// or a d×k matrix if only k components were computed by
+// TruncatedPrincipalComponents.
 //
-// If dst is empty, VectorsTo will resize dst to be d×min(n, d). When dst is
-// non-empty, VectorsTo will panic if dst is not d×min(n, d). VectorsTo will also
-// panic if the receiver does not contain a successful PC.
+// If dst is empty, VectorsTo will resize dst to be d×min(n, d), or d×k. When
+// dst is non-empty, VectorsTo will panic if dst is not of that size. VectorsTo
+// will also panic if the receiver does not contain a successful PC.
 func (c *PC) VectorsTo(dst *mat.Dense) {
 	if !c.ok {
 		panic("stat: use of unsuccessful principal components analysis")
 	}
 
 	if dst.IsEmpty() {
-		dst.ReuseAs(c.d, min(c.n, c.d))
+		dst.ReuseAs(c.d, c.k)
 	} else {
-		if d, n := dst.Dims(); d != c.d || n != min(c.n, c.d) {
+		if d, n := dst.Dims(); d != c.d || n != c.k {
 			panic(mat.ErrShape)
 		}
 	}
@@ -72,12 +107,13 @@ func (c *PC) VectorsTo(dst *mat.Dense) {
 // in descending order.
 // If dst is not nil it is used to store the variances and returned.
 // Vars will panic if the receiver has not successfully performed a principal
-// components analysis or dst is not nil and the length of dst is not min(n, d).
+// components analysis or dst is not nil and the length of dst is not min(n, d),
+// or k if only k components were computed by TruncatedPrincipalComponents.
 func (c *PC) VarsTo(dst []float64) []float64 {
 	if !c.ok {
 		panic("stat: use of unsuccessful principal components analysis")
 	}
-	if dst != nil && len(dst) != min(c.n, c.d) {
+	if dst != nil && len(dst) != c.k {
 		panic("stat: length of slice does not match analysis")
 	}
 
@@ -287,6 +323,16 @@ func (c *CC) RightTo(dst *mat.Dense, spheredSpace bool) {
 }
 
 func svdFactorizeCentered(work *mat.SVD, m mat.Matrix, weights []float64) (svd *mat.SVD, ok bool) {
+	if work == nil {
+		work = &mat.SVD{}
+	}
+	ok = work.Factorize(centerWeighted(m, weights), mat.SVDThin)
+	return work, ok
+}
+
+// centerWeighted returns a copy of m with centered columns and with rows
+// scaled by the square root of the corresponding weights.
+func centerWeighted(m mat.Matrix, weights []float64) *mat.Dense {
 	n, d := m.Dims()
 	centered := mat.NewDense(n, d, nil)
 	col := make([]float64, n)
@@ -298,11 +344,7 @@ func svdFactorizeCentered(work *mat.SVD, m mat.Matrix, weights []float64) (svd *
 	for i, w := range weights {
 		floats.Scale(math.Sqrt(w), centered.RawRowView(i))
 	}
-	if work == nil {
-		work = &mat.SVD{}
-	}
-	ok = work.Factorize(centered, mat.SVDThin)
-	return work, ok
+	return centered
 }
 
 // scaleColsReciSqrt scales the columns of cols
@@ -8,6 +8,8 @@ import (
 	"math"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/floats/scalar"
 	"gonum.org/v1/gonum/mat"
 )
@@ -196,6 +198,66 @@ tests:
 	}
 }
 
+func TestTruncatedPrincipalComponents(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	const n, d = 200, 30
+	data := mat.NewDense(n, d, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < d; j++ {
+			// Give the variables different scales so that the
+			// variances are well separated.
+			data.Set(i, j, float64(j+1)*rnd.NormFloat64())
+		}
+	}
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1 + rnd.Float64()
+	}
+
+	for _, w := range [][]float64{nil, weights} {
+		var full PC
+		if !full.PrincipalComponents(data, w) {
+			t.Fatal("unexpected PCA failure")
+		}
+		var wantVecs mat.Dense
+		full.VectorsTo(&wantVecs)
+		wantVars := full.VarsTo(nil)
+
+		for _, k := range []int{1, 5, d} {
+			var pc PC
+			if !pc.TruncatedPrincipalComponents(data, w, k) {
+				t.Errorf("k=%d: unexpected PCA failure", k)
+				continue
+			}
+			var vecs mat.Dense
+			pc.VectorsTo(&vecs)
+			vars := pc.VarsTo(nil)
+			if !approxEqual(vars, wantVars[:k], tol) {
+				t.Errorf("k=%d: unexpected variances: got %v, want %v", k, vars, wantVars[:k])
+			}
+			if r, c := vecs.Dims(); r != d || c != k {
+				t.Errorf("k=%d: unexpected size of vectors: %d×%d", k, r, c)
+				continue
+			}
+			// The vectors are unique up to their sign.
+			for j := 0; j < k; j++ {
+				got := vecs.ColView(j)
+				want := wantVecs.ColView(j)
+				if mat.Dot(got, want) < 0 {
+					var neg mat.VecDense
+					neg.ScaleVec(-1, got)
+					got = &neg
+				}
+				if !mat.EqualApprox(got, want, tol) {
+					t.Errorf("k=%d: unexpected vector %d", k, j)
+				}
+			}
+		}
+	}
+}
+
 func approxEqual(a, b []float64, epsilon float64) bool {
 	if len(a) != len(b) {
 		return false